@@ -5,32 +5,150 @@ import (
 	"flag"
 	"log"
 	"path/filepath"
+	"strings"
 	"time"
 
+	"github.com/jirfag/go-queryset/internal/config"
 	"github.com/jirfag/go-queryset/internal/parser"
 	"github.com/jirfag/go-queryset/internal/queryset/generator"
+	"github.com/jirfag/go-queryset/internal/queryset/methods"
+	"github.com/pkg/errors"
 )
 
+// filesFlag is a repeatable flag: -in a.go -in b.go
+type filesFlag []string
+
+func (f *filesFlag) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *filesFlag) Set(v string) error {
+	*f = append(*f, v)
+	return nil
+}
+
 func main() {
-	const defaultOutPath = "autogenerated_{in}"
+	const (
+		defaultInPath     = "models.go"
+		defaultOutPath    = "autogenerated_{in}"
+		defaultPkgOutName = "autogenerated_querysets.go"
+	)
 
-	inFile := flag.String("in", "models.go", "path to input file")
+	var inFiles filesFlag
+	flag.Var(&inFiles, "in", "path to input file, can be repeated to generate "+
+		"one output for multiple files of one package (default \""+defaultInPath+"\")")
+	pkgDir := flag.String("pkg", "", "path to package directory: generate one output for all its files")
 	outFile := flag.String("out", defaultOutPath, "path to output file")
 	timeout := flag.Duration("timeout", time.Minute, "timeout for generation")
+	backendName := flag.String("backend", string(methods.BackendGormV1),
+		"gorm version to generate querysets for: gorm1 (github.com/jinzhu/gorm) or gorm2 (gorm.io/gorm)")
+	configPath := flag.String("config", "", "path to config file (e.g. "+config.FileName+
+		"): generate querysets for all packages listed in it")
 	flag.Parse()
 
+	backend, err := methods.ParseBackend(*backendName)
+	if err != nil {
+		log.Fatalf("invalid backend: %s", err)
+	}
+
+	ctx, finish := context.WithTimeout(context.Background(), *timeout)
+	defer finish()
+
+	if *configPath != "" {
+		if *pkgDir != "" || len(inFiles) != 0 || *outFile != defaultOutPath {
+			log.Fatalf("-config can't be used with -in, -pkg or -out")
+		}
+
+		// explicitly passed backend overrides config one
+		if !isFlagPassed("backend") {
+			backend = ""
+		}
+		if err = generateByConfig(ctx, *configPath, backend); err != nil {
+			log.Fatalf("can't generate query sets: %s", err)
+		}
+		return
+	}
+
+	if *pkgDir != "" && len(inFiles) != 0 {
+		log.Fatalf("-pkg and -in can't be used together")
+	}
+	if *pkgDir == "" && len(inFiles) == 0 {
+		inFiles = filesFlag{defaultInPath}
+	}
+
 	if *outFile == defaultOutPath {
-		*outFile = filepath.Join(filepath.Dir(*inFile), "autogenerated_"+filepath.Base(*inFile))
+		switch {
+		case *pkgDir != "":
+			*outFile = filepath.Join(*pkgDir, defaultPkgOutName)
+		case len(inFiles) == 1:
+			*outFile = filepath.Join(filepath.Dir(inFiles[0]), "autogenerated_"+filepath.Base(inFiles[0]))
+		default:
+			*outFile = filepath.Join(filepath.Dir(inFiles[0]), defaultPkgOutName)
+		}
 	}
 
 	g := generator.Generator{
 		StructsParser: &parser.Structs{},
+		Backend:       backend,
 	}
 
-	ctx, finish := context.WithTimeout(context.Background(), *timeout)
-	defer finish()
-
-	if err := g.Generate(ctx, *inFile, *outFile); err != nil {
+	switch {
+	case *pkgDir != "":
+		err = g.GeneratePackage(ctx, *pkgDir, *outFile)
+	case len(inFiles) == 1:
+		err = g.Generate(ctx, inFiles[0], *outFile)
+	default:
+		err = g.GenerateFiles(ctx, inFiles, *outFile)
+	}
+	if err != nil {
 		log.Fatalf("can't generate query sets: %s", err)
 	}
 }
+
+func isFlagPassed(name string) bool {
+	passed := false
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			passed = true
+		}
+	})
+	return passed
+}
+
+// generateByConfig generates querysets for all packages from config,
+// backend overrides config backend if it's not empty
+func generateByConfig(ctx context.Context, configPath string, backend methods.Backend) error {
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return err
+	}
+
+	targets, err := cfg.Targets()
+	if err != nil {
+		return err
+	}
+
+	if backend == "" {
+		backend = cfg.Backend
+	}
+
+	for _, t := range targets {
+		g := generator.Generator{
+			StructsParser: &parser.Structs{},
+			Backend:       backend,
+			Structs:       t.Structs,
+			Naming:        cfg.Naming,
+			SkipMethods:   cfg.SkipMethods,
+		}
+
+		err = g.GeneratePackage(ctx, t.Dir, t.Out)
+		if errors.Cause(err) == generator.ErrNoStructs && t.Recursive {
+			continue // "gen:qs" is found in package, but not in struct annotation
+		}
+		if err != nil {
+			return errors.Wrapf(err, "can't generate querysets for package %s", t.Dir)
+		}
+	}
+
+	return nil
+}
@@ -2,8 +2,15 @@
 package gorm4
 
 import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"reflect"
+	"sort"
 	"strings"
 	"time"
 
@@ -39,32 +46,189 @@ func (qs UserQuerySet) Select(fields ...UserDBSchemaField) UserQuerySet {
 	return qs.w(qs.db.Select(strings.Join(names, ",")))
 }
 
+// GroupBy groups rows by fields
+func (qs UserQuerySet) GroupBy(fields ...UserDBSchemaField) UserQuerySet {
+	names := []string{}
+	for _, f := range fields {
+		names = append(names, f.String())
+	}
+
+	return qs.w(qs.db.Group(strings.Join(names, ",")))
+}
+
+// Where filters rows by predicate p, predicates are created by UserQ
+// nolint: dupl
+func (qs UserQuerySet) Where(p UserPredicate) UserQuerySet {
+	if p.err != nil {
+		// error is added to copy of db: qs can be reused as a base queryset
+		db := qs.db.Model(&User{})
+		db.AddError(p.err)
+		return qs.w(db)
+	}
+	if p.expr == "" {
+		return qs
+	}
+
+	return qs.w(qs.db.Where(p.expr, p.args...))
+}
+
+// Not filters rows not matching predicate p
+// nolint: dupl
+func (qs UserQuerySet) Not(p UserPredicate) UserQuerySet {
+	return qs.Where(p.Not())
+}
+
 // Create is an autogenerated method
 // nolint: dupl
 func (o *User) Create(db *gorm.DB) error {
-	return db.Create(o).Error
+	return checkQueryContext(db).Create(o).Error
 }
 
 // Delete is an autogenerated method
 // nolint: dupl
 func (o *User) Delete(db *gorm.DB) error {
-	return db.Delete(o).Error
+	return checkQueryContext(db).Delete(o).Error
 }
 
 // All is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) All(ret *[]User) error {
-	return qs.db.Find(ret).Error
+	return checkQueryContext(qs.db).Find(ret).Error
+}
+
+// AvgRating returns AVG of Rating, it's zero value if there are no rows
+// nolint: dupl
+func (qs UserQuerySet) AvgRating() (float64, error) {
+	var ret float64
+	var res struct {
+		Value *float64
+	}
+	err := checkQueryContext(qs.db).Select("AVG(rating) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// AvgRatingMarks returns AVG of RatingMarks, it's zero value if there are no rows
+// nolint: dupl
+func (qs UserQuerySet) AvgRatingMarks() (float64, error) {
+	var ret float64
+	var res struct {
+		Value *float64
+	}
+	err := checkQueryContext(qs.db).Select("AVG(rating_marks) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// Batches calls fn for every batch of at most size rows, batches are
+// fetched by Page: ordered by primary key after queryset orders.
+// It stops on the first error of fn and returns it.
+// nolint: dupl
+func (qs UserQuerySet) Batches(size int, fn func([]User) error) error {
+	cursor := ""
+	for {
+		var batch []User
+		next, err := qs.Page(cursor, size, &batch)
+		if err != nil {
+			return err
+		}
+
+		if len(batch) != 0 {
+			if err = fn(batch); err != nil {
+				return err
+			}
+		}
+
+		if next == "" {
+			return nil
+		}
+		cursor = next
+	}
 }
 
 // Count is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) Count() (int, error) {
 	var count int
-	err := qs.db.Count(&count).Error
+	db := checkQueryContext(qs.db)
+	if db.Error != nil {
+		return count, db.Error
+	}
+
+	err := db.Count(&count).Error
 	return count, err
 }
 
+// CountByID returns count of rows for every value of ID
+// nolint: dupl
+func (qs UserQuerySet) CountByID() (map[uint]int, error) {
+	var rows []struct {
+		Value uint
+		Count int
+	}
+	err := checkQueryContext(qs.db).Select("id AS value, COUNT(*) AS count").Group("id").Scan(&rows).Error
+	ret := make(map[uint]int, len(rows))
+	for _, r := range rows {
+		ret[r.Value] = r.Count
+	}
+	return ret, err
+}
+
+// CountByRating returns count of rows for every value of Rating
+// nolint: dupl
+func (qs UserQuerySet) CountByRating() (map[int]int, error) {
+	var rows []struct {
+		Value int
+		Count int
+	}
+	err := checkQueryContext(qs.db).Select("rating AS value, COUNT(*) AS count").Group("rating").Scan(&rows).Error
+	ret := make(map[int]int, len(rows))
+	for _, r := range rows {
+		ret[r.Value] = r.Count
+	}
+	return ret, err
+}
+
+// CountByRatingMarks returns count of rows for every value of RatingMarks
+// nolint: dupl
+func (qs UserQuerySet) CountByRatingMarks() (map[int]int, error) {
+	var rows []struct {
+		Value int
+		Count int
+	}
+	err := checkQueryContext(qs.db).Select("rating_marks AS value, COUNT(*) AS count").Group("rating_marks").Scan(&rows).Error
+	ret := make(map[int]int, len(rows))
+	for _, r := range rows {
+		ret[r.Value] = r.Count
+	}
+	return ret, err
+}
+
+// CreateMany inserts models by multi-row inserts, conditions of queryset
+// aren't used. Generated primary keys are written back to models if
+// database supports it (all except MS SQL for gorm v1).
+// Unlike gorm Create it doesn't call hooks and doesn't read back default
+// values: zero fields having default values are skipped in insert.
+// nolint: dupl
+func (qs UserQuerySet) CreateMany(models []User) error {
+	rows := make([]map[string]interface{}, 0, len(models))
+	for i := range models {
+		rows = append(rows, qs.fieldPtrs(&models[i]))
+	}
+
+	return bulkInsert(checkQueryContext(qs.db), "id", rows)
+}
+
+// CreatedAtBetween filters rows with CreatedAt in range [from, to]
+// nolint: dupl
+func (qs UserQuerySet) CreatedAtBetween(from time.Time, to time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("created_at BETWEEN ? AND ?", from, to))
+}
+
 // CreatedAtEq is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) CreatedAtEq(createdAt time.Time) UserQuerySet {
@@ -83,6 +247,12 @@ func (qs UserQuerySet) CreatedAtGte(createdAt time.Time) UserQuerySet {
 	return qs.w(qs.db.Where("created_at >= ?", createdAt))
 }
 
+// CreatedAtInRange filters rows with CreatedAt in range [from, to)
+// nolint: dupl
+func (qs UserQuerySet) CreatedAtInRange(from time.Time, to time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("created_at >= ? AND created_at < ?", from, to))
+}
+
 // CreatedAtLt is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) CreatedAtLt(createdAt time.Time) UserQuerySet {
@@ -101,26 +271,38 @@ func (qs UserQuerySet) CreatedAtNe(createdAt time.Time) UserQuerySet {
 	return qs.w(qs.db.Where("created_at != ?", createdAt))
 }
 
+// CreatedAtWithin filters rows with CreatedAt not earlier than d ago
+// nolint: dupl
+func (qs UserQuerySet) CreatedAtWithin(d time.Duration) UserQuerySet {
+	return qs.w(qs.db.Where("created_at >= ?", time.Now().Add(-d)))
+}
+
 // Delete is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) Delete() error {
-	return qs.db.Delete(User{}).Error
+	return checkQueryContext(qs.db).Delete(User{}).Error
 }
 
 // DeleteNum is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) DeleteNum() (int64, error) {
-	db := qs.db.Delete(User{})
+	db := checkQueryContext(qs.db).Delete(User{})
 	return db.RowsAffected, db.Error
 }
 
 // DeleteNumUnscoped is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) DeleteNumUnscoped() (int64, error) {
-	db := qs.db.Unscoped().Delete(User{})
+	db := checkQueryContext(qs.db).Unscoped().Delete(User{})
 	return db.RowsAffected, db.Error
 }
 
+// DeletedAtBetween filters rows with DeletedAt in range [from, to]
+// nolint: dupl
+func (qs UserQuerySet) DeletedAtBetween(from time.Time, to time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("deleted_at BETWEEN ? AND ?", from, to))
+}
+
 // DeletedAtEq is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) DeletedAtEq(deletedAt time.Time) UserQuerySet {
@@ -139,6 +321,12 @@ func (qs UserQuerySet) DeletedAtGte(deletedAt time.Time) UserQuerySet {
 	return qs.w(qs.db.Where("deleted_at >= ?", deletedAt))
 }
 
+// DeletedAtInRange filters rows with DeletedAt in range [from, to)
+// nolint: dupl
+func (qs UserQuerySet) DeletedAtInRange(from time.Time, to time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("deleted_at >= ? AND deleted_at < ?", from, to))
+}
+
 // DeletedAtIsNotNull is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) DeletedAtIsNotNull() UserQuerySet {
@@ -169,6 +357,12 @@ func (qs UserQuerySet) DeletedAtNe(deletedAt time.Time) UserQuerySet {
 	return qs.w(qs.db.Where("deleted_at != ?", deletedAt))
 }
 
+// DeletedAtWithin filters rows with DeletedAt not earlier than d ago
+// nolint: dupl
+func (qs UserQuerySet) DeletedAtWithin(d time.Duration) UserQuerySet {
+	return qs.w(qs.db.Where("deleted_at >= ?", time.Now().Add(-d)))
+}
+
 // GetDB is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) GetDB() *gorm.DB {
@@ -181,6 +375,84 @@ func (qs UserQuerySet) GetUpdater() UserUpdater {
 	return NewUserUpdater(qs.db)
 }
 
+// GroupByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) GroupByCreatedAt() UserQuerySet {
+	return qs.w(qs.db.Group("created_at"))
+}
+
+// GroupByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) GroupByDeletedAt() UserQuerySet {
+	return qs.w(qs.db.Group("deleted_at"))
+}
+
+// GroupByID is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) GroupByID() UserQuerySet {
+	return qs.w(qs.db.Group("id"))
+}
+
+// GroupByRating is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) GroupByRating() UserQuerySet {
+	return qs.w(qs.db.Group("rating"))
+}
+
+// GroupByRatingMarks is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) GroupByRatingMarks() UserQuerySet {
+	return qs.w(qs.db.Group("rating_marks"))
+}
+
+// GroupByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) GroupByUpdatedAt() UserQuerySet {
+	return qs.w(qs.db.Group("updated_at"))
+}
+
+// HavingCountEq is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) HavingCountEq(count int) UserQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) = ?", count))
+}
+
+// HavingCountGt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) HavingCountGt(count int) UserQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) > ?", count))
+}
+
+// HavingCountGte is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) HavingCountGte(count int) UserQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) >= ?", count))
+}
+
+// HavingCountLt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) HavingCountLt(count int) UserQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) < ?", count))
+}
+
+// HavingCountLte is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) HavingCountLte(count int) UserQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) <= ?", count))
+}
+
+// HavingCountNe is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) HavingCountNe(count int) UserQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) != ?", count))
+}
+
+// IDBetween filters rows with ID in range [from, to]
+// nolint: dupl
+func (qs UserQuerySet) IDBetween(from uint, to uint) UserQuerySet {
+	return qs.w(qs.db.Where("id BETWEEN ? AND ?", from, to))
+}
+
 // IDEq is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) IDEq(ID uint) UserQuerySet {
@@ -209,6 +481,18 @@ func (qs UserQuerySet) IDIn(ID ...uint) UserQuerySet {
 	return qs.w(qs.db.Where("id IN (?)", ID))
 }
 
+// IDInQuery filters rows with ID IN values selected by subquery q
+// nolint: dupl
+func (qs UserQuerySet) IDInQuery(q UintSubquery) UserQuerySet {
+	return qs.w(qs.db.Where("id IN (?)", q.expr()))
+}
+
+// IDInRange filters rows with ID in range [from, to)
+// nolint: dupl
+func (qs UserQuerySet) IDInRange(from uint, to uint) UserQuerySet {
+	return qs.w(qs.db.Where("id >= ? AND id < ?", from, to))
+}
+
 // IDLt is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) IDLt(ID uint) UserQuerySet {
@@ -237,12 +521,206 @@ func (qs UserQuerySet) IDNotIn(ID ...uint) UserQuerySet {
 	return qs.w(qs.db.Where("id NOT IN (?)", ID))
 }
 
+// IDNotInQuery filters rows with ID NOT IN values selected by subquery q
+// nolint: dupl
+func (qs UserQuerySet) IDNotInQuery(q UintSubquery) UserQuerySet {
+	return qs.w(qs.db.Where("id NOT IN (?)", q.expr()))
+}
+
+// InTx returns copy bound to transaction tx: queries are executed in it.
+// Gorm v1 can't move conditions into another connection, so with gorm v1
+// InTx must be called before any condition is set, otherwise queries fail.
+// Context and preloads are moved into transaction by both gorm versions.
+// nolint: dupl
+func (qs UserQuerySet) InTx(tx *gorm.DB) UserQuerySet {
+	if !reflect.DeepEqual(qs.db.QueryExpr(), NewUserQuerySet(qs.db.New()).db.QueryExpr()) {
+		tx = tx.New()
+		tx.AddError(errors.New("InTx must be called before any condition is set: " +
+			"gorm v1 can't move conditions into transaction"))
+	}
+	tx = txWithSettings(qs.db, tx)
+	return NewUserQuerySet(tx)
+}
+
+// Iterate scans rows one by one and calls fn for every row without
+// loading all rows into memory. It stops on the first error of fn and
+// returns it. Preloads aren't applied.
+// nolint: dupl
+func (qs UserQuerySet) Iterate(fn func(*User) error) error {
+	db := checkQueryContext(qs.db)
+	if db.Error != nil {
+		return db.Error // gorm v1 doesn't check it in Rows
+	}
+
+	rows, err := db.Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var o User
+		if err = db.ScanRows(rows, &o); err != nil {
+			return err
+		}
+		if err = fn(&o); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
 // Limit is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) Limit(limit int) UserQuerySet {
 	return qs.w(qs.db.Limit(limit))
 }
 
+// MaxCreatedAt returns MAX of CreatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs UserQuerySet) MaxCreatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := checkQueryContext(qs.db).Select("created_at AS value").Where("created_at IS NOT NULL").
+		Order("created_at DESC", true).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MaxID returns MAX of ID, it's zero value if there are no rows
+// nolint: dupl
+func (qs UserQuerySet) MaxID() (uint, error) {
+	var ret uint
+	var res struct {
+		Value *uint
+	}
+	err := checkQueryContext(qs.db).Select("MAX(id) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// MaxRating returns MAX of Rating, it's zero value if there are no rows
+// nolint: dupl
+func (qs UserQuerySet) MaxRating() (int, error) {
+	var ret int
+	var res struct {
+		Value *int
+	}
+	err := checkQueryContext(qs.db).Select("MAX(rating) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// MaxRatingMarks returns MAX of RatingMarks, it's zero value if there are no rows
+// nolint: dupl
+func (qs UserQuerySet) MaxRatingMarks() (int, error) {
+	var ret int
+	var res struct {
+		Value *int
+	}
+	err := checkQueryContext(qs.db).Select("MAX(rating_marks) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// MaxUpdatedAt returns MAX of UpdatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs UserQuerySet) MaxUpdatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := checkQueryContext(qs.db).Select("updated_at AS value").Where("updated_at IS NOT NULL").
+		Order("updated_at DESC", true).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MinCreatedAt returns MIN of CreatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs UserQuerySet) MinCreatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := checkQueryContext(qs.db).Select("created_at AS value").Where("created_at IS NOT NULL").
+		Order("created_at ASC", true).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MinID returns MIN of ID, it's zero value if there are no rows
+// nolint: dupl
+func (qs UserQuerySet) MinID() (uint, error) {
+	var ret uint
+	var res struct {
+		Value *uint
+	}
+	err := checkQueryContext(qs.db).Select("MIN(id) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// MinRating returns MIN of Rating, it's zero value if there are no rows
+// nolint: dupl
+func (qs UserQuerySet) MinRating() (int, error) {
+	var ret int
+	var res struct {
+		Value *int
+	}
+	err := checkQueryContext(qs.db).Select("MIN(rating) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// MinRatingMarks returns MIN of RatingMarks, it's zero value if there are no rows
+// nolint: dupl
+func (qs UserQuerySet) MinRatingMarks() (int, error) {
+	var ret int
+	var res struct {
+		Value *int
+	}
+	err := checkQueryContext(qs.db).Select("MIN(rating_marks) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// MinUpdatedAt returns MIN of UpdatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs UserQuerySet) MinUpdatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := checkQueryContext(qs.db).Select("updated_at AS value").Where("updated_at IS NOT NULL").
+		Order("updated_at ASC", true).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
 // Offset is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) Offset(offset int) UserQuerySet {
@@ -252,79 +730,113 @@ func (qs UserQuerySet) Offset(offset int) UserQuerySet {
 // One is used to retrieve one result. It returns gorm.ErrRecordNotFound
 // if nothing was fetched
 func (qs UserQuerySet) One(ret *User) error {
-	return qs.db.First(ret).Error
+	return checkQueryContext(qs.db).First(ret).Error
 }
 
 // OrderAscByCreatedAt is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) OrderAscByCreatedAt() UserQuerySet {
-	return qs.w(qs.db.Order("created_at ASC"))
+	return qs.w(addPageOrder(qs.db.Order("created_at ASC"), "created_at", false, false))
 }
 
 // OrderAscByDeletedAt is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) OrderAscByDeletedAt() UserQuerySet {
-	return qs.w(qs.db.Order("deleted_at ASC"))
+	return qs.w(addPageOrder(qs.db.Order("deleted_at ASC"), "deleted_at", false, false))
 }
 
 // OrderAscByID is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) OrderAscByID() UserQuerySet {
-	return qs.w(qs.db.Order("id ASC"))
+	return qs.w(addPageOrder(qs.db.Order("id ASC"), "id", false, false))
 }
 
 // OrderAscByRating is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) OrderAscByRating() UserQuerySet {
-	return qs.w(qs.db.Order("rating ASC"))
+	return qs.w(addPageOrder(qs.db.Order("rating ASC"), "rating", false, false))
 }
 
 // OrderAscByRatingMarks is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) OrderAscByRatingMarks() UserQuerySet {
-	return qs.w(qs.db.Order("rating_marks ASC"))
+	return qs.w(addPageOrder(qs.db.Order("rating_marks ASC"), "rating_marks", false, false))
 }
 
 // OrderAscByUpdatedAt is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) OrderAscByUpdatedAt() UserQuerySet {
-	return qs.w(qs.db.Order("updated_at ASC"))
+	return qs.w(addPageOrder(qs.db.Order("updated_at ASC"), "updated_at", false, false))
 }
 
 // OrderDescByCreatedAt is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) OrderDescByCreatedAt() UserQuerySet {
-	return qs.w(qs.db.Order("created_at DESC"))
+	return qs.w(addPageOrder(qs.db.Order("created_at DESC"), "created_at", true, false))
 }
 
 // OrderDescByDeletedAt is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) OrderDescByDeletedAt() UserQuerySet {
-	return qs.w(qs.db.Order("deleted_at DESC"))
+	return qs.w(addPageOrder(qs.db.Order("deleted_at DESC"), "deleted_at", true, false))
 }
 
 // OrderDescByID is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) OrderDescByID() UserQuerySet {
-	return qs.w(qs.db.Order("id DESC"))
+	return qs.w(addPageOrder(qs.db.Order("id DESC"), "id", true, false))
 }
 
 // OrderDescByRating is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) OrderDescByRating() UserQuerySet {
-	return qs.w(qs.db.Order("rating DESC"))
+	return qs.w(addPageOrder(qs.db.Order("rating DESC"), "rating", true, false))
 }
 
 // OrderDescByRatingMarks is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) OrderDescByRatingMarks() UserQuerySet {
-	return qs.w(qs.db.Order("rating_marks DESC"))
+	return qs.w(addPageOrder(qs.db.Order("rating_marks DESC"), "rating_marks", true, false))
 }
 
 // OrderDescByUpdatedAt is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) OrderDescByUpdatedAt() UserQuerySet {
-	return qs.w(qs.db.Order("updated_at DESC"))
+	return qs.w(addPageOrder(qs.db.Order("updated_at DESC"), "updated_at", true, false))
+}
+
+// Page fetches at most size rows following cursor into ret, it uses
+// orders set by OrderAscBy/OrderDescBy methods and primary key to break ties.
+// Ordered fields can't be nullable: rows with NULL can't be compared with
+// cursor, so error is returned for them. Empty cursor means the first page. It returns cursor of the next page or
+// empty string if there are no more rows.
+// nolint: dupl
+func (qs UserQuerySet) Page(cursor string, size int, ret *[]User) (string, error) {
+	if size <= 0 {
+		return "", errors.New("page size must be positive")
+	}
+
+	var last User
+	db, orders, err := pageQuery(qs.db, "", "id", cursor, qs.fieldPtrs(&last))
+	if err != nil {
+		return "", err
+	}
+
+	if err = checkQueryContext(db).Limit(size + 1).Find(ret).Error; err != nil {
+		return "", err
+	}
+	if len(*ret) <= size {
+		return "", nil
+	}
+
+	*ret = (*ret)[:size]
+	return encodePageCursor(orders, qs.fieldPtrs(&(*ret)[size-1]))
+}
+
+// RatingBetween filters rows with Rating in range [from, to]
+// nolint: dupl
+func (qs UserQuerySet) RatingBetween(from int, to int) UserQuerySet {
+	return qs.w(qs.db.Where("rating BETWEEN ? AND ?", from, to))
 }
 
 // RatingEq is an autogenerated method
@@ -355,6 +867,18 @@ func (qs UserQuerySet) RatingIn(rating ...int) UserQuerySet {
 	return qs.w(qs.db.Where("rating IN (?)", rating))
 }
 
+// RatingInQuery filters rows with Rating IN values selected by subquery q
+// nolint: dupl
+func (qs UserQuerySet) RatingInQuery(q IntSubquery) UserQuerySet {
+	return qs.w(qs.db.Where("rating IN (?)", q.expr()))
+}
+
+// RatingInRange filters rows with Rating in range [from, to)
+// nolint: dupl
+func (qs UserQuerySet) RatingInRange(from int, to int) UserQuerySet {
+	return qs.w(qs.db.Where("rating >= ? AND rating < ?", from, to))
+}
+
 // RatingLt is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) RatingLt(rating int) UserQuerySet {
@@ -367,6 +891,12 @@ func (qs UserQuerySet) RatingLte(rating int) UserQuerySet {
 	return qs.w(qs.db.Where("rating <= ?", rating))
 }
 
+// RatingMarksBetween filters rows with RatingMarks in range [from, to]
+// nolint: dupl
+func (qs UserQuerySet) RatingMarksBetween(from int, to int) UserQuerySet {
+	return qs.w(qs.db.Where("rating_marks BETWEEN ? AND ?", from, to))
+}
+
 // RatingMarksEq is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) RatingMarksEq(ratingMarks int) UserQuerySet {
@@ -395,6 +925,18 @@ func (qs UserQuerySet) RatingMarksIn(ratingMarks ...int) UserQuerySet {
 	return qs.w(qs.db.Where("rating_marks IN (?)", ratingMarks))
 }
 
+// RatingMarksInQuery filters rows with RatingMarks IN values selected by subquery q
+// nolint: dupl
+func (qs UserQuerySet) RatingMarksInQuery(q IntSubquery) UserQuerySet {
+	return qs.w(qs.db.Where("rating_marks IN (?)", q.expr()))
+}
+
+// RatingMarksInRange filters rows with RatingMarks in range [from, to)
+// nolint: dupl
+func (qs UserQuerySet) RatingMarksInRange(from int, to int) UserQuerySet {
+	return qs.w(qs.db.Where("rating_marks >= ? AND rating_marks < ?", from, to))
+}
+
 // RatingMarksLt is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) RatingMarksLt(ratingMarks int) UserQuerySet {
@@ -423,6 +965,12 @@ func (qs UserQuerySet) RatingMarksNotIn(ratingMarks ...int) UserQuerySet {
 	return qs.w(qs.db.Where("rating_marks NOT IN (?)", ratingMarks))
 }
 
+// RatingMarksNotInQuery filters rows with RatingMarks NOT IN values selected by subquery q
+// nolint: dupl
+func (qs UserQuerySet) RatingMarksNotInQuery(q IntSubquery) UserQuerySet {
+	return qs.w(qs.db.Where("rating_marks NOT IN (?)", q.expr()))
+}
+
 // RatingNe is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) RatingNe(rating int) UserQuerySet {
@@ -439,6 +987,67 @@ func (qs UserQuerySet) RatingNotIn(rating ...int) UserQuerySet {
 	return qs.w(qs.db.Where("rating NOT IN (?)", rating))
 }
 
+// RatingNotInQuery filters rows with Rating NOT IN values selected by subquery q
+// nolint: dupl
+func (qs UserQuerySet) RatingNotInQuery(q IntSubquery) UserQuerySet {
+	return qs.w(qs.db.Where("rating NOT IN (?)", q.expr()))
+}
+
+// SelectID returns subquery selecting ID of rows of queryset
+// for InQuery and NotInQuery filters
+// nolint: dupl
+func (qs UserQuerySet) SelectID() UintSubquery {
+	return UintSubquery{db: qs.db.Select("id")}
+}
+
+// SelectRating returns subquery selecting Rating of rows of queryset
+// for InQuery and NotInQuery filters
+// nolint: dupl
+func (qs UserQuerySet) SelectRating() IntSubquery {
+	return IntSubquery{db: qs.db.Select("rating")}
+}
+
+// SelectRatingMarks returns subquery selecting RatingMarks of rows of queryset
+// for InQuery and NotInQuery filters
+// nolint: dupl
+func (qs UserQuerySet) SelectRatingMarks() IntSubquery {
+	return IntSubquery{db: qs.db.Select("rating_marks")}
+}
+
+// SumRating returns SUM of Rating, it's zero value if there are no rows
+// nolint: dupl
+func (qs UserQuerySet) SumRating() (int, error) {
+	var ret int
+	var res struct {
+		Value *int
+	}
+	err := checkQueryContext(qs.db).Select("SUM(rating) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// SumRatingMarks returns SUM of RatingMarks, it's zero value if there are no rows
+// nolint: dupl
+func (qs UserQuerySet) SumRatingMarks() (int, error) {
+	var ret int
+	var res struct {
+		Value *int
+	}
+	err := checkQueryContext(qs.db).Select("SUM(rating_marks) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// UpdatedAtBetween filters rows with UpdatedAt in range [from, to]
+// nolint: dupl
+func (qs UserQuerySet) UpdatedAtBetween(from time.Time, to time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("updated_at BETWEEN ? AND ?", from, to))
+}
+
 // UpdatedAtEq is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) UpdatedAtEq(updatedAt time.Time) UserQuerySet {
@@ -457,6 +1066,12 @@ func (qs UserQuerySet) UpdatedAtGte(updatedAt time.Time) UserQuerySet {
 	return qs.w(qs.db.Where("updated_at >= ?", updatedAt))
 }
 
+// UpdatedAtInRange filters rows with UpdatedAt in range [from, to)
+// nolint: dupl
+func (qs UserQuerySet) UpdatedAtInRange(from time.Time, to time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("updated_at >= ? AND updated_at < ?", from, to))
+}
+
 // UpdatedAtLt is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) UpdatedAtLt(updatedAt time.Time) UserQuerySet {
@@ -475,16 +1090,58 @@ func (qs UserQuerySet) UpdatedAtNe(updatedAt time.Time) UserQuerySet {
 	return qs.w(qs.db.Where("updated_at != ?", updatedAt))
 }
 
-// SetCreatedAt is an autogenerated method
+// UpdatedAtWithin filters rows with UpdatedAt not earlier than d ago
 // nolint: dupl
-func (u UserUpdater) SetCreatedAt(createdAt time.Time) UserUpdater {
-	u.fields[string(UserDBSchema.CreatedAt)] = createdAt
-	return u
+func (qs UserQuerySet) UpdatedAtWithin(d time.Duration) UserQuerySet {
+	return qs.w(qs.db.Where("updated_at >= ?", time.Now().Add(-d)))
 }
 
-// SetDeletedAt is an autogenerated method
+// WithContext returns copy executing queries with context ctx.
+// Gorm v1 doesn't support context: its error is checked only before query.
 // nolint: dupl
-func (u UserUpdater) SetDeletedAt(deletedAt *time.Time) UserUpdater {
+func (qs UserQuerySet) WithContext(ctx context.Context) UserQuerySet {
+	return qs.w(DBWithContext(ctx, qs.db))
+}
+
+// fieldPtrs returns pointers to fields of o by their columns
+// nolint: dupl
+func (qs UserQuerySet) fieldPtrs(o *User) map[string]interface{} {
+	return map[string]interface{}{
+		"id":           &o.ID,
+		"created_at":   &o.CreatedAt,
+		"updated_at":   &o.UpdatedAt,
+		"deleted_at":   &o.DeletedAt,
+		"rating":       &o.Rating,
+		"rating_marks": &o.RatingMarks,
+	}
+}
+
+// InTx returns copy bound to transaction tx: queries are executed in it.
+// Gorm v1 can't move conditions into another connection, so with gorm v1
+// InTx must be called before any condition is set, otherwise queries fail.
+// Context and preloads are moved into transaction by both gorm versions.
+// nolint: dupl
+func (u UserUpdater) InTx(tx *gorm.DB) UserUpdater {
+	if !reflect.DeepEqual(u.db.QueryExpr(), u.db.New().Model(&User{}).QueryExpr()) {
+		tx = tx.New()
+		tx.AddError(errors.New("InTx must be called before any condition is set: " +
+			"gorm v1 can't move conditions into transaction"))
+	}
+	tx = txWithSettings(u.db, tx)
+	u.db = tx.Model(&User{})
+	return u
+}
+
+// SetCreatedAt is an autogenerated method
+// nolint: dupl
+func (u UserUpdater) SetCreatedAt(createdAt time.Time) UserUpdater {
+	u.fields[string(UserDBSchema.CreatedAt)] = createdAt
+	return u
+}
+
+// SetDeletedAt is an autogenerated method
+// nolint: dupl
+func (u UserUpdater) SetDeletedAt(deletedAt *time.Time) UserUpdater {
 	u.fields[string(UserDBSchema.DeletedAt)] = deletedAt
 	return u
 }
@@ -520,16 +1177,24 @@ func (u UserUpdater) SetUpdatedAt(updatedAt time.Time) UserUpdater {
 // Update is an autogenerated method
 // nolint: dupl
 func (u UserUpdater) Update() error {
-	return u.db.Updates(u.fields).Error
+	return checkQueryContext(u.db).Updates(u.fields).Error
 }
 
 // UpdateNum is an autogenerated method
 // nolint: dupl
 func (u UserUpdater) UpdateNum() (int64, error) {
-	db := u.db.Updates(u.fields)
+	db := checkQueryContext(u.db).Updates(u.fields)
 	return db.RowsAffected, db.Error
 }
 
+// WithContext returns copy executing queries with context ctx.
+// Gorm v1 doesn't support context: its error is checked only before query.
+// nolint: dupl
+func (u UserUpdater) WithContext(ctx context.Context) UserUpdater {
+	u.db = DBWithContext(ctx, u.db)
+	return u
+}
+
 // ===== END of query set UserQuerySet
 
 // ===== BEGIN of User modifiers
@@ -577,7 +1242,7 @@ func (o *User) Update(db *gorm.DB, fields ...UserDBSchemaField) error {
 		fs := f.String()
 		u[fs] = dbNameToFieldName[fs]
 	}
-	if err := db.Model(o).Updates(u).Error; err != nil {
+	if err := checkQueryContext(db).Model(o).Updates(u).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return err
 		}
@@ -589,6 +1254,29 @@ func (o *User) Update(db *gorm.DB, fields ...UserDBSchemaField) error {
 	return nil
 }
 
+// Upsert inserts User or updates updateFields of existing row
+// if insert violates unique constraint on conflictFields. Row isn't
+// changed if there are no updateFields: any conflict is ignored then if
+// there are no conflictFields. MySQL ignores conflictFields: it checks
+// all unique constraints, other databases require them to update row.
+// nolint: dupl
+func (o *User) Upsert(db *gorm.DB, conflictFields []UserDBSchemaField,
+	updateFields ...UserDBSchemaField) error {
+
+	conflictColumns := make([]string, 0, len(conflictFields))
+	for _, f := range conflictFields {
+		conflictColumns = append(conflictColumns, f.String())
+	}
+	updateColumns := make([]string, 0, len(updateFields))
+	for _, f := range updateFields {
+		updateColumns = append(updateColumns, f.String())
+	}
+
+	qs := NewUserQuerySet(db)
+	return upsert(checkQueryContext(qs.db), "id", qs.fieldPtrs(o),
+		conflictColumns, updateColumns)
+}
+
 // UserUpdater is an User updates manager
 type UserUpdater struct {
 	fields map[string]interface{}
@@ -606,4 +1294,980 @@ func NewUserUpdater(db *gorm.DB) UserUpdater {
 
 // ===== END of User modifiers
 
+// ===== BEGIN of User predicates
+
+// UserPredicate is a condition on User fields for Where and Not
+// methods of UserQuerySet: predicates are created by UserQ and
+// combined by And, Or and Not. Zero predicate matches all rows.
+type UserPredicate struct {
+	expr string
+	args []interface{}
+	err  error
+}
+
+// join combines predicates by operation op, empty predicates are skipped
+// nolint: dupl
+func (p UserPredicate) join(op string, preds []UserPredicate) UserPredicate {
+	for _, q := range preds {
+		if p.err == nil {
+			p.err = q.err
+		}
+		if q.expr == "" {
+			continue
+		}
+		if p.expr == "" {
+			p.expr, p.args = q.expr, q.args
+			continue
+		}
+
+		p.expr = "(" + p.expr + ") " + op + " (" + q.expr + ")"
+		p.args = append(append([]interface{}{}, p.args...), q.args...)
+	}
+
+	return p
+}
+
+// And returns predicate matching rows matched by p and all preds
+// nolint: dupl
+func (p UserPredicate) And(preds ...UserPredicate) UserPredicate {
+	return p.join("AND", preds)
+}
+
+// Or returns predicate matching rows matched by p or any of preds
+// nolint: dupl
+func (p UserPredicate) Or(preds ...UserPredicate) UserPredicate {
+	return p.join("OR", preds)
+}
+
+// Not returns predicate matching rows not matched by p
+// nolint: dupl
+func (p UserPredicate) Not() UserPredicate {
+	if p.expr != "" {
+		p.expr = "NOT (" + p.expr + ")"
+	}
+
+	return p
+}
+
+// UserPredicates creates predicates by User fields
+type UserPredicates struct{}
+
+// UserQ creates predicates by User fields
+var UserQ UserPredicates
+
+// CreatedAtEq creates predicate "created_at = ?"
+// nolint: dupl
+func (UserPredicates) CreatedAtEq(createdAt time.Time) UserPredicate {
+	return UserPredicate{expr: "created_at = ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtGt creates predicate "created_at > ?"
+// nolint: dupl
+func (UserPredicates) CreatedAtGt(createdAt time.Time) UserPredicate {
+	return UserPredicate{expr: "created_at > ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtGte creates predicate "created_at >= ?"
+// nolint: dupl
+func (UserPredicates) CreatedAtGte(createdAt time.Time) UserPredicate {
+	return UserPredicate{expr: "created_at >= ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtLt creates predicate "created_at < ?"
+// nolint: dupl
+func (UserPredicates) CreatedAtLt(createdAt time.Time) UserPredicate {
+	return UserPredicate{expr: "created_at < ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtLte creates predicate "created_at <= ?"
+// nolint: dupl
+func (UserPredicates) CreatedAtLte(createdAt time.Time) UserPredicate {
+	return UserPredicate{expr: "created_at <= ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtNe creates predicate "created_at != ?"
+// nolint: dupl
+func (UserPredicates) CreatedAtNe(createdAt time.Time) UserPredicate {
+	return UserPredicate{expr: "created_at != ?", args: []interface{}{createdAt}}
+}
+
+// DeletedAtEq creates predicate "deleted_at = ?"
+// nolint: dupl
+func (UserPredicates) DeletedAtEq(deletedAt time.Time) UserPredicate {
+	return UserPredicate{expr: "deleted_at = ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtGt creates predicate "deleted_at > ?"
+// nolint: dupl
+func (UserPredicates) DeletedAtGt(deletedAt time.Time) UserPredicate {
+	return UserPredicate{expr: "deleted_at > ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtGte creates predicate "deleted_at >= ?"
+// nolint: dupl
+func (UserPredicates) DeletedAtGte(deletedAt time.Time) UserPredicate {
+	return UserPredicate{expr: "deleted_at >= ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtIsNotNull creates predicate "deleted_at IS NOT NULL"
+// nolint: dupl
+func (UserPredicates) DeletedAtIsNotNull() UserPredicate {
+	return UserPredicate{expr: "deleted_at IS NOT NULL"}
+}
+
+// DeletedAtIsNull creates predicate "deleted_at IS NULL"
+// nolint: dupl
+func (UserPredicates) DeletedAtIsNull() UserPredicate {
+	return UserPredicate{expr: "deleted_at IS NULL"}
+}
+
+// DeletedAtLt creates predicate "deleted_at < ?"
+// nolint: dupl
+func (UserPredicates) DeletedAtLt(deletedAt time.Time) UserPredicate {
+	return UserPredicate{expr: "deleted_at < ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtLte creates predicate "deleted_at <= ?"
+// nolint: dupl
+func (UserPredicates) DeletedAtLte(deletedAt time.Time) UserPredicate {
+	return UserPredicate{expr: "deleted_at <= ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtNe creates predicate "deleted_at != ?"
+// nolint: dupl
+func (UserPredicates) DeletedAtNe(deletedAt time.Time) UserPredicate {
+	return UserPredicate{expr: "deleted_at != ?", args: []interface{}{deletedAt}}
+}
+
+// IDEq creates predicate "id = ?"
+// nolint: dupl
+func (UserPredicates) IDEq(ID uint) UserPredicate {
+	return UserPredicate{expr: "id = ?", args: []interface{}{ID}}
+}
+
+// IDGt creates predicate "id > ?"
+// nolint: dupl
+func (UserPredicates) IDGt(ID uint) UserPredicate {
+	return UserPredicate{expr: "id > ?", args: []interface{}{ID}}
+}
+
+// IDGte creates predicate "id >= ?"
+// nolint: dupl
+func (UserPredicates) IDGte(ID uint) UserPredicate {
+	return UserPredicate{expr: "id >= ?", args: []interface{}{ID}}
+}
+
+// IDIn creates predicate "id IN (?)"
+// nolint: dupl
+func (UserPredicates) IDIn(ID ...uint) UserPredicate {
+	if len(ID) == 0 {
+		return UserPredicate{err: errors.New("must at least pass one ID in IDIn")}
+	}
+	return UserPredicate{expr: "id IN (?)", args: []interface{}{ID}}
+}
+
+// IDLt creates predicate "id < ?"
+// nolint: dupl
+func (UserPredicates) IDLt(ID uint) UserPredicate {
+	return UserPredicate{expr: "id < ?", args: []interface{}{ID}}
+}
+
+// IDLte creates predicate "id <= ?"
+// nolint: dupl
+func (UserPredicates) IDLte(ID uint) UserPredicate {
+	return UserPredicate{expr: "id <= ?", args: []interface{}{ID}}
+}
+
+// IDNe creates predicate "id != ?"
+// nolint: dupl
+func (UserPredicates) IDNe(ID uint) UserPredicate {
+	return UserPredicate{expr: "id != ?", args: []interface{}{ID}}
+}
+
+// IDNotIn creates predicate "id NOT IN (?)"
+// nolint: dupl
+func (UserPredicates) IDNotIn(ID ...uint) UserPredicate {
+	if len(ID) == 0 {
+		return UserPredicate{err: errors.New("must at least pass one ID in IDNotIn")}
+	}
+	return UserPredicate{expr: "id NOT IN (?)", args: []interface{}{ID}}
+}
+
+// RatingEq creates predicate "rating = ?"
+// nolint: dupl
+func (UserPredicates) RatingEq(rating int) UserPredicate {
+	return UserPredicate{expr: "rating = ?", args: []interface{}{rating}}
+}
+
+// RatingGt creates predicate "rating > ?"
+// nolint: dupl
+func (UserPredicates) RatingGt(rating int) UserPredicate {
+	return UserPredicate{expr: "rating > ?", args: []interface{}{rating}}
+}
+
+// RatingGte creates predicate "rating >= ?"
+// nolint: dupl
+func (UserPredicates) RatingGte(rating int) UserPredicate {
+	return UserPredicate{expr: "rating >= ?", args: []interface{}{rating}}
+}
+
+// RatingIn creates predicate "rating IN (?)"
+// nolint: dupl
+func (UserPredicates) RatingIn(rating ...int) UserPredicate {
+	if len(rating) == 0 {
+		return UserPredicate{err: errors.New("must at least pass one rating in RatingIn")}
+	}
+	return UserPredicate{expr: "rating IN (?)", args: []interface{}{rating}}
+}
+
+// RatingLt creates predicate "rating < ?"
+// nolint: dupl
+func (UserPredicates) RatingLt(rating int) UserPredicate {
+	return UserPredicate{expr: "rating < ?", args: []interface{}{rating}}
+}
+
+// RatingLte creates predicate "rating <= ?"
+// nolint: dupl
+func (UserPredicates) RatingLte(rating int) UserPredicate {
+	return UserPredicate{expr: "rating <= ?", args: []interface{}{rating}}
+}
+
+// RatingMarksEq creates predicate "rating_marks = ?"
+// nolint: dupl
+func (UserPredicates) RatingMarksEq(ratingMarks int) UserPredicate {
+	return UserPredicate{expr: "rating_marks = ?", args: []interface{}{ratingMarks}}
+}
+
+// RatingMarksGt creates predicate "rating_marks > ?"
+// nolint: dupl
+func (UserPredicates) RatingMarksGt(ratingMarks int) UserPredicate {
+	return UserPredicate{expr: "rating_marks > ?", args: []interface{}{ratingMarks}}
+}
+
+// RatingMarksGte creates predicate "rating_marks >= ?"
+// nolint: dupl
+func (UserPredicates) RatingMarksGte(ratingMarks int) UserPredicate {
+	return UserPredicate{expr: "rating_marks >= ?", args: []interface{}{ratingMarks}}
+}
+
+// RatingMarksIn creates predicate "rating_marks IN (?)"
+// nolint: dupl
+func (UserPredicates) RatingMarksIn(ratingMarks ...int) UserPredicate {
+	if len(ratingMarks) == 0 {
+		return UserPredicate{err: errors.New("must at least pass one ratingMarks in RatingMarksIn")}
+	}
+	return UserPredicate{expr: "rating_marks IN (?)", args: []interface{}{ratingMarks}}
+}
+
+// RatingMarksLt creates predicate "rating_marks < ?"
+// nolint: dupl
+func (UserPredicates) RatingMarksLt(ratingMarks int) UserPredicate {
+	return UserPredicate{expr: "rating_marks < ?", args: []interface{}{ratingMarks}}
+}
+
+// RatingMarksLte creates predicate "rating_marks <= ?"
+// nolint: dupl
+func (UserPredicates) RatingMarksLte(ratingMarks int) UserPredicate {
+	return UserPredicate{expr: "rating_marks <= ?", args: []interface{}{ratingMarks}}
+}
+
+// RatingMarksNe creates predicate "rating_marks != ?"
+// nolint: dupl
+func (UserPredicates) RatingMarksNe(ratingMarks int) UserPredicate {
+	return UserPredicate{expr: "rating_marks != ?", args: []interface{}{ratingMarks}}
+}
+
+// RatingMarksNotIn creates predicate "rating_marks NOT IN (?)"
+// nolint: dupl
+func (UserPredicates) RatingMarksNotIn(ratingMarks ...int) UserPredicate {
+	if len(ratingMarks) == 0 {
+		return UserPredicate{err: errors.New("must at least pass one ratingMarks in RatingMarksNotIn")}
+	}
+	return UserPredicate{expr: "rating_marks NOT IN (?)", args: []interface{}{ratingMarks}}
+}
+
+// RatingNe creates predicate "rating != ?"
+// nolint: dupl
+func (UserPredicates) RatingNe(rating int) UserPredicate {
+	return UserPredicate{expr: "rating != ?", args: []interface{}{rating}}
+}
+
+// RatingNotIn creates predicate "rating NOT IN (?)"
+// nolint: dupl
+func (UserPredicates) RatingNotIn(rating ...int) UserPredicate {
+	if len(rating) == 0 {
+		return UserPredicate{err: errors.New("must at least pass one rating in RatingNotIn")}
+	}
+	return UserPredicate{expr: "rating NOT IN (?)", args: []interface{}{rating}}
+}
+
+// UpdatedAtEq creates predicate "updated_at = ?"
+// nolint: dupl
+func (UserPredicates) UpdatedAtEq(updatedAt time.Time) UserPredicate {
+	return UserPredicate{expr: "updated_at = ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtGt creates predicate "updated_at > ?"
+// nolint: dupl
+func (UserPredicates) UpdatedAtGt(updatedAt time.Time) UserPredicate {
+	return UserPredicate{expr: "updated_at > ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtGte creates predicate "updated_at >= ?"
+// nolint: dupl
+func (UserPredicates) UpdatedAtGte(updatedAt time.Time) UserPredicate {
+	return UserPredicate{expr: "updated_at >= ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtLt creates predicate "updated_at < ?"
+// nolint: dupl
+func (UserPredicates) UpdatedAtLt(updatedAt time.Time) UserPredicate {
+	return UserPredicate{expr: "updated_at < ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtLte creates predicate "updated_at <= ?"
+// nolint: dupl
+func (UserPredicates) UpdatedAtLte(updatedAt time.Time) UserPredicate {
+	return UserPredicate{expr: "updated_at <= ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtNe creates predicate "updated_at != ?"
+// nolint: dupl
+func (UserPredicates) UpdatedAtNe(updatedAt time.Time) UserPredicate {
+	return UserPredicate{expr: "updated_at != ?", args: []interface{}{updatedAt}}
+}
+
+// ===== END of User predicates
+
+// IntSubquery is a subquery selecting single column, it's created by Select
+// methods of querysets and passed to their InQuery and NotInQuery filters
+type IntSubquery struct {
+	db *gorm.DB
+}
+
+func (q IntSubquery) expr() interface{} {
+	return q.db.QueryExpr()
+}
+
+// UintSubquery is a subquery selecting single column, it's created by Select
+// methods of querysets and passed to their InQuery and NotInQuery filters
+type UintSubquery struct {
+	db *gorm.DB
+}
+
+func (q UintSubquery) expr() interface{} {
+	return q.db.QueryExpr()
+}
+
 // ===== END of all query sets
+
+// ===== BEGIN of package helpers
+
+// WithTx runs fn in transaction: it's committed if fn returns nil and
+// rolled back if fn returns error or panics. If db is already a transaction,
+// savepoint is used instead of a new transaction: nested calls are
+// rolled back independently.
+func WithTx(db *gorm.DB, fn func(tx *gorm.DB) error) error {
+	if _, ok := db.CommonDB().(interface{ Rollback() error }); ok {
+		return withTxSavepoint(db, fn)
+	}
+
+	tx := db.Begin()
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	finished := false
+	defer func() {
+		if !finished {
+			tx.Rollback()
+		}
+	}()
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+
+	finished = true
+	return tx.Commit().Error
+}
+
+func withTxSavepoint(db *gorm.DB, fn func(tx *gorm.DB) error) error {
+	const depthKey = "goqueryset:savepoint_depth"
+	depth, _ := db.Get(depthKey)
+	n, _ := depth.(int)
+	savepoint := fmt.Sprintf("goqueryset_sp%d", n+1)
+
+	if err := db.Exec("SAVEPOINT " + savepoint).Error; err != nil {
+		return err
+	}
+
+	released := false
+	defer func() {
+		if !released {
+			db.Exec("ROLLBACK TO SAVEPOINT " + savepoint)
+		}
+	}()
+
+	if err := fn(db.Set(depthKey, n+1)); err != nil {
+		return err
+	}
+
+	released = true
+	return db.Exec("RELEASE SAVEPOINT " + savepoint).Error
+}
+
+// DBWithContext returns db executing queries with context ctx: it's needed
+// to pass context to struct modifiers (Create, Delete, Update).
+// Gorm v1 doesn't support context, so its error is checked only before query.
+func DBWithContext(ctx context.Context, db *gorm.DB) *gorm.DB {
+	return db.Set(queryContextKey, ctx)
+}
+
+const queryContextKey = "goqueryset:context"
+
+// checkQueryContext returns copy of db with context error if context
+// set by DBWithContext is done: gorm doesn't execute queries then.
+func checkQueryContext(db *gorm.DB) *gorm.DB {
+	v, ok := db.Get(queryContextKey)
+	if !ok {
+		return db
+	}
+
+	ctx, ok := v.(context.Context)
+	if !ok || ctx.Err() == nil {
+		return db
+	}
+
+	db = db.Set(queryContextKey, ctx) // copy db to not change passed one
+	db.AddError(ctx.Err())
+	return db
+}
+
+const preloadsKey = "goqueryset:preloads"
+
+// preloadSpec is arguments of gorm Preload call
+type preloadSpec struct {
+	column     string
+	conditions []interface{}
+}
+
+// preload returns db preloading column and remembers preload: gorm v1
+// doesn't expose preloads, but InTx must move them into transaction
+func preload(db *gorm.DB, column string, conditions ...interface{}) *gorm.DB {
+	v, _ := db.Get(preloadsKey)
+	preloads, _ := v.([]preloadSpec)
+	preloads = append(preloads[:len(preloads):len(preloads)], preloadSpec{column: column, conditions: conditions})
+	return db.Preload(column, conditions...).Set(preloadsKey, preloads)
+}
+
+// txWithSettings returns tx with context and preloads of db: they
+// aren't in query expression of db, so InTx moves them explicitly
+func txWithSettings(db, tx *gorm.DB) *gorm.DB {
+	if ctx, ok := db.Get(queryContextKey); ok {
+		tx = tx.Set(queryContextKey, ctx)
+	}
+
+	v, _ := db.Get(preloadsKey)
+	preloads, _ := v.([]preloadSpec)
+	for _, p := range preloads {
+		tx = preload(tx, p.column, p.conditions...)
+	}
+	return tx
+}
+
+const pageOrdersKey = "goqueryset:page_orders"
+
+// pageOrder is an order of queryset remembered for keyset pagination
+type pageOrder struct {
+	column   string
+	desc     bool
+	nullable bool
+}
+
+// addPageOrder remembers order by column in db
+func addPageOrder(db *gorm.DB, column string, desc, nullable bool) *gorm.DB {
+	v, _ := db.Get(pageOrdersKey)
+	orders, _ := v.([]pageOrder)
+	orders = append(orders[:len(orders):len(orders)], pageOrder{column: column, desc: desc, nullable: nullable})
+	return db.Set(pageOrdersKey, orders)
+}
+
+// pageQuery returns db filtered by cursor and ordered by primary key after
+// orders of db, fieldPtrs are pointers to fields of model by their columns.
+// Columns are prefixed by table in SQL, table is empty if they aren't qualified.
+func pageQuery(db *gorm.DB, table, pk, cursor string,
+	fieldPtrs map[string]interface{}) (*gorm.DB, []pageOrder, error) {
+
+	v, _ := db.Get(pageOrdersKey)
+	orders, _ := v.([]pageOrder)
+	hasPKOrder := false
+	for _, o := range orders {
+		if _, ok := fieldPtrs[o.column]; !ok {
+			return nil, nil, fmt.Errorf("can't paginate by column %s: it's not a field of model", o.column)
+		}
+		if o.nullable {
+			// NULL isn't greater or less than cursor value: such rows would be lost
+			return nil, nil, fmt.Errorf("can't paginate by column %s: it's nullable", o.column)
+		}
+		hasPKOrder = hasPKOrder || o.column == pk
+	}
+	if !hasPKOrder {
+		orders = append(orders[:len(orders):len(orders)], pageOrder{column: pk})
+		db = db.Order(table + pk + " ASC")
+	}
+
+	if cursor == "" {
+		return db, orders, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid cursor: %s", err)
+	}
+	var values []json.RawMessage
+	if err = json.Unmarshal(data, &values); err != nil {
+		return nil, nil, fmt.Errorf("invalid cursor: %s", err)
+	}
+	if len(values) != len(orders) {
+		return nil, nil, errors.New("invalid cursor: it was made for other orders")
+	}
+
+	// (a > ?) OR (a = ? AND b < ?) OR ...
+	var conds []string
+	var args []interface{}
+	var eqConds []string
+	var eqArgs []interface{}
+	for i, o := range orders {
+		ptr := fieldPtrs[o.column]
+		if err = json.Unmarshal(values[i], ptr); err != nil {
+			return nil, nil, fmt.Errorf("invalid cursor value of %s: %s", o.column, err)
+		}
+		value := reflect.ValueOf(ptr).Elem().Interface()
+
+		op := ">"
+		if o.desc {
+			op = "<"
+		}
+		conds = append(conds, "("+strings.Join(append(eqConds, table+o.column+" "+op+" ?"), " AND ")+")")
+		args = append(append(args, eqArgs...), value)
+
+		eqConds = append(eqConds, table+o.column+" = ?")
+		eqArgs = append(eqArgs, value)
+	}
+
+	return db.Where(strings.Join(conds, " OR "), args...), orders, nil
+}
+
+// encodePageCursor encodes values of ordered fields of the last fetched row
+func encodePageCursor(orders []pageOrder, fieldPtrs map[string]interface{}) (string, error) {
+	var values []interface{}
+	for _, o := range orders {
+		values = append(values, fieldPtrs[o.column])
+	}
+
+	data, err := json.Marshal(values)
+	if err != nil {
+		return "", fmt.Errorf("can't encode cursor: %s", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// ValidationError is returned by Validate methods of models and updaters
+type ValidationError struct {
+	Field  string // name of invalid field
+	Reason string
+}
+
+func (e ValidationError) Error() string {
+	return fmt.Sprintf("invalid field %s: %s", e.Field, e.Reason)
+}
+
+// checkConflictFields checks that row updated by upsert can be found: only
+// MySQL finds conflicting row without conflict fields
+func checkConflictFields(db *gorm.DB, conflictColumns, updateColumns []string) error {
+	if len(conflictColumns) == 0 && len(updateColumns) != 0 && dialectName(db) != "mysql" {
+		return errors.New("conflict fields are required to update existing row by upsert")
+	}
+
+	return nil
+}
+
+// dialectName returns name of database dialect of db
+func dialectName(db *gorm.DB) string {
+	return db.Dialect().GetName()
+}
+
+// likePatternEscaper escapes wildcards of LIKE with "!": backslash isn't
+// used because its escaping in string literals differs between databases.
+// "[" is a wildcard in SQL Server.
+var likePatternEscaper = strings.NewReplacer("!", "!!", "%", "!%", "_", "!_", "[", "![")
+
+// whereLike filters column by pattern: escaped value between prefix and
+// suffix. Case-insensitive matching uses ILIKE in PostgreSQL and LOWER elsewhere.
+func whereLike(db *gorm.DB, column, prefix, value, suffix string, caseInsensitive bool) *gorm.DB {
+	pattern := prefix + likePatternEscaper.Replace(value) + suffix
+	switch {
+	case !caseInsensitive:
+		return db.Where(column+" LIKE ? ESCAPE '!'", pattern)
+	case dialectName(db) == "postgres":
+		return db.Where(column+" ILIKE ? ESCAPE '!'", pattern)
+	default:
+		return db.Where("LOWER("+column+") LIKE LOWER(?) ESCAPE '!'", pattern)
+	}
+}
+
+// jsonColumnValue is a map or a slice stored in JSON column: it's marshaled
+// to JSON when passed to database, nil is stored as NULL
+type jsonColumnValue struct {
+	v interface{}
+}
+
+// Value implements driver.Valuer
+func (j jsonColumnValue) Value() (driver.Value, error) {
+	if reflect.ValueOf(j.v).IsNil() {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(j.v)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// whereJSONEq filters JSON column by text value at dot-separated path
+func whereJSONEq(db *gorm.DB, column, path, value string) *gorm.DB {
+	switch dialectName(db) {
+	case "postgres":
+		return db.Where(column+" #>> ? = ?", "{"+strings.Replace(path, ".", ",", -1)+"}", value)
+	case "mysql":
+		return db.Where("JSON_UNQUOTE(JSON_EXTRACT("+column+", ?)) = ?", "$."+path, value)
+	default:
+		return db.Where("CAST(JSON_EXTRACT("+column+", ?) AS TEXT) = ?", "$."+path, value)
+	}
+}
+
+// bulkInsertBatchSize returns max count of rows in one insert query:
+// databases limit count of placeholders in query
+func bulkInsertBatchSize(dialect string, columns int) int {
+	maxParams := 999 // sqlite and unknown dialects
+	switch dialect {
+	case "mysql", "postgres":
+		maxParams = 65535
+	case "mssql", "sqlserver":
+		maxParams = 2100
+	}
+
+	if columns == 0 || columns > maxParams {
+		return 1
+	}
+	return maxParams / columns
+}
+
+// isZeroField checks whether field pointed by ptr has zero value
+func isZeroField(ptr interface{}) bool {
+	v := reflect.ValueOf(ptr).Elem()
+	return reflect.DeepEqual(v.Interface(), reflect.Zero(v.Type()).Interface())
+}
+
+// setGeneratedPK sets field of primary key pointed by ptr to id
+func setGeneratedPK(ptr interface{}, id int64) {
+	v := reflect.ValueOf(ptr).Elem()
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(id)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.SetUint(uint64(id))
+	}
+}
+
+// prepareInsertRows sets timestamps like gorm does on create and returns
+// sorted columns to insert: primary key is skipped if it's generated.
+// Columns of timestamps are taken from parsed model of db.
+func prepareInsertRows(db *gorm.DB, rows []map[string]interface{}, generatedPK string) []string {
+	scope := db.NewScope(db.Value)
+	var timeColumns []string
+	for _, name := range []string{"CreatedAt", "UpdatedAt"} {
+		if f, ok := scope.FieldByName(name); ok {
+			timeColumns = append(timeColumns, f.DBName)
+		}
+	}
+
+	now := gorm.NowFunc()
+	for _, r := range rows {
+		for _, c := range timeColumns {
+			if t, ok := r[c].(*time.Time); ok && t.IsZero() {
+				*t = now
+			}
+		}
+	}
+
+	var columns []string
+	for c := range rows[0] {
+		if c != generatedPK {
+			columns = append(columns, c)
+		}
+	}
+	sort.Strings(columns)
+	return columns
+}
+
+// defaultValueColumns returns columns of db model having default values
+func defaultValueColumns(db *gorm.DB) map[string]bool {
+	ret := map[string]bool{}
+	for _, f := range db.NewScope(db.Value).GetModelStruct().StructFields {
+		if f.HasDefaultValue && !f.IsPrimaryKey {
+			ret[f.DBName] = true
+		}
+	}
+	return ret
+}
+
+// rowInsertColumns returns columns to insert row by: columns having
+// default values are skipped if they are zero like gorm does on create
+func rowInsertColumns(columns []string, row map[string]interface{}, defaults map[string]bool) []string {
+	ret := make([]string, 0, len(columns))
+	for _, c := range columns {
+		if !defaults[c] || !isZeroField(row[c]) {
+			ret = append(ret, c)
+		}
+	}
+	return ret
+}
+
+// buildInsertQuery returns query inserting rows into table of db model
+func buildInsertQuery(db *gorm.DB, columns []string, rows []map[string]interface{}) (string, []interface{}) {
+	dialect := db.Dialect()
+	quotedColumns := make([]string, 0, len(columns))
+	for _, c := range columns {
+		quotedColumns = append(quotedColumns, dialect.Quote(c))
+	}
+
+	var values []string
+	var args []interface{}
+	for _, r := range rows {
+		placeholders := make([]string, 0, len(columns))
+		for _, c := range columns {
+			args = append(args, reflect.ValueOf(r[c]).Elem().Interface())
+			placeholders = append(placeholders, dialect.BindVar(len(args)))
+		}
+		values = append(values, "("+strings.Join(placeholders, ",")+")")
+	}
+
+	query := fmt.Sprintf("INSERT INTO %s (%s) VALUES %s", db.NewScope(db.Value).QuotedTableName(),
+		strings.Join(quotedColumns, ","), strings.Join(values, ","))
+	// gorm's common dialect bind var is replaced by "?" in queries
+	query = strings.Replace(query, "$$$", "?", -1)
+	return query, args
+}
+
+// bulkInsert inserts rows (pointers to fields by columns) into table of db model
+// by batches. Primary key is generated by database if it's not set in all rows.
+func bulkInsert(db *gorm.DB, pk string, rows []map[string]interface{}) error {
+	if db.Error != nil {
+		return db.Error
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+
+	generatedPK := ""
+	if pk != "" {
+		zeroPKs := 0
+		for _, r := range rows {
+			if isZeroField(r[pk]) {
+				zeroPKs++
+			}
+		}
+		if zeroPKs != 0 && zeroPKs != len(rows) {
+			return errors.New("primary key must be set in all rows or in none of them")
+		}
+		if zeroPKs != 0 {
+			generatedPK = pk
+		}
+	}
+
+	columns := prepareInsertRows(db, rows, generatedPK)
+	defaults := defaultValueColumns(db)
+	for start := 0; start < len(rows); {
+		// batch is made of consecutive rows with the same columns:
+		// zero columns having default values are skipped
+		batchColumns := rowInsertColumns(columns, rows[start], defaults)
+		size := bulkInsertBatchSize(db.Dialect().GetName(), len(batchColumns))
+		end := start + 1
+		for end < len(rows) && end-start < size &&
+			reflect.DeepEqual(rowInsertColumns(columns, rows[end], defaults), batchColumns) {
+			end++
+		}
+
+		if err := bulkInsertBatch(db, batchColumns, generatedPK, rows[start:end]); err != nil {
+			return err
+		}
+		start = end
+	}
+
+	return nil
+}
+
+// bulkInsertBatch inserts rows by one query and writes back generated
+// primary keys if database supports it
+func bulkInsertBatch(db *gorm.DB, columns []string, generatedPK string,
+	rows []map[string]interface{}) error {
+
+	dialect := db.Dialect()
+	query, args := buildInsertQuery(db, columns, rows)
+
+	if generatedPK != "" && dialect.GetName() == "postgres" {
+		// rows are returned in order of values
+		res, err := db.CommonDB().Query(query+" RETURNING "+dialect.Quote(generatedPK), args...)
+		if err != nil {
+			return err
+		}
+		defer res.Close()
+
+		for i := 0; res.Next(); i++ {
+			var id int64
+			if err = res.Scan(&id); err != nil {
+				return err
+			}
+			setGeneratedPK(rows[i][generatedPK], id)
+		}
+		return res.Err()
+	}
+
+	res, err := db.CommonDB().Exec(query, args...)
+	if err != nil {
+		return err
+	}
+
+	if generatedPK == "" {
+		return nil
+	}
+
+	var firstID int64
+	switch dialect.GetName() {
+	case "mysql":
+		// ids of rows inserted by one query are consecutive,
+		// the first of them is returned
+		firstID, err = res.LastInsertId()
+	case "sqlite3":
+		// the last id is returned
+		firstID, err = res.LastInsertId()
+		firstID -= int64(len(rows) - 1)
+	default:
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for i, r := range rows {
+		setGeneratedPK(r[generatedPK], firstID+int64(i))
+	}
+	return nil
+}
+
+// upsert inserts row (pointers to fields by columns) into table of db model
+// or updates updateColumns of existing row if insert violates unique
+// constraint on conflictColumns. Generated primary key is written back
+// for MySQL and PostgreSQL.
+func upsert(db *gorm.DB, pk string, row map[string]interface{},
+	conflictColumns, updateColumns []string) error {
+
+	if db.Error != nil {
+		return db.Error
+	}
+	if err := checkConflictFields(db, conflictColumns, updateColumns); err != nil {
+		return err
+	}
+
+	generatedPK := ""
+	if pk != "" && isZeroField(row[pk]) {
+		generatedPK = pk
+	}
+
+	rows := []map[string]interface{}{row}
+	columns := rowInsertColumns(prepareInsertRows(db, rows, generatedPK), row, defaultValueColumns(db))
+	query, args := buildInsertQuery(db, columns, rows)
+
+	dialect := db.Dialect()
+	var sets []string
+	switch dialect.GetName() {
+	case "mysql":
+		// conflict columns are chosen by MySQL itself
+		if generatedPK != "" {
+			// make LAST_INSERT_ID return id of updated row
+			sets = append(sets, fmt.Sprintf("%s = LAST_INSERT_ID(%s)",
+				dialect.Quote(generatedPK), dialect.Quote(generatedPK)))
+		}
+		for _, c := range updateColumns {
+			sets = append(sets, fmt.Sprintf("%s = VALUES(%s)", dialect.Quote(c), dialect.Quote(c)))
+		}
+		if len(sets) == 0 {
+			// do nothing: assign column to itself
+			c := pk
+			if len(conflictColumns) != 0 {
+				c = conflictColumns[0]
+			} else if c == "" {
+				c = columns[0]
+			}
+			sets = append(sets, dialect.Quote(c)+" = "+dialect.Quote(c))
+		}
+		query += " ON DUPLICATE KEY UPDATE " + strings.Join(sets, ", ")
+	case "postgres", "sqlite3":
+		var quotedConflictColumns []string
+		for _, c := range conflictColumns {
+			quotedConflictColumns = append(quotedConflictColumns, dialect.Quote(c))
+		}
+		for _, c := range updateColumns {
+			sets = append(sets, fmt.Sprintf("%s = excluded.%s", dialect.Quote(c), dialect.Quote(c)))
+		}
+
+		query += " ON CONFLICT"
+		if len(quotedConflictColumns) != 0 {
+			query += " (" + strings.Join(quotedConflictColumns, ",") + ")"
+		}
+		if len(sets) == 0 {
+			query += " DO NOTHING"
+		} else {
+			query += " DO UPDATE SET " + strings.Join(sets, ", ")
+		}
+	default:
+		return fmt.Errorf("upsert isn't supported for dialect %s", dialect.GetName())
+	}
+
+	if generatedPK != "" && dialect.GetName() == "postgres" {
+		var id int64
+		err := db.CommonDB().QueryRow(query+" RETURNING "+dialect.Quote(generatedPK), args...).Scan(&id)
+		if err == sql.ErrNoRows {
+			return nil // conflict without update
+		}
+		if err != nil {
+			return err
+		}
+
+		setGeneratedPK(row[generatedPK], id)
+		return nil
+	}
+
+	res, err := db.CommonDB().Exec(query, args...)
+	if err != nil {
+		return err
+	}
+
+	if generatedPK != "" && dialect.GetName() == "mysql" {
+		id, err := res.LastInsertId()
+		if err != nil {
+			return err
+		}
+		if id != 0 {
+			setGeneratedPK(row[generatedPK], id)
+		}
+	}
+
+	return nil
+}
+
+// ===== END of package helpers
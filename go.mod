@@ -1,21 +1,24 @@
 module github.com/jirfag/go-queryset
 
+go 1.22.0
+
 require (
-	cloud.google.com/go v0.37.0 // indirect
-	github.com/denisenkom/go-mssqldb v0.0.0-20190315220205-a8ed825ac853 // indirect
-	github.com/erikstmartin/go-testdb v0.0.0-20160219214506-8d10e4a1bae5 // indirect
-	github.com/go-sql-driver/mysql v0.0.0-20170822214809-26471af196a1 // indirect
-	github.com/gofrs/uuid v3.2.0+incompatible // indirect
 	github.com/jinzhu/gorm v1.9.2
-	github.com/jinzhu/inflection v0.0.0-20170102125226-1c35d901db3d // indirect
-	github.com/jinzhu/now v1.0.0 // indirect
-	github.com/lib/pq v1.0.0 // indirect
-	github.com/mattn/go-sqlite3 v1.10.0 // indirect
 	github.com/pkg/errors v0.8.1
 	github.com/stretchr/testify v1.3.0
-	golang.org/x/crypto v0.0.0-20190308221718-c2843e01d9a2 // indirect
-	golang.org/x/tools v0.0.0-20190226205152-f727befe758c
+	golang.org/x/tools v0.30.0
 	gopkg.in/DATA-DOG/go-sqlmock.v1 v1.2.0
+	gopkg.in/yaml.v2 v2.4.0
+	gorm.io/gorm v1.25.12
 )
 
-go 1.13
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/go-sql-driver/mysql v0.0.0-20170822214809-26471af196a1 // indirect
+	github.com/jinzhu/inflection v1.0.0 // indirect
+	github.com/jinzhu/now v1.1.5 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	golang.org/x/mod v0.23.0 // indirect
+	golang.org/x/sync v0.11.0 // indirect
+	golang.org/x/text v0.14.0 // indirect
+)
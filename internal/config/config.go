@@ -0,0 +1,267 @@
+// Package config loads goqueryset.yaml: configuration of querysets
+// generation for multiple packages of repository
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/jirfag/go-queryset/internal/queryset/generator"
+	"github.com/jirfag/go-queryset/internal/queryset/methods"
+	"github.com/pkg/errors"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// FileName is a default name of config file
+const FileName = "goqueryset.yaml"
+
+// DefaultOutput is a default pattern of output file path
+const DefaultOutput = dirPlaceholder + "/autogenerated_querysets.go"
+
+// dirPlaceholder is replaced by package directory in output pattern
+const dirPlaceholder = "{dir}"
+
+// recursiveSuffix in package path means the directory and all its subdirectories
+const recursiveSuffix = "/..."
+
+type fileConfig struct {
+	Backend string `yaml:"backend"`
+	Output  string `yaml:"output"`
+	Naming  struct {
+		QuerySet string `yaml:"queryset"`
+		Updater  string `yaml:"updater"`
+	} `yaml:"naming"`
+	Methods struct {
+		Include []string `yaml:"include"`
+		Exclude []string `yaml:"exclude"`
+	} `yaml:"methods"`
+	Packages []struct {
+		Path    string   `yaml:"path"`
+		Structs []string `yaml:"structs"`
+	} `yaml:"packages"`
+}
+
+// Package is a package to generate querysets for
+type Package struct {
+	// Path is a path to package directory, it can end with "/..." to
+	// include all subdirectories having annotated structs
+	Path string
+
+	// Structs are names of structs to generate querysets for, empty means
+	// all structs annotated by "gen:qs"
+	Structs []string
+}
+
+// Config is a parsed config file
+type Config struct {
+	// Dir is a directory of config file: paths are relative to it
+	Dir string
+
+	Backend     methods.Backend
+	Output      string
+	Naming      generator.Naming
+	SkipMethods map[generator.MethodFamily]bool
+	Packages    []Package
+}
+
+// Target is a package to generate querysets for and output file for them
+type Target struct {
+	Dir     string
+	Out     string
+	Structs []string
+
+	// Recursive is true if package was found by recursive path: it can
+	// have "gen:qs" text without annotated structs
+	Recursive bool
+}
+
+// Load loads and validates config file
+func Load(path string) (*Config, error) {
+	data, err := ioutil.ReadFile(path) // nolint: gosec
+	if err != nil {
+		return nil, errors.Wrap(err, "can't read config")
+	}
+
+	cfg, err := parse(data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid config %s", path)
+	}
+
+	cfg.Dir = filepath.Dir(path)
+	return cfg, nil
+}
+
+func parseSkipMethods(include, exclude []string) (map[generator.MethodFamily]bool, error) {
+	if len(include) != 0 && len(exclude) != 0 {
+		return nil, fmt.Errorf("methods include and exclude can't be used together")
+	}
+
+	skip := map[generator.MethodFamily]bool{}
+	if len(include) != 0 {
+		for _, f := range generator.MethodFamilies {
+			skip[f] = true
+		}
+	}
+
+	for _, name := range append(include, exclude...) {
+		f, err := generator.ParseMethodFamily(name)
+		if err != nil {
+			return nil, err
+		}
+		skip[f] = len(exclude) != 0
+	}
+
+	return skip, nil
+}
+
+func parse(data []byte) (*Config, error) {
+	var fc fileConfig
+	if err := yaml.UnmarshalStrict(data, &fc); err != nil {
+		return nil, err
+	}
+
+	cfg := Config{
+		Output: fc.Output,
+		Naming: generator.Naming{
+			QuerySet: fc.Naming.QuerySet,
+			Updater:  fc.Naming.Updater,
+		},
+	}
+
+	if fc.Backend != "" {
+		backend, err := methods.ParseBackend(fc.Backend)
+		if err != nil {
+			return nil, err
+		}
+		cfg.Backend = backend
+	}
+
+	if cfg.Output == "" {
+		cfg.Output = DefaultOutput
+	}
+	if !strings.Contains(cfg.Output, dirPlaceholder) {
+		return nil, fmt.Errorf("output %q must contain %s: querysets must be "+
+			"generated into package directory", cfg.Output, dirPlaceholder)
+	}
+
+	if err := cfg.Naming.Validate(); err != nil {
+		return nil, err
+	}
+
+	skip, err := parseSkipMethods(fc.Methods.Include, fc.Methods.Exclude)
+	if err != nil {
+		return nil, err
+	}
+	cfg.SkipMethods = skip
+
+	if len(fc.Packages) == 0 {
+		return nil, fmt.Errorf("no packages")
+	}
+	for _, p := range fc.Packages {
+		if p.Path == "" {
+			return nil, fmt.Errorf("package path is empty")
+		}
+		if strings.HasSuffix(p.Path, recursiveSuffix) && len(p.Structs) != 0 {
+			return nil, fmt.Errorf("structs can't be listed for recursive path %s", p.Path)
+		}
+		cfg.Packages = append(cfg.Packages, Package{
+			Path:    p.Path,
+			Structs: p.Structs,
+		})
+	}
+
+	return &cfg, nil
+}
+
+func (c Config) target(dir string, structs []string) Target {
+	return Target{
+		Dir:     dir,
+		Out:     filepath.FromSlash(strings.Replace(c.Output, dirPlaceholder, filepath.ToSlash(dir), -1)),
+		Structs: structs,
+	}
+}
+
+// Targets returns packages to generate querysets for, recursive
+// paths are expanded
+func (c Config) Targets() ([]Target, error) {
+	var ret []Target
+	for _, p := range c.Packages {
+		if !strings.HasSuffix(p.Path, recursiveSuffix) {
+			ret = append(ret, c.target(filepath.Join(c.Dir, p.Path), p.Structs))
+			continue
+		}
+
+		dirs, err := findAnnotatedDirs(filepath.Join(c.Dir, strings.TrimSuffix(p.Path, recursiveSuffix)))
+		if err != nil {
+			return nil, errors.Wrapf(err, "can't expand %s", p.Path)
+		}
+		for _, dir := range dirs {
+			t := c.target(dir, nil)
+			t.Recursive = true
+			ret = append(ret, t)
+		}
+	}
+
+	return ret, nil
+}
+
+// findAnnotatedDirs returns root and its subdirectories having Go files
+// with "gen:qs" annotation. Hidden directories, vendor and testdata are
+// skipped like go tool does.
+func findAnnotatedDirs(root string) ([]string, error) {
+	found := map[string]bool{}
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		name := info.Name()
+		if info.IsDir() {
+			if path != root && (strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_") ||
+				name == "vendor" || name == "testdata") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if found[filepath.Dir(path)] || !strings.HasSuffix(name, ".go") ||
+			strings.HasSuffix(name, "_test.go") {
+			return nil
+		}
+
+		annotated, err := hasAnnotation(path)
+		if err != nil {
+			return err
+		}
+		if annotated {
+			found[filepath.Dir(path)] = true
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var ret []string
+	for dir := range found {
+		ret = append(ret, dir)
+	}
+	sort.Strings(ret)
+	return ret, nil
+}
+
+// hasAnnotation checks whether file has "gen:qs" annotation, it's a fast
+// check: false positives are skipped by generator
+func hasAnnotation(path string) (bool, error) {
+	data, err := ioutil.ReadFile(path) // nolint: gosec
+	if err != nil {
+		return false, err
+	}
+
+	return bytes.Contains(data, []byte("gen:qs")), nil
+}
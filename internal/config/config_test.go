@@ -0,0 +1,89 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jirfag/go-queryset/internal/queryset/generator"
+	"github.com/jirfag/go-queryset/internal/queryset/methods"
+	"github.com/stretchr/testify/assert"
+)
+
+func writeFile(t *testing.T, path, content string) {
+	assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0750))
+	assert.NoError(t, ioutil.WriteFile(path, []byte(content), 0640))
+}
+
+func TestLoad(t *testing.T) {
+	dir, err := ioutil.TempDir("", "goqueryset")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	writeFile(t, filepath.Join(dir, FileName), `
+backend: gorm2
+output: "{dir}/querysets.go"
+naming:
+  queryset: "{Struct}Query"
+methods:
+  exclude: [joins, upsert]
+packages:
+  - path: models
+    structs: [User]
+  - path: internal/...
+`)
+	annotated := "package a\n\n// gen:qs\ntype A struct{}\n"
+	writeFile(t, filepath.Join(dir, "internal", "a", "a.go"), annotated)
+	writeFile(t, filepath.Join(dir, "internal", "a", "b", "b.go"), "package b\n")
+	writeFile(t, filepath.Join(dir, "internal", "testdata", "c.go"), annotated)
+	writeFile(t, filepath.Join(dir, "internal", "d", "d_test.go"), annotated)
+
+	cfg, err := Load(filepath.Join(dir, FileName))
+	assert.NoError(t, err)
+	assert.Equal(t, methods.BackendGormV2, cfg.Backend)
+	assert.Equal(t, generator.Naming{QuerySet: "{Struct}Query"}, cfg.Naming)
+	assert.True(t, cfg.SkipMethods[generator.MethodsJoins])
+	assert.False(t, cfg.SkipMethods[generator.MethodsPagination])
+
+	targets, err := cfg.Targets()
+	assert.NoError(t, err)
+	assert.Equal(t, []Target{
+		{
+			Dir:     filepath.Join(dir, "models"),
+			Out:     filepath.Join(dir, "models", "querysets.go"),
+			Structs: []string{"User"},
+		},
+		{
+			Dir:       filepath.Join(dir, "internal", "a"),
+			Out:       filepath.Join(dir, "internal", "a", "querysets.go"),
+			Recursive: true,
+		},
+	}, targets)
+}
+
+func TestParseInvalid(t *testing.T) {
+	cases := []string{
+		"packages: []",
+		"unknown: 1\npackages: [{path: a}]",
+		"backend: gorm3\npackages: [{path: a}]",
+		"output: querysets.go\npackages: [{path: a}]",
+		"naming: {queryset: Query}\npackages: [{path: a}]",
+		"methods: {exclude: [unknown]}\npackages: [{path: a}]",
+		"methods: {include: [joins], exclude: [bulk]}\npackages: [{path: a}]",
+		"packages: [{path: a/..., structs: [A]}]",
+	}
+
+	for _, c := range cases {
+		_, err := parse([]byte(c))
+		assert.Error(t, err, c)
+	}
+}
+
+func TestParseMethodsInclude(t *testing.T) {
+	cfg, err := parse([]byte("methods: {include: [joins]}\npackages: [{path: a}]"))
+	assert.NoError(t, err)
+	assert.False(t, cfg.SkipMethods[generator.MethodsJoins])
+	assert.True(t, cfg.SkipMethods[generator.MethodsBulk])
+	assert.Equal(t, DefaultOutput, cfg.Output)
+}
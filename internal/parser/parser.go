@@ -46,24 +46,75 @@ type Result struct {
 	Structs     map[string]ParsedStruct
 	PackageName string
 	Types       *types.Package
+	Fset        *token.FileSet // positions of Types objects
 }
 
 type Structs struct{}
 
+// ParseFile parses structs declared in the file
 func (p Structs) ParseFile(ctx context.Context, filePath string) (*Result, error) {
-	absFilePath, err := filepath.Abs(filePath)
-	if err != nil {
-		return nil, errors.Wrapf(err, "can't get abs path for %s", filePath)
+	return p.ParseFiles(ctx, []string{filePath})
+}
+
+// ParseFiles parses structs declared in the files, all files
+// must be in the same package
+func (p Structs) ParseFiles(ctx context.Context, filePaths []string) (*Result, error) {
+	if len(filePaths) == 0 {
+		return nil, errors.New("no files to parse")
 	}
 
-	neededStructs, err := p.getStructNamesInFile(absFilePath)
-	if err != nil {
-		return nil, errors.Wrap(err, "can't get struct names")
+	neededStructs := structNamesInfo{}
+	for _, filePath := range filePaths {
+		if filepath.Dir(filePath) != filepath.Dir(filePaths[0]) {
+			return nil, fmt.Errorf("files %s and %s are in different directories",
+				filePaths[0], filePath)
+		}
+
+		absFilePath, err := filepath.Abs(filePath)
+		if err != nil {
+			return nil, errors.Wrapf(err, "can't get abs path for %s", filePath)
+		}
+
+		fileStructs, err := p.getStructNamesInFile(absFilePath)
+		if err != nil {
+			return nil, errors.Wrap(err, "can't get struct names")
+		}
+
+		for name, decl := range fileStructs {
+			neededStructs[name] = decl
+		}
 	}
 
 	// need load the full package type info because
 	// some deps can be in other files
-	inPkgName := filepath.Dir(filePath)
+	pkg, err := p.loadPackage(ctx, filepath.Dir(filePaths[0]))
+	if err != nil {
+		return nil, err
+	}
+
+	return p.buildResult(pkg, neededStructs), nil
+}
+
+// ParsePackage parses structs declared in all files of the package in dir
+func (p Structs) ParsePackage(ctx context.Context, dir string) (*Result, error) {
+	pkg, err := p.loadPackage(ctx, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	// package is loaded with comments, no need to parse files again
+	v := structNamesVisitor{
+		names: structNamesInfo{},
+	}
+	for _, f := range pkg.Syntax {
+		ast.Walk(&v, f)
+	}
+
+	return p.buildResult(pkg, v.names), nil
+}
+
+func (p Structs) loadPackage(ctx context.Context, dir string) (*packages.Package, error) {
+	inPkgName := dir
 	if !filepath.IsAbs(inPkgName) && !strings.HasPrefix(inPkgName, ".") {
 		// to make this dir name a local package name
 		// can't use filepath.Join because it calls Clean and removes "."+sep
@@ -76,19 +127,23 @@ func (p Structs) ParseFile(ctx context.Context, filePath string) (*Result, error
 		Tests:   false,
 	}, inPkgName)
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to load package for file %s", filePath)
+		return nil, errors.Wrapf(err, "failed to load package %s", dir)
 	}
 
 	if len(pkgs) != 1 {
 		return nil, fmt.Errorf("got too many (%d) packages: %#v", len(pkgs), pkgs)
 	}
 
-	structs := p.buildParsedStructs(pkgs[0], neededStructs)
+	return pkgs[0], nil
+}
+
+func (p Structs) buildResult(pkg *packages.Package, neededStructs structNamesInfo) *Result {
 	return &Result{
-		Structs:     structs,
-		PackageName: pkgs[0].Name,
-		Types:       pkgs[0].Types,
-	}, nil
+		Structs:     p.buildParsedStructs(pkg, neededStructs),
+		PackageName: pkg.Name,
+		Types:       pkg.Types,
+		Fset:        pkg.Fset,
+	}
 }
 
 func (p Structs) buildParsedStructs(pkg *packages.Package, neededStructs structNamesInfo) map[string]ParsedStruct {
@@ -159,7 +214,9 @@ func newStructField(f *types.Var, tag string) *StructField {
 	}
 }
 
-func parseStructFields(s *types.Struct) []StructField {
+// ParseStructFields returns exported fields of struct,
+// fields of embedded structs are included
+func ParseStructFields(s *types.Struct) []StructField {
 	var fields []StructField
 	for i := 0; i < s.NumFields(); i++ {
 		f := s.Field(i)
@@ -174,7 +231,7 @@ func parseStructFields(s *types.Struct) []StructField {
 				continue
 			}
 
-			pf := parseStructFields(e)
+			pf := ParseStructFields(e)
 			if len(pf) == 0 {
 				continue
 			}
@@ -195,7 +252,7 @@ func parseStructFields(s *types.Struct) []StructField {
 }
 
 func parseStruct(s *types.Struct, decl *ast.GenDecl) *ParsedStruct {
-	fields := parseStructFields(s)
+	fields := ParseStructFields(s)
 	if len(fields) == 0 {
 		// e.g. no exported fields in struct
 		return nil
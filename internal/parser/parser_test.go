@@ -10,6 +10,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"sort"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -294,3 +295,72 @@ func testStructFields(t *testing.T, tc structFieldsCase) {
 		assert.Equal(t, tc.expectedDoc, docLines)
 	}
 }
+
+func getTmpDirForCodes(codes []string) (string, []string) {
+	tmpDir, err := ioutil.TempDir(getTempDirRoot(), "tmptestdir")
+	if err != nil {
+		log.Fatalf("can't create temp dir: %s", err)
+	}
+
+	var fileNames []string
+	for i, code := range codes {
+		p := filepath.Join(tmpDir, fmt.Sprintf("file%d.go", i))
+		if err := ioutil.WriteFile(p, []byte(code), 0600); err != nil {
+			log.Fatalf("can't write to temp file %q: %s", p, err)
+		}
+		fileNames = append(fileNames, p)
+	}
+
+	return tmpDir, fileNames
+}
+
+func getSortedStructNames(res *Result) []string {
+	names := []string{}
+	for name := range res.Structs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestParseMultipleFiles(t *testing.T) {
+	codes := []string{
+		`package p
+		// gen:qs
+		type T1 struct {
+			F int
+		}`,
+		`package p
+		type T2 struct {
+			T1
+			G string
+		}`,
+		`package p
+		type T3 struct {
+			H int
+		}`,
+	}
+
+	dir, fileNames := getTmpDirForCodes(codes)
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			log.Fatalf("can't remove dir %s: %s", dir, err)
+		}
+	}()
+
+	p := Structs{}
+
+	res, err := p.ParsePackage(context.Background(), dir)
+	assert.NoError(t, err)
+	assert.Equal(t, "p", res.PackageName)
+	assert.Equal(t, []string{"T1", "T2", "T3"}, getSortedStructNames(res))
+	assert.NotNil(t, res.Structs["T1"].Doc)
+	assert.Len(t, res.Structs["T2"].Fields, 2)
+
+	res, err = p.ParseFiles(context.Background(), fileNames[:2])
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"T1", "T2"}, getSortedStructNames(res))
+
+	_, err = p.ParseFiles(context.Background(), []string{fileNames[0], "other/file.go"})
+	assert.Error(t, err)
+}
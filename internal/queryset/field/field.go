@@ -10,19 +10,42 @@ import (
 )
 
 type BaseInfo struct {
-	Name      string // name of field
-	DBName    string // name of field in DB
-	TypeName  string // name of type of field
-	IsStruct  bool
-	IsNumeric bool
-	IsTime    bool
-	IsString  bool
+	Name       string            // name of field
+	DBName     string            // name of field in DB
+	TypeName   string            // name of type of field
+	TagSetting map[string]string // parsed gorm and sql tags
+	Tag        reflect.StructTag // all tags of field
+	Qs         *QsTag            // parsed qs tag, nil if there is no tag
+	IsStruct   bool
+	IsNumeric  bool
+	IsTime     bool
+	IsString   bool
+	IsSlice    bool // slice except []byte, e.g. PostgreSQL array
+	IsMap      bool
+	IsJSON     bool // JSON column: by type or by gorm type or serializer tag
+}
+
+// IsMarshaledToJSON checks whether field is a map or a slice stored in JSON
+// column: its value must be marshaled to JSON before passing to database
+func (bi BaseInfo) IsMarshaledToJSON() bool {
+	return bi.IsJSON && (bi.IsSlice || bi.IsMap)
 }
 
 type Info struct {
 	pointed *BaseInfo
 	BaseInfo
 	IsPointer bool
+
+	// IsNullValue is true for nullable value types like sql.NullString:
+	// structs of value and Valid flag. Pointed info describes the value.
+	IsNullValue    bool
+	NullValueField string // name of value field, e.g. String
+}
+
+// IsNullable checks whether field is a pointer or a nullable value:
+// filters by value are generated by pointed info
+func (fi Info) IsNullable() bool {
+	return fi.IsPointer || fi.IsNullValue
 }
 
 func (fi Info) GetPointed() Info {
@@ -31,6 +54,18 @@ func (fi Info) GetPointed() Info {
 	}
 }
 
+// WithNames returns copy of field info with changed name and db name,
+// pointed field info is changed too
+func (fi Info) WithNames(name, dbName string) Info {
+	fi.Name, fi.DBName = name, dbName
+	if fi.pointed != nil {
+		pointed := *fi.pointed
+		pointed.Name, pointed.DBName = name, dbName
+		fi.pointed = &pointed
+	}
+	return fi
+}
+
 type InfoGenerator struct {
 	pkg *types.Package
 }
@@ -65,14 +100,173 @@ func NewInfoGenerator(pkg *types.Package) *InfoGenerator {
 	}
 }
 
-func (g InfoGenerator) getOriginalTypeName(t *types.Named) string {
-	if t.Obj().Pkg() == g.pkg {
+func (g InfoGenerator) getOriginalTypeName(obj *types.TypeName) string {
+	if obj.Pkg() == g.pkg {
 		// t is from the same package as a struct
-		return t.Obj().Name()
+		return obj.Name()
 	}
 
 	// t is an imported from another package type
-	return fmt.Sprintf("%s.%s", t.Obj().Pkg().Name(), t.Obj().Name())
+	return fmt.Sprintf("%s.%s", obj.Pkg().Name(), obj.Name())
+}
+
+// genSQLValueFieldInfo returns info of field of struct or array type
+// implementing driver.Valuer or sql.Scanner: it's a column value, not an
+// association. Nullable values like sql.NullString are described by info
+// of their value.
+func (g InfoGenerator) genSQLValueFieldInfo(f Field, bi BaseInfo, t *types.Named) (*Info, error) {
+	bi.TypeName = g.getOriginalTypeName(t.Obj())
+	vf := nullValueField(t)
+	if vf == nil {
+		return &Info{
+			BaseInfo: bi,
+		}, nil
+	}
+
+	vi, err := g.GenFieldInfo(field{
+		name: f.Name(),
+		typ:  vf.Type(),
+		tag:  f.Tag(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if vi == nil || vi.IsStruct || vi.IsNullable() {
+		// value can't be filtered: only nullable value is compared
+		return &Info{
+			BaseInfo: bi,
+		}, nil
+	}
+
+	return &Info{
+		BaseInfo:       bi,
+		pointed:        &vi.BaseInfo,
+		IsNullValue:    true,
+		NullValueField: vf.Name(),
+	}, nil
+}
+
+// genNamedFieldInfo returns info of field of named or alias type obj,
+// field info is built by type t and has name of obj
+func (g InfoGenerator) genNamedFieldInfo(f Field, obj *types.TypeName, t types.Type) (*Info, error) {
+	r, err := g.GenFieldInfo(field{
+		name: f.Name(),
+		typ:  t,
+		tag:  f.Tag(),
+	})
+	if r != nil {
+		r.TypeName = g.getOriginalTypeName(obj)
+		if jsonTypeNames[r.TypeName] {
+			r.IsJSON = true
+			r.IsStruct = false // e.g. postgres.Jsonb isn't an association
+		}
+	}
+	return r, err
+}
+
+// genCompositeFieldInfo returns info of field of slice or map type t, it
+// returns nil if values of t can't be passed to database: only []byte,
+// driver.Valuer and values marshaled to JSON are stored in one column
+func genCompositeFieldInfo(bi BaseInfo, t types.Type, isValue bool) *Info {
+	bi.IsJSON = isJSONColumn(bi.TagSetting) || jsonTypeNames[bi.TypeName]
+	if s, ok := t.(*types.Slice); ok {
+		if elem, ok := s.Elem().(*types.Basic); ok && elem.Kind() == types.Byte {
+			return &Info{
+				BaseInfo: bi,
+			}
+		}
+		if isAssociationElem(s.Elem()) {
+			return nil
+		}
+		bi.IsSlice = true
+	} else {
+		bi.IsMap = true
+	}
+
+	if !isValue && !bi.IsJSON {
+		// e.g. []string is expanded into list of values by gorm
+		return nil
+	}
+
+	return &Info{
+		BaseInfo: bi,
+	}
+}
+
+// jsonTypeNames are names of types stored in JSON columns
+var jsonTypeNames = map[string]bool{
+	"json.RawMessage":    true,
+	"datatypes.JSON":     true,
+	"datatypes.JSONMap":  true,
+	"datatypes.JSONType": true,
+	"postgres.Jsonb":     true,
+}
+
+// isJSONColumn checks whether gorm tags declare column as JSON
+func isJSONColumn(tagSetting map[string]string) bool {
+	switch strings.ToLower(tagSetting["TYPE"]) {
+	case "json", "jsonb":
+		return true
+	}
+
+	return strings.ToLower(tagSetting["SERIALIZER"]) == "json"
+}
+
+// isAssociationElem checks whether slice element is a struct or a pointer
+// to struct: such slices are has-many or many2many associations
+func isAssociationElem(t types.Type) bool {
+	if p, ok := t.(*types.Pointer); ok {
+		t = p.Elem()
+	}
+
+	_, ok := t.Underlying().(*types.Struct)
+	return ok && t.String() != "time.Time"
+}
+
+// hasMethod checks whether method set of type t has method name
+func hasMethod(t types.Type, name string) bool {
+	obj, _, _ := types.LookupFieldOrMethod(t, false, nil, name)
+	_, ok := obj.(*types.Func)
+	return ok
+}
+
+// isSQLValue checks whether type implements driver.Valuer or its pointer
+// implements sql.Scanner: it's stored in one column
+func isSQLValue(t types.Type) bool {
+	return hasMethod(t, "Value") || hasMethod(types.NewPointer(t), "Scan")
+}
+
+// nullValueField returns value field of nullable value type like
+// sql.NullString: struct of value and Valid flag, it can be embedded
+// like in null.String
+func nullValueField(t types.Type) *types.Var {
+	s, ok := t.Underlying().(*types.Struct)
+	if !ok {
+		return nil
+	}
+
+	if s.NumFields() == 1 && s.Field(0).Anonymous() {
+		return nullValueField(s.Field(0).Type())
+	}
+	if s.NumFields() != 2 {
+		return nil
+	}
+
+	var value *types.Var
+	hasValid := false
+	for i := 0; i < s.NumFields(); i++ {
+		f := s.Field(i)
+		if f.Name() == "Valid" && types.Identical(f.Type(), types.Typ[types.Bool]) {
+			hasValid = true
+		} else if f.Exported() {
+			value = f
+		}
+	}
+	if !hasValid {
+		return nil
+	}
+
+	return value
 }
 
 // parseTagSetting is copy-pasted from gorm source code.
@@ -93,10 +287,37 @@ func parseTagSetting(tags reflect.StructTag) map[string]string {
 	return setting
 }
 
-func (g InfoGenerator) GenFieldInfo(f Field) *Info {
+// GenAssociationElemInfo returns info of element struct of has many or
+// many to many association field (e.g. Post for Posts []*Post) named as
+// the field. Such fields aren't stored in DB: GenFieldInfo skips them.
+// It returns nil if field isn't a slice of structs.
+func (g InfoGenerator) GenAssociationElemInfo(f Field) (*Info, error) {
+	s, ok := f.Type().(*types.Slice)
+	if !ok || !isAssociationElem(s.Elem()) {
+		return nil, nil
+	}
+
+	elem := s.Elem()
+	if p, ok := elem.(*types.Pointer); ok {
+		elem = p.Elem()
+	}
+	info, err := g.GenFieldInfo(field{
+		name: f.Name(),
+		typ:  elem,
+		tag:  f.Tag(),
+	})
+	if info == nil || err != nil || !info.IsStruct {
+		return nil, err
+	}
+
+	return info, nil
+}
+
+// GenFieldInfo returns nil if field isn't stored in DB or can't be filtered
+func (g InfoGenerator) GenFieldInfo(f Field) (*Info, error) {
 	tagSetting := parseTagSetting(f.Tag())
 	if tagSetting["-"] != "" { // skipped by tag field
-		return nil
+		return nil, nil
 	}
 
 	dbName := gorm.ToDBName(f.Name())
@@ -104,9 +325,18 @@ func (g InfoGenerator) GenFieldInfo(f Field) *Info {
 		dbName = dbColName
 	}
 	bi := BaseInfo{
-		Name:     f.Name(),
-		TypeName: f.Type().String(),
-		DBName:   dbName,
+		Name:       f.Name(),
+		TypeName:   f.Type().String(),
+		DBName:     dbName,
+		TagSetting: tagSetting,
+		Tag:        f.Tag(),
+	}
+	if qsTag, ok := f.Tag().Lookup("qs"); ok {
+		qs, err := ParseQsTag(qsTag)
+		if err != nil {
+			return nil, fmt.Errorf("invalid qs tag of field %s: %s", f.Name(), err)
+		}
+		bi.Qs = qs
 	}
 
 	if bi.TypeName == "time.Time" {
@@ -114,7 +344,7 @@ func (g InfoGenerator) GenFieldInfo(f Field) *Info {
 		bi.IsNumeric = true
 		return &Info{
 			BaseInfo: bi,
-		}
+		}, nil
 	}
 
 	switch t := f.Type().(type) {
@@ -123,42 +353,45 @@ func (g InfoGenerator) GenFieldInfo(f Field) *Info {
 		bi.IsNumeric = t.Info()&types.IsNumeric != 0
 		return &Info{
 			BaseInfo: bi,
-		}
-	case *types.Slice:
-		if t.Elem().String() == "byte" {
-			return &Info{
-				BaseInfo: bi,
-			}
-		}
-		return nil
+		}, nil
+	case *types.Slice, *types.Map:
+		// type without name has no methods: it isn't driver.Valuer
+		return genCompositeFieldInfo(bi, t, false), nil
 	case *types.Named:
-		r := g.GenFieldInfo(field{
-			name: f.Name(),
-			typ:  t.Underlying(),
-			tag:  f.Tag(),
-		})
-		if r != nil {
-			r.TypeName = g.getOriginalTypeName(t)
+		switch u := t.Underlying().(type) {
+		case *types.Struct, *types.Array:
+			if isSQLValue(t) {
+				return g.genSQLValueFieldInfo(f, bi, t)
+			}
+		case *types.Slice, *types.Map:
+			bi.TypeName = g.getOriginalTypeName(t.Obj())
+			return genCompositeFieldInfo(bi, u, isSQLValue(t)), nil
 		}
-		return r
+		return g.genNamedFieldInfo(f, t.Obj(), t.Underlying())
+	case *types.Alias:
+		// e.g. json.RawMessage is an alias of jsontext.Value
+		return g.genNamedFieldInfo(f, t.Obj(), types.Unalias(t))
 	case *types.Struct:
 		bi.IsStruct = true
 		return &Info{
 			BaseInfo: bi,
-		}
+		}, nil
 	case *types.Pointer:
-		pf := g.GenFieldInfo(field{
+		pf, err := g.GenFieldInfo(field{
 			name: f.Name(),
 			typ:  t.Elem(),
 			tag:  f.Tag(),
 		})
+		if pf == nil || err != nil {
+			return nil, err
+		}
 		return &Info{
 			BaseInfo:  bi,
 			IsPointer: true,
 			pointed:   &pf.BaseInfo,
-		}
+		}, nil
 	default:
 		// no filtering is needed
-		return nil
+		return nil, nil
 	}
 }
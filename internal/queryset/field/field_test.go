@@ -32,7 +32,11 @@ func newG() *InfoGenerator {
 }
 
 func genFieldInfo(f Field) *Info {
-	return newG().GenFieldInfo(f)
+	info, err := newG().GenFieldInfo(f)
+	if err != nil {
+		panic(err)
+	}
+	return info
 }
 
 var typeString = types.Typ[types.String]
@@ -73,3 +77,152 @@ func TestColumnNameSetInTag(t *testing.T) {
 	assert.Equal(t, fName, info.Name)
 	assert.Equal(t, typeNamedString.String(), info.TypeName)
 }
+
+func TestWithNames(t *testing.T) {
+	info := genFieldInfo(newTf(fName, typeStringPtr, ""))
+	renamed := info.WithNames("BlogF", "blog_join.f")
+	assert.Equal(t, "BlogF", renamed.Name)
+	assert.Equal(t, "blog_join.f", renamed.DBName)
+	assert.Equal(t, "BlogF", renamed.GetPointed().Name)
+	assert.Equal(t, "blog_join.f", renamed.GetPointed().DBName)
+
+	// original info isn't changed
+	assert.Equal(t, fName, info.GetPointed().Name)
+	assert.Equal(t, "f", info.GetPointed().DBName)
+}
+
+func TestQsTag(t *testing.T) {
+	info := genFieldInfo(newTf(fName, typeStringPtr, `qs:"filters=eq,isnull;order"`))
+	assert.Equal(t, &QsTag{Filters: []string{"eq", "isnull"}, Order: true}, info.Qs)
+	assert.Equal(t, info.Qs, info.GetPointed().Qs)
+	assert.True(t, info.Qs.HasFilter("isnull"))
+	assert.False(t, info.Qs.HasFilter("ne"))
+	assert.False(t, info.Qs.HasGroup())
+
+	info = genFieldInfo(newTf(fName, typeString, `qs:"-"`))
+	assert.Equal(t, &QsTag{}, info.Qs)
+
+	info = genFieldInfo(newTf(fName, typeString, ""))
+	assert.Nil(t, info.Qs)
+	assert.True(t, info.Qs.HasFilter("like"))
+	assert.True(t, info.Qs.HasAggregates())
+
+	for _, tag := range []string{`qs:"filters=equal"`, `qs:"order=asc"`, `qs:"sort"`} {
+		_, err := newG().GenFieldInfo(newTf(fName, typeString, tag))
+		assert.Error(t, err, tag)
+	}
+}
+
+func newNamedType(pkgPath, pkgName, name string, underlying types.Type) *types.Named {
+	pkg := types.NewPackage(pkgPath, pkgName)
+	return types.NewNamed(types.NewTypeName(token.Pos(0), pkg, name, nil), underlying, nil)
+}
+
+func TestCompositeTypes(t *testing.T) {
+	typeBytes := types.NewSlice(types.Typ[types.Byte])
+	typeStrings := types.NewSlice(typeString)
+	typeMap := types.NewMap(typeString, typeString)
+	typeStruct := types.NewStruct(nil, nil)
+
+	// plain slices and maps can't be passed to database: gorm expands slices
+	assert.Nil(t, genFieldInfo(newTf(fName, typeStrings, "")))
+	assert.Nil(t, genFieldInfo(newTf(fName, typeMap, "")))
+	assert.Nil(t, genFieldInfo(newTf(fName, newNamedType("github.com/a/b", "b", "Strings", typeStrings), "")))
+
+	info := genFieldInfo(newTf(fName, typeStrings, `gorm:"serializer:json"`))
+	assert.True(t, info.IsSlice)
+	assert.True(t, info.IsJSON)
+
+	info = genFieldInfo(newTf(fName, newValuerType("github.com/lib/pq", "pq", "StringArray", typeStrings), ""))
+	assert.True(t, info.IsSlice)
+	assert.False(t, info.IsJSON)
+	assert.Equal(t, "pq.StringArray", info.TypeName)
+
+	info = genFieldInfo(newTf(fName, typeBytes, `gorm:"type:JSONB"`))
+	assert.True(t, info.IsJSON)
+	assert.False(t, info.IsSlice)
+
+	info = genFieldInfo(newTf(fName, typeMap, `gorm:"serializer:json"`))
+	assert.True(t, info.IsMap)
+	assert.True(t, info.IsJSON)
+
+	info = genFieldInfo(newTf(fName, newNamedType("encoding/json", "json", "RawMessage", typeBytes), ""))
+	assert.True(t, info.IsJSON)
+	assert.Equal(t, "json.RawMessage", info.TypeName)
+
+	info = genFieldInfo(newTf(fName, newNamedType("github.com/jinzhu/gorm/dialects/postgres",
+		"postgres", "Jsonb", typeStruct), ""))
+	assert.True(t, info.IsJSON)
+	assert.False(t, info.IsStruct)
+
+	// has-many associations
+	assert.Nil(t, genFieldInfo(newTf(fName, types.NewSlice(typeStruct), "")))
+	assert.Nil(t, genFieldInfo(newTf(fName, types.NewSlice(types.NewPointer(typeStruct)), "")))
+}
+
+// newValuerType returns named type with Value method: it's a driver.Valuer
+func newValuerType(pkgPath, pkgName, name string, underlying types.Type) *types.Named {
+	named := newNamedType(pkgPath, pkgName, name, underlying)
+	pkg := named.Obj().Pkg()
+	recv := types.NewVar(token.Pos(0), pkg, "", named)
+	results := types.NewTuple(
+		types.NewVar(token.Pos(0), pkg, "", types.NewInterfaceType(nil, nil)),
+		types.NewVar(token.Pos(0), pkg, "", types.Universe.Lookup("error").Type()),
+	)
+	named.AddMethod(types.NewFunc(token.Pos(0), pkg, "Value",
+		types.NewSignatureType(recv, nil, nil, nil, results, false)))
+	return named
+}
+
+func TestSQLValueTypes(t *testing.T) {
+	newVar := func(name string, typ types.Type) *types.Var {
+		return types.NewField(token.Pos(0), nil, name, typ, false)
+	}
+	nullString := newValuerType("database/sql", "sql", "NullString", types.NewStruct([]*types.Var{
+		newVar("String", typeString),
+		newVar("Valid", types.Typ[types.Bool]),
+	}, nil))
+
+	info := genFieldInfo(newTf(fName, nullString, ""))
+	assert.True(t, info.IsNullValue)
+	assert.True(t, info.IsNullable())
+	assert.False(t, info.IsStruct)
+	assert.Equal(t, "sql.NullString", info.TypeName)
+	assert.Equal(t, "String", info.NullValueField)
+	assert.True(t, info.GetPointed().IsString)
+	assert.Equal(t, "string", info.GetPointed().TypeName)
+
+	// null.String embeds sql.NullString
+	info = genFieldInfo(newTf(fName, newValuerType("gopkg.in/guregu/null.v4", "null", "String",
+		types.NewStruct([]*types.Var{types.NewField(token.Pos(0), nil, "NullString", nullString, true)}, nil)), ""))
+	assert.True(t, info.IsNullValue)
+	assert.Equal(t, "null.String", info.TypeName)
+	assert.Equal(t, "String", info.NullValueField)
+
+	// opaque value is compared as a whole
+	info = genFieldInfo(newTf(fName, newValuerType("github.com/a/geo", "geo", "Point", types.NewStruct([]*types.Var{
+		newVar("X", types.Typ[types.Int]),
+		newVar("Y", types.Typ[types.Int]),
+	}, nil)), ""))
+	assert.False(t, info.IsNullValue)
+	assert.False(t, info.IsStruct)
+	assert.Equal(t, "geo.Point", info.TypeName)
+}
+
+func TestAssociationElemInfo(t *testing.T) {
+	post := newNamedType("github.com/a/blog", "blog", "Post", types.NewStruct(nil, nil))
+
+	info, err := newG().GenAssociationElemInfo(newTf("Posts", types.NewSlice(types.NewPointer(post)),
+		`gorm:"many2many:post_readers"`))
+	assert.Nil(t, err)
+	assert.Equal(t, "Posts", info.Name)
+	assert.Equal(t, "blog.Post", info.TypeName)
+	assert.True(t, info.IsStruct)
+	assert.Equal(t, "post_readers", info.TagSetting["MANY2MANY"])
+
+	for _, typ := range []types.Type{post, types.NewSlice(typeString), types.NewSlice(types.NewPointer(typeString))} {
+		info, err = newG().GenAssociationElemInfo(newTf(fName, typ, ""))
+		assert.Nil(t, err)
+		assert.Nil(t, info)
+	}
+}
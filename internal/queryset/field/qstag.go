@@ -0,0 +1,115 @@
+package field
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FilterOperations are names of all filter operations of fields
+var FilterOperations = []string{
+	"eq", "ne", "in", "notin", "like", "notlike",
+	"ieq", "icontains", "startswith", "endswith",
+	"lt", "gt", "lte", "gte", "between", "inrange", "within",
+	"contains", "overlaps", "jsoneq", "isnull", "isnotnull",
+}
+
+// QsTag is a parsed "qs" tag of field: it selects queryset methods generated
+// for the field, e.g. `qs:"filters=eq,in;order"`. Tag "-" disables all of them.
+// Methods of nil QsTag return true: all methods are generated without tag.
+type QsTag struct {
+	Filters    []string // filter operations, all if AllFilters is set
+	AllFilters bool     // "filters" without operations
+	Order      bool     // OrderAscBy and OrderDescBy
+	Group      bool     // GroupBy and CountBy
+	Aggregates bool     // Sum, Avg, Min and Max
+	Preload    bool     // Preload of association
+}
+
+func isFilterOperation(op string) bool {
+	for _, o := range FilterOperations {
+		if o == op {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseQsTag parses value of "qs" tag: it's a list of methods groups
+// separated by ";", filters can be limited by operations: "filters=eq,in"
+func ParseQsTag(tag string) (*QsTag, error) {
+	ret := &QsTag{}
+	if strings.TrimSpace(tag) == "-" {
+		return ret, nil
+	}
+
+	for _, part := range strings.Split(tag, ";") {
+		kv := strings.SplitN(strings.TrimSpace(part), "=", 2)
+		key := kv[0]
+		if key != "filters" && len(kv) == 2 {
+			return nil, fmt.Errorf("%s doesn't have value", key)
+		}
+
+		switch key {
+		case "filters":
+			if len(kv) == 1 {
+				ret.AllFilters = true
+				continue
+			}
+			for _, op := range strings.Split(kv[1], ",") {
+				op = strings.ToLower(strings.TrimSpace(op))
+				if !isFilterOperation(op) {
+					return nil, fmt.Errorf("unknown filter %q, must be one of %v", op, FilterOperations)
+				}
+				ret.Filters = append(ret.Filters, op)
+			}
+		case "order":
+			ret.Order = true
+		case "group":
+			ret.Group = true
+		case "aggregates":
+			ret.Aggregates = true
+		case "preload":
+			ret.Preload = true
+		default:
+			return nil, fmt.Errorf("unknown methods %q", key)
+		}
+	}
+
+	return ret, nil
+}
+
+// HasFilter checks whether filter by operation op is generated
+func (t *QsTag) HasFilter(op string) bool {
+	if t == nil || t.AllFilters {
+		return true
+	}
+
+	for _, f := range t.Filters {
+		if f == op {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasOrder checks whether order methods are generated
+func (t *QsTag) HasOrder() bool {
+	return t == nil || t.Order
+}
+
+// HasGroup checks whether grouping methods are generated
+func (t *QsTag) HasGroup() bool {
+	return t == nil || t.Group
+}
+
+// HasAggregates checks whether aggregate methods are generated
+func (t *QsTag) HasAggregates() bool {
+	return t == nil || t.Aggregates
+}
+
+// HasPreload checks whether Preload method is generated
+func (t *QsTag) HasPreload() bool {
+	return t == nil || t.Preload
+}
@@ -0,0 +1,227 @@
+package generator
+
+import (
+	"fmt"
+	"go/types"
+
+	"github.com/jinzhu/gorm"
+	"github.com/jirfag/go-queryset/internal/parser"
+	"github.com/jirfag/go-queryset/internal/queryset/field"
+	"github.com/jirfag/go-queryset/internal/queryset/methods"
+)
+
+// assocInfo describes an association: field of model referring
+// to another struct (e.g. Post.Blog)
+type assocInfo struct {
+	field    field.Info   // field of model
+	typeName string       // name of associated struct type
+	fields   []field.Info // non-association fields of associated struct
+
+	deletedAtDBName string // soft delete column of associated struct, if any
+
+	isMany    bool   // has many or many to many association: field is a slice
+	joinTable string // join table of many to many association from many2many tag
+}
+
+func getAssocStruct(t types.Type) (*types.Named, *types.Struct) {
+	if p, ok := t.(*types.Pointer); ok {
+		t = p.Elem()
+	}
+
+	named, ok := t.(*types.Named)
+	if !ok {
+		return nil, nil
+	}
+
+	s, ok := named.Underlying().(*types.Struct)
+	if !ok {
+		return nil, nil
+	}
+
+	return named, s
+}
+
+func genStructAssocInfos(s parser.ParsedStruct, pkg *types.Package) ([]assocInfo, error) {
+	var ret []assocInfo
+
+	g := field.NewInfoGenerator(pkg)
+	for _, f := range s.Fields {
+		fi, err := g.GenFieldInfo(f)
+		if err != nil {
+			return nil, err
+		}
+		isMany := false
+		if fi == nil {
+			// slices of structs aren't stored in DB
+			if fi, err = g.GenAssociationElemInfo(f); err != nil {
+				return nil, err
+			}
+			isMany = true
+		}
+		if fi == nil || fi.IsTime {
+			continue
+		}
+
+		// field info of has many association describes element of slice
+		t, typeName := f.Type(), fi.TypeName
+		switch {
+		case isMany:
+			t = t.(*types.Slice).Elem()
+		case fi.IsPointer:
+			p := fi.GetPointed()
+			if !p.IsStruct {
+				continue
+			}
+			typeName = p.TypeName
+		case !fi.IsStruct:
+			continue
+		}
+
+		_, assocStruct := getAssocStruct(t)
+		if assocStruct == nil {
+			continue // e.g. anonymous struct
+		}
+
+		a := assocInfo{
+			field:     *fi,
+			typeName:  typeName,
+			isMany:    isMany,
+			joinTable: fi.TagSetting["MANY2MANY"],
+		}
+		for _, af := range parser.ParseStructFields(assocStruct) {
+			afi, err := g.GenFieldInfo(af)
+			if err != nil {
+				return nil, fmt.Errorf("invalid field of associated struct %s: %s", typeName, err)
+			}
+			if afi != nil && afi.Name == "DeletedAt" {
+				// it's a struct (gorm.DeletedAt) in gorm v2
+				a.deletedAtDBName = afi.DBName
+			}
+			if afi == nil || afi.IsStruct || (afi.IsPointer && afi.GetPointed().IsStruct) {
+				continue // only one level of associations
+			}
+			a.fields = append(a.fields, *afi)
+		}
+
+		ret = append(ret, a)
+	}
+
+	return ret, nil
+}
+
+func findFieldByName(fields []field.Info, name string) *field.Info {
+	for i := range fields {
+		if fields[i].Name == name {
+			return &fields[i]
+		}
+	}
+
+	return nil
+}
+
+const primaryKeyName = "ID"
+
+// getHasOneKeys returns foreign key field of associated struct and
+// referenced field of model for has one or has many relation, nils if
+// they weren't found
+func (a assocInfo) getHasOneKeys(modelTypeName string, modelFields []field.Info) (fk, key *field.Info) {
+	fkName, keyName := a.field.TagSetting["FOREIGNKEY"], a.field.TagSetting["ASSOCIATION_FOREIGNKEY"]
+	if fkName == "" {
+		fkName = modelTypeName + primaryKeyName
+	}
+	if keyName == "" {
+		keyName = primaryKeyName
+	}
+	fk, key = findFieldByName(a.fields, fkName), findFieldByName(modelFields, keyName)
+	if fk == nil || key == nil {
+		return nil, nil
+	}
+
+	return fk, key
+}
+
+// getBelongsToKeys returns foreign key field of model and referenced field
+// of associated struct for belongs to relation, nils if they weren't found
+func (a assocInfo) getBelongsToKeys(modelFields []field.Info) (fk, key *field.Info) {
+	fkName, keyName := a.field.TagSetting["FOREIGNKEY"], a.field.TagSetting["ASSOCIATION_FOREIGNKEY"]
+	if fkName == "" {
+		fkName = a.field.Name + primaryKeyName
+	}
+	if keyName == "" {
+		keyName = primaryKeyName
+	}
+	fk, key = findFieldByName(modelFields, fkName), findFieldByName(a.fields, keyName)
+	if fk == nil || key == nil {
+		return nil, nil
+	}
+
+	return fk, key
+}
+
+// getJoinSpec finds keys to join associated struct table the same way
+// as gorm does: has one relation is checked first, then belongs to.
+// It returns nil if relation keys weren't found.
+func (a assocInfo) getJoinSpec(modelTypeName string, modelFields []field.Info) *methods.JoinSpec {
+	if a.isMany {
+		return nil // joined rows would duplicate rows of model
+	}
+
+	spec := &methods.JoinSpec{
+		FieldName:     a.field.Name,
+		AssocTypeName: a.typeName,
+		Alias:         gorm.ToDBName(a.field.Name) + "_join",
+
+		AssocDeletedAtColumn: a.deletedAtDBName,
+	}
+
+	// has one: foreign key is in associated struct
+	if fk, key := a.getHasOneKeys(modelTypeName, modelFields); fk != nil {
+		spec.AssocColumn, spec.ModelColumn = fk.DBName, key.DBName
+		return spec
+	}
+
+	// belongs to: foreign key is in model
+	if fk, key := a.getBelongsToKeys(modelFields); fk != nil {
+		spec.ModelColumn, spec.AssocColumn = fk.DBName, key.DBName
+		return spec
+	}
+
+	return nil
+}
+
+// getJoinedFields returns fields of associated struct to filter joined
+// querysets by: they are named with association name prefix and
+// qualified by join alias
+func (a assocInfo) getJoinedFields(spec methods.JoinSpec, modelFields []field.Info) []field.Info {
+	var ret []field.Info
+	for _, f := range a.fields {
+		jf := f.WithNames(a.field.Name+f.Name, spec.Alias+"."+f.DBName)
+		if findFieldByName(modelFields, jf.Name) != nil {
+			// e.g. Post.UserID and Post.User.ID: the same column for belongs to
+			continue
+		}
+		ret = append(ret, jf)
+	}
+
+	return ret
+}
+
+// getAssocSpec returns spec of has many or many to many association, it
+// returns nil if gorm can't find relation keys: has many association
+// needs foreign key in associated struct
+func (a assocInfo) getAssocSpec(modelTypeName string, modelFields []field.Info) *methods.AssocSpec {
+	if !a.isMany {
+		return nil
+	}
+	if a.joinTable == "" {
+		if fk, _ := a.getHasOneKeys(modelTypeName, modelFields); fk == nil {
+			return nil
+		}
+	}
+
+	return &methods.AssocSpec{
+		FieldName:     a.field.Name,
+		AssocTypeName: a.typeName,
+		JoinTable:     a.joinTable,
+	}
+}
@@ -8,16 +8,31 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 
 	"github.com/jirfag/go-queryset/internal/parser"
+	"github.com/jirfag/go-queryset/internal/queryset/methods"
 	"github.com/pkg/errors"
 	"golang.org/x/tools/imports"
 )
 
 type Generator struct {
 	StructsParser *parser.Structs
+
+	// Backend is a default gorm version to generate querysets for,
+	// it can be overridden by "gen:qs backend=..." struct annotation
+	Backend methods.Backend
+
+	// Structs, Naming and SkipMethods are passed to generation Options
+	Structs     []string
+	Naming      Naming
+	SkipMethods map[MethodFamily]bool
 }
 
+// ErrNoStructs is returned if there are no structs to generate querysets for
+var ErrNoStructs = errors.New("no structs to generate query set")
+
 // Generate generates output file with querysets
 func (g Generator) Generate(ctx context.Context, inFilePath, outFilePath string) error {
 	parsedFile, err := g.StructsParser.ParseFile(ctx, inFilePath)
@@ -25,17 +40,57 @@ func (g Generator) Generate(ctx context.Context, inFilePath, outFilePath string)
 		return errors.Wrapf(err, "can't parse file %s to get structs", inFilePath)
 	}
 
-	var r io.Reader
-	r, err = GenerateQuerySetsForStructs(parsedFile.Types, parsedFile.Structs)
+	return g.generate(parsedFile, inFilePath, outFilePath)
+}
+
+// GenerateFiles generates one output file with querysets for structs
+// from all input files, input files must be in the same package
+func (g Generator) GenerateFiles(ctx context.Context, inFilePaths []string, outFilePath string) error {
+	parsedFiles, err := g.StructsParser.ParseFiles(ctx, inFilePaths)
+	if err != nil {
+		return errors.Wrapf(err, "can't parse files %v to get structs", inFilePaths)
+	}
+
+	return g.generate(parsedFiles, strings.Join(inFilePaths, ","), outFilePath)
+}
+
+// GeneratePackage generates one output file with querysets for structs
+// from all files of the package in pkgDir
+func (g Generator) GeneratePackage(ctx context.Context, pkgDir, outFilePath string) error {
+	parsedPkg, err := g.StructsParser.ParsePackage(ctx, pkgDir)
+	if err != nil {
+		return errors.Wrapf(err, "can't parse package %s to get structs", pkgDir)
+	}
+
+	return g.generate(parsedPkg, pkgDir, outFilePath)
+}
+
+func (g Generator) generate(parsed *parser.Result, inName, outFilePath string) error {
+	defaultBackend := g.Backend
+	if defaultBackend == "" {
+		defaultBackend = methods.BackendGormV1
+	}
+
+	opts := Options{
+		Backend:     defaultBackend,
+		SkipHelpers: isDeclaredInOtherFile(parsed, helperName, outFilePath),
+		Structs:     g.Structs,
+		Naming:      g.Naming,
+		SkipMethods: g.SkipMethods,
+		IsDeclared: func(name string) bool {
+			return isDeclaredInOtherFile(parsed, name, outFilePath)
+		},
+	}
+	r, backend, err := GenerateQuerySetsForStructs(parsed.Types, parsed.Structs, opts)
 	if err != nil {
 		return errors.Wrap(err, "can't generate query sets")
 	}
 
 	if r == nil {
-		return fmt.Errorf("no structs to generate query set in %s", inFilePath)
+		return errors.Wrapf(ErrNoStructs, "in %s", inName)
 	}
 
-	if err = g.writeQuerySetsToOutput(r, parsedFile.PackageName, outFilePath); err != nil {
+	if err = g.writeQuerySetsToOutput(r, parsed.PackageName, backend, outFilePath); err != nil {
 		return errors.Wrapf(err, "can't save query sets to out file %s", outFilePath)
 	}
 
@@ -49,17 +104,52 @@ func (g Generator) Generate(ctx context.Context, inFilePath, outFilePath string)
 	return nil
 }
 
-func (g Generator) writeQuerySetsToOutput(r io.Reader, packageName, outFile string) error {
+// helperName is a name of one of generated package-level helpers:
+// all of them are generated into one file
+const helperName = "WithTx"
+
+// isDeclaredInOtherFile checks whether package-level name is declared in
+// the package not in the file: then generated declaration would conflict
+// with it. E.g. package has multiple files with generated querysets.
+func isDeclaredInOtherFile(parsed *parser.Result, name, filePath string) bool {
+	obj := parsed.Types.Scope().Lookup(name)
+	if obj == nil {
+		return false
+	}
+
+	declFilePath, err := filepath.Abs(parsed.Fset.Position(obj.Pos()).Filename)
+	if err != nil {
+		return true
+	}
+
+	absFilePath, err := filepath.Abs(filePath)
+	if err != nil {
+		return true
+	}
+
+	return declFilePath != absFilePath
+}
+
+func (g Generator) writeQuerySetsToOutput(r io.Reader, packageName string,
+	backend methods.Backend, outFile string) error {
+
 	const hdrTmpl = `%s
 	package %s
 
 import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"reflect"
+	"sort"
 	"strings"
 	"time"
+	"unicode/utf8"
 
-	"github.com/jinzhu/gorm"
+	%s
 )
 `
 
@@ -67,7 +157,11 @@ import (
 	const genHdr = `// Code generated by go-queryset. DO NOT EDIT.`
 
 	var buf bytes.Buffer
-	pkgName := fmt.Sprintf(hdrTmpl, genHdr, packageName)
+	var gormImports []string
+	for _, p := range backend.ImportPaths() {
+		gormImports = append(gormImports, strconv.Quote(p))
+	}
+	pkgName := fmt.Sprintf(hdrTmpl, genHdr, packageName, strings.Join(gormImports, "\n"))
 	if _, err := buf.WriteString(pkgName); err != nil {
 		return errors.Wrap(err, "can't write hdr string into buf")
 	}
@@ -1,150 +1,663 @@
 package generator
 
 import (
+	"fmt"
+
 	"github.com/jirfag/go-queryset/internal/parser"
 	"github.com/jirfag/go-queryset/internal/queryset/field"
 	"github.com/jirfag/go-queryset/internal/queryset/methods"
 )
 
 type methodsBuilder struct {
-	fields []field.Info
-	s      parser.ParsedStruct
-	ret    []methods.Method
-	sctx   methods.QsStructContext
+	fields  []field.Info
+	assocs  []assocInfo
+	s       parser.ParsedStruct
+	ret     []methods.Method
+	sctx    methods.QsStructContext
+	backend methods.Backend
+
+	qsName      string
+	updaterName string
+	qsNames     map[string]string // queryset names of structs of the package
+
+	validate bool                  // call Validate before writes
+	skip     map[MethodFamily]bool // method families to not generate
+	readOnly bool                  // no updater and methods writing to database
 }
 
 func (b *methodsBuilder) qsTypeName() string {
-	return b.s.TypeName + "QuerySet"
+	return b.qsName
 }
 
-func newMethodsBuilder(s parser.ParsedStruct, fields []field.Info) *methodsBuilder {
+func (b *methodsBuilder) updaterTypeName() string {
+	return b.updaterName
+}
+
+func newMethodsBuilder(s parser.ParsedStruct, fields []field.Info, assocs []assocInfo,
+	backend methods.Backend, qsTypeName, updaterTypeName string) *methodsBuilder {
+
 	return &methodsBuilder{
-		s:      s,
-		sctx:   methods.NewQsStructContext(s),
-		fields: fields,
+		s:           s,
+		sctx:        methods.NewQsStructContext(s, backend, qsTypeName),
+		fields:      fields,
+		assocs:      assocs,
+		backend:     backend,
+		qsName:      qsTypeName,
+		updaterName: updaterTypeName,
 	}
 }
 
-func (b *methodsBuilder) getQuerySetMethodsForField(f field.Info) []methods.Method {
-	fctx := b.sctx.FieldCtx(f)
-	basicTypeMethods := []methods.Method{
-		methods.NewBinaryFilterMethod(fctx.WithOperationName("eq")),
-		methods.NewBinaryFilterMethod(fctx.WithOperationName("ne")),
-		methods.NewOrderAscByMethod(fctx),
-		methods.NewOrderDescByMethod(fctx),
+// isComposite checks whether field is stored as array, map or JSON: such
+// columns can be NULL without pointer and aren't ordered or grouped
+func isComposite(f field.Info) bool {
+	return f.IsSlice || f.IsMap || f.IsJSON
+}
+
+// getFilterOperations returns names of filter operations applicable to field
+func getFilterOperations(f field.Info) []string {
+	if f.IsStruct {
+		return nil
 	}
 
-	if !f.IsTime {
-		inMethod := methods.NewInFilterMethod(fctx)
-		notInMethod := methods.NewNotInFilterMethod(fctx)
-		basicTypeMethods = append(basicTypeMethods, inMethod, notInMethod)
+	if f.IsNullable() {
+		ops := getFilterOperations(f.GetPointed())
+		if isComposite(f.GetPointed()) {
+			return ops // null checks are already added
+		}
+		return append(ops, "isnull", "isnotnull")
 	}
 
-	numericMethods := []methods.Method{
-		methods.NewBinaryFilterMethod(fctx.WithOperationName("lt")),
-		methods.NewBinaryFilterMethod(fctx.WithOperationName("gt")),
-		methods.NewBinaryFilterMethod(fctx.WithOperationName("lte")),
-		methods.NewBinaryFilterMethod(fctx.WithOperationName("gte")),
+	if f.IsJSON {
+		return []string{"jsoneq", "isnull", "isnotnull"}
+	}
+	if f.IsSlice || f.IsMap {
+		ops := []string{"eq", "ne", "isnull", "isnotnull"}
+		if f.IsSlice {
+			ops = append(ops, "contains", "overlaps")
+		}
+		return ops
 	}
 
+	ops := []string{"eq", "ne"}
+	if !f.IsTime {
+		ops = append(ops, "in", "notin")
+	}
 	if f.IsString {
-		likeMethod := methods.NewBinaryFilterMethod(fctx.WithOperationName("like"))
-		notLikeMethod := methods.NewBinaryFilterMethod(fctx.WithOperationName("notlike"))
+		ops = append(ops, "like", "notlike", "ieq", "icontains", "startswith", "endswith")
+	}
+	if f.IsString || f.IsNumeric {
+		ops = append(ops, "lt", "gt", "lte", "gte")
+	}
+	if f.IsNumeric {
+		ops = append(ops, "between", "inrange")
+	}
+	if f.IsTime {
+		ops = append(ops, "within")
+	}
+	return ops
+}
 
-		methods := append(basicTypeMethods, likeMethod, notLikeMethod)
-		return append(methods, numericMethods...)
+// validateQsTag checks that methods from qs tag of field can be generated
+func validateQsTag(f field.Info) error {
+	if f.Qs == nil {
+		return nil
 	}
 
-	if f.IsNumeric {
-		return append(basicTypeMethods, numericMethods...)
+	ops := map[string]bool{}
+	for _, op := range getFilterOperations(f) {
+		ops[op] = true
+	}
+	for _, op := range f.Qs.Filters {
+		if !ops[op] {
+			return fmt.Errorf("filter %s isn't supported for field %s", op, f.Name)
+		}
 	}
 
-	if f.IsStruct {
+	base := f
+	if f.IsNullable() {
+		base = f.GetPointed()
+	}
+	if f.Qs.Aggregates && !base.IsNumeric {
+		return fmt.Errorf("aggregates aren't supported for non-numeric field %s", f.Name)
+	}
+	if f.Qs.Preload && !base.IsStruct {
+		return fmt.Errorf("preload isn't supported for non-association field %s", f.Name)
+	}
+	if (f.Qs.Order || f.Qs.Group) && base.IsStruct {
+		return fmt.Errorf("association field %s can't be used for order or group", f.Name)
+	}
+	if (f.Qs.Order || f.Qs.Group) && isComposite(base) {
+		return fmt.Errorf("array, map or JSON field %s can't be used for order or group", f.Name)
+	}
+
+	return nil
+}
+
+func (b *methodsBuilder) getFilterMethodsForField(f field.Info) []methods.Method {
+	if f.IsNullable() {
+		// filters by value are generated by pointed field, it has the same tag
+		ret := b.getFilterMethodsForField(f.GetPointed())
+		if isComposite(f.GetPointed()) {
+			return ret
+		}
+		fctx := b.sctx.FieldCtx(f)
+		if f.Qs.HasFilter("isnull") {
+			ret = append(ret, methods.NewIsNullMethod(fctx))
+		}
+		if f.Qs.HasFilter("isnotnull") {
+			ret = append(ret, methods.NewIsNotNullMethod(fctx))
+		}
+		return ret
+	}
+
+	var ret []methods.Method
+	fctx := b.sctx.FieldCtx(f)
+	for _, op := range getFilterOperations(f) {
+		if !f.Qs.HasFilter(op) {
+			continue
+		}
+
+		switch op {
+		case "in":
+			ret = append(ret, methods.NewInFilterMethod(fctx), methods.NewInQueryFilterMethod(fctx))
+		case "notin":
+			ret = append(ret, methods.NewNotInFilterMethod(fctx), methods.NewNotInQueryFilterMethod(fctx))
+		case "ieq":
+			ret = append(ret, methods.NewIEqMethod(fctx))
+		case "icontains":
+			ret = append(ret, methods.NewIContainsMethod(fctx))
+		case "startswith":
+			ret = append(ret, methods.NewStartsWithMethod(fctx))
+		case "endswith":
+			ret = append(ret, methods.NewEndsWithMethod(fctx))
+		case "between":
+			ret = append(ret, methods.NewBetweenMethod(fctx))
+		case "inrange":
+			ret = append(ret, methods.NewInRangeMethod(fctx))
+		case "within":
+			ret = append(ret, methods.NewWithinMethod(fctx))
+		case "jsoneq":
+			ret = append(ret, methods.NewJSONEqMethod(fctx))
+		case "isnull":
+			ret = append(ret, methods.NewIsNullMethod(fctx))
+		case "isnotnull":
+			ret = append(ret, methods.NewIsNotNullMethod(fctx))
+		default:
+			ret = append(ret, methods.NewBinaryFilterMethod(fctx.WithOperationName(op)))
+		}
+	}
+	return ret
+}
+
+// getPredicateMethodsForField returns methods creating predicates by field:
+// predicates are generated for filters with operations of getWhereCondition,
+// IN and NULL checks
+func (b *methodsBuilder) getPredicateMethodsForField(f field.Info) []methods.Method {
+	if f.IsNullable() {
+		ret := b.getPredicateMethodsForField(f.GetPointed())
+		if isComposite(f.GetPointed()) {
+			return ret
+		}
+		fctx := b.sctx.FieldCtx(f)
+		if f.Qs.HasFilter("isnull") {
+			ret = append(ret, methods.NewIsNullPredicateMethod(fctx))
+		}
+		if f.Qs.HasFilter("isnotnull") {
+			ret = append(ret, methods.NewIsNotNullPredicateMethod(fctx))
+		}
+		return ret
+	}
+
+	var ret []methods.Method
+	fctx := b.sctx.FieldCtx(f)
+	for _, op := range getFilterOperations(f) {
+		if !f.Qs.HasFilter(op) {
+			continue
+		}
+
+		switch op {
+		case "eq", "ne", "lt", "gt", "lte", "gte", "like", "notlike", "contains", "overlaps":
+			ret = append(ret, methods.NewBinaryPredicateMethod(fctx.WithOperationName(op)))
+		case "in":
+			ret = append(ret, methods.NewInPredicateMethod(fctx))
+		case "notin":
+			ret = append(ret, methods.NewNotInPredicateMethod(fctx))
+		case "isnull":
+			ret = append(ret, methods.NewIsNullPredicateMethod(fctx))
+		case "isnotnull":
+			ret = append(ret, methods.NewIsNotNullPredicateMethod(fctx))
+		}
+	}
+	return ret
+}
+
+// getPreloadMethods returns Preload<Field> method of association field f
+// and Preload<Field>Where if associated struct has queryset
+func (b *methodsBuilder) getPreloadMethods(f field.Info, assocTypeName string) []methods.Method {
+	if !f.Qs.HasPreload() {
+		return nil
+	}
+
+	fctx := b.sctx.FieldCtx(f)
+	ret := []methods.Method{methods.NewPreloadMethod(fctx)}
+	if qsName, ok := b.qsNames[assocTypeName]; ok {
+		ret = append(ret, methods.NewPreloadWhereMethod(fctx, qsName))
+	}
+	return ret
+}
+
+func (b *methodsBuilder) getQuerySetMethodsForField(f field.Info) []methods.Method {
+	ret := b.getFilterMethodsForField(f)
+
+	base := f
+	if f.IsNullable() {
+		base = f.GetPointed()
+	}
+	fctx := b.sctx.FieldCtx(base)
+
+	if base.IsStruct {
 		// Association was found (any struct or struct pointer)
-		return []methods.Method{methods.NewPreloadMethod(fctx)}
+		return append(ret, b.getPreloadMethods(f, base.TypeName)...)
+	}
+
+	if isComposite(base) {
+		// arrays, maps and JSON aren't ordered, grouped or selected by subquery
+		return ret
+	}
+
+	if !f.IsNullable() && !f.IsTime && f.Qs.HasFilter("in") {
+		// NULL values of nullable fields would break NOT IN subquery
+		ret = append(ret, methods.NewSelectSubqueryMethod(fctx))
+	}
+	if f.Qs.HasOrder() {
+		ret = append(ret,
+			methods.NewOrderAscByMethod(fctx),
+			methods.NewOrderDescByMethod(fctx))
+	}
+	if f.Qs.HasGroup() && !b.skip[MethodsGrouping] {
+		ret = append(ret, methods.NewGroupByMethod(fctx))
+	}
+	if base.IsNumeric && f.Qs.HasAggregates() {
+		ret = append(ret, b.getAggrMethodsForField(base)...)
+	}
+
+	return ret
+}
+
+func (b *methodsBuilder) getAggrMethodsForField(f field.Info) []methods.Method {
+	if b.skip[MethodsAggregates] {
+		return nil
+	}
+
+	if b.isSoftDelete(f) {
+		// gorm selects only rows with NULL in soft delete column
+		return nil
+	}
+
+	fctx := b.sctx.FieldCtx(f)
+	aggrMethods := []methods.Method{
+		methods.NewMinMethod(fctx),
+		methods.NewMaxMethod(fctx),
+	}
+	if !f.IsTime && !b.isKey(f) {
+		aggrMethods = append(aggrMethods,
+			methods.NewSumMethod(fctx),
+			methods.NewAvgMethod(fctx))
+	}
+
+	return aggrMethods
+}
+
+// isKey checks whether field is a primary key, a foreign key of belongs
+// to association or a primary key of joined association: sum and average
+// of keys are meaningless
+func (b *methodsBuilder) isKey(f field.Info) bool {
+	if pk := getPrimaryKey(b.fields); pk != nil && pk.Name == f.Name {
+		return true
+	}
+
+	for _, a := range b.assocs {
+		if fk, _ := a.getBelongsToKeys(b.fields); fk != nil && fk.Name == f.Name {
+			return true
+		}
+		if pk := getPrimaryKey(a.fields); pk != nil && a.field.Name+pk.Name == f.Name {
+			return true // joined fields are named with association name prefix
+		}
+	}
+
+	return false
+}
+
+// isSoftDelete checks whether field is a soft delete column of model
+// or of joined association
+func (b *methodsBuilder) isSoftDelete(f field.Info) bool {
+	if f.Name == "DeletedAt" {
+		return true
 	}
 
-	if f.IsPointer {
-		ptrMethods := b.getQuerySetMethodsForField(f.GetPointed())
-		return append(ptrMethods,
-			methods.NewIsNullMethod(fctx),
-			methods.NewIsNotNullMethod(fctx))
+	for _, a := range b.assocs {
+		if a.deletedAtDBName != "" && a.field.Name+"DeletedAt" == f.Name {
+			return true
+		}
 	}
 
-	// it's a string
-	return basicTypeMethods
+	return false
 }
 
 func (b *methodsBuilder) buildQuerySetFieldMethods(f field.Info) *methodsBuilder {
 	methods := b.getQuerySetMethodsForField(f)
 	b.ret = append(b.ret, methods...)
+	return b.buildCountByMethod(f)
+}
+
+func (b *methodsBuilder) buildCountByMethod(f field.Info) *methodsBuilder {
+	if b.skip[MethodsGrouping] || !f.Qs.HasGroup() || f.IsNullable() || !(f.IsNumeric || f.IsString) || f.IsTime {
+		// NULL can't be a key of result map, time.Time keys are compared
+		// with location, so they can't be looked up by time built by caller
+		return b
+	}
+
+	b.ret = append(b.ret, methods.NewCountByMethod(b.sctx.FieldCtx(f)))
 	return b
 }
 
-func getUpdaterTypeName(structTypeName string) string {
-	return structTypeName + "Updater"
+// withValidation wraps m to call validation if it's enabled
+func (b *methodsBuilder) withValidation(m methods.Method, validation string) methods.Method {
+	if !b.validate {
+		return m
+	}
+
+	return methods.NewValidatedMethod(m, validation)
 }
 
 func (b *methodsBuilder) buildUpdaterStructMethods() {
-	updaterTypeName := getUpdaterTypeName(b.s.TypeName)
+	if b.readOnly {
+		return
+	}
+
+	updaterTypeName := b.updaterTypeName()
 	b.ret = append(b.ret,
-		methods.NewUpdaterUpdateMethod(updaterTypeName),
-		methods.NewUpdaterUpdateNumMethod(updaterTypeName),
+		b.withValidation(methods.NewUpdaterUpdateMethod(updaterTypeName, b.backend),
+			`if err := u.Validate(); err != nil {
+				return err
+			}`),
+		b.withValidation(methods.NewUpdaterUpdateNumMethod(updaterTypeName, b.backend),
+			`if err := u.Validate(); err != nil {
+				return 0, err
+			}`),
+		methods.NewUpdaterInTxMethod(b.sctx, updaterTypeName),
+		methods.NewUpdaterWithContextMethod(updaterTypeName),
 	)
 }
 
 func (b *methodsBuilder) buildUpdaterFieldMethods(f field.Info) {
-	if f.IsPointer {
-		p := f.GetPointed()
-		if p.IsStruct {
-			// TODO
-			return
-		}
+	if b.readOnly {
+		return
+	}
 
-		// It's a pointer to simple field (string, int).
-		// Developer used pointer to distinguish between NULL and not NULL values.
+	if f.IsStruct || (f.IsPointer && f.GetPointed().IsStruct) {
+		// struct isn't a column: only foreign key of association is set
+		b.buildUpdaterAssocMethods(f)
+		return
 	}
+	// It's a simple field (string, int) or a pointer to it: developer
+	// used pointer to distinguish between NULL and not NULL values.
 
 	dbSchemaTypeName := b.s.TypeName + "DBSchema"
-	updaterTypeName := getUpdaterTypeName(b.s.TypeName)
+	if f.IsNullValue {
+		// value is set by Set<Field>(value), NULL by Set<Field>Null()
+		b.ret = append(b.ret,
+			methods.NewUpdaterSetMethod(f.Name, f.GetPointed().TypeName, b.updaterTypeName(),
+				dbSchemaTypeName),
+			methods.NewUpdaterSetNullMethod(f.Name, b.updaterTypeName(), dbSchemaTypeName))
+		return
+	}
+	if f.IsMarshaledToJSON() {
+		b.ret = append(b.ret,
+			methods.NewUpdaterSetJSONMethod(f.Name, f.TypeName, b.updaterTypeName(),
+				dbSchemaTypeName))
+		return
+	}
+
 	b.ret = append(b.ret,
-		methods.NewUpdaterSetMethod(f.Name, f.TypeName, updaterTypeName,
+		methods.NewUpdaterSetMethod(f.Name, f.TypeName, b.updaterTypeName(),
 			dbSchemaTypeName))
 }
 
+// buildUpdaterAssocMethods builds setters of foreign key for belongs to
+// association f: foreign key of has one association is in another table
+func (b *methodsBuilder) buildUpdaterAssocMethods(f field.Info) {
+	for _, a := range b.assocs {
+		if a.field.Name != f.Name {
+			continue
+		}
+		if fk, _ := a.getHasOneKeys(b.s.TypeName, b.fields); fk != nil {
+			return
+		}
+
+		fk, key := a.getBelongsToKeys(b.fields)
+		if fk == nil || fk.IsStruct || key.IsNullable() {
+			return
+		}
+
+		dbSchemaTypeName := b.s.TypeName + "DBSchema"
+		b.ret = append(b.ret, methods.NewUpdaterSetAssocMethod(f, *fk, *key,
+			b.updaterTypeName(), dbSchemaTypeName))
+		if fk.Name != f.Name+primaryKeyName {
+			// otherwise Set<Assoc>ID is a setter of foreign key field
+			b.ret = append(b.ret, methods.NewUpdaterSetAssocIDMethod(f.Name, *fk,
+				b.updaterTypeName(), dbSchemaTypeName))
+		}
+		return
+	}
+}
+
+// buildAssociationMethods builds methods of has many and many to many
+// associations: Preload<Field> of queryset and methods of model changing
+// and counting associated objects
+func (b *methodsBuilder) buildAssociationMethods() *methodsBuilder {
+	for _, a := range b.assocs {
+		spec := a.getAssocSpec(b.s.TypeName, b.fields)
+		if spec == nil {
+			// no foreign key or join table: gorm can't use this association too
+			continue
+		}
+
+		b.ret = append(b.ret, b.getPreloadMethods(a.field, a.typeName)...)
+		if b.skip[MethodsAssociations] {
+			continue
+		}
+
+		b.ret = append(b.ret, methods.NewCountAssociationMethod(b.sctx, *spec))
+		if b.readOnly {
+			continue
+		}
+		b.ret = append(b.ret,
+			methods.NewAppendAssociationMethod(b.sctx, *spec),
+			methods.NewReplaceAssociationMethod(b.sctx, *spec),
+			methods.NewRemoveAssociationMethod(b.sctx, *spec),
+			methods.NewClearAssociationMethod(b.sctx, *spec))
+	}
+
+	return b
+}
+
 func (b *methodsBuilder) buildStructSelectMethods() *methodsBuilder {
 	b.ret = append(b.ret,
-		methods.NewAllMethod(b.s.TypeName, b.qsTypeName()),
-		methods.NewOneMethod(b.s.TypeName, b.qsTypeName()),
+		methods.NewAllMethod(b.s.TypeName, b.qsTypeName(), b.backend),
+		methods.NewOneMethod(b.s.TypeName, b.qsTypeName(), b.backend),
 		methods.NewLimitMethod(b.qsTypeName()),
 		methods.NewOffsetMethod(b.qsTypeName()))
+	if !b.skip[MethodsIterate] {
+		b.ret = append(b.ret, methods.NewIterateMethod(b.sctx))
+	}
 	return b
 }
 
 func (b *methodsBuilder) buildAggrMethods() *methodsBuilder {
 	b.ret = append(b.ret,
-		methods.NewCountMethod(b.qsTypeName()))
+		methods.NewCountMethod(b.qsTypeName(), b.backend))
+	if b.skip[MethodsGrouping] {
+		return b
+	}
+
+	for _, op := range []string{"eq", "ne", "lt", "gt", "lte", "gte"} {
+		b.ret = append(b.ret, methods.NewHavingCountMethod(b.qsTypeName(), op))
+	}
 	return b
 }
 
 func (b *methodsBuilder) buildCRUDMethods() *methodsBuilder {
 	b.ret = append(b.ret,
-		methods.NewGetUpdaterMethod(b.qsTypeName(), getUpdaterTypeName(b.s.TypeName)),
-		methods.NewDeleteMethod(b.qsTypeName(), b.s.TypeName),
-		methods.NewStructModifierMethod("Create", b.s.TypeName),
-		methods.NewStructModifierMethod("Delete", b.s.TypeName),
-		methods.NewDeleteNumMethod(b.qsTypeName(), b.s.TypeName),
-		methods.NewDeleteNumUnscopedMethod(b.qsTypeName(), b.s.TypeName),
 		methods.NewGetDBMethod(b.qsTypeName()),
+		methods.NewQuerySetInTxMethod(b.sctx),
+		methods.NewQuerySetWithContextMethod(b.qsTypeName()),
+	)
+	if b.readOnly {
+		return b
+	}
+
+	b.ret = append(b.ret,
+		methods.NewGetUpdaterMethod(b.qsTypeName(), b.updaterTypeName()),
+		methods.NewDeleteMethod(b.qsTypeName(), b.s.TypeName, b.backend),
+		b.withValidation(methods.NewStructModifierMethod("Create", b.s.TypeName, b.backend),
+			`if err := o.Validate(); err != nil {
+				return err
+			}`),
+		methods.NewStructModifierMethod("Delete", b.s.TypeName, b.backend),
+		methods.NewDeleteNumMethod(b.qsTypeName(), b.s.TypeName, b.backend),
+		methods.NewDeleteNumUnscopedMethod(b.qsTypeName(), b.s.TypeName, b.backend),
 	)
 
 	return b
 }
 
+// joinSpec is a spec of join of association
+type joinSpec struct {
+	methods.JoinSpec
+	assoc assocInfo
+}
+
+// getJoinSpecs returns specs of associations which tables can be joined
+func (b methodsBuilder) getJoinSpecs() []joinSpec {
+	if b.skip[MethodsJoins] {
+		return nil
+	}
+
+	var ret []joinSpec
+	for _, a := range b.assocs {
+		spec := a.getJoinSpec(b.s.TypeName, b.fields)
+		if spec == nil {
+			// no foreign key: gorm can't use this association too
+			continue
+		}
+
+		ret = append(ret, joinSpec{JoinSpec: *spec, assoc: a})
+	}
+
+	return ret
+}
+
+func (b *methodsBuilder) buildJoinMethods() *methodsBuilder {
+	specs := b.getJoinSpecs()
+	for _, spec := range specs {
+		b.ret = append(b.ret,
+			methods.NewJoinMethod(b.sctx, spec.JoinSpec),
+			methods.NewLeftJoinMethod(b.sctx, spec.JoinSpec))
+		for _, f := range spec.assoc.getJoinedFields(spec.JoinSpec, b.fields) {
+			b.buildQuerySetFieldMethods(f)
+		}
+	}
+
+	if len(specs) != 0 {
+		b.ret = append(b.ret,
+			methods.NewQuotedTableNameMethod(b.sctx),
+			methods.NewColumnMethod(b.sctx))
+	}
+
+	return b
+}
+
+// getPrimaryKey returns field of primary key the same way as gorm does:
+// by primary_key tag or by name ID
+func getPrimaryKey(fields []field.Info) *field.Info {
+	for i := range fields {
+		tags := fields[i].TagSetting
+		if tags["PRIMARY_KEY"] != "" || tags["PRIMARYKEY"] != "" {
+			return &fields[i]
+		}
+	}
+
+	return findFieldByName(fields, "ID")
+}
+
+func (b *methodsBuilder) buildPageMethods() *methodsBuilder {
+	pk := getPrimaryKey(b.fields)
+	if pk == nil || b.skip[MethodsPagination] {
+		// ties can't be broken without primary key: pagination isn't stable
+		return b
+	}
+
+	b.ret = append(b.ret,
+		methods.NewPageMethod(b.sctx, pk.DBName),
+		methods.NewBatchesMethod(b.sctx))
+	return b
+}
+
+// getColumnFields returns fields stored in columns of table: all fields
+// except associations
+func (b methodsBuilder) getColumnFields() []field.Info {
+	var ret []field.Info
+	for _, f := range b.fields {
+		if f.IsStruct || (f.IsPointer && f.GetPointed().IsStruct) {
+			continue
+		}
+		ret = append(ret, f)
+	}
+
+	return ret
+}
+
+func (b *methodsBuilder) buildBulkMethods() *methodsBuilder {
+	pkDBName := ""
+	if pk := getPrimaryKey(b.fields); pk != nil {
+		pkDBName = pk.DBName
+	}
+
+	// fieldPtrs is used by Page and Upsert too
+	b.ret = append(b.ret, methods.NewFieldPtrsMethod(b.sctx, b.getColumnFields()))
+	if b.skip[MethodsBulk] || b.readOnly {
+		return b
+	}
+
+	b.ret = append(b.ret,
+		b.withValidation(methods.NewCreateManyMethod(b.sctx, pkDBName),
+			`for i := range models {
+				if err := models[i].Validate(); err != nil {
+					return err
+				}
+			}`))
+	return b
+}
+
+// BuildPredicates returns methods of <Struct>Predicates type
+func (b methodsBuilder) BuildPredicates() []methods.Method {
+	var ret []methods.Method
+	for _, f := range b.fields {
+		ret = append(ret, b.getPredicateMethodsForField(f)...)
+	}
+
+	return ret
+}
+
 func (b methodsBuilder) Build() []methods.Method {
 	b.buildStructSelectMethods().
+		buildPageMethods().
+		buildBulkMethods().
 		buildAggrMethods().
 		buildCRUDMethods().
+		buildJoinMethods().
+		buildAssociationMethods().
 		buildUpdaterStructMethods()
 
 	for _, f := range b.fields {
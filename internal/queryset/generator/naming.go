@@ -0,0 +1,108 @@
+package generator
+
+import (
+	"fmt"
+	"go/token"
+	"strings"
+)
+
+// structNamePlaceholder is replaced by struct name in naming templates
+const structNamePlaceholder = "{Struct}"
+
+// Naming contains templates of generated type names, e.g. "{Struct}QuerySet".
+// Empty template means the default one.
+type Naming struct {
+	QuerySet string
+	Updater  string
+}
+
+// DefaultNaming is a naming of generated types used by default
+var DefaultNaming = Naming{
+	QuerySet: structNamePlaceholder + "QuerySet",
+	Updater:  structNamePlaceholder + "Updater",
+}
+
+func validateNameTemplate(tmpl string) error {
+	if tmpl == "" {
+		return nil
+	}
+
+	if strings.Count(tmpl, structNamePlaceholder) != 1 {
+		return fmt.Errorf("template %q must contain %s exactly once", tmpl, structNamePlaceholder)
+	}
+	if !token.IsIdentifier(strings.Replace(tmpl, structNamePlaceholder, "X", 1)) {
+		return fmt.Errorf("template %q doesn't produce valid identifier", tmpl)
+	}
+
+	return nil
+}
+
+// Validate checks that templates produce valid and distinct type names
+func (n Naming) Validate() error {
+	if err := validateNameTemplate(n.QuerySet); err != nil {
+		return fmt.Errorf("invalid queryset naming: %s", err)
+	}
+	if err := validateNameTemplate(n.Updater); err != nil {
+		return fmt.Errorf("invalid updater naming: %s", err)
+	}
+
+	if n.querySetName("X") == n.updaterName("X") {
+		return fmt.Errorf("queryset and updater naming templates are the same")
+	}
+
+	return nil
+}
+
+func applyNameTemplate(tmpl, defaultTmpl, structName string) string {
+	if tmpl == "" {
+		tmpl = defaultTmpl
+	}
+	return strings.Replace(tmpl, structNamePlaceholder, structName, 1)
+}
+
+func (n Naming) querySetName(structName string) string {
+	return applyNameTemplate(n.QuerySet, DefaultNaming.QuerySet, structName)
+}
+
+func (n Naming) updaterName(structName string) string {
+	return applyNameTemplate(n.Updater, DefaultNaming.Updater, structName)
+}
+
+// MethodFamily is a group of generated methods which generation can be
+// disabled, e.g. to reduce size of generated code
+type MethodFamily string
+
+// Method families
+const (
+	MethodsAggregates   MethodFamily = "aggregates"   // Sum, Avg, Min and Max
+	MethodsGrouping     MethodFamily = "grouping"     // GroupBy, HavingCount and CountBy
+	MethodsPagination   MethodFamily = "pagination"   // Page and Batches
+	MethodsIterate      MethodFamily = "iterate"      // Iterate
+	MethodsBulk         MethodFamily = "bulk"         // CreateMany
+	MethodsUpsert       MethodFamily = "upsert"       // Upsert
+	MethodsJoins        MethodFamily = "joins"        // Join and LeftJoin
+	MethodsAssociations MethodFamily = "associations" // Append, Replace, Remove, Clear and Count<Assoc>
+)
+
+// MethodFamilies are all method families
+var MethodFamilies = []MethodFamily{
+	MethodsAggregates,
+	MethodsGrouping,
+	MethodsPagination,
+	MethodsIterate,
+	MethodsBulk,
+	MethodsUpsert,
+	MethodsJoins,
+	MethodsAssociations,
+}
+
+// ParseMethodFamily parses name of method family
+func ParseMethodFamily(name string) (MethodFamily, error) {
+	for _, f := range MethodFamilies {
+		if string(f) == name {
+			return f, nil
+		}
+	}
+
+	return "", fmt.Errorf("unknown method family %q, must be one of %v", name, MethodFamilies)
+}
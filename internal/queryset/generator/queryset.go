@@ -4,9 +4,11 @@ import (
 	"bytes"
 	"fmt"
 	"go/ast"
+	"go/token"
 	"go/types"
 	"io"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/jirfag/go-queryset/internal/parser"
@@ -15,10 +17,31 @@ import (
 )
 
 type querySetStructConfig struct {
-	StructName string
-	Name       string
-	Methods    methodsSlice
-	Fields     []field.Info
+	StructName  string
+	Name        string
+	UpdaterName string
+	Methods     methodsSlice
+	Predicates  methodsSlice // methods of predicates type
+	Fields      []field.Info
+	Backend     methods.Backend
+	PrimaryKey  string // column of primary key, if any
+
+	// QualifyColumns is set if queryset can join other tables: own columns
+	// are qualified by table of model then
+	QualifyColumns bool
+
+	Validate    bool // Validate methods are generated and called before writes
+	Validations []fieldValidation
+
+	WithGrouping bool // GroupBy method is generated
+	WithUpsert   bool // Upsert method is generated
+	WithUpdate   bool // Update method of struct is generated
+	WithUpdater  bool // updater type is generated
+}
+
+// PredicateTablePlaceholder returns placeholder of table in predicates
+func (c querySetStructConfig) PredicateTablePlaceholder() string {
+	return methods.PredicateTablePlaceholder
 }
 
 type methodsSlice []methods.Method
@@ -44,83 +67,413 @@ func (s querySetStructConfigSlice) Less(i, j int) bool {
 }
 func (s querySetStructConfigSlice) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
 
-func doesNeedToGenerateQuerySet(doc *ast.CommentGroup) bool {
+// qsAnnotation is a parsed "gen:qs" doc-comment line with its options,
+// e.g. "gen:qs backend=gorm2 name=Users skip=Delete,DeleteNum readonly"
+type qsAnnotation struct {
+	backend  methods.Backend
+	validate bool
+	name     string   // name of queryset type
+	skip     []string // names of methods to not generate
+	readOnly bool     // no updater and methods writing to database
+}
+
+func parseQuerySetAnnotationOption(a *qsAnnotation, opt string) error {
+	if opt == "readonly" {
+		a.readOnly = true
+		return nil
+	}
+
+	kv := strings.SplitN(opt, "=", 2)
+	if len(kv) != 2 {
+		return fmt.Errorf("invalid option %q: must be in form key=value", opt)
+	}
+
+	switch kv[0] {
+	case "name":
+		if !token.IsIdentifier(kv[1]) {
+			return fmt.Errorf("invalid name %q: must be identifier", kv[1])
+		}
+		a.name = kv[1]
+	case "skip":
+		for _, name := range strings.Split(kv[1], ",") {
+			if !token.IsIdentifier(name) {
+				return fmt.Errorf("invalid method name %q", name)
+			}
+			a.skip = append(a.skip, name)
+		}
+	case "readonly":
+		readOnly, err := strconv.ParseBool(kv[1])
+		if err != nil {
+			return fmt.Errorf("invalid value of readonly: %s", err)
+		}
+		a.readOnly = readOnly
+	case "backend":
+		backend, err := methods.ParseBackend(kv[1])
+		if err != nil {
+			return err
+		}
+		a.backend = backend
+	case "validate":
+		validate, err := strconv.ParseBool(kv[1])
+		if err != nil {
+			return fmt.Errorf("invalid value of validate: %s", err)
+		}
+		a.validate = validate
+	default:
+		return fmt.Errorf("unknown option %q", kv[0])
+	}
+
+	return nil
+}
+
+// parseQuerySetAnnotation returns nil if there is no "gen:qs" line in doc
+func parseQuerySetAnnotation(doc *ast.CommentGroup) (*qsAnnotation, error) {
 	if doc == nil {
-		return false
+		return nil, nil
 	}
 
 	for _, c := range doc.List {
 		parts := strings.Split(strings.TrimSpace(c.Text), ":")
-		ok := len(parts) == 2 &&
-			strings.TrimSpace(strings.TrimPrefix(parts[0], "//")) == "gen" &&
-			strings.TrimSpace(parts[1]) == "qs"
-		if ok {
-			return true
+		if len(parts) != 2 || strings.TrimSpace(strings.TrimPrefix(parts[0], "//")) != "gen" {
+			continue
 		}
+
+		words := strings.Fields(parts[1])
+		if len(words) == 0 || words[0] != "qs" {
+			continue
+		}
+
+		a := &qsAnnotation{}
+		for _, opt := range words[1:] {
+			if err := parseQuerySetAnnotationOption(a, opt); err != nil {
+				return nil, fmt.Errorf("can't parse %q: %s", c.Text, err)
+			}
+		}
+		return a, nil
 	}
 
-	return false
+	return nil, nil
 }
 
-func genStructFieldInfos(s parser.ParsedStruct, types *types.Package) (ret []field.Info) {
+func genStructFieldInfos(s parser.ParsedStruct, types *types.Package) ([]field.Info, error) {
+	var ret []field.Info
 	g := field.NewInfoGenerator(types)
 	for _, f := range s.Fields {
-		fi := g.GenFieldInfo(f)
+		fi, err := g.GenFieldInfo(f)
+		if err != nil {
+			return nil, err
+		}
 		if fi == nil {
 			continue
 		}
 		ret = append(ret, *fi)
 	}
-	return ret
+	return ret, nil
+}
+
+// getStructAnnotation returns nil if queryset isn't generated for struct s
+func getStructAnnotation(s parser.ParsedStruct, opts Options) (*qsAnnotation, error) {
+	a, err := parseQuerySetAnnotation(s.Doc)
+	if err != nil {
+		return nil, fmt.Errorf("invalid annotation of struct %s: %s", s.TypeName, err)
+	}
+	if len(opts.Structs) == 0 {
+		return a, nil
+	}
+
+	for _, name := range opts.Structs {
+		if name != s.TypeName {
+			continue
+		}
+
+		if a == nil {
+			a = &qsAnnotation{}
+		}
+		return a, nil
+	}
+
+	return nil, nil // struct isn't listed
 }
 
 func generateQuerySetConfigs(types *types.Package,
-	structs map[string]parser.ParsedStruct) querySetStructConfigSlice {
+	structs map[string]parser.ParsedStruct, opts Options) (querySetStructConfigSlice, error) {
+
+	for _, name := range opts.Structs {
+		if _, ok := structs[name]; !ok {
+			return nil, fmt.Errorf("no struct %s to generate queryset for", name)
+		}
+	}
+
+	annotations := map[string]qsAnnotation{}
+	qsNames := map[string]string{} // struct name -> queryset name
+	for _, s := range structs {
+		a, err := getStructAnnotation(s, opts)
+		if err != nil {
+			return nil, err
+		}
+		if a == nil {
+			continue
+		}
+
+		annotations[s.TypeName] = *a
+		qsNames[s.TypeName] = querySetName(s, *a, opts)
+	}
 
 	querySetStructConfigs := querySetStructConfigSlice{}
 
 	for _, s := range structs {
-		if !doesNeedToGenerateQuerySet(s.Doc) {
+		a, ok := annotations[s.TypeName]
+		if !ok {
 			continue
 		}
 
-		fields := genStructFieldInfos(s, types)
-		b := newMethodsBuilder(s, fields)
-		methods := b.Build()
+		qsConfig, err := generateQuerySetConfig(types, s, a, opts, qsNames)
+		if err != nil {
+			return nil, fmt.Errorf("can't generate queryset of struct %s: %s", s.TypeName, err)
+		}
+		querySetStructConfigs = append(querySetStructConfigs, *qsConfig)
+	}
+
+	return querySetStructConfigs, nil
+}
+
+// querySetName returns name of queryset type of struct s
+func querySetName(s parser.ParsedStruct, a qsAnnotation, opts Options) string {
+	if a.name != "" {
+		return a.name
+	}
+
+	return opts.Naming.querySetName(s.TypeName)
+}
+
+// generateQuerySetConfig generates queryset of struct s, qsNames are names
+// of querysets of all structs of the package by struct names
+func generateQuerySetConfig(types *types.Package, s parser.ParsedStruct,
+	a qsAnnotation, opts Options, qsNames map[string]string) (*querySetStructConfig, error) {
+
+	backend := opts.Backend
+	if a.backend != "" {
+		backend = a.backend
+	}
+
+	qsName := qsNames[s.TypeName]
+	updaterName := opts.Naming.updaterName(s.TypeName)
+	if qsName == s.TypeName || qsName == updaterName {
+		return nil, fmt.Errorf("queryset name %s conflicts with struct or updater name", qsName)
+	}
+
+	fields, err := genStructFieldInfos(s, types)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range fields {
+		if err = validateQsTag(f); err != nil {
+			return nil, err
+		}
+	}
+
+	assocs, err := genStructAssocInfos(s, types)
+	if err != nil {
+		return nil, err
+	}
 
-		qsConfig := querySetStructConfig{
-			StructName: s.TypeName,
-			Name:       s.TypeName + "QuerySet",
-			Methods:    methods,
-			Fields:     fields,
+	var validations []fieldValidation
+	if a.validate {
+		validations, err = getFieldValidations(fields)
+		if err != nil {
+			return nil, fmt.Errorf("can't generate validation: %s", err)
 		}
-		sort.Sort(qsConfig.Methods) // make output queryset stable
-		querySetStructConfigs = append(querySetStructConfigs, qsConfig)
 	}
 
-	return querySetStructConfigs
+	b := newMethodsBuilder(s, fields, assocs, backend, qsName, updaterName)
+	b.qsNames = qsNames
+	b.validate = a.validate
+	b.skip = opts.SkipMethods
+	b.readOnly = a.readOnly
+	qualifyColumns := len(b.getJoinSpecs()) != 0
+	if qualifyColumns {
+		b.sctx = b.sctx.WithQualifiedColumns()
+	}
+
+	qsConfig := querySetStructConfig{
+		StructName:  s.TypeName,
+		Name:        qsName,
+		UpdaterName: updaterName,
+		Methods:     b.Build(),
+		Predicates:  b.BuildPredicates(),
+		Fields:      fields,
+		Backend:     backend,
+
+		QualifyColumns: qualifyColumns,
+
+		Validate:    a.validate,
+		Validations: validations,
+
+		WithGrouping: !opts.SkipMethods[MethodsGrouping],
+		WithUpsert:   !opts.SkipMethods[MethodsUpsert] && !a.readOnly,
+		WithUpdate:   !a.readOnly,
+		WithUpdater:  !a.readOnly,
+	}
+	if err = qsConfig.skipMethods(a.skip); err != nil {
+		return nil, err
+	}
+
+	if pk := getPrimaryKey(fields); pk != nil {
+		qsConfig.PrimaryKey = pk.DBName
+	}
+	sort.Sort(qsConfig.Methods) // make output queryset stable
+	sort.Sort(qsConfig.Predicates)
+	return &qsConfig, nil
+}
+
+// dependentMethods are methods calling other method: they are skipped
+// together with it
+var dependentMethods = map[string][]string{
+	"Page": {"Batches"},
+}
+
+// skipMethods removes methods with names, names must be names of
+// generated exported methods
+func (c *querySetStructConfig) skipMethods(names []string) error {
+	// methods declared in template
+	generated := map[string]bool{
+		"GroupBy": c.WithGrouping,
+		"Update":  c.WithUpdate,
+		"Upsert":  c.WithUpsert,
+	}
+	for _, m := range c.Methods {
+		if ast.IsExported(m.GetMethodName()) {
+			generated[m.GetMethodName()] = true
+		}
+	}
+
+	skipped := map[string]bool{}
+	for _, name := range names {
+		if !generated[name] {
+			return fmt.Errorf("can't skip method %s: it isn't generated", name)
+		}
+		skipped[name] = true
+		for _, dep := range dependentMethods[name] {
+			skipped[dep] = true
+		}
+	}
+
+	var ret methodsSlice
+	for _, m := range c.Methods {
+		if !skipped[m.GetMethodName()] {
+			ret = append(ret, m)
+		}
+	}
+	c.Methods = ret
+
+	c.WithGrouping = c.WithGrouping && !skipped["GroupBy"]
+	c.WithUpdate = c.WithUpdate && !skipped["Update"]
+	c.WithUpsert = c.WithUpsert && !skipped["Upsert"]
+	return nil
+}
+
+// getCommonBackend returns backend of all querysets: all of them are written
+// into one file and share one gorm import
+func getCommonBackend(configs querySetStructConfigSlice) (methods.Backend, error) {
+	backend := configs[0].Backend
+	for _, c := range configs[1:] {
+		if c.Backend != backend {
+			return "", fmt.Errorf("struct %s uses backend %s, but struct %s uses backend %s: "+
+				"they can't be generated into one file", configs[0].StructName, backend,
+				c.StructName, c.Backend)
+		}
+	}
+
+	return backend, nil
+}
+
+// Options are options of querysets generation
+type Options struct {
+	// Backend is used for structs without backend annotation
+	Backend methods.Backend
+
+	// SkipHelpers disables generation of package-level helpers (WithTx,
+	// DBWithContext), e.g. because they're already generated into another
+	// file of the package
+	SkipHelpers bool
+
+	// Structs are names of structs to generate querysets for, annotation
+	// "gen:qs" isn't required for them. Empty means all annotated structs.
+	Structs []string
+
+	// Naming contains templates of generated type names
+	Naming Naming
+
+	// SkipMethods are method families which aren't generated
+	SkipMethods map[MethodFamily]bool
+
+	// IsDeclared checks whether shared package-level type (e.g. subquery
+	// type) is already declared in another file of the package
+	IsDeclared func(name string) bool
+}
+
+// getSubqueryTypes returns sorted names of subquery types used by methods
+// of querysets and not declared in other files of the package
+func getSubqueryTypes(configs querySetStructConfigSlice, isDeclared func(name string) bool) []string {
+	names := map[string]bool{}
+	for _, c := range configs {
+		for _, m := range c.Methods {
+			sm, ok := m.(methods.SubqueryMethod)
+			if !ok {
+				continue
+			}
+			name := sm.GetSubqueryTypeName()
+			if isDeclared == nil || !isDeclared(name) {
+				names[name] = true
+			}
+		}
+	}
+
+	var ret []string
+	for name := range names {
+		ret = append(ret, name)
+	}
+	sort.Strings(ret)
+	return ret
 }
 
 // GenerateQuerySetsForStructs is an internal method to retrieve querysets
-// generated code from parsed structs
-func GenerateQuerySetsForStructs(types *types.Package, structs map[string]parser.ParsedStruct) (io.Reader, error) {
-	querySetStructConfigs := generateQuerySetConfigs(types, structs)
+// generated code from parsed structs. It also returns backend the code was
+// generated for.
+func GenerateQuerySetsForStructs(types *types.Package, structs map[string]parser.ParsedStruct,
+	opts Options) (io.Reader, methods.Backend, error) {
+
+	querySetStructConfigs, err := generateQuerySetConfigs(types, structs, opts)
+	if err != nil {
+		return nil, "", err
+	}
 	if len(querySetStructConfigs) == 0 {
-		return nil, nil
+		return nil, "", nil
 	}
 
 	sort.Sort(querySetStructConfigs)
 
+	backend, err := getCommonBackend(querySetStructConfigs)
+	if err != nil {
+		return nil, "", err
+	}
+
 	var b bytes.Buffer
-	err := qsTmpl.Execute(&b, struct {
-		Configs querySetStructConfigSlice
+	err = qsTmpl.Execute(&b, struct {
+		Configs     querySetStructConfigSlice
+		Backend     methods.Backend
+		WithHelpers bool
+		Subqueries  []string
 	}{
-		Configs: querySetStructConfigs,
+		Configs:     querySetStructConfigs,
+		Backend:     backend,
+		WithHelpers: !opts.SkipHelpers,
+		Subqueries:  getSubqueryTypes(querySetStructConfigs, opts.IsDeclared),
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("can't generate structs query sets: %s", err)
+		return nil, "", fmt.Errorf("can't generate structs query sets: %s", err)
 	}
 
-	return &b, nil
+	return &b, backend, nil
 }
@@ -4,7 +4,12 @@ import (
 	"context"
 	"database/sql"
 	"database/sql/driver"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"go/ast"
+	"go/format"
+	"io/ioutil"
 	"log"
 	"math/rand"
 	"os"
@@ -17,13 +22,19 @@ import (
 	"time"
 
 	"github.com/jirfag/go-queryset/internal/parser"
+	"github.com/jirfag/go-queryset/internal/queryset/field"
 
 	"github.com/jinzhu/gorm"
 	_ "github.com/jinzhu/gorm/dialects/mysql"
 	"github.com/jirfag/go-queryset/internal/queryset/generator/test"
+	"github.com/jirfag/go-queryset/internal/queryset/generator/test/gorm2"
+	"github.com/jirfag/go-queryset/internal/queryset/methods"
 	assert "github.com/stretchr/testify/require"
 
 	sqlmock "gopkg.in/DATA-DOG/go-sqlmock.v1"
+	gormv2 "gorm.io/gorm"
+	gormv2logger "gorm.io/gorm/logger"
+	gormv2tests "gorm.io/gorm/utils/tests"
 )
 
 const testSurname = "Ivanov"
@@ -127,10 +138,38 @@ func TestQueries(t *testing.T) {
 		testUserDeleteByEmail,
 		testUserDeleteByPK,
 		testUserQueryFilters,
+		testUserPredicateError,
 		testUsersCount,
+		testAccountsAggregates,
+		testPostsTimeAggregates,
+		testPostsCountBy,
+		testPostsGroupByHaving,
+		testUsersPage,
+		testUsersIterate,
+		testUsersBatches,
+		testUsersCreateMany,
+		testCommentsCreateMany,
+		testDocumentsCreateMany,
+		testUserUpsert,
+		testAccountValidation,
+		testProfileNullValues,
+		testPostSetBlog,
+		testUserPostsAssociation,
+		testUsersPreloadPostsWhere,
 		testUsersUpdateNum,
 		testUsersDeleteNum,
 		testUsersDeleteNumUnscoped,
+		testPostsJoinBlog,
+		testPostsJoinBlogOwnColumns,
+		testPostsLeftJoinUser,
+		testPostsUserIDInQuery,
+		testWithTxCommit,
+		testWithTxRollback,
+		testWithTxNestedSavepoint,
+		testInTxAfterConditions,
+		testInTxKeepsContextAndPreloads,
+		testWithContextCanceled,
+		testWithContextActive,
 	}
 	for _, f := range funcs {
 		f := f // save range var
@@ -268,6 +307,77 @@ func testUserQueryFilters(t *testing.T, m sqlmock.Sqlmock, db *gorm.DB) {
 				return qs.NameNotIn("a", "b")
 			},
 		},
+		{
+			q:    "((name LIKE ? ESCAPE '!'))",
+			args: []driver.Value{"a!%b!_c!!![%"},
+			qs: func(qs test.UserQuerySet) test.UserQuerySet {
+				return qs.NameStartsWith("a%b_c![")
+			},
+		},
+		{
+			q:    "((name LIKE ? ESCAPE '!'))",
+			args: []driver.Value{"%a"},
+			qs: func(qs test.UserQuerySet) test.UserQuerySet {
+				return qs.NameEndsWith("a")
+			},
+		},
+		{
+			q:    "((LOWER(name) LIKE LOWER(?) ESCAPE '!'))",
+			args: []driver.Value{"%a!%%"},
+			qs: func(qs test.UserQuerySet) test.UserQuerySet {
+				return qs.NameIContains("a%")
+			},
+		},
+		{
+			q:    "((LOWER(name) LIKE LOWER(?) ESCAPE '!'))",
+			args: []driver.Value{"A!_b"},
+			qs: func(qs test.UserQuerySet) test.UserQuerySet {
+				return qs.NameIEq("A_b")
+			},
+		},
+		{
+			q:    "((id BETWEEN ? AND ?))",
+			args: []driver.Value{1, 5},
+			qs: func(qs test.UserQuerySet) test.UserQuerySet {
+				return qs.IDBetween(1, 5)
+			},
+		},
+		{
+			q:    "((id >= ? AND id < ?))",
+			args: []driver.Value{1, 5},
+			qs: func(qs test.UserQuerySet) test.UserQuerySet {
+				return qs.IDInRange(1, 5)
+			},
+		},
+		{
+			q:    "((created_at >= ?))",
+			args: []driver.Value{sqlmock.AnyArg()},
+			qs: func(qs test.UserQuerySet) test.UserQuerySet {
+				return qs.CreatedAtWithin(time.Hour)
+			},
+		},
+		{
+			q:    "(((name = ?) OR ((email LIKE ?) AND (user_surname IS NULL))))",
+			args: []driver.Value{"a", "%b"},
+			qs: func(qs test.UserQuerySet) test.UserQuerySet {
+				return qs.Where(test.UserQ.NameEq("a").Or(
+					test.UserQ.EmailLike("%b").And(test.UserQ.SurnameIsNull())))
+			},
+		},
+		{
+			q:    "((NOT ((name IN (?,?)) OR (id > ?))))",
+			args: []driver.Value{"a", "b", 5},
+			qs: func(qs test.UserQuerySet) test.UserQuerySet {
+				return qs.Not(test.UserQ.NameIn("a", "b").Or(test.UserQ.IDGt(5)))
+			},
+		},
+		{
+			q:    "((name = ?))",
+			args: []driver.Value{"a"},
+			qs: func(qs test.UserQuerySet) test.UserQuerySet {
+				return qs.Where(test.UserPredicate{}).Where(test.UserPredicate{}.Or(test.UserQ.NameEq("a")))
+			},
+		},
 	}
 	for _, c := range cases {
 		t.Run(c.q, func(t *testing.T) {
@@ -276,6 +386,22 @@ func testUserQueryFilters(t *testing.T, m sqlmock.Sqlmock, db *gorm.DB) {
 	}
 }
 
+func testUserPredicateError(t *testing.T, m sqlmock.Sqlmock, db *gorm.DB) {
+	var users []test.User
+	err := test.NewUserQuerySet(db).Where(test.UserQ.IDEq(1).Or(test.UserQ.NameIn())).All(&users)
+	assert.EqualError(t, err, "must at least pass one name in NameIn")
+
+	// error doesn't break base queryset
+	req := "SELECT * FROM `users` WHERE `users`.`deleted_at` IS NULL AND ((name = ?))"
+	m.ExpectQuery(fixedFullRe(req)).WithArgs("a").
+		WillReturnRows(getRowsForUsers(getTestUsers(1)))
+
+	base := test.NewUserQuerySet(db).NameEq("a")
+	assert.Error(t, base.Where(test.UserQ.NameIn()).All(&users))
+	assert.Nil(t, base.All(&users))
+	assert.Len(t, users, 1)
+}
+
 func runUserQueryFilterSubTest(t *testing.T, c userQueryTestCase, m sqlmock.Sqlmock, db *gorm.DB) {
 	expUsers := getTestUsers(5)
 	req := "SELECT * FROM `users` WHERE `users`.`deleted_at` IS NULL AND " + c.q
@@ -425,6 +551,1008 @@ func testUsersCount(t *testing.T, m sqlmock.Sqlmock, db *gorm.DB) {
 	assert.Equal(t, expCount, cnt)
 }
 
+func testAccountsAggregates(t *testing.T, m sqlmock.Sqlmock, db *gorm.DB) {
+	req := "SELECT SUM(age) AS value FROM `accounts` WHERE `accounts`.`deleted_at` IS NULL AND ((role = ?))"
+	m.ExpectQuery(fixedFullRe(req)).WithArgs("user").
+		WillReturnRows(sqlmock.NewRows([]string{"value"}).AddRow(70))
+	sum, err := test.NewAccountQuerySet(db).RoleEq("user").SumAge()
+	assert.Nil(t, err)
+	assert.Equal(t, 70, sum)
+
+	req = "SELECT AVG(age) AS value FROM `accounts` WHERE `accounts`.`deleted_at` IS NULL"
+	m.ExpectQuery(fixedFullRe(req)).
+		WillReturnRows(sqlmock.NewRows([]string{"value"}).AddRow(20.5))
+	avg, err := test.NewAccountQuerySet(db).AvgAge()
+	assert.Nil(t, err)
+	assert.Equal(t, 20.5, avg)
+
+	// no rows: NULL is returned
+	req = "SELECT MAX(age) AS value FROM `accounts` WHERE `accounts`.`deleted_at` IS NULL"
+	m.ExpectQuery(fixedFullRe(req)).
+		WillReturnRows(sqlmock.NewRows([]string{"value"}).AddRow(nil))
+	maxAge, err := test.NewAccountQuerySet(db).MaxAge()
+	assert.Nil(t, err)
+	assert.Zero(t, maxAge)
+}
+
+func testPostsTimeAggregates(t *testing.T, m sqlmock.Sqlmock, db *gorm.DB) {
+	// time is selected by order: it's scanned from column, not from result of MAX
+	req := "SELECT `posts`.created_at AS value FROM `posts` WHERE `posts`.`deleted_at` IS NULL AND " +
+		"((`posts`.blog_id = ?) AND (`posts`.created_at IS NOT NULL)) ORDER BY `posts`.created_at DESC LIMIT 1"
+	createdAt := time.Now().Round(time.Second)
+	m.ExpectQuery(fixedFullRe(req)).WithArgs(driver.Value(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"value"}).AddRow(createdAt))
+	maxCreatedAt, err := test.NewPostQuerySet(db).BlogIDEq(1).OrderAscByID().MaxCreatedAt()
+	assert.Nil(t, err)
+	assert.Equal(t, createdAt, maxCreatedAt)
+
+	// no rows: zero time is returned
+	req = "SELECT `posts`.created_at AS value FROM `posts` WHERE `posts`.`deleted_at` IS NULL AND " +
+		"((`posts`.created_at IS NOT NULL)) ORDER BY `posts`.created_at ASC LIMIT 1"
+	m.ExpectQuery(fixedFullRe(req)).
+		WillReturnRows(sqlmock.NewRows([]string{"value"}))
+	minCreatedAt, err := test.NewPostQuerySet(db).MinCreatedAt()
+	assert.Nil(t, err)
+	assert.True(t, minCreatedAt.IsZero())
+
+	// soft deleted rows are never selected: aggregates of DeletedAt are always zero
+	for _, name := range []string{"MinDeletedAt", "MaxDeletedAt", "MinBlogDeletedAt", "MaxUserDeletedAt"} {
+		_, ok := reflect.TypeOf(test.PostQuerySet{}).MethodByName(name)
+		assert.False(t, ok, name)
+	}
+}
+
+func testPostsCountBy(t *testing.T, m sqlmock.Sqlmock, db *gorm.DB) {
+	req := "SELECT `posts`.user_id AS value, COUNT(*) AS count FROM `posts` " +
+		"WHERE `posts`.`deleted_at` IS NULL GROUP BY `posts`.user_id"
+	m.ExpectQuery(fixedFullRe(req)).
+		WillReturnRows(sqlmock.NewRows([]string{"value", "count"}).AddRow(1, 3).AddRow(2, 5))
+
+	counts, err := test.NewPostQuerySet(db).CountByUserID()
+	assert.Nil(t, err)
+	assert.Equal(t, map[uint]int{1: 3, 2: 5}, counts)
+
+	// time.Time keys can't be looked up by time with another location
+	qsType := reflect.TypeOf(test.PostQuerySet{})
+	_, ok := qsType.MethodByName("CountByCreatedAt")
+	assert.False(t, ok)
+	_, ok = qsType.MethodByName("GroupByCreatedAt")
+	assert.True(t, ok)
+}
+
+func testPostsGroupByHaving(t *testing.T, m sqlmock.Sqlmock, db *gorm.DB) {
+	req := "SELECT `posts`.user_id FROM `posts` WHERE `posts`.`deleted_at` IS NULL " +
+		"GROUP BY `posts`.user_id,`posts`.blog_id HAVING (COUNT(*) > ?)"
+	m.ExpectQuery(fixedFullRe(req)).WithArgs(driver.Value(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id"}).AddRow(1))
+
+	var posts []test.Post
+	err := test.NewPostQuerySet(db).
+		Select(test.PostDBSchema.UserID).
+		GroupBy(test.PostDBSchema.UserID, test.PostDBSchema.BlogID).
+		HavingCountGt(1).
+		All(&posts)
+	assert.Nil(t, err)
+	assert.Len(t, posts, 1)
+
+	req = "SELECT * FROM `posts` WHERE `posts`.`deleted_at` IS NULL GROUP BY `posts`.user_id"
+	m.ExpectQuery(fixedFullRe(req)).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id"}))
+	assert.Nil(t, test.NewPostQuerySet(db).GroupByUserID().All(&posts))
+}
+
+func testUsersPage(t *testing.T, m sqlmock.Sqlmock, db *gorm.DB) {
+	req := "SELECT * FROM `users` WHERE `users`.`deleted_at` IS NULL " +
+		"ORDER BY name DESC,id ASC LIMIT 3"
+	m.ExpectQuery(fixedFullRe(req)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).
+			AddRow(5, "b").AddRow(7, "a").AddRow(8, "a"))
+
+	qs := test.NewUserQuerySet(db).OrderDescByName()
+	var users []test.User
+	cursor, err := qs.Page("", 2, &users)
+	assert.Nil(t, err)
+	assert.Len(t, users, 2)
+	assert.NotEmpty(t, cursor)
+
+	req = "SELECT * FROM `users` WHERE `users`.`deleted_at` IS NULL " +
+		"AND (((name < ?) OR (name = ? AND id > ?))) ORDER BY name DESC,id ASC LIMIT 3"
+	m.ExpectQuery(fixedFullRe(req)).WithArgs("a", "a", 7).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(8, "a"))
+
+	cursor, err = qs.Page(cursor, 2, &users)
+	assert.Nil(t, err)
+	assert.Len(t, users, 1)
+	assert.Empty(t, cursor)
+
+	_, err = qs.Page("invalid", 2, &users)
+	assert.Error(t, err)
+
+	// no queries are expected: rows with NULL surname would be lost
+	_, err = test.NewUserQuerySet(db).OrderAscBySurname().Page("", 2, &users)
+	assert.Error(t, err)
+	err = test.NewUserQuerySet(db).OrderDescByDeletedAt().Batches(2, func([]test.User) error { return nil })
+	assert.Error(t, err)
+}
+
+func testUsersIterate(t *testing.T, m sqlmock.Sqlmock, db *gorm.DB) {
+	req := "SELECT * FROM `users` WHERE `users`.`deleted_at` IS NULL AND ((name != ?))"
+	for i := 0; i < 2; i++ {
+		m.ExpectQuery(fixedFullRe(req)).WithArgs(driver.Value("")).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).
+				AddRow(1, "a").AddRow(2, "b").AddRow(3, "c"))
+	}
+
+	qs := test.NewUserQuerySet(db).NameNe("")
+	var names []string
+	err := qs.Iterate(func(u *test.User) error {
+		names = append(names, u.Name)
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, names)
+
+	// iteration stops on the first error
+	stopErr := errors.New("stop")
+	names = nil
+	err = qs.Iterate(func(u *test.User) error {
+		names = append(names, u.Name)
+		if len(names) == 2 {
+			return stopErr
+		}
+		return nil
+	})
+	assert.Equal(t, stopErr, err)
+	assert.Equal(t, []string{"a", "b"}, names)
+}
+
+func testUsersBatches(t *testing.T, m sqlmock.Sqlmock, db *gorm.DB) {
+	req := "SELECT * FROM `users` WHERE `users`.`deleted_at` IS NULL AND ((name != ?)) ORDER BY id ASC LIMIT 3"
+	m.ExpectQuery(fixedFullRe(req)).WithArgs(driver.Value("")).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2).AddRow(3))
+	req = "SELECT * FROM `users` WHERE `users`.`deleted_at` IS NULL AND ((name != ?) " +
+		"AND ((id > ?))) ORDER BY id ASC LIMIT 3"
+	m.ExpectQuery(fixedFullRe(req)).WithArgs(driver.Value(""), 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+
+	var batches [][]uint
+	err := test.NewUserQuerySet(db).NameNe("").Batches(2, func(users []test.User) error {
+		var ids []uint
+		for _, u := range users {
+			ids = append(ids, u.ID)
+		}
+		batches = append(batches, ids)
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, [][]uint{{1, 2}, {3}}, batches)
+
+	assert.Error(t, test.NewUserQuerySet(db).Batches(0, func([]test.User) error { return nil }))
+}
+
+func testUsersCreateMany(t *testing.T, m sqlmock.Sqlmock, db *gorm.DB) {
+	users := []test.User{getUserNoID(), getUserNoID()}
+	users[1].Name = "other"
+
+	req := "INSERT INTO `users` (`created_at`,`deleted_at`,`email`,`name`,`updated_at`,`user_surname`) " +
+		"VALUES (?,?,?,?,?,?),(?,?,?,?,?,?)"
+	args := []driver.Value{}
+	for _, u := range users {
+		args = append(args, sqlmock.AnyArg(), nil, u.Email, u.Name, sqlmock.AnyArg(), u.Surname)
+	}
+	m.ExpectExec(fixedFullRe(req)).WithArgs(args...).
+		WillReturnResult(sqlmock.NewResult(10, 2))
+
+	err := test.NewUserQuerySet(db).NameEq("ignored").CreateMany(users)
+	assert.Nil(t, err)
+	assert.Equal(t, uint(10), users[0].ID)
+	assert.Equal(t, uint(11), users[1].ID)
+	assert.False(t, users[0].CreatedAt.IsZero())
+
+	users[1].ID = 0
+	assert.Error(t, test.NewUserQuerySet(db).CreateMany(users))
+}
+
+func testCommentsCreateMany(t *testing.T, m sqlmock.Sqlmock, db *gorm.DB) {
+	comments := []test.Comment{{Text: "a"}, {Text: "b"}, {Text: "c", Status: "spam"}}
+
+	// zero status is set by database default, timestamps are renamed
+	req := "INSERT INTO `comments` (`created`,`modified`,`text`) VALUES (?,?,?),(?,?,?)"
+	m.ExpectExec(fixedFullRe(req)).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), "a", sqlmock.AnyArg(), sqlmock.AnyArg(), "b").
+		WillReturnResult(sqlmock.NewResult(1, 2))
+	req = "INSERT INTO `comments` (`created`,`modified`,`status`,`text`) VALUES (?,?,?,?)"
+	m.ExpectExec(fixedFullRe(req)).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), "spam", "c").
+		WillReturnResult(sqlmock.NewResult(3, 1))
+
+	assert.Nil(t, test.NewCommentQuerySet(db).CreateMany(comments))
+	for i, c := range comments {
+		assert.Equal(t, uint(i+1), c.ID)
+		assert.False(t, c.CreatedAt.IsZero())
+		assert.False(t, c.UpdatedAt.IsZero())
+	}
+}
+
+func testDocumentsCreateMany(t *testing.T, m sqlmock.Sqlmock, db *gorm.DB) {
+	docs := []test.Document{
+		{Tags: test.Tags{"a"}, Data: json.RawMessage(`{"x":1}`), Attrs: map[string]string{"k": "v"},
+			Labels: []string{"l"}},
+		{},
+	}
+
+	// maps are marshaled to JSON, nil ones are NULL; plain slice Labels
+	// can't be passed to database, so it isn't inserted
+	req := "INSERT INTO `documents` (`attrs`,`created_at`,`data`,`deleted_at`,`tags`,`updated_at`) " +
+		"VALUES (?,?,?,?,?,?),(?,?,?,?,?,?)"
+	m.ExpectExec(fixedFullRe(req)).
+		WithArgs(`{"k":"v"}`, sqlmock.AnyArg(), []byte(`{"x":1}`), nil, "{a}", sqlmock.AnyArg(),
+			nil, sqlmock.AnyArg(), []byte(nil), nil, nil, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 2))
+
+	assert.Nil(t, test.NewDocumentQuerySet(db).CreateMany(docs))
+	assert.Equal(t, uint(2), docs[1].ID)
+
+	for _, name := range []string{"LabelsEq", "LabelsContains", "LabelsIsNull"} {
+		_, ok := reflect.TypeOf(test.DocumentQuerySet{}).MethodByName(name)
+		assert.False(t, ok, name)
+	}
+	_, ok := reflect.TypeOf(test.DocumentUpdater{}).MethodByName("SetLabels")
+	assert.False(t, ok)
+}
+
+func testAccountValidation(t *testing.T, m sqlmock.Sqlmock, db *gorm.DB) {
+	nickname := "nick"
+	valid := func() test.Account {
+		return test.Account{Login: "login", Nickname: &nickname, Role: "user", Age: 20, Str: "str"}
+	}
+
+	cases := []struct {
+		modify func(a *test.Account)
+		err    test.ValidationError
+	}{
+		{func(a *test.Account) { a.Login = "" }, test.ValidationError{Field: "Login", Reason: "is required"}},
+		{func(a *test.Account) { a.Login = "too long login" }, test.ValidationError{Field: "Login", Reason: "length must be at most 8"}},
+		{func(a *test.Account) { a.Nickname = nil }, test.ValidationError{Field: "Nickname", Reason: "must be not null"}},
+		{func(a *test.Account) { a.Role = "root" }, test.ValidationError{Field: "Role", Reason: "must be one of: admin user"}},
+		{func(a *test.Account) { a.Age = 17 }, test.ValidationError{Field: "Age", Reason: "must be at least 18"}},
+		{func(a *test.Account) { a.Age = 151 }, test.ValidationError{Field: "Age", Reason: "must be at most 150"}},
+		{func(a *test.Account) { a.Str = "strin" }, test.ValidationError{Field: "Str", Reason: "length must be at most 4"}},
+	}
+	for _, c := range cases {
+		a := valid()
+		c.modify(&a)
+		assert.Equal(t, c.err, a.Validate())
+		// invalid account isn't written: there are no expected queries
+		assert.Equal(t, c.err, a.Create(db))
+	}
+
+	a := valid()
+	assert.Nil(t, a.Validate())
+
+	err := test.NewAccountUpdater(db).SetAge(10).Update()
+	assert.Equal(t, test.ValidationError{Field: "Age", Reason: "must be at least 18"}, err)
+}
+
+func testProfileNullValues(t *testing.T, m sqlmock.Sqlmock, db *gorm.DB) {
+	req := "SELECT * FROM `profiles` WHERE `profiles`.`deleted_at` IS NULL AND " +
+		"((`profiles`.bio = ?) AND (`profiles`.rating IS NULL))"
+	m.ExpectQuery(fixedFullRe(req)).WithArgs("bio").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	var profiles []test.Profile
+	assert.Nil(t, test.NewProfileQuerySet(db).BioEq("bio").RatingIsNull().All(&profiles))
+
+	req = "UPDATE `profiles` SET `bio` = ?, `rating` = ? WHERE `profiles`.`deleted_at` IS NULL AND ((`profiles`.id = ?))"
+	m.ExpectExec(fixedFullRe(req)).WithArgs(nil, 3, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := test.NewProfileQuerySet(db).IDEq(1).GetUpdater().SetBioNull().SetRating(3).Update()
+	assert.Nil(t, err)
+
+	// inner value is validated, NULL isn't
+	err = test.NewProfileUpdater(db).SetRating(7).Update()
+	assert.Equal(t, test.ValidationError{Field: "Rating", Reason: "must be at most 5"}, err)
+	p := test.Profile{Bio: sql.NullString{String: "too long bio"}}
+	assert.Nil(t, p.Validate())
+	p.Bio.Valid = true
+	assert.Equal(t, test.ValidationError{Field: "Bio", Reason: "length must be at most 8"}, p.Validate())
+}
+
+func testPostSetBlog(t *testing.T, m sqlmock.Sqlmock, db *gorm.DB) {
+	req := "UPDATE `posts` SET `blog_id` = ? WHERE `posts`.`deleted_at` IS NULL AND ((`posts`.id = ?))"
+	m.ExpectExec(fixedFullRe(req)).WithArgs(3, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	m.ExpectExec(fixedFullRe(req)).WithArgs(nil, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	blog := test.Blog{Model: gorm.Model{ID: 3}}
+	assert.Nil(t, test.NewPostQuerySet(db).IDEq(1).GetUpdater().SetBlog(&blog).Update())
+	assert.Nil(t, test.NewPostQuerySet(db).IDEq(1).GetUpdater().SetBlog(nil).Update())
+
+	// value association: foreign key is written, not column of struct
+	req = "UPDATE `posts` SET `user_id` = ? WHERE `posts`.`deleted_at` IS NULL AND ((`posts`.id = ?))"
+	m.ExpectExec(fixedFullRe(req)).WithArgs(4, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	user := getUser()
+	user.ID = 4
+	assert.Nil(t, test.NewPostQuerySet(db).IDEq(1).GetUpdater().SetUser(&user).Update())
+
+	// foreign key is set by tag
+	req = "UPDATE `profiles` SET `owner_ref` = ? WHERE `profiles`.`deleted_at` IS NULL AND ((`profiles`.id = ?))"
+	m.ExpectExec(fixedFullRe(req)).WithArgs(5, 2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	m.ExpectExec(fixedFullRe(req)).WithArgs(6, 2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	owner := getUser()
+	owner.ID = 5
+	assert.Nil(t, test.NewProfileQuerySet(db).IDEq(2).GetUpdater().SetOwner(&owner).Update())
+	ownerID := uint(6)
+	assert.Nil(t, test.NewProfileQuerySet(db).IDEq(2).GetUpdater().SetOwnerID(&ownerID).Update())
+}
+
+func testUserPostsAssociation(t *testing.T, m sqlmock.Sqlmock, db *gorm.DB) {
+	u := getUser()
+	req := "SELECT count(*) FROM `posts` WHERE `posts`.`deleted_at` IS NULL AND ((`user_id` IN (?)))"
+	m.ExpectQuery(fixedFullRe(req)).WithArgs(u.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+
+	n, err := u.CountPosts(db)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, n)
+
+	req = "UPDATE `posts` SET `user_id` = ? WHERE `posts`.`deleted_at` IS NULL AND ((`user_id` = ?))"
+	m.ExpectExec(fixedFullRe(req)).WithArgs(nil, u.ID).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	assert.Nil(t, u.ClearPosts(db))
+}
+
+func testUsersPreloadPostsWhere(t *testing.T, m sqlmock.Sqlmock, db *gorm.DB) {
+	u := getUser()
+	req := "SELECT * FROM `users` WHERE `users`.`deleted_at` IS NULL"
+	m.ExpectQuery(fixedFullRe(req)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(u.ID, u.Name))
+	req = "SELECT * FROM `posts` WHERE `posts`.`deleted_at` IS NULL AND ((`posts`.title IS NOT NULL) AND " +
+		"(`user_id` IN (?))) ORDER BY `posts`.id DESC"
+	m.ExpectQuery(fixedFullRe(req)).WithArgs(u.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id"}).AddRow(2, u.ID))
+
+	var users []test.User
+	err := test.NewUserQuerySet(db).PreloadPostsWhere(func(qs test.PostQuerySet) test.PostQuerySet {
+		return qs.TitleIsNotNull().OrderDescByID()
+	}).All(&users)
+	assert.Nil(t, err)
+	assert.Len(t, users, 1)
+	assert.Len(t, users[0].Posts, 1)
+	assert.Equal(t, uint(2), users[0].Posts[0].ID)
+}
+
+func testUserUpsert(t *testing.T, m sqlmock.Sqlmock, db *gorm.DB) {
+	u := getUserNoID()
+	req := "INSERT INTO `users` (`created_at`,`deleted_at`,`email`,`name`,`updated_at`,`user_surname`) " +
+		"VALUES (?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `id` = LAST_INSERT_ID(`id`), `name` = VALUES(`name`)"
+	m.ExpectExec(fixedFullRe(req)).
+		WithArgs(sqlmock.AnyArg(), nil, u.Email, u.Name, sqlmock.AnyArg(), u.Surname).
+		WillReturnResult(sqlmock.NewResult(5, 2))
+
+	err := u.Upsert(db, []test.UserDBSchemaField{test.UserDBSchema.Email}, test.UserDBSchema.Name)
+	assert.Nil(t, err)
+	assert.Equal(t, uint(5), u.ID)
+
+	// primary key is set: nothing to update
+	req = "INSERT INTO `users` (`created_at`,`deleted_at`,`email`,`id`,`name`,`updated_at`,`user_surname`) " +
+		"VALUES (?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `email` = `email`"
+	m.ExpectExec(fixedFullRe(req)).WillReturnResult(sqlmock.NewResult(0, 0))
+	assert.Nil(t, u.Upsert(db, []test.UserDBSchemaField{test.UserDBSchema.Email}))
+
+	// no conflict and update fields: primary key is assigned to itself
+	req = "INSERT INTO `users` (`created_at`,`deleted_at`,`email`,`id`,`name`,`updated_at`,`user_surname`) " +
+		"VALUES (?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `id` = `id`"
+	m.ExpectExec(fixedFullRe(req)).WillReturnResult(sqlmock.NewResult(0, 0))
+	assert.Nil(t, u.Upsert(db, nil))
+}
+
+func TestPatternFiltersPostgres(t *testing.T) {
+	sqlDB, m, err := sqlmock.New()
+	assert.Nil(t, err)
+	db, err := gorm.Open("postgres", sqlDB)
+	assert.Nil(t, err)
+	defer checkMock(t, m)
+
+	req := `SELECT * FROM "users" WHERE "users"."deleted_at" IS NULL AND ((name ILIKE $1 ESCAPE '!'))`
+	m.ExpectQuery(fixedFullRe(req)).WithArgs("%a!_%").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	var users []test.User
+	assert.Nil(t, test.NewUserQuerySet(db).NameIContains("a_").All(&users))
+}
+
+func TestCompositeFiltersPostgres(t *testing.T) {
+	sqlDB, m, err := sqlmock.New()
+	assert.Nil(t, err)
+	db, err := gorm.Open("postgres", sqlDB)
+	assert.Nil(t, err)
+	defer checkMock(t, m)
+
+	req := `SELECT * FROM "documents" WHERE "documents"."deleted_at" IS NULL AND ` +
+		`((tags @> $1) AND (data #>> $2 = $3) AND (attrs IS NULL))`
+	m.ExpectQuery(fixedFullRe(req)).WithArgs("{a,b}", "{address,city}", "Paris").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	var docs []test.Document
+	qs := test.NewDocumentQuerySet(db).TagsContains(test.Tags{"a", "b"}).
+		DataJSONEq("address.city", "Paris").AttrsIsNull()
+	assert.Nil(t, qs.All(&docs))
+}
+
+func TestUpsertPostgres(t *testing.T) {
+	sqlDB, m, err := sqlmock.New()
+	assert.Nil(t, err)
+	db, err := gorm.Open("postgres", sqlDB)
+	assert.Nil(t, err)
+	defer checkMock(t, m)
+
+	u := getUserNoID()
+	req := `INSERT INTO "users" ("created_at","deleted_at","email","name","updated_at","user_surname") ` +
+		`VALUES ($1,$2,$3,$4,$5,$6) ON CONFLICT ("email") DO UPDATE SET "name" = excluded."name" RETURNING "id"`
+	m.ExpectQuery(fixedFullRe(req)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+
+	err = u.Upsert(db, []test.UserDBSchemaField{test.UserDBSchema.Email}, test.UserDBSchema.Name)
+	assert.Nil(t, err)
+	assert.Equal(t, uint(7), u.ID)
+
+	// conflict without update: no rows are returned
+	u.ID = 0
+	req = `INSERT INTO "users" ("created_at","deleted_at","email","name","updated_at","user_surname") ` +
+		`VALUES ($1,$2,$3,$4,$5,$6) ON CONFLICT ("email") DO NOTHING RETURNING "id"`
+	m.ExpectQuery(fixedFullRe(req)).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	assert.Nil(t, u.Upsert(db, []test.UserDBSchemaField{test.UserDBSchema.Email}))
+	assert.Zero(t, u.ID)
+
+	// no conflict fields: any conflict is ignored
+	u.ID = 3
+	req = `INSERT INTO "users" ("created_at","deleted_at","email","id","name","updated_at","user_surname") ` +
+		`VALUES ($1,$2,$3,$4,$5,$6,$7) ON CONFLICT DO NOTHING`
+	m.ExpectExec(fixedFullRe(req)).WillReturnResult(sqlmock.NewResult(0, 0))
+	assert.Nil(t, u.Upsert(db, nil))
+
+	// no queries are expected: row to update can't be found without conflict fields
+	assert.Error(t, u.Upsert(db, nil, test.UserDBSchema.Name))
+}
+
+func testPostsJoinBlog(t *testing.T, m sqlmock.Sqlmock, db *gorm.DB) {
+	req := "SELECT `posts`.* FROM `posts` " +
+		"JOIN `blogs` blog_join ON blog_join.id = `posts`.blog_id AND blog_join.deleted_at IS NULL " +
+		"WHERE `posts`.`deleted_at` IS NULL AND ((blog_join.myname = ?))"
+	m.ExpectQuery(fixedFullRe(req)).WithArgs("blog").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "blog_id"}).AddRow(1, 2))
+
+	var posts []test.Post
+	assert.Nil(t, test.NewPostQuerySet(db).JoinBlog().BlogNameEq("blog").All(&posts))
+	assert.Len(t, posts, 1)
+	assert.Equal(t, uint(1), posts[0].ID)
+}
+
+func testPostsJoinBlogOwnColumns(t *testing.T, m sqlmock.Sqlmock, db *gorm.DB) {
+	// own columns are qualified: id and created_at are columns of both tables
+	req := "SELECT `posts`.* FROM `posts` " +
+		"JOIN `blogs` blog_join ON blog_join.id = `posts`.blog_id AND blog_join.deleted_at IS NULL " +
+		"WHERE `posts`.`deleted_at` IS NULL AND ((blog_join.myname = ?) AND (`posts`.id > ?) AND " +
+		"(`posts`.user_id = ?)) ORDER BY `posts`.created_at DESC,`posts`.id ASC LIMIT 3"
+	m.ExpectQuery(fixedFullRe(req)).WithArgs("blog", 1, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "blog_id"}).AddRow(2, 3))
+
+	var posts []test.Post
+	cursor, err := test.NewPostQuerySet(db).JoinBlog().BlogNameEq("blog").IDGt(1).
+		Where(test.PostQ.UserIDEq(2)).OrderDescByCreatedAt().Page("", 2, &posts)
+	assert.Nil(t, err)
+	assert.Empty(t, cursor)
+	assert.Len(t, posts, 1)
+}
+
+func testPostsLeftJoinUser(t *testing.T, m sqlmock.Sqlmock, db *gorm.DB) {
+	req := "SELECT count(*) FROM `posts` " +
+		"LEFT JOIN `users` user_join ON user_join.id = `posts`.user_id AND user_join.deleted_at IS NULL " +
+		"WHERE `posts`.`deleted_at` IS NULL AND ((user_join.email LIKE ?))"
+	m.ExpectQuery(fixedFullRe(req)).WithArgs("%@mail.ru").
+		WillReturnRows(getRowWithFields([]driver.Value{3}))
+
+	cnt, err := test.NewPostQuerySet(db).LeftJoinUser().UserEmailLike("%@mail.ru").Count()
+	assert.Nil(t, err)
+	assert.Equal(t, 3, cnt)
+}
+
+func testPostsUserIDInQuery(t *testing.T, m sqlmock.Sqlmock, db *gorm.DB) {
+	req := "SELECT * FROM `posts` WHERE `posts`.`deleted_at` IS NULL AND " +
+		"((`posts`.user_id IN (SELECT id FROM `users` WHERE `users`.`deleted_at` IS NULL AND ((email LIKE ?)))) AND " +
+		"(`posts`.blog_id NOT IN (SELECT id FROM `blogs` WHERE `blogs`.`deleted_at` IS NULL AND ((myname = ?)))))"
+	m.ExpectQuery(fixedFullRe(req)).WithArgs("%corp%", "blog").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id"}).AddRow(1, 2))
+
+	var posts []test.Post
+	users := test.NewUserQuerySet(db).EmailLike("%corp%").SelectID()
+	blogs := test.NewBlogQuerySet(db).NameEq("blog").SelectID()
+	assert.Nil(t, test.NewPostQuerySet(db).UserIDInQuery(users).BlogIDNotInQuery(blogs).All(&posts))
+	assert.Len(t, posts, 1)
+	assert.Equal(t, uint(2), posts[0].UserID)
+}
+
+func testWithTxCommit(t *testing.T, m sqlmock.Sqlmock, db *gorm.DB) {
+	m.ExpectBegin()
+	m.ExpectQuery(fixedFullRe("SELECT count(*) FROM `users` WHERE `users`.`deleted_at` IS NULL AND ((name = ?))")).
+		WithArgs("a").
+		WillReturnRows(getRowWithFields([]driver.Value{1}))
+	m.ExpectExec(fixedFullRe("UPDATE `users` SET `name` = ? WHERE `users`.`deleted_at` IS NULL AND ((name = ?))")).
+		WithArgs("b", "a").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	m.ExpectCommit()
+
+	qs := test.NewUserQuerySet(db)
+	err := test.WithTx(db, func(tx *gorm.DB) error {
+		cnt, err := qs.InTx(tx).NameEq("a").Count()
+		if err != nil {
+			return err
+		}
+		assert.Equal(t, 1, cnt)
+
+		return test.NewUserQuerySet(tx).NameEq("a").GetUpdater().SetName("b").Update()
+	})
+	assert.Nil(t, err)
+}
+
+func testWithTxRollback(t *testing.T, m sqlmock.Sqlmock, db *gorm.DB) {
+	m.ExpectBegin()
+	m.ExpectRollback()
+
+	fnErr := errors.New("fn error")
+	err := test.WithTx(db, func(tx *gorm.DB) error {
+		return fnErr
+	})
+	assert.Equal(t, fnErr, err)
+}
+
+func testWithTxNestedSavepoint(t *testing.T, m sqlmock.Sqlmock, db *gorm.DB) {
+	m.ExpectBegin()
+	m.ExpectExec(fixedFullRe("SAVEPOINT goqueryset_sp1")).WillReturnResult(sqlmock.NewResult(0, 0))
+	m.ExpectExec(fixedFullRe("SAVEPOINT goqueryset_sp2")).WillReturnResult(sqlmock.NewResult(0, 0))
+	m.ExpectExec(fixedFullRe("ROLLBACK TO SAVEPOINT goqueryset_sp2")).WillReturnResult(sqlmock.NewResult(0, 0))
+	m.ExpectExec(fixedFullRe("RELEASE SAVEPOINT goqueryset_sp1")).WillReturnResult(sqlmock.NewResult(0, 0))
+	m.ExpectCommit()
+
+	nestedErr := errors.New("nested error")
+	err := test.WithTx(db, func(tx *gorm.DB) error {
+		return test.WithTx(tx, func(tx *gorm.DB) error {
+			err := test.WithTx(tx, func(tx *gorm.DB) error {
+				return nestedErr
+			})
+			assert.Equal(t, nestedErr, err)
+			return nil
+		})
+	})
+	assert.Nil(t, err)
+}
+
+func testInTxAfterConditions(t *testing.T, m sqlmock.Sqlmock, db *gorm.DB) {
+	m.ExpectBegin()
+	m.ExpectRollback()
+
+	err := test.WithTx(db, func(tx *gorm.DB) error {
+		return test.NewUserQuerySet(db).NameEq("a").InTx(tx).Delete()
+	})
+	assert.Error(t, err)
+
+	u := test.NewUserQuerySet(db).NameEq("a").GetUpdater()
+	assert.Error(t, u.InTx(db).SetName("b").Update())
+}
+
+func testInTxKeepsContextAndPreloads(t *testing.T, m sqlmock.Sqlmock, db *gorm.DB) {
+	m.ExpectBegin()
+	req := "SELECT * FROM `users` WHERE `users`.`deleted_at` IS NULL"
+	m.ExpectQuery(fixedFullRe(req)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(1, "a"))
+	req = "SELECT * FROM `posts` WHERE `posts`.`deleted_at` IS NULL AND ((`user_id` IN (?))) ORDER BY `posts`.id DESC"
+	m.ExpectQuery(fixedFullRe(req)).WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id"}).AddRow(2, 1))
+	m.ExpectCommit()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := test.WithTx(db, func(tx *gorm.DB) error {
+		// no queries are expected: context is moved into transaction
+		_, err := test.NewUserQuerySet(db).WithContext(ctx).InTx(tx).Count()
+		assert.Equal(t, context.Canceled, err)
+		assert.Equal(t, context.Canceled, test.NewUserUpdater(db).WithContext(ctx).InTx(tx).SetName("a").Update())
+
+		var users []test.User
+		qs := test.NewUserQuerySet(db).PreloadPostsWhere(func(qs test.PostQuerySet) test.PostQuerySet {
+			return qs.OrderDescByID()
+		})
+		if err := qs.InTx(tx).All(&users); err != nil {
+			return err
+		}
+		assert.Len(t, users, 1)
+		assert.Len(t, users[0].Posts, 1)
+		return nil
+	})
+	assert.Nil(t, err)
+}
+
+func testWithContextCanceled(t *testing.T, m sqlmock.Sqlmock, db *gorm.DB) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// no queries are expected: gorm v1 checks context before query
+	qs := test.NewUserQuerySet(db).WithContext(ctx)
+	var users []test.User
+	assert.Equal(t, context.Canceled, qs.All(&users))
+	_, err := qs.Count()
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, context.Canceled, qs.GetUpdater().SetName("a").Update())
+	assert.Equal(t, context.Canceled, test.NewUserUpdater(db).WithContext(ctx).SetName("a").Update())
+
+	u := getUser()
+	assert.Equal(t, context.Canceled, u.Create(test.DBWithContext(ctx, db)))
+	assert.Equal(t, context.Canceled, u.Delete(test.DBWithContext(ctx, db)))
+}
+
+func testWithContextActive(t *testing.T, m sqlmock.Sqlmock, db *gorm.DB) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	m.ExpectQuery(fixedFullRe("SELECT count(*) FROM `users` WHERE `users`.`deleted_at` IS NULL")).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	n, err := test.NewUserQuerySet(db).WithContext(ctx).Count()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, n)
+}
+
+func TestParseQuerySetAnnotation(t *testing.T) {
+	cases := []struct {
+		lines           []string
+		expected        *qsAnnotation
+		errorIsExpected bool
+	}{
+		{
+			lines: []string{"// User is a user"},
+		},
+		{
+			lines:    []string{"// User is a user", "// gen:qs"},
+			expected: &qsAnnotation{},
+		},
+		{
+			lines:    []string{"// gen: qs "},
+			expected: &qsAnnotation{},
+		},
+		{
+			lines:    []string{"// gen:qs backend=gorm2"},
+			expected: &qsAnnotation{backend: methods.BackendGormV2},
+		},
+		{
+			lines: []string{"// gen:qs name=Users skip=Delete,DeleteNumUnscoped readonly"},
+			expected: &qsAnnotation{
+				name:     "Users",
+				skip:     []string{"Delete", "DeleteNumUnscoped"},
+				readOnly: true,
+			},
+		},
+		{
+			lines:    []string{"// gen:qs readonly=false"},
+			expected: &qsAnnotation{},
+		},
+		{
+			lines:           []string{"// gen:qs name=1Users"},
+			errorIsExpected: true,
+		},
+		{
+			lines:           []string{"// gen:qs skip=Delete,"},
+			errorIsExpected: true,
+		},
+		{
+			lines: []string{"// gen:qsx"},
+		},
+		{
+			lines:           []string{"// gen:qs backend=gorm3"},
+			errorIsExpected: true,
+		},
+		{
+			lines:           []string{"// gen:qs unknown=1"},
+			errorIsExpected: true,
+		},
+		{
+			lines:           []string{"// gen:qs backend"},
+			errorIsExpected: true,
+		},
+	}
+
+	for _, c := range cases {
+		doc := &ast.CommentGroup{}
+		for _, l := range c.lines {
+			doc.List = append(doc.List, &ast.Comment{Text: l})
+		}
+
+		a, err := parseQuerySetAnnotation(doc)
+		if c.errorIsExpected {
+			assert.Error(t, err, "%v", c.lines)
+			continue
+		}
+
+		assert.NoError(t, err, "%v", c.lines)
+		assert.Equal(t, c.expected, a, "%v", c.lines)
+	}
+}
+
+func TestGenerateGormV2(t *testing.T) {
+	res, err := (&parser.Structs{}).ParseFile(context.Background(), "test/models.go")
+	assert.NoError(t, err)
+
+	r, backend, err := GenerateQuerySetsForStructs(res.Types, res.Structs,
+		Options{Backend: methods.BackendGormV2})
+	assert.NoError(t, err)
+	assert.Equal(t, methods.BackendGormV2, backend)
+
+	codeBytes, err := ioutil.ReadAll(r)
+	assert.NoError(t, err)
+	codeBytes, err = format.Source(codeBytes)
+	assert.NoError(t, err)
+	code := string(codeBytes)
+
+	assert.Contains(t, code, "db: db.Model(&User{}).Session(&gorm.Session{}),")
+	assert.Contains(t, code, "func (qs UserQuerySet) Count() (int64, error)")
+	assert.Contains(t, code, "var count int64")
+	assert.Contains(t, code, "return qs.db.Delete(&User{}).Error")
+	assert.Contains(t, code, "db := qs.db.Unscoped().Delete(&User{})")
+	assert.Contains(t, code, "errors.Is(err, gorm.ErrRecordNotFound)")
+}
+
+// sqlRecorder is a gorm v2 logger saving executed SQL
+type sqlRecorder struct {
+	gormv2logger.Interface
+	sqls []string
+	ctxs []context.Context
+}
+
+func (r *sqlRecorder) Trace(ctx context.Context, _ time.Time, fc func() (string, int64), _ error) {
+	sql, _ := fc()
+	r.sqls = append(r.sqls, sql)
+	r.ctxs = append(r.ctxs, ctx)
+}
+
+func newDryRunGormV2DB(t *testing.T) (*gormv2.DB, *sqlRecorder) {
+	r := &sqlRecorder{Interface: gormv2logger.Discard}
+	db, err := gormv2.Open(gormv2tests.DummyDialector{}, &gormv2.Config{
+		DryRun: true,
+		Logger: r,
+	})
+	assert.NoError(t, err)
+	return db, r
+}
+
+func TestGormV2Fixture(t *testing.T) {
+	funcs := []func(t *testing.T, db *gormv2.DB, r *sqlRecorder){
+		testGormV2FilterAndOrder,
+		testGormV2BaseQuerySetReuse,
+		testGormV2UpdateWithoutWhere,
+		testGormV2CreateMany,
+		testGormV2JoinOwnColumns,
+		testGormV2PredicateError,
+		testGormV2InTxKeepsContext,
+	}
+	for _, f := range funcs {
+		f := f // save range var
+		funcName := runtime.FuncForPC(reflect.ValueOf(f).Pointer()).Name()
+		funcName = filepath.Ext(funcName)
+		funcName = strings.TrimPrefix(funcName, ".")
+		t.Run(funcName, func(t *testing.T) {
+			t.Parallel()
+			db, r := newDryRunGormV2DB(t)
+			f(t, db, r)
+		})
+	}
+}
+
+func testGormV2FilterAndOrder(t *testing.T, db *gormv2.DB, r *sqlRecorder) {
+	var users []gorm2.User
+	err := gorm2.NewUserQuerySet(db).NameEq("a").OrderAscByID().All(&users)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"SELECT * FROM `users` WHERE name = \"a\" AND `users`.`deleted_at` IS NULL ORDER BY id ASC"},
+		r.sqls)
+}
+
+func testGormV2BaseQuerySetReuse(t *testing.T, db *gormv2.DB, r *sqlRecorder) {
+	var users []gorm2.User
+	base := gorm2.NewUserQuerySet(db).NameEq("a")
+	assert.NoError(t, base.EmailEq("b").All(&users))
+	assert.NoError(t, base.All(&users))
+	assert.Len(t, r.sqls, 2)
+	assert.Contains(t, r.sqls[0], "email = \"b\"")
+	assert.NotContains(t, r.sqls[1], "email")
+}
+
+func testGormV2UpdateWithoutWhere(t *testing.T, db *gormv2.DB, r *sqlRecorder) {
+	err := gorm2.NewUserQuerySet(db).GetUpdater().SetName("a").Update()
+	assert.True(t, errors.Is(err, gormv2.ErrMissingWhereClause), "got error %v", err)
+
+	err = gorm2.NewUserQuerySet(db).NameEq("b").GetUpdater().SetName("a").Update()
+	assert.NoError(t, err)
+	assert.Contains(t, r.sqls[len(r.sqls)-1], "UPDATE `users` SET `name`=\"a\"")
+}
+
+func testGormV2CreateMany(t *testing.T, db *gormv2.DB, r *sqlRecorder) {
+	users := []gorm2.User{{Name: "a"}, {Name: "b"}, {Name: "c"}}
+	assert.NoError(t, gorm2.NewUserQuerySet(db).NameEq("x").CreateMany(users))
+	assert.Len(t, r.sqls, 1)
+	assert.Contains(t, r.sqls[0], "INSERT INTO `users`")
+	assert.Equal(t, 3, strings.Count(r.sqls[0], "\"a\"")+strings.Count(r.sqls[0], "\"b\"")+
+		strings.Count(r.sqls[0], "\"c\""))
+	assert.NotContains(t, r.sqls[0], "\"x\"")
+}
+
+func testGormV2JoinOwnColumns(t *testing.T, db *gormv2.DB, r *sqlRecorder) {
+	var posts []gorm2.Post
+	err := gorm2.NewPostQuerySet(db).JoinBlog().BlogNameEq("blog").IDGt(1).
+		Where(gorm2.PostQ.UserIDEq(2)).OrderDescByCreatedAt().All(&posts)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"SELECT `posts`.* FROM `posts` " +
+		"JOIN `blogs` blog_join ON blog_join.id = `posts`.blog_id AND blog_join.deleted_at IS NULL " +
+		"WHERE blog_join.myname = \"blog\" AND `posts`.id > 1 AND `posts`.user_id = 2 " +
+		"AND `posts`.`deleted_at` IS NULL ORDER BY `posts`.created_at DESC"}, r.sqls)
+}
+
+func testGormV2PredicateError(t *testing.T, db *gormv2.DB, r *sqlRecorder) {
+	var users []gorm2.User
+	base := gorm2.NewUserQuerySet(db).NameEq("a")
+	err := base.Where(gorm2.UserQ.NameIn()).All(&users)
+	assert.EqualError(t, err, "must at least pass one name in NameIn")
+
+	// error doesn't break base queryset
+	assert.NoError(t, base.All(&users))
+	assert.Equal(t, []string{"SELECT * FROM `users` WHERE name = \"a\" AND `users`.`deleted_at` IS NULL"}, r.sqls)
+}
+
+func TestGenerateMixedBackends(t *testing.T) {
+	res, err := (&parser.Structs{}).ParseFile(context.Background(), "test/models.go")
+	assert.NoError(t, err)
+
+	u := res.Structs["User"]
+	u.Doc = &ast.CommentGroup{List: []*ast.Comment{{Text: "// gen:qs backend=gorm2"}}}
+	structs := map[string]parser.ParsedStruct{
+		"User": u,
+		"Blog": res.Structs["Blog"],
+	}
+
+	_, _, err = GenerateQuerySetsForStructs(res.Types, structs, Options{Backend: methods.BackendGormV1})
+	assert.Error(t, err)
+}
+
+func TestGenerateWithOptions(t *testing.T) {
+	res, err := (&parser.Structs{}).ParseFile(context.Background(), "test/models.go")
+	assert.NoError(t, err)
+
+	r, _, err := GenerateQuerySetsForStructs(res.Types, res.Structs, Options{
+		Backend: methods.BackendGormV1,
+		Structs: []string{"Post"},
+		Naming: Naming{
+			QuerySet: "{Struct}Query",
+			Updater:  "Update{Struct}",
+		},
+		SkipMethods: map[MethodFamily]bool{
+			MethodsAggregates: true,
+			MethodsJoins:      true,
+			MethodsUpsert:     true,
+		},
+	})
+	assert.NoError(t, err)
+
+	codeBytes, err := ioutil.ReadAll(r)
+	assert.NoError(t, err)
+	codeBytes, err = format.Source(codeBytes)
+	assert.NoError(t, err)
+	code := string(codeBytes)
+
+	assert.Contains(t, code, "type PostQuery struct")
+	assert.Contains(t, code, "func (qs PostQuery) GetUpdater() UpdatePost")
+	assert.Contains(t, code, "func NewUpdatePost(db *gorm.DB) UpdatePost")
+	assert.Contains(t, code, "func (qs PostQuery) Iterate(")
+	assert.NotContains(t, code, "UserQuery")
+	assert.NotContains(t, code, "SumID")
+	assert.NotContains(t, code, "JoinBlog")
+	assert.NotContains(t, code, "Upsert")
+
+	_, _, err = GenerateQuerySetsForStructs(res.Types, res.Structs, Options{
+		Backend: methods.BackendGormV1,
+		Structs: []string{"NoSuchStruct"},
+	})
+	assert.Error(t, err)
+}
+
+func TestAnnotationOptions(t *testing.T) {
+	hasMethod := func(v interface{}, name string) bool {
+		_, ok := reflect.TypeOf(v).MethodByName(name)
+		return ok
+	}
+
+	// readonly: no updater and writing methods
+	assert.True(t, hasMethod(test.AuditLogs{}, "All"))
+	assert.True(t, hasMethod(test.AuditLogs{}, "Page"))
+	for _, name := range []string{"Delete", "DeleteNum", "DeleteNumUnscoped", "GetUpdater", "CreateMany"} {
+		assert.False(t, hasMethod(test.AuditLogs{}, name), name)
+	}
+	for _, name := range []string{"Create", "Delete", "Update", "Upsert"} {
+		assert.False(t, hasMethod(&test.AuditLog{}, name), name)
+	}
+
+	// skip: Batches is skipped with Page because it calls Page
+	for _, name := range []string{"Delete", "DeleteNumUnscoped", "Page", "Batches"} {
+		assert.False(t, hasMethod(test.EventQuerySet{}, name), name)
+	}
+	assert.True(t, hasMethod(test.EventQuerySet{}, "DeleteNum"))
+	assert.False(t, hasMethod(&test.Event{}, "Delete"))
+	assert.False(t, hasMethod(&test.Event{}, "Upsert"))
+	assert.True(t, hasMethod(&test.Event{}, "Update"))
+
+	res, err := (&parser.Structs{}).ParseFile(context.Background(), "test/models.go")
+	assert.NoError(t, err)
+	for _, doc := range []string{"// gen:qs skip=NoSuchMethod", "// gen:qs readonly skip=Delete", "// gen:qs name=User"} {
+		u := res.Structs["User"]
+		u.Doc = &ast.CommentGroup{List: []*ast.Comment{{Text: doc}}}
+		structs := map[string]parser.ParsedStruct{"User": u}
+		_, _, err = GenerateQuerySetsForStructs(res.Types, structs, Options{Backend: methods.BackendGormV1})
+		assert.Error(t, err, doc)
+	}
+}
+
+func TestFieldQsTags(t *testing.T) {
+	qsType := reflect.TypeOf(test.ProductQuerySet{})
+	for _, name := range []string{"IDEq", "IDIn", "OrderAscByID", "NameEq", "NameLike",
+		"PriceGte", "PriceLte", "OrderDescByPrice", "GroupByPrice", "CountByPrice", "SumPrice", "NoteIsNull"} {
+		_, ok := qsType.MethodByName(name)
+		assert.True(t, ok, name)
+	}
+	for _, name := range []string{"IDNe", "OrderAscByName", "NameNotlike", "PriceEq", "GroupByID",
+		"CountByName", "NoteIsNotNull", "NoteEq", "InternalEq", "OrderAscByInternal"} {
+		_, ok := qsType.MethodByName(name)
+		assert.False(t, ok, name)
+	}
+
+	// updater isn't affected by qs tags
+	_, ok := reflect.TypeOf(test.ProductUpdater{}).MethodByName("SetInternal")
+	assert.True(t, ok)
+
+	invalid := []field.Info{
+		{BaseInfo: field.BaseInfo{Name: "N", IsNumeric: true, Qs: &field.QsTag{Filters: []string{"like"}}}},
+		{BaseInfo: field.BaseInfo{Name: "N", IsString: true, Qs: &field.QsTag{Filters: []string{"isnull"}}}},
+		{BaseInfo: field.BaseInfo{Name: "N", IsString: true, Qs: &field.QsTag{Aggregates: true}}},
+		{BaseInfo: field.BaseInfo{Name: "N", IsString: true, Qs: &field.QsTag{Preload: true}}},
+		{BaseInfo: field.BaseInfo{Name: "N", IsStruct: true, Qs: &field.QsTag{Order: true}}},
+	}
+	for _, f := range invalid {
+		assert.Error(t, validateQsTag(f), "%+v", f.Qs)
+	}
+	assert.NoError(t, validateQsTag(field.Info{BaseInfo: field.BaseInfo{Name: "N", IsStruct: true,
+		Qs: &field.QsTag{Preload: true}}}))
+}
+
+func TestNamingValidate(t *testing.T) {
+	assert.NoError(t, Naming{}.Validate())
+	assert.NoError(t, Naming{QuerySet: "{Struct}Qs", Updater: "{Struct}Upd"}.Validate())
+	assert.Error(t, Naming{QuerySet: "QuerySet"}.Validate())
+	assert.Error(t, Naming{QuerySet: "{Struct}-qs"}.Validate())
+	assert.Error(t, Naming{QuerySet: "{Struct}Updater"}.Validate())
+}
+
+func TestIsDeclaredInOtherFile(t *testing.T) {
+	res, err := (&parser.Structs{}).ParseFile(context.Background(), "test/models.go")
+	assert.NoError(t, err)
+
+	assert.False(t, isDeclaredInOtherFile(res, helperName, "test/autogenerated_models.go"))
+	assert.True(t, isDeclaredInOtherFile(res, helperName, "test/autogenerated_other.go"))
+	assert.False(t, isDeclaredInOtherFile(res, "NotDeclared", "test/autogenerated_other.go"))
+}
+
 func TestMain(m *testing.M) {
 	g := Generator{
 		StructsParser: &parser.Structs{},
@@ -434,6 +1562,12 @@ func TestMain(m *testing.M) {
 		panic(err)
 	}
 
+	g.Backend = methods.BackendGormV2
+	err = g.Generate(context.Background(), "test/gorm2/models.go", "test/gorm2/autogenerated_models.go")
+	if err != nil {
+		panic(err)
+	}
+
 	os.Exit(m.Run())
 }
 
@@ -449,3 +1583,17 @@ func BenchmarkHello(b *testing.B) {
 		}
 	}
 }
+
+func testGormV2InTxKeepsContext(t *testing.T, db *gormv2.DB, r *sqlRecorder) {
+	type ctxKey struct{}
+	ctx := context.WithValue(context.Background(), ctxKey{}, "qs")
+	tx := db.Session(&gormv2.Session{})
+
+	var users []gorm2.User
+	assert.NoError(t, gorm2.NewUserQuerySet(db).WithContext(ctx).InTx(tx).PreloadPosts().All(&users))
+	assert.NoError(t, gorm2.NewUserQuerySet(db).InTx(tx.WithContext(ctx)).All(&users))
+	assert.Len(t, r.ctxs, 2)
+	for _, c := range r.ctxs {
+		assert.Equal(t, "qs", c.Value(ctxKey{}))
+	}
+}
@@ -14,6 +14,8 @@ const qsCode = `
 
 {{ range .Configs }}
   {{ $ft := printf "%s%s" .StructName "DBSchemaField" }}
+  {{ $schema := printf "%s%s" .StructName "DBSchema" }}
+  {{ $pred := printf "%s%s" .StructName "Predicate" }}
   // ===== BEGIN of query set {{ .Name }}
 
 	// {{ .Name }} is an queryset type for {{ .StructName }}
@@ -24,7 +26,13 @@ const qsCode = `
   // New{{ .Name }} constructs new {{ .Name }}
   func New{{ .Name }}(db *gorm.DB) {{ .Name }} {
 	  return {{ .Name }}{
+		  {{- if .Backend.IsGormV2 }}
+		  // new session makes every chained call clone the statement,
+		  // so a queryset can be safely reused as a base for other querysets
+		  db: db.Model(&{{ .StructName }}{}).Session(&gorm.Session{}),
+		  {{- else }}
 		  db: db.Model(&{{ .StructName }}{}),
+		  {{- end }}
 	  }
   }
 
@@ -35,12 +43,65 @@ const qsCode = `
   func (qs {{ .Name }}) Select(fields ...{{ $ft }}) {{ .Name }} {
 	  names := []string{}
 	  for _, f := range fields {
+		  {{- if .QualifyColumns }}
+		  names = append(names, qs.column(f.String()))
+		  {{- else }}
 		  names = append(names, f.String())
+		  {{- end }}
 	  }
 
 	  return qs.w(qs.db.Select(strings.Join(names, ",")))
   }
 
+  {{- if .WithGrouping }}
+
+  // GroupBy groups rows by fields
+  func (qs {{ .Name }}) GroupBy(fields ...{{ $ft }}) {{ .Name }} {
+	  names := []string{}
+	  for _, f := range fields {
+		  {{- if .QualifyColumns }}
+		  names = append(names, qs.column(f.String()))
+		  {{- else }}
+		  names = append(names, f.String())
+		  {{- end }}
+	  }
+
+	  return qs.w(qs.db.Group(strings.Join(names, ",")))
+  }
+  {{- end }}
+
+  // Where filters rows by predicate p, predicates are created by {{ .StructName }}Q
+  // nolint: dupl
+  func (qs {{ .Name }}) Where(p {{ $pred }}) {{ .Name }} {
+	  if p.err != nil {
+		  // error is added to copy of db: qs can be reused as a base queryset
+		  {{- if .Backend.IsGormV2 }}
+		  db := qs.db.Session(&gorm.Session{})
+		  {{- else }}
+		  db := qs.db.Model(&{{ .StructName }}{})
+		  {{- end }}
+		  db.AddError(p.err)
+		  return qs.w(db)
+	  }
+	  if p.expr == "" {
+		  return qs
+	  }
+	  {{- if .QualifyColumns }}
+
+	  expr := strings.Replace(p.expr, "{{ .PredicateTablePlaceholder }}", qs.quotedTableName(&{{ .StructName }}{}), -1)
+	  return qs.w(qs.db.Where(expr, p.args...))
+	  {{- else }}
+
+	  return qs.w(qs.db.Where(p.expr, p.args...))
+	  {{- end }}
+  }
+
+  // Not filters rows not matching predicate p
+  // nolint: dupl
+  func (qs {{ .Name }}) Not(p {{ $pred }}) {{ .Name }} {
+	  return qs.Where(p.Not())
+  }
+
 	{{ range .Methods }}
 		{{ .GetDoc .GetMethodName }}
 		func ({{ .GetReceiverDeclaration }}) {{ .GetMethodName }}({{ .GetArgsDeclaration }})
@@ -73,12 +134,18 @@ const qsCode = `
 		{{- end }}
 	}
 
+	{{- if .WithUpdate }}
+
 	// Update updates {{ .StructName }} fields by primary key
 	// nolint: dupl
 	func (o *{{ .StructName }}) Update(db *gorm.DB, fields ...{{ $ft }}) error {
 		dbNameToFieldName := map[string]interface{}{
 			{{- range .Fields }}
+				{{- if .IsMarshaledToJSON }}
+				"{{ .DBName }}": jsonColumnValue{o.{{ .Name }}},
+				{{- else }}
 				"{{ .DBName }}": o.{{ .Name }},
+				{{- end }}
 			{{- end }}
 		}
 		u := map[string]interface{}{}
@@ -86,8 +153,21 @@ const qsCode = `
 			fs := f.String()
 			u[fs] = dbNameToFieldName[fs]
 		}
+		{{- if .Validate }}
+		if err := validate{{ .StructName }}Fields(u); err != nil {
+			return err
+		}
+		{{- end }}
+		{{- if .Backend.IsGormV2 }}
 		if err := db.Model(o).Updates(u).Error; err != nil {
+		{{- else }}
+		if err := checkQueryContext(db).Model(o).Updates(u).Error; err != nil {
+		{{- end }}
+			{{- if .Backend.IsGormV2 }}
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+			{{- else }}
 			if err == gorm.ErrRecordNotFound {
+			{{- end }}
 				return err
 			}
 
@@ -97,24 +177,841 @@ const qsCode = `
 
 		return nil
 	}
+	{{- end }}
+
+	{{- if .WithUpsert }}
+
+	// Upsert inserts {{ .StructName }} or updates updateFields of existing row
+	// if insert violates unique constraint on conflictFields. Row isn't
+	// changed if there are no updateFields: any conflict is ignored then if
+	// there are no conflictFields. MySQL ignores conflictFields: it checks
+	// all unique constraints, other databases require them to update row.
+	// nolint: dupl
+	func (o *{{ .StructName }}) Upsert(db *gorm.DB, conflictFields []{{ $ft }},
+		updateFields ...{{ $ft }}) error {
+
+		{{- if .Validate }}
+		if err := o.Validate(); err != nil {
+			return err
+		}
+
+		{{ end }}
+
+		conflictColumns := make([]string, 0, len(conflictFields))
+		for _, f := range conflictFields {
+			conflictColumns = append(conflictColumns, f.String())
+		}
+		updateColumns := make([]string, 0, len(updateFields))
+		for _, f := range updateFields {
+			updateColumns = append(updateColumns, f.String())
+		}
+
+		{{- if .Backend.IsGormV2 }}
+
+		if err := checkConflictFields(db, conflictColumns, updateColumns); err != nil {
+			return err
+		}
+
+		onConflict := clause.OnConflict{
+			DoNothing: len(updateColumns) == 0,
+		}
+		for _, c := range conflictColumns {
+			onConflict.Columns = append(onConflict.Columns, clause.Column{Name: c})
+		}
+		if len(updateColumns) != 0 {
+			onConflict.DoUpdates = clause.AssignmentColumns(updateColumns)
+		}
+
+		return db.Clauses(onConflict).Create(o).Error
+		{{- else }}
+
+		qs := New{{ .Name }}(db)
+		return upsert(checkQueryContext(qs.db), "{{ .PrimaryKey }}", qs.fieldPtrs(o),
+			conflictColumns, updateColumns)
+		{{- end }}
+	}
+	{{- end }}
+
+	{{- if .WithUpdater }}
 
-	// {{ .StructName }}Updater is an {{ .StructName }} updates manager
-	type {{ .StructName }}Updater struct {
+	// {{ .UpdaterName }} is an {{ .StructName }} updates manager
+	type {{ .UpdaterName }} struct {
 		fields map[string]interface{}
 		db *gorm.DB
 	}
 
-	// New{{ .StructName }}Updater creates new {{ .StructName }} updater
+	// New{{ .UpdaterName }} creates new {{ .StructName }} updater
 	// nolint: dupl
-	func New{{ .StructName }}Updater(db *gorm.DB) {{ .StructName }}Updater {
-		return {{ .StructName }}Updater{
+	func New{{ .UpdaterName }}(db *gorm.DB) {{ .UpdaterName }} {
+		return {{ .UpdaterName }}{
 			fields: map[string]interface{}{},
 			db: db.Model(&{{ .StructName }}{}),
 		}
 	}
+	{{- end }}
+
+	{{- if .Validate }}
+
+	// validate{{ .StructName }}Fields validates values of {{ .StructName }} fields by their columns
+	func validate{{ .StructName }}Fields(fields map[string]interface{}) error {
+		{{- range .Validations }}
+		if value, ok := fields[string({{ $schema }}.{{ .Field.Name }})]; ok {
+			{{- if .Field.IsNullValue }}
+			v, ok := value.({{ .Field.TypeName }})
+			if !ok {
+				// updater sets value or NULL
+				v.{{ .Field.NullValueField }}, v.Valid = value.({{ .Field.GetPointed.TypeName }})
+			}
+			{{- else }}
+			v := value.({{ .Field.TypeName }})
+			{{- end }}
+			{{ .Checks }}
+		}
+		{{- end }}
+
+		return nil
+	}
+
+	// Validate checks {{ .StructName }} by rules from tags, it's called
+	// before writes to database
+	func (o *{{ .StructName }}) Validate() error {
+		return validate{{ .StructName }}Fields(map[string]interface{}{
+			{{- range .Validations }}
+			string({{ $schema }}.{{ .Field.Name }}): o.{{ .Field.Name }},
+			{{- end }}
+		})
+	}
+
+	{{- if .WithUpdater }}
+
+	// Validate checks fields set in updater by rules from tags,
+	// it's called before update
+	func (u {{ .UpdaterName }}) Validate() error {
+		return validate{{ .StructName }}Fields(u.fields)
+	}
+	{{- end }}
+	{{- end }}
 
 	// ===== END of {{ .StructName }} modifiers
+
+	// ===== BEGIN of {{ .StructName }} predicates
+
+	// {{ $pred }} is a condition on {{ .StructName }} fields for Where and Not
+	// methods of {{ .Name }}: predicates are created by {{ .StructName }}Q and
+	// combined by And, Or and Not. Zero predicate matches all rows.
+	type {{ $pred }} struct {
+		expr string
+		args []interface{}
+		err  error
+	}
+
+	// join combines predicates by operation op, empty predicates are skipped
+	// nolint: dupl
+	func (p {{ $pred }}) join(op string, preds []{{ $pred }}) {{ $pred }} {
+		for _, q := range preds {
+			if p.err == nil {
+				p.err = q.err
+			}
+			if q.expr == "" {
+				continue
+			}
+			if p.expr == "" {
+				p.expr, p.args = q.expr, q.args
+				continue
+			}
+
+			p.expr = "(" + p.expr + ") " + op + " (" + q.expr + ")"
+			p.args = append(append([]interface{}{}, p.args...), q.args...)
+		}
+
+		return p
+	}
+
+	// And returns predicate matching rows matched by p and all preds
+	// nolint: dupl
+	func (p {{ $pred }}) And(preds ...{{ $pred }}) {{ $pred }} {
+		return p.join("AND", preds)
+	}
+
+	// Or returns predicate matching rows matched by p or any of preds
+	// nolint: dupl
+	func (p {{ $pred }}) Or(preds ...{{ $pred }}) {{ $pred }} {
+		return p.join("OR", preds)
+	}
+
+	// Not returns predicate matching rows not matched by p
+	// nolint: dupl
+	func (p {{ $pred }}) Not() {{ $pred }} {
+		if p.expr != "" {
+			p.expr = "NOT (" + p.expr + ")"
+		}
+
+		return p
+	}
+
+	// {{ $pred }}s creates predicates by {{ .StructName }} fields
+	type {{ $pred }}s struct{}
+
+	// {{ .StructName }}Q creates predicates by {{ .StructName }} fields
+	var {{ .StructName }}Q {{ $pred }}s
+
+	{{ range .Predicates }}
+		{{ .GetDoc .GetMethodName }}
+		func ({{ .GetReceiverDeclaration }}) {{ .GetMethodName }}({{ .GetArgsDeclaration }})
+		{{- .GetReturnValuesDeclaration }} {
+			{{ .GetBody }}
+		}
+	{{ end }}
+
+	// ===== END of {{ .StructName }} predicates
 {{ end }}
 
+{{- range .Subqueries }}
+
+// {{ . }} is a subquery selecting single column, it's created by Select
+// methods of querysets and passed to their InQuery and NotInQuery filters
+type {{ . }} struct {
+	db *gorm.DB
+}
+
+func (q {{ . }}) expr() interface{} {
+	{{- if $.Backend.IsGormV2 }}
+	return q.db
+	{{- else }}
+	return q.db.QueryExpr()
+	{{- end }}
+}
+{{- end }}
+
 // ===== END of all query sets
+{{ if .WithHelpers }}
+// ===== BEGIN of package helpers
+
+// WithTx runs fn in transaction: it's committed if fn returns nil and
+// rolled back if fn returns error or panics. If db is already a transaction,
+// savepoint is used instead of a new transaction: nested calls are
+// rolled back independently.
+{{- if .Backend.IsGormV2 }}
+func WithTx(db *gorm.DB, fn func(tx *gorm.DB) error) error {
+	return db.Transaction(fn)
+}
+{{- else }}
+func WithTx(db *gorm.DB, fn func(tx *gorm.DB) error) error {
+	if _, ok := db.CommonDB().(interface{ Rollback() error }); ok {
+		return withTxSavepoint(db, fn)
+	}
+
+	tx := db.Begin()
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	finished := false
+	defer func() {
+		if !finished {
+			tx.Rollback()
+		}
+	}()
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+
+	finished = true
+	return tx.Commit().Error
+}
+
+func withTxSavepoint(db *gorm.DB, fn func(tx *gorm.DB) error) error {
+	const depthKey = "goqueryset:savepoint_depth"
+	depth, _ := db.Get(depthKey)
+	n, _ := depth.(int)
+	savepoint := fmt.Sprintf("goqueryset_sp%d", n+1)
+
+	if err := db.Exec("SAVEPOINT " + savepoint).Error; err != nil {
+		return err
+	}
+
+	released := false
+	defer func() {
+		if !released {
+			db.Exec("ROLLBACK TO SAVEPOINT " + savepoint)
+		}
+	}()
+
+	if err := fn(db.Set(depthKey, n+1)); err != nil {
+		return err
+	}
+
+	released = true
+	return db.Exec("RELEASE SAVEPOINT " + savepoint).Error
+}
+{{- end }}
+
+// DBWithContext returns db executing queries with context ctx: it's needed
+// to pass context to struct modifiers (Create, Delete, Update).
+{{- if .Backend.IsGormV2 }}
+func DBWithContext(ctx context.Context, db *gorm.DB) *gorm.DB {
+	return db.WithContext(ctx)
+}
+{{- else }}
+// Gorm v1 doesn't support context, so its error is checked only before query.
+func DBWithContext(ctx context.Context, db *gorm.DB) *gorm.DB {
+	return db.Set(queryContextKey, ctx)
+}
+
+const queryContextKey = "goqueryset:context"
+
+// checkQueryContext returns copy of db with context error if context
+// set by DBWithContext is done: gorm doesn't execute queries then.
+func checkQueryContext(db *gorm.DB) *gorm.DB {
+	v, ok := db.Get(queryContextKey)
+	if !ok {
+		return db
+	}
+
+	ctx, ok := v.(context.Context)
+	if !ok || ctx.Err() == nil {
+		return db
+	}
+
+	db = db.Set(queryContextKey, ctx) // copy db to not change passed one
+	db.AddError(ctx.Err())
+	return db
+}
+
+const preloadsKey = "goqueryset:preloads"
+
+// preloadSpec is arguments of gorm Preload call
+type preloadSpec struct {
+	column     string
+	conditions []interface{}
+}
+
+// preload returns db preloading column and remembers preload: gorm v1
+// doesn't expose preloads, but InTx must move them into transaction
+func preload(db *gorm.DB, column string, conditions ...interface{}) *gorm.DB {
+	v, _ := db.Get(preloadsKey)
+	preloads, _ := v.([]preloadSpec)
+	preloads = append(preloads[:len(preloads):len(preloads)], preloadSpec{column: column, conditions: conditions})
+	return db.Preload(column, conditions...).Set(preloadsKey, preloads)
+}
+
+// txWithSettings returns tx with context and preloads of db: they
+// aren't in query expression of db, so InTx moves them explicitly
+func txWithSettings(db, tx *gorm.DB) *gorm.DB {
+	if ctx, ok := db.Get(queryContextKey); ok {
+		tx = tx.Set(queryContextKey, ctx)
+	}
+
+	v, _ := db.Get(preloadsKey)
+	preloads, _ := v.([]preloadSpec)
+	for _, p := range preloads {
+		tx = preload(tx, p.column, p.conditions...)
+	}
+	return tx
+}
+{{- end }}
+
+const pageOrdersKey = "goqueryset:page_orders"
+
+// pageOrder is an order of queryset remembered for keyset pagination
+type pageOrder struct {
+	column   string
+	desc     bool
+	nullable bool
+}
+
+// addPageOrder remembers order by column in db
+func addPageOrder(db *gorm.DB, column string, desc, nullable bool) *gorm.DB {
+	v, _ := db.Get(pageOrdersKey)
+	orders, _ := v.([]pageOrder)
+	orders = append(orders[:len(orders):len(orders)], pageOrder{column: column, desc: desc, nullable: nullable})
+	return db.Set(pageOrdersKey, orders)
+}
+
+// pageQuery returns db filtered by cursor and ordered by primary key after
+// orders of db, fieldPtrs are pointers to fields of model by their columns.
+// Columns are prefixed by table in SQL, table is empty if they aren't qualified.
+func pageQuery(db *gorm.DB, table, pk, cursor string,
+	fieldPtrs map[string]interface{}) (*gorm.DB, []pageOrder, error) {
+
+	v, _ := db.Get(pageOrdersKey)
+	orders, _ := v.([]pageOrder)
+	hasPKOrder := false
+	for _, o := range orders {
+		if _, ok := fieldPtrs[o.column]; !ok {
+			return nil, nil, fmt.Errorf("can't paginate by column %s: it's not a field of model", o.column)
+		}
+		if o.nullable {
+			// NULL isn't greater or less than cursor value: such rows would be lost
+			return nil, nil, fmt.Errorf("can't paginate by column %s: it's nullable", o.column)
+		}
+		hasPKOrder = hasPKOrder || o.column == pk
+	}
+	if !hasPKOrder {
+		orders = append(orders[:len(orders):len(orders)], pageOrder{column: pk})
+		db = db.Order(table + pk + " ASC")
+	}
+
+	if cursor == "" {
+		return db, orders, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid cursor: %s", err)
+	}
+	var values []json.RawMessage
+	if err = json.Unmarshal(data, &values); err != nil {
+		return nil, nil, fmt.Errorf("invalid cursor: %s", err)
+	}
+	if len(values) != len(orders) {
+		return nil, nil, errors.New("invalid cursor: it was made for other orders")
+	}
+
+	// (a > ?) OR (a = ? AND b < ?) OR ...
+	var conds []string
+	var args []interface{}
+	var eqConds []string
+	var eqArgs []interface{}
+	for i, o := range orders {
+		ptr := fieldPtrs[o.column]
+		if err = json.Unmarshal(values[i], ptr); err != nil {
+			return nil, nil, fmt.Errorf("invalid cursor value of %s: %s", o.column, err)
+		}
+		value := reflect.ValueOf(ptr).Elem().Interface()
+
+		op := ">"
+		if o.desc {
+			op = "<"
+		}
+		conds = append(conds, "("+strings.Join(append(eqConds, table+o.column+" "+op+" ?"), " AND ")+")")
+		args = append(append(args, eqArgs...), value)
+
+		eqConds = append(eqConds, table+o.column+" = ?")
+		eqArgs = append(eqArgs, value)
+	}
+
+	return db.Where(strings.Join(conds, " OR "), args...), orders, nil
+}
+
+// encodePageCursor encodes values of ordered fields of the last fetched row
+func encodePageCursor(orders []pageOrder, fieldPtrs map[string]interface{}) (string, error) {
+	var values []interface{}
+	for _, o := range orders {
+		values = append(values, fieldPtrs[o.column])
+	}
+
+	data, err := json.Marshal(values)
+	if err != nil {
+		return "", fmt.Errorf("can't encode cursor: %s", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// ValidationError is returned by Validate methods of models and updaters
+type ValidationError struct {
+	Field  string // name of invalid field
+	Reason string
+}
+
+func (e ValidationError) Error() string {
+	return fmt.Sprintf("invalid field %s: %s", e.Field, e.Reason)
+}
+
+// checkConflictFields checks that row updated by upsert can be found: only
+// MySQL finds conflicting row without conflict fields
+func checkConflictFields(db *gorm.DB, conflictColumns, updateColumns []string) error {
+	if len(conflictColumns) == 0 && len(updateColumns) != 0 && dialectName(db) != "mysql" {
+		return errors.New("conflict fields are required to update existing row by upsert")
+	}
+
+	return nil
+}
+
+// dialectName returns name of database dialect of db
+func dialectName(db *gorm.DB) string {
+	{{- if .Backend.IsGormV2 }}
+	return db.Dialector.Name()
+	{{- else }}
+	return db.Dialect().GetName()
+	{{- end }}
+}
+
+// likePatternEscaper escapes wildcards of LIKE with "!": backslash isn't
+// used because its escaping in string literals differs between databases.
+// "[" is a wildcard in SQL Server.
+var likePatternEscaper = strings.NewReplacer("!", "!!", "%", "!%", "_", "!_", "[", "![")
+
+// whereLike filters column by pattern: escaped value between prefix and
+// suffix. Case-insensitive matching uses ILIKE in PostgreSQL and LOWER elsewhere.
+func whereLike(db *gorm.DB, column, prefix, value, suffix string, caseInsensitive bool) *gorm.DB {
+	pattern := prefix + likePatternEscaper.Replace(value) + suffix
+	switch {
+	case !caseInsensitive:
+		return db.Where(column+" LIKE ? ESCAPE '!'", pattern)
+	case dialectName(db) == "postgres":
+		return db.Where(column+" ILIKE ? ESCAPE '!'", pattern)
+	default:
+		return db.Where("LOWER("+column+") LIKE LOWER(?) ESCAPE '!'", pattern)
+	}
+}
+
+// jsonColumnValue is a map or a slice stored in JSON column: it's marshaled
+// to JSON when passed to database, nil is stored as NULL
+type jsonColumnValue struct {
+	v interface{}
+}
+
+// Value implements driver.Valuer
+func (j jsonColumnValue) Value() (driver.Value, error) {
+	if reflect.ValueOf(j.v).IsNil() {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(j.v)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// whereJSONEq filters JSON column by text value at dot-separated path
+func whereJSONEq(db *gorm.DB, column, path, value string) *gorm.DB {
+	switch dialectName(db) {
+	case "postgres":
+		return db.Where(column+" #>> ? = ?", "{"+strings.Replace(path, ".", ",", -1)+"}", value)
+	case "mysql":
+		return db.Where("JSON_UNQUOTE(JSON_EXTRACT("+column+", ?)) = ?", "$."+path, value)
+	default:
+		return db.Where("CAST(JSON_EXTRACT("+column+", ?) AS TEXT) = ?", "$."+path, value)
+	}
+}
+
+// bulkInsertBatchSize returns max count of rows in one insert query:
+// databases limit count of placeholders in query
+func bulkInsertBatchSize(dialect string, columns int) int {
+	maxParams := 999 // sqlite and unknown dialects
+	switch dialect {
+	case "mysql", "postgres":
+		maxParams = 65535
+	case "mssql", "sqlserver":
+		maxParams = 2100
+	}
+
+	if columns == 0 || columns > maxParams {
+		return 1
+	}
+	return maxParams / columns
+}
+{{- if not .Backend.IsGormV2 }}
+
+// isZeroField checks whether field pointed by ptr has zero value
+func isZeroField(ptr interface{}) bool {
+	v := reflect.ValueOf(ptr).Elem()
+	return reflect.DeepEqual(v.Interface(), reflect.Zero(v.Type()).Interface())
+}
+
+// setGeneratedPK sets field of primary key pointed by ptr to id
+func setGeneratedPK(ptr interface{}, id int64) {
+	v := reflect.ValueOf(ptr).Elem()
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(id)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.SetUint(uint64(id))
+	}
+}
+
+// prepareInsertRows sets timestamps like gorm does on create and returns
+// sorted columns to insert: primary key is skipped if it's generated.
+// Columns of timestamps are taken from parsed model of db.
+func prepareInsertRows(db *gorm.DB, rows []map[string]interface{}, generatedPK string) []string {
+	scope := db.NewScope(db.Value)
+	var timeColumns []string
+	for _, name := range []string{"CreatedAt", "UpdatedAt"} {
+		if f, ok := scope.FieldByName(name); ok {
+			timeColumns = append(timeColumns, f.DBName)
+		}
+	}
+
+	now := gorm.NowFunc()
+	for _, r := range rows {
+		for _, c := range timeColumns {
+			if t, ok := r[c].(*time.Time); ok && t.IsZero() {
+				*t = now
+			}
+		}
+	}
+
+	var columns []string
+	for c := range rows[0] {
+		if c != generatedPK {
+			columns = append(columns, c)
+		}
+	}
+	sort.Strings(columns)
+	return columns
+}
+
+// defaultValueColumns returns columns of db model having default values
+func defaultValueColumns(db *gorm.DB) map[string]bool {
+	ret := map[string]bool{}
+	for _, f := range db.NewScope(db.Value).GetModelStruct().StructFields {
+		if f.HasDefaultValue && !f.IsPrimaryKey {
+			ret[f.DBName] = true
+		}
+	}
+	return ret
+}
+
+// rowInsertColumns returns columns to insert row by: columns having
+// default values are skipped if they are zero like gorm does on create
+func rowInsertColumns(columns []string, row map[string]interface{}, defaults map[string]bool) []string {
+	ret := make([]string, 0, len(columns))
+	for _, c := range columns {
+		if !defaults[c] || !isZeroField(row[c]) {
+			ret = append(ret, c)
+		}
+	}
+	return ret
+}
+
+// buildInsertQuery returns query inserting rows into table of db model
+func buildInsertQuery(db *gorm.DB, columns []string, rows []map[string]interface{}) (string, []interface{}) {
+	dialect := db.Dialect()
+	quotedColumns := make([]string, 0, len(columns))
+	for _, c := range columns {
+		quotedColumns = append(quotedColumns, dialect.Quote(c))
+	}
+
+	var values []string
+	var args []interface{}
+	for _, r := range rows {
+		placeholders := make([]string, 0, len(columns))
+		for _, c := range columns {
+			args = append(args, reflect.ValueOf(r[c]).Elem().Interface())
+			placeholders = append(placeholders, dialect.BindVar(len(args)))
+		}
+		values = append(values, "("+strings.Join(placeholders, ",")+")")
+	}
+
+	query := fmt.Sprintf("INSERT INTO %s (%s) VALUES %s", db.NewScope(db.Value).QuotedTableName(),
+		strings.Join(quotedColumns, ","), strings.Join(values, ","))
+	// gorm's common dialect bind var is replaced by "?" in queries
+	query = strings.Replace(query, "$$$", "?", -1)
+	return query, args
+}
+
+// bulkInsert inserts rows (pointers to fields by columns) into table of db model
+// by batches. Primary key is generated by database if it's not set in all rows.
+func bulkInsert(db *gorm.DB, pk string, rows []map[string]interface{}) error {
+	if db.Error != nil {
+		return db.Error
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+
+	generatedPK := ""
+	if pk != "" {
+		zeroPKs := 0
+		for _, r := range rows {
+			if isZeroField(r[pk]) {
+				zeroPKs++
+			}
+		}
+		if zeroPKs != 0 && zeroPKs != len(rows) {
+			return errors.New("primary key must be set in all rows or in none of them")
+		}
+		if zeroPKs != 0 {
+			generatedPK = pk
+		}
+	}
+
+	columns := prepareInsertRows(db, rows, generatedPK)
+	defaults := defaultValueColumns(db)
+	for start := 0; start < len(rows); {
+		// batch is made of consecutive rows with the same columns:
+		// zero columns having default values are skipped
+		batchColumns := rowInsertColumns(columns, rows[start], defaults)
+		size := bulkInsertBatchSize(db.Dialect().GetName(), len(batchColumns))
+		end := start + 1
+		for end < len(rows) && end-start < size &&
+			reflect.DeepEqual(rowInsertColumns(columns, rows[end], defaults), batchColumns) {
+			end++
+		}
+
+		if err := bulkInsertBatch(db, batchColumns, generatedPK, rows[start:end]); err != nil {
+			return err
+		}
+		start = end
+	}
+
+	return nil
+}
+
+// bulkInsertBatch inserts rows by one query and writes back generated
+// primary keys if database supports it
+func bulkInsertBatch(db *gorm.DB, columns []string, generatedPK string,
+	rows []map[string]interface{}) error {
+
+	dialect := db.Dialect()
+	query, args := buildInsertQuery(db, columns, rows)
+
+	if generatedPK != "" && dialect.GetName() == "postgres" {
+		// rows are returned in order of values
+		res, err := db.CommonDB().Query(query+" RETURNING "+dialect.Quote(generatedPK), args...)
+		if err != nil {
+			return err
+		}
+		defer res.Close()
+
+		for i := 0; res.Next(); i++ {
+			var id int64
+			if err = res.Scan(&id); err != nil {
+				return err
+			}
+			setGeneratedPK(rows[i][generatedPK], id)
+		}
+		return res.Err()
+	}
+
+	res, err := db.CommonDB().Exec(query, args...)
+	if err != nil {
+		return err
+	}
+
+	if generatedPK == "" {
+		return nil
+	}
+
+	var firstID int64
+	switch dialect.GetName() {
+	case "mysql":
+		// ids of rows inserted by one query are consecutive,
+		// the first of them is returned
+		firstID, err = res.LastInsertId()
+	case "sqlite3":
+		// the last id is returned
+		firstID, err = res.LastInsertId()
+		firstID -= int64(len(rows) - 1)
+	default:
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for i, r := range rows {
+		setGeneratedPK(r[generatedPK], firstID+int64(i))
+	}
+	return nil
+}
+
+// upsert inserts row (pointers to fields by columns) into table of db model
+// or updates updateColumns of existing row if insert violates unique
+// constraint on conflictColumns. Generated primary key is written back
+// for MySQL and PostgreSQL.
+func upsert(db *gorm.DB, pk string, row map[string]interface{},
+	conflictColumns, updateColumns []string) error {
+
+	if db.Error != nil {
+		return db.Error
+	}
+	if err := checkConflictFields(db, conflictColumns, updateColumns); err != nil {
+		return err
+	}
+
+	generatedPK := ""
+	if pk != "" && isZeroField(row[pk]) {
+		generatedPK = pk
+	}
+
+	rows := []map[string]interface{}{row}
+	columns := rowInsertColumns(prepareInsertRows(db, rows, generatedPK), row, defaultValueColumns(db))
+	query, args := buildInsertQuery(db, columns, rows)
+
+	dialect := db.Dialect()
+	var sets []string
+	switch dialect.GetName() {
+	case "mysql":
+		// conflict columns are chosen by MySQL itself
+		if generatedPK != "" {
+			// make LAST_INSERT_ID return id of updated row
+			sets = append(sets, fmt.Sprintf("%s = LAST_INSERT_ID(%s)",
+				dialect.Quote(generatedPK), dialect.Quote(generatedPK)))
+		}
+		for _, c := range updateColumns {
+			sets = append(sets, fmt.Sprintf("%s = VALUES(%s)", dialect.Quote(c), dialect.Quote(c)))
+		}
+		if len(sets) == 0 {
+			// do nothing: assign column to itself
+			c := pk
+			if len(conflictColumns) != 0 {
+				c = conflictColumns[0]
+			} else if c == "" {
+				c = columns[0]
+			}
+			sets = append(sets, dialect.Quote(c)+" = "+dialect.Quote(c))
+		}
+		query += " ON DUPLICATE KEY UPDATE " + strings.Join(sets, ", ")
+	case "postgres", "sqlite3":
+		var quotedConflictColumns []string
+		for _, c := range conflictColumns {
+			quotedConflictColumns = append(quotedConflictColumns, dialect.Quote(c))
+		}
+		for _, c := range updateColumns {
+			sets = append(sets, fmt.Sprintf("%s = excluded.%s", dialect.Quote(c), dialect.Quote(c)))
+		}
+
+		query += " ON CONFLICT"
+		if len(quotedConflictColumns) != 0 {
+			query += " (" + strings.Join(quotedConflictColumns, ",") + ")"
+		}
+		if len(sets) == 0 {
+			query += " DO NOTHING"
+		} else {
+			query += " DO UPDATE SET " + strings.Join(sets, ", ")
+		}
+	default:
+		return fmt.Errorf("upsert isn't supported for dialect %s", dialect.GetName())
+	}
+
+	if generatedPK != "" && dialect.GetName() == "postgres" {
+		var id int64
+		err := db.CommonDB().QueryRow(query+" RETURNING "+dialect.Quote(generatedPK), args...).Scan(&id)
+		if err == sql.ErrNoRows {
+			return nil // conflict without update
+		}
+		if err != nil {
+			return err
+		}
+
+		setGeneratedPK(row[generatedPK], id)
+		return nil
+	}
+
+	res, err := db.CommonDB().Exec(query, args...)
+	if err != nil {
+		return err
+	}
+
+	if generatedPK != "" && dialect.GetName() == "mysql" {
+		id, err := res.LastInsertId()
+		if err != nil {
+			return err
+		}
+		if id != 0 {
+			setGeneratedPK(row[generatedPK], id)
+		}
+	}
+
+	return nil
+}
+{{- end }}
+
+// ===== END of package helpers
+{{ end }}
 `
@@ -2,10 +2,18 @@
 package test
 
 import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"reflect"
+	"sort"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/jinzhu/gorm"
 	"github.com/jirfag/go-queryset/internal/queryset/generator/tmp"
@@ -13,25 +21,25 @@ import (
 
 // ===== BEGIN of all query sets
 
-// ===== BEGIN of query set BlogQuerySet
+// ===== BEGIN of query set AccountQuerySet
 
-// BlogQuerySet is an queryset type for Blog
-type BlogQuerySet struct {
+// AccountQuerySet is an queryset type for Account
+type AccountQuerySet struct {
 	db *gorm.DB
 }
 
-// NewBlogQuerySet constructs new BlogQuerySet
-func NewBlogQuerySet(db *gorm.DB) BlogQuerySet {
-	return BlogQuerySet{
-		db: db.Model(&Blog{}),
+// NewAccountQuerySet constructs new AccountQuerySet
+func NewAccountQuerySet(db *gorm.DB) AccountQuerySet {
+	return AccountQuerySet{
+		db: db.Model(&Account{}),
 	}
 }
 
-func (qs BlogQuerySet) w(db *gorm.DB) BlogQuerySet {
-	return NewBlogQuerySet(db)
+func (qs AccountQuerySet) w(db *gorm.DB) AccountQuerySet {
+	return NewAccountQuerySet(db)
 }
 
-func (qs BlogQuerySet) Select(fields ...BlogDBSchemaField) BlogQuerySet {
+func (qs AccountQuerySet) Select(fields ...AccountDBSchemaField) AccountQuerySet {
 	names := []string{}
 	for _, f := range fields {
 		names = append(names, f.String())
@@ -40,2234 +48,18900 @@ func (qs BlogQuerySet) Select(fields ...BlogDBSchemaField) BlogQuerySet {
 	return qs.w(qs.db.Select(strings.Join(names, ",")))
 }
 
+// GroupBy groups rows by fields
+func (qs AccountQuerySet) GroupBy(fields ...AccountDBSchemaField) AccountQuerySet {
+	names := []string{}
+	for _, f := range fields {
+		names = append(names, f.String())
+	}
+
+	return qs.w(qs.db.Group(strings.Join(names, ",")))
+}
+
+// Where filters rows by predicate p, predicates are created by AccountQ
+// nolint: dupl
+func (qs AccountQuerySet) Where(p AccountPredicate) AccountQuerySet {
+	if p.err != nil {
+		// error is added to copy of db: qs can be reused as a base queryset
+		db := qs.db.Model(&Account{})
+		db.AddError(p.err)
+		return qs.w(db)
+	}
+	if p.expr == "" {
+		return qs
+	}
+
+	return qs.w(qs.db.Where(p.expr, p.args...))
+}
+
+// Not filters rows not matching predicate p
+// nolint: dupl
+func (qs AccountQuerySet) Not(p AccountPredicate) AccountQuerySet {
+	return qs.Where(p.Not())
+}
+
 // Create is an autogenerated method
 // nolint: dupl
-func (o *Blog) Create(db *gorm.DB) error {
-	return db.Create(o).Error
+func (o *Account) Create(db *gorm.DB) error {
+	if err := o.Validate(); err != nil {
+		return err
+	}
+
+	return checkQueryContext(db).Create(o).Error
 }
 
 // Delete is an autogenerated method
 // nolint: dupl
-func (o *Blog) Delete(db *gorm.DB) error {
-	return db.Delete(o).Error
+func (o *Account) Delete(db *gorm.DB) error {
+	return checkQueryContext(db).Delete(o).Error
+}
+
+// AgeBetween filters rows with Age in range [from, to]
+// nolint: dupl
+func (qs AccountQuerySet) AgeBetween(from int, to int) AccountQuerySet {
+	return qs.w(qs.db.Where("age BETWEEN ? AND ?", from, to))
+}
+
+// AgeEq is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) AgeEq(age int) AccountQuerySet {
+	return qs.w(qs.db.Where("age = ?", age))
+}
+
+// AgeGt is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) AgeGt(age int) AccountQuerySet {
+	return qs.w(qs.db.Where("age > ?", age))
+}
+
+// AgeGte is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) AgeGte(age int) AccountQuerySet {
+	return qs.w(qs.db.Where("age >= ?", age))
+}
+
+// AgeIn is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) AgeIn(age ...int) AccountQuerySet {
+	if len(age) == 0 {
+		qs.db.AddError(errors.New("must at least pass one age in AgeIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("age IN (?)", age))
+}
+
+// AgeInQuery filters rows with Age IN values selected by subquery q
+// nolint: dupl
+func (qs AccountQuerySet) AgeInQuery(q IntSubquery) AccountQuerySet {
+	return qs.w(qs.db.Where("age IN (?)", q.expr()))
+}
+
+// AgeInRange filters rows with Age in range [from, to)
+// nolint: dupl
+func (qs AccountQuerySet) AgeInRange(from int, to int) AccountQuerySet {
+	return qs.w(qs.db.Where("age >= ? AND age < ?", from, to))
+}
+
+// AgeLt is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) AgeLt(age int) AccountQuerySet {
+	return qs.w(qs.db.Where("age < ?", age))
+}
+
+// AgeLte is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) AgeLte(age int) AccountQuerySet {
+	return qs.w(qs.db.Where("age <= ?", age))
+}
+
+// AgeNe is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) AgeNe(age int) AccountQuerySet {
+	return qs.w(qs.db.Where("age != ?", age))
+}
+
+// AgeNotIn is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) AgeNotIn(age ...int) AccountQuerySet {
+	if len(age) == 0 {
+		qs.db.AddError(errors.New("must at least pass one age in AgeNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("age NOT IN (?)", age))
+}
+
+// AgeNotInQuery filters rows with Age NOT IN values selected by subquery q
+// nolint: dupl
+func (qs AccountQuerySet) AgeNotInQuery(q IntSubquery) AccountQuerySet {
+	return qs.w(qs.db.Where("age NOT IN (?)", q.expr()))
 }
 
 // All is an autogenerated method
 // nolint: dupl
-func (qs BlogQuerySet) All(ret *[]Blog) error {
-	return qs.db.Find(ret).Error
+func (qs AccountQuerySet) All(ret *[]Account) error {
+	return checkQueryContext(qs.db).Find(ret).Error
+}
+
+// AvgAge returns AVG of Age, it's zero value if there are no rows
+// nolint: dupl
+func (qs AccountQuerySet) AvgAge() (float64, error) {
+	var ret float64
+	var res struct {
+		Value *float64
+	}
+	err := checkQueryContext(qs.db).Select("AVG(age) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// Batches calls fn for every batch of at most size rows, batches are
+// fetched by Page: ordered by primary key after queryset orders.
+// It stops on the first error of fn and returns it.
+// nolint: dupl
+func (qs AccountQuerySet) Batches(size int, fn func([]Account) error) error {
+	cursor := ""
+	for {
+		var batch []Account
+		next, err := qs.Page(cursor, size, &batch)
+		if err != nil {
+			return err
+		}
+
+		if len(batch) != 0 {
+			if err = fn(batch); err != nil {
+				return err
+			}
+		}
+
+		if next == "" {
+			return nil
+		}
+		cursor = next
+	}
 }
 
 // Count is an autogenerated method
 // nolint: dupl
-func (qs BlogQuerySet) Count() (int, error) {
+func (qs AccountQuerySet) Count() (int, error) {
 	var count int
-	err := qs.db.Count(&count).Error
+	db := checkQueryContext(qs.db)
+	if db.Error != nil {
+		return count, db.Error
+	}
+
+	err := db.Count(&count).Error
 	return count, err
 }
 
+// CountByAge returns count of rows for every value of Age
+// nolint: dupl
+func (qs AccountQuerySet) CountByAge() (map[int]int, error) {
+	var rows []struct {
+		Value int
+		Count int
+	}
+	err := checkQueryContext(qs.db).Select("age AS value, COUNT(*) AS count").Group("age").Scan(&rows).Error
+	ret := make(map[int]int, len(rows))
+	for _, r := range rows {
+		ret[r.Value] = r.Count
+	}
+	return ret, err
+}
+
+// CountByID returns count of rows for every value of ID
+// nolint: dupl
+func (qs AccountQuerySet) CountByID() (map[uint]int, error) {
+	var rows []struct {
+		Value uint
+		Count int
+	}
+	err := checkQueryContext(qs.db).Select("id AS value, COUNT(*) AS count").Group("id").Scan(&rows).Error
+	ret := make(map[uint]int, len(rows))
+	for _, r := range rows {
+		ret[r.Value] = r.Count
+	}
+	return ret, err
+}
+
+// CountByLogin returns count of rows for every value of Login
+// nolint: dupl
+func (qs AccountQuerySet) CountByLogin() (map[string]int, error) {
+	var rows []struct {
+		Value string
+		Count int
+	}
+	err := checkQueryContext(qs.db).Select("login AS value, COUNT(*) AS count").Group("login").Scan(&rows).Error
+	ret := make(map[string]int, len(rows))
+	for _, r := range rows {
+		ret[r.Value] = r.Count
+	}
+	return ret, err
+}
+
+// CountByRole returns count of rows for every value of Role
+// nolint: dupl
+func (qs AccountQuerySet) CountByRole() (map[string]int, error) {
+	var rows []struct {
+		Value string
+		Count int
+	}
+	err := checkQueryContext(qs.db).Select("role AS value, COUNT(*) AS count").Group("role").Scan(&rows).Error
+	ret := make(map[string]int, len(rows))
+	for _, r := range rows {
+		ret[r.Value] = r.Count
+	}
+	return ret, err
+}
+
+// CountByStr returns count of rows for every value of Str
+// nolint: dupl
+func (qs AccountQuerySet) CountByStr() (map[tmp.StringDef]int, error) {
+	var rows []struct {
+		Value tmp.StringDef
+		Count int
+	}
+	err := checkQueryContext(qs.db).Select("str AS value, COUNT(*) AS count").Group("str").Scan(&rows).Error
+	ret := make(map[tmp.StringDef]int, len(rows))
+	for _, r := range rows {
+		ret[r.Value] = r.Count
+	}
+	return ret, err
+}
+
+// CreateMany inserts models by multi-row inserts, conditions of queryset
+// aren't used. Generated primary keys are written back to models if
+// database supports it (all except MS SQL for gorm v1).
+// Unlike gorm Create it doesn't call hooks and doesn't read back default
+// values: zero fields having default values are skipped in insert.
+// nolint: dupl
+func (qs AccountQuerySet) CreateMany(models []Account) error {
+	for i := range models {
+		if err := models[i].Validate(); err != nil {
+			return err
+		}
+	}
+
+	rows := make([]map[string]interface{}, 0, len(models))
+	for i := range models {
+		rows = append(rows, qs.fieldPtrs(&models[i]))
+	}
+
+	return bulkInsert(checkQueryContext(qs.db), "id", rows)
+}
+
+// CreatedAtBetween filters rows with CreatedAt in range [from, to]
+// nolint: dupl
+func (qs AccountQuerySet) CreatedAtBetween(from time.Time, to time.Time) AccountQuerySet {
+	return qs.w(qs.db.Where("created_at BETWEEN ? AND ?", from, to))
+}
+
 // CreatedAtEq is an autogenerated method
 // nolint: dupl
-func (qs BlogQuerySet) CreatedAtEq(createdAt time.Time) BlogQuerySet {
+func (qs AccountQuerySet) CreatedAtEq(createdAt time.Time) AccountQuerySet {
 	return qs.w(qs.db.Where("created_at = ?", createdAt))
 }
 
 // CreatedAtGt is an autogenerated method
 // nolint: dupl
-func (qs BlogQuerySet) CreatedAtGt(createdAt time.Time) BlogQuerySet {
+func (qs AccountQuerySet) CreatedAtGt(createdAt time.Time) AccountQuerySet {
 	return qs.w(qs.db.Where("created_at > ?", createdAt))
 }
 
 // CreatedAtGte is an autogenerated method
 // nolint: dupl
-func (qs BlogQuerySet) CreatedAtGte(createdAt time.Time) BlogQuerySet {
+func (qs AccountQuerySet) CreatedAtGte(createdAt time.Time) AccountQuerySet {
 	return qs.w(qs.db.Where("created_at >= ?", createdAt))
 }
 
+// CreatedAtInRange filters rows with CreatedAt in range [from, to)
+// nolint: dupl
+func (qs AccountQuerySet) CreatedAtInRange(from time.Time, to time.Time) AccountQuerySet {
+	return qs.w(qs.db.Where("created_at >= ? AND created_at < ?", from, to))
+}
+
 // CreatedAtLt is an autogenerated method
 // nolint: dupl
-func (qs BlogQuerySet) CreatedAtLt(createdAt time.Time) BlogQuerySet {
+func (qs AccountQuerySet) CreatedAtLt(createdAt time.Time) AccountQuerySet {
 	return qs.w(qs.db.Where("created_at < ?", createdAt))
 }
 
 // CreatedAtLte is an autogenerated method
 // nolint: dupl
-func (qs BlogQuerySet) CreatedAtLte(createdAt time.Time) BlogQuerySet {
+func (qs AccountQuerySet) CreatedAtLte(createdAt time.Time) AccountQuerySet {
 	return qs.w(qs.db.Where("created_at <= ?", createdAt))
 }
 
 // CreatedAtNe is an autogenerated method
 // nolint: dupl
-func (qs BlogQuerySet) CreatedAtNe(createdAt time.Time) BlogQuerySet {
+func (qs AccountQuerySet) CreatedAtNe(createdAt time.Time) AccountQuerySet {
 	return qs.w(qs.db.Where("created_at != ?", createdAt))
 }
 
+// CreatedAtWithin filters rows with CreatedAt not earlier than d ago
+// nolint: dupl
+func (qs AccountQuerySet) CreatedAtWithin(d time.Duration) AccountQuerySet {
+	return qs.w(qs.db.Where("created_at >= ?", time.Now().Add(-d)))
+}
+
 // Delete is an autogenerated method
 // nolint: dupl
-func (qs BlogQuerySet) Delete() error {
-	return qs.db.Delete(Blog{}).Error
+func (qs AccountQuerySet) Delete() error {
+	return checkQueryContext(qs.db).Delete(Account{}).Error
 }
 
 // DeleteNum is an autogenerated method
 // nolint: dupl
-func (qs BlogQuerySet) DeleteNum() (int64, error) {
-	db := qs.db.Delete(Blog{})
+func (qs AccountQuerySet) DeleteNum() (int64, error) {
+	db := checkQueryContext(qs.db).Delete(Account{})
 	return db.RowsAffected, db.Error
 }
 
 // DeleteNumUnscoped is an autogenerated method
 // nolint: dupl
-func (qs BlogQuerySet) DeleteNumUnscoped() (int64, error) {
-	db := qs.db.Unscoped().Delete(Blog{})
+func (qs AccountQuerySet) DeleteNumUnscoped() (int64, error) {
+	db := checkQueryContext(qs.db).Unscoped().Delete(Account{})
 	return db.RowsAffected, db.Error
 }
 
+// DeletedAtBetween filters rows with DeletedAt in range [from, to]
+// nolint: dupl
+func (qs AccountQuerySet) DeletedAtBetween(from time.Time, to time.Time) AccountQuerySet {
+	return qs.w(qs.db.Where("deleted_at BETWEEN ? AND ?", from, to))
+}
+
 // DeletedAtEq is an autogenerated method
 // nolint: dupl
-func (qs BlogQuerySet) DeletedAtEq(deletedAt time.Time) BlogQuerySet {
+func (qs AccountQuerySet) DeletedAtEq(deletedAt time.Time) AccountQuerySet {
 	return qs.w(qs.db.Where("deleted_at = ?", deletedAt))
 }
 
 // DeletedAtGt is an autogenerated method
 // nolint: dupl
-func (qs BlogQuerySet) DeletedAtGt(deletedAt time.Time) BlogQuerySet {
+func (qs AccountQuerySet) DeletedAtGt(deletedAt time.Time) AccountQuerySet {
 	return qs.w(qs.db.Where("deleted_at > ?", deletedAt))
 }
 
 // DeletedAtGte is an autogenerated method
 // nolint: dupl
-func (qs BlogQuerySet) DeletedAtGte(deletedAt time.Time) BlogQuerySet {
+func (qs AccountQuerySet) DeletedAtGte(deletedAt time.Time) AccountQuerySet {
 	return qs.w(qs.db.Where("deleted_at >= ?", deletedAt))
 }
 
+// DeletedAtInRange filters rows with DeletedAt in range [from, to)
+// nolint: dupl
+func (qs AccountQuerySet) DeletedAtInRange(from time.Time, to time.Time) AccountQuerySet {
+	return qs.w(qs.db.Where("deleted_at >= ? AND deleted_at < ?", from, to))
+}
+
 // DeletedAtIsNotNull is an autogenerated method
 // nolint: dupl
-func (qs BlogQuerySet) DeletedAtIsNotNull() BlogQuerySet {
+func (qs AccountQuerySet) DeletedAtIsNotNull() AccountQuerySet {
 	return qs.w(qs.db.Where("deleted_at IS NOT NULL"))
 }
 
 // DeletedAtIsNull is an autogenerated method
 // nolint: dupl
-func (qs BlogQuerySet) DeletedAtIsNull() BlogQuerySet {
+func (qs AccountQuerySet) DeletedAtIsNull() AccountQuerySet {
 	return qs.w(qs.db.Where("deleted_at IS NULL"))
 }
 
 // DeletedAtLt is an autogenerated method
 // nolint: dupl
-func (qs BlogQuerySet) DeletedAtLt(deletedAt time.Time) BlogQuerySet {
+func (qs AccountQuerySet) DeletedAtLt(deletedAt time.Time) AccountQuerySet {
 	return qs.w(qs.db.Where("deleted_at < ?", deletedAt))
 }
 
 // DeletedAtLte is an autogenerated method
 // nolint: dupl
-func (qs BlogQuerySet) DeletedAtLte(deletedAt time.Time) BlogQuerySet {
+func (qs AccountQuerySet) DeletedAtLte(deletedAt time.Time) AccountQuerySet {
 	return qs.w(qs.db.Where("deleted_at <= ?", deletedAt))
 }
 
 // DeletedAtNe is an autogenerated method
 // nolint: dupl
-func (qs BlogQuerySet) DeletedAtNe(deletedAt time.Time) BlogQuerySet {
+func (qs AccountQuerySet) DeletedAtNe(deletedAt time.Time) AccountQuerySet {
 	return qs.w(qs.db.Where("deleted_at != ?", deletedAt))
 }
 
+// DeletedAtWithin filters rows with DeletedAt not earlier than d ago
+// nolint: dupl
+func (qs AccountQuerySet) DeletedAtWithin(d time.Duration) AccountQuerySet {
+	return qs.w(qs.db.Where("deleted_at >= ?", time.Now().Add(-d)))
+}
+
 // GetDB is an autogenerated method
 // nolint: dupl
-func (qs BlogQuerySet) GetDB() *gorm.DB {
+func (qs AccountQuerySet) GetDB() *gorm.DB {
 	return qs.db
 }
 
 // GetUpdater is an autogenerated method
 // nolint: dupl
-func (qs BlogQuerySet) GetUpdater() BlogUpdater {
-	return NewBlogUpdater(qs.db)
+func (qs AccountQuerySet) GetUpdater() AccountUpdater {
+	return NewAccountUpdater(qs.db)
 }
 
-// IDEq is an autogenerated method
+// GroupByAge is an autogenerated method
 // nolint: dupl
-func (qs BlogQuerySet) IDEq(ID uint) BlogQuerySet {
-	return qs.w(qs.db.Where("id = ?", ID))
+func (qs AccountQuerySet) GroupByAge() AccountQuerySet {
+	return qs.w(qs.db.Group("age"))
 }
 
-// IDGt is an autogenerated method
+// GroupByCreatedAt is an autogenerated method
 // nolint: dupl
-func (qs BlogQuerySet) IDGt(ID uint) BlogQuerySet {
-	return qs.w(qs.db.Where("id > ?", ID))
+func (qs AccountQuerySet) GroupByCreatedAt() AccountQuerySet {
+	return qs.w(qs.db.Group("created_at"))
 }
 
-// IDGte is an autogenerated method
+// GroupByDeletedAt is an autogenerated method
 // nolint: dupl
-func (qs BlogQuerySet) IDGte(ID uint) BlogQuerySet {
-	return qs.w(qs.db.Where("id >= ?", ID))
+func (qs AccountQuerySet) GroupByDeletedAt() AccountQuerySet {
+	return qs.w(qs.db.Group("deleted_at"))
 }
 
-// IDIn is an autogenerated method
+// GroupByID is an autogenerated method
 // nolint: dupl
-func (qs BlogQuerySet) IDIn(ID ...uint) BlogQuerySet {
-	if len(ID) == 0 {
-		qs.db.AddError(errors.New("must at least pass one ID in IDIn"))
-		return qs.w(qs.db)
-	}
-	return qs.w(qs.db.Where("id IN (?)", ID))
+func (qs AccountQuerySet) GroupByID() AccountQuerySet {
+	return qs.w(qs.db.Group("id"))
 }
 
-// IDLt is an autogenerated method
+// GroupByLogin is an autogenerated method
 // nolint: dupl
-func (qs BlogQuerySet) IDLt(ID uint) BlogQuerySet {
-	return qs.w(qs.db.Where("id < ?", ID))
+func (qs AccountQuerySet) GroupByLogin() AccountQuerySet {
+	return qs.w(qs.db.Group("login"))
 }
 
-// IDLte is an autogenerated method
+// GroupByNickname is an autogenerated method
 // nolint: dupl
-func (qs BlogQuerySet) IDLte(ID uint) BlogQuerySet {
-	return qs.w(qs.db.Where("id <= ?", ID))
+func (qs AccountQuerySet) GroupByNickname() AccountQuerySet {
+	return qs.w(qs.db.Group("nickname"))
 }
 
-// IDNe is an autogenerated method
+// GroupByRole is an autogenerated method
 // nolint: dupl
-func (qs BlogQuerySet) IDNe(ID uint) BlogQuerySet {
-	return qs.w(qs.db.Where("id != ?", ID))
+func (qs AccountQuerySet) GroupByRole() AccountQuerySet {
+	return qs.w(qs.db.Group("role"))
 }
 
-// IDNotIn is an autogenerated method
+// GroupByStr is an autogenerated method
 // nolint: dupl
-func (qs BlogQuerySet) IDNotIn(ID ...uint) BlogQuerySet {
-	if len(ID) == 0 {
-		qs.db.AddError(errors.New("must at least pass one ID in IDNotIn"))
-		return qs.w(qs.db)
-	}
-	return qs.w(qs.db.Where("id NOT IN (?)", ID))
+func (qs AccountQuerySet) GroupByStr() AccountQuerySet {
+	return qs.w(qs.db.Group("str"))
 }
 
-// Limit is an autogenerated method
+// GroupByUpdatedAt is an autogenerated method
 // nolint: dupl
-func (qs BlogQuerySet) Limit(limit int) BlogQuerySet {
-	return qs.w(qs.db.Limit(limit))
+func (qs AccountQuerySet) GroupByUpdatedAt() AccountQuerySet {
+	return qs.w(qs.db.Group("updated_at"))
 }
 
-// NameEq is an autogenerated method
+// HavingCountEq is an autogenerated method
 // nolint: dupl
-func (qs BlogQuerySet) NameEq(name string) BlogQuerySet {
-	return qs.w(qs.db.Where("myname = ?", name))
+func (qs AccountQuerySet) HavingCountEq(count int) AccountQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) = ?", count))
 }
 
-// NameGt is an autogenerated method
+// HavingCountGt is an autogenerated method
 // nolint: dupl
-func (qs BlogQuerySet) NameGt(name string) BlogQuerySet {
-	return qs.w(qs.db.Where("myname > ?", name))
+func (qs AccountQuerySet) HavingCountGt(count int) AccountQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) > ?", count))
 }
 
-// NameGte is an autogenerated method
+// HavingCountGte is an autogenerated method
 // nolint: dupl
-func (qs BlogQuerySet) NameGte(name string) BlogQuerySet {
-	return qs.w(qs.db.Where("myname >= ?", name))
+func (qs AccountQuerySet) HavingCountGte(count int) AccountQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) >= ?", count))
 }
 
-// NameIn is an autogenerated method
+// HavingCountLt is an autogenerated method
 // nolint: dupl
-func (qs BlogQuerySet) NameIn(name ...string) BlogQuerySet {
-	if len(name) == 0 {
-		qs.db.AddError(errors.New("must at least pass one name in NameIn"))
-		return qs.w(qs.db)
-	}
-	return qs.w(qs.db.Where("myname IN (?)", name))
+func (qs AccountQuerySet) HavingCountLt(count int) AccountQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) < ?", count))
 }
 
-// NameLike is an autogenerated method
+// HavingCountLte is an autogenerated method
 // nolint: dupl
-func (qs BlogQuerySet) NameLike(name string) BlogQuerySet {
-	return qs.w(qs.db.Where("myname LIKE ?", name))
+func (qs AccountQuerySet) HavingCountLte(count int) AccountQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) <= ?", count))
 }
 
-// NameLt is an autogenerated method
+// HavingCountNe is an autogenerated method
 // nolint: dupl
-func (qs BlogQuerySet) NameLt(name string) BlogQuerySet {
-	return qs.w(qs.db.Where("myname < ?", name))
+func (qs AccountQuerySet) HavingCountNe(count int) AccountQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) != ?", count))
 }
 
-// NameLte is an autogenerated method
+// IDBetween filters rows with ID in range [from, to]
 // nolint: dupl
-func (qs BlogQuerySet) NameLte(name string) BlogQuerySet {
-	return qs.w(qs.db.Where("myname <= ?", name))
+func (qs AccountQuerySet) IDBetween(from uint, to uint) AccountQuerySet {
+	return qs.w(qs.db.Where("id BETWEEN ? AND ?", from, to))
 }
 
-// NameNe is an autogenerated method
+// IDEq is an autogenerated method
 // nolint: dupl
-func (qs BlogQuerySet) NameNe(name string) BlogQuerySet {
-	return qs.w(qs.db.Where("myname != ?", name))
+func (qs AccountQuerySet) IDEq(ID uint) AccountQuerySet {
+	return qs.w(qs.db.Where("id = ?", ID))
 }
 
-// NameNotIn is an autogenerated method
+// IDGt is an autogenerated method
 // nolint: dupl
-func (qs BlogQuerySet) NameNotIn(name ...string) BlogQuerySet {
-	if len(name) == 0 {
-		qs.db.AddError(errors.New("must at least pass one name in NameNotIn"))
-		return qs.w(qs.db)
-	}
-	return qs.w(qs.db.Where("myname NOT IN (?)", name))
+func (qs AccountQuerySet) IDGt(ID uint) AccountQuerySet {
+	return qs.w(qs.db.Where("id > ?", ID))
 }
 
-// NameNotlike is an autogenerated method
+// IDGte is an autogenerated method
 // nolint: dupl
-func (qs BlogQuerySet) NameNotlike(name string) BlogQuerySet {
-	return qs.w(qs.db.Where("myname NOT LIKE ?", name))
+func (qs AccountQuerySet) IDGte(ID uint) AccountQuerySet {
+	return qs.w(qs.db.Where("id >= ?", ID))
 }
 
-// Offset is an autogenerated method
+// IDIn is an autogenerated method
 // nolint: dupl
-func (qs BlogQuerySet) Offset(offset int) BlogQuerySet {
-	return qs.w(qs.db.Offset(offset))
+func (qs AccountQuerySet) IDIn(ID ...uint) AccountQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("id IN (?)", ID))
 }
 
-// One is used to retrieve one result. It returns gorm.ErrRecordNotFound
-// if nothing was fetched
-func (qs BlogQuerySet) One(ret *Blog) error {
-	return qs.db.First(ret).Error
+// IDInQuery filters rows with ID IN values selected by subquery q
+// nolint: dupl
+func (qs AccountQuerySet) IDInQuery(q UintSubquery) AccountQuerySet {
+	return qs.w(qs.db.Where("id IN (?)", q.expr()))
 }
 
-// OrderAscByCreatedAt is an autogenerated method
+// IDInRange filters rows with ID in range [from, to)
 // nolint: dupl
-func (qs BlogQuerySet) OrderAscByCreatedAt() BlogQuerySet {
-	return qs.w(qs.db.Order("created_at ASC"))
+func (qs AccountQuerySet) IDInRange(from uint, to uint) AccountQuerySet {
+	return qs.w(qs.db.Where("id >= ? AND id < ?", from, to))
 }
 
-// OrderAscByDeletedAt is an autogenerated method
+// IDLt is an autogenerated method
 // nolint: dupl
-func (qs BlogQuerySet) OrderAscByDeletedAt() BlogQuerySet {
-	return qs.w(qs.db.Order("deleted_at ASC"))
+func (qs AccountQuerySet) IDLt(ID uint) AccountQuerySet {
+	return qs.w(qs.db.Where("id < ?", ID))
 }
 
-// OrderAscByID is an autogenerated method
+// IDLte is an autogenerated method
 // nolint: dupl
-func (qs BlogQuerySet) OrderAscByID() BlogQuerySet {
-	return qs.w(qs.db.Order("id ASC"))
+func (qs AccountQuerySet) IDLte(ID uint) AccountQuerySet {
+	return qs.w(qs.db.Where("id <= ?", ID))
 }
 
-// OrderAscByName is an autogenerated method
+// IDNe is an autogenerated method
 // nolint: dupl
-func (qs BlogQuerySet) OrderAscByName() BlogQuerySet {
-	return qs.w(qs.db.Order("myname ASC"))
+func (qs AccountQuerySet) IDNe(ID uint) AccountQuerySet {
+	return qs.w(qs.db.Where("id != ?", ID))
 }
 
-// OrderAscByUpdatedAt is an autogenerated method
+// IDNotIn is an autogenerated method
 // nolint: dupl
-func (qs BlogQuerySet) OrderAscByUpdatedAt() BlogQuerySet {
-	return qs.w(qs.db.Order("updated_at ASC"))
+func (qs AccountQuerySet) IDNotIn(ID ...uint) AccountQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("id NOT IN (?)", ID))
 }
 
-// OrderDescByCreatedAt is an autogenerated method
+// IDNotInQuery filters rows with ID NOT IN values selected by subquery q
 // nolint: dupl
-func (qs BlogQuerySet) OrderDescByCreatedAt() BlogQuerySet {
-	return qs.w(qs.db.Order("created_at DESC"))
+func (qs AccountQuerySet) IDNotInQuery(q UintSubquery) AccountQuerySet {
+	return qs.w(qs.db.Where("id NOT IN (?)", q.expr()))
 }
 
-// OrderDescByDeletedAt is an autogenerated method
+// InTx returns copy bound to transaction tx: queries are executed in it.
+// Gorm v1 can't move conditions into another connection, so with gorm v1
+// InTx must be called before any condition is set, otherwise queries fail.
+// Context and preloads are moved into transaction by both gorm versions.
 // nolint: dupl
-func (qs BlogQuerySet) OrderDescByDeletedAt() BlogQuerySet {
-	return qs.w(qs.db.Order("deleted_at DESC"))
+func (qs AccountQuerySet) InTx(tx *gorm.DB) AccountQuerySet {
+	if !reflect.DeepEqual(qs.db.QueryExpr(), NewAccountQuerySet(qs.db.New()).db.QueryExpr()) {
+		tx = tx.New()
+		tx.AddError(errors.New("InTx must be called before any condition is set: " +
+			"gorm v1 can't move conditions into transaction"))
+	}
+	tx = txWithSettings(qs.db, tx)
+	return NewAccountQuerySet(tx)
 }
 
-// OrderDescByID is an autogenerated method
+// Iterate scans rows one by one and calls fn for every row without
+// loading all rows into memory. It stops on the first error of fn and
+// returns it. Preloads aren't applied.
 // nolint: dupl
-func (qs BlogQuerySet) OrderDescByID() BlogQuerySet {
-	return qs.w(qs.db.Order("id DESC"))
+func (qs AccountQuerySet) Iterate(fn func(*Account) error) error {
+	db := checkQueryContext(qs.db)
+	if db.Error != nil {
+		return db.Error // gorm v1 doesn't check it in Rows
+	}
+
+	rows, err := db.Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var o Account
+		if err = db.ScanRows(rows, &o); err != nil {
+			return err
+		}
+		if err = fn(&o); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
 }
 
-// OrderDescByName is an autogenerated method
+// Limit is an autogenerated method
 // nolint: dupl
-func (qs BlogQuerySet) OrderDescByName() BlogQuerySet {
-	return qs.w(qs.db.Order("myname DESC"))
+func (qs AccountQuerySet) Limit(limit int) AccountQuerySet {
+	return qs.w(qs.db.Limit(limit))
 }
 
-// OrderDescByUpdatedAt is an autogenerated method
+// LoginEndsWith filters rows with Login ending with the argument
 // nolint: dupl
-func (qs BlogQuerySet) OrderDescByUpdatedAt() BlogQuerySet {
-	return qs.w(qs.db.Order("updated_at DESC"))
+func (qs AccountQuerySet) LoginEndsWith(login string) AccountQuerySet {
+	return qs.w(whereLike(qs.db, "login", "%", login, "", false))
 }
 
-// UpdatedAtEq is an autogenerated method
+// LoginEq is an autogenerated method
 // nolint: dupl
-func (qs BlogQuerySet) UpdatedAtEq(updatedAt time.Time) BlogQuerySet {
-	return qs.w(qs.db.Where("updated_at = ?", updatedAt))
+func (qs AccountQuerySet) LoginEq(login string) AccountQuerySet {
+	return qs.w(qs.db.Where("login = ?", login))
 }
 
-// UpdatedAtGt is an autogenerated method
+// LoginGt is an autogenerated method
 // nolint: dupl
-func (qs BlogQuerySet) UpdatedAtGt(updatedAt time.Time) BlogQuerySet {
-	return qs.w(qs.db.Where("updated_at > ?", updatedAt))
+func (qs AccountQuerySet) LoginGt(login string) AccountQuerySet {
+	return qs.w(qs.db.Where("login > ?", login))
 }
 
-// UpdatedAtGte is an autogenerated method
+// LoginGte is an autogenerated method
 // nolint: dupl
-func (qs BlogQuerySet) UpdatedAtGte(updatedAt time.Time) BlogQuerySet {
-	return qs.w(qs.db.Where("updated_at >= ?", updatedAt))
+func (qs AccountQuerySet) LoginGte(login string) AccountQuerySet {
+	return qs.w(qs.db.Where("login >= ?", login))
 }
 
-// UpdatedAtLt is an autogenerated method
+// LoginIContains filters rows with Login containing the argument, case is ignored
 // nolint: dupl
-func (qs BlogQuerySet) UpdatedAtLt(updatedAt time.Time) BlogQuerySet {
-	return qs.w(qs.db.Where("updated_at < ?", updatedAt))
+func (qs AccountQuerySet) LoginIContains(login string) AccountQuerySet {
+	return qs.w(whereLike(qs.db, "login", "%", login, "%", true))
 }
 
-// UpdatedAtLte is an autogenerated method
+// LoginIEq filters rows with Login equal to the argument, case is ignored
 // nolint: dupl
-func (qs BlogQuerySet) UpdatedAtLte(updatedAt time.Time) BlogQuerySet {
-	return qs.w(qs.db.Where("updated_at <= ?", updatedAt))
+func (qs AccountQuerySet) LoginIEq(login string) AccountQuerySet {
+	return qs.w(whereLike(qs.db, "login", "", login, "", true))
 }
 
-// UpdatedAtNe is an autogenerated method
+// LoginIn is an autogenerated method
 // nolint: dupl
-func (qs BlogQuerySet) UpdatedAtNe(updatedAt time.Time) BlogQuerySet {
-	return qs.w(qs.db.Where("updated_at != ?", updatedAt))
+func (qs AccountQuerySet) LoginIn(login ...string) AccountQuerySet {
+	if len(login) == 0 {
+		qs.db.AddError(errors.New("must at least pass one login in LoginIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("login IN (?)", login))
 }
 
-// SetCreatedAt is an autogenerated method
+// LoginInQuery filters rows with Login IN values selected by subquery q
 // nolint: dupl
-func (u BlogUpdater) SetCreatedAt(createdAt time.Time) BlogUpdater {
-	u.fields[string(BlogDBSchema.CreatedAt)] = createdAt
-	return u
+func (qs AccountQuerySet) LoginInQuery(q StringSubquery) AccountQuerySet {
+	return qs.w(qs.db.Where("login IN (?)", q.expr()))
 }
 
-// SetDeletedAt is an autogenerated method
+// LoginLike is an autogenerated method
 // nolint: dupl
-func (u BlogUpdater) SetDeletedAt(deletedAt *time.Time) BlogUpdater {
-	u.fields[string(BlogDBSchema.DeletedAt)] = deletedAt
-	return u
+func (qs AccountQuerySet) LoginLike(login string) AccountQuerySet {
+	return qs.w(qs.db.Where("login LIKE ?", login))
 }
 
-// SetID is an autogenerated method
+// LoginLt is an autogenerated method
 // nolint: dupl
-func (u BlogUpdater) SetID(ID uint) BlogUpdater {
-	u.fields[string(BlogDBSchema.ID)] = ID
-	return u
+func (qs AccountQuerySet) LoginLt(login string) AccountQuerySet {
+	return qs.w(qs.db.Where("login < ?", login))
 }
 
-// SetName is an autogenerated method
+// LoginLte is an autogenerated method
 // nolint: dupl
-func (u BlogUpdater) SetName(name string) BlogUpdater {
-	u.fields[string(BlogDBSchema.Name)] = name
-	return u
+func (qs AccountQuerySet) LoginLte(login string) AccountQuerySet {
+	return qs.w(qs.db.Where("login <= ?", login))
 }
 
-// SetUpdatedAt is an autogenerated method
+// LoginNe is an autogenerated method
 // nolint: dupl
-func (u BlogUpdater) SetUpdatedAt(updatedAt time.Time) BlogUpdater {
-	u.fields[string(BlogDBSchema.UpdatedAt)] = updatedAt
-	return u
+func (qs AccountQuerySet) LoginNe(login string) AccountQuerySet {
+	return qs.w(qs.db.Where("login != ?", login))
 }
 
-// Update is an autogenerated method
+// LoginNotIn is an autogenerated method
 // nolint: dupl
-func (u BlogUpdater) Update() error {
-	return u.db.Updates(u.fields).Error
+func (qs AccountQuerySet) LoginNotIn(login ...string) AccountQuerySet {
+	if len(login) == 0 {
+		qs.db.AddError(errors.New("must at least pass one login in LoginNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("login NOT IN (?)", login))
 }
 
-// UpdateNum is an autogenerated method
+// LoginNotInQuery filters rows with Login NOT IN values selected by subquery q
 // nolint: dupl
-func (u BlogUpdater) UpdateNum() (int64, error) {
-	db := u.db.Updates(u.fields)
-	return db.RowsAffected, db.Error
+func (qs AccountQuerySet) LoginNotInQuery(q StringSubquery) AccountQuerySet {
+	return qs.w(qs.db.Where("login NOT IN (?)", q.expr()))
 }
 
-// ===== END of query set BlogQuerySet
-
-// ===== BEGIN of Blog modifiers
+// LoginNotlike is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) LoginNotlike(login string) AccountQuerySet {
+	return qs.w(qs.db.Where("login NOT LIKE ?", login))
+}
 
-// BlogDBSchemaField describes database schema field. It requires for method 'Update'
-type BlogDBSchemaField string
+// LoginStartsWith filters rows with Login starting with the argument
+// nolint: dupl
+func (qs AccountQuerySet) LoginStartsWith(login string) AccountQuerySet {
+	return qs.w(whereLike(qs.db, "login", "", login, "%", false))
+}
 
-// String method returns string representation of field.
+// MaxAge returns MAX of Age, it's zero value if there are no rows
 // nolint: dupl
-func (f BlogDBSchemaField) String() string {
-	return string(f)
+func (qs AccountQuerySet) MaxAge() (int, error) {
+	var ret int
+	var res struct {
+		Value *int
+	}
+	err := checkQueryContext(qs.db).Select("MAX(age) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
 }
 
-// BlogDBSchema stores db field names of Blog
-var BlogDBSchema = struct {
-	ID        BlogDBSchemaField
-	CreatedAt BlogDBSchemaField
-	UpdatedAt BlogDBSchemaField
-	DeletedAt BlogDBSchemaField
-	Name      BlogDBSchemaField
-}{
+// MaxCreatedAt returns MAX of CreatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs AccountQuerySet) MaxCreatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := checkQueryContext(qs.db).Select("created_at AS value").Where("created_at IS NOT NULL").
+		Order("created_at DESC", true).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
 
-	ID:        BlogDBSchemaField("id"),
-	CreatedAt: BlogDBSchemaField("created_at"),
-	UpdatedAt: BlogDBSchemaField("updated_at"),
-	DeletedAt: BlogDBSchemaField("deleted_at"),
-	Name:      BlogDBSchemaField("myname"),
+// MaxID returns MAX of ID, it's zero value if there are no rows
+// nolint: dupl
+func (qs AccountQuerySet) MaxID() (uint, error) {
+	var ret uint
+	var res struct {
+		Value *uint
+	}
+	err := checkQueryContext(qs.db).Select("MAX(id) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
 }
 
-// Update updates Blog fields by primary key
+// MaxUpdatedAt returns MAX of UpdatedAt, it's zero value if there are no rows
 // nolint: dupl
-func (o *Blog) Update(db *gorm.DB, fields ...BlogDBSchemaField) error {
-	dbNameToFieldName := map[string]interface{}{
-		"id":         o.ID,
-		"created_at": o.CreatedAt,
-		"updated_at": o.UpdatedAt,
-		"deleted_at": o.DeletedAt,
-		"myname":     o.Name,
+func (qs AccountQuerySet) MaxUpdatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
 	}
-	u := map[string]interface{}{}
-	for _, f := range fields {
-		fs := f.String()
-		u[fs] = dbNameToFieldName[fs]
+	err := checkQueryContext(qs.db).Select("updated_at AS value").Where("updated_at IS NOT NULL").
+		Order("updated_at DESC", true).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
 	}
-	if err := db.Model(o).Updates(u).Error; err != nil {
-		if err == gorm.ErrRecordNotFound {
-			return err
-		}
+	return rows[0].Value, err
+}
 
-		return fmt.Errorf("can't update Blog %v fields %v: %s",
-			o, fields, err)
+// MinAge returns MIN of Age, it's zero value if there are no rows
+// nolint: dupl
+func (qs AccountQuerySet) MinAge() (int, error) {
+	var ret int
+	var res struct {
+		Value *int
 	}
+	err := checkQueryContext(qs.db).Select("MIN(age) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
 
-	return nil
+// MinCreatedAt returns MIN of CreatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs AccountQuerySet) MinCreatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := checkQueryContext(qs.db).Select("created_at AS value").Where("created_at IS NOT NULL").
+		Order("created_at ASC", true).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
 }
 
-// BlogUpdater is an Blog updates manager
-type BlogUpdater struct {
-	fields map[string]interface{}
-	db     *gorm.DB
+// MinID returns MIN of ID, it's zero value if there are no rows
+// nolint: dupl
+func (qs AccountQuerySet) MinID() (uint, error) {
+	var ret uint
+	var res struct {
+		Value *uint
+	}
+	err := checkQueryContext(qs.db).Select("MIN(id) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
 }
 
-// NewBlogUpdater creates new Blog updater
+// MinUpdatedAt returns MIN of UpdatedAt, it's zero value if there are no rows
 // nolint: dupl
-func NewBlogUpdater(db *gorm.DB) BlogUpdater {
-	return BlogUpdater{
-		fields: map[string]interface{}{},
-		db:     db.Model(&Blog{}),
+func (qs AccountQuerySet) MinUpdatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
 	}
+	err := checkQueryContext(qs.db).Select("updated_at AS value").Where("updated_at IS NOT NULL").
+		Order("updated_at ASC", true).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
 }
 
-// ===== END of Blog modifiers
+// NicknameEndsWith filters rows with Nickname ending with the argument
+// nolint: dupl
+func (qs AccountQuerySet) NicknameEndsWith(nickname string) AccountQuerySet {
+	return qs.w(whereLike(qs.db, "nickname", "%", nickname, "", false))
+}
 
-// ===== BEGIN of query set CheckReservedKeywordsQuerySet
+// NicknameEq is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) NicknameEq(nickname string) AccountQuerySet {
+	return qs.w(qs.db.Where("nickname = ?", nickname))
+}
 
-// CheckReservedKeywordsQuerySet is an queryset type for CheckReservedKeywords
-type CheckReservedKeywordsQuerySet struct {
-	db *gorm.DB
+// NicknameGt is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) NicknameGt(nickname string) AccountQuerySet {
+	return qs.w(qs.db.Where("nickname > ?", nickname))
 }
 
-// NewCheckReservedKeywordsQuerySet constructs new CheckReservedKeywordsQuerySet
-func NewCheckReservedKeywordsQuerySet(db *gorm.DB) CheckReservedKeywordsQuerySet {
-	return CheckReservedKeywordsQuerySet{
-		db: db.Model(&CheckReservedKeywords{}),
-	}
+// NicknameGte is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) NicknameGte(nickname string) AccountQuerySet {
+	return qs.w(qs.db.Where("nickname >= ?", nickname))
 }
 
-func (qs CheckReservedKeywordsQuerySet) w(db *gorm.DB) CheckReservedKeywordsQuerySet {
-	return NewCheckReservedKeywordsQuerySet(db)
+// NicknameIContains filters rows with Nickname containing the argument, case is ignored
+// nolint: dupl
+func (qs AccountQuerySet) NicknameIContains(nickname string) AccountQuerySet {
+	return qs.w(whereLike(qs.db, "nickname", "%", nickname, "%", true))
 }
 
-func (qs CheckReservedKeywordsQuerySet) Select(fields ...CheckReservedKeywordsDBSchemaField) CheckReservedKeywordsQuerySet {
-	names := []string{}
-	for _, f := range fields {
-		names = append(names, f.String())
+// NicknameIEq filters rows with Nickname equal to the argument, case is ignored
+// nolint: dupl
+func (qs AccountQuerySet) NicknameIEq(nickname string) AccountQuerySet {
+	return qs.w(whereLike(qs.db, "nickname", "", nickname, "", true))
+}
+
+// NicknameIn is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) NicknameIn(nickname ...string) AccountQuerySet {
+	if len(nickname) == 0 {
+		qs.db.AddError(errors.New("must at least pass one nickname in NicknameIn"))
+		return qs.w(qs.db)
 	}
+	return qs.w(qs.db.Where("nickname IN (?)", nickname))
+}
 
-	return qs.w(qs.db.Select(strings.Join(names, ",")))
+// NicknameInQuery filters rows with Nickname IN values selected by subquery q
+// nolint: dupl
+func (qs AccountQuerySet) NicknameInQuery(q StringSubquery) AccountQuerySet {
+	return qs.w(qs.db.Where("nickname IN (?)", q.expr()))
 }
 
-// Create is an autogenerated method
+// NicknameIsNotNull is an autogenerated method
 // nolint: dupl
-func (o *CheckReservedKeywords) Create(db *gorm.DB) error {
-	return db.Create(o).Error
+func (qs AccountQuerySet) NicknameIsNotNull() AccountQuerySet {
+	return qs.w(qs.db.Where("nickname IS NOT NULL"))
 }
 
-// Delete is an autogenerated method
+// NicknameIsNull is an autogenerated method
 // nolint: dupl
-func (o *CheckReservedKeywords) Delete(db *gorm.DB) error {
-	return db.Delete(o).Error
+func (qs AccountQuerySet) NicknameIsNull() AccountQuerySet {
+	return qs.w(qs.db.Where("nickname IS NULL"))
 }
 
-// All is an autogenerated method
+// NicknameLike is an autogenerated method
 // nolint: dupl
-func (qs CheckReservedKeywordsQuerySet) All(ret *[]CheckReservedKeywords) error {
-	return qs.db.Find(ret).Error
+func (qs AccountQuerySet) NicknameLike(nickname string) AccountQuerySet {
+	return qs.w(qs.db.Where("nickname LIKE ?", nickname))
 }
 
-// Count is an autogenerated method
+// NicknameLt is an autogenerated method
 // nolint: dupl
-func (qs CheckReservedKeywordsQuerySet) Count() (int, error) {
-	var count int
-	err := qs.db.Count(&count).Error
-	return count, err
+func (qs AccountQuerySet) NicknameLt(nickname string) AccountQuerySet {
+	return qs.w(qs.db.Where("nickname < ?", nickname))
 }
 
-// Delete is an autogenerated method
+// NicknameLte is an autogenerated method
 // nolint: dupl
-func (qs CheckReservedKeywordsQuerySet) Delete() error {
-	return qs.db.Delete(CheckReservedKeywords{}).Error
+func (qs AccountQuerySet) NicknameLte(nickname string) AccountQuerySet {
+	return qs.w(qs.db.Where("nickname <= ?", nickname))
 }
 
-// DeleteNum is an autogenerated method
+// NicknameNe is an autogenerated method
 // nolint: dupl
-func (qs CheckReservedKeywordsQuerySet) DeleteNum() (int64, error) {
-	db := qs.db.Delete(CheckReservedKeywords{})
-	return db.RowsAffected, db.Error
+func (qs AccountQuerySet) NicknameNe(nickname string) AccountQuerySet {
+	return qs.w(qs.db.Where("nickname != ?", nickname))
 }
 
-// DeleteNumUnscoped is an autogenerated method
+// NicknameNotIn is an autogenerated method
 // nolint: dupl
-func (qs CheckReservedKeywordsQuerySet) DeleteNumUnscoped() (int64, error) {
-	db := qs.db.Unscoped().Delete(CheckReservedKeywords{})
-	return db.RowsAffected, db.Error
+func (qs AccountQuerySet) NicknameNotIn(nickname ...string) AccountQuerySet {
+	if len(nickname) == 0 {
+		qs.db.AddError(errors.New("must at least pass one nickname in NicknameNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("nickname NOT IN (?)", nickname))
 }
 
-// GetDB is an autogenerated method
+// NicknameNotInQuery filters rows with Nickname NOT IN values selected by subquery q
 // nolint: dupl
-func (qs CheckReservedKeywordsQuerySet) GetDB() *gorm.DB {
-	return qs.db
+func (qs AccountQuerySet) NicknameNotInQuery(q StringSubquery) AccountQuerySet {
+	return qs.w(qs.db.Where("nickname NOT IN (?)", q.expr()))
 }
 
-// GetUpdater is an autogenerated method
+// NicknameNotlike is an autogenerated method
 // nolint: dupl
-func (qs CheckReservedKeywordsQuerySet) GetUpdater() CheckReservedKeywordsUpdater {
-	return NewCheckReservedKeywordsUpdater(qs.db)
+func (qs AccountQuerySet) NicknameNotlike(nickname string) AccountQuerySet {
+	return qs.w(qs.db.Where("nickname NOT LIKE ?", nickname))
 }
 
-// Limit is an autogenerated method
+// NicknameStartsWith filters rows with Nickname starting with the argument
 // nolint: dupl
-func (qs CheckReservedKeywordsQuerySet) Limit(limit int) CheckReservedKeywordsQuerySet {
-	return qs.w(qs.db.Limit(limit))
+func (qs AccountQuerySet) NicknameStartsWith(nickname string) AccountQuerySet {
+	return qs.w(whereLike(qs.db, "nickname", "", nickname, "%", false))
 }
 
 // Offset is an autogenerated method
 // nolint: dupl
-func (qs CheckReservedKeywordsQuerySet) Offset(offset int) CheckReservedKeywordsQuerySet {
+func (qs AccountQuerySet) Offset(offset int) AccountQuerySet {
 	return qs.w(qs.db.Offset(offset))
 }
 
 // One is used to retrieve one result. It returns gorm.ErrRecordNotFound
 // if nothing was fetched
-func (qs CheckReservedKeywordsQuerySet) One(ret *CheckReservedKeywords) error {
-	return qs.db.First(ret).Error
+func (qs AccountQuerySet) One(ret *Account) error {
+	return checkQueryContext(qs.db).First(ret).Error
 }
 
-// OrderAscByStruct is an autogenerated method
+// OrderAscByAge is an autogenerated method
 // nolint: dupl
-func (qs CheckReservedKeywordsQuerySet) OrderAscByStruct() CheckReservedKeywordsQuerySet {
-	return qs.w(qs.db.Order("struct ASC"))
+func (qs AccountQuerySet) OrderAscByAge() AccountQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("age ASC"), "age", false, false))
 }
 
-// OrderAscByType is an autogenerated method
+// OrderAscByCreatedAt is an autogenerated method
 // nolint: dupl
-func (qs CheckReservedKeywordsQuerySet) OrderAscByType() CheckReservedKeywordsQuerySet {
-	return qs.w(qs.db.Order("type ASC"))
+func (qs AccountQuerySet) OrderAscByCreatedAt() AccountQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("created_at ASC"), "created_at", false, false))
 }
 
-// OrderDescByStruct is an autogenerated method
+// OrderAscByDeletedAt is an autogenerated method
 // nolint: dupl
-func (qs CheckReservedKeywordsQuerySet) OrderDescByStruct() CheckReservedKeywordsQuerySet {
-	return qs.w(qs.db.Order("struct DESC"))
+func (qs AccountQuerySet) OrderAscByDeletedAt() AccountQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("deleted_at ASC"), "deleted_at", false, false))
 }
 
-// OrderDescByType is an autogenerated method
+// OrderAscByID is an autogenerated method
 // nolint: dupl
-func (qs CheckReservedKeywordsQuerySet) OrderDescByType() CheckReservedKeywordsQuerySet {
-	return qs.w(qs.db.Order("type DESC"))
+func (qs AccountQuerySet) OrderAscByID() AccountQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("id ASC"), "id", false, false))
 }
 
-// StructEq is an autogenerated method
+// OrderAscByLogin is an autogenerated method
 // nolint: dupl
-func (qs CheckReservedKeywordsQuerySet) StructEq(structValue int) CheckReservedKeywordsQuerySet {
-	return qs.w(qs.db.Where("struct = ?", structValue))
+func (qs AccountQuerySet) OrderAscByLogin() AccountQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("login ASC"), "login", false, false))
 }
 
-// StructGt is an autogenerated method
+// OrderAscByNickname is an autogenerated method
 // nolint: dupl
-func (qs CheckReservedKeywordsQuerySet) StructGt(structValue int) CheckReservedKeywordsQuerySet {
-	return qs.w(qs.db.Where("struct > ?", structValue))
+func (qs AccountQuerySet) OrderAscByNickname() AccountQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("nickname ASC"), "nickname", false, false))
 }
 
-// StructGte is an autogenerated method
+// OrderAscByRole is an autogenerated method
 // nolint: dupl
-func (qs CheckReservedKeywordsQuerySet) StructGte(structValue int) CheckReservedKeywordsQuerySet {
-	return qs.w(qs.db.Where("struct >= ?", structValue))
+func (qs AccountQuerySet) OrderAscByRole() AccountQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("role ASC"), "role", false, false))
 }
 
-// StructIn is an autogenerated method
+// OrderAscByStr is an autogenerated method
 // nolint: dupl
-func (qs CheckReservedKeywordsQuerySet) StructIn(structValue ...int) CheckReservedKeywordsQuerySet {
-	if len(structValue) == 0 {
-		qs.db.AddError(errors.New("must at least pass one structValue in StructIn"))
-		return qs.w(qs.db)
-	}
-	return qs.w(qs.db.Where("struct IN (?)", structValue))
+func (qs AccountQuerySet) OrderAscByStr() AccountQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("str ASC"), "str", false, false))
 }
 
-// StructLt is an autogenerated method
+// OrderAscByUpdatedAt is an autogenerated method
 // nolint: dupl
-func (qs CheckReservedKeywordsQuerySet) StructLt(structValue int) CheckReservedKeywordsQuerySet {
-	return qs.w(qs.db.Where("struct < ?", structValue))
+func (qs AccountQuerySet) OrderAscByUpdatedAt() AccountQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("updated_at ASC"), "updated_at", false, false))
 }
 
-// StructLte is an autogenerated method
+// OrderDescByAge is an autogenerated method
 // nolint: dupl
-func (qs CheckReservedKeywordsQuerySet) StructLte(structValue int) CheckReservedKeywordsQuerySet {
-	return qs.w(qs.db.Where("struct <= ?", structValue))
+func (qs AccountQuerySet) OrderDescByAge() AccountQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("age DESC"), "age", true, false))
 }
 
-// StructNe is an autogenerated method
+// OrderDescByCreatedAt is an autogenerated method
 // nolint: dupl
-func (qs CheckReservedKeywordsQuerySet) StructNe(structValue int) CheckReservedKeywordsQuerySet {
-	return qs.w(qs.db.Where("struct != ?", structValue))
+func (qs AccountQuerySet) OrderDescByCreatedAt() AccountQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("created_at DESC"), "created_at", true, false))
 }
 
-// StructNotIn is an autogenerated method
+// OrderDescByDeletedAt is an autogenerated method
 // nolint: dupl
-func (qs CheckReservedKeywordsQuerySet) StructNotIn(structValue ...int) CheckReservedKeywordsQuerySet {
-	if len(structValue) == 0 {
-		qs.db.AddError(errors.New("must at least pass one structValue in StructNotIn"))
-		return qs.w(qs.db)
-	}
-	return qs.w(qs.db.Where("struct NOT IN (?)", structValue))
+func (qs AccountQuerySet) OrderDescByDeletedAt() AccountQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("deleted_at DESC"), "deleted_at", true, false))
 }
 
-// TypeEq is an autogenerated method
+// OrderDescByID is an autogenerated method
 // nolint: dupl
-func (qs CheckReservedKeywordsQuerySet) TypeEq(typeValue string) CheckReservedKeywordsQuerySet {
-	return qs.w(qs.db.Where("type = ?", typeValue))
+func (qs AccountQuerySet) OrderDescByID() AccountQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("id DESC"), "id", true, false))
 }
 
-// TypeGt is an autogenerated method
+// OrderDescByLogin is an autogenerated method
 // nolint: dupl
-func (qs CheckReservedKeywordsQuerySet) TypeGt(typeValue string) CheckReservedKeywordsQuerySet {
-	return qs.w(qs.db.Where("type > ?", typeValue))
+func (qs AccountQuerySet) OrderDescByLogin() AccountQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("login DESC"), "login", true, false))
 }
 
-// TypeGte is an autogenerated method
+// OrderDescByNickname is an autogenerated method
 // nolint: dupl
-func (qs CheckReservedKeywordsQuerySet) TypeGte(typeValue string) CheckReservedKeywordsQuerySet {
-	return qs.w(qs.db.Where("type >= ?", typeValue))
+func (qs AccountQuerySet) OrderDescByNickname() AccountQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("nickname DESC"), "nickname", true, false))
 }
 
-// TypeIn is an autogenerated method
+// OrderDescByRole is an autogenerated method
 // nolint: dupl
-func (qs CheckReservedKeywordsQuerySet) TypeIn(typeValue ...string) CheckReservedKeywordsQuerySet {
-	if len(typeValue) == 0 {
-		qs.db.AddError(errors.New("must at least pass one typeValue in TypeIn"))
-		return qs.w(qs.db)
-	}
-	return qs.w(qs.db.Where("type IN (?)", typeValue))
+func (qs AccountQuerySet) OrderDescByRole() AccountQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("role DESC"), "role", true, false))
 }
 
-// TypeLike is an autogenerated method
+// OrderDescByStr is an autogenerated method
 // nolint: dupl
-func (qs CheckReservedKeywordsQuerySet) TypeLike(typeValue string) CheckReservedKeywordsQuerySet {
-	return qs.w(qs.db.Where("type LIKE ?", typeValue))
+func (qs AccountQuerySet) OrderDescByStr() AccountQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("str DESC"), "str", true, false))
 }
 
-// TypeLt is an autogenerated method
+// OrderDescByUpdatedAt is an autogenerated method
 // nolint: dupl
-func (qs CheckReservedKeywordsQuerySet) TypeLt(typeValue string) CheckReservedKeywordsQuerySet {
-	return qs.w(qs.db.Where("type < ?", typeValue))
+func (qs AccountQuerySet) OrderDescByUpdatedAt() AccountQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("updated_at DESC"), "updated_at", true, false))
 }
 
-// TypeLte is an autogenerated method
+// Page fetches at most size rows following cursor into ret, it uses
+// orders set by OrderAscBy/OrderDescBy methods and primary key to break ties.
+// Ordered fields can't be nullable: rows with NULL can't be compared with
+// cursor, so error is returned for them. Empty cursor means the first page. It returns cursor of the next page or
+// empty string if there are no more rows.
 // nolint: dupl
-func (qs CheckReservedKeywordsQuerySet) TypeLte(typeValue string) CheckReservedKeywordsQuerySet {
-	return qs.w(qs.db.Where("type <= ?", typeValue))
-}
+func (qs AccountQuerySet) Page(cursor string, size int, ret *[]Account) (string, error) {
+	if size <= 0 {
+		return "", errors.New("page size must be positive")
+	}
 
-// TypeNe is an autogenerated method
-// nolint: dupl
-func (qs CheckReservedKeywordsQuerySet) TypeNe(typeValue string) CheckReservedKeywordsQuerySet {
-	return qs.w(qs.db.Where("type != ?", typeValue))
-}
+	var last Account
+	db, orders, err := pageQuery(qs.db, "", "id", cursor, qs.fieldPtrs(&last))
+	if err != nil {
+		return "", err
+	}
 
-// TypeNotIn is an autogenerated method
-// nolint: dupl
-func (qs CheckReservedKeywordsQuerySet) TypeNotIn(typeValue ...string) CheckReservedKeywordsQuerySet {
-	if len(typeValue) == 0 {
-		qs.db.AddError(errors.New("must at least pass one typeValue in TypeNotIn"))
-		return qs.w(qs.db)
+	if err = checkQueryContext(db).Limit(size + 1).Find(ret).Error; err != nil {
+		return "", err
+	}
+	if len(*ret) <= size {
+		return "", nil
 	}
-	return qs.w(qs.db.Where("type NOT IN (?)", typeValue))
-}
 
-// TypeNotlike is an autogenerated method
-// nolint: dupl
-func (qs CheckReservedKeywordsQuerySet) TypeNotlike(typeValue string) CheckReservedKeywordsQuerySet {
-	return qs.w(qs.db.Where("type NOT LIKE ?", typeValue))
+	*ret = (*ret)[:size]
+	return encodePageCursor(orders, qs.fieldPtrs(&(*ret)[size-1]))
 }
 
-// SetStruct is an autogenerated method
+// RoleEndsWith filters rows with Role ending with the argument
 // nolint: dupl
-func (u CheckReservedKeywordsUpdater) SetStruct(structValue int) CheckReservedKeywordsUpdater {
-	u.fields[string(CheckReservedKeywordsDBSchema.Struct)] = structValue
-	return u
+func (qs AccountQuerySet) RoleEndsWith(role string) AccountQuerySet {
+	return qs.w(whereLike(qs.db, "role", "%", role, "", false))
 }
 
-// SetType is an autogenerated method
+// RoleEq is an autogenerated method
 // nolint: dupl
-func (u CheckReservedKeywordsUpdater) SetType(typeValue string) CheckReservedKeywordsUpdater {
-	u.fields[string(CheckReservedKeywordsDBSchema.Type)] = typeValue
-	return u
+func (qs AccountQuerySet) RoleEq(role string) AccountQuerySet {
+	return qs.w(qs.db.Where("role = ?", role))
 }
 
-// Update is an autogenerated method
+// RoleGt is an autogenerated method
 // nolint: dupl
-func (u CheckReservedKeywordsUpdater) Update() error {
-	return u.db.Updates(u.fields).Error
+func (qs AccountQuerySet) RoleGt(role string) AccountQuerySet {
+	return qs.w(qs.db.Where("role > ?", role))
 }
 
-// UpdateNum is an autogenerated method
+// RoleGte is an autogenerated method
 // nolint: dupl
-func (u CheckReservedKeywordsUpdater) UpdateNum() (int64, error) {
-	db := u.db.Updates(u.fields)
-	return db.RowsAffected, db.Error
+func (qs AccountQuerySet) RoleGte(role string) AccountQuerySet {
+	return qs.w(qs.db.Where("role >= ?", role))
 }
 
-// ===== END of query set CheckReservedKeywordsQuerySet
-
-// ===== BEGIN of CheckReservedKeywords modifiers
-
-// CheckReservedKeywordsDBSchemaField describes database schema field. It requires for method 'Update'
-type CheckReservedKeywordsDBSchemaField string
-
-// String method returns string representation of field.
+// RoleIContains filters rows with Role containing the argument, case is ignored
 // nolint: dupl
-func (f CheckReservedKeywordsDBSchemaField) String() string {
-	return string(f)
+func (qs AccountQuerySet) RoleIContains(role string) AccountQuerySet {
+	return qs.w(whereLike(qs.db, "role", "%", role, "%", true))
 }
 
-// CheckReservedKeywordsDBSchema stores db field names of CheckReservedKeywords
-var CheckReservedKeywordsDBSchema = struct {
-	Type   CheckReservedKeywordsDBSchemaField
-	Struct CheckReservedKeywordsDBSchemaField
-}{
-
-	Type:   CheckReservedKeywordsDBSchemaField("type"),
-	Struct: CheckReservedKeywordsDBSchemaField("struct"),
+// RoleIEq filters rows with Role equal to the argument, case is ignored
+// nolint: dupl
+func (qs AccountQuerySet) RoleIEq(role string) AccountQuerySet {
+	return qs.w(whereLike(qs.db, "role", "", role, "", true))
 }
 
-// Update updates CheckReservedKeywords fields by primary key
+// RoleIn is an autogenerated method
 // nolint: dupl
-func (o *CheckReservedKeywords) Update(db *gorm.DB, fields ...CheckReservedKeywordsDBSchemaField) error {
-	dbNameToFieldName := map[string]interface{}{
-		"type":   o.Type,
-		"struct": o.Struct,
-	}
-	u := map[string]interface{}{}
-	for _, f := range fields {
-		fs := f.String()
-		u[fs] = dbNameToFieldName[fs]
-	}
-	if err := db.Model(o).Updates(u).Error; err != nil {
-		if err == gorm.ErrRecordNotFound {
-			return err
-		}
-
-		return fmt.Errorf("can't update CheckReservedKeywords %v fields %v: %s",
-			o, fields, err)
+func (qs AccountQuerySet) RoleIn(role ...string) AccountQuerySet {
+	if len(role) == 0 {
+		qs.db.AddError(errors.New("must at least pass one role in RoleIn"))
+		return qs.w(qs.db)
 	}
-
-	return nil
+	return qs.w(qs.db.Where("role IN (?)", role))
 }
 
-// CheckReservedKeywordsUpdater is an CheckReservedKeywords updates manager
-type CheckReservedKeywordsUpdater struct {
-	fields map[string]interface{}
-	db     *gorm.DB
+// RoleInQuery filters rows with Role IN values selected by subquery q
+// nolint: dupl
+func (qs AccountQuerySet) RoleInQuery(q StringSubquery) AccountQuerySet {
+	return qs.w(qs.db.Where("role IN (?)", q.expr()))
 }
 
-// NewCheckReservedKeywordsUpdater creates new CheckReservedKeywords updater
+// RoleLike is an autogenerated method
 // nolint: dupl
-func NewCheckReservedKeywordsUpdater(db *gorm.DB) CheckReservedKeywordsUpdater {
-	return CheckReservedKeywordsUpdater{
-		fields: map[string]interface{}{},
-		db:     db.Model(&CheckReservedKeywords{}),
-	}
+func (qs AccountQuerySet) RoleLike(role string) AccountQuerySet {
+	return qs.w(qs.db.Where("role LIKE ?", role))
 }
 
-// ===== END of CheckReservedKeywords modifiers
-
-// ===== BEGIN of query set PostQuerySet
-
-// PostQuerySet is an queryset type for Post
-type PostQuerySet struct {
-	db *gorm.DB
+// RoleLt is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) RoleLt(role string) AccountQuerySet {
+	return qs.w(qs.db.Where("role < ?", role))
 }
 
-// NewPostQuerySet constructs new PostQuerySet
-func NewPostQuerySet(db *gorm.DB) PostQuerySet {
-	return PostQuerySet{
-		db: db.Model(&Post{}),
-	}
+// RoleLte is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) RoleLte(role string) AccountQuerySet {
+	return qs.w(qs.db.Where("role <= ?", role))
 }
 
-func (qs PostQuerySet) w(db *gorm.DB) PostQuerySet {
-	return NewPostQuerySet(db)
+// RoleNe is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) RoleNe(role string) AccountQuerySet {
+	return qs.w(qs.db.Where("role != ?", role))
 }
 
-func (qs PostQuerySet) Select(fields ...PostDBSchemaField) PostQuerySet {
-	names := []string{}
-	for _, f := range fields {
-		names = append(names, f.String())
+// RoleNotIn is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) RoleNotIn(role ...string) AccountQuerySet {
+	if len(role) == 0 {
+		qs.db.AddError(errors.New("must at least pass one role in RoleNotIn"))
+		return qs.w(qs.db)
 	}
-
-	return qs.w(qs.db.Select(strings.Join(names, ",")))
+	return qs.w(qs.db.Where("role NOT IN (?)", role))
 }
 
-// Create is an autogenerated method
+// RoleNotInQuery filters rows with Role NOT IN values selected by subquery q
 // nolint: dupl
-func (o *Post) Create(db *gorm.DB) error {
-	return db.Create(o).Error
+func (qs AccountQuerySet) RoleNotInQuery(q StringSubquery) AccountQuerySet {
+	return qs.w(qs.db.Where("role NOT IN (?)", q.expr()))
 }
 
-// Delete is an autogenerated method
+// RoleNotlike is an autogenerated method
 // nolint: dupl
-func (o *Post) Delete(db *gorm.DB) error {
-	return db.Delete(o).Error
+func (qs AccountQuerySet) RoleNotlike(role string) AccountQuerySet {
+	return qs.w(qs.db.Where("role NOT LIKE ?", role))
 }
 
-// All is an autogenerated method
+// RoleStartsWith filters rows with Role starting with the argument
 // nolint: dupl
-func (qs PostQuerySet) All(ret *[]Post) error {
-	return qs.db.Find(ret).Error
+func (qs AccountQuerySet) RoleStartsWith(role string) AccountQuerySet {
+	return qs.w(whereLike(qs.db, "role", "", role, "%", false))
 }
 
-// BlogIsNotNull is an autogenerated method
+// SelectAge returns subquery selecting Age of rows of queryset
+// for InQuery and NotInQuery filters
 // nolint: dupl
-func (qs PostQuerySet) BlogIsNotNull() PostQuerySet {
-	return qs.w(qs.db.Where("blog IS NOT NULL"))
+func (qs AccountQuerySet) SelectAge() IntSubquery {
+	return IntSubquery{db: qs.db.Select("age")}
 }
 
-// BlogIsNull is an autogenerated method
+// SelectID returns subquery selecting ID of rows of queryset
+// for InQuery and NotInQuery filters
 // nolint: dupl
-func (qs PostQuerySet) BlogIsNull() PostQuerySet {
-	return qs.w(qs.db.Where("blog IS NULL"))
+func (qs AccountQuerySet) SelectID() UintSubquery {
+	return UintSubquery{db: qs.db.Select("id")}
 }
 
-// Count is an autogenerated method
+// SelectLogin returns subquery selecting Login of rows of queryset
+// for InQuery and NotInQuery filters
 // nolint: dupl
-func (qs PostQuerySet) Count() (int, error) {
-	var count int
-	err := qs.db.Count(&count).Error
-	return count, err
+func (qs AccountQuerySet) SelectLogin() StringSubquery {
+	return StringSubquery{db: qs.db.Select("login")}
 }
 
-// CreatedAtEq is an autogenerated method
+// SelectRole returns subquery selecting Role of rows of queryset
+// for InQuery and NotInQuery filters
 // nolint: dupl
-func (qs PostQuerySet) CreatedAtEq(createdAt time.Time) PostQuerySet {
-	return qs.w(qs.db.Where("created_at = ?", createdAt))
+func (qs AccountQuerySet) SelectRole() StringSubquery {
+	return StringSubquery{db: qs.db.Select("role")}
 }
 
-// CreatedAtGt is an autogenerated method
+// SelectStr returns subquery selecting Str of rows of queryset
+// for InQuery and NotInQuery filters
 // nolint: dupl
-func (qs PostQuerySet) CreatedAtGt(createdAt time.Time) PostQuerySet {
-	return qs.w(qs.db.Where("created_at > ?", createdAt))
+func (qs AccountQuerySet) SelectStr() TmpStringDefSubquery {
+	return TmpStringDefSubquery{db: qs.db.Select("str")}
 }
 
-// CreatedAtGte is an autogenerated method
+// StrEndsWith filters rows with Str ending with the argument
 // nolint: dupl
-func (qs PostQuerySet) CreatedAtGte(createdAt time.Time) PostQuerySet {
-	return qs.w(qs.db.Where("created_at >= ?", createdAt))
+func (qs AccountQuerySet) StrEndsWith(str tmp.StringDef) AccountQuerySet {
+	return qs.w(whereLike(qs.db, "str", "%", string(str), "", false))
 }
 
-// CreatedAtLt is an autogenerated method
+// StrEq is an autogenerated method
 // nolint: dupl
-func (qs PostQuerySet) CreatedAtLt(createdAt time.Time) PostQuerySet {
-	return qs.w(qs.db.Where("created_at < ?", createdAt))
+func (qs AccountQuerySet) StrEq(str tmp.StringDef) AccountQuerySet {
+	return qs.w(qs.db.Where("str = ?", str))
 }
 
-// CreatedAtLte is an autogenerated method
+// StrGt is an autogenerated method
 // nolint: dupl
-func (qs PostQuerySet) CreatedAtLte(createdAt time.Time) PostQuerySet {
-	return qs.w(qs.db.Where("created_at <= ?", createdAt))
+func (qs AccountQuerySet) StrGt(str tmp.StringDef) AccountQuerySet {
+	return qs.w(qs.db.Where("str > ?", str))
 }
 
-// CreatedAtNe is an autogenerated method
+// StrGte is an autogenerated method
 // nolint: dupl
-func (qs PostQuerySet) CreatedAtNe(createdAt time.Time) PostQuerySet {
-	return qs.w(qs.db.Where("created_at != ?", createdAt))
+func (qs AccountQuerySet) StrGte(str tmp.StringDef) AccountQuerySet {
+	return qs.w(qs.db.Where("str >= ?", str))
 }
 
-// Delete is an autogenerated method
+// StrIContains filters rows with Str containing the argument, case is ignored
 // nolint: dupl
-func (qs PostQuerySet) Delete() error {
-	return qs.db.Delete(Post{}).Error
+func (qs AccountQuerySet) StrIContains(str tmp.StringDef) AccountQuerySet {
+	return qs.w(whereLike(qs.db, "str", "%", string(str), "%", true))
 }
 
-// DeleteNum is an autogenerated method
+// StrIEq filters rows with Str equal to the argument, case is ignored
 // nolint: dupl
-func (qs PostQuerySet) DeleteNum() (int64, error) {
-	db := qs.db.Delete(Post{})
-	return db.RowsAffected, db.Error
+func (qs AccountQuerySet) StrIEq(str tmp.StringDef) AccountQuerySet {
+	return qs.w(whereLike(qs.db, "str", "", string(str), "", true))
 }
 
-// DeleteNumUnscoped is an autogenerated method
+// StrIn is an autogenerated method
 // nolint: dupl
-func (qs PostQuerySet) DeleteNumUnscoped() (int64, error) {
-	db := qs.db.Unscoped().Delete(Post{})
-	return db.RowsAffected, db.Error
+func (qs AccountQuerySet) StrIn(str ...tmp.StringDef) AccountQuerySet {
+	if len(str) == 0 {
+		qs.db.AddError(errors.New("must at least pass one str in StrIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("str IN (?)", str))
 }
 
-// DeletedAtEq is an autogenerated method
+// StrInQuery filters rows with Str IN values selected by subquery q
 // nolint: dupl
-func (qs PostQuerySet) DeletedAtEq(deletedAt time.Time) PostQuerySet {
-	return qs.w(qs.db.Where("deleted_at = ?", deletedAt))
+func (qs AccountQuerySet) StrInQuery(q TmpStringDefSubquery) AccountQuerySet {
+	return qs.w(qs.db.Where("str IN (?)", q.expr()))
 }
 
-// DeletedAtGt is an autogenerated method
+// StrLike is an autogenerated method
 // nolint: dupl
-func (qs PostQuerySet) DeletedAtGt(deletedAt time.Time) PostQuerySet {
-	return qs.w(qs.db.Where("deleted_at > ?", deletedAt))
+func (qs AccountQuerySet) StrLike(str tmp.StringDef) AccountQuerySet {
+	return qs.w(qs.db.Where("str LIKE ?", str))
 }
 
-// DeletedAtGte is an autogenerated method
+// StrLt is an autogenerated method
 // nolint: dupl
-func (qs PostQuerySet) DeletedAtGte(deletedAt time.Time) PostQuerySet {
-	return qs.w(qs.db.Where("deleted_at >= ?", deletedAt))
+func (qs AccountQuerySet) StrLt(str tmp.StringDef) AccountQuerySet {
+	return qs.w(qs.db.Where("str < ?", str))
 }
 
-// DeletedAtIsNotNull is an autogenerated method
+// StrLte is an autogenerated method
 // nolint: dupl
-func (qs PostQuerySet) DeletedAtIsNotNull() PostQuerySet {
-	return qs.w(qs.db.Where("deleted_at IS NOT NULL"))
+func (qs AccountQuerySet) StrLte(str tmp.StringDef) AccountQuerySet {
+	return qs.w(qs.db.Where("str <= ?", str))
 }
 
-// DeletedAtIsNull is an autogenerated method
+// StrNe is an autogenerated method
 // nolint: dupl
-func (qs PostQuerySet) DeletedAtIsNull() PostQuerySet {
-	return qs.w(qs.db.Where("deleted_at IS NULL"))
+func (qs AccountQuerySet) StrNe(str tmp.StringDef) AccountQuerySet {
+	return qs.w(qs.db.Where("str != ?", str))
 }
 
-// DeletedAtLt is an autogenerated method
+// StrNotIn is an autogenerated method
 // nolint: dupl
-func (qs PostQuerySet) DeletedAtLt(deletedAt time.Time) PostQuerySet {
-	return qs.w(qs.db.Where("deleted_at < ?", deletedAt))
+func (qs AccountQuerySet) StrNotIn(str ...tmp.StringDef) AccountQuerySet {
+	if len(str) == 0 {
+		qs.db.AddError(errors.New("must at least pass one str in StrNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("str NOT IN (?)", str))
 }
 
-// DeletedAtLte is an autogenerated method
+// StrNotInQuery filters rows with Str NOT IN values selected by subquery q
 // nolint: dupl
-func (qs PostQuerySet) DeletedAtLte(deletedAt time.Time) PostQuerySet {
-	return qs.w(qs.db.Where("deleted_at <= ?", deletedAt))
+func (qs AccountQuerySet) StrNotInQuery(q TmpStringDefSubquery) AccountQuerySet {
+	return qs.w(qs.db.Where("str NOT IN (?)", q.expr()))
 }
 
-// DeletedAtNe is an autogenerated method
+// StrNotlike is an autogenerated method
 // nolint: dupl
-func (qs PostQuerySet) DeletedAtNe(deletedAt time.Time) PostQuerySet {
-	return qs.w(qs.db.Where("deleted_at != ?", deletedAt))
+func (qs AccountQuerySet) StrNotlike(str tmp.StringDef) AccountQuerySet {
+	return qs.w(qs.db.Where("str NOT LIKE ?", str))
 }
 
-// GetDB is an autogenerated method
+// StrStartsWith filters rows with Str starting with the argument
 // nolint: dupl
-func (qs PostQuerySet) GetDB() *gorm.DB {
-	return qs.db
+func (qs AccountQuerySet) StrStartsWith(str tmp.StringDef) AccountQuerySet {
+	return qs.w(whereLike(qs.db, "str", "", string(str), "%", false))
 }
 
-// GetUpdater is an autogenerated method
+// SumAge returns SUM of Age, it's zero value if there are no rows
 // nolint: dupl
-func (qs PostQuerySet) GetUpdater() PostUpdater {
-	return NewPostUpdater(qs.db)
+func (qs AccountQuerySet) SumAge() (int, error) {
+	var ret int
+	var res struct {
+		Value *int
+	}
+	err := checkQueryContext(qs.db).Select("SUM(age) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
 }
 
-// IDEq is an autogenerated method
+// UpdatedAtBetween filters rows with UpdatedAt in range [from, to]
 // nolint: dupl
-func (qs PostQuerySet) IDEq(ID uint) PostQuerySet {
-	return qs.w(qs.db.Where("id = ?", ID))
+func (qs AccountQuerySet) UpdatedAtBetween(from time.Time, to time.Time) AccountQuerySet {
+	return qs.w(qs.db.Where("updated_at BETWEEN ? AND ?", from, to))
 }
 
-// IDGt is an autogenerated method
+// UpdatedAtEq is an autogenerated method
 // nolint: dupl
-func (qs PostQuerySet) IDGt(ID uint) PostQuerySet {
-	return qs.w(qs.db.Where("id > ?", ID))
+func (qs AccountQuerySet) UpdatedAtEq(updatedAt time.Time) AccountQuerySet {
+	return qs.w(qs.db.Where("updated_at = ?", updatedAt))
 }
 
-// IDGte is an autogenerated method
+// UpdatedAtGt is an autogenerated method
 // nolint: dupl
-func (qs PostQuerySet) IDGte(ID uint) PostQuerySet {
-	return qs.w(qs.db.Where("id >= ?", ID))
+func (qs AccountQuerySet) UpdatedAtGt(updatedAt time.Time) AccountQuerySet {
+	return qs.w(qs.db.Where("updated_at > ?", updatedAt))
 }
 
-// IDIn is an autogenerated method
+// UpdatedAtGte is an autogenerated method
 // nolint: dupl
-func (qs PostQuerySet) IDIn(ID ...uint) PostQuerySet {
-	if len(ID) == 0 {
-		qs.db.AddError(errors.New("must at least pass one ID in IDIn"))
-		return qs.w(qs.db)
-	}
-	return qs.w(qs.db.Where("id IN (?)", ID))
+func (qs AccountQuerySet) UpdatedAtGte(updatedAt time.Time) AccountQuerySet {
+	return qs.w(qs.db.Where("updated_at >= ?", updatedAt))
 }
 
-// IDLt is an autogenerated method
+// UpdatedAtInRange filters rows with UpdatedAt in range [from, to)
 // nolint: dupl
-func (qs PostQuerySet) IDLt(ID uint) PostQuerySet {
-	return qs.w(qs.db.Where("id < ?", ID))
+func (qs AccountQuerySet) UpdatedAtInRange(from time.Time, to time.Time) AccountQuerySet {
+	return qs.w(qs.db.Where("updated_at >= ? AND updated_at < ?", from, to))
 }
 
-// IDLte is an autogenerated method
+// UpdatedAtLt is an autogenerated method
 // nolint: dupl
-func (qs PostQuerySet) IDLte(ID uint) PostQuerySet {
-	return qs.w(qs.db.Where("id <= ?", ID))
+func (qs AccountQuerySet) UpdatedAtLt(updatedAt time.Time) AccountQuerySet {
+	return qs.w(qs.db.Where("updated_at < ?", updatedAt))
 }
 
-// IDNe is an autogenerated method
+// UpdatedAtLte is an autogenerated method
 // nolint: dupl
-func (qs PostQuerySet) IDNe(ID uint) PostQuerySet {
-	return qs.w(qs.db.Where("id != ?", ID))
+func (qs AccountQuerySet) UpdatedAtLte(updatedAt time.Time) AccountQuerySet {
+	return qs.w(qs.db.Where("updated_at <= ?", updatedAt))
 }
 
-// IDNotIn is an autogenerated method
+// UpdatedAtNe is an autogenerated method
 // nolint: dupl
-func (qs PostQuerySet) IDNotIn(ID ...uint) PostQuerySet {
-	if len(ID) == 0 {
-		qs.db.AddError(errors.New("must at least pass one ID in IDNotIn"))
-		return qs.w(qs.db)
-	}
-	return qs.w(qs.db.Where("id NOT IN (?)", ID))
+func (qs AccountQuerySet) UpdatedAtNe(updatedAt time.Time) AccountQuerySet {
+	return qs.w(qs.db.Where("updated_at != ?", updatedAt))
 }
 
-// Limit is an autogenerated method
+// UpdatedAtWithin filters rows with UpdatedAt not earlier than d ago
 // nolint: dupl
-func (qs PostQuerySet) Limit(limit int) PostQuerySet {
-	return qs.w(qs.db.Limit(limit))
+func (qs AccountQuerySet) UpdatedAtWithin(d time.Duration) AccountQuerySet {
+	return qs.w(qs.db.Where("updated_at >= ?", time.Now().Add(-d)))
 }
 
-// Offset is an autogenerated method
+// WithContext returns copy executing queries with context ctx.
+// Gorm v1 doesn't support context: its error is checked only before query.
 // nolint: dupl
-func (qs PostQuerySet) Offset(offset int) PostQuerySet {
-	return qs.w(qs.db.Offset(offset))
+func (qs AccountQuerySet) WithContext(ctx context.Context) AccountQuerySet {
+	return qs.w(DBWithContext(ctx, qs.db))
 }
 
-// One is used to retrieve one result. It returns gorm.ErrRecordNotFound
-// if nothing was fetched
-func (qs PostQuerySet) One(ret *Post) error {
-	return qs.db.First(ret).Error
+// fieldPtrs returns pointers to fields of o by their columns
+// nolint: dupl
+func (qs AccountQuerySet) fieldPtrs(o *Account) map[string]interface{} {
+	return map[string]interface{}{
+		"id":         &o.ID,
+		"created_at": &o.CreatedAt,
+		"updated_at": &o.UpdatedAt,
+		"deleted_at": &o.DeletedAt,
+		"login":      &o.Login,
+		"nickname":   &o.Nickname,
+		"role":       &o.Role,
+		"age":        &o.Age,
+		"str":        &o.Str,
+	}
 }
 
-// OrderAscByCreatedAt is an autogenerated method
+// InTx returns copy bound to transaction tx: queries are executed in it.
+// Gorm v1 can't move conditions into another connection, so with gorm v1
+// InTx must be called before any condition is set, otherwise queries fail.
+// Context and preloads are moved into transaction by both gorm versions.
 // nolint: dupl
-func (qs PostQuerySet) OrderAscByCreatedAt() PostQuerySet {
-	return qs.w(qs.db.Order("created_at ASC"))
+func (u AccountUpdater) InTx(tx *gorm.DB) AccountUpdater {
+	if !reflect.DeepEqual(u.db.QueryExpr(), u.db.New().Model(&Account{}).QueryExpr()) {
+		tx = tx.New()
+		tx.AddError(errors.New("InTx must be called before any condition is set: " +
+			"gorm v1 can't move conditions into transaction"))
+	}
+	tx = txWithSettings(u.db, tx)
+	u.db = tx.Model(&Account{})
+	return u
 }
 
-// OrderAscByDeletedAt is an autogenerated method
+// SetAge is an autogenerated method
 // nolint: dupl
-func (qs PostQuerySet) OrderAscByDeletedAt() PostQuerySet {
-	return qs.w(qs.db.Order("deleted_at ASC"))
+func (u AccountUpdater) SetAge(age int) AccountUpdater {
+	u.fields[string(AccountDBSchema.Age)] = age
+	return u
 }
 
-// OrderAscByID is an autogenerated method
+// SetCreatedAt is an autogenerated method
 // nolint: dupl
-func (qs PostQuerySet) OrderAscByID() PostQuerySet {
-	return qs.w(qs.db.Order("id ASC"))
+func (u AccountUpdater) SetCreatedAt(createdAt time.Time) AccountUpdater {
+	u.fields[string(AccountDBSchema.CreatedAt)] = createdAt
+	return u
 }
 
-// OrderAscByStr is an autogenerated method
+// SetDeletedAt is an autogenerated method
 // nolint: dupl
-func (qs PostQuerySet) OrderAscByStr() PostQuerySet {
-	return qs.w(qs.db.Order("str ASC"))
+func (u AccountUpdater) SetDeletedAt(deletedAt *time.Time) AccountUpdater {
+	u.fields[string(AccountDBSchema.DeletedAt)] = deletedAt
+	return u
 }
 
-// OrderAscByTitle is an autogenerated method
+// SetID is an autogenerated method
 // nolint: dupl
-func (qs PostQuerySet) OrderAscByTitle() PostQuerySet {
-	return qs.w(qs.db.Order("title ASC"))
+func (u AccountUpdater) SetID(ID uint) AccountUpdater {
+	u.fields[string(AccountDBSchema.ID)] = ID
+	return u
 }
 
-// OrderAscByUpdatedAt is an autogenerated method
+// SetLogin is an autogenerated method
 // nolint: dupl
-func (qs PostQuerySet) OrderAscByUpdatedAt() PostQuerySet {
-	return qs.w(qs.db.Order("updated_at ASC"))
+func (u AccountUpdater) SetLogin(login string) AccountUpdater {
+	u.fields[string(AccountDBSchema.Login)] = login
+	return u
 }
 
-// OrderDescByCreatedAt is an autogenerated method
+// SetNickname is an autogenerated method
 // nolint: dupl
-func (qs PostQuerySet) OrderDescByCreatedAt() PostQuerySet {
-	return qs.w(qs.db.Order("created_at DESC"))
+func (u AccountUpdater) SetNickname(nickname *string) AccountUpdater {
+	u.fields[string(AccountDBSchema.Nickname)] = nickname
+	return u
 }
 
-// OrderDescByDeletedAt is an autogenerated method
+// SetRole is an autogenerated method
 // nolint: dupl
-func (qs PostQuerySet) OrderDescByDeletedAt() PostQuerySet {
-	return qs.w(qs.db.Order("deleted_at DESC"))
+func (u AccountUpdater) SetRole(role string) AccountUpdater {
+	u.fields[string(AccountDBSchema.Role)] = role
+	return u
 }
 
-// OrderDescByID is an autogenerated method
+// SetStr is an autogenerated method
 // nolint: dupl
-func (qs PostQuerySet) OrderDescByID() PostQuerySet {
-	return qs.w(qs.db.Order("id DESC"))
+func (u AccountUpdater) SetStr(str tmp.StringDef) AccountUpdater {
+	u.fields[string(AccountDBSchema.Str)] = str
+	return u
 }
 
-// OrderDescByStr is an autogenerated method
+// SetUpdatedAt is an autogenerated method
 // nolint: dupl
-func (qs PostQuerySet) OrderDescByStr() PostQuerySet {
-	return qs.w(qs.db.Order("str DESC"))
+func (u AccountUpdater) SetUpdatedAt(updatedAt time.Time) AccountUpdater {
+	u.fields[string(AccountDBSchema.UpdatedAt)] = updatedAt
+	return u
 }
 
-// OrderDescByTitle is an autogenerated method
+// Update is an autogenerated method
 // nolint: dupl
-func (qs PostQuerySet) OrderDescByTitle() PostQuerySet {
-	return qs.w(qs.db.Order("title DESC"))
+func (u AccountUpdater) Update() error {
+	if err := u.Validate(); err != nil {
+		return err
+	}
+
+	return checkQueryContext(u.db).Updates(u.fields).Error
 }
 
-// OrderDescByUpdatedAt is an autogenerated method
+// UpdateNum is an autogenerated method
 // nolint: dupl
-func (qs PostQuerySet) OrderDescByUpdatedAt() PostQuerySet {
-	return qs.w(qs.db.Order("updated_at DESC"))
+func (u AccountUpdater) UpdateNum() (int64, error) {
+	if err := u.Validate(); err != nil {
+		return 0, err
+	}
+
+	db := checkQueryContext(u.db).Updates(u.fields)
+	return db.RowsAffected, db.Error
 }
 
-// PreloadBlog is an autogenerated method
+// WithContext returns copy executing queries with context ctx.
+// Gorm v1 doesn't support context: its error is checked only before query.
 // nolint: dupl
-func (qs PostQuerySet) PreloadBlog() PostQuerySet {
-	return qs.w(qs.db.Preload("Blog"))
+func (u AccountUpdater) WithContext(ctx context.Context) AccountUpdater {
+	u.db = DBWithContext(ctx, u.db)
+	return u
 }
 
-// PreloadUser is an autogenerated method
+// ===== END of query set AccountQuerySet
+
+// ===== BEGIN of Account modifiers
+
+// AccountDBSchemaField describes database schema field. It requires for method 'Update'
+type AccountDBSchemaField string
+
+// String method returns string representation of field.
 // nolint: dupl
-func (qs PostQuerySet) PreloadUser() PostQuerySet {
-	return qs.w(qs.db.Preload("User"))
+func (f AccountDBSchemaField) String() string {
+	return string(f)
 }
 
-// StrEq is an autogenerated method
-// nolint: dupl
-func (qs PostQuerySet) StrEq(str tmp.StringDef) PostQuerySet {
-	return qs.w(qs.db.Where("str = ?", str))
+// AccountDBSchema stores db field names of Account
+var AccountDBSchema = struct {
+	ID        AccountDBSchemaField
+	CreatedAt AccountDBSchemaField
+	UpdatedAt AccountDBSchemaField
+	DeletedAt AccountDBSchemaField
+	Login     AccountDBSchemaField
+	Nickname  AccountDBSchemaField
+	Role      AccountDBSchemaField
+	Age       AccountDBSchemaField
+	Str       AccountDBSchemaField
+}{
+
+	ID:        AccountDBSchemaField("id"),
+	CreatedAt: AccountDBSchemaField("created_at"),
+	UpdatedAt: AccountDBSchemaField("updated_at"),
+	DeletedAt: AccountDBSchemaField("deleted_at"),
+	Login:     AccountDBSchemaField("login"),
+	Nickname:  AccountDBSchemaField("nickname"),
+	Role:      AccountDBSchemaField("role"),
+	Age:       AccountDBSchemaField("age"),
+	Str:       AccountDBSchemaField("str"),
 }
 
-// StrGt is an autogenerated method
+// Update updates Account fields by primary key
 // nolint: dupl
-func (qs PostQuerySet) StrGt(str tmp.StringDef) PostQuerySet {
-	return qs.w(qs.db.Where("str > ?", str))
+func (o *Account) Update(db *gorm.DB, fields ...AccountDBSchemaField) error {
+	dbNameToFieldName := map[string]interface{}{
+		"id":         o.ID,
+		"created_at": o.CreatedAt,
+		"updated_at": o.UpdatedAt,
+		"deleted_at": o.DeletedAt,
+		"login":      o.Login,
+		"nickname":   o.Nickname,
+		"role":       o.Role,
+		"age":        o.Age,
+		"str":        o.Str,
+	}
+	u := map[string]interface{}{}
+	for _, f := range fields {
+		fs := f.String()
+		u[fs] = dbNameToFieldName[fs]
+	}
+	if err := validateAccountFields(u); err != nil {
+		return err
+	}
+	if err := checkQueryContext(db).Model(o).Updates(u).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return err
+		}
+
+		return fmt.Errorf("can't update Account %v fields %v: %s",
+			o, fields, err)
+	}
+
+	return nil
 }
 
-// StrGte is an autogenerated method
+// Upsert inserts Account or updates updateFields of existing row
+// if insert violates unique constraint on conflictFields. Row isn't
+// changed if there are no updateFields: any conflict is ignored then if
+// there are no conflictFields. MySQL ignores conflictFields: it checks
+// all unique constraints, other databases require them to update row.
 // nolint: dupl
-func (qs PostQuerySet) StrGte(str tmp.StringDef) PostQuerySet {
-	return qs.w(qs.db.Where("str >= ?", str))
+func (o *Account) Upsert(db *gorm.DB, conflictFields []AccountDBSchemaField,
+	updateFields ...AccountDBSchemaField) error {
+	if err := o.Validate(); err != nil {
+		return err
+	}
+
+	conflictColumns := make([]string, 0, len(conflictFields))
+	for _, f := range conflictFields {
+		conflictColumns = append(conflictColumns, f.String())
+	}
+	updateColumns := make([]string, 0, len(updateFields))
+	for _, f := range updateFields {
+		updateColumns = append(updateColumns, f.String())
+	}
+
+	qs := NewAccountQuerySet(db)
+	return upsert(checkQueryContext(qs.db), "id", qs.fieldPtrs(o),
+		conflictColumns, updateColumns)
 }
 
-// StrIn is an autogenerated method
+// AccountUpdater is an Account updates manager
+type AccountUpdater struct {
+	fields map[string]interface{}
+	db     *gorm.DB
+}
+
+// NewAccountUpdater creates new Account updater
 // nolint: dupl
-func (qs PostQuerySet) StrIn(str ...tmp.StringDef) PostQuerySet {
-	if len(str) == 0 {
-		qs.db.AddError(errors.New("must at least pass one str in StrIn"))
-		return qs.w(qs.db)
+func NewAccountUpdater(db *gorm.DB) AccountUpdater {
+	return AccountUpdater{
+		fields: map[string]interface{}{},
+		db:     db.Model(&Account{}),
 	}
-	return qs.w(qs.db.Where("str IN (?)", str))
 }
 
-// StrLike is an autogenerated method
-// nolint: dupl
-func (qs PostQuerySet) StrLike(str tmp.StringDef) PostQuerySet {
-	return qs.w(qs.db.Where("str LIKE ?", str))
+// validateAccountFields validates values of Account fields by their columns
+func validateAccountFields(fields map[string]interface{}) error {
+	if value, ok := fields[string(AccountDBSchema.Login)]; ok {
+		v := value.(string)
+		if utf8.RuneCountInString(string(v)) > 8 {
+			return ValidationError{Field: "Login", Reason: "length must be at most 8"}
+		}
+		if v == "" {
+			return ValidationError{Field: "Login", Reason: "is required"}
+		}
+	}
+	if value, ok := fields[string(AccountDBSchema.Nickname)]; ok {
+		v := value.(*string)
+		if v == nil {
+			return ValidationError{Field: "Nickname", Reason: "must be not null"}
+		}
+		if v != nil {
+			if utf8.RuneCountInString(string(*v)) > 16 {
+				return ValidationError{Field: "Nickname", Reason: "length must be at most 16"}
+			}
+		}
+	}
+	if value, ok := fields[string(AccountDBSchema.Role)]; ok {
+		v := value.(string)
+		if v != "admin" && v != "user" {
+			return ValidationError{Field: "Role", Reason: "must be one of: admin user"}
+		}
+	}
+	if value, ok := fields[string(AccountDBSchema.Age)]; ok {
+		v := value.(int)
+		if v < 18 {
+			return ValidationError{Field: "Age", Reason: "must be at least 18"}
+		}
+		if v > 150 {
+			return ValidationError{Field: "Age", Reason: "must be at most 150"}
+		}
+	}
+	if value, ok := fields[string(AccountDBSchema.Str)]; ok {
+		v := value.(tmp.StringDef)
+		if utf8.RuneCountInString(string(v)) > 4 {
+			return ValidationError{Field: "Str", Reason: "length must be at most 4"}
+		}
+	}
+
+	return nil
 }
 
-// StrLt is an autogenerated method
-// nolint: dupl
-func (qs PostQuerySet) StrLt(str tmp.StringDef) PostQuerySet {
-	return qs.w(qs.db.Where("str < ?", str))
+// Validate checks Account by rules from tags, it's called
+// before writes to database
+func (o *Account) Validate() error {
+	return validateAccountFields(map[string]interface{}{
+		string(AccountDBSchema.Login):    o.Login,
+		string(AccountDBSchema.Nickname): o.Nickname,
+		string(AccountDBSchema.Role):     o.Role,
+		string(AccountDBSchema.Age):      o.Age,
+		string(AccountDBSchema.Str):      o.Str,
+	})
 }
 
-// StrLte is an autogenerated method
-// nolint: dupl
-func (qs PostQuerySet) StrLte(str tmp.StringDef) PostQuerySet {
-	return qs.w(qs.db.Where("str <= ?", str))
+// Validate checks fields set in updater by rules from tags,
+// it's called before update
+func (u AccountUpdater) Validate() error {
+	return validateAccountFields(u.fields)
 }
 
-// StrNe is an autogenerated method
-// nolint: dupl
-func (qs PostQuerySet) StrNe(str tmp.StringDef) PostQuerySet {
-	return qs.w(qs.db.Where("str != ?", str))
+// ===== END of Account modifiers
+
+// ===== BEGIN of Account predicates
+
+// AccountPredicate is a condition on Account fields for Where and Not
+// methods of AccountQuerySet: predicates are created by AccountQ and
+// combined by And, Or and Not. Zero predicate matches all rows.
+type AccountPredicate struct {
+	expr string
+	args []interface{}
+	err  error
 }
 
-// StrNotIn is an autogenerated method
+// join combines predicates by operation op, empty predicates are skipped
 // nolint: dupl
-func (qs PostQuerySet) StrNotIn(str ...tmp.StringDef) PostQuerySet {
-	if len(str) == 0 {
-		qs.db.AddError(errors.New("must at least pass one str in StrNotIn"))
-		return qs.w(qs.db)
+func (p AccountPredicate) join(op string, preds []AccountPredicate) AccountPredicate {
+	for _, q := range preds {
+		if p.err == nil {
+			p.err = q.err
+		}
+		if q.expr == "" {
+			continue
+		}
+		if p.expr == "" {
+			p.expr, p.args = q.expr, q.args
+			continue
+		}
+
+		p.expr = "(" + p.expr + ") " + op + " (" + q.expr + ")"
+		p.args = append(append([]interface{}{}, p.args...), q.args...)
 	}
-	return qs.w(qs.db.Where("str NOT IN (?)", str))
-}
 
-// StrNotlike is an autogenerated method
-// nolint: dupl
-func (qs PostQuerySet) StrNotlike(str tmp.StringDef) PostQuerySet {
-	return qs.w(qs.db.Where("str NOT LIKE ?", str))
+	return p
 }
 
-// TitleEq is an autogenerated method
+// And returns predicate matching rows matched by p and all preds
 // nolint: dupl
-func (qs PostQuerySet) TitleEq(title string) PostQuerySet {
-	return qs.w(qs.db.Where("title = ?", title))
+func (p AccountPredicate) And(preds ...AccountPredicate) AccountPredicate {
+	return p.join("AND", preds)
 }
 
-// TitleGt is an autogenerated method
+// Or returns predicate matching rows matched by p or any of preds
 // nolint: dupl
-func (qs PostQuerySet) TitleGt(title string) PostQuerySet {
-	return qs.w(qs.db.Where("title > ?", title))
+func (p AccountPredicate) Or(preds ...AccountPredicate) AccountPredicate {
+	return p.join("OR", preds)
 }
 
-// TitleGte is an autogenerated method
+// Not returns predicate matching rows not matched by p
 // nolint: dupl
-func (qs PostQuerySet) TitleGte(title string) PostQuerySet {
-	return qs.w(qs.db.Where("title >= ?", title))
+func (p AccountPredicate) Not() AccountPredicate {
+	if p.expr != "" {
+		p.expr = "NOT (" + p.expr + ")"
+	}
+
+	return p
 }
 
-// TitleIn is an autogenerated method
+// AccountPredicates creates predicates by Account fields
+type AccountPredicates struct{}
+
+// AccountQ creates predicates by Account fields
+var AccountQ AccountPredicates
+
+// AgeEq creates predicate "age = ?"
 // nolint: dupl
-func (qs PostQuerySet) TitleIn(title ...string) PostQuerySet {
-	if len(title) == 0 {
-		qs.db.AddError(errors.New("must at least pass one title in TitleIn"))
-		return qs.w(qs.db)
-	}
-	return qs.w(qs.db.Where("title IN (?)", title))
+func (AccountPredicates) AgeEq(age int) AccountPredicate {
+	return AccountPredicate{expr: "age = ?", args: []interface{}{age}}
 }
 
-// TitleIsNotNull is an autogenerated method
+// AgeGt creates predicate "age > ?"
 // nolint: dupl
-func (qs PostQuerySet) TitleIsNotNull() PostQuerySet {
-	return qs.w(qs.db.Where("title IS NOT NULL"))
+func (AccountPredicates) AgeGt(age int) AccountPredicate {
+	return AccountPredicate{expr: "age > ?", args: []interface{}{age}}
 }
 
-// TitleIsNull is an autogenerated method
+// AgeGte creates predicate "age >= ?"
 // nolint: dupl
-func (qs PostQuerySet) TitleIsNull() PostQuerySet {
-	return qs.w(qs.db.Where("title IS NULL"))
+func (AccountPredicates) AgeGte(age int) AccountPredicate {
+	return AccountPredicate{expr: "age >= ?", args: []interface{}{age}}
 }
 
-// TitleLike is an autogenerated method
+// AgeIn creates predicate "age IN (?)"
 // nolint: dupl
-func (qs PostQuerySet) TitleLike(title string) PostQuerySet {
-	return qs.w(qs.db.Where("title LIKE ?", title))
+func (AccountPredicates) AgeIn(age ...int) AccountPredicate {
+	if len(age) == 0 {
+		return AccountPredicate{err: errors.New("must at least pass one age in AgeIn")}
+	}
+	return AccountPredicate{expr: "age IN (?)", args: []interface{}{age}}
 }
 
-// TitleLt is an autogenerated method
+// AgeLt creates predicate "age < ?"
 // nolint: dupl
-func (qs PostQuerySet) TitleLt(title string) PostQuerySet {
-	return qs.w(qs.db.Where("title < ?", title))
+func (AccountPredicates) AgeLt(age int) AccountPredicate {
+	return AccountPredicate{expr: "age < ?", args: []interface{}{age}}
 }
 
-// TitleLte is an autogenerated method
+// AgeLte creates predicate "age <= ?"
 // nolint: dupl
-func (qs PostQuerySet) TitleLte(title string) PostQuerySet {
-	return qs.w(qs.db.Where("title <= ?", title))
+func (AccountPredicates) AgeLte(age int) AccountPredicate {
+	return AccountPredicate{expr: "age <= ?", args: []interface{}{age}}
 }
 
-// TitleNe is an autogenerated method
+// AgeNe creates predicate "age != ?"
 // nolint: dupl
-func (qs PostQuerySet) TitleNe(title string) PostQuerySet {
-	return qs.w(qs.db.Where("title != ?", title))
+func (AccountPredicates) AgeNe(age int) AccountPredicate {
+	return AccountPredicate{expr: "age != ?", args: []interface{}{age}}
 }
 
-// TitleNotIn is an autogenerated method
+// AgeNotIn creates predicate "age NOT IN (?)"
 // nolint: dupl
-func (qs PostQuerySet) TitleNotIn(title ...string) PostQuerySet {
-	if len(title) == 0 {
-		qs.db.AddError(errors.New("must at least pass one title in TitleNotIn"))
-		return qs.w(qs.db)
+func (AccountPredicates) AgeNotIn(age ...int) AccountPredicate {
+	if len(age) == 0 {
+		return AccountPredicate{err: errors.New("must at least pass one age in AgeNotIn")}
 	}
-	return qs.w(qs.db.Where("title NOT IN (?)", title))
+	return AccountPredicate{expr: "age NOT IN (?)", args: []interface{}{age}}
 }
 
-// TitleNotlike is an autogenerated method
+// CreatedAtEq creates predicate "created_at = ?"
 // nolint: dupl
-func (qs PostQuerySet) TitleNotlike(title string) PostQuerySet {
-	return qs.w(qs.db.Where("title NOT LIKE ?", title))
+func (AccountPredicates) CreatedAtEq(createdAt time.Time) AccountPredicate {
+	return AccountPredicate{expr: "created_at = ?", args: []interface{}{createdAt}}
 }
 
-// UpdatedAtEq is an autogenerated method
+// CreatedAtGt creates predicate "created_at > ?"
 // nolint: dupl
-func (qs PostQuerySet) UpdatedAtEq(updatedAt time.Time) PostQuerySet {
-	return qs.w(qs.db.Where("updated_at = ?", updatedAt))
+func (AccountPredicates) CreatedAtGt(createdAt time.Time) AccountPredicate {
+	return AccountPredicate{expr: "created_at > ?", args: []interface{}{createdAt}}
 }
 
-// UpdatedAtGt is an autogenerated method
+// CreatedAtGte creates predicate "created_at >= ?"
 // nolint: dupl
-func (qs PostQuerySet) UpdatedAtGt(updatedAt time.Time) PostQuerySet {
-	return qs.w(qs.db.Where("updated_at > ?", updatedAt))
+func (AccountPredicates) CreatedAtGte(createdAt time.Time) AccountPredicate {
+	return AccountPredicate{expr: "created_at >= ?", args: []interface{}{createdAt}}
 }
 
-// UpdatedAtGte is an autogenerated method
+// CreatedAtLt creates predicate "created_at < ?"
 // nolint: dupl
-func (qs PostQuerySet) UpdatedAtGte(updatedAt time.Time) PostQuerySet {
-	return qs.w(qs.db.Where("updated_at >= ?", updatedAt))
+func (AccountPredicates) CreatedAtLt(createdAt time.Time) AccountPredicate {
+	return AccountPredicate{expr: "created_at < ?", args: []interface{}{createdAt}}
 }
 
-// UpdatedAtLt is an autogenerated method
+// CreatedAtLte creates predicate "created_at <= ?"
 // nolint: dupl
-func (qs PostQuerySet) UpdatedAtLt(updatedAt time.Time) PostQuerySet {
-	return qs.w(qs.db.Where("updated_at < ?", updatedAt))
+func (AccountPredicates) CreatedAtLte(createdAt time.Time) AccountPredicate {
+	return AccountPredicate{expr: "created_at <= ?", args: []interface{}{createdAt}}
 }
 
-// UpdatedAtLte is an autogenerated method
+// CreatedAtNe creates predicate "created_at != ?"
 // nolint: dupl
-func (qs PostQuerySet) UpdatedAtLte(updatedAt time.Time) PostQuerySet {
-	return qs.w(qs.db.Where("updated_at <= ?", updatedAt))
+func (AccountPredicates) CreatedAtNe(createdAt time.Time) AccountPredicate {
+	return AccountPredicate{expr: "created_at != ?", args: []interface{}{createdAt}}
 }
 
-// UpdatedAtNe is an autogenerated method
+// DeletedAtEq creates predicate "deleted_at = ?"
 // nolint: dupl
-func (qs PostQuerySet) UpdatedAtNe(updatedAt time.Time) PostQuerySet {
-	return qs.w(qs.db.Where("updated_at != ?", updatedAt))
+func (AccountPredicates) DeletedAtEq(deletedAt time.Time) AccountPredicate {
+	return AccountPredicate{expr: "deleted_at = ?", args: []interface{}{deletedAt}}
 }
 
-// SetCreatedAt is an autogenerated method
+// DeletedAtGt creates predicate "deleted_at > ?"
 // nolint: dupl
-func (u PostUpdater) SetCreatedAt(createdAt time.Time) PostUpdater {
-	u.fields[string(PostDBSchema.CreatedAt)] = createdAt
-	return u
+func (AccountPredicates) DeletedAtGt(deletedAt time.Time) AccountPredicate {
+	return AccountPredicate{expr: "deleted_at > ?", args: []interface{}{deletedAt}}
 }
 
-// SetDeletedAt is an autogenerated method
+// DeletedAtGte creates predicate "deleted_at >= ?"
 // nolint: dupl
-func (u PostUpdater) SetDeletedAt(deletedAt *time.Time) PostUpdater {
-	u.fields[string(PostDBSchema.DeletedAt)] = deletedAt
-	return u
+func (AccountPredicates) DeletedAtGte(deletedAt time.Time) AccountPredicate {
+	return AccountPredicate{expr: "deleted_at >= ?", args: []interface{}{deletedAt}}
 }
 
-// SetID is an autogenerated method
+// DeletedAtIsNotNull creates predicate "deleted_at IS NOT NULL"
 // nolint: dupl
-func (u PostUpdater) SetID(ID uint) PostUpdater {
-	u.fields[string(PostDBSchema.ID)] = ID
-	return u
+func (AccountPredicates) DeletedAtIsNotNull() AccountPredicate {
+	return AccountPredicate{expr: "deleted_at IS NOT NULL"}
 }
 
-// SetStr is an autogenerated method
+// DeletedAtIsNull creates predicate "deleted_at IS NULL"
 // nolint: dupl
-func (u PostUpdater) SetStr(str tmp.StringDef) PostUpdater {
-	u.fields[string(PostDBSchema.Str)] = str
-	return u
+func (AccountPredicates) DeletedAtIsNull() AccountPredicate {
+	return AccountPredicate{expr: "deleted_at IS NULL"}
 }
 
-// SetTitle is an autogenerated method
+// DeletedAtLt creates predicate "deleted_at < ?"
 // nolint: dupl
-func (u PostUpdater) SetTitle(title *string) PostUpdater {
-	u.fields[string(PostDBSchema.Title)] = title
-	return u
+func (AccountPredicates) DeletedAtLt(deletedAt time.Time) AccountPredicate {
+	return AccountPredicate{expr: "deleted_at < ?", args: []interface{}{deletedAt}}
 }
 
-// SetUpdatedAt is an autogenerated method
+// DeletedAtLte creates predicate "deleted_at <= ?"
 // nolint: dupl
-func (u PostUpdater) SetUpdatedAt(updatedAt time.Time) PostUpdater {
-	u.fields[string(PostDBSchema.UpdatedAt)] = updatedAt
-	return u
+func (AccountPredicates) DeletedAtLte(deletedAt time.Time) AccountPredicate {
+	return AccountPredicate{expr: "deleted_at <= ?", args: []interface{}{deletedAt}}
 }
 
-// SetUser is an autogenerated method
+// DeletedAtNe creates predicate "deleted_at != ?"
 // nolint: dupl
-func (u PostUpdater) SetUser(user User) PostUpdater {
-	u.fields[string(PostDBSchema.User)] = user
-	return u
+func (AccountPredicates) DeletedAtNe(deletedAt time.Time) AccountPredicate {
+	return AccountPredicate{expr: "deleted_at != ?", args: []interface{}{deletedAt}}
 }
 
-// Update is an autogenerated method
+// IDEq creates predicate "id = ?"
 // nolint: dupl
-func (u PostUpdater) Update() error {
-	return u.db.Updates(u.fields).Error
+func (AccountPredicates) IDEq(ID uint) AccountPredicate {
+	return AccountPredicate{expr: "id = ?", args: []interface{}{ID}}
 }
 
-// UpdateNum is an autogenerated method
+// IDGt creates predicate "id > ?"
 // nolint: dupl
-func (u PostUpdater) UpdateNum() (int64, error) {
-	db := u.db.Updates(u.fields)
-	return db.RowsAffected, db.Error
+func (AccountPredicates) IDGt(ID uint) AccountPredicate {
+	return AccountPredicate{expr: "id > ?", args: []interface{}{ID}}
 }
 
-// ===== END of query set PostQuerySet
-
-// ===== BEGIN of Post modifiers
+// IDGte creates predicate "id >= ?"
+// nolint: dupl
+func (AccountPredicates) IDGte(ID uint) AccountPredicate {
+	return AccountPredicate{expr: "id >= ?", args: []interface{}{ID}}
+}
 
-// PostDBSchemaField describes database schema field. It requires for method 'Update'
-type PostDBSchemaField string
+// IDIn creates predicate "id IN (?)"
+// nolint: dupl
+func (AccountPredicates) IDIn(ID ...uint) AccountPredicate {
+	if len(ID) == 0 {
+		return AccountPredicate{err: errors.New("must at least pass one ID in IDIn")}
+	}
+	return AccountPredicate{expr: "id IN (?)", args: []interface{}{ID}}
+}
 
-// String method returns string representation of field.
+// IDLt creates predicate "id < ?"
 // nolint: dupl
-func (f PostDBSchemaField) String() string {
-	return string(f)
+func (AccountPredicates) IDLt(ID uint) AccountPredicate {
+	return AccountPredicate{expr: "id < ?", args: []interface{}{ID}}
 }
 
-// PostDBSchema stores db field names of Post
-var PostDBSchema = struct {
-	ID        PostDBSchemaField
-	CreatedAt PostDBSchemaField
-	UpdatedAt PostDBSchemaField
-	DeletedAt PostDBSchemaField
-	Blog      PostDBSchemaField
-	User      PostDBSchemaField
-	Title     PostDBSchemaField
-	Str       PostDBSchemaField
-}{
+// IDLte creates predicate "id <= ?"
+// nolint: dupl
+func (AccountPredicates) IDLte(ID uint) AccountPredicate {
+	return AccountPredicate{expr: "id <= ?", args: []interface{}{ID}}
+}
 
-	ID:        PostDBSchemaField("id"),
-	CreatedAt: PostDBSchemaField("created_at"),
-	UpdatedAt: PostDBSchemaField("updated_at"),
-	DeletedAt: PostDBSchemaField("deleted_at"),
-	Blog:      PostDBSchemaField("blog"),
-	User:      PostDBSchemaField("user"),
-	Title:     PostDBSchemaField("title"),
-	Str:       PostDBSchemaField("str"),
+// IDNe creates predicate "id != ?"
+// nolint: dupl
+func (AccountPredicates) IDNe(ID uint) AccountPredicate {
+	return AccountPredicate{expr: "id != ?", args: []interface{}{ID}}
 }
 
-// Update updates Post fields by primary key
+// IDNotIn creates predicate "id NOT IN (?)"
 // nolint: dupl
-func (o *Post) Update(db *gorm.DB, fields ...PostDBSchemaField) error {
-	dbNameToFieldName := map[string]interface{}{
-		"id":         o.ID,
-		"created_at": o.CreatedAt,
-		"updated_at": o.UpdatedAt,
-		"deleted_at": o.DeletedAt,
-		"blog":       o.Blog,
-		"user":       o.User,
-		"title":      o.Title,
-		"str":        o.Str,
-	}
-	u := map[string]interface{}{}
-	for _, f := range fields {
-		fs := f.String()
-		u[fs] = dbNameToFieldName[fs]
+func (AccountPredicates) IDNotIn(ID ...uint) AccountPredicate {
+	if len(ID) == 0 {
+		return AccountPredicate{err: errors.New("must at least pass one ID in IDNotIn")}
 	}
-	if err := db.Model(o).Updates(u).Error; err != nil {
-		if err == gorm.ErrRecordNotFound {
-			return err
-		}
+	return AccountPredicate{expr: "id NOT IN (?)", args: []interface{}{ID}}
+}
 
-		return fmt.Errorf("can't update Post %v fields %v: %s",
-			o, fields, err)
-	}
+// LoginEq creates predicate "login = ?"
+// nolint: dupl
+func (AccountPredicates) LoginEq(login string) AccountPredicate {
+	return AccountPredicate{expr: "login = ?", args: []interface{}{login}}
+}
 
-	return nil
+// LoginGt creates predicate "login > ?"
+// nolint: dupl
+func (AccountPredicates) LoginGt(login string) AccountPredicate {
+	return AccountPredicate{expr: "login > ?", args: []interface{}{login}}
 }
 
-// PostUpdater is an Post updates manager
-type PostUpdater struct {
-	fields map[string]interface{}
-	db     *gorm.DB
+// LoginGte creates predicate "login >= ?"
+// nolint: dupl
+func (AccountPredicates) LoginGte(login string) AccountPredicate {
+	return AccountPredicate{expr: "login >= ?", args: []interface{}{login}}
 }
 
-// NewPostUpdater creates new Post updater
+// LoginIn creates predicate "login IN (?)"
 // nolint: dupl
-func NewPostUpdater(db *gorm.DB) PostUpdater {
-	return PostUpdater{
-		fields: map[string]interface{}{},
-		db:     db.Model(&Post{}),
+func (AccountPredicates) LoginIn(login ...string) AccountPredicate {
+	if len(login) == 0 {
+		return AccountPredicate{err: errors.New("must at least pass one login in LoginIn")}
 	}
+	return AccountPredicate{expr: "login IN (?)", args: []interface{}{login}}
 }
 
-// ===== END of Post modifiers
-
-// ===== BEGIN of query set UserQuerySet
+// LoginLike creates predicate "login LIKE ?"
+// nolint: dupl
+func (AccountPredicates) LoginLike(login string) AccountPredicate {
+	return AccountPredicate{expr: "login LIKE ?", args: []interface{}{login}}
+}
 
-// UserQuerySet is an queryset type for User
-type UserQuerySet struct {
-	db *gorm.DB
+// LoginLt creates predicate "login < ?"
+// nolint: dupl
+func (AccountPredicates) LoginLt(login string) AccountPredicate {
+	return AccountPredicate{expr: "login < ?", args: []interface{}{login}}
 }
 
-// NewUserQuerySet constructs new UserQuerySet
-func NewUserQuerySet(db *gorm.DB) UserQuerySet {
-	return UserQuerySet{
-		db: db.Model(&User{}),
-	}
+// LoginLte creates predicate "login <= ?"
+// nolint: dupl
+func (AccountPredicates) LoginLte(login string) AccountPredicate {
+	return AccountPredicate{expr: "login <= ?", args: []interface{}{login}}
 }
 
-func (qs UserQuerySet) w(db *gorm.DB) UserQuerySet {
-	return NewUserQuerySet(db)
+// LoginNe creates predicate "login != ?"
+// nolint: dupl
+func (AccountPredicates) LoginNe(login string) AccountPredicate {
+	return AccountPredicate{expr: "login != ?", args: []interface{}{login}}
 }
 
-func (qs UserQuerySet) Select(fields ...UserDBSchemaField) UserQuerySet {
-	names := []string{}
-	for _, f := range fields {
-		names = append(names, f.String())
+// LoginNotIn creates predicate "login NOT IN (?)"
+// nolint: dupl
+func (AccountPredicates) LoginNotIn(login ...string) AccountPredicate {
+	if len(login) == 0 {
+		return AccountPredicate{err: errors.New("must at least pass one login in LoginNotIn")}
 	}
+	return AccountPredicate{expr: "login NOT IN (?)", args: []interface{}{login}}
+}
 
-	return qs.w(qs.db.Select(strings.Join(names, ",")))
+// LoginNotlike creates predicate "login NOT LIKE ?"
+// nolint: dupl
+func (AccountPredicates) LoginNotlike(login string) AccountPredicate {
+	return AccountPredicate{expr: "login NOT LIKE ?", args: []interface{}{login}}
 }
 
-// Create is an autogenerated method
+// NicknameEq creates predicate "nickname = ?"
 // nolint: dupl
-func (o *User) Create(db *gorm.DB) error {
-	return db.Create(o).Error
+func (AccountPredicates) NicknameEq(nickname string) AccountPredicate {
+	return AccountPredicate{expr: "nickname = ?", args: []interface{}{nickname}}
 }
 
-// Delete is an autogenerated method
+// NicknameGt creates predicate "nickname > ?"
 // nolint: dupl
-func (o *User) Delete(db *gorm.DB) error {
-	return db.Delete(o).Error
+func (AccountPredicates) NicknameGt(nickname string) AccountPredicate {
+	return AccountPredicate{expr: "nickname > ?", args: []interface{}{nickname}}
 }
 
-// All is an autogenerated method
+// NicknameGte creates predicate "nickname >= ?"
 // nolint: dupl
-func (qs UserQuerySet) All(ret *[]User) error {
-	return qs.db.Find(ret).Error
+func (AccountPredicates) NicknameGte(nickname string) AccountPredicate {
+	return AccountPredicate{expr: "nickname >= ?", args: []interface{}{nickname}}
 }
 
-// Count is an autogenerated method
+// NicknameIn creates predicate "nickname IN (?)"
 // nolint: dupl
-func (qs UserQuerySet) Count() (int, error) {
-	var count int
-	err := qs.db.Count(&count).Error
-	return count, err
+func (AccountPredicates) NicknameIn(nickname ...string) AccountPredicate {
+	if len(nickname) == 0 {
+		return AccountPredicate{err: errors.New("must at least pass one nickname in NicknameIn")}
+	}
+	return AccountPredicate{expr: "nickname IN (?)", args: []interface{}{nickname}}
 }
 
-// CreatedAtEq is an autogenerated method
+// NicknameIsNotNull creates predicate "nickname IS NOT NULL"
 // nolint: dupl
-func (qs UserQuerySet) CreatedAtEq(createdAt time.Time) UserQuerySet {
-	return qs.w(qs.db.Where("created_at = ?", createdAt))
+func (AccountPredicates) NicknameIsNotNull() AccountPredicate {
+	return AccountPredicate{expr: "nickname IS NOT NULL"}
 }
 
-// CreatedAtGt is an autogenerated method
+// NicknameIsNull creates predicate "nickname IS NULL"
 // nolint: dupl
-func (qs UserQuerySet) CreatedAtGt(createdAt time.Time) UserQuerySet {
-	return qs.w(qs.db.Where("created_at > ?", createdAt))
+func (AccountPredicates) NicknameIsNull() AccountPredicate {
+	return AccountPredicate{expr: "nickname IS NULL"}
 }
 
-// CreatedAtGte is an autogenerated method
+// NicknameLike creates predicate "nickname LIKE ?"
 // nolint: dupl
-func (qs UserQuerySet) CreatedAtGte(createdAt time.Time) UserQuerySet {
-	return qs.w(qs.db.Where("created_at >= ?", createdAt))
+func (AccountPredicates) NicknameLike(nickname string) AccountPredicate {
+	return AccountPredicate{expr: "nickname LIKE ?", args: []interface{}{nickname}}
 }
 
-// CreatedAtLt is an autogenerated method
+// NicknameLt creates predicate "nickname < ?"
 // nolint: dupl
-func (qs UserQuerySet) CreatedAtLt(createdAt time.Time) UserQuerySet {
-	return qs.w(qs.db.Where("created_at < ?", createdAt))
+func (AccountPredicates) NicknameLt(nickname string) AccountPredicate {
+	return AccountPredicate{expr: "nickname < ?", args: []interface{}{nickname}}
 }
 
-// CreatedAtLte is an autogenerated method
+// NicknameLte creates predicate "nickname <= ?"
 // nolint: dupl
-func (qs UserQuerySet) CreatedAtLte(createdAt time.Time) UserQuerySet {
-	return qs.w(qs.db.Where("created_at <= ?", createdAt))
+func (AccountPredicates) NicknameLte(nickname string) AccountPredicate {
+	return AccountPredicate{expr: "nickname <= ?", args: []interface{}{nickname}}
 }
 
-// CreatedAtNe is an autogenerated method
+// NicknameNe creates predicate "nickname != ?"
 // nolint: dupl
-func (qs UserQuerySet) CreatedAtNe(createdAt time.Time) UserQuerySet {
-	return qs.w(qs.db.Where("created_at != ?", createdAt))
+func (AccountPredicates) NicknameNe(nickname string) AccountPredicate {
+	return AccountPredicate{expr: "nickname != ?", args: []interface{}{nickname}}
 }
 
-// Delete is an autogenerated method
+// NicknameNotIn creates predicate "nickname NOT IN (?)"
 // nolint: dupl
-func (qs UserQuerySet) Delete() error {
-	return qs.db.Delete(User{}).Error
+func (AccountPredicates) NicknameNotIn(nickname ...string) AccountPredicate {
+	if len(nickname) == 0 {
+		return AccountPredicate{err: errors.New("must at least pass one nickname in NicknameNotIn")}
+	}
+	return AccountPredicate{expr: "nickname NOT IN (?)", args: []interface{}{nickname}}
 }
 
-// DeleteNum is an autogenerated method
+// NicknameNotlike creates predicate "nickname NOT LIKE ?"
 // nolint: dupl
-func (qs UserQuerySet) DeleteNum() (int64, error) {
-	db := qs.db.Delete(User{})
-	return db.RowsAffected, db.Error
+func (AccountPredicates) NicknameNotlike(nickname string) AccountPredicate {
+	return AccountPredicate{expr: "nickname NOT LIKE ?", args: []interface{}{nickname}}
 }
 
-// DeleteNumUnscoped is an autogenerated method
+// RoleEq creates predicate "role = ?"
 // nolint: dupl
-func (qs UserQuerySet) DeleteNumUnscoped() (int64, error) {
-	db := qs.db.Unscoped().Delete(User{})
-	return db.RowsAffected, db.Error
+func (AccountPredicates) RoleEq(role string) AccountPredicate {
+	return AccountPredicate{expr: "role = ?", args: []interface{}{role}}
 }
 
-// DeletedAtEq is an autogenerated method
+// RoleGt creates predicate "role > ?"
 // nolint: dupl
-func (qs UserQuerySet) DeletedAtEq(deletedAt time.Time) UserQuerySet {
-	return qs.w(qs.db.Where("deleted_at = ?", deletedAt))
+func (AccountPredicates) RoleGt(role string) AccountPredicate {
+	return AccountPredicate{expr: "role > ?", args: []interface{}{role}}
 }
 
-// DeletedAtGt is an autogenerated method
+// RoleGte creates predicate "role >= ?"
 // nolint: dupl
-func (qs UserQuerySet) DeletedAtGt(deletedAt time.Time) UserQuerySet {
-	return qs.w(qs.db.Where("deleted_at > ?", deletedAt))
+func (AccountPredicates) RoleGte(role string) AccountPredicate {
+	return AccountPredicate{expr: "role >= ?", args: []interface{}{role}}
 }
 
-// DeletedAtGte is an autogenerated method
+// RoleIn creates predicate "role IN (?)"
 // nolint: dupl
-func (qs UserQuerySet) DeletedAtGte(deletedAt time.Time) UserQuerySet {
-	return qs.w(qs.db.Where("deleted_at >= ?", deletedAt))
+func (AccountPredicates) RoleIn(role ...string) AccountPredicate {
+	if len(role) == 0 {
+		return AccountPredicate{err: errors.New("must at least pass one role in RoleIn")}
+	}
+	return AccountPredicate{expr: "role IN (?)", args: []interface{}{role}}
 }
 
-// DeletedAtIsNotNull is an autogenerated method
+// RoleLike creates predicate "role LIKE ?"
 // nolint: dupl
-func (qs UserQuerySet) DeletedAtIsNotNull() UserQuerySet {
-	return qs.w(qs.db.Where("deleted_at IS NOT NULL"))
+func (AccountPredicates) RoleLike(role string) AccountPredicate {
+	return AccountPredicate{expr: "role LIKE ?", args: []interface{}{role}}
 }
 
-// DeletedAtIsNull is an autogenerated method
+// RoleLt creates predicate "role < ?"
 // nolint: dupl
-func (qs UserQuerySet) DeletedAtIsNull() UserQuerySet {
-	return qs.w(qs.db.Where("deleted_at IS NULL"))
+func (AccountPredicates) RoleLt(role string) AccountPredicate {
+	return AccountPredicate{expr: "role < ?", args: []interface{}{role}}
 }
 
-// DeletedAtLt is an autogenerated method
+// RoleLte creates predicate "role <= ?"
 // nolint: dupl
-func (qs UserQuerySet) DeletedAtLt(deletedAt time.Time) UserQuerySet {
-	return qs.w(qs.db.Where("deleted_at < ?", deletedAt))
+func (AccountPredicates) RoleLte(role string) AccountPredicate {
+	return AccountPredicate{expr: "role <= ?", args: []interface{}{role}}
 }
 
-// DeletedAtLte is an autogenerated method
+// RoleNe creates predicate "role != ?"
 // nolint: dupl
-func (qs UserQuerySet) DeletedAtLte(deletedAt time.Time) UserQuerySet {
-	return qs.w(qs.db.Where("deleted_at <= ?", deletedAt))
+func (AccountPredicates) RoleNe(role string) AccountPredicate {
+	return AccountPredicate{expr: "role != ?", args: []interface{}{role}}
 }
 
-// DeletedAtNe is an autogenerated method
+// RoleNotIn creates predicate "role NOT IN (?)"
 // nolint: dupl
-func (qs UserQuerySet) DeletedAtNe(deletedAt time.Time) UserQuerySet {
-	return qs.w(qs.db.Where("deleted_at != ?", deletedAt))
+func (AccountPredicates) RoleNotIn(role ...string) AccountPredicate {
+	if len(role) == 0 {
+		return AccountPredicate{err: errors.New("must at least pass one role in RoleNotIn")}
+	}
+	return AccountPredicate{expr: "role NOT IN (?)", args: []interface{}{role}}
 }
 
-// EmailEq is an autogenerated method
+// RoleNotlike creates predicate "role NOT LIKE ?"
 // nolint: dupl
-func (qs UserQuerySet) EmailEq(email string) UserQuerySet {
-	return qs.w(qs.db.Where("email = ?", email))
+func (AccountPredicates) RoleNotlike(role string) AccountPredicate {
+	return AccountPredicate{expr: "role NOT LIKE ?", args: []interface{}{role}}
 }
 
-// EmailGt is an autogenerated method
+// StrEq creates predicate "str = ?"
 // nolint: dupl
-func (qs UserQuerySet) EmailGt(email string) UserQuerySet {
-	return qs.w(qs.db.Where("email > ?", email))
+func (AccountPredicates) StrEq(str tmp.StringDef) AccountPredicate {
+	return AccountPredicate{expr: "str = ?", args: []interface{}{str}}
 }
 
-// EmailGte is an autogenerated method
+// StrGt creates predicate "str > ?"
 // nolint: dupl
-func (qs UserQuerySet) EmailGte(email string) UserQuerySet {
-	return qs.w(qs.db.Where("email >= ?", email))
+func (AccountPredicates) StrGt(str tmp.StringDef) AccountPredicate {
+	return AccountPredicate{expr: "str > ?", args: []interface{}{str}}
 }
 
-// EmailIn is an autogenerated method
+// StrGte creates predicate "str >= ?"
 // nolint: dupl
-func (qs UserQuerySet) EmailIn(email ...string) UserQuerySet {
-	if len(email) == 0 {
-		qs.db.AddError(errors.New("must at least pass one email in EmailIn"))
-		return qs.w(qs.db)
+func (AccountPredicates) StrGte(str tmp.StringDef) AccountPredicate {
+	return AccountPredicate{expr: "str >= ?", args: []interface{}{str}}
+}
+
+// StrIn creates predicate "str IN (?)"
+// nolint: dupl
+func (AccountPredicates) StrIn(str ...tmp.StringDef) AccountPredicate {
+	if len(str) == 0 {
+		return AccountPredicate{err: errors.New("must at least pass one str in StrIn")}
 	}
-	return qs.w(qs.db.Where("email IN (?)", email))
+	return AccountPredicate{expr: "str IN (?)", args: []interface{}{str}}
 }
 
-// EmailLike is an autogenerated method
+// StrLike creates predicate "str LIKE ?"
 // nolint: dupl
-func (qs UserQuerySet) EmailLike(email string) UserQuerySet {
-	return qs.w(qs.db.Where("email LIKE ?", email))
+func (AccountPredicates) StrLike(str tmp.StringDef) AccountPredicate {
+	return AccountPredicate{expr: "str LIKE ?", args: []interface{}{str}}
 }
 
-// EmailLt is an autogenerated method
+// StrLt creates predicate "str < ?"
 // nolint: dupl
-func (qs UserQuerySet) EmailLt(email string) UserQuerySet {
-	return qs.w(qs.db.Where("email < ?", email))
+func (AccountPredicates) StrLt(str tmp.StringDef) AccountPredicate {
+	return AccountPredicate{expr: "str < ?", args: []interface{}{str}}
 }
 
-// EmailLte is an autogenerated method
+// StrLte creates predicate "str <= ?"
 // nolint: dupl
-func (qs UserQuerySet) EmailLte(email string) UserQuerySet {
-	return qs.w(qs.db.Where("email <= ?", email))
+func (AccountPredicates) StrLte(str tmp.StringDef) AccountPredicate {
+	return AccountPredicate{expr: "str <= ?", args: []interface{}{str}}
 }
 
-// EmailNe is an autogenerated method
+// StrNe creates predicate "str != ?"
 // nolint: dupl
-func (qs UserQuerySet) EmailNe(email string) UserQuerySet {
-	return qs.w(qs.db.Where("email != ?", email))
+func (AccountPredicates) StrNe(str tmp.StringDef) AccountPredicate {
+	return AccountPredicate{expr: "str != ?", args: []interface{}{str}}
 }
 
-// EmailNotIn is an autogenerated method
+// StrNotIn creates predicate "str NOT IN (?)"
 // nolint: dupl
-func (qs UserQuerySet) EmailNotIn(email ...string) UserQuerySet {
-	if len(email) == 0 {
-		qs.db.AddError(errors.New("must at least pass one email in EmailNotIn"))
-		return qs.w(qs.db)
+func (AccountPredicates) StrNotIn(str ...tmp.StringDef) AccountPredicate {
+	if len(str) == 0 {
+		return AccountPredicate{err: errors.New("must at least pass one str in StrNotIn")}
 	}
-	return qs.w(qs.db.Where("email NOT IN (?)", email))
+	return AccountPredicate{expr: "str NOT IN (?)", args: []interface{}{str}}
 }
 
-// EmailNotlike is an autogenerated method
+// StrNotlike creates predicate "str NOT LIKE ?"
 // nolint: dupl
-func (qs UserQuerySet) EmailNotlike(email string) UserQuerySet {
-	return qs.w(qs.db.Where("email NOT LIKE ?", email))
+func (AccountPredicates) StrNotlike(str tmp.StringDef) AccountPredicate {
+	return AccountPredicate{expr: "str NOT LIKE ?", args: []interface{}{str}}
 }
 
-// GetDB is an autogenerated method
+// UpdatedAtEq creates predicate "updated_at = ?"
 // nolint: dupl
-func (qs UserQuerySet) GetDB() *gorm.DB {
-	return qs.db
+func (AccountPredicates) UpdatedAtEq(updatedAt time.Time) AccountPredicate {
+	return AccountPredicate{expr: "updated_at = ?", args: []interface{}{updatedAt}}
 }
 
-// GetUpdater is an autogenerated method
+// UpdatedAtGt creates predicate "updated_at > ?"
 // nolint: dupl
-func (qs UserQuerySet) GetUpdater() UserUpdater {
-	return NewUserUpdater(qs.db)
+func (AccountPredicates) UpdatedAtGt(updatedAt time.Time) AccountPredicate {
+	return AccountPredicate{expr: "updated_at > ?", args: []interface{}{updatedAt}}
 }
 
-// IDEq is an autogenerated method
+// UpdatedAtGte creates predicate "updated_at >= ?"
 // nolint: dupl
-func (qs UserQuerySet) IDEq(ID uint) UserQuerySet {
-	return qs.w(qs.db.Where("id = ?", ID))
+func (AccountPredicates) UpdatedAtGte(updatedAt time.Time) AccountPredicate {
+	return AccountPredicate{expr: "updated_at >= ?", args: []interface{}{updatedAt}}
 }
 
-// IDGt is an autogenerated method
+// UpdatedAtLt creates predicate "updated_at < ?"
 // nolint: dupl
-func (qs UserQuerySet) IDGt(ID uint) UserQuerySet {
-	return qs.w(qs.db.Where("id > ?", ID))
+func (AccountPredicates) UpdatedAtLt(updatedAt time.Time) AccountPredicate {
+	return AccountPredicate{expr: "updated_at < ?", args: []interface{}{updatedAt}}
 }
 
-// IDGte is an autogenerated method
+// UpdatedAtLte creates predicate "updated_at <= ?"
 // nolint: dupl
-func (qs UserQuerySet) IDGte(ID uint) UserQuerySet {
-	return qs.w(qs.db.Where("id >= ?", ID))
+func (AccountPredicates) UpdatedAtLte(updatedAt time.Time) AccountPredicate {
+	return AccountPredicate{expr: "updated_at <= ?", args: []interface{}{updatedAt}}
 }
 
-// IDIn is an autogenerated method
+// UpdatedAtNe creates predicate "updated_at != ?"
 // nolint: dupl
-func (qs UserQuerySet) IDIn(ID ...uint) UserQuerySet {
-	if len(ID) == 0 {
-		qs.db.AddError(errors.New("must at least pass one ID in IDIn"))
-		return qs.w(qs.db)
-	}
-	return qs.w(qs.db.Where("id IN (?)", ID))
+func (AccountPredicates) UpdatedAtNe(updatedAt time.Time) AccountPredicate {
+	return AccountPredicate{expr: "updated_at != ?", args: []interface{}{updatedAt}}
 }
 
-// IDLt is an autogenerated method
-// nolint: dupl
-func (qs UserQuerySet) IDLt(ID uint) UserQuerySet {
-	return qs.w(qs.db.Where("id < ?", ID))
+// ===== END of Account predicates
+
+// ===== BEGIN of query set AuditLogs
+
+// AuditLogs is an queryset type for AuditLog
+type AuditLogs struct {
+	db *gorm.DB
 }
 
-// IDLte is an autogenerated method
-// nolint: dupl
-func (qs UserQuerySet) IDLte(ID uint) UserQuerySet {
-	return qs.w(qs.db.Where("id <= ?", ID))
+// NewAuditLogs constructs new AuditLogs
+func NewAuditLogs(db *gorm.DB) AuditLogs {
+	return AuditLogs{
+		db: db.Model(&AuditLog{}),
+	}
 }
 
-// IDNe is an autogenerated method
-// nolint: dupl
-func (qs UserQuerySet) IDNe(ID uint) UserQuerySet {
-	return qs.w(qs.db.Where("id != ?", ID))
+func (qs AuditLogs) w(db *gorm.DB) AuditLogs {
+	return NewAuditLogs(db)
 }
 
-// IDNotIn is an autogenerated method
-// nolint: dupl
-func (qs UserQuerySet) IDNotIn(ID ...uint) UserQuerySet {
-	if len(ID) == 0 {
-		qs.db.AddError(errors.New("must at least pass one ID in IDNotIn"))
-		return qs.w(qs.db)
+func (qs AuditLogs) Select(fields ...AuditLogDBSchemaField) AuditLogs {
+	names := []string{}
+	for _, f := range fields {
+		names = append(names, f.String())
 	}
-	return qs.w(qs.db.Where("id NOT IN (?)", ID))
+
+	return qs.w(qs.db.Select(strings.Join(names, ",")))
 }
 
-// Limit is an autogenerated method
-// nolint: dupl
-func (qs UserQuerySet) Limit(limit int) UserQuerySet {
-	return qs.w(qs.db.Limit(limit))
+// GroupBy groups rows by fields
+func (qs AuditLogs) GroupBy(fields ...AuditLogDBSchemaField) AuditLogs {
+	names := []string{}
+	for _, f := range fields {
+		names = append(names, f.String())
+	}
+
+	return qs.w(qs.db.Group(strings.Join(names, ",")))
 }
 
-// NameEq is an autogenerated method
+// Where filters rows by predicate p, predicates are created by AuditLogQ
 // nolint: dupl
-func (qs UserQuerySet) NameEq(name string) UserQuerySet {
-	return qs.w(qs.db.Where("name = ?", name))
+func (qs AuditLogs) Where(p AuditLogPredicate) AuditLogs {
+	if p.err != nil {
+		// error is added to copy of db: qs can be reused as a base queryset
+		db := qs.db.Model(&AuditLog{})
+		db.AddError(p.err)
+		return qs.w(db)
+	}
+	if p.expr == "" {
+		return qs
+	}
+
+	return qs.w(qs.db.Where(p.expr, p.args...))
 }
 
-// NameGt is an autogenerated method
+// Not filters rows not matching predicate p
 // nolint: dupl
-func (qs UserQuerySet) NameGt(name string) UserQuerySet {
-	return qs.w(qs.db.Where("name > ?", name))
+func (qs AuditLogs) Not(p AuditLogPredicate) AuditLogs {
+	return qs.Where(p.Not())
 }
 
-// NameGte is an autogenerated method
+// ActionEndsWith filters rows with Action ending with the argument
 // nolint: dupl
-func (qs UserQuerySet) NameGte(name string) UserQuerySet {
-	return qs.w(qs.db.Where("name >= ?", name))
+func (qs AuditLogs) ActionEndsWith(action string) AuditLogs {
+	return qs.w(whereLike(qs.db, "action", "%", action, "", false))
 }
 
-// NameIn is an autogenerated method
+// ActionEq is an autogenerated method
 // nolint: dupl
-func (qs UserQuerySet) NameIn(name ...string) UserQuerySet {
-	if len(name) == 0 {
-		qs.db.AddError(errors.New("must at least pass one name in NameIn"))
-		return qs.w(qs.db)
-	}
-	return qs.w(qs.db.Where("name IN (?)", name))
+func (qs AuditLogs) ActionEq(action string) AuditLogs {
+	return qs.w(qs.db.Where("action = ?", action))
 }
 
-// NameLike is an autogenerated method
+// ActionGt is an autogenerated method
 // nolint: dupl
-func (qs UserQuerySet) NameLike(name string) UserQuerySet {
-	return qs.w(qs.db.Where("name LIKE ?", name))
+func (qs AuditLogs) ActionGt(action string) AuditLogs {
+	return qs.w(qs.db.Where("action > ?", action))
 }
 
-// NameLt is an autogenerated method
+// ActionGte is an autogenerated method
 // nolint: dupl
-func (qs UserQuerySet) NameLt(name string) UserQuerySet {
-	return qs.w(qs.db.Where("name < ?", name))
+func (qs AuditLogs) ActionGte(action string) AuditLogs {
+	return qs.w(qs.db.Where("action >= ?", action))
 }
 
-// NameLte is an autogenerated method
+// ActionIContains filters rows with Action containing the argument, case is ignored
 // nolint: dupl
-func (qs UserQuerySet) NameLte(name string) UserQuerySet {
-	return qs.w(qs.db.Where("name <= ?", name))
+func (qs AuditLogs) ActionIContains(action string) AuditLogs {
+	return qs.w(whereLike(qs.db, "action", "%", action, "%", true))
 }
 
-// NameNe is an autogenerated method
+// ActionIEq filters rows with Action equal to the argument, case is ignored
 // nolint: dupl
-func (qs UserQuerySet) NameNe(name string) UserQuerySet {
-	return qs.w(qs.db.Where("name != ?", name))
+func (qs AuditLogs) ActionIEq(action string) AuditLogs {
+	return qs.w(whereLike(qs.db, "action", "", action, "", true))
 }
 
-// NameNotIn is an autogenerated method
+// ActionIn is an autogenerated method
 // nolint: dupl
-func (qs UserQuerySet) NameNotIn(name ...string) UserQuerySet {
-	if len(name) == 0 {
-		qs.db.AddError(errors.New("must at least pass one name in NameNotIn"))
+func (qs AuditLogs) ActionIn(action ...string) AuditLogs {
+	if len(action) == 0 {
+		qs.db.AddError(errors.New("must at least pass one action in ActionIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("name NOT IN (?)", name))
+	return qs.w(qs.db.Where("action IN (?)", action))
 }
 
-// NameNotlike is an autogenerated method
+// ActionInQuery filters rows with Action IN values selected by subquery q
 // nolint: dupl
-func (qs UserQuerySet) NameNotlike(name string) UserQuerySet {
-	return qs.w(qs.db.Where("name NOT LIKE ?", name))
+func (qs AuditLogs) ActionInQuery(q StringSubquery) AuditLogs {
+	return qs.w(qs.db.Where("action IN (?)", q.expr()))
 }
 
-// Offset is an autogenerated method
+// ActionLike is an autogenerated method
 // nolint: dupl
-func (qs UserQuerySet) Offset(offset int) UserQuerySet {
-	return qs.w(qs.db.Offset(offset))
+func (qs AuditLogs) ActionLike(action string) AuditLogs {
+	return qs.w(qs.db.Where("action LIKE ?", action))
 }
 
-// One is used to retrieve one result. It returns gorm.ErrRecordNotFound
-// if nothing was fetched
-func (qs UserQuerySet) One(ret *User) error {
-	return qs.db.First(ret).Error
+// ActionLt is an autogenerated method
+// nolint: dupl
+func (qs AuditLogs) ActionLt(action string) AuditLogs {
+	return qs.w(qs.db.Where("action < ?", action))
 }
 
-// OrderAscByCreatedAt is an autogenerated method
+// ActionLte is an autogenerated method
 // nolint: dupl
-func (qs UserQuerySet) OrderAscByCreatedAt() UserQuerySet {
-	return qs.w(qs.db.Order("created_at ASC"))
+func (qs AuditLogs) ActionLte(action string) AuditLogs {
+	return qs.w(qs.db.Where("action <= ?", action))
 }
 
-// OrderAscByDeletedAt is an autogenerated method
+// ActionNe is an autogenerated method
 // nolint: dupl
-func (qs UserQuerySet) OrderAscByDeletedAt() UserQuerySet {
-	return qs.w(qs.db.Order("deleted_at ASC"))
+func (qs AuditLogs) ActionNe(action string) AuditLogs {
+	return qs.w(qs.db.Where("action != ?", action))
 }
 
-// OrderAscByEmail is an autogenerated method
+// ActionNotIn is an autogenerated method
 // nolint: dupl
-func (qs UserQuerySet) OrderAscByEmail() UserQuerySet {
-	return qs.w(qs.db.Order("email ASC"))
+func (qs AuditLogs) ActionNotIn(action ...string) AuditLogs {
+	if len(action) == 0 {
+		qs.db.AddError(errors.New("must at least pass one action in ActionNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("action NOT IN (?)", action))
 }
 
-// OrderAscByID is an autogenerated method
+// ActionNotInQuery filters rows with Action NOT IN values selected by subquery q
 // nolint: dupl
-func (qs UserQuerySet) OrderAscByID() UserQuerySet {
-	return qs.w(qs.db.Order("id ASC"))
+func (qs AuditLogs) ActionNotInQuery(q StringSubquery) AuditLogs {
+	return qs.w(qs.db.Where("action NOT IN (?)", q.expr()))
 }
 
-// OrderAscByName is an autogenerated method
+// ActionNotlike is an autogenerated method
 // nolint: dupl
-func (qs UserQuerySet) OrderAscByName() UserQuerySet {
-	return qs.w(qs.db.Order("name ASC"))
+func (qs AuditLogs) ActionNotlike(action string) AuditLogs {
+	return qs.w(qs.db.Where("action NOT LIKE ?", action))
 }
 
-// OrderAscBySurname is an autogenerated method
+// ActionStartsWith filters rows with Action starting with the argument
 // nolint: dupl
-func (qs UserQuerySet) OrderAscBySurname() UserQuerySet {
-	return qs.w(qs.db.Order("user_surname ASC"))
+func (qs AuditLogs) ActionStartsWith(action string) AuditLogs {
+	return qs.w(whereLike(qs.db, "action", "", action, "%", false))
 }
 
-// OrderAscByUpdatedAt is an autogenerated method
+// All is an autogenerated method
 // nolint: dupl
-func (qs UserQuerySet) OrderAscByUpdatedAt() UserQuerySet {
-	return qs.w(qs.db.Order("updated_at ASC"))
+func (qs AuditLogs) All(ret *[]AuditLog) error {
+	return checkQueryContext(qs.db).Find(ret).Error
 }
 
-// OrderDescByCreatedAt is an autogenerated method
+// Batches calls fn for every batch of at most size rows, batches are
+// fetched by Page: ordered by primary key after queryset orders.
+// It stops on the first error of fn and returns it.
 // nolint: dupl
-func (qs UserQuerySet) OrderDescByCreatedAt() UserQuerySet {
-	return qs.w(qs.db.Order("created_at DESC"))
+func (qs AuditLogs) Batches(size int, fn func([]AuditLog) error) error {
+	cursor := ""
+	for {
+		var batch []AuditLog
+		next, err := qs.Page(cursor, size, &batch)
+		if err != nil {
+			return err
+		}
+
+		if len(batch) != 0 {
+			if err = fn(batch); err != nil {
+				return err
+			}
+		}
+
+		if next == "" {
+			return nil
+		}
+		cursor = next
+	}
 }
 
-// OrderDescByDeletedAt is an autogenerated method
+// Count is an autogenerated method
 // nolint: dupl
-func (qs UserQuerySet) OrderDescByDeletedAt() UserQuerySet {
-	return qs.w(qs.db.Order("deleted_at DESC"))
+func (qs AuditLogs) Count() (int, error) {
+	var count int
+	db := checkQueryContext(qs.db)
+	if db.Error != nil {
+		return count, db.Error
+	}
+
+	err := db.Count(&count).Error
+	return count, err
 }
 
-// OrderDescByEmail is an autogenerated method
+// CountByAction returns count of rows for every value of Action
 // nolint: dupl
-func (qs UserQuerySet) OrderDescByEmail() UserQuerySet {
-	return qs.w(qs.db.Order("email DESC"))
+func (qs AuditLogs) CountByAction() (map[string]int, error) {
+	var rows []struct {
+		Value string
+		Count int
+	}
+	err := checkQueryContext(qs.db).Select("action AS value, COUNT(*) AS count").Group("action").Scan(&rows).Error
+	ret := make(map[string]int, len(rows))
+	for _, r := range rows {
+		ret[r.Value] = r.Count
+	}
+	return ret, err
 }
 
-// OrderDescByID is an autogenerated method
+// CountByID returns count of rows for every value of ID
 // nolint: dupl
-func (qs UserQuerySet) OrderDescByID() UserQuerySet {
-	return qs.w(qs.db.Order("id DESC"))
+func (qs AuditLogs) CountByID() (map[uint]int, error) {
+	var rows []struct {
+		Value uint
+		Count int
+	}
+	err := checkQueryContext(qs.db).Select("id AS value, COUNT(*) AS count").Group("id").Scan(&rows).Error
+	ret := make(map[uint]int, len(rows))
+	for _, r := range rows {
+		ret[r.Value] = r.Count
+	}
+	return ret, err
 }
 
-// OrderDescByName is an autogenerated method
+// GetDB is an autogenerated method
 // nolint: dupl
-func (qs UserQuerySet) OrderDescByName() UserQuerySet {
-	return qs.w(qs.db.Order("name DESC"))
+func (qs AuditLogs) GetDB() *gorm.DB {
+	return qs.db
 }
 
-// OrderDescBySurname is an autogenerated method
+// GroupByAction is an autogenerated method
 // nolint: dupl
-func (qs UserQuerySet) OrderDescBySurname() UserQuerySet {
-	return qs.w(qs.db.Order("user_surname DESC"))
+func (qs AuditLogs) GroupByAction() AuditLogs {
+	return qs.w(qs.db.Group("action"))
 }
 
-// OrderDescByUpdatedAt is an autogenerated method
+// GroupByID is an autogenerated method
 // nolint: dupl
-func (qs UserQuerySet) OrderDescByUpdatedAt() UserQuerySet {
-	return qs.w(qs.db.Order("updated_at DESC"))
+func (qs AuditLogs) GroupByID() AuditLogs {
+	return qs.w(qs.db.Group("id"))
 }
 
-// SurnameEq is an autogenerated method
+// HavingCountEq is an autogenerated method
 // nolint: dupl
-func (qs UserQuerySet) SurnameEq(surname string) UserQuerySet {
-	return qs.w(qs.db.Where("user_surname = ?", surname))
+func (qs AuditLogs) HavingCountEq(count int) AuditLogs {
+	return qs.w(qs.db.Having("COUNT(*) = ?", count))
 }
 
-// SurnameGt is an autogenerated method
+// HavingCountGt is an autogenerated method
 // nolint: dupl
-func (qs UserQuerySet) SurnameGt(surname string) UserQuerySet {
-	return qs.w(qs.db.Where("user_surname > ?", surname))
+func (qs AuditLogs) HavingCountGt(count int) AuditLogs {
+	return qs.w(qs.db.Having("COUNT(*) > ?", count))
 }
 
-// SurnameGte is an autogenerated method
+// HavingCountGte is an autogenerated method
 // nolint: dupl
-func (qs UserQuerySet) SurnameGte(surname string) UserQuerySet {
-	return qs.w(qs.db.Where("user_surname >= ?", surname))
+func (qs AuditLogs) HavingCountGte(count int) AuditLogs {
+	return qs.w(qs.db.Having("COUNT(*) >= ?", count))
 }
 
-// SurnameIn is an autogenerated method
+// HavingCountLt is an autogenerated method
 // nolint: dupl
-func (qs UserQuerySet) SurnameIn(surname ...string) UserQuerySet {
-	if len(surname) == 0 {
-		qs.db.AddError(errors.New("must at least pass one surname in SurnameIn"))
-		return qs.w(qs.db)
-	}
-	return qs.w(qs.db.Where("user_surname IN (?)", surname))
+func (qs AuditLogs) HavingCountLt(count int) AuditLogs {
+	return qs.w(qs.db.Having("COUNT(*) < ?", count))
 }
 
-// SurnameIsNotNull is an autogenerated method
+// HavingCountLte is an autogenerated method
 // nolint: dupl
-func (qs UserQuerySet) SurnameIsNotNull() UserQuerySet {
-	return qs.w(qs.db.Where("user_surname IS NOT NULL"))
+func (qs AuditLogs) HavingCountLte(count int) AuditLogs {
+	return qs.w(qs.db.Having("COUNT(*) <= ?", count))
 }
 
-// SurnameIsNull is an autogenerated method
+// HavingCountNe is an autogenerated method
 // nolint: dupl
-func (qs UserQuerySet) SurnameIsNull() UserQuerySet {
-	return qs.w(qs.db.Where("user_surname IS NULL"))
+func (qs AuditLogs) HavingCountNe(count int) AuditLogs {
+	return qs.w(qs.db.Having("COUNT(*) != ?", count))
 }
 
-// SurnameLike is an autogenerated method
+// IDBetween filters rows with ID in range [from, to]
 // nolint: dupl
-func (qs UserQuerySet) SurnameLike(surname string) UserQuerySet {
-	return qs.w(qs.db.Where("user_surname LIKE ?", surname))
+func (qs AuditLogs) IDBetween(from uint, to uint) AuditLogs {
+	return qs.w(qs.db.Where("id BETWEEN ? AND ?", from, to))
 }
 
-// SurnameLt is an autogenerated method
+// IDEq is an autogenerated method
 // nolint: dupl
-func (qs UserQuerySet) SurnameLt(surname string) UserQuerySet {
-	return qs.w(qs.db.Where("user_surname < ?", surname))
+func (qs AuditLogs) IDEq(ID uint) AuditLogs {
+	return qs.w(qs.db.Where("id = ?", ID))
 }
 
-// SurnameLte is an autogenerated method
+// IDGt is an autogenerated method
 // nolint: dupl
-func (qs UserQuerySet) SurnameLte(surname string) UserQuerySet {
-	return qs.w(qs.db.Where("user_surname <= ?", surname))
+func (qs AuditLogs) IDGt(ID uint) AuditLogs {
+	return qs.w(qs.db.Where("id > ?", ID))
 }
 
-// SurnameNe is an autogenerated method
+// IDGte is an autogenerated method
 // nolint: dupl
-func (qs UserQuerySet) SurnameNe(surname string) UserQuerySet {
-	return qs.w(qs.db.Where("user_surname != ?", surname))
+func (qs AuditLogs) IDGte(ID uint) AuditLogs {
+	return qs.w(qs.db.Where("id >= ?", ID))
 }
 
-// SurnameNotIn is an autogenerated method
+// IDIn is an autogenerated method
 // nolint: dupl
-func (qs UserQuerySet) SurnameNotIn(surname ...string) UserQuerySet {
-	if len(surname) == 0 {
-		qs.db.AddError(errors.New("must at least pass one surname in SurnameNotIn"))
+func (qs AuditLogs) IDIn(ID ...uint) AuditLogs {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("user_surname NOT IN (?)", surname))
+	return qs.w(qs.db.Where("id IN (?)", ID))
 }
 
-// SurnameNotlike is an autogenerated method
+// IDInQuery filters rows with ID IN values selected by subquery q
 // nolint: dupl
-func (qs UserQuerySet) SurnameNotlike(surname string) UserQuerySet {
-	return qs.w(qs.db.Where("user_surname NOT LIKE ?", surname))
+func (qs AuditLogs) IDInQuery(q UintSubquery) AuditLogs {
+	return qs.w(qs.db.Where("id IN (?)", q.expr()))
 }
 
-// UpdatedAtEq is an autogenerated method
+// IDInRange filters rows with ID in range [from, to)
 // nolint: dupl
-func (qs UserQuerySet) UpdatedAtEq(updatedAt time.Time) UserQuerySet {
-	return qs.w(qs.db.Where("updated_at = ?", updatedAt))
+func (qs AuditLogs) IDInRange(from uint, to uint) AuditLogs {
+	return qs.w(qs.db.Where("id >= ? AND id < ?", from, to))
 }
 
-// UpdatedAtGt is an autogenerated method
+// IDLt is an autogenerated method
 // nolint: dupl
-func (qs UserQuerySet) UpdatedAtGt(updatedAt time.Time) UserQuerySet {
-	return qs.w(qs.db.Where("updated_at > ?", updatedAt))
+func (qs AuditLogs) IDLt(ID uint) AuditLogs {
+	return qs.w(qs.db.Where("id < ?", ID))
 }
 
-// UpdatedAtGte is an autogenerated method
+// IDLte is an autogenerated method
 // nolint: dupl
-func (qs UserQuerySet) UpdatedAtGte(updatedAt time.Time) UserQuerySet {
-	return qs.w(qs.db.Where("updated_at >= ?", updatedAt))
+func (qs AuditLogs) IDLte(ID uint) AuditLogs {
+	return qs.w(qs.db.Where("id <= ?", ID))
 }
 
-// UpdatedAtLt is an autogenerated method
+// IDNe is an autogenerated method
 // nolint: dupl
-func (qs UserQuerySet) UpdatedAtLt(updatedAt time.Time) UserQuerySet {
-	return qs.w(qs.db.Where("updated_at < ?", updatedAt))
+func (qs AuditLogs) IDNe(ID uint) AuditLogs {
+	return qs.w(qs.db.Where("id != ?", ID))
 }
 
-// UpdatedAtLte is an autogenerated method
+// IDNotIn is an autogenerated method
 // nolint: dupl
-func (qs UserQuerySet) UpdatedAtLte(updatedAt time.Time) UserQuerySet {
-	return qs.w(qs.db.Where("updated_at <= ?", updatedAt))
+func (qs AuditLogs) IDNotIn(ID ...uint) AuditLogs {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("id NOT IN (?)", ID))
 }
 
-// UpdatedAtNe is an autogenerated method
+// IDNotInQuery filters rows with ID NOT IN values selected by subquery q
 // nolint: dupl
-func (qs UserQuerySet) UpdatedAtNe(updatedAt time.Time) UserQuerySet {
-	return qs.w(qs.db.Where("updated_at != ?", updatedAt))
+func (qs AuditLogs) IDNotInQuery(q UintSubquery) AuditLogs {
+	return qs.w(qs.db.Where("id NOT IN (?)", q.expr()))
 }
 
-// SetCreatedAt is an autogenerated method
+// InTx returns copy bound to transaction tx: queries are executed in it.
+// Gorm v1 can't move conditions into another connection, so with gorm v1
+// InTx must be called before any condition is set, otherwise queries fail.
+// Context and preloads are moved into transaction by both gorm versions.
 // nolint: dupl
-func (u UserUpdater) SetCreatedAt(createdAt time.Time) UserUpdater {
-	u.fields[string(UserDBSchema.CreatedAt)] = createdAt
-	return u
+func (qs AuditLogs) InTx(tx *gorm.DB) AuditLogs {
+	if !reflect.DeepEqual(qs.db.QueryExpr(), NewAuditLogs(qs.db.New()).db.QueryExpr()) {
+		tx = tx.New()
+		tx.AddError(errors.New("InTx must be called before any condition is set: " +
+			"gorm v1 can't move conditions into transaction"))
+	}
+	tx = txWithSettings(qs.db, tx)
+	return NewAuditLogs(tx)
 }
 
-// SetDeletedAt is an autogenerated method
+// Iterate scans rows one by one and calls fn for every row without
+// loading all rows into memory. It stops on the first error of fn and
+// returns it. Preloads aren't applied.
 // nolint: dupl
-func (u UserUpdater) SetDeletedAt(deletedAt *time.Time) UserUpdater {
-	u.fields[string(UserDBSchema.DeletedAt)] = deletedAt
-	return u
+func (qs AuditLogs) Iterate(fn func(*AuditLog) error) error {
+	db := checkQueryContext(qs.db)
+	if db.Error != nil {
+		return db.Error // gorm v1 doesn't check it in Rows
+	}
+
+	rows, err := db.Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var o AuditLog
+		if err = db.ScanRows(rows, &o); err != nil {
+			return err
+		}
+		if err = fn(&o); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
 }
 
-// SetEmail is an autogenerated method
+// Limit is an autogenerated method
 // nolint: dupl
-func (u UserUpdater) SetEmail(email string) UserUpdater {
-	u.fields[string(UserDBSchema.Email)] = email
-	return u
+func (qs AuditLogs) Limit(limit int) AuditLogs {
+	return qs.w(qs.db.Limit(limit))
 }
 
-// SetID is an autogenerated method
+// MaxID returns MAX of ID, it's zero value if there are no rows
 // nolint: dupl
-func (u UserUpdater) SetID(ID uint) UserUpdater {
-	u.fields[string(UserDBSchema.ID)] = ID
-	return u
+func (qs AuditLogs) MaxID() (uint, error) {
+	var ret uint
+	var res struct {
+		Value *uint
+	}
+	err := checkQueryContext(qs.db).Select("MAX(id) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
 }
 
-// SetName is an autogenerated method
+// MinID returns MIN of ID, it's zero value if there are no rows
 // nolint: dupl
-func (u UserUpdater) SetName(name string) UserUpdater {
-	u.fields[string(UserDBSchema.Name)] = name
-	return u
+func (qs AuditLogs) MinID() (uint, error) {
+	var ret uint
+	var res struct {
+		Value *uint
+	}
+	err := checkQueryContext(qs.db).Select("MIN(id) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
 }
 
-// SetSurname is an autogenerated method
+// Offset is an autogenerated method
 // nolint: dupl
-func (u UserUpdater) SetSurname(surname *string) UserUpdater {
-	u.fields[string(UserDBSchema.Surname)] = surname
-	return u
+func (qs AuditLogs) Offset(offset int) AuditLogs {
+	return qs.w(qs.db.Offset(offset))
 }
 
-// SetUpdatedAt is an autogenerated method
+// One is used to retrieve one result. It returns gorm.ErrRecordNotFound
+// if nothing was fetched
+func (qs AuditLogs) One(ret *AuditLog) error {
+	return checkQueryContext(qs.db).First(ret).Error
+}
+
+// OrderAscByAction is an autogenerated method
 // nolint: dupl
-func (u UserUpdater) SetUpdatedAt(updatedAt time.Time) UserUpdater {
-	u.fields[string(UserDBSchema.UpdatedAt)] = updatedAt
-	return u
+func (qs AuditLogs) OrderAscByAction() AuditLogs {
+	return qs.w(addPageOrder(qs.db.Order("action ASC"), "action", false, false))
 }
 
-// Update is an autogenerated method
+// OrderAscByID is an autogenerated method
 // nolint: dupl
-func (u UserUpdater) Update() error {
-	return u.db.Updates(u.fields).Error
+func (qs AuditLogs) OrderAscByID() AuditLogs {
+	return qs.w(addPageOrder(qs.db.Order("id ASC"), "id", false, false))
 }
 
-// UpdateNum is an autogenerated method
+// OrderDescByAction is an autogenerated method
 // nolint: dupl
-func (u UserUpdater) UpdateNum() (int64, error) {
-	db := u.db.Updates(u.fields)
-	return db.RowsAffected, db.Error
+func (qs AuditLogs) OrderDescByAction() AuditLogs {
+	return qs.w(addPageOrder(qs.db.Order("action DESC"), "action", true, false))
 }
 
-// ===== END of query set UserQuerySet
+// OrderDescByID is an autogenerated method
+// nolint: dupl
+func (qs AuditLogs) OrderDescByID() AuditLogs {
+	return qs.w(addPageOrder(qs.db.Order("id DESC"), "id", true, false))
+}
 
-// ===== BEGIN of User modifiers
+// Page fetches at most size rows following cursor into ret, it uses
+// orders set by OrderAscBy/OrderDescBy methods and primary key to break ties.
+// Ordered fields can't be nullable: rows with NULL can't be compared with
+// cursor, so error is returned for them. Empty cursor means the first page. It returns cursor of the next page or
+// empty string if there are no more rows.
+// nolint: dupl
+func (qs AuditLogs) Page(cursor string, size int, ret *[]AuditLog) (string, error) {
+	if size <= 0 {
+		return "", errors.New("page size must be positive")
+	}
 
-// UserDBSchemaField describes database schema field. It requires for method 'Update'
-type UserDBSchemaField string
+	var last AuditLog
+	db, orders, err := pageQuery(qs.db, "", "id", cursor, qs.fieldPtrs(&last))
+	if err != nil {
+		return "", err
+	}
+
+	if err = checkQueryContext(db).Limit(size + 1).Find(ret).Error; err != nil {
+		return "", err
+	}
+	if len(*ret) <= size {
+		return "", nil
+	}
+
+	*ret = (*ret)[:size]
+	return encodePageCursor(orders, qs.fieldPtrs(&(*ret)[size-1]))
+}
+
+// SelectAction returns subquery selecting Action of rows of queryset
+// for InQuery and NotInQuery filters
+// nolint: dupl
+func (qs AuditLogs) SelectAction() StringSubquery {
+	return StringSubquery{db: qs.db.Select("action")}
+}
+
+// SelectID returns subquery selecting ID of rows of queryset
+// for InQuery and NotInQuery filters
+// nolint: dupl
+func (qs AuditLogs) SelectID() UintSubquery {
+	return UintSubquery{db: qs.db.Select("id")}
+}
+
+// WithContext returns copy executing queries with context ctx.
+// Gorm v1 doesn't support context: its error is checked only before query.
+// nolint: dupl
+func (qs AuditLogs) WithContext(ctx context.Context) AuditLogs {
+	return qs.w(DBWithContext(ctx, qs.db))
+}
+
+// fieldPtrs returns pointers to fields of o by their columns
+// nolint: dupl
+func (qs AuditLogs) fieldPtrs(o *AuditLog) map[string]interface{} {
+	return map[string]interface{}{
+		"id":     &o.ID,
+		"action": &o.Action,
+	}
+}
+
+// ===== END of query set AuditLogs
+
+// ===== BEGIN of AuditLog modifiers
+
+// AuditLogDBSchemaField describes database schema field. It requires for method 'Update'
+type AuditLogDBSchemaField string
 
 // String method returns string representation of field.
 // nolint: dupl
-func (f UserDBSchemaField) String() string {
+func (f AuditLogDBSchemaField) String() string {
 	return string(f)
 }
 
-// UserDBSchema stores db field names of User
-var UserDBSchema = struct {
-	ID        UserDBSchemaField
-	CreatedAt UserDBSchemaField
-	UpdatedAt UserDBSchemaField
-	DeletedAt UserDBSchemaField
-	Name      UserDBSchemaField
-	Surname   UserDBSchemaField
-	Email     UserDBSchemaField
+// AuditLogDBSchema stores db field names of AuditLog
+var AuditLogDBSchema = struct {
+	ID     AuditLogDBSchemaField
+	Action AuditLogDBSchemaField
 }{
 
-	ID:        UserDBSchemaField("id"),
-	CreatedAt: UserDBSchemaField("created_at"),
-	UpdatedAt: UserDBSchemaField("updated_at"),
-	DeletedAt: UserDBSchemaField("deleted_at"),
-	Name:      UserDBSchemaField("name"),
-	Surname:   UserDBSchemaField("user_surname"),
-	Email:     UserDBSchemaField("email"),
+	ID:     AuditLogDBSchemaField("id"),
+	Action: AuditLogDBSchemaField("action"),
 }
 
-// Update updates User fields by primary key
+// ===== END of AuditLog modifiers
+
+// ===== BEGIN of AuditLog predicates
+
+// AuditLogPredicate is a condition on AuditLog fields for Where and Not
+// methods of AuditLogs: predicates are created by AuditLogQ and
+// combined by And, Or and Not. Zero predicate matches all rows.
+type AuditLogPredicate struct {
+	expr string
+	args []interface{}
+	err  error
+}
+
+// join combines predicates by operation op, empty predicates are skipped
 // nolint: dupl
-func (o *User) Update(db *gorm.DB, fields ...UserDBSchemaField) error {
-	dbNameToFieldName := map[string]interface{}{
-		"id":           o.ID,
-		"created_at":   o.CreatedAt,
-		"updated_at":   o.UpdatedAt,
-		"deleted_at":   o.DeletedAt,
-		"name":         o.Name,
-		"user_surname": o.Surname,
-		"email":        o.Email,
+func (p AuditLogPredicate) join(op string, preds []AuditLogPredicate) AuditLogPredicate {
+	for _, q := range preds {
+		if p.err == nil {
+			p.err = q.err
+		}
+		if q.expr == "" {
+			continue
+		}
+		if p.expr == "" {
+			p.expr, p.args = q.expr, q.args
+			continue
+		}
+
+		p.expr = "(" + p.expr + ") " + op + " (" + q.expr + ")"
+		p.args = append(append([]interface{}{}, p.args...), q.args...)
 	}
-	u := map[string]interface{}{}
+
+	return p
+}
+
+// And returns predicate matching rows matched by p and all preds
+// nolint: dupl
+func (p AuditLogPredicate) And(preds ...AuditLogPredicate) AuditLogPredicate {
+	return p.join("AND", preds)
+}
+
+// Or returns predicate matching rows matched by p or any of preds
+// nolint: dupl
+func (p AuditLogPredicate) Or(preds ...AuditLogPredicate) AuditLogPredicate {
+	return p.join("OR", preds)
+}
+
+// Not returns predicate matching rows not matched by p
+// nolint: dupl
+func (p AuditLogPredicate) Not() AuditLogPredicate {
+	if p.expr != "" {
+		p.expr = "NOT (" + p.expr + ")"
+	}
+
+	return p
+}
+
+// AuditLogPredicates creates predicates by AuditLog fields
+type AuditLogPredicates struct{}
+
+// AuditLogQ creates predicates by AuditLog fields
+var AuditLogQ AuditLogPredicates
+
+// ActionEq creates predicate "action = ?"
+// nolint: dupl
+func (AuditLogPredicates) ActionEq(action string) AuditLogPredicate {
+	return AuditLogPredicate{expr: "action = ?", args: []interface{}{action}}
+}
+
+// ActionGt creates predicate "action > ?"
+// nolint: dupl
+func (AuditLogPredicates) ActionGt(action string) AuditLogPredicate {
+	return AuditLogPredicate{expr: "action > ?", args: []interface{}{action}}
+}
+
+// ActionGte creates predicate "action >= ?"
+// nolint: dupl
+func (AuditLogPredicates) ActionGte(action string) AuditLogPredicate {
+	return AuditLogPredicate{expr: "action >= ?", args: []interface{}{action}}
+}
+
+// ActionIn creates predicate "action IN (?)"
+// nolint: dupl
+func (AuditLogPredicates) ActionIn(action ...string) AuditLogPredicate {
+	if len(action) == 0 {
+		return AuditLogPredicate{err: errors.New("must at least pass one action in ActionIn")}
+	}
+	return AuditLogPredicate{expr: "action IN (?)", args: []interface{}{action}}
+}
+
+// ActionLike creates predicate "action LIKE ?"
+// nolint: dupl
+func (AuditLogPredicates) ActionLike(action string) AuditLogPredicate {
+	return AuditLogPredicate{expr: "action LIKE ?", args: []interface{}{action}}
+}
+
+// ActionLt creates predicate "action < ?"
+// nolint: dupl
+func (AuditLogPredicates) ActionLt(action string) AuditLogPredicate {
+	return AuditLogPredicate{expr: "action < ?", args: []interface{}{action}}
+}
+
+// ActionLte creates predicate "action <= ?"
+// nolint: dupl
+func (AuditLogPredicates) ActionLte(action string) AuditLogPredicate {
+	return AuditLogPredicate{expr: "action <= ?", args: []interface{}{action}}
+}
+
+// ActionNe creates predicate "action != ?"
+// nolint: dupl
+func (AuditLogPredicates) ActionNe(action string) AuditLogPredicate {
+	return AuditLogPredicate{expr: "action != ?", args: []interface{}{action}}
+}
+
+// ActionNotIn creates predicate "action NOT IN (?)"
+// nolint: dupl
+func (AuditLogPredicates) ActionNotIn(action ...string) AuditLogPredicate {
+	if len(action) == 0 {
+		return AuditLogPredicate{err: errors.New("must at least pass one action in ActionNotIn")}
+	}
+	return AuditLogPredicate{expr: "action NOT IN (?)", args: []interface{}{action}}
+}
+
+// ActionNotlike creates predicate "action NOT LIKE ?"
+// nolint: dupl
+func (AuditLogPredicates) ActionNotlike(action string) AuditLogPredicate {
+	return AuditLogPredicate{expr: "action NOT LIKE ?", args: []interface{}{action}}
+}
+
+// IDEq creates predicate "id = ?"
+// nolint: dupl
+func (AuditLogPredicates) IDEq(ID uint) AuditLogPredicate {
+	return AuditLogPredicate{expr: "id = ?", args: []interface{}{ID}}
+}
+
+// IDGt creates predicate "id > ?"
+// nolint: dupl
+func (AuditLogPredicates) IDGt(ID uint) AuditLogPredicate {
+	return AuditLogPredicate{expr: "id > ?", args: []interface{}{ID}}
+}
+
+// IDGte creates predicate "id >= ?"
+// nolint: dupl
+func (AuditLogPredicates) IDGte(ID uint) AuditLogPredicate {
+	return AuditLogPredicate{expr: "id >= ?", args: []interface{}{ID}}
+}
+
+// IDIn creates predicate "id IN (?)"
+// nolint: dupl
+func (AuditLogPredicates) IDIn(ID ...uint) AuditLogPredicate {
+	if len(ID) == 0 {
+		return AuditLogPredicate{err: errors.New("must at least pass one ID in IDIn")}
+	}
+	return AuditLogPredicate{expr: "id IN (?)", args: []interface{}{ID}}
+}
+
+// IDLt creates predicate "id < ?"
+// nolint: dupl
+func (AuditLogPredicates) IDLt(ID uint) AuditLogPredicate {
+	return AuditLogPredicate{expr: "id < ?", args: []interface{}{ID}}
+}
+
+// IDLte creates predicate "id <= ?"
+// nolint: dupl
+func (AuditLogPredicates) IDLte(ID uint) AuditLogPredicate {
+	return AuditLogPredicate{expr: "id <= ?", args: []interface{}{ID}}
+}
+
+// IDNe creates predicate "id != ?"
+// nolint: dupl
+func (AuditLogPredicates) IDNe(ID uint) AuditLogPredicate {
+	return AuditLogPredicate{expr: "id != ?", args: []interface{}{ID}}
+}
+
+// IDNotIn creates predicate "id NOT IN (?)"
+// nolint: dupl
+func (AuditLogPredicates) IDNotIn(ID ...uint) AuditLogPredicate {
+	if len(ID) == 0 {
+		return AuditLogPredicate{err: errors.New("must at least pass one ID in IDNotIn")}
+	}
+	return AuditLogPredicate{expr: "id NOT IN (?)", args: []interface{}{ID}}
+}
+
+// ===== END of AuditLog predicates
+
+// ===== BEGIN of query set BlogQuerySet
+
+// BlogQuerySet is an queryset type for Blog
+type BlogQuerySet struct {
+	db *gorm.DB
+}
+
+// NewBlogQuerySet constructs new BlogQuerySet
+func NewBlogQuerySet(db *gorm.DB) BlogQuerySet {
+	return BlogQuerySet{
+		db: db.Model(&Blog{}),
+	}
+}
+
+func (qs BlogQuerySet) w(db *gorm.DB) BlogQuerySet {
+	return NewBlogQuerySet(db)
+}
+
+func (qs BlogQuerySet) Select(fields ...BlogDBSchemaField) BlogQuerySet {
+	names := []string{}
 	for _, f := range fields {
-		fs := f.String()
-		u[fs] = dbNameToFieldName[fs]
+		names = append(names, f.String())
 	}
-	if err := db.Model(o).Updates(u).Error; err != nil {
-		if err == gorm.ErrRecordNotFound {
-			return err
-		}
 
-		return fmt.Errorf("can't update User %v fields %v: %s",
-			o, fields, err)
+	return qs.w(qs.db.Select(strings.Join(names, ",")))
+}
+
+// GroupBy groups rows by fields
+func (qs BlogQuerySet) GroupBy(fields ...BlogDBSchemaField) BlogQuerySet {
+	names := []string{}
+	for _, f := range fields {
+		names = append(names, f.String())
 	}
 
-	return nil
+	return qs.w(qs.db.Group(strings.Join(names, ",")))
 }
 
-// UserUpdater is an User updates manager
-type UserUpdater struct {
-	fields map[string]interface{}
-	db     *gorm.DB
+// Where filters rows by predicate p, predicates are created by BlogQ
+// nolint: dupl
+func (qs BlogQuerySet) Where(p BlogPredicate) BlogQuerySet {
+	if p.err != nil {
+		// error is added to copy of db: qs can be reused as a base queryset
+		db := qs.db.Model(&Blog{})
+		db.AddError(p.err)
+		return qs.w(db)
+	}
+	if p.expr == "" {
+		return qs
+	}
+
+	return qs.w(qs.db.Where(p.expr, p.args...))
 }
 
-// NewUserUpdater creates new User updater
+// Not filters rows not matching predicate p
 // nolint: dupl
-func NewUserUpdater(db *gorm.DB) UserUpdater {
-	return UserUpdater{
-		fields: map[string]interface{}{},
-		db:     db.Model(&User{}),
+func (qs BlogQuerySet) Not(p BlogPredicate) BlogQuerySet {
+	return qs.Where(p.Not())
+}
+
+// AppendEditors adds editors to Editors of o: editors are saved and rows of join table blog_editors are set
+// nolint: dupl
+func (o *Blog) AppendEditors(db *gorm.DB, editors ...*User) error {
+	return checkQueryContext(db).Model(o).Association("Editors").Append(editors).Error
+}
+
+// ClearEditors removes all objects from Editors of o: rows of join table blog_editors are cleared,
+// objects aren't deleted
+// nolint: dupl
+func (o *Blog) ClearEditors(db *gorm.DB) error {
+	return checkQueryContext(db).Model(o).Association("Editors").Clear().Error
+}
+
+// CountEditors returns number of objects in Editors of o
+// nolint: dupl
+func (o *Blog) CountEditors(db *gorm.DB) (int, error) {
+	a := checkQueryContext(db).Model(o).Association("Editors")
+	n := a.Count()
+	return n, a.Error
+}
+
+// Create is an autogenerated method
+// nolint: dupl
+func (o *Blog) Create(db *gorm.DB) error {
+	return checkQueryContext(db).Create(o).Error
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (o *Blog) Delete(db *gorm.DB) error {
+	return checkQueryContext(db).Delete(o).Error
+}
+
+// RemoveEditors removes editors from Editors of o: rows of join table blog_editors are cleared,
+// objects aren't deleted
+// nolint: dupl
+func (o *Blog) RemoveEditors(db *gorm.DB, editors ...*User) error {
+	return checkQueryContext(db).Model(o).Association("Editors").Delete(editors).Error
+}
+
+// ReplaceEditors replaces Editors of o by editors: other objects are removed
+// from association but aren't deleted
+// nolint: dupl
+func (o *Blog) ReplaceEditors(db *gorm.DB, editors ...*User) error {
+	return checkQueryContext(db).Model(o).Association("Editors").Replace(editors).Error
+}
+
+// All is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) All(ret *[]Blog) error {
+	return checkQueryContext(qs.db).Find(ret).Error
+}
+
+// Batches calls fn for every batch of at most size rows, batches are
+// fetched by Page: ordered by primary key after queryset orders.
+// It stops on the first error of fn and returns it.
+// nolint: dupl
+func (qs BlogQuerySet) Batches(size int, fn func([]Blog) error) error {
+	cursor := ""
+	for {
+		var batch []Blog
+		next, err := qs.Page(cursor, size, &batch)
+		if err != nil {
+			return err
+		}
+
+		if len(batch) != 0 {
+			if err = fn(batch); err != nil {
+				return err
+			}
+		}
+
+		if next == "" {
+			return nil
+		}
+		cursor = next
 	}
 }
 
-// ===== END of User modifiers
+// Count is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) Count() (int, error) {
+	var count int
+	db := checkQueryContext(qs.db)
+	if db.Error != nil {
+		return count, db.Error
+	}
 
-// ===== END of all query sets
+	err := db.Count(&count).Error
+	return count, err
+}
+
+// CountByID returns count of rows for every value of ID
+// nolint: dupl
+func (qs BlogQuerySet) CountByID() (map[uint]int, error) {
+	var rows []struct {
+		Value uint
+		Count int
+	}
+	err := checkQueryContext(qs.db).Select("id AS value, COUNT(*) AS count").Group("id").Scan(&rows).Error
+	ret := make(map[uint]int, len(rows))
+	for _, r := range rows {
+		ret[r.Value] = r.Count
+	}
+	return ret, err
+}
+
+// CountByName returns count of rows for every value of Name
+// nolint: dupl
+func (qs BlogQuerySet) CountByName() (map[string]int, error) {
+	var rows []struct {
+		Value string
+		Count int
+	}
+	err := checkQueryContext(qs.db).Select("myname AS value, COUNT(*) AS count").Group("myname").Scan(&rows).Error
+	ret := make(map[string]int, len(rows))
+	for _, r := range rows {
+		ret[r.Value] = r.Count
+	}
+	return ret, err
+}
+
+// CreateMany inserts models by multi-row inserts, conditions of queryset
+// aren't used. Generated primary keys are written back to models if
+// database supports it (all except MS SQL for gorm v1).
+// Unlike gorm Create it doesn't call hooks and doesn't read back default
+// values: zero fields having default values are skipped in insert.
+// nolint: dupl
+func (qs BlogQuerySet) CreateMany(models []Blog) error {
+	rows := make([]map[string]interface{}, 0, len(models))
+	for i := range models {
+		rows = append(rows, qs.fieldPtrs(&models[i]))
+	}
+
+	return bulkInsert(checkQueryContext(qs.db), "id", rows)
+}
+
+// CreatedAtBetween filters rows with CreatedAt in range [from, to]
+// nolint: dupl
+func (qs BlogQuerySet) CreatedAtBetween(from time.Time, to time.Time) BlogQuerySet {
+	return qs.w(qs.db.Where("created_at BETWEEN ? AND ?", from, to))
+}
+
+// CreatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) CreatedAtEq(createdAt time.Time) BlogQuerySet {
+	return qs.w(qs.db.Where("created_at = ?", createdAt))
+}
+
+// CreatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) CreatedAtGt(createdAt time.Time) BlogQuerySet {
+	return qs.w(qs.db.Where("created_at > ?", createdAt))
+}
+
+// CreatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) CreatedAtGte(createdAt time.Time) BlogQuerySet {
+	return qs.w(qs.db.Where("created_at >= ?", createdAt))
+}
+
+// CreatedAtInRange filters rows with CreatedAt in range [from, to)
+// nolint: dupl
+func (qs BlogQuerySet) CreatedAtInRange(from time.Time, to time.Time) BlogQuerySet {
+	return qs.w(qs.db.Where("created_at >= ? AND created_at < ?", from, to))
+}
+
+// CreatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) CreatedAtLt(createdAt time.Time) BlogQuerySet {
+	return qs.w(qs.db.Where("created_at < ?", createdAt))
+}
+
+// CreatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) CreatedAtLte(createdAt time.Time) BlogQuerySet {
+	return qs.w(qs.db.Where("created_at <= ?", createdAt))
+}
+
+// CreatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) CreatedAtNe(createdAt time.Time) BlogQuerySet {
+	return qs.w(qs.db.Where("created_at != ?", createdAt))
+}
+
+// CreatedAtWithin filters rows with CreatedAt not earlier than d ago
+// nolint: dupl
+func (qs BlogQuerySet) CreatedAtWithin(d time.Duration) BlogQuerySet {
+	return qs.w(qs.db.Where("created_at >= ?", time.Now().Add(-d)))
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) Delete() error {
+	return checkQueryContext(qs.db).Delete(Blog{}).Error
+}
+
+// DeleteNum is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) DeleteNum() (int64, error) {
+	db := checkQueryContext(qs.db).Delete(Blog{})
+	return db.RowsAffected, db.Error
+}
+
+// DeleteNumUnscoped is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) DeleteNumUnscoped() (int64, error) {
+	db := checkQueryContext(qs.db).Unscoped().Delete(Blog{})
+	return db.RowsAffected, db.Error
+}
+
+// DeletedAtBetween filters rows with DeletedAt in range [from, to]
+// nolint: dupl
+func (qs BlogQuerySet) DeletedAtBetween(from time.Time, to time.Time) BlogQuerySet {
+	return qs.w(qs.db.Where("deleted_at BETWEEN ? AND ?", from, to))
+}
+
+// DeletedAtEq is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) DeletedAtEq(deletedAt time.Time) BlogQuerySet {
+	return qs.w(qs.db.Where("deleted_at = ?", deletedAt))
+}
+
+// DeletedAtGt is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) DeletedAtGt(deletedAt time.Time) BlogQuerySet {
+	return qs.w(qs.db.Where("deleted_at > ?", deletedAt))
+}
+
+// DeletedAtGte is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) DeletedAtGte(deletedAt time.Time) BlogQuerySet {
+	return qs.w(qs.db.Where("deleted_at >= ?", deletedAt))
+}
+
+// DeletedAtInRange filters rows with DeletedAt in range [from, to)
+// nolint: dupl
+func (qs BlogQuerySet) DeletedAtInRange(from time.Time, to time.Time) BlogQuerySet {
+	return qs.w(qs.db.Where("deleted_at >= ? AND deleted_at < ?", from, to))
+}
+
+// DeletedAtIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) DeletedAtIsNotNull() BlogQuerySet {
+	return qs.w(qs.db.Where("deleted_at IS NOT NULL"))
+}
+
+// DeletedAtIsNull is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) DeletedAtIsNull() BlogQuerySet {
+	return qs.w(qs.db.Where("deleted_at IS NULL"))
+}
+
+// DeletedAtLt is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) DeletedAtLt(deletedAt time.Time) BlogQuerySet {
+	return qs.w(qs.db.Where("deleted_at < ?", deletedAt))
+}
+
+// DeletedAtLte is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) DeletedAtLte(deletedAt time.Time) BlogQuerySet {
+	return qs.w(qs.db.Where("deleted_at <= ?", deletedAt))
+}
+
+// DeletedAtNe is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) DeletedAtNe(deletedAt time.Time) BlogQuerySet {
+	return qs.w(qs.db.Where("deleted_at != ?", deletedAt))
+}
+
+// DeletedAtWithin filters rows with DeletedAt not earlier than d ago
+// nolint: dupl
+func (qs BlogQuerySet) DeletedAtWithin(d time.Duration) BlogQuerySet {
+	return qs.w(qs.db.Where("deleted_at >= ?", time.Now().Add(-d)))
+}
+
+// GetDB is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) GetDB() *gorm.DB {
+	return qs.db
+}
+
+// GetUpdater is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) GetUpdater() BlogUpdater {
+	return NewBlogUpdater(qs.db)
+}
+
+// GroupByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) GroupByCreatedAt() BlogQuerySet {
+	return qs.w(qs.db.Group("created_at"))
+}
+
+// GroupByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) GroupByDeletedAt() BlogQuerySet {
+	return qs.w(qs.db.Group("deleted_at"))
+}
+
+// GroupByID is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) GroupByID() BlogQuerySet {
+	return qs.w(qs.db.Group("id"))
+}
+
+// GroupByName is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) GroupByName() BlogQuerySet {
+	return qs.w(qs.db.Group("myname"))
+}
+
+// GroupByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) GroupByUpdatedAt() BlogQuerySet {
+	return qs.w(qs.db.Group("updated_at"))
+}
+
+// HavingCountEq is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) HavingCountEq(count int) BlogQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) = ?", count))
+}
+
+// HavingCountGt is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) HavingCountGt(count int) BlogQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) > ?", count))
+}
+
+// HavingCountGte is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) HavingCountGte(count int) BlogQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) >= ?", count))
+}
+
+// HavingCountLt is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) HavingCountLt(count int) BlogQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) < ?", count))
+}
+
+// HavingCountLte is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) HavingCountLte(count int) BlogQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) <= ?", count))
+}
+
+// HavingCountNe is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) HavingCountNe(count int) BlogQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) != ?", count))
+}
+
+// IDBetween filters rows with ID in range [from, to]
+// nolint: dupl
+func (qs BlogQuerySet) IDBetween(from uint, to uint) BlogQuerySet {
+	return qs.w(qs.db.Where("id BETWEEN ? AND ?", from, to))
+}
+
+// IDEq is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) IDEq(ID uint) BlogQuerySet {
+	return qs.w(qs.db.Where("id = ?", ID))
+}
+
+// IDGt is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) IDGt(ID uint) BlogQuerySet {
+	return qs.w(qs.db.Where("id > ?", ID))
+}
+
+// IDGte is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) IDGte(ID uint) BlogQuerySet {
+	return qs.w(qs.db.Where("id >= ?", ID))
+}
+
+// IDIn is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) IDIn(ID ...uint) BlogQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("id IN (?)", ID))
+}
+
+// IDInQuery filters rows with ID IN values selected by subquery q
+// nolint: dupl
+func (qs BlogQuerySet) IDInQuery(q UintSubquery) BlogQuerySet {
+	return qs.w(qs.db.Where("id IN (?)", q.expr()))
+}
+
+// IDInRange filters rows with ID in range [from, to)
+// nolint: dupl
+func (qs BlogQuerySet) IDInRange(from uint, to uint) BlogQuerySet {
+	return qs.w(qs.db.Where("id >= ? AND id < ?", from, to))
+}
+
+// IDLt is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) IDLt(ID uint) BlogQuerySet {
+	return qs.w(qs.db.Where("id < ?", ID))
+}
+
+// IDLte is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) IDLte(ID uint) BlogQuerySet {
+	return qs.w(qs.db.Where("id <= ?", ID))
+}
+
+// IDNe is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) IDNe(ID uint) BlogQuerySet {
+	return qs.w(qs.db.Where("id != ?", ID))
+}
+
+// IDNotIn is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) IDNotIn(ID ...uint) BlogQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("id NOT IN (?)", ID))
+}
+
+// IDNotInQuery filters rows with ID NOT IN values selected by subquery q
+// nolint: dupl
+func (qs BlogQuerySet) IDNotInQuery(q UintSubquery) BlogQuerySet {
+	return qs.w(qs.db.Where("id NOT IN (?)", q.expr()))
+}
+
+// InTx returns copy bound to transaction tx: queries are executed in it.
+// Gorm v1 can't move conditions into another connection, so with gorm v1
+// InTx must be called before any condition is set, otherwise queries fail.
+// Context and preloads are moved into transaction by both gorm versions.
+// nolint: dupl
+func (qs BlogQuerySet) InTx(tx *gorm.DB) BlogQuerySet {
+	if !reflect.DeepEqual(qs.db.QueryExpr(), NewBlogQuerySet(qs.db.New()).db.QueryExpr()) {
+		tx = tx.New()
+		tx.AddError(errors.New("InTx must be called before any condition is set: " +
+			"gorm v1 can't move conditions into transaction"))
+	}
+	tx = txWithSettings(qs.db, tx)
+	return NewBlogQuerySet(tx)
+}
+
+// Iterate scans rows one by one and calls fn for every row without
+// loading all rows into memory. It stops on the first error of fn and
+// returns it. Preloads aren't applied.
+// nolint: dupl
+func (qs BlogQuerySet) Iterate(fn func(*Blog) error) error {
+	db := checkQueryContext(qs.db)
+	if db.Error != nil {
+		return db.Error // gorm v1 doesn't check it in Rows
+	}
+
+	rows, err := db.Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var o Blog
+		if err = db.ScanRows(rows, &o); err != nil {
+			return err
+		}
+		if err = fn(&o); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// Limit is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) Limit(limit int) BlogQuerySet {
+	return qs.w(qs.db.Limit(limit))
+}
+
+// MaxCreatedAt returns MAX of CreatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs BlogQuerySet) MaxCreatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := checkQueryContext(qs.db).Select("created_at AS value").Where("created_at IS NOT NULL").
+		Order("created_at DESC", true).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MaxID returns MAX of ID, it's zero value if there are no rows
+// nolint: dupl
+func (qs BlogQuerySet) MaxID() (uint, error) {
+	var ret uint
+	var res struct {
+		Value *uint
+	}
+	err := checkQueryContext(qs.db).Select("MAX(id) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// MaxUpdatedAt returns MAX of UpdatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs BlogQuerySet) MaxUpdatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := checkQueryContext(qs.db).Select("updated_at AS value").Where("updated_at IS NOT NULL").
+		Order("updated_at DESC", true).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MinCreatedAt returns MIN of CreatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs BlogQuerySet) MinCreatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := checkQueryContext(qs.db).Select("created_at AS value").Where("created_at IS NOT NULL").
+		Order("created_at ASC", true).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MinID returns MIN of ID, it's zero value if there are no rows
+// nolint: dupl
+func (qs BlogQuerySet) MinID() (uint, error) {
+	var ret uint
+	var res struct {
+		Value *uint
+	}
+	err := checkQueryContext(qs.db).Select("MIN(id) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// MinUpdatedAt returns MIN of UpdatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs BlogQuerySet) MinUpdatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := checkQueryContext(qs.db).Select("updated_at AS value").Where("updated_at IS NOT NULL").
+		Order("updated_at ASC", true).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// NameEndsWith filters rows with Name ending with the argument
+// nolint: dupl
+func (qs BlogQuerySet) NameEndsWith(name string) BlogQuerySet {
+	return qs.w(whereLike(qs.db, "myname", "%", name, "", false))
+}
+
+// NameEq is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) NameEq(name string) BlogQuerySet {
+	return qs.w(qs.db.Where("myname = ?", name))
+}
+
+// NameGt is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) NameGt(name string) BlogQuerySet {
+	return qs.w(qs.db.Where("myname > ?", name))
+}
+
+// NameGte is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) NameGte(name string) BlogQuerySet {
+	return qs.w(qs.db.Where("myname >= ?", name))
+}
+
+// NameIContains filters rows with Name containing the argument, case is ignored
+// nolint: dupl
+func (qs BlogQuerySet) NameIContains(name string) BlogQuerySet {
+	return qs.w(whereLike(qs.db, "myname", "%", name, "%", true))
+}
+
+// NameIEq filters rows with Name equal to the argument, case is ignored
+// nolint: dupl
+func (qs BlogQuerySet) NameIEq(name string) BlogQuerySet {
+	return qs.w(whereLike(qs.db, "myname", "", name, "", true))
+}
+
+// NameIn is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) NameIn(name ...string) BlogQuerySet {
+	if len(name) == 0 {
+		qs.db.AddError(errors.New("must at least pass one name in NameIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("myname IN (?)", name))
+}
+
+// NameInQuery filters rows with Name IN values selected by subquery q
+// nolint: dupl
+func (qs BlogQuerySet) NameInQuery(q StringSubquery) BlogQuerySet {
+	return qs.w(qs.db.Where("myname IN (?)", q.expr()))
+}
+
+// NameLike is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) NameLike(name string) BlogQuerySet {
+	return qs.w(qs.db.Where("myname LIKE ?", name))
+}
+
+// NameLt is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) NameLt(name string) BlogQuerySet {
+	return qs.w(qs.db.Where("myname < ?", name))
+}
+
+// NameLte is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) NameLte(name string) BlogQuerySet {
+	return qs.w(qs.db.Where("myname <= ?", name))
+}
+
+// NameNe is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) NameNe(name string) BlogQuerySet {
+	return qs.w(qs.db.Where("myname != ?", name))
+}
+
+// NameNotIn is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) NameNotIn(name ...string) BlogQuerySet {
+	if len(name) == 0 {
+		qs.db.AddError(errors.New("must at least pass one name in NameNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("myname NOT IN (?)", name))
+}
+
+// NameNotInQuery filters rows with Name NOT IN values selected by subquery q
+// nolint: dupl
+func (qs BlogQuerySet) NameNotInQuery(q StringSubquery) BlogQuerySet {
+	return qs.w(qs.db.Where("myname NOT IN (?)", q.expr()))
+}
+
+// NameNotlike is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) NameNotlike(name string) BlogQuerySet {
+	return qs.w(qs.db.Where("myname NOT LIKE ?", name))
+}
+
+// NameStartsWith filters rows with Name starting with the argument
+// nolint: dupl
+func (qs BlogQuerySet) NameStartsWith(name string) BlogQuerySet {
+	return qs.w(whereLike(qs.db, "myname", "", name, "%", false))
+}
+
+// Offset is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) Offset(offset int) BlogQuerySet {
+	return qs.w(qs.db.Offset(offset))
+}
+
+// One is used to retrieve one result. It returns gorm.ErrRecordNotFound
+// if nothing was fetched
+func (qs BlogQuerySet) One(ret *Blog) error {
+	return checkQueryContext(qs.db).First(ret).Error
+}
+
+// OrderAscByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) OrderAscByCreatedAt() BlogQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("created_at ASC"), "created_at", false, false))
+}
+
+// OrderAscByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) OrderAscByDeletedAt() BlogQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("deleted_at ASC"), "deleted_at", false, false))
+}
+
+// OrderAscByID is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) OrderAscByID() BlogQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("id ASC"), "id", false, false))
+}
+
+// OrderAscByName is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) OrderAscByName() BlogQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("myname ASC"), "myname", false, false))
+}
+
+// OrderAscByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) OrderAscByUpdatedAt() BlogQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("updated_at ASC"), "updated_at", false, false))
+}
+
+// OrderDescByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) OrderDescByCreatedAt() BlogQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("created_at DESC"), "created_at", true, false))
+}
+
+// OrderDescByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) OrderDescByDeletedAt() BlogQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("deleted_at DESC"), "deleted_at", true, false))
+}
+
+// OrderDescByID is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) OrderDescByID() BlogQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("id DESC"), "id", true, false))
+}
+
+// OrderDescByName is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) OrderDescByName() BlogQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("myname DESC"), "myname", true, false))
+}
+
+// OrderDescByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) OrderDescByUpdatedAt() BlogQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("updated_at DESC"), "updated_at", true, false))
+}
+
+// Page fetches at most size rows following cursor into ret, it uses
+// orders set by OrderAscBy/OrderDescBy methods and primary key to break ties.
+// Ordered fields can't be nullable: rows with NULL can't be compared with
+// cursor, so error is returned for them. Empty cursor means the first page. It returns cursor of the next page or
+// empty string if there are no more rows.
+// nolint: dupl
+func (qs BlogQuerySet) Page(cursor string, size int, ret *[]Blog) (string, error) {
+	if size <= 0 {
+		return "", errors.New("page size must be positive")
+	}
+
+	var last Blog
+	db, orders, err := pageQuery(qs.db, "", "id", cursor, qs.fieldPtrs(&last))
+	if err != nil {
+		return "", err
+	}
+
+	if err = checkQueryContext(db).Limit(size + 1).Find(ret).Error; err != nil {
+		return "", err
+	}
+	if len(*ret) <= size {
+		return "", nil
+	}
+
+	*ret = (*ret)[:size]
+	return encodePageCursor(orders, qs.fieldPtrs(&(*ret)[size-1]))
+}
+
+// PreloadEditors is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) PreloadEditors() BlogQuerySet {
+	return qs.w(preload(qs.db, "Editors"))
+}
+
+// PreloadEditorsWhere preloads Editors filtered, ordered or limited by f:
+// limit is applied to all preloaded objects, not to objects of every row
+// nolint: dupl
+func (qs BlogQuerySet) PreloadEditorsWhere(f func(UserQuerySet) UserQuerySet) BlogQuerySet {
+	return qs.w(preload(qs.db, "Editors", func(db *gorm.DB) *gorm.DB {
+		return f(NewUserQuerySet(db)).db
+	}))
+}
+
+// SelectID returns subquery selecting ID of rows of queryset
+// for InQuery and NotInQuery filters
+// nolint: dupl
+func (qs BlogQuerySet) SelectID() UintSubquery {
+	return UintSubquery{db: qs.db.Select("id")}
+}
+
+// SelectName returns subquery selecting Name of rows of queryset
+// for InQuery and NotInQuery filters
+// nolint: dupl
+func (qs BlogQuerySet) SelectName() StringSubquery {
+	return StringSubquery{db: qs.db.Select("myname")}
+}
+
+// UpdatedAtBetween filters rows with UpdatedAt in range [from, to]
+// nolint: dupl
+func (qs BlogQuerySet) UpdatedAtBetween(from time.Time, to time.Time) BlogQuerySet {
+	return qs.w(qs.db.Where("updated_at BETWEEN ? AND ?", from, to))
+}
+
+// UpdatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) UpdatedAtEq(updatedAt time.Time) BlogQuerySet {
+	return qs.w(qs.db.Where("updated_at = ?", updatedAt))
+}
+
+// UpdatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) UpdatedAtGt(updatedAt time.Time) BlogQuerySet {
+	return qs.w(qs.db.Where("updated_at > ?", updatedAt))
+}
+
+// UpdatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) UpdatedAtGte(updatedAt time.Time) BlogQuerySet {
+	return qs.w(qs.db.Where("updated_at >= ?", updatedAt))
+}
+
+// UpdatedAtInRange filters rows with UpdatedAt in range [from, to)
+// nolint: dupl
+func (qs BlogQuerySet) UpdatedAtInRange(from time.Time, to time.Time) BlogQuerySet {
+	return qs.w(qs.db.Where("updated_at >= ? AND updated_at < ?", from, to))
+}
+
+// UpdatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) UpdatedAtLt(updatedAt time.Time) BlogQuerySet {
+	return qs.w(qs.db.Where("updated_at < ?", updatedAt))
+}
+
+// UpdatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) UpdatedAtLte(updatedAt time.Time) BlogQuerySet {
+	return qs.w(qs.db.Where("updated_at <= ?", updatedAt))
+}
+
+// UpdatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) UpdatedAtNe(updatedAt time.Time) BlogQuerySet {
+	return qs.w(qs.db.Where("updated_at != ?", updatedAt))
+}
+
+// UpdatedAtWithin filters rows with UpdatedAt not earlier than d ago
+// nolint: dupl
+func (qs BlogQuerySet) UpdatedAtWithin(d time.Duration) BlogQuerySet {
+	return qs.w(qs.db.Where("updated_at >= ?", time.Now().Add(-d)))
+}
+
+// WithContext returns copy executing queries with context ctx.
+// Gorm v1 doesn't support context: its error is checked only before query.
+// nolint: dupl
+func (qs BlogQuerySet) WithContext(ctx context.Context) BlogQuerySet {
+	return qs.w(DBWithContext(ctx, qs.db))
+}
+
+// fieldPtrs returns pointers to fields of o by their columns
+// nolint: dupl
+func (qs BlogQuerySet) fieldPtrs(o *Blog) map[string]interface{} {
+	return map[string]interface{}{
+		"id":         &o.ID,
+		"created_at": &o.CreatedAt,
+		"updated_at": &o.UpdatedAt,
+		"deleted_at": &o.DeletedAt,
+		"myname":     &o.Name,
+	}
+}
+
+// InTx returns copy bound to transaction tx: queries are executed in it.
+// Gorm v1 can't move conditions into another connection, so with gorm v1
+// InTx must be called before any condition is set, otherwise queries fail.
+// Context and preloads are moved into transaction by both gorm versions.
+// nolint: dupl
+func (u BlogUpdater) InTx(tx *gorm.DB) BlogUpdater {
+	if !reflect.DeepEqual(u.db.QueryExpr(), u.db.New().Model(&Blog{}).QueryExpr()) {
+		tx = tx.New()
+		tx.AddError(errors.New("InTx must be called before any condition is set: " +
+			"gorm v1 can't move conditions into transaction"))
+	}
+	tx = txWithSettings(u.db, tx)
+	u.db = tx.Model(&Blog{})
+	return u
+}
+
+// SetCreatedAt is an autogenerated method
+// nolint: dupl
+func (u BlogUpdater) SetCreatedAt(createdAt time.Time) BlogUpdater {
+	u.fields[string(BlogDBSchema.CreatedAt)] = createdAt
+	return u
+}
+
+// SetDeletedAt is an autogenerated method
+// nolint: dupl
+func (u BlogUpdater) SetDeletedAt(deletedAt *time.Time) BlogUpdater {
+	u.fields[string(BlogDBSchema.DeletedAt)] = deletedAt
+	return u
+}
+
+// SetID is an autogenerated method
+// nolint: dupl
+func (u BlogUpdater) SetID(ID uint) BlogUpdater {
+	u.fields[string(BlogDBSchema.ID)] = ID
+	return u
+}
+
+// SetName is an autogenerated method
+// nolint: dupl
+func (u BlogUpdater) SetName(name string) BlogUpdater {
+	u.fields[string(BlogDBSchema.Name)] = name
+	return u
+}
+
+// SetUpdatedAt is an autogenerated method
+// nolint: dupl
+func (u BlogUpdater) SetUpdatedAt(updatedAt time.Time) BlogUpdater {
+	u.fields[string(BlogDBSchema.UpdatedAt)] = updatedAt
+	return u
+}
+
+// Update is an autogenerated method
+// nolint: dupl
+func (u BlogUpdater) Update() error {
+	return checkQueryContext(u.db).Updates(u.fields).Error
+}
+
+// UpdateNum is an autogenerated method
+// nolint: dupl
+func (u BlogUpdater) UpdateNum() (int64, error) {
+	db := checkQueryContext(u.db).Updates(u.fields)
+	return db.RowsAffected, db.Error
+}
+
+// WithContext returns copy executing queries with context ctx.
+// Gorm v1 doesn't support context: its error is checked only before query.
+// nolint: dupl
+func (u BlogUpdater) WithContext(ctx context.Context) BlogUpdater {
+	u.db = DBWithContext(ctx, u.db)
+	return u
+}
+
+// ===== END of query set BlogQuerySet
+
+// ===== BEGIN of Blog modifiers
+
+// BlogDBSchemaField describes database schema field. It requires for method 'Update'
+type BlogDBSchemaField string
+
+// String method returns string representation of field.
+// nolint: dupl
+func (f BlogDBSchemaField) String() string {
+	return string(f)
+}
+
+// BlogDBSchema stores db field names of Blog
+var BlogDBSchema = struct {
+	ID        BlogDBSchemaField
+	CreatedAt BlogDBSchemaField
+	UpdatedAt BlogDBSchemaField
+	DeletedAt BlogDBSchemaField
+	Name      BlogDBSchemaField
+}{
+
+	ID:        BlogDBSchemaField("id"),
+	CreatedAt: BlogDBSchemaField("created_at"),
+	UpdatedAt: BlogDBSchemaField("updated_at"),
+	DeletedAt: BlogDBSchemaField("deleted_at"),
+	Name:      BlogDBSchemaField("myname"),
+}
+
+// Update updates Blog fields by primary key
+// nolint: dupl
+func (o *Blog) Update(db *gorm.DB, fields ...BlogDBSchemaField) error {
+	dbNameToFieldName := map[string]interface{}{
+		"id":         o.ID,
+		"created_at": o.CreatedAt,
+		"updated_at": o.UpdatedAt,
+		"deleted_at": o.DeletedAt,
+		"myname":     o.Name,
+	}
+	u := map[string]interface{}{}
+	for _, f := range fields {
+		fs := f.String()
+		u[fs] = dbNameToFieldName[fs]
+	}
+	if err := checkQueryContext(db).Model(o).Updates(u).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return err
+		}
+
+		return fmt.Errorf("can't update Blog %v fields %v: %s",
+			o, fields, err)
+	}
+
+	return nil
+}
+
+// Upsert inserts Blog or updates updateFields of existing row
+// if insert violates unique constraint on conflictFields. Row isn't
+// changed if there are no updateFields: any conflict is ignored then if
+// there are no conflictFields. MySQL ignores conflictFields: it checks
+// all unique constraints, other databases require them to update row.
+// nolint: dupl
+func (o *Blog) Upsert(db *gorm.DB, conflictFields []BlogDBSchemaField,
+	updateFields ...BlogDBSchemaField) error {
+
+	conflictColumns := make([]string, 0, len(conflictFields))
+	for _, f := range conflictFields {
+		conflictColumns = append(conflictColumns, f.String())
+	}
+	updateColumns := make([]string, 0, len(updateFields))
+	for _, f := range updateFields {
+		updateColumns = append(updateColumns, f.String())
+	}
+
+	qs := NewBlogQuerySet(db)
+	return upsert(checkQueryContext(qs.db), "id", qs.fieldPtrs(o),
+		conflictColumns, updateColumns)
+}
+
+// BlogUpdater is an Blog updates manager
+type BlogUpdater struct {
+	fields map[string]interface{}
+	db     *gorm.DB
+}
+
+// NewBlogUpdater creates new Blog updater
+// nolint: dupl
+func NewBlogUpdater(db *gorm.DB) BlogUpdater {
+	return BlogUpdater{
+		fields: map[string]interface{}{},
+		db:     db.Model(&Blog{}),
+	}
+}
+
+// ===== END of Blog modifiers
+
+// ===== BEGIN of Blog predicates
+
+// BlogPredicate is a condition on Blog fields for Where and Not
+// methods of BlogQuerySet: predicates are created by BlogQ and
+// combined by And, Or and Not. Zero predicate matches all rows.
+type BlogPredicate struct {
+	expr string
+	args []interface{}
+	err  error
+}
+
+// join combines predicates by operation op, empty predicates are skipped
+// nolint: dupl
+func (p BlogPredicate) join(op string, preds []BlogPredicate) BlogPredicate {
+	for _, q := range preds {
+		if p.err == nil {
+			p.err = q.err
+		}
+		if q.expr == "" {
+			continue
+		}
+		if p.expr == "" {
+			p.expr, p.args = q.expr, q.args
+			continue
+		}
+
+		p.expr = "(" + p.expr + ") " + op + " (" + q.expr + ")"
+		p.args = append(append([]interface{}{}, p.args...), q.args...)
+	}
+
+	return p
+}
+
+// And returns predicate matching rows matched by p and all preds
+// nolint: dupl
+func (p BlogPredicate) And(preds ...BlogPredicate) BlogPredicate {
+	return p.join("AND", preds)
+}
+
+// Or returns predicate matching rows matched by p or any of preds
+// nolint: dupl
+func (p BlogPredicate) Or(preds ...BlogPredicate) BlogPredicate {
+	return p.join("OR", preds)
+}
+
+// Not returns predicate matching rows not matched by p
+// nolint: dupl
+func (p BlogPredicate) Not() BlogPredicate {
+	if p.expr != "" {
+		p.expr = "NOT (" + p.expr + ")"
+	}
+
+	return p
+}
+
+// BlogPredicates creates predicates by Blog fields
+type BlogPredicates struct{}
+
+// BlogQ creates predicates by Blog fields
+var BlogQ BlogPredicates
+
+// CreatedAtEq creates predicate "created_at = ?"
+// nolint: dupl
+func (BlogPredicates) CreatedAtEq(createdAt time.Time) BlogPredicate {
+	return BlogPredicate{expr: "created_at = ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtGt creates predicate "created_at > ?"
+// nolint: dupl
+func (BlogPredicates) CreatedAtGt(createdAt time.Time) BlogPredicate {
+	return BlogPredicate{expr: "created_at > ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtGte creates predicate "created_at >= ?"
+// nolint: dupl
+func (BlogPredicates) CreatedAtGte(createdAt time.Time) BlogPredicate {
+	return BlogPredicate{expr: "created_at >= ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtLt creates predicate "created_at < ?"
+// nolint: dupl
+func (BlogPredicates) CreatedAtLt(createdAt time.Time) BlogPredicate {
+	return BlogPredicate{expr: "created_at < ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtLte creates predicate "created_at <= ?"
+// nolint: dupl
+func (BlogPredicates) CreatedAtLte(createdAt time.Time) BlogPredicate {
+	return BlogPredicate{expr: "created_at <= ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtNe creates predicate "created_at != ?"
+// nolint: dupl
+func (BlogPredicates) CreatedAtNe(createdAt time.Time) BlogPredicate {
+	return BlogPredicate{expr: "created_at != ?", args: []interface{}{createdAt}}
+}
+
+// DeletedAtEq creates predicate "deleted_at = ?"
+// nolint: dupl
+func (BlogPredicates) DeletedAtEq(deletedAt time.Time) BlogPredicate {
+	return BlogPredicate{expr: "deleted_at = ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtGt creates predicate "deleted_at > ?"
+// nolint: dupl
+func (BlogPredicates) DeletedAtGt(deletedAt time.Time) BlogPredicate {
+	return BlogPredicate{expr: "deleted_at > ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtGte creates predicate "deleted_at >= ?"
+// nolint: dupl
+func (BlogPredicates) DeletedAtGte(deletedAt time.Time) BlogPredicate {
+	return BlogPredicate{expr: "deleted_at >= ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtIsNotNull creates predicate "deleted_at IS NOT NULL"
+// nolint: dupl
+func (BlogPredicates) DeletedAtIsNotNull() BlogPredicate {
+	return BlogPredicate{expr: "deleted_at IS NOT NULL"}
+}
+
+// DeletedAtIsNull creates predicate "deleted_at IS NULL"
+// nolint: dupl
+func (BlogPredicates) DeletedAtIsNull() BlogPredicate {
+	return BlogPredicate{expr: "deleted_at IS NULL"}
+}
+
+// DeletedAtLt creates predicate "deleted_at < ?"
+// nolint: dupl
+func (BlogPredicates) DeletedAtLt(deletedAt time.Time) BlogPredicate {
+	return BlogPredicate{expr: "deleted_at < ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtLte creates predicate "deleted_at <= ?"
+// nolint: dupl
+func (BlogPredicates) DeletedAtLte(deletedAt time.Time) BlogPredicate {
+	return BlogPredicate{expr: "deleted_at <= ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtNe creates predicate "deleted_at != ?"
+// nolint: dupl
+func (BlogPredicates) DeletedAtNe(deletedAt time.Time) BlogPredicate {
+	return BlogPredicate{expr: "deleted_at != ?", args: []interface{}{deletedAt}}
+}
+
+// IDEq creates predicate "id = ?"
+// nolint: dupl
+func (BlogPredicates) IDEq(ID uint) BlogPredicate {
+	return BlogPredicate{expr: "id = ?", args: []interface{}{ID}}
+}
+
+// IDGt creates predicate "id > ?"
+// nolint: dupl
+func (BlogPredicates) IDGt(ID uint) BlogPredicate {
+	return BlogPredicate{expr: "id > ?", args: []interface{}{ID}}
+}
+
+// IDGte creates predicate "id >= ?"
+// nolint: dupl
+func (BlogPredicates) IDGte(ID uint) BlogPredicate {
+	return BlogPredicate{expr: "id >= ?", args: []interface{}{ID}}
+}
+
+// IDIn creates predicate "id IN (?)"
+// nolint: dupl
+func (BlogPredicates) IDIn(ID ...uint) BlogPredicate {
+	if len(ID) == 0 {
+		return BlogPredicate{err: errors.New("must at least pass one ID in IDIn")}
+	}
+	return BlogPredicate{expr: "id IN (?)", args: []interface{}{ID}}
+}
+
+// IDLt creates predicate "id < ?"
+// nolint: dupl
+func (BlogPredicates) IDLt(ID uint) BlogPredicate {
+	return BlogPredicate{expr: "id < ?", args: []interface{}{ID}}
+}
+
+// IDLte creates predicate "id <= ?"
+// nolint: dupl
+func (BlogPredicates) IDLte(ID uint) BlogPredicate {
+	return BlogPredicate{expr: "id <= ?", args: []interface{}{ID}}
+}
+
+// IDNe creates predicate "id != ?"
+// nolint: dupl
+func (BlogPredicates) IDNe(ID uint) BlogPredicate {
+	return BlogPredicate{expr: "id != ?", args: []interface{}{ID}}
+}
+
+// IDNotIn creates predicate "id NOT IN (?)"
+// nolint: dupl
+func (BlogPredicates) IDNotIn(ID ...uint) BlogPredicate {
+	if len(ID) == 0 {
+		return BlogPredicate{err: errors.New("must at least pass one ID in IDNotIn")}
+	}
+	return BlogPredicate{expr: "id NOT IN (?)", args: []interface{}{ID}}
+}
+
+// NameEq creates predicate "myname = ?"
+// nolint: dupl
+func (BlogPredicates) NameEq(name string) BlogPredicate {
+	return BlogPredicate{expr: "myname = ?", args: []interface{}{name}}
+}
+
+// NameGt creates predicate "myname > ?"
+// nolint: dupl
+func (BlogPredicates) NameGt(name string) BlogPredicate {
+	return BlogPredicate{expr: "myname > ?", args: []interface{}{name}}
+}
+
+// NameGte creates predicate "myname >= ?"
+// nolint: dupl
+func (BlogPredicates) NameGte(name string) BlogPredicate {
+	return BlogPredicate{expr: "myname >= ?", args: []interface{}{name}}
+}
+
+// NameIn creates predicate "myname IN (?)"
+// nolint: dupl
+func (BlogPredicates) NameIn(name ...string) BlogPredicate {
+	if len(name) == 0 {
+		return BlogPredicate{err: errors.New("must at least pass one name in NameIn")}
+	}
+	return BlogPredicate{expr: "myname IN (?)", args: []interface{}{name}}
+}
+
+// NameLike creates predicate "myname LIKE ?"
+// nolint: dupl
+func (BlogPredicates) NameLike(name string) BlogPredicate {
+	return BlogPredicate{expr: "myname LIKE ?", args: []interface{}{name}}
+}
+
+// NameLt creates predicate "myname < ?"
+// nolint: dupl
+func (BlogPredicates) NameLt(name string) BlogPredicate {
+	return BlogPredicate{expr: "myname < ?", args: []interface{}{name}}
+}
+
+// NameLte creates predicate "myname <= ?"
+// nolint: dupl
+func (BlogPredicates) NameLte(name string) BlogPredicate {
+	return BlogPredicate{expr: "myname <= ?", args: []interface{}{name}}
+}
+
+// NameNe creates predicate "myname != ?"
+// nolint: dupl
+func (BlogPredicates) NameNe(name string) BlogPredicate {
+	return BlogPredicate{expr: "myname != ?", args: []interface{}{name}}
+}
+
+// NameNotIn creates predicate "myname NOT IN (?)"
+// nolint: dupl
+func (BlogPredicates) NameNotIn(name ...string) BlogPredicate {
+	if len(name) == 0 {
+		return BlogPredicate{err: errors.New("must at least pass one name in NameNotIn")}
+	}
+	return BlogPredicate{expr: "myname NOT IN (?)", args: []interface{}{name}}
+}
+
+// NameNotlike creates predicate "myname NOT LIKE ?"
+// nolint: dupl
+func (BlogPredicates) NameNotlike(name string) BlogPredicate {
+	return BlogPredicate{expr: "myname NOT LIKE ?", args: []interface{}{name}}
+}
+
+// UpdatedAtEq creates predicate "updated_at = ?"
+// nolint: dupl
+func (BlogPredicates) UpdatedAtEq(updatedAt time.Time) BlogPredicate {
+	return BlogPredicate{expr: "updated_at = ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtGt creates predicate "updated_at > ?"
+// nolint: dupl
+func (BlogPredicates) UpdatedAtGt(updatedAt time.Time) BlogPredicate {
+	return BlogPredicate{expr: "updated_at > ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtGte creates predicate "updated_at >= ?"
+// nolint: dupl
+func (BlogPredicates) UpdatedAtGte(updatedAt time.Time) BlogPredicate {
+	return BlogPredicate{expr: "updated_at >= ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtLt creates predicate "updated_at < ?"
+// nolint: dupl
+func (BlogPredicates) UpdatedAtLt(updatedAt time.Time) BlogPredicate {
+	return BlogPredicate{expr: "updated_at < ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtLte creates predicate "updated_at <= ?"
+// nolint: dupl
+func (BlogPredicates) UpdatedAtLte(updatedAt time.Time) BlogPredicate {
+	return BlogPredicate{expr: "updated_at <= ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtNe creates predicate "updated_at != ?"
+// nolint: dupl
+func (BlogPredicates) UpdatedAtNe(updatedAt time.Time) BlogPredicate {
+	return BlogPredicate{expr: "updated_at != ?", args: []interface{}{updatedAt}}
+}
+
+// ===== END of Blog predicates
+
+// ===== BEGIN of query set CheckReservedKeywordsQuerySet
+
+// CheckReservedKeywordsQuerySet is an queryset type for CheckReservedKeywords
+type CheckReservedKeywordsQuerySet struct {
+	db *gorm.DB
+}
+
+// NewCheckReservedKeywordsQuerySet constructs new CheckReservedKeywordsQuerySet
+func NewCheckReservedKeywordsQuerySet(db *gorm.DB) CheckReservedKeywordsQuerySet {
+	return CheckReservedKeywordsQuerySet{
+		db: db.Model(&CheckReservedKeywords{}),
+	}
+}
+
+func (qs CheckReservedKeywordsQuerySet) w(db *gorm.DB) CheckReservedKeywordsQuerySet {
+	return NewCheckReservedKeywordsQuerySet(db)
+}
+
+func (qs CheckReservedKeywordsQuerySet) Select(fields ...CheckReservedKeywordsDBSchemaField) CheckReservedKeywordsQuerySet {
+	names := []string{}
+	for _, f := range fields {
+		names = append(names, f.String())
+	}
+
+	return qs.w(qs.db.Select(strings.Join(names, ",")))
+}
+
+// GroupBy groups rows by fields
+func (qs CheckReservedKeywordsQuerySet) GroupBy(fields ...CheckReservedKeywordsDBSchemaField) CheckReservedKeywordsQuerySet {
+	names := []string{}
+	for _, f := range fields {
+		names = append(names, f.String())
+	}
+
+	return qs.w(qs.db.Group(strings.Join(names, ",")))
+}
+
+// Where filters rows by predicate p, predicates are created by CheckReservedKeywordsQ
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) Where(p CheckReservedKeywordsPredicate) CheckReservedKeywordsQuerySet {
+	if p.err != nil {
+		// error is added to copy of db: qs can be reused as a base queryset
+		db := qs.db.Model(&CheckReservedKeywords{})
+		db.AddError(p.err)
+		return qs.w(db)
+	}
+	if p.expr == "" {
+		return qs
+	}
+
+	return qs.w(qs.db.Where(p.expr, p.args...))
+}
+
+// Not filters rows not matching predicate p
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) Not(p CheckReservedKeywordsPredicate) CheckReservedKeywordsQuerySet {
+	return qs.Where(p.Not())
+}
+
+// Create is an autogenerated method
+// nolint: dupl
+func (o *CheckReservedKeywords) Create(db *gorm.DB) error {
+	return checkQueryContext(db).Create(o).Error
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (o *CheckReservedKeywords) Delete(db *gorm.DB) error {
+	return checkQueryContext(db).Delete(o).Error
+}
+
+// All is an autogenerated method
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) All(ret *[]CheckReservedKeywords) error {
+	return checkQueryContext(qs.db).Find(ret).Error
+}
+
+// AvgStruct returns AVG of Struct, it's zero value if there are no rows
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) AvgStruct() (float64, error) {
+	var ret float64
+	var res struct {
+		Value *float64
+	}
+	err := checkQueryContext(qs.db).Select("AVG(struct) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// Count is an autogenerated method
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) Count() (int, error) {
+	var count int
+	db := checkQueryContext(qs.db)
+	if db.Error != nil {
+		return count, db.Error
+	}
+
+	err := db.Count(&count).Error
+	return count, err
+}
+
+// CountByStruct returns count of rows for every value of Struct
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) CountByStruct() (map[int]int, error) {
+	var rows []struct {
+		Value int
+		Count int
+	}
+	err := checkQueryContext(qs.db).Select("struct AS value, COUNT(*) AS count").Group("struct").Scan(&rows).Error
+	ret := make(map[int]int, len(rows))
+	for _, r := range rows {
+		ret[r.Value] = r.Count
+	}
+	return ret, err
+}
+
+// CountByType returns count of rows for every value of Type
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) CountByType() (map[string]int, error) {
+	var rows []struct {
+		Value string
+		Count int
+	}
+	err := checkQueryContext(qs.db).Select("type AS value, COUNT(*) AS count").Group("type").Scan(&rows).Error
+	ret := make(map[string]int, len(rows))
+	for _, r := range rows {
+		ret[r.Value] = r.Count
+	}
+	return ret, err
+}
+
+// CreateMany inserts models by multi-row inserts, conditions of queryset
+// aren't used. Generated primary keys are written back to models if
+// database supports it (all except MS SQL for gorm v1).
+// Unlike gorm Create it doesn't call hooks and doesn't read back default
+// values: zero fields having default values are skipped in insert.
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) CreateMany(models []CheckReservedKeywords) error {
+	rows := make([]map[string]interface{}, 0, len(models))
+	for i := range models {
+		rows = append(rows, qs.fieldPtrs(&models[i]))
+	}
+
+	return bulkInsert(checkQueryContext(qs.db), "", rows)
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) Delete() error {
+	return checkQueryContext(qs.db).Delete(CheckReservedKeywords{}).Error
+}
+
+// DeleteNum is an autogenerated method
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) DeleteNum() (int64, error) {
+	db := checkQueryContext(qs.db).Delete(CheckReservedKeywords{})
+	return db.RowsAffected, db.Error
+}
+
+// DeleteNumUnscoped is an autogenerated method
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) DeleteNumUnscoped() (int64, error) {
+	db := checkQueryContext(qs.db).Unscoped().Delete(CheckReservedKeywords{})
+	return db.RowsAffected, db.Error
+}
+
+// GetDB is an autogenerated method
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) GetDB() *gorm.DB {
+	return qs.db
+}
+
+// GetUpdater is an autogenerated method
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) GetUpdater() CheckReservedKeywordsUpdater {
+	return NewCheckReservedKeywordsUpdater(qs.db)
+}
+
+// GroupByStruct is an autogenerated method
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) GroupByStruct() CheckReservedKeywordsQuerySet {
+	return qs.w(qs.db.Group("struct"))
+}
+
+// GroupByType is an autogenerated method
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) GroupByType() CheckReservedKeywordsQuerySet {
+	return qs.w(qs.db.Group("type"))
+}
+
+// HavingCountEq is an autogenerated method
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) HavingCountEq(count int) CheckReservedKeywordsQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) = ?", count))
+}
+
+// HavingCountGt is an autogenerated method
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) HavingCountGt(count int) CheckReservedKeywordsQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) > ?", count))
+}
+
+// HavingCountGte is an autogenerated method
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) HavingCountGte(count int) CheckReservedKeywordsQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) >= ?", count))
+}
+
+// HavingCountLt is an autogenerated method
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) HavingCountLt(count int) CheckReservedKeywordsQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) < ?", count))
+}
+
+// HavingCountLte is an autogenerated method
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) HavingCountLte(count int) CheckReservedKeywordsQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) <= ?", count))
+}
+
+// HavingCountNe is an autogenerated method
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) HavingCountNe(count int) CheckReservedKeywordsQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) != ?", count))
+}
+
+// InTx returns copy bound to transaction tx: queries are executed in it.
+// Gorm v1 can't move conditions into another connection, so with gorm v1
+// InTx must be called before any condition is set, otherwise queries fail.
+// Context and preloads are moved into transaction by both gorm versions.
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) InTx(tx *gorm.DB) CheckReservedKeywordsQuerySet {
+	if !reflect.DeepEqual(qs.db.QueryExpr(), NewCheckReservedKeywordsQuerySet(qs.db.New()).db.QueryExpr()) {
+		tx = tx.New()
+		tx.AddError(errors.New("InTx must be called before any condition is set: " +
+			"gorm v1 can't move conditions into transaction"))
+	}
+	tx = txWithSettings(qs.db, tx)
+	return NewCheckReservedKeywordsQuerySet(tx)
+}
+
+// Iterate scans rows one by one and calls fn for every row without
+// loading all rows into memory. It stops on the first error of fn and
+// returns it. Preloads aren't applied.
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) Iterate(fn func(*CheckReservedKeywords) error) error {
+	db := checkQueryContext(qs.db)
+	if db.Error != nil {
+		return db.Error // gorm v1 doesn't check it in Rows
+	}
+
+	rows, err := db.Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var o CheckReservedKeywords
+		if err = db.ScanRows(rows, &o); err != nil {
+			return err
+		}
+		if err = fn(&o); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// Limit is an autogenerated method
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) Limit(limit int) CheckReservedKeywordsQuerySet {
+	return qs.w(qs.db.Limit(limit))
+}
+
+// MaxStruct returns MAX of Struct, it's zero value if there are no rows
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) MaxStruct() (int, error) {
+	var ret int
+	var res struct {
+		Value *int
+	}
+	err := checkQueryContext(qs.db).Select("MAX(struct) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// MinStruct returns MIN of Struct, it's zero value if there are no rows
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) MinStruct() (int, error) {
+	var ret int
+	var res struct {
+		Value *int
+	}
+	err := checkQueryContext(qs.db).Select("MIN(struct) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// Offset is an autogenerated method
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) Offset(offset int) CheckReservedKeywordsQuerySet {
+	return qs.w(qs.db.Offset(offset))
+}
+
+// One is used to retrieve one result. It returns gorm.ErrRecordNotFound
+// if nothing was fetched
+func (qs CheckReservedKeywordsQuerySet) One(ret *CheckReservedKeywords) error {
+	return checkQueryContext(qs.db).First(ret).Error
+}
+
+// OrderAscByStruct is an autogenerated method
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) OrderAscByStruct() CheckReservedKeywordsQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("struct ASC"), "struct", false, false))
+}
+
+// OrderAscByType is an autogenerated method
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) OrderAscByType() CheckReservedKeywordsQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("type ASC"), "type", false, false))
+}
+
+// OrderDescByStruct is an autogenerated method
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) OrderDescByStruct() CheckReservedKeywordsQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("struct DESC"), "struct", true, false))
+}
+
+// OrderDescByType is an autogenerated method
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) OrderDescByType() CheckReservedKeywordsQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("type DESC"), "type", true, false))
+}
+
+// SelectStruct returns subquery selecting Struct of rows of queryset
+// for InQuery and NotInQuery filters
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) SelectStruct() IntSubquery {
+	return IntSubquery{db: qs.db.Select("struct")}
+}
+
+// SelectType returns subquery selecting Type of rows of queryset
+// for InQuery and NotInQuery filters
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) SelectType() StringSubquery {
+	return StringSubquery{db: qs.db.Select("type")}
+}
+
+// StructBetween filters rows with Struct in range [from, to]
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) StructBetween(from int, to int) CheckReservedKeywordsQuerySet {
+	return qs.w(qs.db.Where("struct BETWEEN ? AND ?", from, to))
+}
+
+// StructEq is an autogenerated method
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) StructEq(structValue int) CheckReservedKeywordsQuerySet {
+	return qs.w(qs.db.Where("struct = ?", structValue))
+}
+
+// StructGt is an autogenerated method
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) StructGt(structValue int) CheckReservedKeywordsQuerySet {
+	return qs.w(qs.db.Where("struct > ?", structValue))
+}
+
+// StructGte is an autogenerated method
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) StructGte(structValue int) CheckReservedKeywordsQuerySet {
+	return qs.w(qs.db.Where("struct >= ?", structValue))
+}
+
+// StructIn is an autogenerated method
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) StructIn(structValue ...int) CheckReservedKeywordsQuerySet {
+	if len(structValue) == 0 {
+		qs.db.AddError(errors.New("must at least pass one structValue in StructIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("struct IN (?)", structValue))
+}
+
+// StructInQuery filters rows with Struct IN values selected by subquery q
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) StructInQuery(q IntSubquery) CheckReservedKeywordsQuerySet {
+	return qs.w(qs.db.Where("struct IN (?)", q.expr()))
+}
+
+// StructInRange filters rows with Struct in range [from, to)
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) StructInRange(from int, to int) CheckReservedKeywordsQuerySet {
+	return qs.w(qs.db.Where("struct >= ? AND struct < ?", from, to))
+}
+
+// StructLt is an autogenerated method
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) StructLt(structValue int) CheckReservedKeywordsQuerySet {
+	return qs.w(qs.db.Where("struct < ?", structValue))
+}
+
+// StructLte is an autogenerated method
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) StructLte(structValue int) CheckReservedKeywordsQuerySet {
+	return qs.w(qs.db.Where("struct <= ?", structValue))
+}
+
+// StructNe is an autogenerated method
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) StructNe(structValue int) CheckReservedKeywordsQuerySet {
+	return qs.w(qs.db.Where("struct != ?", structValue))
+}
+
+// StructNotIn is an autogenerated method
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) StructNotIn(structValue ...int) CheckReservedKeywordsQuerySet {
+	if len(structValue) == 0 {
+		qs.db.AddError(errors.New("must at least pass one structValue in StructNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("struct NOT IN (?)", structValue))
+}
+
+// StructNotInQuery filters rows with Struct NOT IN values selected by subquery q
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) StructNotInQuery(q IntSubquery) CheckReservedKeywordsQuerySet {
+	return qs.w(qs.db.Where("struct NOT IN (?)", q.expr()))
+}
+
+// SumStruct returns SUM of Struct, it's zero value if there are no rows
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) SumStruct() (int, error) {
+	var ret int
+	var res struct {
+		Value *int
+	}
+	err := checkQueryContext(qs.db).Select("SUM(struct) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// TypeEndsWith filters rows with Type ending with the argument
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) TypeEndsWith(typeValue string) CheckReservedKeywordsQuerySet {
+	return qs.w(whereLike(qs.db, "type", "%", typeValue, "", false))
+}
+
+// TypeEq is an autogenerated method
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) TypeEq(typeValue string) CheckReservedKeywordsQuerySet {
+	return qs.w(qs.db.Where("type = ?", typeValue))
+}
+
+// TypeGt is an autogenerated method
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) TypeGt(typeValue string) CheckReservedKeywordsQuerySet {
+	return qs.w(qs.db.Where("type > ?", typeValue))
+}
+
+// TypeGte is an autogenerated method
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) TypeGte(typeValue string) CheckReservedKeywordsQuerySet {
+	return qs.w(qs.db.Where("type >= ?", typeValue))
+}
+
+// TypeIContains filters rows with Type containing the argument, case is ignored
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) TypeIContains(typeValue string) CheckReservedKeywordsQuerySet {
+	return qs.w(whereLike(qs.db, "type", "%", typeValue, "%", true))
+}
+
+// TypeIEq filters rows with Type equal to the argument, case is ignored
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) TypeIEq(typeValue string) CheckReservedKeywordsQuerySet {
+	return qs.w(whereLike(qs.db, "type", "", typeValue, "", true))
+}
+
+// TypeIn is an autogenerated method
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) TypeIn(typeValue ...string) CheckReservedKeywordsQuerySet {
+	if len(typeValue) == 0 {
+		qs.db.AddError(errors.New("must at least pass one typeValue in TypeIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("type IN (?)", typeValue))
+}
+
+// TypeInQuery filters rows with Type IN values selected by subquery q
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) TypeInQuery(q StringSubquery) CheckReservedKeywordsQuerySet {
+	return qs.w(qs.db.Where("type IN (?)", q.expr()))
+}
+
+// TypeLike is an autogenerated method
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) TypeLike(typeValue string) CheckReservedKeywordsQuerySet {
+	return qs.w(qs.db.Where("type LIKE ?", typeValue))
+}
+
+// TypeLt is an autogenerated method
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) TypeLt(typeValue string) CheckReservedKeywordsQuerySet {
+	return qs.w(qs.db.Where("type < ?", typeValue))
+}
+
+// TypeLte is an autogenerated method
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) TypeLte(typeValue string) CheckReservedKeywordsQuerySet {
+	return qs.w(qs.db.Where("type <= ?", typeValue))
+}
+
+// TypeNe is an autogenerated method
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) TypeNe(typeValue string) CheckReservedKeywordsQuerySet {
+	return qs.w(qs.db.Where("type != ?", typeValue))
+}
+
+// TypeNotIn is an autogenerated method
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) TypeNotIn(typeValue ...string) CheckReservedKeywordsQuerySet {
+	if len(typeValue) == 0 {
+		qs.db.AddError(errors.New("must at least pass one typeValue in TypeNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("type NOT IN (?)", typeValue))
+}
+
+// TypeNotInQuery filters rows with Type NOT IN values selected by subquery q
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) TypeNotInQuery(q StringSubquery) CheckReservedKeywordsQuerySet {
+	return qs.w(qs.db.Where("type NOT IN (?)", q.expr()))
+}
+
+// TypeNotlike is an autogenerated method
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) TypeNotlike(typeValue string) CheckReservedKeywordsQuerySet {
+	return qs.w(qs.db.Where("type NOT LIKE ?", typeValue))
+}
+
+// TypeStartsWith filters rows with Type starting with the argument
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) TypeStartsWith(typeValue string) CheckReservedKeywordsQuerySet {
+	return qs.w(whereLike(qs.db, "type", "", typeValue, "%", false))
+}
+
+// WithContext returns copy executing queries with context ctx.
+// Gorm v1 doesn't support context: its error is checked only before query.
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) WithContext(ctx context.Context) CheckReservedKeywordsQuerySet {
+	return qs.w(DBWithContext(ctx, qs.db))
+}
+
+// fieldPtrs returns pointers to fields of o by their columns
+// nolint: dupl
+func (qs CheckReservedKeywordsQuerySet) fieldPtrs(o *CheckReservedKeywords) map[string]interface{} {
+	return map[string]interface{}{
+		"type":   &o.Type,
+		"struct": &o.Struct,
+	}
+}
+
+// InTx returns copy bound to transaction tx: queries are executed in it.
+// Gorm v1 can't move conditions into another connection, so with gorm v1
+// InTx must be called before any condition is set, otherwise queries fail.
+// Context and preloads are moved into transaction by both gorm versions.
+// nolint: dupl
+func (u CheckReservedKeywordsUpdater) InTx(tx *gorm.DB) CheckReservedKeywordsUpdater {
+	if !reflect.DeepEqual(u.db.QueryExpr(), u.db.New().Model(&CheckReservedKeywords{}).QueryExpr()) {
+		tx = tx.New()
+		tx.AddError(errors.New("InTx must be called before any condition is set: " +
+			"gorm v1 can't move conditions into transaction"))
+	}
+	tx = txWithSettings(u.db, tx)
+	u.db = tx.Model(&CheckReservedKeywords{})
+	return u
+}
+
+// SetStruct is an autogenerated method
+// nolint: dupl
+func (u CheckReservedKeywordsUpdater) SetStruct(structValue int) CheckReservedKeywordsUpdater {
+	u.fields[string(CheckReservedKeywordsDBSchema.Struct)] = structValue
+	return u
+}
+
+// SetType is an autogenerated method
+// nolint: dupl
+func (u CheckReservedKeywordsUpdater) SetType(typeValue string) CheckReservedKeywordsUpdater {
+	u.fields[string(CheckReservedKeywordsDBSchema.Type)] = typeValue
+	return u
+}
+
+// Update is an autogenerated method
+// nolint: dupl
+func (u CheckReservedKeywordsUpdater) Update() error {
+	return checkQueryContext(u.db).Updates(u.fields).Error
+}
+
+// UpdateNum is an autogenerated method
+// nolint: dupl
+func (u CheckReservedKeywordsUpdater) UpdateNum() (int64, error) {
+	db := checkQueryContext(u.db).Updates(u.fields)
+	return db.RowsAffected, db.Error
+}
+
+// WithContext returns copy executing queries with context ctx.
+// Gorm v1 doesn't support context: its error is checked only before query.
+// nolint: dupl
+func (u CheckReservedKeywordsUpdater) WithContext(ctx context.Context) CheckReservedKeywordsUpdater {
+	u.db = DBWithContext(ctx, u.db)
+	return u
+}
+
+// ===== END of query set CheckReservedKeywordsQuerySet
+
+// ===== BEGIN of CheckReservedKeywords modifiers
+
+// CheckReservedKeywordsDBSchemaField describes database schema field. It requires for method 'Update'
+type CheckReservedKeywordsDBSchemaField string
+
+// String method returns string representation of field.
+// nolint: dupl
+func (f CheckReservedKeywordsDBSchemaField) String() string {
+	return string(f)
+}
+
+// CheckReservedKeywordsDBSchema stores db field names of CheckReservedKeywords
+var CheckReservedKeywordsDBSchema = struct {
+	Type   CheckReservedKeywordsDBSchemaField
+	Struct CheckReservedKeywordsDBSchemaField
+}{
+
+	Type:   CheckReservedKeywordsDBSchemaField("type"),
+	Struct: CheckReservedKeywordsDBSchemaField("struct"),
+}
+
+// Update updates CheckReservedKeywords fields by primary key
+// nolint: dupl
+func (o *CheckReservedKeywords) Update(db *gorm.DB, fields ...CheckReservedKeywordsDBSchemaField) error {
+	dbNameToFieldName := map[string]interface{}{
+		"type":   o.Type,
+		"struct": o.Struct,
+	}
+	u := map[string]interface{}{}
+	for _, f := range fields {
+		fs := f.String()
+		u[fs] = dbNameToFieldName[fs]
+	}
+	if err := checkQueryContext(db).Model(o).Updates(u).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return err
+		}
+
+		return fmt.Errorf("can't update CheckReservedKeywords %v fields %v: %s",
+			o, fields, err)
+	}
+
+	return nil
+}
+
+// Upsert inserts CheckReservedKeywords or updates updateFields of existing row
+// if insert violates unique constraint on conflictFields. Row isn't
+// changed if there are no updateFields: any conflict is ignored then if
+// there are no conflictFields. MySQL ignores conflictFields: it checks
+// all unique constraints, other databases require them to update row.
+// nolint: dupl
+func (o *CheckReservedKeywords) Upsert(db *gorm.DB, conflictFields []CheckReservedKeywordsDBSchemaField,
+	updateFields ...CheckReservedKeywordsDBSchemaField) error {
+
+	conflictColumns := make([]string, 0, len(conflictFields))
+	for _, f := range conflictFields {
+		conflictColumns = append(conflictColumns, f.String())
+	}
+	updateColumns := make([]string, 0, len(updateFields))
+	for _, f := range updateFields {
+		updateColumns = append(updateColumns, f.String())
+	}
+
+	qs := NewCheckReservedKeywordsQuerySet(db)
+	return upsert(checkQueryContext(qs.db), "", qs.fieldPtrs(o),
+		conflictColumns, updateColumns)
+}
+
+// CheckReservedKeywordsUpdater is an CheckReservedKeywords updates manager
+type CheckReservedKeywordsUpdater struct {
+	fields map[string]interface{}
+	db     *gorm.DB
+}
+
+// NewCheckReservedKeywordsUpdater creates new CheckReservedKeywords updater
+// nolint: dupl
+func NewCheckReservedKeywordsUpdater(db *gorm.DB) CheckReservedKeywordsUpdater {
+	return CheckReservedKeywordsUpdater{
+		fields: map[string]interface{}{},
+		db:     db.Model(&CheckReservedKeywords{}),
+	}
+}
+
+// ===== END of CheckReservedKeywords modifiers
+
+// ===== BEGIN of CheckReservedKeywords predicates
+
+// CheckReservedKeywordsPredicate is a condition on CheckReservedKeywords fields for Where and Not
+// methods of CheckReservedKeywordsQuerySet: predicates are created by CheckReservedKeywordsQ and
+// combined by And, Or and Not. Zero predicate matches all rows.
+type CheckReservedKeywordsPredicate struct {
+	expr string
+	args []interface{}
+	err  error
+}
+
+// join combines predicates by operation op, empty predicates are skipped
+// nolint: dupl
+func (p CheckReservedKeywordsPredicate) join(op string, preds []CheckReservedKeywordsPredicate) CheckReservedKeywordsPredicate {
+	for _, q := range preds {
+		if p.err == nil {
+			p.err = q.err
+		}
+		if q.expr == "" {
+			continue
+		}
+		if p.expr == "" {
+			p.expr, p.args = q.expr, q.args
+			continue
+		}
+
+		p.expr = "(" + p.expr + ") " + op + " (" + q.expr + ")"
+		p.args = append(append([]interface{}{}, p.args...), q.args...)
+	}
+
+	return p
+}
+
+// And returns predicate matching rows matched by p and all preds
+// nolint: dupl
+func (p CheckReservedKeywordsPredicate) And(preds ...CheckReservedKeywordsPredicate) CheckReservedKeywordsPredicate {
+	return p.join("AND", preds)
+}
+
+// Or returns predicate matching rows matched by p or any of preds
+// nolint: dupl
+func (p CheckReservedKeywordsPredicate) Or(preds ...CheckReservedKeywordsPredicate) CheckReservedKeywordsPredicate {
+	return p.join("OR", preds)
+}
+
+// Not returns predicate matching rows not matched by p
+// nolint: dupl
+func (p CheckReservedKeywordsPredicate) Not() CheckReservedKeywordsPredicate {
+	if p.expr != "" {
+		p.expr = "NOT (" + p.expr + ")"
+	}
+
+	return p
+}
+
+// CheckReservedKeywordsPredicates creates predicates by CheckReservedKeywords fields
+type CheckReservedKeywordsPredicates struct{}
+
+// CheckReservedKeywordsQ creates predicates by CheckReservedKeywords fields
+var CheckReservedKeywordsQ CheckReservedKeywordsPredicates
+
+// StructEq creates predicate "struct = ?"
+// nolint: dupl
+func (CheckReservedKeywordsPredicates) StructEq(structValue int) CheckReservedKeywordsPredicate {
+	return CheckReservedKeywordsPredicate{expr: "struct = ?", args: []interface{}{structValue}}
+}
+
+// StructGt creates predicate "struct > ?"
+// nolint: dupl
+func (CheckReservedKeywordsPredicates) StructGt(structValue int) CheckReservedKeywordsPredicate {
+	return CheckReservedKeywordsPredicate{expr: "struct > ?", args: []interface{}{structValue}}
+}
+
+// StructGte creates predicate "struct >= ?"
+// nolint: dupl
+func (CheckReservedKeywordsPredicates) StructGte(structValue int) CheckReservedKeywordsPredicate {
+	return CheckReservedKeywordsPredicate{expr: "struct >= ?", args: []interface{}{structValue}}
+}
+
+// StructIn creates predicate "struct IN (?)"
+// nolint: dupl
+func (CheckReservedKeywordsPredicates) StructIn(structValue ...int) CheckReservedKeywordsPredicate {
+	if len(structValue) == 0 {
+		return CheckReservedKeywordsPredicate{err: errors.New("must at least pass one structValue in StructIn")}
+	}
+	return CheckReservedKeywordsPredicate{expr: "struct IN (?)", args: []interface{}{structValue}}
+}
+
+// StructLt creates predicate "struct < ?"
+// nolint: dupl
+func (CheckReservedKeywordsPredicates) StructLt(structValue int) CheckReservedKeywordsPredicate {
+	return CheckReservedKeywordsPredicate{expr: "struct < ?", args: []interface{}{structValue}}
+}
+
+// StructLte creates predicate "struct <= ?"
+// nolint: dupl
+func (CheckReservedKeywordsPredicates) StructLte(structValue int) CheckReservedKeywordsPredicate {
+	return CheckReservedKeywordsPredicate{expr: "struct <= ?", args: []interface{}{structValue}}
+}
+
+// StructNe creates predicate "struct != ?"
+// nolint: dupl
+func (CheckReservedKeywordsPredicates) StructNe(structValue int) CheckReservedKeywordsPredicate {
+	return CheckReservedKeywordsPredicate{expr: "struct != ?", args: []interface{}{structValue}}
+}
+
+// StructNotIn creates predicate "struct NOT IN (?)"
+// nolint: dupl
+func (CheckReservedKeywordsPredicates) StructNotIn(structValue ...int) CheckReservedKeywordsPredicate {
+	if len(structValue) == 0 {
+		return CheckReservedKeywordsPredicate{err: errors.New("must at least pass one structValue in StructNotIn")}
+	}
+	return CheckReservedKeywordsPredicate{expr: "struct NOT IN (?)", args: []interface{}{structValue}}
+}
+
+// TypeEq creates predicate "type = ?"
+// nolint: dupl
+func (CheckReservedKeywordsPredicates) TypeEq(typeValue string) CheckReservedKeywordsPredicate {
+	return CheckReservedKeywordsPredicate{expr: "type = ?", args: []interface{}{typeValue}}
+}
+
+// TypeGt creates predicate "type > ?"
+// nolint: dupl
+func (CheckReservedKeywordsPredicates) TypeGt(typeValue string) CheckReservedKeywordsPredicate {
+	return CheckReservedKeywordsPredicate{expr: "type > ?", args: []interface{}{typeValue}}
+}
+
+// TypeGte creates predicate "type >= ?"
+// nolint: dupl
+func (CheckReservedKeywordsPredicates) TypeGte(typeValue string) CheckReservedKeywordsPredicate {
+	return CheckReservedKeywordsPredicate{expr: "type >= ?", args: []interface{}{typeValue}}
+}
+
+// TypeIn creates predicate "type IN (?)"
+// nolint: dupl
+func (CheckReservedKeywordsPredicates) TypeIn(typeValue ...string) CheckReservedKeywordsPredicate {
+	if len(typeValue) == 0 {
+		return CheckReservedKeywordsPredicate{err: errors.New("must at least pass one typeValue in TypeIn")}
+	}
+	return CheckReservedKeywordsPredicate{expr: "type IN (?)", args: []interface{}{typeValue}}
+}
+
+// TypeLike creates predicate "type LIKE ?"
+// nolint: dupl
+func (CheckReservedKeywordsPredicates) TypeLike(typeValue string) CheckReservedKeywordsPredicate {
+	return CheckReservedKeywordsPredicate{expr: "type LIKE ?", args: []interface{}{typeValue}}
+}
+
+// TypeLt creates predicate "type < ?"
+// nolint: dupl
+func (CheckReservedKeywordsPredicates) TypeLt(typeValue string) CheckReservedKeywordsPredicate {
+	return CheckReservedKeywordsPredicate{expr: "type < ?", args: []interface{}{typeValue}}
+}
+
+// TypeLte creates predicate "type <= ?"
+// nolint: dupl
+func (CheckReservedKeywordsPredicates) TypeLte(typeValue string) CheckReservedKeywordsPredicate {
+	return CheckReservedKeywordsPredicate{expr: "type <= ?", args: []interface{}{typeValue}}
+}
+
+// TypeNe creates predicate "type != ?"
+// nolint: dupl
+func (CheckReservedKeywordsPredicates) TypeNe(typeValue string) CheckReservedKeywordsPredicate {
+	return CheckReservedKeywordsPredicate{expr: "type != ?", args: []interface{}{typeValue}}
+}
+
+// TypeNotIn creates predicate "type NOT IN (?)"
+// nolint: dupl
+func (CheckReservedKeywordsPredicates) TypeNotIn(typeValue ...string) CheckReservedKeywordsPredicate {
+	if len(typeValue) == 0 {
+		return CheckReservedKeywordsPredicate{err: errors.New("must at least pass one typeValue in TypeNotIn")}
+	}
+	return CheckReservedKeywordsPredicate{expr: "type NOT IN (?)", args: []interface{}{typeValue}}
+}
+
+// TypeNotlike creates predicate "type NOT LIKE ?"
+// nolint: dupl
+func (CheckReservedKeywordsPredicates) TypeNotlike(typeValue string) CheckReservedKeywordsPredicate {
+	return CheckReservedKeywordsPredicate{expr: "type NOT LIKE ?", args: []interface{}{typeValue}}
+}
+
+// ===== END of CheckReservedKeywords predicates
+
+// ===== BEGIN of query set CommentQuerySet
+
+// CommentQuerySet is an queryset type for Comment
+type CommentQuerySet struct {
+	db *gorm.DB
+}
+
+// NewCommentQuerySet constructs new CommentQuerySet
+func NewCommentQuerySet(db *gorm.DB) CommentQuerySet {
+	return CommentQuerySet{
+		db: db.Model(&Comment{}),
+	}
+}
+
+func (qs CommentQuerySet) w(db *gorm.DB) CommentQuerySet {
+	return NewCommentQuerySet(db)
+}
+
+func (qs CommentQuerySet) Select(fields ...CommentDBSchemaField) CommentQuerySet {
+	names := []string{}
+	for _, f := range fields {
+		names = append(names, f.String())
+	}
+
+	return qs.w(qs.db.Select(strings.Join(names, ",")))
+}
+
+// GroupBy groups rows by fields
+func (qs CommentQuerySet) GroupBy(fields ...CommentDBSchemaField) CommentQuerySet {
+	names := []string{}
+	for _, f := range fields {
+		names = append(names, f.String())
+	}
+
+	return qs.w(qs.db.Group(strings.Join(names, ",")))
+}
+
+// Where filters rows by predicate p, predicates are created by CommentQ
+// nolint: dupl
+func (qs CommentQuerySet) Where(p CommentPredicate) CommentQuerySet {
+	if p.err != nil {
+		// error is added to copy of db: qs can be reused as a base queryset
+		db := qs.db.Model(&Comment{})
+		db.AddError(p.err)
+		return qs.w(db)
+	}
+	if p.expr == "" {
+		return qs
+	}
+
+	return qs.w(qs.db.Where(p.expr, p.args...))
+}
+
+// Not filters rows not matching predicate p
+// nolint: dupl
+func (qs CommentQuerySet) Not(p CommentPredicate) CommentQuerySet {
+	return qs.Where(p.Not())
+}
+
+// Create is an autogenerated method
+// nolint: dupl
+func (o *Comment) Create(db *gorm.DB) error {
+	return checkQueryContext(db).Create(o).Error
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (o *Comment) Delete(db *gorm.DB) error {
+	return checkQueryContext(db).Delete(o).Error
+}
+
+// All is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) All(ret *[]Comment) error {
+	return checkQueryContext(qs.db).Find(ret).Error
+}
+
+// Batches calls fn for every batch of at most size rows, batches are
+// fetched by Page: ordered by primary key after queryset orders.
+// It stops on the first error of fn and returns it.
+// nolint: dupl
+func (qs CommentQuerySet) Batches(size int, fn func([]Comment) error) error {
+	cursor := ""
+	for {
+		var batch []Comment
+		next, err := qs.Page(cursor, size, &batch)
+		if err != nil {
+			return err
+		}
+
+		if len(batch) != 0 {
+			if err = fn(batch); err != nil {
+				return err
+			}
+		}
+
+		if next == "" {
+			return nil
+		}
+		cursor = next
+	}
+}
+
+// Count is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) Count() (int, error) {
+	var count int
+	db := checkQueryContext(qs.db)
+	if db.Error != nil {
+		return count, db.Error
+	}
+
+	err := db.Count(&count).Error
+	return count, err
+}
+
+// CountByID returns count of rows for every value of ID
+// nolint: dupl
+func (qs CommentQuerySet) CountByID() (map[uint]int, error) {
+	var rows []struct {
+		Value uint
+		Count int
+	}
+	err := checkQueryContext(qs.db).Select("id AS value, COUNT(*) AS count").Group("id").Scan(&rows).Error
+	ret := make(map[uint]int, len(rows))
+	for _, r := range rows {
+		ret[r.Value] = r.Count
+	}
+	return ret, err
+}
+
+// CountByStatus returns count of rows for every value of Status
+// nolint: dupl
+func (qs CommentQuerySet) CountByStatus() (map[string]int, error) {
+	var rows []struct {
+		Value string
+		Count int
+	}
+	err := checkQueryContext(qs.db).Select("status AS value, COUNT(*) AS count").Group("status").Scan(&rows).Error
+	ret := make(map[string]int, len(rows))
+	for _, r := range rows {
+		ret[r.Value] = r.Count
+	}
+	return ret, err
+}
+
+// CountByText returns count of rows for every value of Text
+// nolint: dupl
+func (qs CommentQuerySet) CountByText() (map[string]int, error) {
+	var rows []struct {
+		Value string
+		Count int
+	}
+	err := checkQueryContext(qs.db).Select("text AS value, COUNT(*) AS count").Group("text").Scan(&rows).Error
+	ret := make(map[string]int, len(rows))
+	for _, r := range rows {
+		ret[r.Value] = r.Count
+	}
+	return ret, err
+}
+
+// CreateMany inserts models by multi-row inserts, conditions of queryset
+// aren't used. Generated primary keys are written back to models if
+// database supports it (all except MS SQL for gorm v1).
+// Unlike gorm Create it doesn't call hooks and doesn't read back default
+// values: zero fields having default values are skipped in insert.
+// nolint: dupl
+func (qs CommentQuerySet) CreateMany(models []Comment) error {
+	rows := make([]map[string]interface{}, 0, len(models))
+	for i := range models {
+		rows = append(rows, qs.fieldPtrs(&models[i]))
+	}
+
+	return bulkInsert(checkQueryContext(qs.db), "id", rows)
+}
+
+// CreatedAtBetween filters rows with CreatedAt in range [from, to]
+// nolint: dupl
+func (qs CommentQuerySet) CreatedAtBetween(from time.Time, to time.Time) CommentQuerySet {
+	return qs.w(qs.db.Where("created BETWEEN ? AND ?", from, to))
+}
+
+// CreatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) CreatedAtEq(createdAt time.Time) CommentQuerySet {
+	return qs.w(qs.db.Where("created = ?", createdAt))
+}
+
+// CreatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) CreatedAtGt(createdAt time.Time) CommentQuerySet {
+	return qs.w(qs.db.Where("created > ?", createdAt))
+}
+
+// CreatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) CreatedAtGte(createdAt time.Time) CommentQuerySet {
+	return qs.w(qs.db.Where("created >= ?", createdAt))
+}
+
+// CreatedAtInRange filters rows with CreatedAt in range [from, to)
+// nolint: dupl
+func (qs CommentQuerySet) CreatedAtInRange(from time.Time, to time.Time) CommentQuerySet {
+	return qs.w(qs.db.Where("created >= ? AND created < ?", from, to))
+}
+
+// CreatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) CreatedAtLt(createdAt time.Time) CommentQuerySet {
+	return qs.w(qs.db.Where("created < ?", createdAt))
+}
+
+// CreatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) CreatedAtLte(createdAt time.Time) CommentQuerySet {
+	return qs.w(qs.db.Where("created <= ?", createdAt))
+}
+
+// CreatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) CreatedAtNe(createdAt time.Time) CommentQuerySet {
+	return qs.w(qs.db.Where("created != ?", createdAt))
+}
+
+// CreatedAtWithin filters rows with CreatedAt not earlier than d ago
+// nolint: dupl
+func (qs CommentQuerySet) CreatedAtWithin(d time.Duration) CommentQuerySet {
+	return qs.w(qs.db.Where("created >= ?", time.Now().Add(-d)))
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) Delete() error {
+	return checkQueryContext(qs.db).Delete(Comment{}).Error
+}
+
+// DeleteNum is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) DeleteNum() (int64, error) {
+	db := checkQueryContext(qs.db).Delete(Comment{})
+	return db.RowsAffected, db.Error
+}
+
+// DeleteNumUnscoped is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) DeleteNumUnscoped() (int64, error) {
+	db := checkQueryContext(qs.db).Unscoped().Delete(Comment{})
+	return db.RowsAffected, db.Error
+}
+
+// GetDB is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) GetDB() *gorm.DB {
+	return qs.db
+}
+
+// GetUpdater is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) GetUpdater() CommentUpdater {
+	return NewCommentUpdater(qs.db)
+}
+
+// GroupByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) GroupByCreatedAt() CommentQuerySet {
+	return qs.w(qs.db.Group("created"))
+}
+
+// GroupByID is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) GroupByID() CommentQuerySet {
+	return qs.w(qs.db.Group("id"))
+}
+
+// GroupByStatus is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) GroupByStatus() CommentQuerySet {
+	return qs.w(qs.db.Group("status"))
+}
+
+// GroupByText is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) GroupByText() CommentQuerySet {
+	return qs.w(qs.db.Group("text"))
+}
+
+// GroupByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) GroupByUpdatedAt() CommentQuerySet {
+	return qs.w(qs.db.Group("modified"))
+}
+
+// HavingCountEq is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) HavingCountEq(count int) CommentQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) = ?", count))
+}
+
+// HavingCountGt is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) HavingCountGt(count int) CommentQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) > ?", count))
+}
+
+// HavingCountGte is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) HavingCountGte(count int) CommentQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) >= ?", count))
+}
+
+// HavingCountLt is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) HavingCountLt(count int) CommentQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) < ?", count))
+}
+
+// HavingCountLte is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) HavingCountLte(count int) CommentQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) <= ?", count))
+}
+
+// HavingCountNe is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) HavingCountNe(count int) CommentQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) != ?", count))
+}
+
+// IDBetween filters rows with ID in range [from, to]
+// nolint: dupl
+func (qs CommentQuerySet) IDBetween(from uint, to uint) CommentQuerySet {
+	return qs.w(qs.db.Where("id BETWEEN ? AND ?", from, to))
+}
+
+// IDEq is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) IDEq(ID uint) CommentQuerySet {
+	return qs.w(qs.db.Where("id = ?", ID))
+}
+
+// IDGt is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) IDGt(ID uint) CommentQuerySet {
+	return qs.w(qs.db.Where("id > ?", ID))
+}
+
+// IDGte is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) IDGte(ID uint) CommentQuerySet {
+	return qs.w(qs.db.Where("id >= ?", ID))
+}
+
+// IDIn is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) IDIn(ID ...uint) CommentQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("id IN (?)", ID))
+}
+
+// IDInQuery filters rows with ID IN values selected by subquery q
+// nolint: dupl
+func (qs CommentQuerySet) IDInQuery(q UintSubquery) CommentQuerySet {
+	return qs.w(qs.db.Where("id IN (?)", q.expr()))
+}
+
+// IDInRange filters rows with ID in range [from, to)
+// nolint: dupl
+func (qs CommentQuerySet) IDInRange(from uint, to uint) CommentQuerySet {
+	return qs.w(qs.db.Where("id >= ? AND id < ?", from, to))
+}
+
+// IDLt is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) IDLt(ID uint) CommentQuerySet {
+	return qs.w(qs.db.Where("id < ?", ID))
+}
+
+// IDLte is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) IDLte(ID uint) CommentQuerySet {
+	return qs.w(qs.db.Where("id <= ?", ID))
+}
+
+// IDNe is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) IDNe(ID uint) CommentQuerySet {
+	return qs.w(qs.db.Where("id != ?", ID))
+}
+
+// IDNotIn is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) IDNotIn(ID ...uint) CommentQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("id NOT IN (?)", ID))
+}
+
+// IDNotInQuery filters rows with ID NOT IN values selected by subquery q
+// nolint: dupl
+func (qs CommentQuerySet) IDNotInQuery(q UintSubquery) CommentQuerySet {
+	return qs.w(qs.db.Where("id NOT IN (?)", q.expr()))
+}
+
+// InTx returns copy bound to transaction tx: queries are executed in it.
+// Gorm v1 can't move conditions into another connection, so with gorm v1
+// InTx must be called before any condition is set, otherwise queries fail.
+// Context and preloads are moved into transaction by both gorm versions.
+// nolint: dupl
+func (qs CommentQuerySet) InTx(tx *gorm.DB) CommentQuerySet {
+	if !reflect.DeepEqual(qs.db.QueryExpr(), NewCommentQuerySet(qs.db.New()).db.QueryExpr()) {
+		tx = tx.New()
+		tx.AddError(errors.New("InTx must be called before any condition is set: " +
+			"gorm v1 can't move conditions into transaction"))
+	}
+	tx = txWithSettings(qs.db, tx)
+	return NewCommentQuerySet(tx)
+}
+
+// Iterate scans rows one by one and calls fn for every row without
+// loading all rows into memory. It stops on the first error of fn and
+// returns it. Preloads aren't applied.
+// nolint: dupl
+func (qs CommentQuerySet) Iterate(fn func(*Comment) error) error {
+	db := checkQueryContext(qs.db)
+	if db.Error != nil {
+		return db.Error // gorm v1 doesn't check it in Rows
+	}
+
+	rows, err := db.Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var o Comment
+		if err = db.ScanRows(rows, &o); err != nil {
+			return err
+		}
+		if err = fn(&o); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// Limit is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) Limit(limit int) CommentQuerySet {
+	return qs.w(qs.db.Limit(limit))
+}
+
+// MaxCreatedAt returns MAX of CreatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs CommentQuerySet) MaxCreatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := checkQueryContext(qs.db).Select("created AS value").Where("created IS NOT NULL").
+		Order("created DESC", true).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MaxID returns MAX of ID, it's zero value if there are no rows
+// nolint: dupl
+func (qs CommentQuerySet) MaxID() (uint, error) {
+	var ret uint
+	var res struct {
+		Value *uint
+	}
+	err := checkQueryContext(qs.db).Select("MAX(id) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// MaxUpdatedAt returns MAX of UpdatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs CommentQuerySet) MaxUpdatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := checkQueryContext(qs.db).Select("modified AS value").Where("modified IS NOT NULL").
+		Order("modified DESC", true).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MinCreatedAt returns MIN of CreatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs CommentQuerySet) MinCreatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := checkQueryContext(qs.db).Select("created AS value").Where("created IS NOT NULL").
+		Order("created ASC", true).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MinID returns MIN of ID, it's zero value if there are no rows
+// nolint: dupl
+func (qs CommentQuerySet) MinID() (uint, error) {
+	var ret uint
+	var res struct {
+		Value *uint
+	}
+	err := checkQueryContext(qs.db).Select("MIN(id) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// MinUpdatedAt returns MIN of UpdatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs CommentQuerySet) MinUpdatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := checkQueryContext(qs.db).Select("modified AS value").Where("modified IS NOT NULL").
+		Order("modified ASC", true).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// Offset is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) Offset(offset int) CommentQuerySet {
+	return qs.w(qs.db.Offset(offset))
+}
+
+// One is used to retrieve one result. It returns gorm.ErrRecordNotFound
+// if nothing was fetched
+func (qs CommentQuerySet) One(ret *Comment) error {
+	return checkQueryContext(qs.db).First(ret).Error
+}
+
+// OrderAscByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) OrderAscByCreatedAt() CommentQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("created ASC"), "created", false, false))
+}
+
+// OrderAscByID is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) OrderAscByID() CommentQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("id ASC"), "id", false, false))
+}
+
+// OrderAscByStatus is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) OrderAscByStatus() CommentQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("status ASC"), "status", false, false))
+}
+
+// OrderAscByText is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) OrderAscByText() CommentQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("text ASC"), "text", false, false))
+}
+
+// OrderAscByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) OrderAscByUpdatedAt() CommentQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("modified ASC"), "modified", false, false))
+}
+
+// OrderDescByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) OrderDescByCreatedAt() CommentQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("created DESC"), "created", true, false))
+}
+
+// OrderDescByID is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) OrderDescByID() CommentQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("id DESC"), "id", true, false))
+}
+
+// OrderDescByStatus is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) OrderDescByStatus() CommentQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("status DESC"), "status", true, false))
+}
+
+// OrderDescByText is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) OrderDescByText() CommentQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("text DESC"), "text", true, false))
+}
+
+// OrderDescByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) OrderDescByUpdatedAt() CommentQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("modified DESC"), "modified", true, false))
+}
+
+// Page fetches at most size rows following cursor into ret, it uses
+// orders set by OrderAscBy/OrderDescBy methods and primary key to break ties.
+// Ordered fields can't be nullable: rows with NULL can't be compared with
+// cursor, so error is returned for them. Empty cursor means the first page. It returns cursor of the next page or
+// empty string if there are no more rows.
+// nolint: dupl
+func (qs CommentQuerySet) Page(cursor string, size int, ret *[]Comment) (string, error) {
+	if size <= 0 {
+		return "", errors.New("page size must be positive")
+	}
+
+	var last Comment
+	db, orders, err := pageQuery(qs.db, "", "id", cursor, qs.fieldPtrs(&last))
+	if err != nil {
+		return "", err
+	}
+
+	if err = checkQueryContext(db).Limit(size + 1).Find(ret).Error; err != nil {
+		return "", err
+	}
+	if len(*ret) <= size {
+		return "", nil
+	}
+
+	*ret = (*ret)[:size]
+	return encodePageCursor(orders, qs.fieldPtrs(&(*ret)[size-1]))
+}
+
+// SelectID returns subquery selecting ID of rows of queryset
+// for InQuery and NotInQuery filters
+// nolint: dupl
+func (qs CommentQuerySet) SelectID() UintSubquery {
+	return UintSubquery{db: qs.db.Select("id")}
+}
+
+// SelectStatus returns subquery selecting Status of rows of queryset
+// for InQuery and NotInQuery filters
+// nolint: dupl
+func (qs CommentQuerySet) SelectStatus() StringSubquery {
+	return StringSubquery{db: qs.db.Select("status")}
+}
+
+// SelectText returns subquery selecting Text of rows of queryset
+// for InQuery and NotInQuery filters
+// nolint: dupl
+func (qs CommentQuerySet) SelectText() StringSubquery {
+	return StringSubquery{db: qs.db.Select("text")}
+}
+
+// StatusEndsWith filters rows with Status ending with the argument
+// nolint: dupl
+func (qs CommentQuerySet) StatusEndsWith(status string) CommentQuerySet {
+	return qs.w(whereLike(qs.db, "status", "%", status, "", false))
+}
+
+// StatusEq is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) StatusEq(status string) CommentQuerySet {
+	return qs.w(qs.db.Where("status = ?", status))
+}
+
+// StatusGt is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) StatusGt(status string) CommentQuerySet {
+	return qs.w(qs.db.Where("status > ?", status))
+}
+
+// StatusGte is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) StatusGte(status string) CommentQuerySet {
+	return qs.w(qs.db.Where("status >= ?", status))
+}
+
+// StatusIContains filters rows with Status containing the argument, case is ignored
+// nolint: dupl
+func (qs CommentQuerySet) StatusIContains(status string) CommentQuerySet {
+	return qs.w(whereLike(qs.db, "status", "%", status, "%", true))
+}
+
+// StatusIEq filters rows with Status equal to the argument, case is ignored
+// nolint: dupl
+func (qs CommentQuerySet) StatusIEq(status string) CommentQuerySet {
+	return qs.w(whereLike(qs.db, "status", "", status, "", true))
+}
+
+// StatusIn is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) StatusIn(status ...string) CommentQuerySet {
+	if len(status) == 0 {
+		qs.db.AddError(errors.New("must at least pass one status in StatusIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("status IN (?)", status))
+}
+
+// StatusInQuery filters rows with Status IN values selected by subquery q
+// nolint: dupl
+func (qs CommentQuerySet) StatusInQuery(q StringSubquery) CommentQuerySet {
+	return qs.w(qs.db.Where("status IN (?)", q.expr()))
+}
+
+// StatusLike is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) StatusLike(status string) CommentQuerySet {
+	return qs.w(qs.db.Where("status LIKE ?", status))
+}
+
+// StatusLt is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) StatusLt(status string) CommentQuerySet {
+	return qs.w(qs.db.Where("status < ?", status))
+}
+
+// StatusLte is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) StatusLte(status string) CommentQuerySet {
+	return qs.w(qs.db.Where("status <= ?", status))
+}
+
+// StatusNe is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) StatusNe(status string) CommentQuerySet {
+	return qs.w(qs.db.Where("status != ?", status))
+}
+
+// StatusNotIn is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) StatusNotIn(status ...string) CommentQuerySet {
+	if len(status) == 0 {
+		qs.db.AddError(errors.New("must at least pass one status in StatusNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("status NOT IN (?)", status))
+}
+
+// StatusNotInQuery filters rows with Status NOT IN values selected by subquery q
+// nolint: dupl
+func (qs CommentQuerySet) StatusNotInQuery(q StringSubquery) CommentQuerySet {
+	return qs.w(qs.db.Where("status NOT IN (?)", q.expr()))
+}
+
+// StatusNotlike is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) StatusNotlike(status string) CommentQuerySet {
+	return qs.w(qs.db.Where("status NOT LIKE ?", status))
+}
+
+// StatusStartsWith filters rows with Status starting with the argument
+// nolint: dupl
+func (qs CommentQuerySet) StatusStartsWith(status string) CommentQuerySet {
+	return qs.w(whereLike(qs.db, "status", "", status, "%", false))
+}
+
+// TextEndsWith filters rows with Text ending with the argument
+// nolint: dupl
+func (qs CommentQuerySet) TextEndsWith(text string) CommentQuerySet {
+	return qs.w(whereLike(qs.db, "text", "%", text, "", false))
+}
+
+// TextEq is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) TextEq(text string) CommentQuerySet {
+	return qs.w(qs.db.Where("text = ?", text))
+}
+
+// TextGt is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) TextGt(text string) CommentQuerySet {
+	return qs.w(qs.db.Where("text > ?", text))
+}
+
+// TextGte is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) TextGte(text string) CommentQuerySet {
+	return qs.w(qs.db.Where("text >= ?", text))
+}
+
+// TextIContains filters rows with Text containing the argument, case is ignored
+// nolint: dupl
+func (qs CommentQuerySet) TextIContains(text string) CommentQuerySet {
+	return qs.w(whereLike(qs.db, "text", "%", text, "%", true))
+}
+
+// TextIEq filters rows with Text equal to the argument, case is ignored
+// nolint: dupl
+func (qs CommentQuerySet) TextIEq(text string) CommentQuerySet {
+	return qs.w(whereLike(qs.db, "text", "", text, "", true))
+}
+
+// TextIn is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) TextIn(text ...string) CommentQuerySet {
+	if len(text) == 0 {
+		qs.db.AddError(errors.New("must at least pass one text in TextIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("text IN (?)", text))
+}
+
+// TextInQuery filters rows with Text IN values selected by subquery q
+// nolint: dupl
+func (qs CommentQuerySet) TextInQuery(q StringSubquery) CommentQuerySet {
+	return qs.w(qs.db.Where("text IN (?)", q.expr()))
+}
+
+// TextLike is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) TextLike(text string) CommentQuerySet {
+	return qs.w(qs.db.Where("text LIKE ?", text))
+}
+
+// TextLt is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) TextLt(text string) CommentQuerySet {
+	return qs.w(qs.db.Where("text < ?", text))
+}
+
+// TextLte is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) TextLte(text string) CommentQuerySet {
+	return qs.w(qs.db.Where("text <= ?", text))
+}
+
+// TextNe is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) TextNe(text string) CommentQuerySet {
+	return qs.w(qs.db.Where("text != ?", text))
+}
+
+// TextNotIn is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) TextNotIn(text ...string) CommentQuerySet {
+	if len(text) == 0 {
+		qs.db.AddError(errors.New("must at least pass one text in TextNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("text NOT IN (?)", text))
+}
+
+// TextNotInQuery filters rows with Text NOT IN values selected by subquery q
+// nolint: dupl
+func (qs CommentQuerySet) TextNotInQuery(q StringSubquery) CommentQuerySet {
+	return qs.w(qs.db.Where("text NOT IN (?)", q.expr()))
+}
+
+// TextNotlike is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) TextNotlike(text string) CommentQuerySet {
+	return qs.w(qs.db.Where("text NOT LIKE ?", text))
+}
+
+// TextStartsWith filters rows with Text starting with the argument
+// nolint: dupl
+func (qs CommentQuerySet) TextStartsWith(text string) CommentQuerySet {
+	return qs.w(whereLike(qs.db, "text", "", text, "%", false))
+}
+
+// UpdatedAtBetween filters rows with UpdatedAt in range [from, to]
+// nolint: dupl
+func (qs CommentQuerySet) UpdatedAtBetween(from time.Time, to time.Time) CommentQuerySet {
+	return qs.w(qs.db.Where("modified BETWEEN ? AND ?", from, to))
+}
+
+// UpdatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) UpdatedAtEq(updatedAt time.Time) CommentQuerySet {
+	return qs.w(qs.db.Where("modified = ?", updatedAt))
+}
+
+// UpdatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) UpdatedAtGt(updatedAt time.Time) CommentQuerySet {
+	return qs.w(qs.db.Where("modified > ?", updatedAt))
+}
+
+// UpdatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) UpdatedAtGte(updatedAt time.Time) CommentQuerySet {
+	return qs.w(qs.db.Where("modified >= ?", updatedAt))
+}
+
+// UpdatedAtInRange filters rows with UpdatedAt in range [from, to)
+// nolint: dupl
+func (qs CommentQuerySet) UpdatedAtInRange(from time.Time, to time.Time) CommentQuerySet {
+	return qs.w(qs.db.Where("modified >= ? AND modified < ?", from, to))
+}
+
+// UpdatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) UpdatedAtLt(updatedAt time.Time) CommentQuerySet {
+	return qs.w(qs.db.Where("modified < ?", updatedAt))
+}
+
+// UpdatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) UpdatedAtLte(updatedAt time.Time) CommentQuerySet {
+	return qs.w(qs.db.Where("modified <= ?", updatedAt))
+}
+
+// UpdatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs CommentQuerySet) UpdatedAtNe(updatedAt time.Time) CommentQuerySet {
+	return qs.w(qs.db.Where("modified != ?", updatedAt))
+}
+
+// UpdatedAtWithin filters rows with UpdatedAt not earlier than d ago
+// nolint: dupl
+func (qs CommentQuerySet) UpdatedAtWithin(d time.Duration) CommentQuerySet {
+	return qs.w(qs.db.Where("modified >= ?", time.Now().Add(-d)))
+}
+
+// WithContext returns copy executing queries with context ctx.
+// Gorm v1 doesn't support context: its error is checked only before query.
+// nolint: dupl
+func (qs CommentQuerySet) WithContext(ctx context.Context) CommentQuerySet {
+	return qs.w(DBWithContext(ctx, qs.db))
+}
+
+// fieldPtrs returns pointers to fields of o by their columns
+// nolint: dupl
+func (qs CommentQuerySet) fieldPtrs(o *Comment) map[string]interface{} {
+	return map[string]interface{}{
+		"id":       &o.ID,
+		"text":     &o.Text,
+		"status":   &o.Status,
+		"created":  &o.CreatedAt,
+		"modified": &o.UpdatedAt,
+	}
+}
+
+// InTx returns copy bound to transaction tx: queries are executed in it.
+// Gorm v1 can't move conditions into another connection, so with gorm v1
+// InTx must be called before any condition is set, otherwise queries fail.
+// Context and preloads are moved into transaction by both gorm versions.
+// nolint: dupl
+func (u CommentUpdater) InTx(tx *gorm.DB) CommentUpdater {
+	if !reflect.DeepEqual(u.db.QueryExpr(), u.db.New().Model(&Comment{}).QueryExpr()) {
+		tx = tx.New()
+		tx.AddError(errors.New("InTx must be called before any condition is set: " +
+			"gorm v1 can't move conditions into transaction"))
+	}
+	tx = txWithSettings(u.db, tx)
+	u.db = tx.Model(&Comment{})
+	return u
+}
+
+// SetCreatedAt is an autogenerated method
+// nolint: dupl
+func (u CommentUpdater) SetCreatedAt(createdAt time.Time) CommentUpdater {
+	u.fields[string(CommentDBSchema.CreatedAt)] = createdAt
+	return u
+}
+
+// SetID is an autogenerated method
+// nolint: dupl
+func (u CommentUpdater) SetID(ID uint) CommentUpdater {
+	u.fields[string(CommentDBSchema.ID)] = ID
+	return u
+}
+
+// SetStatus is an autogenerated method
+// nolint: dupl
+func (u CommentUpdater) SetStatus(status string) CommentUpdater {
+	u.fields[string(CommentDBSchema.Status)] = status
+	return u
+}
+
+// SetText is an autogenerated method
+// nolint: dupl
+func (u CommentUpdater) SetText(text string) CommentUpdater {
+	u.fields[string(CommentDBSchema.Text)] = text
+	return u
+}
+
+// SetUpdatedAt is an autogenerated method
+// nolint: dupl
+func (u CommentUpdater) SetUpdatedAt(updatedAt time.Time) CommentUpdater {
+	u.fields[string(CommentDBSchema.UpdatedAt)] = updatedAt
+	return u
+}
+
+// Update is an autogenerated method
+// nolint: dupl
+func (u CommentUpdater) Update() error {
+	return checkQueryContext(u.db).Updates(u.fields).Error
+}
+
+// UpdateNum is an autogenerated method
+// nolint: dupl
+func (u CommentUpdater) UpdateNum() (int64, error) {
+	db := checkQueryContext(u.db).Updates(u.fields)
+	return db.RowsAffected, db.Error
+}
+
+// WithContext returns copy executing queries with context ctx.
+// Gorm v1 doesn't support context: its error is checked only before query.
+// nolint: dupl
+func (u CommentUpdater) WithContext(ctx context.Context) CommentUpdater {
+	u.db = DBWithContext(ctx, u.db)
+	return u
+}
+
+// ===== END of query set CommentQuerySet
+
+// ===== BEGIN of Comment modifiers
+
+// CommentDBSchemaField describes database schema field. It requires for method 'Update'
+type CommentDBSchemaField string
+
+// String method returns string representation of field.
+// nolint: dupl
+func (f CommentDBSchemaField) String() string {
+	return string(f)
+}
+
+// CommentDBSchema stores db field names of Comment
+var CommentDBSchema = struct {
+	ID        CommentDBSchemaField
+	Text      CommentDBSchemaField
+	Status    CommentDBSchemaField
+	CreatedAt CommentDBSchemaField
+	UpdatedAt CommentDBSchemaField
+}{
+
+	ID:        CommentDBSchemaField("id"),
+	Text:      CommentDBSchemaField("text"),
+	Status:    CommentDBSchemaField("status"),
+	CreatedAt: CommentDBSchemaField("created"),
+	UpdatedAt: CommentDBSchemaField("modified"),
+}
+
+// Update updates Comment fields by primary key
+// nolint: dupl
+func (o *Comment) Update(db *gorm.DB, fields ...CommentDBSchemaField) error {
+	dbNameToFieldName := map[string]interface{}{
+		"id":       o.ID,
+		"text":     o.Text,
+		"status":   o.Status,
+		"created":  o.CreatedAt,
+		"modified": o.UpdatedAt,
+	}
+	u := map[string]interface{}{}
+	for _, f := range fields {
+		fs := f.String()
+		u[fs] = dbNameToFieldName[fs]
+	}
+	if err := checkQueryContext(db).Model(o).Updates(u).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return err
+		}
+
+		return fmt.Errorf("can't update Comment %v fields %v: %s",
+			o, fields, err)
+	}
+
+	return nil
+}
+
+// Upsert inserts Comment or updates updateFields of existing row
+// if insert violates unique constraint on conflictFields. Row isn't
+// changed if there are no updateFields: any conflict is ignored then if
+// there are no conflictFields. MySQL ignores conflictFields: it checks
+// all unique constraints, other databases require them to update row.
+// nolint: dupl
+func (o *Comment) Upsert(db *gorm.DB, conflictFields []CommentDBSchemaField,
+	updateFields ...CommentDBSchemaField) error {
+
+	conflictColumns := make([]string, 0, len(conflictFields))
+	for _, f := range conflictFields {
+		conflictColumns = append(conflictColumns, f.String())
+	}
+	updateColumns := make([]string, 0, len(updateFields))
+	for _, f := range updateFields {
+		updateColumns = append(updateColumns, f.String())
+	}
+
+	qs := NewCommentQuerySet(db)
+	return upsert(checkQueryContext(qs.db), "id", qs.fieldPtrs(o),
+		conflictColumns, updateColumns)
+}
+
+// CommentUpdater is an Comment updates manager
+type CommentUpdater struct {
+	fields map[string]interface{}
+	db     *gorm.DB
+}
+
+// NewCommentUpdater creates new Comment updater
+// nolint: dupl
+func NewCommentUpdater(db *gorm.DB) CommentUpdater {
+	return CommentUpdater{
+		fields: map[string]interface{}{},
+		db:     db.Model(&Comment{}),
+	}
+}
+
+// ===== END of Comment modifiers
+
+// ===== BEGIN of Comment predicates
+
+// CommentPredicate is a condition on Comment fields for Where and Not
+// methods of CommentQuerySet: predicates are created by CommentQ and
+// combined by And, Or and Not. Zero predicate matches all rows.
+type CommentPredicate struct {
+	expr string
+	args []interface{}
+	err  error
+}
+
+// join combines predicates by operation op, empty predicates are skipped
+// nolint: dupl
+func (p CommentPredicate) join(op string, preds []CommentPredicate) CommentPredicate {
+	for _, q := range preds {
+		if p.err == nil {
+			p.err = q.err
+		}
+		if q.expr == "" {
+			continue
+		}
+		if p.expr == "" {
+			p.expr, p.args = q.expr, q.args
+			continue
+		}
+
+		p.expr = "(" + p.expr + ") " + op + " (" + q.expr + ")"
+		p.args = append(append([]interface{}{}, p.args...), q.args...)
+	}
+
+	return p
+}
+
+// And returns predicate matching rows matched by p and all preds
+// nolint: dupl
+func (p CommentPredicate) And(preds ...CommentPredicate) CommentPredicate {
+	return p.join("AND", preds)
+}
+
+// Or returns predicate matching rows matched by p or any of preds
+// nolint: dupl
+func (p CommentPredicate) Or(preds ...CommentPredicate) CommentPredicate {
+	return p.join("OR", preds)
+}
+
+// Not returns predicate matching rows not matched by p
+// nolint: dupl
+func (p CommentPredicate) Not() CommentPredicate {
+	if p.expr != "" {
+		p.expr = "NOT (" + p.expr + ")"
+	}
+
+	return p
+}
+
+// CommentPredicates creates predicates by Comment fields
+type CommentPredicates struct{}
+
+// CommentQ creates predicates by Comment fields
+var CommentQ CommentPredicates
+
+// CreatedAtEq creates predicate "created = ?"
+// nolint: dupl
+func (CommentPredicates) CreatedAtEq(createdAt time.Time) CommentPredicate {
+	return CommentPredicate{expr: "created = ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtGt creates predicate "created > ?"
+// nolint: dupl
+func (CommentPredicates) CreatedAtGt(createdAt time.Time) CommentPredicate {
+	return CommentPredicate{expr: "created > ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtGte creates predicate "created >= ?"
+// nolint: dupl
+func (CommentPredicates) CreatedAtGte(createdAt time.Time) CommentPredicate {
+	return CommentPredicate{expr: "created >= ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtLt creates predicate "created < ?"
+// nolint: dupl
+func (CommentPredicates) CreatedAtLt(createdAt time.Time) CommentPredicate {
+	return CommentPredicate{expr: "created < ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtLte creates predicate "created <= ?"
+// nolint: dupl
+func (CommentPredicates) CreatedAtLte(createdAt time.Time) CommentPredicate {
+	return CommentPredicate{expr: "created <= ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtNe creates predicate "created != ?"
+// nolint: dupl
+func (CommentPredicates) CreatedAtNe(createdAt time.Time) CommentPredicate {
+	return CommentPredicate{expr: "created != ?", args: []interface{}{createdAt}}
+}
+
+// IDEq creates predicate "id = ?"
+// nolint: dupl
+func (CommentPredicates) IDEq(ID uint) CommentPredicate {
+	return CommentPredicate{expr: "id = ?", args: []interface{}{ID}}
+}
+
+// IDGt creates predicate "id > ?"
+// nolint: dupl
+func (CommentPredicates) IDGt(ID uint) CommentPredicate {
+	return CommentPredicate{expr: "id > ?", args: []interface{}{ID}}
+}
+
+// IDGte creates predicate "id >= ?"
+// nolint: dupl
+func (CommentPredicates) IDGte(ID uint) CommentPredicate {
+	return CommentPredicate{expr: "id >= ?", args: []interface{}{ID}}
+}
+
+// IDIn creates predicate "id IN (?)"
+// nolint: dupl
+func (CommentPredicates) IDIn(ID ...uint) CommentPredicate {
+	if len(ID) == 0 {
+		return CommentPredicate{err: errors.New("must at least pass one ID in IDIn")}
+	}
+	return CommentPredicate{expr: "id IN (?)", args: []interface{}{ID}}
+}
+
+// IDLt creates predicate "id < ?"
+// nolint: dupl
+func (CommentPredicates) IDLt(ID uint) CommentPredicate {
+	return CommentPredicate{expr: "id < ?", args: []interface{}{ID}}
+}
+
+// IDLte creates predicate "id <= ?"
+// nolint: dupl
+func (CommentPredicates) IDLte(ID uint) CommentPredicate {
+	return CommentPredicate{expr: "id <= ?", args: []interface{}{ID}}
+}
+
+// IDNe creates predicate "id != ?"
+// nolint: dupl
+func (CommentPredicates) IDNe(ID uint) CommentPredicate {
+	return CommentPredicate{expr: "id != ?", args: []interface{}{ID}}
+}
+
+// IDNotIn creates predicate "id NOT IN (?)"
+// nolint: dupl
+func (CommentPredicates) IDNotIn(ID ...uint) CommentPredicate {
+	if len(ID) == 0 {
+		return CommentPredicate{err: errors.New("must at least pass one ID in IDNotIn")}
+	}
+	return CommentPredicate{expr: "id NOT IN (?)", args: []interface{}{ID}}
+}
+
+// StatusEq creates predicate "status = ?"
+// nolint: dupl
+func (CommentPredicates) StatusEq(status string) CommentPredicate {
+	return CommentPredicate{expr: "status = ?", args: []interface{}{status}}
+}
+
+// StatusGt creates predicate "status > ?"
+// nolint: dupl
+func (CommentPredicates) StatusGt(status string) CommentPredicate {
+	return CommentPredicate{expr: "status > ?", args: []interface{}{status}}
+}
+
+// StatusGte creates predicate "status >= ?"
+// nolint: dupl
+func (CommentPredicates) StatusGte(status string) CommentPredicate {
+	return CommentPredicate{expr: "status >= ?", args: []interface{}{status}}
+}
+
+// StatusIn creates predicate "status IN (?)"
+// nolint: dupl
+func (CommentPredicates) StatusIn(status ...string) CommentPredicate {
+	if len(status) == 0 {
+		return CommentPredicate{err: errors.New("must at least pass one status in StatusIn")}
+	}
+	return CommentPredicate{expr: "status IN (?)", args: []interface{}{status}}
+}
+
+// StatusLike creates predicate "status LIKE ?"
+// nolint: dupl
+func (CommentPredicates) StatusLike(status string) CommentPredicate {
+	return CommentPredicate{expr: "status LIKE ?", args: []interface{}{status}}
+}
+
+// StatusLt creates predicate "status < ?"
+// nolint: dupl
+func (CommentPredicates) StatusLt(status string) CommentPredicate {
+	return CommentPredicate{expr: "status < ?", args: []interface{}{status}}
+}
+
+// StatusLte creates predicate "status <= ?"
+// nolint: dupl
+func (CommentPredicates) StatusLte(status string) CommentPredicate {
+	return CommentPredicate{expr: "status <= ?", args: []interface{}{status}}
+}
+
+// StatusNe creates predicate "status != ?"
+// nolint: dupl
+func (CommentPredicates) StatusNe(status string) CommentPredicate {
+	return CommentPredicate{expr: "status != ?", args: []interface{}{status}}
+}
+
+// StatusNotIn creates predicate "status NOT IN (?)"
+// nolint: dupl
+func (CommentPredicates) StatusNotIn(status ...string) CommentPredicate {
+	if len(status) == 0 {
+		return CommentPredicate{err: errors.New("must at least pass one status in StatusNotIn")}
+	}
+	return CommentPredicate{expr: "status NOT IN (?)", args: []interface{}{status}}
+}
+
+// StatusNotlike creates predicate "status NOT LIKE ?"
+// nolint: dupl
+func (CommentPredicates) StatusNotlike(status string) CommentPredicate {
+	return CommentPredicate{expr: "status NOT LIKE ?", args: []interface{}{status}}
+}
+
+// TextEq creates predicate "text = ?"
+// nolint: dupl
+func (CommentPredicates) TextEq(text string) CommentPredicate {
+	return CommentPredicate{expr: "text = ?", args: []interface{}{text}}
+}
+
+// TextGt creates predicate "text > ?"
+// nolint: dupl
+func (CommentPredicates) TextGt(text string) CommentPredicate {
+	return CommentPredicate{expr: "text > ?", args: []interface{}{text}}
+}
+
+// TextGte creates predicate "text >= ?"
+// nolint: dupl
+func (CommentPredicates) TextGte(text string) CommentPredicate {
+	return CommentPredicate{expr: "text >= ?", args: []interface{}{text}}
+}
+
+// TextIn creates predicate "text IN (?)"
+// nolint: dupl
+func (CommentPredicates) TextIn(text ...string) CommentPredicate {
+	if len(text) == 0 {
+		return CommentPredicate{err: errors.New("must at least pass one text in TextIn")}
+	}
+	return CommentPredicate{expr: "text IN (?)", args: []interface{}{text}}
+}
+
+// TextLike creates predicate "text LIKE ?"
+// nolint: dupl
+func (CommentPredicates) TextLike(text string) CommentPredicate {
+	return CommentPredicate{expr: "text LIKE ?", args: []interface{}{text}}
+}
+
+// TextLt creates predicate "text < ?"
+// nolint: dupl
+func (CommentPredicates) TextLt(text string) CommentPredicate {
+	return CommentPredicate{expr: "text < ?", args: []interface{}{text}}
+}
+
+// TextLte creates predicate "text <= ?"
+// nolint: dupl
+func (CommentPredicates) TextLte(text string) CommentPredicate {
+	return CommentPredicate{expr: "text <= ?", args: []interface{}{text}}
+}
+
+// TextNe creates predicate "text != ?"
+// nolint: dupl
+func (CommentPredicates) TextNe(text string) CommentPredicate {
+	return CommentPredicate{expr: "text != ?", args: []interface{}{text}}
+}
+
+// TextNotIn creates predicate "text NOT IN (?)"
+// nolint: dupl
+func (CommentPredicates) TextNotIn(text ...string) CommentPredicate {
+	if len(text) == 0 {
+		return CommentPredicate{err: errors.New("must at least pass one text in TextNotIn")}
+	}
+	return CommentPredicate{expr: "text NOT IN (?)", args: []interface{}{text}}
+}
+
+// TextNotlike creates predicate "text NOT LIKE ?"
+// nolint: dupl
+func (CommentPredicates) TextNotlike(text string) CommentPredicate {
+	return CommentPredicate{expr: "text NOT LIKE ?", args: []interface{}{text}}
+}
+
+// UpdatedAtEq creates predicate "modified = ?"
+// nolint: dupl
+func (CommentPredicates) UpdatedAtEq(updatedAt time.Time) CommentPredicate {
+	return CommentPredicate{expr: "modified = ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtGt creates predicate "modified > ?"
+// nolint: dupl
+func (CommentPredicates) UpdatedAtGt(updatedAt time.Time) CommentPredicate {
+	return CommentPredicate{expr: "modified > ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtGte creates predicate "modified >= ?"
+// nolint: dupl
+func (CommentPredicates) UpdatedAtGte(updatedAt time.Time) CommentPredicate {
+	return CommentPredicate{expr: "modified >= ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtLt creates predicate "modified < ?"
+// nolint: dupl
+func (CommentPredicates) UpdatedAtLt(updatedAt time.Time) CommentPredicate {
+	return CommentPredicate{expr: "modified < ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtLte creates predicate "modified <= ?"
+// nolint: dupl
+func (CommentPredicates) UpdatedAtLte(updatedAt time.Time) CommentPredicate {
+	return CommentPredicate{expr: "modified <= ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtNe creates predicate "modified != ?"
+// nolint: dupl
+func (CommentPredicates) UpdatedAtNe(updatedAt time.Time) CommentPredicate {
+	return CommentPredicate{expr: "modified != ?", args: []interface{}{updatedAt}}
+}
+
+// ===== END of Comment predicates
+
+// ===== BEGIN of query set DocumentQuerySet
+
+// DocumentQuerySet is an queryset type for Document
+type DocumentQuerySet struct {
+	db *gorm.DB
+}
+
+// NewDocumentQuerySet constructs new DocumentQuerySet
+func NewDocumentQuerySet(db *gorm.DB) DocumentQuerySet {
+	return DocumentQuerySet{
+		db: db.Model(&Document{}),
+	}
+}
+
+func (qs DocumentQuerySet) w(db *gorm.DB) DocumentQuerySet {
+	return NewDocumentQuerySet(db)
+}
+
+func (qs DocumentQuerySet) Select(fields ...DocumentDBSchemaField) DocumentQuerySet {
+	names := []string{}
+	for _, f := range fields {
+		names = append(names, f.String())
+	}
+
+	return qs.w(qs.db.Select(strings.Join(names, ",")))
+}
+
+// GroupBy groups rows by fields
+func (qs DocumentQuerySet) GroupBy(fields ...DocumentDBSchemaField) DocumentQuerySet {
+	names := []string{}
+	for _, f := range fields {
+		names = append(names, f.String())
+	}
+
+	return qs.w(qs.db.Group(strings.Join(names, ",")))
+}
+
+// Where filters rows by predicate p, predicates are created by DocumentQ
+// nolint: dupl
+func (qs DocumentQuerySet) Where(p DocumentPredicate) DocumentQuerySet {
+	if p.err != nil {
+		// error is added to copy of db: qs can be reused as a base queryset
+		db := qs.db.Model(&Document{})
+		db.AddError(p.err)
+		return qs.w(db)
+	}
+	if p.expr == "" {
+		return qs
+	}
+
+	return qs.w(qs.db.Where(p.expr, p.args...))
+}
+
+// Not filters rows not matching predicate p
+// nolint: dupl
+func (qs DocumentQuerySet) Not(p DocumentPredicate) DocumentQuerySet {
+	return qs.Where(p.Not())
+}
+
+// Create is an autogenerated method
+// nolint: dupl
+func (o *Document) Create(db *gorm.DB) error {
+	return checkQueryContext(db).Create(o).Error
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (o *Document) Delete(db *gorm.DB) error {
+	return checkQueryContext(db).Delete(o).Error
+}
+
+// All is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) All(ret *[]Document) error {
+	return checkQueryContext(qs.db).Find(ret).Error
+}
+
+// AttrsIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) AttrsIsNotNull() DocumentQuerySet {
+	return qs.w(qs.db.Where("attrs IS NOT NULL"))
+}
+
+// AttrsIsNull is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) AttrsIsNull() DocumentQuerySet {
+	return qs.w(qs.db.Where("attrs IS NULL"))
+}
+
+// AttrsJSONEq filters rows with text value of Attrs at path equal to value,
+// path is a dot-separated list of keys, e.g. "address.city"
+// nolint: dupl
+func (qs DocumentQuerySet) AttrsJSONEq(path string, value string) DocumentQuerySet {
+	return qs.w(whereJSONEq(qs.db, "attrs", path, value))
+}
+
+// Batches calls fn for every batch of at most size rows, batches are
+// fetched by Page: ordered by primary key after queryset orders.
+// It stops on the first error of fn and returns it.
+// nolint: dupl
+func (qs DocumentQuerySet) Batches(size int, fn func([]Document) error) error {
+	cursor := ""
+	for {
+		var batch []Document
+		next, err := qs.Page(cursor, size, &batch)
+		if err != nil {
+			return err
+		}
+
+		if len(batch) != 0 {
+			if err = fn(batch); err != nil {
+				return err
+			}
+		}
+
+		if next == "" {
+			return nil
+		}
+		cursor = next
+	}
+}
+
+// Count is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) Count() (int, error) {
+	var count int
+	db := checkQueryContext(qs.db)
+	if db.Error != nil {
+		return count, db.Error
+	}
+
+	err := db.Count(&count).Error
+	return count, err
+}
+
+// CountByID returns count of rows for every value of ID
+// nolint: dupl
+func (qs DocumentQuerySet) CountByID() (map[uint]int, error) {
+	var rows []struct {
+		Value uint
+		Count int
+	}
+	err := checkQueryContext(qs.db).Select("id AS value, COUNT(*) AS count").Group("id").Scan(&rows).Error
+	ret := make(map[uint]int, len(rows))
+	for _, r := range rows {
+		ret[r.Value] = r.Count
+	}
+	return ret, err
+}
+
+// CreateMany inserts models by multi-row inserts, conditions of queryset
+// aren't used. Generated primary keys are written back to models if
+// database supports it (all except MS SQL for gorm v1).
+// Unlike gorm Create it doesn't call hooks and doesn't read back default
+// values: zero fields having default values are skipped in insert.
+// nolint: dupl
+func (qs DocumentQuerySet) CreateMany(models []Document) error {
+	rows := make([]map[string]interface{}, 0, len(models))
+	for i := range models {
+		rows = append(rows, qs.fieldPtrs(&models[i]))
+	}
+
+	return bulkInsert(checkQueryContext(qs.db), "id", rows)
+}
+
+// CreatedAtBetween filters rows with CreatedAt in range [from, to]
+// nolint: dupl
+func (qs DocumentQuerySet) CreatedAtBetween(from time.Time, to time.Time) DocumentQuerySet {
+	return qs.w(qs.db.Where("created_at BETWEEN ? AND ?", from, to))
+}
+
+// CreatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) CreatedAtEq(createdAt time.Time) DocumentQuerySet {
+	return qs.w(qs.db.Where("created_at = ?", createdAt))
+}
+
+// CreatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) CreatedAtGt(createdAt time.Time) DocumentQuerySet {
+	return qs.w(qs.db.Where("created_at > ?", createdAt))
+}
+
+// CreatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) CreatedAtGte(createdAt time.Time) DocumentQuerySet {
+	return qs.w(qs.db.Where("created_at >= ?", createdAt))
+}
+
+// CreatedAtInRange filters rows with CreatedAt in range [from, to)
+// nolint: dupl
+func (qs DocumentQuerySet) CreatedAtInRange(from time.Time, to time.Time) DocumentQuerySet {
+	return qs.w(qs.db.Where("created_at >= ? AND created_at < ?", from, to))
+}
+
+// CreatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) CreatedAtLt(createdAt time.Time) DocumentQuerySet {
+	return qs.w(qs.db.Where("created_at < ?", createdAt))
+}
+
+// CreatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) CreatedAtLte(createdAt time.Time) DocumentQuerySet {
+	return qs.w(qs.db.Where("created_at <= ?", createdAt))
+}
+
+// CreatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) CreatedAtNe(createdAt time.Time) DocumentQuerySet {
+	return qs.w(qs.db.Where("created_at != ?", createdAt))
+}
+
+// CreatedAtWithin filters rows with CreatedAt not earlier than d ago
+// nolint: dupl
+func (qs DocumentQuerySet) CreatedAtWithin(d time.Duration) DocumentQuerySet {
+	return qs.w(qs.db.Where("created_at >= ?", time.Now().Add(-d)))
+}
+
+// DataIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) DataIsNotNull() DocumentQuerySet {
+	return qs.w(qs.db.Where("data IS NOT NULL"))
+}
+
+// DataIsNull is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) DataIsNull() DocumentQuerySet {
+	return qs.w(qs.db.Where("data IS NULL"))
+}
+
+// DataJSONEq filters rows with text value of Data at path equal to value,
+// path is a dot-separated list of keys, e.g. "address.city"
+// nolint: dupl
+func (qs DocumentQuerySet) DataJSONEq(path string, value string) DocumentQuerySet {
+	return qs.w(whereJSONEq(qs.db, "data", path, value))
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) Delete() error {
+	return checkQueryContext(qs.db).Delete(Document{}).Error
+}
+
+// DeleteNum is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) DeleteNum() (int64, error) {
+	db := checkQueryContext(qs.db).Delete(Document{})
+	return db.RowsAffected, db.Error
+}
+
+// DeleteNumUnscoped is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) DeleteNumUnscoped() (int64, error) {
+	db := checkQueryContext(qs.db).Unscoped().Delete(Document{})
+	return db.RowsAffected, db.Error
+}
+
+// DeletedAtBetween filters rows with DeletedAt in range [from, to]
+// nolint: dupl
+func (qs DocumentQuerySet) DeletedAtBetween(from time.Time, to time.Time) DocumentQuerySet {
+	return qs.w(qs.db.Where("deleted_at BETWEEN ? AND ?", from, to))
+}
+
+// DeletedAtEq is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) DeletedAtEq(deletedAt time.Time) DocumentQuerySet {
+	return qs.w(qs.db.Where("deleted_at = ?", deletedAt))
+}
+
+// DeletedAtGt is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) DeletedAtGt(deletedAt time.Time) DocumentQuerySet {
+	return qs.w(qs.db.Where("deleted_at > ?", deletedAt))
+}
+
+// DeletedAtGte is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) DeletedAtGte(deletedAt time.Time) DocumentQuerySet {
+	return qs.w(qs.db.Where("deleted_at >= ?", deletedAt))
+}
+
+// DeletedAtInRange filters rows with DeletedAt in range [from, to)
+// nolint: dupl
+func (qs DocumentQuerySet) DeletedAtInRange(from time.Time, to time.Time) DocumentQuerySet {
+	return qs.w(qs.db.Where("deleted_at >= ? AND deleted_at < ?", from, to))
+}
+
+// DeletedAtIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) DeletedAtIsNotNull() DocumentQuerySet {
+	return qs.w(qs.db.Where("deleted_at IS NOT NULL"))
+}
+
+// DeletedAtIsNull is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) DeletedAtIsNull() DocumentQuerySet {
+	return qs.w(qs.db.Where("deleted_at IS NULL"))
+}
+
+// DeletedAtLt is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) DeletedAtLt(deletedAt time.Time) DocumentQuerySet {
+	return qs.w(qs.db.Where("deleted_at < ?", deletedAt))
+}
+
+// DeletedAtLte is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) DeletedAtLte(deletedAt time.Time) DocumentQuerySet {
+	return qs.w(qs.db.Where("deleted_at <= ?", deletedAt))
+}
+
+// DeletedAtNe is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) DeletedAtNe(deletedAt time.Time) DocumentQuerySet {
+	return qs.w(qs.db.Where("deleted_at != ?", deletedAt))
+}
+
+// DeletedAtWithin filters rows with DeletedAt not earlier than d ago
+// nolint: dupl
+func (qs DocumentQuerySet) DeletedAtWithin(d time.Duration) DocumentQuerySet {
+	return qs.w(qs.db.Where("deleted_at >= ?", time.Now().Add(-d)))
+}
+
+// GetDB is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) GetDB() *gorm.DB {
+	return qs.db
+}
+
+// GetUpdater is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) GetUpdater() DocumentUpdater {
+	return NewDocumentUpdater(qs.db)
+}
+
+// GroupByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) GroupByCreatedAt() DocumentQuerySet {
+	return qs.w(qs.db.Group("created_at"))
+}
+
+// GroupByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) GroupByDeletedAt() DocumentQuerySet {
+	return qs.w(qs.db.Group("deleted_at"))
+}
+
+// GroupByID is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) GroupByID() DocumentQuerySet {
+	return qs.w(qs.db.Group("id"))
+}
+
+// GroupByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) GroupByUpdatedAt() DocumentQuerySet {
+	return qs.w(qs.db.Group("updated_at"))
+}
+
+// HavingCountEq is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) HavingCountEq(count int) DocumentQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) = ?", count))
+}
+
+// HavingCountGt is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) HavingCountGt(count int) DocumentQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) > ?", count))
+}
+
+// HavingCountGte is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) HavingCountGte(count int) DocumentQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) >= ?", count))
+}
+
+// HavingCountLt is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) HavingCountLt(count int) DocumentQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) < ?", count))
+}
+
+// HavingCountLte is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) HavingCountLte(count int) DocumentQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) <= ?", count))
+}
+
+// HavingCountNe is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) HavingCountNe(count int) DocumentQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) != ?", count))
+}
+
+// IDBetween filters rows with ID in range [from, to]
+// nolint: dupl
+func (qs DocumentQuerySet) IDBetween(from uint, to uint) DocumentQuerySet {
+	return qs.w(qs.db.Where("id BETWEEN ? AND ?", from, to))
+}
+
+// IDEq is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) IDEq(ID uint) DocumentQuerySet {
+	return qs.w(qs.db.Where("id = ?", ID))
+}
+
+// IDGt is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) IDGt(ID uint) DocumentQuerySet {
+	return qs.w(qs.db.Where("id > ?", ID))
+}
+
+// IDGte is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) IDGte(ID uint) DocumentQuerySet {
+	return qs.w(qs.db.Where("id >= ?", ID))
+}
+
+// IDIn is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) IDIn(ID ...uint) DocumentQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("id IN (?)", ID))
+}
+
+// IDInQuery filters rows with ID IN values selected by subquery q
+// nolint: dupl
+func (qs DocumentQuerySet) IDInQuery(q UintSubquery) DocumentQuerySet {
+	return qs.w(qs.db.Where("id IN (?)", q.expr()))
+}
+
+// IDInRange filters rows with ID in range [from, to)
+// nolint: dupl
+func (qs DocumentQuerySet) IDInRange(from uint, to uint) DocumentQuerySet {
+	return qs.w(qs.db.Where("id >= ? AND id < ?", from, to))
+}
+
+// IDLt is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) IDLt(ID uint) DocumentQuerySet {
+	return qs.w(qs.db.Where("id < ?", ID))
+}
+
+// IDLte is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) IDLte(ID uint) DocumentQuerySet {
+	return qs.w(qs.db.Where("id <= ?", ID))
+}
+
+// IDNe is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) IDNe(ID uint) DocumentQuerySet {
+	return qs.w(qs.db.Where("id != ?", ID))
+}
+
+// IDNotIn is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) IDNotIn(ID ...uint) DocumentQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("id NOT IN (?)", ID))
+}
+
+// IDNotInQuery filters rows with ID NOT IN values selected by subquery q
+// nolint: dupl
+func (qs DocumentQuerySet) IDNotInQuery(q UintSubquery) DocumentQuerySet {
+	return qs.w(qs.db.Where("id NOT IN (?)", q.expr()))
+}
+
+// InTx returns copy bound to transaction tx: queries are executed in it.
+// Gorm v1 can't move conditions into another connection, so with gorm v1
+// InTx must be called before any condition is set, otherwise queries fail.
+// Context and preloads are moved into transaction by both gorm versions.
+// nolint: dupl
+func (qs DocumentQuerySet) InTx(tx *gorm.DB) DocumentQuerySet {
+	if !reflect.DeepEqual(qs.db.QueryExpr(), NewDocumentQuerySet(qs.db.New()).db.QueryExpr()) {
+		tx = tx.New()
+		tx.AddError(errors.New("InTx must be called before any condition is set: " +
+			"gorm v1 can't move conditions into transaction"))
+	}
+	tx = txWithSettings(qs.db, tx)
+	return NewDocumentQuerySet(tx)
+}
+
+// Iterate scans rows one by one and calls fn for every row without
+// loading all rows into memory. It stops on the first error of fn and
+// returns it. Preloads aren't applied.
+// nolint: dupl
+func (qs DocumentQuerySet) Iterate(fn func(*Document) error) error {
+	db := checkQueryContext(qs.db)
+	if db.Error != nil {
+		return db.Error // gorm v1 doesn't check it in Rows
+	}
+
+	rows, err := db.Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var o Document
+		if err = db.ScanRows(rows, &o); err != nil {
+			return err
+		}
+		if err = fn(&o); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// Limit is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) Limit(limit int) DocumentQuerySet {
+	return qs.w(qs.db.Limit(limit))
+}
+
+// MaxCreatedAt returns MAX of CreatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs DocumentQuerySet) MaxCreatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := checkQueryContext(qs.db).Select("created_at AS value").Where("created_at IS NOT NULL").
+		Order("created_at DESC", true).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MaxID returns MAX of ID, it's zero value if there are no rows
+// nolint: dupl
+func (qs DocumentQuerySet) MaxID() (uint, error) {
+	var ret uint
+	var res struct {
+		Value *uint
+	}
+	err := checkQueryContext(qs.db).Select("MAX(id) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// MaxUpdatedAt returns MAX of UpdatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs DocumentQuerySet) MaxUpdatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := checkQueryContext(qs.db).Select("updated_at AS value").Where("updated_at IS NOT NULL").
+		Order("updated_at DESC", true).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MinCreatedAt returns MIN of CreatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs DocumentQuerySet) MinCreatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := checkQueryContext(qs.db).Select("created_at AS value").Where("created_at IS NOT NULL").
+		Order("created_at ASC", true).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MinID returns MIN of ID, it's zero value if there are no rows
+// nolint: dupl
+func (qs DocumentQuerySet) MinID() (uint, error) {
+	var ret uint
+	var res struct {
+		Value *uint
+	}
+	err := checkQueryContext(qs.db).Select("MIN(id) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// MinUpdatedAt returns MIN of UpdatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs DocumentQuerySet) MinUpdatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := checkQueryContext(qs.db).Select("updated_at AS value").Where("updated_at IS NOT NULL").
+		Order("updated_at ASC", true).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// Offset is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) Offset(offset int) DocumentQuerySet {
+	return qs.w(qs.db.Offset(offset))
+}
+
+// One is used to retrieve one result. It returns gorm.ErrRecordNotFound
+// if nothing was fetched
+func (qs DocumentQuerySet) One(ret *Document) error {
+	return checkQueryContext(qs.db).First(ret).Error
+}
+
+// OrderAscByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) OrderAscByCreatedAt() DocumentQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("created_at ASC"), "created_at", false, false))
+}
+
+// OrderAscByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) OrderAscByDeletedAt() DocumentQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("deleted_at ASC"), "deleted_at", false, false))
+}
+
+// OrderAscByID is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) OrderAscByID() DocumentQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("id ASC"), "id", false, false))
+}
+
+// OrderAscByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) OrderAscByUpdatedAt() DocumentQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("updated_at ASC"), "updated_at", false, false))
+}
+
+// OrderDescByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) OrderDescByCreatedAt() DocumentQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("created_at DESC"), "created_at", true, false))
+}
+
+// OrderDescByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) OrderDescByDeletedAt() DocumentQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("deleted_at DESC"), "deleted_at", true, false))
+}
+
+// OrderDescByID is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) OrderDescByID() DocumentQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("id DESC"), "id", true, false))
+}
+
+// OrderDescByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) OrderDescByUpdatedAt() DocumentQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("updated_at DESC"), "updated_at", true, false))
+}
+
+// Page fetches at most size rows following cursor into ret, it uses
+// orders set by OrderAscBy/OrderDescBy methods and primary key to break ties.
+// Ordered fields can't be nullable: rows with NULL can't be compared with
+// cursor, so error is returned for them. Empty cursor means the first page. It returns cursor of the next page or
+// empty string if there are no more rows.
+// nolint: dupl
+func (qs DocumentQuerySet) Page(cursor string, size int, ret *[]Document) (string, error) {
+	if size <= 0 {
+		return "", errors.New("page size must be positive")
+	}
+
+	var last Document
+	db, orders, err := pageQuery(qs.db, "", "id", cursor, qs.fieldPtrs(&last))
+	if err != nil {
+		return "", err
+	}
+
+	if err = checkQueryContext(db).Limit(size + 1).Find(ret).Error; err != nil {
+		return "", err
+	}
+	if len(*ret) <= size {
+		return "", nil
+	}
+
+	*ret = (*ret)[:size]
+	return encodePageCursor(orders, qs.fieldPtrs(&(*ret)[size-1]))
+}
+
+// SelectID returns subquery selecting ID of rows of queryset
+// for InQuery and NotInQuery filters
+// nolint: dupl
+func (qs DocumentQuerySet) SelectID() UintSubquery {
+	return UintSubquery{db: qs.db.Select("id")}
+}
+
+// TagsContains is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) TagsContains(tags Tags) DocumentQuerySet {
+	return qs.w(qs.db.Where("tags @> ?", tags))
+}
+
+// TagsEq is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) TagsEq(tags Tags) DocumentQuerySet {
+	return qs.w(qs.db.Where("tags = ?", tags))
+}
+
+// TagsIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) TagsIsNotNull() DocumentQuerySet {
+	return qs.w(qs.db.Where("tags IS NOT NULL"))
+}
+
+// TagsIsNull is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) TagsIsNull() DocumentQuerySet {
+	return qs.w(qs.db.Where("tags IS NULL"))
+}
+
+// TagsNe is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) TagsNe(tags Tags) DocumentQuerySet {
+	return qs.w(qs.db.Where("tags != ?", tags))
+}
+
+// TagsOverlaps is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) TagsOverlaps(tags Tags) DocumentQuerySet {
+	return qs.w(qs.db.Where("tags && ?", tags))
+}
+
+// UpdatedAtBetween filters rows with UpdatedAt in range [from, to]
+// nolint: dupl
+func (qs DocumentQuerySet) UpdatedAtBetween(from time.Time, to time.Time) DocumentQuerySet {
+	return qs.w(qs.db.Where("updated_at BETWEEN ? AND ?", from, to))
+}
+
+// UpdatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) UpdatedAtEq(updatedAt time.Time) DocumentQuerySet {
+	return qs.w(qs.db.Where("updated_at = ?", updatedAt))
+}
+
+// UpdatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) UpdatedAtGt(updatedAt time.Time) DocumentQuerySet {
+	return qs.w(qs.db.Where("updated_at > ?", updatedAt))
+}
+
+// UpdatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) UpdatedAtGte(updatedAt time.Time) DocumentQuerySet {
+	return qs.w(qs.db.Where("updated_at >= ?", updatedAt))
+}
+
+// UpdatedAtInRange filters rows with UpdatedAt in range [from, to)
+// nolint: dupl
+func (qs DocumentQuerySet) UpdatedAtInRange(from time.Time, to time.Time) DocumentQuerySet {
+	return qs.w(qs.db.Where("updated_at >= ? AND updated_at < ?", from, to))
+}
+
+// UpdatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) UpdatedAtLt(updatedAt time.Time) DocumentQuerySet {
+	return qs.w(qs.db.Where("updated_at < ?", updatedAt))
+}
+
+// UpdatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) UpdatedAtLte(updatedAt time.Time) DocumentQuerySet {
+	return qs.w(qs.db.Where("updated_at <= ?", updatedAt))
+}
+
+// UpdatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) UpdatedAtNe(updatedAt time.Time) DocumentQuerySet {
+	return qs.w(qs.db.Where("updated_at != ?", updatedAt))
+}
+
+// UpdatedAtWithin filters rows with UpdatedAt not earlier than d ago
+// nolint: dupl
+func (qs DocumentQuerySet) UpdatedAtWithin(d time.Duration) DocumentQuerySet {
+	return qs.w(qs.db.Where("updated_at >= ?", time.Now().Add(-d)))
+}
+
+// WithContext returns copy executing queries with context ctx.
+// Gorm v1 doesn't support context: its error is checked only before query.
+// nolint: dupl
+func (qs DocumentQuerySet) WithContext(ctx context.Context) DocumentQuerySet {
+	return qs.w(DBWithContext(ctx, qs.db))
+}
+
+// fieldPtrs returns pointers to fields of o by their columns
+// nolint: dupl
+func (qs DocumentQuerySet) fieldPtrs(o *Document) map[string]interface{} {
+	return map[string]interface{}{
+		"id":         &o.ID,
+		"created_at": &o.CreatedAt,
+		"updated_at": &o.UpdatedAt,
+		"deleted_at": &o.DeletedAt,
+		"tags":       &o.Tags,
+		"data":       &o.Data,
+		"attrs":      &jsonColumnValue{o.Attrs},
+	}
+}
+
+// InTx returns copy bound to transaction tx: queries are executed in it.
+// Gorm v1 can't move conditions into another connection, so with gorm v1
+// InTx must be called before any condition is set, otherwise queries fail.
+// Context and preloads are moved into transaction by both gorm versions.
+// nolint: dupl
+func (u DocumentUpdater) InTx(tx *gorm.DB) DocumentUpdater {
+	if !reflect.DeepEqual(u.db.QueryExpr(), u.db.New().Model(&Document{}).QueryExpr()) {
+		tx = tx.New()
+		tx.AddError(errors.New("InTx must be called before any condition is set: " +
+			"gorm v1 can't move conditions into transaction"))
+	}
+	tx = txWithSettings(u.db, tx)
+	u.db = tx.Model(&Document{})
+	return u
+}
+
+// SetAttrs is an autogenerated method
+// nolint: dupl
+func (u DocumentUpdater) SetAttrs(attrs map[string]string) DocumentUpdater {
+	u.fields[string(DocumentDBSchema.Attrs)] = jsonColumnValue{attrs}
+	return u
+}
+
+// SetCreatedAt is an autogenerated method
+// nolint: dupl
+func (u DocumentUpdater) SetCreatedAt(createdAt time.Time) DocumentUpdater {
+	u.fields[string(DocumentDBSchema.CreatedAt)] = createdAt
+	return u
+}
+
+// SetData is an autogenerated method
+// nolint: dupl
+func (u DocumentUpdater) SetData(data json.RawMessage) DocumentUpdater {
+	u.fields[string(DocumentDBSchema.Data)] = data
+	return u
+}
+
+// SetDeletedAt is an autogenerated method
+// nolint: dupl
+func (u DocumentUpdater) SetDeletedAt(deletedAt *time.Time) DocumentUpdater {
+	u.fields[string(DocumentDBSchema.DeletedAt)] = deletedAt
+	return u
+}
+
+// SetID is an autogenerated method
+// nolint: dupl
+func (u DocumentUpdater) SetID(ID uint) DocumentUpdater {
+	u.fields[string(DocumentDBSchema.ID)] = ID
+	return u
+}
+
+// SetTags is an autogenerated method
+// nolint: dupl
+func (u DocumentUpdater) SetTags(tags Tags) DocumentUpdater {
+	u.fields[string(DocumentDBSchema.Tags)] = tags
+	return u
+}
+
+// SetUpdatedAt is an autogenerated method
+// nolint: dupl
+func (u DocumentUpdater) SetUpdatedAt(updatedAt time.Time) DocumentUpdater {
+	u.fields[string(DocumentDBSchema.UpdatedAt)] = updatedAt
+	return u
+}
+
+// Update is an autogenerated method
+// nolint: dupl
+func (u DocumentUpdater) Update() error {
+	return checkQueryContext(u.db).Updates(u.fields).Error
+}
+
+// UpdateNum is an autogenerated method
+// nolint: dupl
+func (u DocumentUpdater) UpdateNum() (int64, error) {
+	db := checkQueryContext(u.db).Updates(u.fields)
+	return db.RowsAffected, db.Error
+}
+
+// WithContext returns copy executing queries with context ctx.
+// Gorm v1 doesn't support context: its error is checked only before query.
+// nolint: dupl
+func (u DocumentUpdater) WithContext(ctx context.Context) DocumentUpdater {
+	u.db = DBWithContext(ctx, u.db)
+	return u
+}
+
+// ===== END of query set DocumentQuerySet
+
+// ===== BEGIN of Document modifiers
+
+// DocumentDBSchemaField describes database schema field. It requires for method 'Update'
+type DocumentDBSchemaField string
+
+// String method returns string representation of field.
+// nolint: dupl
+func (f DocumentDBSchemaField) String() string {
+	return string(f)
+}
+
+// DocumentDBSchema stores db field names of Document
+var DocumentDBSchema = struct {
+	ID        DocumentDBSchemaField
+	CreatedAt DocumentDBSchemaField
+	UpdatedAt DocumentDBSchemaField
+	DeletedAt DocumentDBSchemaField
+	Tags      DocumentDBSchemaField
+	Data      DocumentDBSchemaField
+	Attrs     DocumentDBSchemaField
+}{
+
+	ID:        DocumentDBSchemaField("id"),
+	CreatedAt: DocumentDBSchemaField("created_at"),
+	UpdatedAt: DocumentDBSchemaField("updated_at"),
+	DeletedAt: DocumentDBSchemaField("deleted_at"),
+	Tags:      DocumentDBSchemaField("tags"),
+	Data:      DocumentDBSchemaField("data"),
+	Attrs:     DocumentDBSchemaField("attrs"),
+}
+
+// Update updates Document fields by primary key
+// nolint: dupl
+func (o *Document) Update(db *gorm.DB, fields ...DocumentDBSchemaField) error {
+	dbNameToFieldName := map[string]interface{}{
+		"id":         o.ID,
+		"created_at": o.CreatedAt,
+		"updated_at": o.UpdatedAt,
+		"deleted_at": o.DeletedAt,
+		"tags":       o.Tags,
+		"data":       o.Data,
+		"attrs":      jsonColumnValue{o.Attrs},
+	}
+	u := map[string]interface{}{}
+	for _, f := range fields {
+		fs := f.String()
+		u[fs] = dbNameToFieldName[fs]
+	}
+	if err := checkQueryContext(db).Model(o).Updates(u).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return err
+		}
+
+		return fmt.Errorf("can't update Document %v fields %v: %s",
+			o, fields, err)
+	}
+
+	return nil
+}
+
+// Upsert inserts Document or updates updateFields of existing row
+// if insert violates unique constraint on conflictFields. Row isn't
+// changed if there are no updateFields: any conflict is ignored then if
+// there are no conflictFields. MySQL ignores conflictFields: it checks
+// all unique constraints, other databases require them to update row.
+// nolint: dupl
+func (o *Document) Upsert(db *gorm.DB, conflictFields []DocumentDBSchemaField,
+	updateFields ...DocumentDBSchemaField) error {
+
+	conflictColumns := make([]string, 0, len(conflictFields))
+	for _, f := range conflictFields {
+		conflictColumns = append(conflictColumns, f.String())
+	}
+	updateColumns := make([]string, 0, len(updateFields))
+	for _, f := range updateFields {
+		updateColumns = append(updateColumns, f.String())
+	}
+
+	qs := NewDocumentQuerySet(db)
+	return upsert(checkQueryContext(qs.db), "id", qs.fieldPtrs(o),
+		conflictColumns, updateColumns)
+}
+
+// DocumentUpdater is an Document updates manager
+type DocumentUpdater struct {
+	fields map[string]interface{}
+	db     *gorm.DB
+}
+
+// NewDocumentUpdater creates new Document updater
+// nolint: dupl
+func NewDocumentUpdater(db *gorm.DB) DocumentUpdater {
+	return DocumentUpdater{
+		fields: map[string]interface{}{},
+		db:     db.Model(&Document{}),
+	}
+}
+
+// ===== END of Document modifiers
+
+// ===== BEGIN of Document predicates
+
+// DocumentPredicate is a condition on Document fields for Where and Not
+// methods of DocumentQuerySet: predicates are created by DocumentQ and
+// combined by And, Or and Not. Zero predicate matches all rows.
+type DocumentPredicate struct {
+	expr string
+	args []interface{}
+	err  error
+}
+
+// join combines predicates by operation op, empty predicates are skipped
+// nolint: dupl
+func (p DocumentPredicate) join(op string, preds []DocumentPredicate) DocumentPredicate {
+	for _, q := range preds {
+		if p.err == nil {
+			p.err = q.err
+		}
+		if q.expr == "" {
+			continue
+		}
+		if p.expr == "" {
+			p.expr, p.args = q.expr, q.args
+			continue
+		}
+
+		p.expr = "(" + p.expr + ") " + op + " (" + q.expr + ")"
+		p.args = append(append([]interface{}{}, p.args...), q.args...)
+	}
+
+	return p
+}
+
+// And returns predicate matching rows matched by p and all preds
+// nolint: dupl
+func (p DocumentPredicate) And(preds ...DocumentPredicate) DocumentPredicate {
+	return p.join("AND", preds)
+}
+
+// Or returns predicate matching rows matched by p or any of preds
+// nolint: dupl
+func (p DocumentPredicate) Or(preds ...DocumentPredicate) DocumentPredicate {
+	return p.join("OR", preds)
+}
+
+// Not returns predicate matching rows not matched by p
+// nolint: dupl
+func (p DocumentPredicate) Not() DocumentPredicate {
+	if p.expr != "" {
+		p.expr = "NOT (" + p.expr + ")"
+	}
+
+	return p
+}
+
+// DocumentPredicates creates predicates by Document fields
+type DocumentPredicates struct{}
+
+// DocumentQ creates predicates by Document fields
+var DocumentQ DocumentPredicates
+
+// AttrsIsNotNull creates predicate "attrs IS NOT NULL"
+// nolint: dupl
+func (DocumentPredicates) AttrsIsNotNull() DocumentPredicate {
+	return DocumentPredicate{expr: "attrs IS NOT NULL"}
+}
+
+// AttrsIsNull creates predicate "attrs IS NULL"
+// nolint: dupl
+func (DocumentPredicates) AttrsIsNull() DocumentPredicate {
+	return DocumentPredicate{expr: "attrs IS NULL"}
+}
+
+// CreatedAtEq creates predicate "created_at = ?"
+// nolint: dupl
+func (DocumentPredicates) CreatedAtEq(createdAt time.Time) DocumentPredicate {
+	return DocumentPredicate{expr: "created_at = ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtGt creates predicate "created_at > ?"
+// nolint: dupl
+func (DocumentPredicates) CreatedAtGt(createdAt time.Time) DocumentPredicate {
+	return DocumentPredicate{expr: "created_at > ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtGte creates predicate "created_at >= ?"
+// nolint: dupl
+func (DocumentPredicates) CreatedAtGte(createdAt time.Time) DocumentPredicate {
+	return DocumentPredicate{expr: "created_at >= ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtLt creates predicate "created_at < ?"
+// nolint: dupl
+func (DocumentPredicates) CreatedAtLt(createdAt time.Time) DocumentPredicate {
+	return DocumentPredicate{expr: "created_at < ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtLte creates predicate "created_at <= ?"
+// nolint: dupl
+func (DocumentPredicates) CreatedAtLte(createdAt time.Time) DocumentPredicate {
+	return DocumentPredicate{expr: "created_at <= ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtNe creates predicate "created_at != ?"
+// nolint: dupl
+func (DocumentPredicates) CreatedAtNe(createdAt time.Time) DocumentPredicate {
+	return DocumentPredicate{expr: "created_at != ?", args: []interface{}{createdAt}}
+}
+
+// DataIsNotNull creates predicate "data IS NOT NULL"
+// nolint: dupl
+func (DocumentPredicates) DataIsNotNull() DocumentPredicate {
+	return DocumentPredicate{expr: "data IS NOT NULL"}
+}
+
+// DataIsNull creates predicate "data IS NULL"
+// nolint: dupl
+func (DocumentPredicates) DataIsNull() DocumentPredicate {
+	return DocumentPredicate{expr: "data IS NULL"}
+}
+
+// DeletedAtEq creates predicate "deleted_at = ?"
+// nolint: dupl
+func (DocumentPredicates) DeletedAtEq(deletedAt time.Time) DocumentPredicate {
+	return DocumentPredicate{expr: "deleted_at = ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtGt creates predicate "deleted_at > ?"
+// nolint: dupl
+func (DocumentPredicates) DeletedAtGt(deletedAt time.Time) DocumentPredicate {
+	return DocumentPredicate{expr: "deleted_at > ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtGte creates predicate "deleted_at >= ?"
+// nolint: dupl
+func (DocumentPredicates) DeletedAtGte(deletedAt time.Time) DocumentPredicate {
+	return DocumentPredicate{expr: "deleted_at >= ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtIsNotNull creates predicate "deleted_at IS NOT NULL"
+// nolint: dupl
+func (DocumentPredicates) DeletedAtIsNotNull() DocumentPredicate {
+	return DocumentPredicate{expr: "deleted_at IS NOT NULL"}
+}
+
+// DeletedAtIsNull creates predicate "deleted_at IS NULL"
+// nolint: dupl
+func (DocumentPredicates) DeletedAtIsNull() DocumentPredicate {
+	return DocumentPredicate{expr: "deleted_at IS NULL"}
+}
+
+// DeletedAtLt creates predicate "deleted_at < ?"
+// nolint: dupl
+func (DocumentPredicates) DeletedAtLt(deletedAt time.Time) DocumentPredicate {
+	return DocumentPredicate{expr: "deleted_at < ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtLte creates predicate "deleted_at <= ?"
+// nolint: dupl
+func (DocumentPredicates) DeletedAtLte(deletedAt time.Time) DocumentPredicate {
+	return DocumentPredicate{expr: "deleted_at <= ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtNe creates predicate "deleted_at != ?"
+// nolint: dupl
+func (DocumentPredicates) DeletedAtNe(deletedAt time.Time) DocumentPredicate {
+	return DocumentPredicate{expr: "deleted_at != ?", args: []interface{}{deletedAt}}
+}
+
+// IDEq creates predicate "id = ?"
+// nolint: dupl
+func (DocumentPredicates) IDEq(ID uint) DocumentPredicate {
+	return DocumentPredicate{expr: "id = ?", args: []interface{}{ID}}
+}
+
+// IDGt creates predicate "id > ?"
+// nolint: dupl
+func (DocumentPredicates) IDGt(ID uint) DocumentPredicate {
+	return DocumentPredicate{expr: "id > ?", args: []interface{}{ID}}
+}
+
+// IDGte creates predicate "id >= ?"
+// nolint: dupl
+func (DocumentPredicates) IDGte(ID uint) DocumentPredicate {
+	return DocumentPredicate{expr: "id >= ?", args: []interface{}{ID}}
+}
+
+// IDIn creates predicate "id IN (?)"
+// nolint: dupl
+func (DocumentPredicates) IDIn(ID ...uint) DocumentPredicate {
+	if len(ID) == 0 {
+		return DocumentPredicate{err: errors.New("must at least pass one ID in IDIn")}
+	}
+	return DocumentPredicate{expr: "id IN (?)", args: []interface{}{ID}}
+}
+
+// IDLt creates predicate "id < ?"
+// nolint: dupl
+func (DocumentPredicates) IDLt(ID uint) DocumentPredicate {
+	return DocumentPredicate{expr: "id < ?", args: []interface{}{ID}}
+}
+
+// IDLte creates predicate "id <= ?"
+// nolint: dupl
+func (DocumentPredicates) IDLte(ID uint) DocumentPredicate {
+	return DocumentPredicate{expr: "id <= ?", args: []interface{}{ID}}
+}
+
+// IDNe creates predicate "id != ?"
+// nolint: dupl
+func (DocumentPredicates) IDNe(ID uint) DocumentPredicate {
+	return DocumentPredicate{expr: "id != ?", args: []interface{}{ID}}
+}
+
+// IDNotIn creates predicate "id NOT IN (?)"
+// nolint: dupl
+func (DocumentPredicates) IDNotIn(ID ...uint) DocumentPredicate {
+	if len(ID) == 0 {
+		return DocumentPredicate{err: errors.New("must at least pass one ID in IDNotIn")}
+	}
+	return DocumentPredicate{expr: "id NOT IN (?)", args: []interface{}{ID}}
+}
+
+// TagsContains creates predicate "tags @> ?"
+// nolint: dupl
+func (DocumentPredicates) TagsContains(tags Tags) DocumentPredicate {
+	return DocumentPredicate{expr: "tags @> ?", args: []interface{}{tags}}
+}
+
+// TagsEq creates predicate "tags = ?"
+// nolint: dupl
+func (DocumentPredicates) TagsEq(tags Tags) DocumentPredicate {
+	return DocumentPredicate{expr: "tags = ?", args: []interface{}{tags}}
+}
+
+// TagsIsNotNull creates predicate "tags IS NOT NULL"
+// nolint: dupl
+func (DocumentPredicates) TagsIsNotNull() DocumentPredicate {
+	return DocumentPredicate{expr: "tags IS NOT NULL"}
+}
+
+// TagsIsNull creates predicate "tags IS NULL"
+// nolint: dupl
+func (DocumentPredicates) TagsIsNull() DocumentPredicate {
+	return DocumentPredicate{expr: "tags IS NULL"}
+}
+
+// TagsNe creates predicate "tags != ?"
+// nolint: dupl
+func (DocumentPredicates) TagsNe(tags Tags) DocumentPredicate {
+	return DocumentPredicate{expr: "tags != ?", args: []interface{}{tags}}
+}
+
+// TagsOverlaps creates predicate "tags && ?"
+// nolint: dupl
+func (DocumentPredicates) TagsOverlaps(tags Tags) DocumentPredicate {
+	return DocumentPredicate{expr: "tags && ?", args: []interface{}{tags}}
+}
+
+// UpdatedAtEq creates predicate "updated_at = ?"
+// nolint: dupl
+func (DocumentPredicates) UpdatedAtEq(updatedAt time.Time) DocumentPredicate {
+	return DocumentPredicate{expr: "updated_at = ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtGt creates predicate "updated_at > ?"
+// nolint: dupl
+func (DocumentPredicates) UpdatedAtGt(updatedAt time.Time) DocumentPredicate {
+	return DocumentPredicate{expr: "updated_at > ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtGte creates predicate "updated_at >= ?"
+// nolint: dupl
+func (DocumentPredicates) UpdatedAtGte(updatedAt time.Time) DocumentPredicate {
+	return DocumentPredicate{expr: "updated_at >= ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtLt creates predicate "updated_at < ?"
+// nolint: dupl
+func (DocumentPredicates) UpdatedAtLt(updatedAt time.Time) DocumentPredicate {
+	return DocumentPredicate{expr: "updated_at < ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtLte creates predicate "updated_at <= ?"
+// nolint: dupl
+func (DocumentPredicates) UpdatedAtLte(updatedAt time.Time) DocumentPredicate {
+	return DocumentPredicate{expr: "updated_at <= ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtNe creates predicate "updated_at != ?"
+// nolint: dupl
+func (DocumentPredicates) UpdatedAtNe(updatedAt time.Time) DocumentPredicate {
+	return DocumentPredicate{expr: "updated_at != ?", args: []interface{}{updatedAt}}
+}
+
+// ===== END of Document predicates
+
+// ===== BEGIN of query set EventQuerySet
+
+// EventQuerySet is an queryset type for Event
+type EventQuerySet struct {
+	db *gorm.DB
+}
+
+// NewEventQuerySet constructs new EventQuerySet
+func NewEventQuerySet(db *gorm.DB) EventQuerySet {
+	return EventQuerySet{
+		db: db.Model(&Event{}),
+	}
+}
+
+func (qs EventQuerySet) w(db *gorm.DB) EventQuerySet {
+	return NewEventQuerySet(db)
+}
+
+func (qs EventQuerySet) Select(fields ...EventDBSchemaField) EventQuerySet {
+	names := []string{}
+	for _, f := range fields {
+		names = append(names, f.String())
+	}
+
+	return qs.w(qs.db.Select(strings.Join(names, ",")))
+}
+
+// GroupBy groups rows by fields
+func (qs EventQuerySet) GroupBy(fields ...EventDBSchemaField) EventQuerySet {
+	names := []string{}
+	for _, f := range fields {
+		names = append(names, f.String())
+	}
+
+	return qs.w(qs.db.Group(strings.Join(names, ",")))
+}
+
+// Where filters rows by predicate p, predicates are created by EventQ
+// nolint: dupl
+func (qs EventQuerySet) Where(p EventPredicate) EventQuerySet {
+	if p.err != nil {
+		// error is added to copy of db: qs can be reused as a base queryset
+		db := qs.db.Model(&Event{})
+		db.AddError(p.err)
+		return qs.w(db)
+	}
+	if p.expr == "" {
+		return qs
+	}
+
+	return qs.w(qs.db.Where(p.expr, p.args...))
+}
+
+// Not filters rows not matching predicate p
+// nolint: dupl
+func (qs EventQuerySet) Not(p EventPredicate) EventQuerySet {
+	return qs.Where(p.Not())
+}
+
+// Create is an autogenerated method
+// nolint: dupl
+func (o *Event) Create(db *gorm.DB) error {
+	return checkQueryContext(db).Create(o).Error
+}
+
+// All is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) All(ret *[]Event) error {
+	return checkQueryContext(qs.db).Find(ret).Error
+}
+
+// Count is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) Count() (int, error) {
+	var count int
+	db := checkQueryContext(qs.db)
+	if db.Error != nil {
+		return count, db.Error
+	}
+
+	err := db.Count(&count).Error
+	return count, err
+}
+
+// CountByID returns count of rows for every value of ID
+// nolint: dupl
+func (qs EventQuerySet) CountByID() (map[uint]int, error) {
+	var rows []struct {
+		Value uint
+		Count int
+	}
+	err := checkQueryContext(qs.db).Select("id AS value, COUNT(*) AS count").Group("id").Scan(&rows).Error
+	ret := make(map[uint]int, len(rows))
+	for _, r := range rows {
+		ret[r.Value] = r.Count
+	}
+	return ret, err
+}
+
+// CountByName returns count of rows for every value of Name
+// nolint: dupl
+func (qs EventQuerySet) CountByName() (map[string]int, error) {
+	var rows []struct {
+		Value string
+		Count int
+	}
+	err := checkQueryContext(qs.db).Select("name AS value, COUNT(*) AS count").Group("name").Scan(&rows).Error
+	ret := make(map[string]int, len(rows))
+	for _, r := range rows {
+		ret[r.Value] = r.Count
+	}
+	return ret, err
+}
+
+// CreateMany inserts models by multi-row inserts, conditions of queryset
+// aren't used. Generated primary keys are written back to models if
+// database supports it (all except MS SQL for gorm v1).
+// Unlike gorm Create it doesn't call hooks and doesn't read back default
+// values: zero fields having default values are skipped in insert.
+// nolint: dupl
+func (qs EventQuerySet) CreateMany(models []Event) error {
+	rows := make([]map[string]interface{}, 0, len(models))
+	for i := range models {
+		rows = append(rows, qs.fieldPtrs(&models[i]))
+	}
+
+	return bulkInsert(checkQueryContext(qs.db), "id", rows)
+}
+
+// CreatedAtBetween filters rows with CreatedAt in range [from, to]
+// nolint: dupl
+func (qs EventQuerySet) CreatedAtBetween(from time.Time, to time.Time) EventQuerySet {
+	return qs.w(qs.db.Where("created_at BETWEEN ? AND ?", from, to))
+}
+
+// CreatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) CreatedAtEq(createdAt time.Time) EventQuerySet {
+	return qs.w(qs.db.Where("created_at = ?", createdAt))
+}
+
+// CreatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) CreatedAtGt(createdAt time.Time) EventQuerySet {
+	return qs.w(qs.db.Where("created_at > ?", createdAt))
+}
+
+// CreatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) CreatedAtGte(createdAt time.Time) EventQuerySet {
+	return qs.w(qs.db.Where("created_at >= ?", createdAt))
+}
+
+// CreatedAtInRange filters rows with CreatedAt in range [from, to)
+// nolint: dupl
+func (qs EventQuerySet) CreatedAtInRange(from time.Time, to time.Time) EventQuerySet {
+	return qs.w(qs.db.Where("created_at >= ? AND created_at < ?", from, to))
+}
+
+// CreatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) CreatedAtLt(createdAt time.Time) EventQuerySet {
+	return qs.w(qs.db.Where("created_at < ?", createdAt))
+}
+
+// CreatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) CreatedAtLte(createdAt time.Time) EventQuerySet {
+	return qs.w(qs.db.Where("created_at <= ?", createdAt))
+}
+
+// CreatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) CreatedAtNe(createdAt time.Time) EventQuerySet {
+	return qs.w(qs.db.Where("created_at != ?", createdAt))
+}
+
+// CreatedAtWithin filters rows with CreatedAt not earlier than d ago
+// nolint: dupl
+func (qs EventQuerySet) CreatedAtWithin(d time.Duration) EventQuerySet {
+	return qs.w(qs.db.Where("created_at >= ?", time.Now().Add(-d)))
+}
+
+// DeleteNum is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) DeleteNum() (int64, error) {
+	db := checkQueryContext(qs.db).Delete(Event{})
+	return db.RowsAffected, db.Error
+}
+
+// DeletedAtBetween filters rows with DeletedAt in range [from, to]
+// nolint: dupl
+func (qs EventQuerySet) DeletedAtBetween(from time.Time, to time.Time) EventQuerySet {
+	return qs.w(qs.db.Where("deleted_at BETWEEN ? AND ?", from, to))
+}
+
+// DeletedAtEq is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) DeletedAtEq(deletedAt time.Time) EventQuerySet {
+	return qs.w(qs.db.Where("deleted_at = ?", deletedAt))
+}
+
+// DeletedAtGt is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) DeletedAtGt(deletedAt time.Time) EventQuerySet {
+	return qs.w(qs.db.Where("deleted_at > ?", deletedAt))
+}
+
+// DeletedAtGte is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) DeletedAtGte(deletedAt time.Time) EventQuerySet {
+	return qs.w(qs.db.Where("deleted_at >= ?", deletedAt))
+}
+
+// DeletedAtInRange filters rows with DeletedAt in range [from, to)
+// nolint: dupl
+func (qs EventQuerySet) DeletedAtInRange(from time.Time, to time.Time) EventQuerySet {
+	return qs.w(qs.db.Where("deleted_at >= ? AND deleted_at < ?", from, to))
+}
+
+// DeletedAtIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) DeletedAtIsNotNull() EventQuerySet {
+	return qs.w(qs.db.Where("deleted_at IS NOT NULL"))
+}
+
+// DeletedAtIsNull is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) DeletedAtIsNull() EventQuerySet {
+	return qs.w(qs.db.Where("deleted_at IS NULL"))
+}
+
+// DeletedAtLt is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) DeletedAtLt(deletedAt time.Time) EventQuerySet {
+	return qs.w(qs.db.Where("deleted_at < ?", deletedAt))
+}
+
+// DeletedAtLte is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) DeletedAtLte(deletedAt time.Time) EventQuerySet {
+	return qs.w(qs.db.Where("deleted_at <= ?", deletedAt))
+}
+
+// DeletedAtNe is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) DeletedAtNe(deletedAt time.Time) EventQuerySet {
+	return qs.w(qs.db.Where("deleted_at != ?", deletedAt))
+}
+
+// DeletedAtWithin filters rows with DeletedAt not earlier than d ago
+// nolint: dupl
+func (qs EventQuerySet) DeletedAtWithin(d time.Duration) EventQuerySet {
+	return qs.w(qs.db.Where("deleted_at >= ?", time.Now().Add(-d)))
+}
+
+// GetDB is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) GetDB() *gorm.DB {
+	return qs.db
+}
+
+// GetUpdater is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) GetUpdater() EventUpdater {
+	return NewEventUpdater(qs.db)
+}
+
+// GroupByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) GroupByCreatedAt() EventQuerySet {
+	return qs.w(qs.db.Group("created_at"))
+}
+
+// GroupByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) GroupByDeletedAt() EventQuerySet {
+	return qs.w(qs.db.Group("deleted_at"))
+}
+
+// GroupByID is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) GroupByID() EventQuerySet {
+	return qs.w(qs.db.Group("id"))
+}
+
+// GroupByName is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) GroupByName() EventQuerySet {
+	return qs.w(qs.db.Group("name"))
+}
+
+// GroupByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) GroupByUpdatedAt() EventQuerySet {
+	return qs.w(qs.db.Group("updated_at"))
+}
+
+// HavingCountEq is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) HavingCountEq(count int) EventQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) = ?", count))
+}
+
+// HavingCountGt is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) HavingCountGt(count int) EventQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) > ?", count))
+}
+
+// HavingCountGte is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) HavingCountGte(count int) EventQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) >= ?", count))
+}
+
+// HavingCountLt is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) HavingCountLt(count int) EventQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) < ?", count))
+}
+
+// HavingCountLte is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) HavingCountLte(count int) EventQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) <= ?", count))
+}
+
+// HavingCountNe is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) HavingCountNe(count int) EventQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) != ?", count))
+}
+
+// IDBetween filters rows with ID in range [from, to]
+// nolint: dupl
+func (qs EventQuerySet) IDBetween(from uint, to uint) EventQuerySet {
+	return qs.w(qs.db.Where("id BETWEEN ? AND ?", from, to))
+}
+
+// IDEq is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) IDEq(ID uint) EventQuerySet {
+	return qs.w(qs.db.Where("id = ?", ID))
+}
+
+// IDGt is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) IDGt(ID uint) EventQuerySet {
+	return qs.w(qs.db.Where("id > ?", ID))
+}
+
+// IDGte is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) IDGte(ID uint) EventQuerySet {
+	return qs.w(qs.db.Where("id >= ?", ID))
+}
+
+// IDIn is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) IDIn(ID ...uint) EventQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("id IN (?)", ID))
+}
+
+// IDInQuery filters rows with ID IN values selected by subquery q
+// nolint: dupl
+func (qs EventQuerySet) IDInQuery(q UintSubquery) EventQuerySet {
+	return qs.w(qs.db.Where("id IN (?)", q.expr()))
+}
+
+// IDInRange filters rows with ID in range [from, to)
+// nolint: dupl
+func (qs EventQuerySet) IDInRange(from uint, to uint) EventQuerySet {
+	return qs.w(qs.db.Where("id >= ? AND id < ?", from, to))
+}
+
+// IDLt is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) IDLt(ID uint) EventQuerySet {
+	return qs.w(qs.db.Where("id < ?", ID))
+}
+
+// IDLte is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) IDLte(ID uint) EventQuerySet {
+	return qs.w(qs.db.Where("id <= ?", ID))
+}
+
+// IDNe is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) IDNe(ID uint) EventQuerySet {
+	return qs.w(qs.db.Where("id != ?", ID))
+}
+
+// IDNotIn is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) IDNotIn(ID ...uint) EventQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("id NOT IN (?)", ID))
+}
+
+// IDNotInQuery filters rows with ID NOT IN values selected by subquery q
+// nolint: dupl
+func (qs EventQuerySet) IDNotInQuery(q UintSubquery) EventQuerySet {
+	return qs.w(qs.db.Where("id NOT IN (?)", q.expr()))
+}
+
+// InTx returns copy bound to transaction tx: queries are executed in it.
+// Gorm v1 can't move conditions into another connection, so with gorm v1
+// InTx must be called before any condition is set, otherwise queries fail.
+// Context and preloads are moved into transaction by both gorm versions.
+// nolint: dupl
+func (qs EventQuerySet) InTx(tx *gorm.DB) EventQuerySet {
+	if !reflect.DeepEqual(qs.db.QueryExpr(), NewEventQuerySet(qs.db.New()).db.QueryExpr()) {
+		tx = tx.New()
+		tx.AddError(errors.New("InTx must be called before any condition is set: " +
+			"gorm v1 can't move conditions into transaction"))
+	}
+	tx = txWithSettings(qs.db, tx)
+	return NewEventQuerySet(tx)
+}
+
+// Iterate scans rows one by one and calls fn for every row without
+// loading all rows into memory. It stops on the first error of fn and
+// returns it. Preloads aren't applied.
+// nolint: dupl
+func (qs EventQuerySet) Iterate(fn func(*Event) error) error {
+	db := checkQueryContext(qs.db)
+	if db.Error != nil {
+		return db.Error // gorm v1 doesn't check it in Rows
+	}
+
+	rows, err := db.Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var o Event
+		if err = db.ScanRows(rows, &o); err != nil {
+			return err
+		}
+		if err = fn(&o); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// Limit is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) Limit(limit int) EventQuerySet {
+	return qs.w(qs.db.Limit(limit))
+}
+
+// MaxCreatedAt returns MAX of CreatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs EventQuerySet) MaxCreatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := checkQueryContext(qs.db).Select("created_at AS value").Where("created_at IS NOT NULL").
+		Order("created_at DESC", true).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MaxID returns MAX of ID, it's zero value if there are no rows
+// nolint: dupl
+func (qs EventQuerySet) MaxID() (uint, error) {
+	var ret uint
+	var res struct {
+		Value *uint
+	}
+	err := checkQueryContext(qs.db).Select("MAX(id) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// MaxUpdatedAt returns MAX of UpdatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs EventQuerySet) MaxUpdatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := checkQueryContext(qs.db).Select("updated_at AS value").Where("updated_at IS NOT NULL").
+		Order("updated_at DESC", true).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MinCreatedAt returns MIN of CreatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs EventQuerySet) MinCreatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := checkQueryContext(qs.db).Select("created_at AS value").Where("created_at IS NOT NULL").
+		Order("created_at ASC", true).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MinID returns MIN of ID, it's zero value if there are no rows
+// nolint: dupl
+func (qs EventQuerySet) MinID() (uint, error) {
+	var ret uint
+	var res struct {
+		Value *uint
+	}
+	err := checkQueryContext(qs.db).Select("MIN(id) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// MinUpdatedAt returns MIN of UpdatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs EventQuerySet) MinUpdatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := checkQueryContext(qs.db).Select("updated_at AS value").Where("updated_at IS NOT NULL").
+		Order("updated_at ASC", true).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// NameEndsWith filters rows with Name ending with the argument
+// nolint: dupl
+func (qs EventQuerySet) NameEndsWith(name string) EventQuerySet {
+	return qs.w(whereLike(qs.db, "name", "%", name, "", false))
+}
+
+// NameEq is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) NameEq(name string) EventQuerySet {
+	return qs.w(qs.db.Where("name = ?", name))
+}
+
+// NameGt is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) NameGt(name string) EventQuerySet {
+	return qs.w(qs.db.Where("name > ?", name))
+}
+
+// NameGte is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) NameGte(name string) EventQuerySet {
+	return qs.w(qs.db.Where("name >= ?", name))
+}
+
+// NameIContains filters rows with Name containing the argument, case is ignored
+// nolint: dupl
+func (qs EventQuerySet) NameIContains(name string) EventQuerySet {
+	return qs.w(whereLike(qs.db, "name", "%", name, "%", true))
+}
+
+// NameIEq filters rows with Name equal to the argument, case is ignored
+// nolint: dupl
+func (qs EventQuerySet) NameIEq(name string) EventQuerySet {
+	return qs.w(whereLike(qs.db, "name", "", name, "", true))
+}
+
+// NameIn is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) NameIn(name ...string) EventQuerySet {
+	if len(name) == 0 {
+		qs.db.AddError(errors.New("must at least pass one name in NameIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("name IN (?)", name))
+}
+
+// NameInQuery filters rows with Name IN values selected by subquery q
+// nolint: dupl
+func (qs EventQuerySet) NameInQuery(q StringSubquery) EventQuerySet {
+	return qs.w(qs.db.Where("name IN (?)", q.expr()))
+}
+
+// NameLike is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) NameLike(name string) EventQuerySet {
+	return qs.w(qs.db.Where("name LIKE ?", name))
+}
+
+// NameLt is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) NameLt(name string) EventQuerySet {
+	return qs.w(qs.db.Where("name < ?", name))
+}
+
+// NameLte is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) NameLte(name string) EventQuerySet {
+	return qs.w(qs.db.Where("name <= ?", name))
+}
+
+// NameNe is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) NameNe(name string) EventQuerySet {
+	return qs.w(qs.db.Where("name != ?", name))
+}
+
+// NameNotIn is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) NameNotIn(name ...string) EventQuerySet {
+	if len(name) == 0 {
+		qs.db.AddError(errors.New("must at least pass one name in NameNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("name NOT IN (?)", name))
+}
+
+// NameNotInQuery filters rows with Name NOT IN values selected by subquery q
+// nolint: dupl
+func (qs EventQuerySet) NameNotInQuery(q StringSubquery) EventQuerySet {
+	return qs.w(qs.db.Where("name NOT IN (?)", q.expr()))
+}
+
+// NameNotlike is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) NameNotlike(name string) EventQuerySet {
+	return qs.w(qs.db.Where("name NOT LIKE ?", name))
+}
+
+// NameStartsWith filters rows with Name starting with the argument
+// nolint: dupl
+func (qs EventQuerySet) NameStartsWith(name string) EventQuerySet {
+	return qs.w(whereLike(qs.db, "name", "", name, "%", false))
+}
+
+// Offset is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) Offset(offset int) EventQuerySet {
+	return qs.w(qs.db.Offset(offset))
+}
+
+// One is used to retrieve one result. It returns gorm.ErrRecordNotFound
+// if nothing was fetched
+func (qs EventQuerySet) One(ret *Event) error {
+	return checkQueryContext(qs.db).First(ret).Error
+}
+
+// OrderAscByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) OrderAscByCreatedAt() EventQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("created_at ASC"), "created_at", false, false))
+}
+
+// OrderAscByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) OrderAscByDeletedAt() EventQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("deleted_at ASC"), "deleted_at", false, false))
+}
+
+// OrderAscByID is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) OrderAscByID() EventQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("id ASC"), "id", false, false))
+}
+
+// OrderAscByName is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) OrderAscByName() EventQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("name ASC"), "name", false, false))
+}
+
+// OrderAscByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) OrderAscByUpdatedAt() EventQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("updated_at ASC"), "updated_at", false, false))
+}
+
+// OrderDescByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) OrderDescByCreatedAt() EventQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("created_at DESC"), "created_at", true, false))
+}
+
+// OrderDescByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) OrderDescByDeletedAt() EventQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("deleted_at DESC"), "deleted_at", true, false))
+}
+
+// OrderDescByID is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) OrderDescByID() EventQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("id DESC"), "id", true, false))
+}
+
+// OrderDescByName is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) OrderDescByName() EventQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("name DESC"), "name", true, false))
+}
+
+// OrderDescByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) OrderDescByUpdatedAt() EventQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("updated_at DESC"), "updated_at", true, false))
+}
+
+// SelectID returns subquery selecting ID of rows of queryset
+// for InQuery and NotInQuery filters
+// nolint: dupl
+func (qs EventQuerySet) SelectID() UintSubquery {
+	return UintSubquery{db: qs.db.Select("id")}
+}
+
+// SelectName returns subquery selecting Name of rows of queryset
+// for InQuery and NotInQuery filters
+// nolint: dupl
+func (qs EventQuerySet) SelectName() StringSubquery {
+	return StringSubquery{db: qs.db.Select("name")}
+}
+
+// UpdatedAtBetween filters rows with UpdatedAt in range [from, to]
+// nolint: dupl
+func (qs EventQuerySet) UpdatedAtBetween(from time.Time, to time.Time) EventQuerySet {
+	return qs.w(qs.db.Where("updated_at BETWEEN ? AND ?", from, to))
+}
+
+// UpdatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) UpdatedAtEq(updatedAt time.Time) EventQuerySet {
+	return qs.w(qs.db.Where("updated_at = ?", updatedAt))
+}
+
+// UpdatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) UpdatedAtGt(updatedAt time.Time) EventQuerySet {
+	return qs.w(qs.db.Where("updated_at > ?", updatedAt))
+}
+
+// UpdatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) UpdatedAtGte(updatedAt time.Time) EventQuerySet {
+	return qs.w(qs.db.Where("updated_at >= ?", updatedAt))
+}
+
+// UpdatedAtInRange filters rows with UpdatedAt in range [from, to)
+// nolint: dupl
+func (qs EventQuerySet) UpdatedAtInRange(from time.Time, to time.Time) EventQuerySet {
+	return qs.w(qs.db.Where("updated_at >= ? AND updated_at < ?", from, to))
+}
+
+// UpdatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) UpdatedAtLt(updatedAt time.Time) EventQuerySet {
+	return qs.w(qs.db.Where("updated_at < ?", updatedAt))
+}
+
+// UpdatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) UpdatedAtLte(updatedAt time.Time) EventQuerySet {
+	return qs.w(qs.db.Where("updated_at <= ?", updatedAt))
+}
+
+// UpdatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs EventQuerySet) UpdatedAtNe(updatedAt time.Time) EventQuerySet {
+	return qs.w(qs.db.Where("updated_at != ?", updatedAt))
+}
+
+// UpdatedAtWithin filters rows with UpdatedAt not earlier than d ago
+// nolint: dupl
+func (qs EventQuerySet) UpdatedAtWithin(d time.Duration) EventQuerySet {
+	return qs.w(qs.db.Where("updated_at >= ?", time.Now().Add(-d)))
+}
+
+// WithContext returns copy executing queries with context ctx.
+// Gorm v1 doesn't support context: its error is checked only before query.
+// nolint: dupl
+func (qs EventQuerySet) WithContext(ctx context.Context) EventQuerySet {
+	return qs.w(DBWithContext(ctx, qs.db))
+}
+
+// fieldPtrs returns pointers to fields of o by their columns
+// nolint: dupl
+func (qs EventQuerySet) fieldPtrs(o *Event) map[string]interface{} {
+	return map[string]interface{}{
+		"id":         &o.ID,
+		"created_at": &o.CreatedAt,
+		"updated_at": &o.UpdatedAt,
+		"deleted_at": &o.DeletedAt,
+		"name":       &o.Name,
+	}
+}
+
+// InTx returns copy bound to transaction tx: queries are executed in it.
+// Gorm v1 can't move conditions into another connection, so with gorm v1
+// InTx must be called before any condition is set, otherwise queries fail.
+// Context and preloads are moved into transaction by both gorm versions.
+// nolint: dupl
+func (u EventUpdater) InTx(tx *gorm.DB) EventUpdater {
+	if !reflect.DeepEqual(u.db.QueryExpr(), u.db.New().Model(&Event{}).QueryExpr()) {
+		tx = tx.New()
+		tx.AddError(errors.New("InTx must be called before any condition is set: " +
+			"gorm v1 can't move conditions into transaction"))
+	}
+	tx = txWithSettings(u.db, tx)
+	u.db = tx.Model(&Event{})
+	return u
+}
+
+// SetCreatedAt is an autogenerated method
+// nolint: dupl
+func (u EventUpdater) SetCreatedAt(createdAt time.Time) EventUpdater {
+	u.fields[string(EventDBSchema.CreatedAt)] = createdAt
+	return u
+}
+
+// SetDeletedAt is an autogenerated method
+// nolint: dupl
+func (u EventUpdater) SetDeletedAt(deletedAt *time.Time) EventUpdater {
+	u.fields[string(EventDBSchema.DeletedAt)] = deletedAt
+	return u
+}
+
+// SetID is an autogenerated method
+// nolint: dupl
+func (u EventUpdater) SetID(ID uint) EventUpdater {
+	u.fields[string(EventDBSchema.ID)] = ID
+	return u
+}
+
+// SetName is an autogenerated method
+// nolint: dupl
+func (u EventUpdater) SetName(name string) EventUpdater {
+	u.fields[string(EventDBSchema.Name)] = name
+	return u
+}
+
+// SetUpdatedAt is an autogenerated method
+// nolint: dupl
+func (u EventUpdater) SetUpdatedAt(updatedAt time.Time) EventUpdater {
+	u.fields[string(EventDBSchema.UpdatedAt)] = updatedAt
+	return u
+}
+
+// Update is an autogenerated method
+// nolint: dupl
+func (u EventUpdater) Update() error {
+	return checkQueryContext(u.db).Updates(u.fields).Error
+}
+
+// UpdateNum is an autogenerated method
+// nolint: dupl
+func (u EventUpdater) UpdateNum() (int64, error) {
+	db := checkQueryContext(u.db).Updates(u.fields)
+	return db.RowsAffected, db.Error
+}
+
+// WithContext returns copy executing queries with context ctx.
+// Gorm v1 doesn't support context: its error is checked only before query.
+// nolint: dupl
+func (u EventUpdater) WithContext(ctx context.Context) EventUpdater {
+	u.db = DBWithContext(ctx, u.db)
+	return u
+}
+
+// ===== END of query set EventQuerySet
+
+// ===== BEGIN of Event modifiers
+
+// EventDBSchemaField describes database schema field. It requires for method 'Update'
+type EventDBSchemaField string
+
+// String method returns string representation of field.
+// nolint: dupl
+func (f EventDBSchemaField) String() string {
+	return string(f)
+}
+
+// EventDBSchema stores db field names of Event
+var EventDBSchema = struct {
+	ID        EventDBSchemaField
+	CreatedAt EventDBSchemaField
+	UpdatedAt EventDBSchemaField
+	DeletedAt EventDBSchemaField
+	Name      EventDBSchemaField
+}{
+
+	ID:        EventDBSchemaField("id"),
+	CreatedAt: EventDBSchemaField("created_at"),
+	UpdatedAt: EventDBSchemaField("updated_at"),
+	DeletedAt: EventDBSchemaField("deleted_at"),
+	Name:      EventDBSchemaField("name"),
+}
+
+// Update updates Event fields by primary key
+// nolint: dupl
+func (o *Event) Update(db *gorm.DB, fields ...EventDBSchemaField) error {
+	dbNameToFieldName := map[string]interface{}{
+		"id":         o.ID,
+		"created_at": o.CreatedAt,
+		"updated_at": o.UpdatedAt,
+		"deleted_at": o.DeletedAt,
+		"name":       o.Name,
+	}
+	u := map[string]interface{}{}
+	for _, f := range fields {
+		fs := f.String()
+		u[fs] = dbNameToFieldName[fs]
+	}
+	if err := checkQueryContext(db).Model(o).Updates(u).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return err
+		}
+
+		return fmt.Errorf("can't update Event %v fields %v: %s",
+			o, fields, err)
+	}
+
+	return nil
+}
+
+// EventUpdater is an Event updates manager
+type EventUpdater struct {
+	fields map[string]interface{}
+	db     *gorm.DB
+}
+
+// NewEventUpdater creates new Event updater
+// nolint: dupl
+func NewEventUpdater(db *gorm.DB) EventUpdater {
+	return EventUpdater{
+		fields: map[string]interface{}{},
+		db:     db.Model(&Event{}),
+	}
+}
+
+// ===== END of Event modifiers
+
+// ===== BEGIN of Event predicates
+
+// EventPredicate is a condition on Event fields for Where and Not
+// methods of EventQuerySet: predicates are created by EventQ and
+// combined by And, Or and Not. Zero predicate matches all rows.
+type EventPredicate struct {
+	expr string
+	args []interface{}
+	err  error
+}
+
+// join combines predicates by operation op, empty predicates are skipped
+// nolint: dupl
+func (p EventPredicate) join(op string, preds []EventPredicate) EventPredicate {
+	for _, q := range preds {
+		if p.err == nil {
+			p.err = q.err
+		}
+		if q.expr == "" {
+			continue
+		}
+		if p.expr == "" {
+			p.expr, p.args = q.expr, q.args
+			continue
+		}
+
+		p.expr = "(" + p.expr + ") " + op + " (" + q.expr + ")"
+		p.args = append(append([]interface{}{}, p.args...), q.args...)
+	}
+
+	return p
+}
+
+// And returns predicate matching rows matched by p and all preds
+// nolint: dupl
+func (p EventPredicate) And(preds ...EventPredicate) EventPredicate {
+	return p.join("AND", preds)
+}
+
+// Or returns predicate matching rows matched by p or any of preds
+// nolint: dupl
+func (p EventPredicate) Or(preds ...EventPredicate) EventPredicate {
+	return p.join("OR", preds)
+}
+
+// Not returns predicate matching rows not matched by p
+// nolint: dupl
+func (p EventPredicate) Not() EventPredicate {
+	if p.expr != "" {
+		p.expr = "NOT (" + p.expr + ")"
+	}
+
+	return p
+}
+
+// EventPredicates creates predicates by Event fields
+type EventPredicates struct{}
+
+// EventQ creates predicates by Event fields
+var EventQ EventPredicates
+
+// CreatedAtEq creates predicate "created_at = ?"
+// nolint: dupl
+func (EventPredicates) CreatedAtEq(createdAt time.Time) EventPredicate {
+	return EventPredicate{expr: "created_at = ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtGt creates predicate "created_at > ?"
+// nolint: dupl
+func (EventPredicates) CreatedAtGt(createdAt time.Time) EventPredicate {
+	return EventPredicate{expr: "created_at > ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtGte creates predicate "created_at >= ?"
+// nolint: dupl
+func (EventPredicates) CreatedAtGte(createdAt time.Time) EventPredicate {
+	return EventPredicate{expr: "created_at >= ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtLt creates predicate "created_at < ?"
+// nolint: dupl
+func (EventPredicates) CreatedAtLt(createdAt time.Time) EventPredicate {
+	return EventPredicate{expr: "created_at < ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtLte creates predicate "created_at <= ?"
+// nolint: dupl
+func (EventPredicates) CreatedAtLte(createdAt time.Time) EventPredicate {
+	return EventPredicate{expr: "created_at <= ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtNe creates predicate "created_at != ?"
+// nolint: dupl
+func (EventPredicates) CreatedAtNe(createdAt time.Time) EventPredicate {
+	return EventPredicate{expr: "created_at != ?", args: []interface{}{createdAt}}
+}
+
+// DeletedAtEq creates predicate "deleted_at = ?"
+// nolint: dupl
+func (EventPredicates) DeletedAtEq(deletedAt time.Time) EventPredicate {
+	return EventPredicate{expr: "deleted_at = ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtGt creates predicate "deleted_at > ?"
+// nolint: dupl
+func (EventPredicates) DeletedAtGt(deletedAt time.Time) EventPredicate {
+	return EventPredicate{expr: "deleted_at > ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtGte creates predicate "deleted_at >= ?"
+// nolint: dupl
+func (EventPredicates) DeletedAtGte(deletedAt time.Time) EventPredicate {
+	return EventPredicate{expr: "deleted_at >= ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtIsNotNull creates predicate "deleted_at IS NOT NULL"
+// nolint: dupl
+func (EventPredicates) DeletedAtIsNotNull() EventPredicate {
+	return EventPredicate{expr: "deleted_at IS NOT NULL"}
+}
+
+// DeletedAtIsNull creates predicate "deleted_at IS NULL"
+// nolint: dupl
+func (EventPredicates) DeletedAtIsNull() EventPredicate {
+	return EventPredicate{expr: "deleted_at IS NULL"}
+}
+
+// DeletedAtLt creates predicate "deleted_at < ?"
+// nolint: dupl
+func (EventPredicates) DeletedAtLt(deletedAt time.Time) EventPredicate {
+	return EventPredicate{expr: "deleted_at < ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtLte creates predicate "deleted_at <= ?"
+// nolint: dupl
+func (EventPredicates) DeletedAtLte(deletedAt time.Time) EventPredicate {
+	return EventPredicate{expr: "deleted_at <= ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtNe creates predicate "deleted_at != ?"
+// nolint: dupl
+func (EventPredicates) DeletedAtNe(deletedAt time.Time) EventPredicate {
+	return EventPredicate{expr: "deleted_at != ?", args: []interface{}{deletedAt}}
+}
+
+// IDEq creates predicate "id = ?"
+// nolint: dupl
+func (EventPredicates) IDEq(ID uint) EventPredicate {
+	return EventPredicate{expr: "id = ?", args: []interface{}{ID}}
+}
+
+// IDGt creates predicate "id > ?"
+// nolint: dupl
+func (EventPredicates) IDGt(ID uint) EventPredicate {
+	return EventPredicate{expr: "id > ?", args: []interface{}{ID}}
+}
+
+// IDGte creates predicate "id >= ?"
+// nolint: dupl
+func (EventPredicates) IDGte(ID uint) EventPredicate {
+	return EventPredicate{expr: "id >= ?", args: []interface{}{ID}}
+}
+
+// IDIn creates predicate "id IN (?)"
+// nolint: dupl
+func (EventPredicates) IDIn(ID ...uint) EventPredicate {
+	if len(ID) == 0 {
+		return EventPredicate{err: errors.New("must at least pass one ID in IDIn")}
+	}
+	return EventPredicate{expr: "id IN (?)", args: []interface{}{ID}}
+}
+
+// IDLt creates predicate "id < ?"
+// nolint: dupl
+func (EventPredicates) IDLt(ID uint) EventPredicate {
+	return EventPredicate{expr: "id < ?", args: []interface{}{ID}}
+}
+
+// IDLte creates predicate "id <= ?"
+// nolint: dupl
+func (EventPredicates) IDLte(ID uint) EventPredicate {
+	return EventPredicate{expr: "id <= ?", args: []interface{}{ID}}
+}
+
+// IDNe creates predicate "id != ?"
+// nolint: dupl
+func (EventPredicates) IDNe(ID uint) EventPredicate {
+	return EventPredicate{expr: "id != ?", args: []interface{}{ID}}
+}
+
+// IDNotIn creates predicate "id NOT IN (?)"
+// nolint: dupl
+func (EventPredicates) IDNotIn(ID ...uint) EventPredicate {
+	if len(ID) == 0 {
+		return EventPredicate{err: errors.New("must at least pass one ID in IDNotIn")}
+	}
+	return EventPredicate{expr: "id NOT IN (?)", args: []interface{}{ID}}
+}
+
+// NameEq creates predicate "name = ?"
+// nolint: dupl
+func (EventPredicates) NameEq(name string) EventPredicate {
+	return EventPredicate{expr: "name = ?", args: []interface{}{name}}
+}
+
+// NameGt creates predicate "name > ?"
+// nolint: dupl
+func (EventPredicates) NameGt(name string) EventPredicate {
+	return EventPredicate{expr: "name > ?", args: []interface{}{name}}
+}
+
+// NameGte creates predicate "name >= ?"
+// nolint: dupl
+func (EventPredicates) NameGte(name string) EventPredicate {
+	return EventPredicate{expr: "name >= ?", args: []interface{}{name}}
+}
+
+// NameIn creates predicate "name IN (?)"
+// nolint: dupl
+func (EventPredicates) NameIn(name ...string) EventPredicate {
+	if len(name) == 0 {
+		return EventPredicate{err: errors.New("must at least pass one name in NameIn")}
+	}
+	return EventPredicate{expr: "name IN (?)", args: []interface{}{name}}
+}
+
+// NameLike creates predicate "name LIKE ?"
+// nolint: dupl
+func (EventPredicates) NameLike(name string) EventPredicate {
+	return EventPredicate{expr: "name LIKE ?", args: []interface{}{name}}
+}
+
+// NameLt creates predicate "name < ?"
+// nolint: dupl
+func (EventPredicates) NameLt(name string) EventPredicate {
+	return EventPredicate{expr: "name < ?", args: []interface{}{name}}
+}
+
+// NameLte creates predicate "name <= ?"
+// nolint: dupl
+func (EventPredicates) NameLte(name string) EventPredicate {
+	return EventPredicate{expr: "name <= ?", args: []interface{}{name}}
+}
+
+// NameNe creates predicate "name != ?"
+// nolint: dupl
+func (EventPredicates) NameNe(name string) EventPredicate {
+	return EventPredicate{expr: "name != ?", args: []interface{}{name}}
+}
+
+// NameNotIn creates predicate "name NOT IN (?)"
+// nolint: dupl
+func (EventPredicates) NameNotIn(name ...string) EventPredicate {
+	if len(name) == 0 {
+		return EventPredicate{err: errors.New("must at least pass one name in NameNotIn")}
+	}
+	return EventPredicate{expr: "name NOT IN (?)", args: []interface{}{name}}
+}
+
+// NameNotlike creates predicate "name NOT LIKE ?"
+// nolint: dupl
+func (EventPredicates) NameNotlike(name string) EventPredicate {
+	return EventPredicate{expr: "name NOT LIKE ?", args: []interface{}{name}}
+}
+
+// UpdatedAtEq creates predicate "updated_at = ?"
+// nolint: dupl
+func (EventPredicates) UpdatedAtEq(updatedAt time.Time) EventPredicate {
+	return EventPredicate{expr: "updated_at = ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtGt creates predicate "updated_at > ?"
+// nolint: dupl
+func (EventPredicates) UpdatedAtGt(updatedAt time.Time) EventPredicate {
+	return EventPredicate{expr: "updated_at > ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtGte creates predicate "updated_at >= ?"
+// nolint: dupl
+func (EventPredicates) UpdatedAtGte(updatedAt time.Time) EventPredicate {
+	return EventPredicate{expr: "updated_at >= ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtLt creates predicate "updated_at < ?"
+// nolint: dupl
+func (EventPredicates) UpdatedAtLt(updatedAt time.Time) EventPredicate {
+	return EventPredicate{expr: "updated_at < ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtLte creates predicate "updated_at <= ?"
+// nolint: dupl
+func (EventPredicates) UpdatedAtLte(updatedAt time.Time) EventPredicate {
+	return EventPredicate{expr: "updated_at <= ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtNe creates predicate "updated_at != ?"
+// nolint: dupl
+func (EventPredicates) UpdatedAtNe(updatedAt time.Time) EventPredicate {
+	return EventPredicate{expr: "updated_at != ?", args: []interface{}{updatedAt}}
+}
+
+// ===== END of Event predicates
+
+// ===== BEGIN of query set PostQuerySet
+
+// PostQuerySet is an queryset type for Post
+type PostQuerySet struct {
+	db *gorm.DB
+}
+
+// NewPostQuerySet constructs new PostQuerySet
+func NewPostQuerySet(db *gorm.DB) PostQuerySet {
+	return PostQuerySet{
+		db: db.Model(&Post{}),
+	}
+}
+
+func (qs PostQuerySet) w(db *gorm.DB) PostQuerySet {
+	return NewPostQuerySet(db)
+}
+
+func (qs PostQuerySet) Select(fields ...PostDBSchemaField) PostQuerySet {
+	names := []string{}
+	for _, f := range fields {
+		names = append(names, qs.column(f.String()))
+	}
+
+	return qs.w(qs.db.Select(strings.Join(names, ",")))
+}
+
+// GroupBy groups rows by fields
+func (qs PostQuerySet) GroupBy(fields ...PostDBSchemaField) PostQuerySet {
+	names := []string{}
+	for _, f := range fields {
+		names = append(names, qs.column(f.String()))
+	}
+
+	return qs.w(qs.db.Group(strings.Join(names, ",")))
+}
+
+// Where filters rows by predicate p, predicates are created by PostQ
+// nolint: dupl
+func (qs PostQuerySet) Where(p PostPredicate) PostQuerySet {
+	if p.err != nil {
+		// error is added to copy of db: qs can be reused as a base queryset
+		db := qs.db.Model(&Post{})
+		db.AddError(p.err)
+		return qs.w(db)
+	}
+	if p.expr == "" {
+		return qs
+	}
+
+	expr := strings.Replace(p.expr, "{table}", qs.quotedTableName(&Post{}), -1)
+	return qs.w(qs.db.Where(expr, p.args...))
+}
+
+// Not filters rows not matching predicate p
+// nolint: dupl
+func (qs PostQuerySet) Not(p PostPredicate) PostQuerySet {
+	return qs.Where(p.Not())
+}
+
+// Create is an autogenerated method
+// nolint: dupl
+func (o *Post) Create(db *gorm.DB) error {
+	return checkQueryContext(db).Create(o).Error
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (o *Post) Delete(db *gorm.DB) error {
+	return checkQueryContext(db).Delete(o).Error
+}
+
+// All is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) All(ret *[]Post) error {
+	return checkQueryContext(qs.db).Find(ret).Error
+}
+
+// Batches calls fn for every batch of at most size rows, batches are
+// fetched by Page: ordered by primary key after queryset orders.
+// It stops on the first error of fn and returns it.
+// nolint: dupl
+func (qs PostQuerySet) Batches(size int, fn func([]Post) error) error {
+	cursor := ""
+	for {
+		var batch []Post
+		next, err := qs.Page(cursor, size, &batch)
+		if err != nil {
+			return err
+		}
+
+		if len(batch) != 0 {
+			if err = fn(batch); err != nil {
+				return err
+			}
+		}
+
+		if next == "" {
+			return nil
+		}
+		cursor = next
+	}
+}
+
+// BlogCreatedAtBetween filters rows with BlogCreatedAt in range [from, to]
+// nolint: dupl
+func (qs PostQuerySet) BlogCreatedAtBetween(from time.Time, to time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.created_at BETWEEN ? AND ?", from, to))
+}
+
+// BlogCreatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogCreatedAtEq(blogCreatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.created_at = ?", blogCreatedAt))
+}
+
+// BlogCreatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogCreatedAtGt(blogCreatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.created_at > ?", blogCreatedAt))
+}
+
+// BlogCreatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogCreatedAtGte(blogCreatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.created_at >= ?", blogCreatedAt))
+}
+
+// BlogCreatedAtInRange filters rows with BlogCreatedAt in range [from, to)
+// nolint: dupl
+func (qs PostQuerySet) BlogCreatedAtInRange(from time.Time, to time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.created_at >= ? AND blog_join.created_at < ?", from, to))
+}
+
+// BlogCreatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogCreatedAtLt(blogCreatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.created_at < ?", blogCreatedAt))
+}
+
+// BlogCreatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogCreatedAtLte(blogCreatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.created_at <= ?", blogCreatedAt))
+}
+
+// BlogCreatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogCreatedAtNe(blogCreatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.created_at != ?", blogCreatedAt))
+}
+
+// BlogCreatedAtWithin filters rows with BlogCreatedAt not earlier than d ago
+// nolint: dupl
+func (qs PostQuerySet) BlogCreatedAtWithin(d time.Duration) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.created_at >= ?", time.Now().Add(-d)))
+}
+
+// BlogDeletedAtBetween filters rows with BlogDeletedAt in range [from, to]
+// nolint: dupl
+func (qs PostQuerySet) BlogDeletedAtBetween(from time.Time, to time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.deleted_at BETWEEN ? AND ?", from, to))
+}
+
+// BlogDeletedAtEq is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogDeletedAtEq(blogDeletedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.deleted_at = ?", blogDeletedAt))
+}
+
+// BlogDeletedAtGt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogDeletedAtGt(blogDeletedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.deleted_at > ?", blogDeletedAt))
+}
+
+// BlogDeletedAtGte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogDeletedAtGte(blogDeletedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.deleted_at >= ?", blogDeletedAt))
+}
+
+// BlogDeletedAtInRange filters rows with BlogDeletedAt in range [from, to)
+// nolint: dupl
+func (qs PostQuerySet) BlogDeletedAtInRange(from time.Time, to time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.deleted_at >= ? AND blog_join.deleted_at < ?", from, to))
+}
+
+// BlogDeletedAtIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogDeletedAtIsNotNull() PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.deleted_at IS NOT NULL"))
+}
+
+// BlogDeletedAtIsNull is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogDeletedAtIsNull() PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.deleted_at IS NULL"))
+}
+
+// BlogDeletedAtLt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogDeletedAtLt(blogDeletedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.deleted_at < ?", blogDeletedAt))
+}
+
+// BlogDeletedAtLte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogDeletedAtLte(blogDeletedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.deleted_at <= ?", blogDeletedAt))
+}
+
+// BlogDeletedAtNe is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogDeletedAtNe(blogDeletedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.deleted_at != ?", blogDeletedAt))
+}
+
+// BlogDeletedAtWithin filters rows with BlogDeletedAt not earlier than d ago
+// nolint: dupl
+func (qs PostQuerySet) BlogDeletedAtWithin(d time.Duration) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.deleted_at >= ?", time.Now().Add(-d)))
+}
+
+// BlogIDBetween filters rows with BlogID in range [from, to]
+// nolint: dupl
+func (qs PostQuerySet) BlogIDBetween(from uint, to uint) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("blog_id")+" BETWEEN ? AND ?", from, to))
+}
+
+// BlogIDEq is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogIDEq(blogID uint) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("blog_id")+" = ?", blogID))
+}
+
+// BlogIDGt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogIDGt(blogID uint) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("blog_id")+" > ?", blogID))
+}
+
+// BlogIDGte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogIDGte(blogID uint) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("blog_id")+" >= ?", blogID))
+}
+
+// BlogIDIn is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogIDIn(blogID ...uint) PostQuerySet {
+	if len(blogID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one blogID in BlogIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where(qs.column("blog_id")+" IN (?)", blogID))
+}
+
+// BlogIDInQuery filters rows with BlogID IN values selected by subquery q
+// nolint: dupl
+func (qs PostQuerySet) BlogIDInQuery(q UintSubquery) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("blog_id")+" IN (?)", q.expr()))
+}
+
+// BlogIDInRange filters rows with BlogID in range [from, to)
+// nolint: dupl
+func (qs PostQuerySet) BlogIDInRange(from uint, to uint) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("blog_id")+" >= ? AND "+qs.column("blog_id")+" < ?", from, to))
+}
+
+// BlogIDIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogIDIsNotNull() PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("blog_id") + " IS NOT NULL"))
+}
+
+// BlogIDIsNull is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogIDIsNull() PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("blog_id") + " IS NULL"))
+}
+
+// BlogIDLt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogIDLt(blogID uint) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("blog_id")+" < ?", blogID))
+}
+
+// BlogIDLte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogIDLte(blogID uint) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("blog_id")+" <= ?", blogID))
+}
+
+// BlogIDNe is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogIDNe(blogID uint) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("blog_id")+" != ?", blogID))
+}
+
+// BlogIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogIDNotIn(blogID ...uint) PostQuerySet {
+	if len(blogID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one blogID in BlogIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where(qs.column("blog_id")+" NOT IN (?)", blogID))
+}
+
+// BlogIDNotInQuery filters rows with BlogID NOT IN values selected by subquery q
+// nolint: dupl
+func (qs PostQuerySet) BlogIDNotInQuery(q UintSubquery) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("blog_id")+" NOT IN (?)", q.expr()))
+}
+
+// BlogIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogIsNotNull() PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("blog") + " IS NOT NULL"))
+}
+
+// BlogIsNull is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogIsNull() PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("blog") + " IS NULL"))
+}
+
+// BlogNameEndsWith filters rows with BlogName ending with the argument
+// nolint: dupl
+func (qs PostQuerySet) BlogNameEndsWith(blogName string) PostQuerySet {
+	return qs.w(whereLike(qs.db, "blog_join.myname", "%", blogName, "", false))
+}
+
+// BlogNameEq is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogNameEq(blogName string) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.myname = ?", blogName))
+}
+
+// BlogNameGt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogNameGt(blogName string) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.myname > ?", blogName))
+}
+
+// BlogNameGte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogNameGte(blogName string) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.myname >= ?", blogName))
+}
+
+// BlogNameIContains filters rows with BlogName containing the argument, case is ignored
+// nolint: dupl
+func (qs PostQuerySet) BlogNameIContains(blogName string) PostQuerySet {
+	return qs.w(whereLike(qs.db, "blog_join.myname", "%", blogName, "%", true))
+}
+
+// BlogNameIEq filters rows with BlogName equal to the argument, case is ignored
+// nolint: dupl
+func (qs PostQuerySet) BlogNameIEq(blogName string) PostQuerySet {
+	return qs.w(whereLike(qs.db, "blog_join.myname", "", blogName, "", true))
+}
+
+// BlogNameIn is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogNameIn(blogName ...string) PostQuerySet {
+	if len(blogName) == 0 {
+		qs.db.AddError(errors.New("must at least pass one blogName in BlogNameIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("blog_join.myname IN (?)", blogName))
+}
+
+// BlogNameInQuery filters rows with BlogName IN values selected by subquery q
+// nolint: dupl
+func (qs PostQuerySet) BlogNameInQuery(q StringSubquery) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.myname IN (?)", q.expr()))
+}
+
+// BlogNameLike is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogNameLike(blogName string) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.myname LIKE ?", blogName))
+}
+
+// BlogNameLt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogNameLt(blogName string) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.myname < ?", blogName))
+}
+
+// BlogNameLte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogNameLte(blogName string) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.myname <= ?", blogName))
+}
+
+// BlogNameNe is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogNameNe(blogName string) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.myname != ?", blogName))
+}
+
+// BlogNameNotIn is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogNameNotIn(blogName ...string) PostQuerySet {
+	if len(blogName) == 0 {
+		qs.db.AddError(errors.New("must at least pass one blogName in BlogNameNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("blog_join.myname NOT IN (?)", blogName))
+}
+
+// BlogNameNotInQuery filters rows with BlogName NOT IN values selected by subquery q
+// nolint: dupl
+func (qs PostQuerySet) BlogNameNotInQuery(q StringSubquery) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.myname NOT IN (?)", q.expr()))
+}
+
+// BlogNameNotlike is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogNameNotlike(blogName string) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.myname NOT LIKE ?", blogName))
+}
+
+// BlogNameStartsWith filters rows with BlogName starting with the argument
+// nolint: dupl
+func (qs PostQuerySet) BlogNameStartsWith(blogName string) PostQuerySet {
+	return qs.w(whereLike(qs.db, "blog_join.myname", "", blogName, "%", false))
+}
+
+// BlogUpdatedAtBetween filters rows with BlogUpdatedAt in range [from, to]
+// nolint: dupl
+func (qs PostQuerySet) BlogUpdatedAtBetween(from time.Time, to time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.updated_at BETWEEN ? AND ?", from, to))
+}
+
+// BlogUpdatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogUpdatedAtEq(blogUpdatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.updated_at = ?", blogUpdatedAt))
+}
+
+// BlogUpdatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogUpdatedAtGt(blogUpdatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.updated_at > ?", blogUpdatedAt))
+}
+
+// BlogUpdatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogUpdatedAtGte(blogUpdatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.updated_at >= ?", blogUpdatedAt))
+}
+
+// BlogUpdatedAtInRange filters rows with BlogUpdatedAt in range [from, to)
+// nolint: dupl
+func (qs PostQuerySet) BlogUpdatedAtInRange(from time.Time, to time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.updated_at >= ? AND blog_join.updated_at < ?", from, to))
+}
+
+// BlogUpdatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogUpdatedAtLt(blogUpdatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.updated_at < ?", blogUpdatedAt))
+}
+
+// BlogUpdatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogUpdatedAtLte(blogUpdatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.updated_at <= ?", blogUpdatedAt))
+}
+
+// BlogUpdatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogUpdatedAtNe(blogUpdatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.updated_at != ?", blogUpdatedAt))
+}
+
+// BlogUpdatedAtWithin filters rows with BlogUpdatedAt not earlier than d ago
+// nolint: dupl
+func (qs PostQuerySet) BlogUpdatedAtWithin(d time.Duration) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.updated_at >= ?", time.Now().Add(-d)))
+}
+
+// Count is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) Count() (int, error) {
+	var count int
+	db := checkQueryContext(qs.db)
+	if db.Error != nil {
+		return count, db.Error
+	}
+
+	err := db.Count(&count).Error
+	return count, err
+}
+
+// CountByBlogName returns count of rows for every value of BlogName
+// nolint: dupl
+func (qs PostQuerySet) CountByBlogName() (map[string]int, error) {
+	var rows []struct {
+		Value string
+		Count int
+	}
+	err := checkQueryContext(qs.db).Select("blog_join.myname AS value, COUNT(*) AS count").Group("blog_join.myname").Scan(&rows).Error
+	ret := make(map[string]int, len(rows))
+	for _, r := range rows {
+		ret[r.Value] = r.Count
+	}
+	return ret, err
+}
+
+// CountByID returns count of rows for every value of ID
+// nolint: dupl
+func (qs PostQuerySet) CountByID() (map[uint]int, error) {
+	var rows []struct {
+		Value uint
+		Count int
+	}
+	err := checkQueryContext(qs.db).Select(qs.column("id") + " AS value, COUNT(*) AS count").Group(qs.column("id")).Scan(&rows).Error
+	ret := make(map[uint]int, len(rows))
+	for _, r := range rows {
+		ret[r.Value] = r.Count
+	}
+	return ret, err
+}
+
+// CountByStr returns count of rows for every value of Str
+// nolint: dupl
+func (qs PostQuerySet) CountByStr() (map[tmp.StringDef]int, error) {
+	var rows []struct {
+		Value tmp.StringDef
+		Count int
+	}
+	err := checkQueryContext(qs.db).Select(qs.column("str") + " AS value, COUNT(*) AS count").Group(qs.column("str")).Scan(&rows).Error
+	ret := make(map[tmp.StringDef]int, len(rows))
+	for _, r := range rows {
+		ret[r.Value] = r.Count
+	}
+	return ret, err
+}
+
+// CountByUserEmail returns count of rows for every value of UserEmail
+// nolint: dupl
+func (qs PostQuerySet) CountByUserEmail() (map[string]int, error) {
+	var rows []struct {
+		Value string
+		Count int
+	}
+	err := checkQueryContext(qs.db).Select("user_join.email AS value, COUNT(*) AS count").Group("user_join.email").Scan(&rows).Error
+	ret := make(map[string]int, len(rows))
+	for _, r := range rows {
+		ret[r.Value] = r.Count
+	}
+	return ret, err
+}
+
+// CountByUserID returns count of rows for every value of UserID
+// nolint: dupl
+func (qs PostQuerySet) CountByUserID() (map[uint]int, error) {
+	var rows []struct {
+		Value uint
+		Count int
+	}
+	err := checkQueryContext(qs.db).Select(qs.column("user_id") + " AS value, COUNT(*) AS count").Group(qs.column("user_id")).Scan(&rows).Error
+	ret := make(map[uint]int, len(rows))
+	for _, r := range rows {
+		ret[r.Value] = r.Count
+	}
+	return ret, err
+}
+
+// CountByUserName returns count of rows for every value of UserName
+// nolint: dupl
+func (qs PostQuerySet) CountByUserName() (map[string]int, error) {
+	var rows []struct {
+		Value string
+		Count int
+	}
+	err := checkQueryContext(qs.db).Select("user_join.name AS value, COUNT(*) AS count").Group("user_join.name").Scan(&rows).Error
+	ret := make(map[string]int, len(rows))
+	for _, r := range rows {
+		ret[r.Value] = r.Count
+	}
+	return ret, err
+}
+
+// CreateMany inserts models by multi-row inserts, conditions of queryset
+// aren't used. Generated primary keys are written back to models if
+// database supports it (all except MS SQL for gorm v1).
+// Unlike gorm Create it doesn't call hooks and doesn't read back default
+// values: zero fields having default values are skipped in insert.
+// nolint: dupl
+func (qs PostQuerySet) CreateMany(models []Post) error {
+	rows := make([]map[string]interface{}, 0, len(models))
+	for i := range models {
+		rows = append(rows, qs.fieldPtrs(&models[i]))
+	}
+
+	return bulkInsert(checkQueryContext(qs.db), "id", rows)
+}
+
+// CreatedAtBetween filters rows with CreatedAt in range [from, to]
+// nolint: dupl
+func (qs PostQuerySet) CreatedAtBetween(from time.Time, to time.Time) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("created_at")+" BETWEEN ? AND ?", from, to))
+}
+
+// CreatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) CreatedAtEq(createdAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("created_at")+" = ?", createdAt))
+}
+
+// CreatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) CreatedAtGt(createdAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("created_at")+" > ?", createdAt))
+}
+
+// CreatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) CreatedAtGte(createdAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("created_at")+" >= ?", createdAt))
+}
+
+// CreatedAtInRange filters rows with CreatedAt in range [from, to)
+// nolint: dupl
+func (qs PostQuerySet) CreatedAtInRange(from time.Time, to time.Time) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("created_at")+" >= ? AND "+qs.column("created_at")+" < ?", from, to))
+}
+
+// CreatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) CreatedAtLt(createdAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("created_at")+" < ?", createdAt))
+}
+
+// CreatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) CreatedAtLte(createdAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("created_at")+" <= ?", createdAt))
+}
+
+// CreatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) CreatedAtNe(createdAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("created_at")+" != ?", createdAt))
+}
+
+// CreatedAtWithin filters rows with CreatedAt not earlier than d ago
+// nolint: dupl
+func (qs PostQuerySet) CreatedAtWithin(d time.Duration) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("created_at")+" >= ?", time.Now().Add(-d)))
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) Delete() error {
+	return checkQueryContext(qs.db).Delete(Post{}).Error
+}
+
+// DeleteNum is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) DeleteNum() (int64, error) {
+	db := checkQueryContext(qs.db).Delete(Post{})
+	return db.RowsAffected, db.Error
+}
+
+// DeleteNumUnscoped is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) DeleteNumUnscoped() (int64, error) {
+	db := checkQueryContext(qs.db).Unscoped().Delete(Post{})
+	return db.RowsAffected, db.Error
+}
+
+// DeletedAtBetween filters rows with DeletedAt in range [from, to]
+// nolint: dupl
+func (qs PostQuerySet) DeletedAtBetween(from time.Time, to time.Time) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("deleted_at")+" BETWEEN ? AND ?", from, to))
+}
+
+// DeletedAtEq is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) DeletedAtEq(deletedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("deleted_at")+" = ?", deletedAt))
+}
+
+// DeletedAtGt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) DeletedAtGt(deletedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("deleted_at")+" > ?", deletedAt))
+}
+
+// DeletedAtGte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) DeletedAtGte(deletedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("deleted_at")+" >= ?", deletedAt))
+}
+
+// DeletedAtInRange filters rows with DeletedAt in range [from, to)
+// nolint: dupl
+func (qs PostQuerySet) DeletedAtInRange(from time.Time, to time.Time) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("deleted_at")+" >= ? AND "+qs.column("deleted_at")+" < ?", from, to))
+}
+
+// DeletedAtIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) DeletedAtIsNotNull() PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("deleted_at") + " IS NOT NULL"))
+}
+
+// DeletedAtIsNull is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) DeletedAtIsNull() PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("deleted_at") + " IS NULL"))
+}
+
+// DeletedAtLt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) DeletedAtLt(deletedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("deleted_at")+" < ?", deletedAt))
+}
+
+// DeletedAtLte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) DeletedAtLte(deletedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("deleted_at")+" <= ?", deletedAt))
+}
+
+// DeletedAtNe is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) DeletedAtNe(deletedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("deleted_at")+" != ?", deletedAt))
+}
+
+// DeletedAtWithin filters rows with DeletedAt not earlier than d ago
+// nolint: dupl
+func (qs PostQuerySet) DeletedAtWithin(d time.Duration) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("deleted_at")+" >= ?", time.Now().Add(-d)))
+}
+
+// GetDB is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) GetDB() *gorm.DB {
+	return qs.db
+}
+
+// GetUpdater is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) GetUpdater() PostUpdater {
+	return NewPostUpdater(qs.db)
+}
+
+// GroupByBlogCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) GroupByBlogCreatedAt() PostQuerySet {
+	return qs.w(qs.db.Group("blog_join.created_at"))
+}
+
+// GroupByBlogDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) GroupByBlogDeletedAt() PostQuerySet {
+	return qs.w(qs.db.Group("blog_join.deleted_at"))
+}
+
+// GroupByBlogID is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) GroupByBlogID() PostQuerySet {
+	return qs.w(qs.db.Group(qs.column("blog_id")))
+}
+
+// GroupByBlogName is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) GroupByBlogName() PostQuerySet {
+	return qs.w(qs.db.Group("blog_join.myname"))
+}
+
+// GroupByBlogUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) GroupByBlogUpdatedAt() PostQuerySet {
+	return qs.w(qs.db.Group("blog_join.updated_at"))
+}
+
+// GroupByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) GroupByCreatedAt() PostQuerySet {
+	return qs.w(qs.db.Group(qs.column("created_at")))
+}
+
+// GroupByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) GroupByDeletedAt() PostQuerySet {
+	return qs.w(qs.db.Group(qs.column("deleted_at")))
+}
+
+// GroupByID is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) GroupByID() PostQuerySet {
+	return qs.w(qs.db.Group(qs.column("id")))
+}
+
+// GroupByStr is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) GroupByStr() PostQuerySet {
+	return qs.w(qs.db.Group(qs.column("str")))
+}
+
+// GroupByTitle is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) GroupByTitle() PostQuerySet {
+	return qs.w(qs.db.Group(qs.column("title")))
+}
+
+// GroupByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) GroupByUpdatedAt() PostQuerySet {
+	return qs.w(qs.db.Group(qs.column("updated_at")))
+}
+
+// GroupByUserCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) GroupByUserCreatedAt() PostQuerySet {
+	return qs.w(qs.db.Group("user_join.created_at"))
+}
+
+// GroupByUserDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) GroupByUserDeletedAt() PostQuerySet {
+	return qs.w(qs.db.Group("user_join.deleted_at"))
+}
+
+// GroupByUserEmail is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) GroupByUserEmail() PostQuerySet {
+	return qs.w(qs.db.Group("user_join.email"))
+}
+
+// GroupByUserID is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) GroupByUserID() PostQuerySet {
+	return qs.w(qs.db.Group(qs.column("user_id")))
+}
+
+// GroupByUserName is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) GroupByUserName() PostQuerySet {
+	return qs.w(qs.db.Group("user_join.name"))
+}
+
+// GroupByUserSurname is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) GroupByUserSurname() PostQuerySet {
+	return qs.w(qs.db.Group("user_join.user_surname"))
+}
+
+// GroupByUserUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) GroupByUserUpdatedAt() PostQuerySet {
+	return qs.w(qs.db.Group("user_join.updated_at"))
+}
+
+// HavingCountEq is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) HavingCountEq(count int) PostQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) = ?", count))
+}
+
+// HavingCountGt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) HavingCountGt(count int) PostQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) > ?", count))
+}
+
+// HavingCountGte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) HavingCountGte(count int) PostQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) >= ?", count))
+}
+
+// HavingCountLt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) HavingCountLt(count int) PostQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) < ?", count))
+}
+
+// HavingCountLte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) HavingCountLte(count int) PostQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) <= ?", count))
+}
+
+// HavingCountNe is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) HavingCountNe(count int) PostQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) != ?", count))
+}
+
+// IDBetween filters rows with ID in range [from, to]
+// nolint: dupl
+func (qs PostQuerySet) IDBetween(from uint, to uint) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("id")+" BETWEEN ? AND ?", from, to))
+}
+
+// IDEq is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) IDEq(ID uint) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("id")+" = ?", ID))
+}
+
+// IDGt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) IDGt(ID uint) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("id")+" > ?", ID))
+}
+
+// IDGte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) IDGte(ID uint) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("id")+" >= ?", ID))
+}
+
+// IDIn is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) IDIn(ID ...uint) PostQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where(qs.column("id")+" IN (?)", ID))
+}
+
+// IDInQuery filters rows with ID IN values selected by subquery q
+// nolint: dupl
+func (qs PostQuerySet) IDInQuery(q UintSubquery) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("id")+" IN (?)", q.expr()))
+}
+
+// IDInRange filters rows with ID in range [from, to)
+// nolint: dupl
+func (qs PostQuerySet) IDInRange(from uint, to uint) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("id")+" >= ? AND "+qs.column("id")+" < ?", from, to))
+}
+
+// IDLt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) IDLt(ID uint) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("id")+" < ?", ID))
+}
+
+// IDLte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) IDLte(ID uint) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("id")+" <= ?", ID))
+}
+
+// IDNe is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) IDNe(ID uint) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("id")+" != ?", ID))
+}
+
+// IDNotIn is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) IDNotIn(ID ...uint) PostQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where(qs.column("id")+" NOT IN (?)", ID))
+}
+
+// IDNotInQuery filters rows with ID NOT IN values selected by subquery q
+// nolint: dupl
+func (qs PostQuerySet) IDNotInQuery(q UintSubquery) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("id")+" NOT IN (?)", q.expr()))
+}
+
+// InTx returns copy bound to transaction tx: queries are executed in it.
+// Gorm v1 can't move conditions into another connection, so with gorm v1
+// InTx must be called before any condition is set, otherwise queries fail.
+// Context and preloads are moved into transaction by both gorm versions.
+// nolint: dupl
+func (qs PostQuerySet) InTx(tx *gorm.DB) PostQuerySet {
+	if !reflect.DeepEqual(qs.db.QueryExpr(), NewPostQuerySet(qs.db.New()).db.QueryExpr()) {
+		tx = tx.New()
+		tx.AddError(errors.New("InTx must be called before any condition is set: " +
+			"gorm v1 can't move conditions into transaction"))
+	}
+	tx = txWithSettings(qs.db, tx)
+	return NewPostQuerySet(tx)
+}
+
+// Iterate scans rows one by one and calls fn for every row without
+// loading all rows into memory. It stops on the first error of fn and
+// returns it. Preloads aren't applied.
+// nolint: dupl
+func (qs PostQuerySet) Iterate(fn func(*Post) error) error {
+	db := checkQueryContext(qs.db)
+	if db.Error != nil {
+		return db.Error // gorm v1 doesn't check it in Rows
+	}
+
+	rows, err := db.Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var o Post
+		if err = db.ScanRows(rows, &o); err != nil {
+			return err
+		}
+		if err = fn(&o); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// JoinBlog joins table of Blog by alias blog_join:
+// it's needed for filtering by Blog fields
+// nolint: dupl
+func (qs PostQuerySet) JoinBlog() PostQuerySet {
+	return qs.w(qs.db.Joins(fmt.Sprintf("JOIN %s blog_join ON blog_join.id = %s.blog_id AND blog_join.deleted_at IS NULL",
+		qs.quotedTableName(&Blog{}), qs.quotedTableName(&Post{}))))
+}
+
+// JoinUser joins table of User by alias user_join:
+// it's needed for filtering by User fields
+// nolint: dupl
+func (qs PostQuerySet) JoinUser() PostQuerySet {
+	return qs.w(qs.db.Joins(fmt.Sprintf("JOIN %s user_join ON user_join.id = %s.user_id AND user_join.deleted_at IS NULL",
+		qs.quotedTableName(&User{}), qs.quotedTableName(&Post{}))))
+}
+
+// LeftJoinBlog joins table of Blog by alias blog_join:
+// it's needed for filtering by Blog fields
+// nolint: dupl
+func (qs PostQuerySet) LeftJoinBlog() PostQuerySet {
+	return qs.w(qs.db.Joins(fmt.Sprintf("LEFT JOIN %s blog_join ON blog_join.id = %s.blog_id AND blog_join.deleted_at IS NULL",
+		qs.quotedTableName(&Blog{}), qs.quotedTableName(&Post{}))))
+}
+
+// LeftJoinUser joins table of User by alias user_join:
+// it's needed for filtering by User fields
+// nolint: dupl
+func (qs PostQuerySet) LeftJoinUser() PostQuerySet {
+	return qs.w(qs.db.Joins(fmt.Sprintf("LEFT JOIN %s user_join ON user_join.id = %s.user_id AND user_join.deleted_at IS NULL",
+		qs.quotedTableName(&User{}), qs.quotedTableName(&Post{}))))
+}
+
+// Limit is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) Limit(limit int) PostQuerySet {
+	return qs.w(qs.db.Limit(limit))
+}
+
+// MaxBlogCreatedAt returns MAX of BlogCreatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs PostQuerySet) MaxBlogCreatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := checkQueryContext(qs.db).Select("blog_join.created_at AS value").Where("blog_join.created_at IS NOT NULL").
+		Order("blog_join.created_at DESC", true).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MaxBlogID returns MAX of BlogID, it's zero value if there are no rows
+// nolint: dupl
+func (qs PostQuerySet) MaxBlogID() (uint, error) {
+	var ret uint
+	var res struct {
+		Value *uint
+	}
+	err := checkQueryContext(qs.db).Select("MAX(" + qs.column("blog_id") + ") AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// MaxBlogUpdatedAt returns MAX of BlogUpdatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs PostQuerySet) MaxBlogUpdatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := checkQueryContext(qs.db).Select("blog_join.updated_at AS value").Where("blog_join.updated_at IS NOT NULL").
+		Order("blog_join.updated_at DESC", true).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MaxCreatedAt returns MAX of CreatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs PostQuerySet) MaxCreatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := checkQueryContext(qs.db).Select(qs.column("created_at")+" AS value").Where(qs.column("created_at")+" IS NOT NULL").
+		Order(qs.column("created_at")+" DESC", true).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MaxID returns MAX of ID, it's zero value if there are no rows
+// nolint: dupl
+func (qs PostQuerySet) MaxID() (uint, error) {
+	var ret uint
+	var res struct {
+		Value *uint
+	}
+	err := checkQueryContext(qs.db).Select("MAX(" + qs.column("id") + ") AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// MaxUpdatedAt returns MAX of UpdatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs PostQuerySet) MaxUpdatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := checkQueryContext(qs.db).Select(qs.column("updated_at")+" AS value").Where(qs.column("updated_at")+" IS NOT NULL").
+		Order(qs.column("updated_at")+" DESC", true).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MaxUserCreatedAt returns MAX of UserCreatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs PostQuerySet) MaxUserCreatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := checkQueryContext(qs.db).Select("user_join.created_at AS value").Where("user_join.created_at IS NOT NULL").
+		Order("user_join.created_at DESC", true).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MaxUserID returns MAX of UserID, it's zero value if there are no rows
+// nolint: dupl
+func (qs PostQuerySet) MaxUserID() (uint, error) {
+	var ret uint
+	var res struct {
+		Value *uint
+	}
+	err := checkQueryContext(qs.db).Select("MAX(" + qs.column("user_id") + ") AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// MaxUserUpdatedAt returns MAX of UserUpdatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs PostQuerySet) MaxUserUpdatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := checkQueryContext(qs.db).Select("user_join.updated_at AS value").Where("user_join.updated_at IS NOT NULL").
+		Order("user_join.updated_at DESC", true).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MinBlogCreatedAt returns MIN of BlogCreatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs PostQuerySet) MinBlogCreatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := checkQueryContext(qs.db).Select("blog_join.created_at AS value").Where("blog_join.created_at IS NOT NULL").
+		Order("blog_join.created_at ASC", true).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MinBlogID returns MIN of BlogID, it's zero value if there are no rows
+// nolint: dupl
+func (qs PostQuerySet) MinBlogID() (uint, error) {
+	var ret uint
+	var res struct {
+		Value *uint
+	}
+	err := checkQueryContext(qs.db).Select("MIN(" + qs.column("blog_id") + ") AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// MinBlogUpdatedAt returns MIN of BlogUpdatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs PostQuerySet) MinBlogUpdatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := checkQueryContext(qs.db).Select("blog_join.updated_at AS value").Where("blog_join.updated_at IS NOT NULL").
+		Order("blog_join.updated_at ASC", true).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MinCreatedAt returns MIN of CreatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs PostQuerySet) MinCreatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := checkQueryContext(qs.db).Select(qs.column("created_at")+" AS value").Where(qs.column("created_at")+" IS NOT NULL").
+		Order(qs.column("created_at")+" ASC", true).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MinID returns MIN of ID, it's zero value if there are no rows
+// nolint: dupl
+func (qs PostQuerySet) MinID() (uint, error) {
+	var ret uint
+	var res struct {
+		Value *uint
+	}
+	err := checkQueryContext(qs.db).Select("MIN(" + qs.column("id") + ") AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// MinUpdatedAt returns MIN of UpdatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs PostQuerySet) MinUpdatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := checkQueryContext(qs.db).Select(qs.column("updated_at")+" AS value").Where(qs.column("updated_at")+" IS NOT NULL").
+		Order(qs.column("updated_at")+" ASC", true).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MinUserCreatedAt returns MIN of UserCreatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs PostQuerySet) MinUserCreatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := checkQueryContext(qs.db).Select("user_join.created_at AS value").Where("user_join.created_at IS NOT NULL").
+		Order("user_join.created_at ASC", true).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MinUserID returns MIN of UserID, it's zero value if there are no rows
+// nolint: dupl
+func (qs PostQuerySet) MinUserID() (uint, error) {
+	var ret uint
+	var res struct {
+		Value *uint
+	}
+	err := checkQueryContext(qs.db).Select("MIN(" + qs.column("user_id") + ") AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// MinUserUpdatedAt returns MIN of UserUpdatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs PostQuerySet) MinUserUpdatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := checkQueryContext(qs.db).Select("user_join.updated_at AS value").Where("user_join.updated_at IS NOT NULL").
+		Order("user_join.updated_at ASC", true).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// Offset is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) Offset(offset int) PostQuerySet {
+	return qs.w(qs.db.Offset(offset))
+}
+
+// One is used to retrieve one result. It returns gorm.ErrRecordNotFound
+// if nothing was fetched
+func (qs PostQuerySet) One(ret *Post) error {
+	return checkQueryContext(qs.db).First(ret).Error
+}
+
+// OrderAscByBlogCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderAscByBlogCreatedAt() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("blog_join.created_at ASC"), "blog_join.created_at", false, false))
+}
+
+// OrderAscByBlogDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderAscByBlogDeletedAt() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("blog_join.deleted_at ASC"), "blog_join.deleted_at", false, false))
+}
+
+// OrderAscByBlogID is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderAscByBlogID() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order(qs.column("blog_id")+" ASC"), "blog_id", false, false))
+}
+
+// OrderAscByBlogName is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderAscByBlogName() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("blog_join.myname ASC"), "blog_join.myname", false, false))
+}
+
+// OrderAscByBlogUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderAscByBlogUpdatedAt() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("blog_join.updated_at ASC"), "blog_join.updated_at", false, false))
+}
+
+// OrderAscByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderAscByCreatedAt() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order(qs.column("created_at")+" ASC"), "created_at", false, false))
+}
+
+// OrderAscByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderAscByDeletedAt() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order(qs.column("deleted_at")+" ASC"), "deleted_at", false, false))
+}
+
+// OrderAscByID is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderAscByID() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order(qs.column("id")+" ASC"), "id", false, false))
+}
+
+// OrderAscByStr is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderAscByStr() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order(qs.column("str")+" ASC"), "str", false, false))
+}
+
+// OrderAscByTitle is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderAscByTitle() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order(qs.column("title")+" ASC"), "title", false, false))
+}
+
+// OrderAscByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderAscByUpdatedAt() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order(qs.column("updated_at")+" ASC"), "updated_at", false, false))
+}
+
+// OrderAscByUserCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderAscByUserCreatedAt() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("user_join.created_at ASC"), "user_join.created_at", false, false))
+}
+
+// OrderAscByUserDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderAscByUserDeletedAt() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("user_join.deleted_at ASC"), "user_join.deleted_at", false, false))
+}
+
+// OrderAscByUserEmail is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderAscByUserEmail() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("user_join.email ASC"), "user_join.email", false, false))
+}
+
+// OrderAscByUserID is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderAscByUserID() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order(qs.column("user_id")+" ASC"), "user_id", false, false))
+}
+
+// OrderAscByUserName is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderAscByUserName() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("user_join.name ASC"), "user_join.name", false, false))
+}
+
+// OrderAscByUserSurname is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderAscByUserSurname() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("user_join.user_surname ASC"), "user_join.user_surname", false, false))
+}
+
+// OrderAscByUserUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderAscByUserUpdatedAt() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("user_join.updated_at ASC"), "user_join.updated_at", false, false))
+}
+
+// OrderDescByBlogCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderDescByBlogCreatedAt() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("blog_join.created_at DESC"), "blog_join.created_at", true, false))
+}
+
+// OrderDescByBlogDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderDescByBlogDeletedAt() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("blog_join.deleted_at DESC"), "blog_join.deleted_at", true, false))
+}
+
+// OrderDescByBlogID is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderDescByBlogID() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order(qs.column("blog_id")+" DESC"), "blog_id", true, false))
+}
+
+// OrderDescByBlogName is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderDescByBlogName() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("blog_join.myname DESC"), "blog_join.myname", true, false))
+}
+
+// OrderDescByBlogUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderDescByBlogUpdatedAt() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("blog_join.updated_at DESC"), "blog_join.updated_at", true, false))
+}
+
+// OrderDescByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderDescByCreatedAt() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order(qs.column("created_at")+" DESC"), "created_at", true, false))
+}
+
+// OrderDescByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderDescByDeletedAt() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order(qs.column("deleted_at")+" DESC"), "deleted_at", true, false))
+}
+
+// OrderDescByID is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderDescByID() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order(qs.column("id")+" DESC"), "id", true, false))
+}
+
+// OrderDescByStr is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderDescByStr() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order(qs.column("str")+" DESC"), "str", true, false))
+}
+
+// OrderDescByTitle is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderDescByTitle() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order(qs.column("title")+" DESC"), "title", true, false))
+}
+
+// OrderDescByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderDescByUpdatedAt() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order(qs.column("updated_at")+" DESC"), "updated_at", true, false))
+}
+
+// OrderDescByUserCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderDescByUserCreatedAt() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("user_join.created_at DESC"), "user_join.created_at", true, false))
+}
+
+// OrderDescByUserDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderDescByUserDeletedAt() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("user_join.deleted_at DESC"), "user_join.deleted_at", true, false))
+}
+
+// OrderDescByUserEmail is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderDescByUserEmail() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("user_join.email DESC"), "user_join.email", true, false))
+}
+
+// OrderDescByUserID is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderDescByUserID() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order(qs.column("user_id")+" DESC"), "user_id", true, false))
+}
+
+// OrderDescByUserName is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderDescByUserName() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("user_join.name DESC"), "user_join.name", true, false))
+}
+
+// OrderDescByUserSurname is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderDescByUserSurname() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("user_join.user_surname DESC"), "user_join.user_surname", true, false))
+}
+
+// OrderDescByUserUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderDescByUserUpdatedAt() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("user_join.updated_at DESC"), "user_join.updated_at", true, false))
+}
+
+// Page fetches at most size rows following cursor into ret, it uses
+// orders set by OrderAscBy/OrderDescBy methods and primary key to break ties.
+// Ordered fields can't be nullable: rows with NULL can't be compared with
+// cursor, so error is returned for them. Empty cursor means the first page. It returns cursor of the next page or
+// empty string if there are no more rows.
+// nolint: dupl
+func (qs PostQuerySet) Page(cursor string, size int, ret *[]Post) (string, error) {
+	if size <= 0 {
+		return "", errors.New("page size must be positive")
+	}
+
+	var last Post
+	db, orders, err := pageQuery(qs.db, qs.quotedTableName(&Post{})+".", "id", cursor, qs.fieldPtrs(&last))
+	if err != nil {
+		return "", err
+	}
+
+	if err = checkQueryContext(db).Limit(size + 1).Find(ret).Error; err != nil {
+		return "", err
+	}
+	if len(*ret) <= size {
+		return "", nil
+	}
+
+	*ret = (*ret)[:size]
+	return encodePageCursor(orders, qs.fieldPtrs(&(*ret)[size-1]))
+}
+
+// PreloadBlog is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) PreloadBlog() PostQuerySet {
+	return qs.w(preload(qs.db, "Blog"))
+}
+
+// PreloadBlogWhere preloads Blog filtered, ordered or limited by f:
+// limit is applied to all preloaded objects, not to objects of every row
+// nolint: dupl
+func (qs PostQuerySet) PreloadBlogWhere(f func(BlogQuerySet) BlogQuerySet) PostQuerySet {
+	return qs.w(preload(qs.db, "Blog", func(db *gorm.DB) *gorm.DB {
+		return f(NewBlogQuerySet(db)).db
+	}))
+}
+
+// PreloadUser is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) PreloadUser() PostQuerySet {
+	return qs.w(preload(qs.db, "User"))
+}
+
+// PreloadUserWhere preloads User filtered, ordered or limited by f:
+// limit is applied to all preloaded objects, not to objects of every row
+// nolint: dupl
+func (qs PostQuerySet) PreloadUserWhere(f func(UserQuerySet) UserQuerySet) PostQuerySet {
+	return qs.w(preload(qs.db, "User", func(db *gorm.DB) *gorm.DB {
+		return f(NewUserQuerySet(db)).db
+	}))
+}
+
+// SelectBlogName returns subquery selecting BlogName of rows of queryset
+// for InQuery and NotInQuery filters
+// nolint: dupl
+func (qs PostQuerySet) SelectBlogName() StringSubquery {
+	return StringSubquery{db: qs.db.Select("blog_join.myname")}
+}
+
+// SelectID returns subquery selecting ID of rows of queryset
+// for InQuery and NotInQuery filters
+// nolint: dupl
+func (qs PostQuerySet) SelectID() UintSubquery {
+	return UintSubquery{db: qs.db.Select(qs.column("id"))}
+}
+
+// SelectStr returns subquery selecting Str of rows of queryset
+// for InQuery and NotInQuery filters
+// nolint: dupl
+func (qs PostQuerySet) SelectStr() TmpStringDefSubquery {
+	return TmpStringDefSubquery{db: qs.db.Select(qs.column("str"))}
+}
+
+// SelectUserEmail returns subquery selecting UserEmail of rows of queryset
+// for InQuery and NotInQuery filters
+// nolint: dupl
+func (qs PostQuerySet) SelectUserEmail() StringSubquery {
+	return StringSubquery{db: qs.db.Select("user_join.email")}
+}
+
+// SelectUserID returns subquery selecting UserID of rows of queryset
+// for InQuery and NotInQuery filters
+// nolint: dupl
+func (qs PostQuerySet) SelectUserID() UintSubquery {
+	return UintSubquery{db: qs.db.Select(qs.column("user_id"))}
+}
+
+// SelectUserName returns subquery selecting UserName of rows of queryset
+// for InQuery and NotInQuery filters
+// nolint: dupl
+func (qs PostQuerySet) SelectUserName() StringSubquery {
+	return StringSubquery{db: qs.db.Select("user_join.name")}
+}
+
+// StrEndsWith filters rows with Str ending with the argument
+// nolint: dupl
+func (qs PostQuerySet) StrEndsWith(str tmp.StringDef) PostQuerySet {
+	return qs.w(whereLike(qs.db, qs.column("str"), "%", string(str), "", false))
+}
+
+// StrEq is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) StrEq(str tmp.StringDef) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("str")+" = ?", str))
+}
+
+// StrGt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) StrGt(str tmp.StringDef) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("str")+" > ?", str))
+}
+
+// StrGte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) StrGte(str tmp.StringDef) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("str")+" >= ?", str))
+}
+
+// StrIContains filters rows with Str containing the argument, case is ignored
+// nolint: dupl
+func (qs PostQuerySet) StrIContains(str tmp.StringDef) PostQuerySet {
+	return qs.w(whereLike(qs.db, qs.column("str"), "%", string(str), "%", true))
+}
+
+// StrIEq filters rows with Str equal to the argument, case is ignored
+// nolint: dupl
+func (qs PostQuerySet) StrIEq(str tmp.StringDef) PostQuerySet {
+	return qs.w(whereLike(qs.db, qs.column("str"), "", string(str), "", true))
+}
+
+// StrIn is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) StrIn(str ...tmp.StringDef) PostQuerySet {
+	if len(str) == 0 {
+		qs.db.AddError(errors.New("must at least pass one str in StrIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where(qs.column("str")+" IN (?)", str))
+}
+
+// StrInQuery filters rows with Str IN values selected by subquery q
+// nolint: dupl
+func (qs PostQuerySet) StrInQuery(q TmpStringDefSubquery) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("str")+" IN (?)", q.expr()))
+}
+
+// StrLike is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) StrLike(str tmp.StringDef) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("str")+" LIKE ?", str))
+}
+
+// StrLt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) StrLt(str tmp.StringDef) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("str")+" < ?", str))
+}
+
+// StrLte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) StrLte(str tmp.StringDef) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("str")+" <= ?", str))
+}
+
+// StrNe is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) StrNe(str tmp.StringDef) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("str")+" != ?", str))
+}
+
+// StrNotIn is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) StrNotIn(str ...tmp.StringDef) PostQuerySet {
+	if len(str) == 0 {
+		qs.db.AddError(errors.New("must at least pass one str in StrNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where(qs.column("str")+" NOT IN (?)", str))
+}
+
+// StrNotInQuery filters rows with Str NOT IN values selected by subquery q
+// nolint: dupl
+func (qs PostQuerySet) StrNotInQuery(q TmpStringDefSubquery) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("str")+" NOT IN (?)", q.expr()))
+}
+
+// StrNotlike is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) StrNotlike(str tmp.StringDef) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("str")+" NOT LIKE ?", str))
+}
+
+// StrStartsWith filters rows with Str starting with the argument
+// nolint: dupl
+func (qs PostQuerySet) StrStartsWith(str tmp.StringDef) PostQuerySet {
+	return qs.w(whereLike(qs.db, qs.column("str"), "", string(str), "%", false))
+}
+
+// TitleEndsWith filters rows with Title ending with the argument
+// nolint: dupl
+func (qs PostQuerySet) TitleEndsWith(title string) PostQuerySet {
+	return qs.w(whereLike(qs.db, qs.column("title"), "%", title, "", false))
+}
+
+// TitleEq is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) TitleEq(title string) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("title")+" = ?", title))
+}
+
+// TitleGt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) TitleGt(title string) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("title")+" > ?", title))
+}
+
+// TitleGte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) TitleGte(title string) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("title")+" >= ?", title))
+}
+
+// TitleIContains filters rows with Title containing the argument, case is ignored
+// nolint: dupl
+func (qs PostQuerySet) TitleIContains(title string) PostQuerySet {
+	return qs.w(whereLike(qs.db, qs.column("title"), "%", title, "%", true))
+}
+
+// TitleIEq filters rows with Title equal to the argument, case is ignored
+// nolint: dupl
+func (qs PostQuerySet) TitleIEq(title string) PostQuerySet {
+	return qs.w(whereLike(qs.db, qs.column("title"), "", title, "", true))
+}
+
+// TitleIn is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) TitleIn(title ...string) PostQuerySet {
+	if len(title) == 0 {
+		qs.db.AddError(errors.New("must at least pass one title in TitleIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where(qs.column("title")+" IN (?)", title))
+}
+
+// TitleInQuery filters rows with Title IN values selected by subquery q
+// nolint: dupl
+func (qs PostQuerySet) TitleInQuery(q StringSubquery) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("title")+" IN (?)", q.expr()))
+}
+
+// TitleIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) TitleIsNotNull() PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("title") + " IS NOT NULL"))
+}
+
+// TitleIsNull is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) TitleIsNull() PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("title") + " IS NULL"))
+}
+
+// TitleLike is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) TitleLike(title string) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("title")+" LIKE ?", title))
+}
+
+// TitleLt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) TitleLt(title string) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("title")+" < ?", title))
+}
+
+// TitleLte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) TitleLte(title string) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("title")+" <= ?", title))
+}
+
+// TitleNe is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) TitleNe(title string) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("title")+" != ?", title))
+}
+
+// TitleNotIn is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) TitleNotIn(title ...string) PostQuerySet {
+	if len(title) == 0 {
+		qs.db.AddError(errors.New("must at least pass one title in TitleNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where(qs.column("title")+" NOT IN (?)", title))
+}
+
+// TitleNotInQuery filters rows with Title NOT IN values selected by subquery q
+// nolint: dupl
+func (qs PostQuerySet) TitleNotInQuery(q StringSubquery) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("title")+" NOT IN (?)", q.expr()))
+}
+
+// TitleNotlike is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) TitleNotlike(title string) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("title")+" NOT LIKE ?", title))
+}
+
+// TitleStartsWith filters rows with Title starting with the argument
+// nolint: dupl
+func (qs PostQuerySet) TitleStartsWith(title string) PostQuerySet {
+	return qs.w(whereLike(qs.db, qs.column("title"), "", title, "%", false))
+}
+
+// UpdatedAtBetween filters rows with UpdatedAt in range [from, to]
+// nolint: dupl
+func (qs PostQuerySet) UpdatedAtBetween(from time.Time, to time.Time) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("updated_at")+" BETWEEN ? AND ?", from, to))
+}
+
+// UpdatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UpdatedAtEq(updatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("updated_at")+" = ?", updatedAt))
+}
+
+// UpdatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UpdatedAtGt(updatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("updated_at")+" > ?", updatedAt))
+}
+
+// UpdatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UpdatedAtGte(updatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("updated_at")+" >= ?", updatedAt))
+}
+
+// UpdatedAtInRange filters rows with UpdatedAt in range [from, to)
+// nolint: dupl
+func (qs PostQuerySet) UpdatedAtInRange(from time.Time, to time.Time) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("updated_at")+" >= ? AND "+qs.column("updated_at")+" < ?", from, to))
+}
+
+// UpdatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UpdatedAtLt(updatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("updated_at")+" < ?", updatedAt))
+}
+
+// UpdatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UpdatedAtLte(updatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("updated_at")+" <= ?", updatedAt))
+}
+
+// UpdatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UpdatedAtNe(updatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("updated_at")+" != ?", updatedAt))
+}
+
+// UpdatedAtWithin filters rows with UpdatedAt not earlier than d ago
+// nolint: dupl
+func (qs PostQuerySet) UpdatedAtWithin(d time.Duration) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("updated_at")+" >= ?", time.Now().Add(-d)))
+}
+
+// UserCreatedAtBetween filters rows with UserCreatedAt in range [from, to]
+// nolint: dupl
+func (qs PostQuerySet) UserCreatedAtBetween(from time.Time, to time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.created_at BETWEEN ? AND ?", from, to))
+}
+
+// UserCreatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserCreatedAtEq(userCreatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.created_at = ?", userCreatedAt))
+}
+
+// UserCreatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserCreatedAtGt(userCreatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.created_at > ?", userCreatedAt))
+}
+
+// UserCreatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserCreatedAtGte(userCreatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.created_at >= ?", userCreatedAt))
+}
+
+// UserCreatedAtInRange filters rows with UserCreatedAt in range [from, to)
+// nolint: dupl
+func (qs PostQuerySet) UserCreatedAtInRange(from time.Time, to time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.created_at >= ? AND user_join.created_at < ?", from, to))
+}
+
+// UserCreatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserCreatedAtLt(userCreatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.created_at < ?", userCreatedAt))
+}
+
+// UserCreatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserCreatedAtLte(userCreatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.created_at <= ?", userCreatedAt))
+}
+
+// UserCreatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserCreatedAtNe(userCreatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.created_at != ?", userCreatedAt))
+}
+
+// UserCreatedAtWithin filters rows with UserCreatedAt not earlier than d ago
+// nolint: dupl
+func (qs PostQuerySet) UserCreatedAtWithin(d time.Duration) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.created_at >= ?", time.Now().Add(-d)))
+}
+
+// UserDeletedAtBetween filters rows with UserDeletedAt in range [from, to]
+// nolint: dupl
+func (qs PostQuerySet) UserDeletedAtBetween(from time.Time, to time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.deleted_at BETWEEN ? AND ?", from, to))
+}
+
+// UserDeletedAtEq is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserDeletedAtEq(userDeletedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.deleted_at = ?", userDeletedAt))
+}
+
+// UserDeletedAtGt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserDeletedAtGt(userDeletedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.deleted_at > ?", userDeletedAt))
+}
+
+// UserDeletedAtGte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserDeletedAtGte(userDeletedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.deleted_at >= ?", userDeletedAt))
+}
+
+// UserDeletedAtInRange filters rows with UserDeletedAt in range [from, to)
+// nolint: dupl
+func (qs PostQuerySet) UserDeletedAtInRange(from time.Time, to time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.deleted_at >= ? AND user_join.deleted_at < ?", from, to))
+}
+
+// UserDeletedAtIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserDeletedAtIsNotNull() PostQuerySet {
+	return qs.w(qs.db.Where("user_join.deleted_at IS NOT NULL"))
+}
+
+// UserDeletedAtIsNull is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserDeletedAtIsNull() PostQuerySet {
+	return qs.w(qs.db.Where("user_join.deleted_at IS NULL"))
+}
+
+// UserDeletedAtLt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserDeletedAtLt(userDeletedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.deleted_at < ?", userDeletedAt))
+}
+
+// UserDeletedAtLte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserDeletedAtLte(userDeletedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.deleted_at <= ?", userDeletedAt))
+}
+
+// UserDeletedAtNe is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserDeletedAtNe(userDeletedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.deleted_at != ?", userDeletedAt))
+}
+
+// UserDeletedAtWithin filters rows with UserDeletedAt not earlier than d ago
+// nolint: dupl
+func (qs PostQuerySet) UserDeletedAtWithin(d time.Duration) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.deleted_at >= ?", time.Now().Add(-d)))
+}
+
+// UserEmailEndsWith filters rows with UserEmail ending with the argument
+// nolint: dupl
+func (qs PostQuerySet) UserEmailEndsWith(userEmail string) PostQuerySet {
+	return qs.w(whereLike(qs.db, "user_join.email", "%", userEmail, "", false))
+}
+
+// UserEmailEq is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserEmailEq(userEmail string) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.email = ?", userEmail))
+}
+
+// UserEmailGt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserEmailGt(userEmail string) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.email > ?", userEmail))
+}
+
+// UserEmailGte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserEmailGte(userEmail string) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.email >= ?", userEmail))
+}
+
+// UserEmailIContains filters rows with UserEmail containing the argument, case is ignored
+// nolint: dupl
+func (qs PostQuerySet) UserEmailIContains(userEmail string) PostQuerySet {
+	return qs.w(whereLike(qs.db, "user_join.email", "%", userEmail, "%", true))
+}
+
+// UserEmailIEq filters rows with UserEmail equal to the argument, case is ignored
+// nolint: dupl
+func (qs PostQuerySet) UserEmailIEq(userEmail string) PostQuerySet {
+	return qs.w(whereLike(qs.db, "user_join.email", "", userEmail, "", true))
+}
+
+// UserEmailIn is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserEmailIn(userEmail ...string) PostQuerySet {
+	if len(userEmail) == 0 {
+		qs.db.AddError(errors.New("must at least pass one userEmail in UserEmailIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("user_join.email IN (?)", userEmail))
+}
+
+// UserEmailInQuery filters rows with UserEmail IN values selected by subquery q
+// nolint: dupl
+func (qs PostQuerySet) UserEmailInQuery(q StringSubquery) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.email IN (?)", q.expr()))
+}
+
+// UserEmailLike is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserEmailLike(userEmail string) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.email LIKE ?", userEmail))
+}
+
+// UserEmailLt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserEmailLt(userEmail string) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.email < ?", userEmail))
+}
+
+// UserEmailLte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserEmailLte(userEmail string) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.email <= ?", userEmail))
+}
+
+// UserEmailNe is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserEmailNe(userEmail string) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.email != ?", userEmail))
+}
+
+// UserEmailNotIn is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserEmailNotIn(userEmail ...string) PostQuerySet {
+	if len(userEmail) == 0 {
+		qs.db.AddError(errors.New("must at least pass one userEmail in UserEmailNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("user_join.email NOT IN (?)", userEmail))
+}
+
+// UserEmailNotInQuery filters rows with UserEmail NOT IN values selected by subquery q
+// nolint: dupl
+func (qs PostQuerySet) UserEmailNotInQuery(q StringSubquery) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.email NOT IN (?)", q.expr()))
+}
+
+// UserEmailNotlike is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserEmailNotlike(userEmail string) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.email NOT LIKE ?", userEmail))
+}
+
+// UserEmailStartsWith filters rows with UserEmail starting with the argument
+// nolint: dupl
+func (qs PostQuerySet) UserEmailStartsWith(userEmail string) PostQuerySet {
+	return qs.w(whereLike(qs.db, "user_join.email", "", userEmail, "%", false))
+}
+
+// UserIDBetween filters rows with UserID in range [from, to]
+// nolint: dupl
+func (qs PostQuerySet) UserIDBetween(from uint, to uint) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("user_id")+" BETWEEN ? AND ?", from, to))
+}
+
+// UserIDEq is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserIDEq(userID uint) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("user_id")+" = ?", userID))
+}
+
+// UserIDGt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserIDGt(userID uint) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("user_id")+" > ?", userID))
+}
+
+// UserIDGte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserIDGte(userID uint) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("user_id")+" >= ?", userID))
+}
+
+// UserIDIn is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserIDIn(userID ...uint) PostQuerySet {
+	if len(userID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one userID in UserIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where(qs.column("user_id")+" IN (?)", userID))
+}
+
+// UserIDInQuery filters rows with UserID IN values selected by subquery q
+// nolint: dupl
+func (qs PostQuerySet) UserIDInQuery(q UintSubquery) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("user_id")+" IN (?)", q.expr()))
+}
+
+// UserIDInRange filters rows with UserID in range [from, to)
+// nolint: dupl
+func (qs PostQuerySet) UserIDInRange(from uint, to uint) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("user_id")+" >= ? AND "+qs.column("user_id")+" < ?", from, to))
+}
+
+// UserIDLt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserIDLt(userID uint) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("user_id")+" < ?", userID))
+}
+
+// UserIDLte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserIDLte(userID uint) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("user_id")+" <= ?", userID))
+}
+
+// UserIDNe is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserIDNe(userID uint) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("user_id")+" != ?", userID))
+}
+
+// UserIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserIDNotIn(userID ...uint) PostQuerySet {
+	if len(userID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one userID in UserIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where(qs.column("user_id")+" NOT IN (?)", userID))
+}
+
+// UserIDNotInQuery filters rows with UserID NOT IN values selected by subquery q
+// nolint: dupl
+func (qs PostQuerySet) UserIDNotInQuery(q UintSubquery) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("user_id")+" NOT IN (?)", q.expr()))
+}
+
+// UserNameEndsWith filters rows with UserName ending with the argument
+// nolint: dupl
+func (qs PostQuerySet) UserNameEndsWith(userName string) PostQuerySet {
+	return qs.w(whereLike(qs.db, "user_join.name", "%", userName, "", false))
+}
+
+// UserNameEq is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserNameEq(userName string) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.name = ?", userName))
+}
+
+// UserNameGt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserNameGt(userName string) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.name > ?", userName))
+}
+
+// UserNameGte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserNameGte(userName string) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.name >= ?", userName))
+}
+
+// UserNameIContains filters rows with UserName containing the argument, case is ignored
+// nolint: dupl
+func (qs PostQuerySet) UserNameIContains(userName string) PostQuerySet {
+	return qs.w(whereLike(qs.db, "user_join.name", "%", userName, "%", true))
+}
+
+// UserNameIEq filters rows with UserName equal to the argument, case is ignored
+// nolint: dupl
+func (qs PostQuerySet) UserNameIEq(userName string) PostQuerySet {
+	return qs.w(whereLike(qs.db, "user_join.name", "", userName, "", true))
+}
+
+// UserNameIn is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserNameIn(userName ...string) PostQuerySet {
+	if len(userName) == 0 {
+		qs.db.AddError(errors.New("must at least pass one userName in UserNameIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("user_join.name IN (?)", userName))
+}
+
+// UserNameInQuery filters rows with UserName IN values selected by subquery q
+// nolint: dupl
+func (qs PostQuerySet) UserNameInQuery(q StringSubquery) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.name IN (?)", q.expr()))
+}
+
+// UserNameLike is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserNameLike(userName string) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.name LIKE ?", userName))
+}
+
+// UserNameLt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserNameLt(userName string) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.name < ?", userName))
+}
+
+// UserNameLte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserNameLte(userName string) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.name <= ?", userName))
+}
+
+// UserNameNe is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserNameNe(userName string) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.name != ?", userName))
+}
+
+// UserNameNotIn is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserNameNotIn(userName ...string) PostQuerySet {
+	if len(userName) == 0 {
+		qs.db.AddError(errors.New("must at least pass one userName in UserNameNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("user_join.name NOT IN (?)", userName))
+}
+
+// UserNameNotInQuery filters rows with UserName NOT IN values selected by subquery q
+// nolint: dupl
+func (qs PostQuerySet) UserNameNotInQuery(q StringSubquery) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.name NOT IN (?)", q.expr()))
+}
+
+// UserNameNotlike is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserNameNotlike(userName string) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.name NOT LIKE ?", userName))
+}
+
+// UserNameStartsWith filters rows with UserName starting with the argument
+// nolint: dupl
+func (qs PostQuerySet) UserNameStartsWith(userName string) PostQuerySet {
+	return qs.w(whereLike(qs.db, "user_join.name", "", userName, "%", false))
+}
+
+// UserSurnameEndsWith filters rows with UserSurname ending with the argument
+// nolint: dupl
+func (qs PostQuerySet) UserSurnameEndsWith(userSurname string) PostQuerySet {
+	return qs.w(whereLike(qs.db, "user_join.user_surname", "%", userSurname, "", false))
+}
+
+// UserSurnameEq is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserSurnameEq(userSurname string) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.user_surname = ?", userSurname))
+}
+
+// UserSurnameGt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserSurnameGt(userSurname string) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.user_surname > ?", userSurname))
+}
+
+// UserSurnameGte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserSurnameGte(userSurname string) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.user_surname >= ?", userSurname))
+}
+
+// UserSurnameIContains filters rows with UserSurname containing the argument, case is ignored
+// nolint: dupl
+func (qs PostQuerySet) UserSurnameIContains(userSurname string) PostQuerySet {
+	return qs.w(whereLike(qs.db, "user_join.user_surname", "%", userSurname, "%", true))
+}
+
+// UserSurnameIEq filters rows with UserSurname equal to the argument, case is ignored
+// nolint: dupl
+func (qs PostQuerySet) UserSurnameIEq(userSurname string) PostQuerySet {
+	return qs.w(whereLike(qs.db, "user_join.user_surname", "", userSurname, "", true))
+}
+
+// UserSurnameIn is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserSurnameIn(userSurname ...string) PostQuerySet {
+	if len(userSurname) == 0 {
+		qs.db.AddError(errors.New("must at least pass one userSurname in UserSurnameIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("user_join.user_surname IN (?)", userSurname))
+}
+
+// UserSurnameInQuery filters rows with UserSurname IN values selected by subquery q
+// nolint: dupl
+func (qs PostQuerySet) UserSurnameInQuery(q StringSubquery) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.user_surname IN (?)", q.expr()))
+}
+
+// UserSurnameIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserSurnameIsNotNull() PostQuerySet {
+	return qs.w(qs.db.Where("user_join.user_surname IS NOT NULL"))
+}
+
+// UserSurnameIsNull is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserSurnameIsNull() PostQuerySet {
+	return qs.w(qs.db.Where("user_join.user_surname IS NULL"))
+}
+
+// UserSurnameLike is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserSurnameLike(userSurname string) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.user_surname LIKE ?", userSurname))
+}
+
+// UserSurnameLt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserSurnameLt(userSurname string) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.user_surname < ?", userSurname))
+}
+
+// UserSurnameLte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserSurnameLte(userSurname string) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.user_surname <= ?", userSurname))
+}
+
+// UserSurnameNe is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserSurnameNe(userSurname string) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.user_surname != ?", userSurname))
+}
+
+// UserSurnameNotIn is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserSurnameNotIn(userSurname ...string) PostQuerySet {
+	if len(userSurname) == 0 {
+		qs.db.AddError(errors.New("must at least pass one userSurname in UserSurnameNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("user_join.user_surname NOT IN (?)", userSurname))
+}
+
+// UserSurnameNotInQuery filters rows with UserSurname NOT IN values selected by subquery q
+// nolint: dupl
+func (qs PostQuerySet) UserSurnameNotInQuery(q StringSubquery) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.user_surname NOT IN (?)", q.expr()))
+}
+
+// UserSurnameNotlike is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserSurnameNotlike(userSurname string) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.user_surname NOT LIKE ?", userSurname))
+}
+
+// UserSurnameStartsWith filters rows with UserSurname starting with the argument
+// nolint: dupl
+func (qs PostQuerySet) UserSurnameStartsWith(userSurname string) PostQuerySet {
+	return qs.w(whereLike(qs.db, "user_join.user_surname", "", userSurname, "%", false))
+}
+
+// UserUpdatedAtBetween filters rows with UserUpdatedAt in range [from, to]
+// nolint: dupl
+func (qs PostQuerySet) UserUpdatedAtBetween(from time.Time, to time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.updated_at BETWEEN ? AND ?", from, to))
+}
+
+// UserUpdatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserUpdatedAtEq(userUpdatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.updated_at = ?", userUpdatedAt))
+}
+
+// UserUpdatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserUpdatedAtGt(userUpdatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.updated_at > ?", userUpdatedAt))
+}
+
+// UserUpdatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserUpdatedAtGte(userUpdatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.updated_at >= ?", userUpdatedAt))
+}
+
+// UserUpdatedAtInRange filters rows with UserUpdatedAt in range [from, to)
+// nolint: dupl
+func (qs PostQuerySet) UserUpdatedAtInRange(from time.Time, to time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.updated_at >= ? AND user_join.updated_at < ?", from, to))
+}
+
+// UserUpdatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserUpdatedAtLt(userUpdatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.updated_at < ?", userUpdatedAt))
+}
+
+// UserUpdatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserUpdatedAtLte(userUpdatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.updated_at <= ?", userUpdatedAt))
+}
+
+// UserUpdatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserUpdatedAtNe(userUpdatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.updated_at != ?", userUpdatedAt))
+}
+
+// UserUpdatedAtWithin filters rows with UserUpdatedAt not earlier than d ago
+// nolint: dupl
+func (qs PostQuerySet) UserUpdatedAtWithin(d time.Duration) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.updated_at >= ?", time.Now().Add(-d)))
+}
+
+// WithContext returns copy executing queries with context ctx.
+// Gorm v1 doesn't support context: its error is checked only before query.
+// nolint: dupl
+func (qs PostQuerySet) WithContext(ctx context.Context) PostQuerySet {
+	return qs.w(DBWithContext(ctx, qs.db))
+}
+
+// column returns column name qualified by quoted table of model
+// nolint: dupl
+func (qs PostQuerySet) column(name string) string {
+	return qs.quotedTableName(&Post{}) + "." + name
+}
+
+// fieldPtrs returns pointers to fields of o by their columns
+// nolint: dupl
+func (qs PostQuerySet) fieldPtrs(o *Post) map[string]interface{} {
+	return map[string]interface{}{
+		"id":         &o.ID,
+		"created_at": &o.CreatedAt,
+		"updated_at": &o.UpdatedAt,
+		"deleted_at": &o.DeletedAt,
+		"blog_id":    &o.BlogID,
+		"user_id":    &o.UserID,
+		"title":      &o.Title,
+		"str":        &o.Str,
+	}
+}
+
+// quotedTableName returns quoted name of model's table
+// nolint: dupl
+func (qs PostQuerySet) quotedTableName(model interface{}) string {
+	return qs.db.New().NewScope(model).QuotedTableName()
+}
+
+// InTx returns copy bound to transaction tx: queries are executed in it.
+// Gorm v1 can't move conditions into another connection, so with gorm v1
+// InTx must be called before any condition is set, otherwise queries fail.
+// Context and preloads are moved into transaction by both gorm versions.
+// nolint: dupl
+func (u PostUpdater) InTx(tx *gorm.DB) PostUpdater {
+	if !reflect.DeepEqual(u.db.QueryExpr(), u.db.New().Model(&Post{}).QueryExpr()) {
+		tx = tx.New()
+		tx.AddError(errors.New("InTx must be called before any condition is set: " +
+			"gorm v1 can't move conditions into transaction"))
+	}
+	tx = txWithSettings(u.db, tx)
+	u.db = tx.Model(&Post{})
+	return u
+}
+
+// SetBlog sets foreign key BlogID to ID of blog, nil clears it
+// nolint: dupl
+func (u PostUpdater) SetBlog(blog *Blog) PostUpdater {
+	var id *uint
+	if blog != nil {
+		v := blog.ID
+		id = &v
+	}
+	u.fields[string(PostDBSchema.BlogID)] = id
+	return u
+}
+
+// SetBlogID is an autogenerated method
+// nolint: dupl
+func (u PostUpdater) SetBlogID(blogID *uint) PostUpdater {
+	u.fields[string(PostDBSchema.BlogID)] = blogID
+	return u
+}
+
+// SetCreatedAt is an autogenerated method
+// nolint: dupl
+func (u PostUpdater) SetCreatedAt(createdAt time.Time) PostUpdater {
+	u.fields[string(PostDBSchema.CreatedAt)] = createdAt
+	return u
+}
+
+// SetDeletedAt is an autogenerated method
+// nolint: dupl
+func (u PostUpdater) SetDeletedAt(deletedAt *time.Time) PostUpdater {
+	u.fields[string(PostDBSchema.DeletedAt)] = deletedAt
+	return u
+}
+
+// SetID is an autogenerated method
+// nolint: dupl
+func (u PostUpdater) SetID(ID uint) PostUpdater {
+	u.fields[string(PostDBSchema.ID)] = ID
+	return u
+}
+
+// SetStr is an autogenerated method
+// nolint: dupl
+func (u PostUpdater) SetStr(str tmp.StringDef) PostUpdater {
+	u.fields[string(PostDBSchema.Str)] = str
+	return u
+}
+
+// SetTitle is an autogenerated method
+// nolint: dupl
+func (u PostUpdater) SetTitle(title *string) PostUpdater {
+	u.fields[string(PostDBSchema.Title)] = title
+	return u
+}
+
+// SetUpdatedAt is an autogenerated method
+// nolint: dupl
+func (u PostUpdater) SetUpdatedAt(updatedAt time.Time) PostUpdater {
+	u.fields[string(PostDBSchema.UpdatedAt)] = updatedAt
+	return u
+}
+
+// SetUser sets foreign key UserID to ID of user, nil clears it
+// nolint: dupl
+func (u PostUpdater) SetUser(user *User) PostUpdater {
+	var id uint
+	if user != nil {
+		id = user.ID
+	}
+	u.fields[string(PostDBSchema.UserID)] = id
+	return u
+}
+
+// SetUserID is an autogenerated method
+// nolint: dupl
+func (u PostUpdater) SetUserID(userID uint) PostUpdater {
+	u.fields[string(PostDBSchema.UserID)] = userID
+	return u
+}
+
+// Update is an autogenerated method
+// nolint: dupl
+func (u PostUpdater) Update() error {
+	return checkQueryContext(u.db).Updates(u.fields).Error
+}
+
+// UpdateNum is an autogenerated method
+// nolint: dupl
+func (u PostUpdater) UpdateNum() (int64, error) {
+	db := checkQueryContext(u.db).Updates(u.fields)
+	return db.RowsAffected, db.Error
+}
+
+// WithContext returns copy executing queries with context ctx.
+// Gorm v1 doesn't support context: its error is checked only before query.
+// nolint: dupl
+func (u PostUpdater) WithContext(ctx context.Context) PostUpdater {
+	u.db = DBWithContext(ctx, u.db)
+	return u
+}
+
+// ===== END of query set PostQuerySet
+
+// ===== BEGIN of Post modifiers
+
+// PostDBSchemaField describes database schema field. It requires for method 'Update'
+type PostDBSchemaField string
+
+// String method returns string representation of field.
+// nolint: dupl
+func (f PostDBSchemaField) String() string {
+	return string(f)
+}
+
+// PostDBSchema stores db field names of Post
+var PostDBSchema = struct {
+	ID        PostDBSchemaField
+	CreatedAt PostDBSchemaField
+	UpdatedAt PostDBSchemaField
+	DeletedAt PostDBSchemaField
+	BlogID    PostDBSchemaField
+	Blog      PostDBSchemaField
+	UserID    PostDBSchemaField
+	User      PostDBSchemaField
+	Title     PostDBSchemaField
+	Str       PostDBSchemaField
+}{
+
+	ID:        PostDBSchemaField("id"),
+	CreatedAt: PostDBSchemaField("created_at"),
+	UpdatedAt: PostDBSchemaField("updated_at"),
+	DeletedAt: PostDBSchemaField("deleted_at"),
+	BlogID:    PostDBSchemaField("blog_id"),
+	Blog:      PostDBSchemaField("blog"),
+	UserID:    PostDBSchemaField("user_id"),
+	User:      PostDBSchemaField("user"),
+	Title:     PostDBSchemaField("title"),
+	Str:       PostDBSchemaField("str"),
+}
+
+// Update updates Post fields by primary key
+// nolint: dupl
+func (o *Post) Update(db *gorm.DB, fields ...PostDBSchemaField) error {
+	dbNameToFieldName := map[string]interface{}{
+		"id":         o.ID,
+		"created_at": o.CreatedAt,
+		"updated_at": o.UpdatedAt,
+		"deleted_at": o.DeletedAt,
+		"blog_id":    o.BlogID,
+		"blog":       o.Blog,
+		"user_id":    o.UserID,
+		"user":       o.User,
+		"title":      o.Title,
+		"str":        o.Str,
+	}
+	u := map[string]interface{}{}
+	for _, f := range fields {
+		fs := f.String()
+		u[fs] = dbNameToFieldName[fs]
+	}
+	if err := checkQueryContext(db).Model(o).Updates(u).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return err
+		}
+
+		return fmt.Errorf("can't update Post %v fields %v: %s",
+			o, fields, err)
+	}
+
+	return nil
+}
+
+// Upsert inserts Post or updates updateFields of existing row
+// if insert violates unique constraint on conflictFields. Row isn't
+// changed if there are no updateFields: any conflict is ignored then if
+// there are no conflictFields. MySQL ignores conflictFields: it checks
+// all unique constraints, other databases require them to update row.
+// nolint: dupl
+func (o *Post) Upsert(db *gorm.DB, conflictFields []PostDBSchemaField,
+	updateFields ...PostDBSchemaField) error {
+
+	conflictColumns := make([]string, 0, len(conflictFields))
+	for _, f := range conflictFields {
+		conflictColumns = append(conflictColumns, f.String())
+	}
+	updateColumns := make([]string, 0, len(updateFields))
+	for _, f := range updateFields {
+		updateColumns = append(updateColumns, f.String())
+	}
+
+	qs := NewPostQuerySet(db)
+	return upsert(checkQueryContext(qs.db), "id", qs.fieldPtrs(o),
+		conflictColumns, updateColumns)
+}
+
+// PostUpdater is an Post updates manager
+type PostUpdater struct {
+	fields map[string]interface{}
+	db     *gorm.DB
+}
+
+// NewPostUpdater creates new Post updater
+// nolint: dupl
+func NewPostUpdater(db *gorm.DB) PostUpdater {
+	return PostUpdater{
+		fields: map[string]interface{}{},
+		db:     db.Model(&Post{}),
+	}
+}
+
+// ===== END of Post modifiers
+
+// ===== BEGIN of Post predicates
+
+// PostPredicate is a condition on Post fields for Where and Not
+// methods of PostQuerySet: predicates are created by PostQ and
+// combined by And, Or and Not. Zero predicate matches all rows.
+type PostPredicate struct {
+	expr string
+	args []interface{}
+	err  error
+}
+
+// join combines predicates by operation op, empty predicates are skipped
+// nolint: dupl
+func (p PostPredicate) join(op string, preds []PostPredicate) PostPredicate {
+	for _, q := range preds {
+		if p.err == nil {
+			p.err = q.err
+		}
+		if q.expr == "" {
+			continue
+		}
+		if p.expr == "" {
+			p.expr, p.args = q.expr, q.args
+			continue
+		}
+
+		p.expr = "(" + p.expr + ") " + op + " (" + q.expr + ")"
+		p.args = append(append([]interface{}{}, p.args...), q.args...)
+	}
+
+	return p
+}
+
+// And returns predicate matching rows matched by p and all preds
+// nolint: dupl
+func (p PostPredicate) And(preds ...PostPredicate) PostPredicate {
+	return p.join("AND", preds)
+}
+
+// Or returns predicate matching rows matched by p or any of preds
+// nolint: dupl
+func (p PostPredicate) Or(preds ...PostPredicate) PostPredicate {
+	return p.join("OR", preds)
+}
+
+// Not returns predicate matching rows not matched by p
+// nolint: dupl
+func (p PostPredicate) Not() PostPredicate {
+	if p.expr != "" {
+		p.expr = "NOT (" + p.expr + ")"
+	}
+
+	return p
+}
+
+// PostPredicates creates predicates by Post fields
+type PostPredicates struct{}
+
+// PostQ creates predicates by Post fields
+var PostQ PostPredicates
+
+// BlogIDEq creates predicate "blog_id = ?"
+// nolint: dupl
+func (PostPredicates) BlogIDEq(blogID uint) PostPredicate {
+	return PostPredicate{expr: "{table}.blog_id = ?", args: []interface{}{blogID}}
+}
+
+// BlogIDGt creates predicate "blog_id > ?"
+// nolint: dupl
+func (PostPredicates) BlogIDGt(blogID uint) PostPredicate {
+	return PostPredicate{expr: "{table}.blog_id > ?", args: []interface{}{blogID}}
+}
+
+// BlogIDGte creates predicate "blog_id >= ?"
+// nolint: dupl
+func (PostPredicates) BlogIDGte(blogID uint) PostPredicate {
+	return PostPredicate{expr: "{table}.blog_id >= ?", args: []interface{}{blogID}}
+}
+
+// BlogIDIn creates predicate "blog_id IN (?)"
+// nolint: dupl
+func (PostPredicates) BlogIDIn(blogID ...uint) PostPredicate {
+	if len(blogID) == 0 {
+		return PostPredicate{err: errors.New("must at least pass one blogID in BlogIDIn")}
+	}
+	return PostPredicate{expr: "{table}.blog_id IN (?)", args: []interface{}{blogID}}
+}
+
+// BlogIDIsNotNull creates predicate "blog_id IS NOT NULL"
+// nolint: dupl
+func (PostPredicates) BlogIDIsNotNull() PostPredicate {
+	return PostPredicate{expr: "{table}.blog_id IS NOT NULL"}
+}
+
+// BlogIDIsNull creates predicate "blog_id IS NULL"
+// nolint: dupl
+func (PostPredicates) BlogIDIsNull() PostPredicate {
+	return PostPredicate{expr: "{table}.blog_id IS NULL"}
+}
+
+// BlogIDLt creates predicate "blog_id < ?"
+// nolint: dupl
+func (PostPredicates) BlogIDLt(blogID uint) PostPredicate {
+	return PostPredicate{expr: "{table}.blog_id < ?", args: []interface{}{blogID}}
+}
+
+// BlogIDLte creates predicate "blog_id <= ?"
+// nolint: dupl
+func (PostPredicates) BlogIDLte(blogID uint) PostPredicate {
+	return PostPredicate{expr: "{table}.blog_id <= ?", args: []interface{}{blogID}}
+}
+
+// BlogIDNe creates predicate "blog_id != ?"
+// nolint: dupl
+func (PostPredicates) BlogIDNe(blogID uint) PostPredicate {
+	return PostPredicate{expr: "{table}.blog_id != ?", args: []interface{}{blogID}}
+}
+
+// BlogIDNotIn creates predicate "blog_id NOT IN (?)"
+// nolint: dupl
+func (PostPredicates) BlogIDNotIn(blogID ...uint) PostPredicate {
+	if len(blogID) == 0 {
+		return PostPredicate{err: errors.New("must at least pass one blogID in BlogIDNotIn")}
+	}
+	return PostPredicate{expr: "{table}.blog_id NOT IN (?)", args: []interface{}{blogID}}
+}
+
+// BlogIsNotNull creates predicate "blog IS NOT NULL"
+// nolint: dupl
+func (PostPredicates) BlogIsNotNull() PostPredicate {
+	return PostPredicate{expr: "{table}.blog IS NOT NULL"}
+}
+
+// BlogIsNull creates predicate "blog IS NULL"
+// nolint: dupl
+func (PostPredicates) BlogIsNull() PostPredicate {
+	return PostPredicate{expr: "{table}.blog IS NULL"}
+}
+
+// CreatedAtEq creates predicate "created_at = ?"
+// nolint: dupl
+func (PostPredicates) CreatedAtEq(createdAt time.Time) PostPredicate {
+	return PostPredicate{expr: "{table}.created_at = ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtGt creates predicate "created_at > ?"
+// nolint: dupl
+func (PostPredicates) CreatedAtGt(createdAt time.Time) PostPredicate {
+	return PostPredicate{expr: "{table}.created_at > ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtGte creates predicate "created_at >= ?"
+// nolint: dupl
+func (PostPredicates) CreatedAtGte(createdAt time.Time) PostPredicate {
+	return PostPredicate{expr: "{table}.created_at >= ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtLt creates predicate "created_at < ?"
+// nolint: dupl
+func (PostPredicates) CreatedAtLt(createdAt time.Time) PostPredicate {
+	return PostPredicate{expr: "{table}.created_at < ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtLte creates predicate "created_at <= ?"
+// nolint: dupl
+func (PostPredicates) CreatedAtLte(createdAt time.Time) PostPredicate {
+	return PostPredicate{expr: "{table}.created_at <= ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtNe creates predicate "created_at != ?"
+// nolint: dupl
+func (PostPredicates) CreatedAtNe(createdAt time.Time) PostPredicate {
+	return PostPredicate{expr: "{table}.created_at != ?", args: []interface{}{createdAt}}
+}
+
+// DeletedAtEq creates predicate "deleted_at = ?"
+// nolint: dupl
+func (PostPredicates) DeletedAtEq(deletedAt time.Time) PostPredicate {
+	return PostPredicate{expr: "{table}.deleted_at = ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtGt creates predicate "deleted_at > ?"
+// nolint: dupl
+func (PostPredicates) DeletedAtGt(deletedAt time.Time) PostPredicate {
+	return PostPredicate{expr: "{table}.deleted_at > ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtGte creates predicate "deleted_at >= ?"
+// nolint: dupl
+func (PostPredicates) DeletedAtGte(deletedAt time.Time) PostPredicate {
+	return PostPredicate{expr: "{table}.deleted_at >= ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtIsNotNull creates predicate "deleted_at IS NOT NULL"
+// nolint: dupl
+func (PostPredicates) DeletedAtIsNotNull() PostPredicate {
+	return PostPredicate{expr: "{table}.deleted_at IS NOT NULL"}
+}
+
+// DeletedAtIsNull creates predicate "deleted_at IS NULL"
+// nolint: dupl
+func (PostPredicates) DeletedAtIsNull() PostPredicate {
+	return PostPredicate{expr: "{table}.deleted_at IS NULL"}
+}
+
+// DeletedAtLt creates predicate "deleted_at < ?"
+// nolint: dupl
+func (PostPredicates) DeletedAtLt(deletedAt time.Time) PostPredicate {
+	return PostPredicate{expr: "{table}.deleted_at < ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtLte creates predicate "deleted_at <= ?"
+// nolint: dupl
+func (PostPredicates) DeletedAtLte(deletedAt time.Time) PostPredicate {
+	return PostPredicate{expr: "{table}.deleted_at <= ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtNe creates predicate "deleted_at != ?"
+// nolint: dupl
+func (PostPredicates) DeletedAtNe(deletedAt time.Time) PostPredicate {
+	return PostPredicate{expr: "{table}.deleted_at != ?", args: []interface{}{deletedAt}}
+}
+
+// IDEq creates predicate "id = ?"
+// nolint: dupl
+func (PostPredicates) IDEq(ID uint) PostPredicate {
+	return PostPredicate{expr: "{table}.id = ?", args: []interface{}{ID}}
+}
+
+// IDGt creates predicate "id > ?"
+// nolint: dupl
+func (PostPredicates) IDGt(ID uint) PostPredicate {
+	return PostPredicate{expr: "{table}.id > ?", args: []interface{}{ID}}
+}
+
+// IDGte creates predicate "id >= ?"
+// nolint: dupl
+func (PostPredicates) IDGte(ID uint) PostPredicate {
+	return PostPredicate{expr: "{table}.id >= ?", args: []interface{}{ID}}
+}
+
+// IDIn creates predicate "id IN (?)"
+// nolint: dupl
+func (PostPredicates) IDIn(ID ...uint) PostPredicate {
+	if len(ID) == 0 {
+		return PostPredicate{err: errors.New("must at least pass one ID in IDIn")}
+	}
+	return PostPredicate{expr: "{table}.id IN (?)", args: []interface{}{ID}}
+}
+
+// IDLt creates predicate "id < ?"
+// nolint: dupl
+func (PostPredicates) IDLt(ID uint) PostPredicate {
+	return PostPredicate{expr: "{table}.id < ?", args: []interface{}{ID}}
+}
+
+// IDLte creates predicate "id <= ?"
+// nolint: dupl
+func (PostPredicates) IDLte(ID uint) PostPredicate {
+	return PostPredicate{expr: "{table}.id <= ?", args: []interface{}{ID}}
+}
+
+// IDNe creates predicate "id != ?"
+// nolint: dupl
+func (PostPredicates) IDNe(ID uint) PostPredicate {
+	return PostPredicate{expr: "{table}.id != ?", args: []interface{}{ID}}
+}
+
+// IDNotIn creates predicate "id NOT IN (?)"
+// nolint: dupl
+func (PostPredicates) IDNotIn(ID ...uint) PostPredicate {
+	if len(ID) == 0 {
+		return PostPredicate{err: errors.New("must at least pass one ID in IDNotIn")}
+	}
+	return PostPredicate{expr: "{table}.id NOT IN (?)", args: []interface{}{ID}}
+}
+
+// StrEq creates predicate "str = ?"
+// nolint: dupl
+func (PostPredicates) StrEq(str tmp.StringDef) PostPredicate {
+	return PostPredicate{expr: "{table}.str = ?", args: []interface{}{str}}
+}
+
+// StrGt creates predicate "str > ?"
+// nolint: dupl
+func (PostPredicates) StrGt(str tmp.StringDef) PostPredicate {
+	return PostPredicate{expr: "{table}.str > ?", args: []interface{}{str}}
+}
+
+// StrGte creates predicate "str >= ?"
+// nolint: dupl
+func (PostPredicates) StrGte(str tmp.StringDef) PostPredicate {
+	return PostPredicate{expr: "{table}.str >= ?", args: []interface{}{str}}
+}
+
+// StrIn creates predicate "str IN (?)"
+// nolint: dupl
+func (PostPredicates) StrIn(str ...tmp.StringDef) PostPredicate {
+	if len(str) == 0 {
+		return PostPredicate{err: errors.New("must at least pass one str in StrIn")}
+	}
+	return PostPredicate{expr: "{table}.str IN (?)", args: []interface{}{str}}
+}
+
+// StrLike creates predicate "str LIKE ?"
+// nolint: dupl
+func (PostPredicates) StrLike(str tmp.StringDef) PostPredicate {
+	return PostPredicate{expr: "{table}.str LIKE ?", args: []interface{}{str}}
+}
+
+// StrLt creates predicate "str < ?"
+// nolint: dupl
+func (PostPredicates) StrLt(str tmp.StringDef) PostPredicate {
+	return PostPredicate{expr: "{table}.str < ?", args: []interface{}{str}}
+}
+
+// StrLte creates predicate "str <= ?"
+// nolint: dupl
+func (PostPredicates) StrLte(str tmp.StringDef) PostPredicate {
+	return PostPredicate{expr: "{table}.str <= ?", args: []interface{}{str}}
+}
+
+// StrNe creates predicate "str != ?"
+// nolint: dupl
+func (PostPredicates) StrNe(str tmp.StringDef) PostPredicate {
+	return PostPredicate{expr: "{table}.str != ?", args: []interface{}{str}}
+}
+
+// StrNotIn creates predicate "str NOT IN (?)"
+// nolint: dupl
+func (PostPredicates) StrNotIn(str ...tmp.StringDef) PostPredicate {
+	if len(str) == 0 {
+		return PostPredicate{err: errors.New("must at least pass one str in StrNotIn")}
+	}
+	return PostPredicate{expr: "{table}.str NOT IN (?)", args: []interface{}{str}}
+}
+
+// StrNotlike creates predicate "str NOT LIKE ?"
+// nolint: dupl
+func (PostPredicates) StrNotlike(str tmp.StringDef) PostPredicate {
+	return PostPredicate{expr: "{table}.str NOT LIKE ?", args: []interface{}{str}}
+}
+
+// TitleEq creates predicate "title = ?"
+// nolint: dupl
+func (PostPredicates) TitleEq(title string) PostPredicate {
+	return PostPredicate{expr: "{table}.title = ?", args: []interface{}{title}}
+}
+
+// TitleGt creates predicate "title > ?"
+// nolint: dupl
+func (PostPredicates) TitleGt(title string) PostPredicate {
+	return PostPredicate{expr: "{table}.title > ?", args: []interface{}{title}}
+}
+
+// TitleGte creates predicate "title >= ?"
+// nolint: dupl
+func (PostPredicates) TitleGte(title string) PostPredicate {
+	return PostPredicate{expr: "{table}.title >= ?", args: []interface{}{title}}
+}
+
+// TitleIn creates predicate "title IN (?)"
+// nolint: dupl
+func (PostPredicates) TitleIn(title ...string) PostPredicate {
+	if len(title) == 0 {
+		return PostPredicate{err: errors.New("must at least pass one title in TitleIn")}
+	}
+	return PostPredicate{expr: "{table}.title IN (?)", args: []interface{}{title}}
+}
+
+// TitleIsNotNull creates predicate "title IS NOT NULL"
+// nolint: dupl
+func (PostPredicates) TitleIsNotNull() PostPredicate {
+	return PostPredicate{expr: "{table}.title IS NOT NULL"}
+}
+
+// TitleIsNull creates predicate "title IS NULL"
+// nolint: dupl
+func (PostPredicates) TitleIsNull() PostPredicate {
+	return PostPredicate{expr: "{table}.title IS NULL"}
+}
+
+// TitleLike creates predicate "title LIKE ?"
+// nolint: dupl
+func (PostPredicates) TitleLike(title string) PostPredicate {
+	return PostPredicate{expr: "{table}.title LIKE ?", args: []interface{}{title}}
+}
+
+// TitleLt creates predicate "title < ?"
+// nolint: dupl
+func (PostPredicates) TitleLt(title string) PostPredicate {
+	return PostPredicate{expr: "{table}.title < ?", args: []interface{}{title}}
+}
+
+// TitleLte creates predicate "title <= ?"
+// nolint: dupl
+func (PostPredicates) TitleLte(title string) PostPredicate {
+	return PostPredicate{expr: "{table}.title <= ?", args: []interface{}{title}}
+}
+
+// TitleNe creates predicate "title != ?"
+// nolint: dupl
+func (PostPredicates) TitleNe(title string) PostPredicate {
+	return PostPredicate{expr: "{table}.title != ?", args: []interface{}{title}}
+}
+
+// TitleNotIn creates predicate "title NOT IN (?)"
+// nolint: dupl
+func (PostPredicates) TitleNotIn(title ...string) PostPredicate {
+	if len(title) == 0 {
+		return PostPredicate{err: errors.New("must at least pass one title in TitleNotIn")}
+	}
+	return PostPredicate{expr: "{table}.title NOT IN (?)", args: []interface{}{title}}
+}
+
+// TitleNotlike creates predicate "title NOT LIKE ?"
+// nolint: dupl
+func (PostPredicates) TitleNotlike(title string) PostPredicate {
+	return PostPredicate{expr: "{table}.title NOT LIKE ?", args: []interface{}{title}}
+}
+
+// UpdatedAtEq creates predicate "updated_at = ?"
+// nolint: dupl
+func (PostPredicates) UpdatedAtEq(updatedAt time.Time) PostPredicate {
+	return PostPredicate{expr: "{table}.updated_at = ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtGt creates predicate "updated_at > ?"
+// nolint: dupl
+func (PostPredicates) UpdatedAtGt(updatedAt time.Time) PostPredicate {
+	return PostPredicate{expr: "{table}.updated_at > ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtGte creates predicate "updated_at >= ?"
+// nolint: dupl
+func (PostPredicates) UpdatedAtGte(updatedAt time.Time) PostPredicate {
+	return PostPredicate{expr: "{table}.updated_at >= ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtLt creates predicate "updated_at < ?"
+// nolint: dupl
+func (PostPredicates) UpdatedAtLt(updatedAt time.Time) PostPredicate {
+	return PostPredicate{expr: "{table}.updated_at < ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtLte creates predicate "updated_at <= ?"
+// nolint: dupl
+func (PostPredicates) UpdatedAtLte(updatedAt time.Time) PostPredicate {
+	return PostPredicate{expr: "{table}.updated_at <= ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtNe creates predicate "updated_at != ?"
+// nolint: dupl
+func (PostPredicates) UpdatedAtNe(updatedAt time.Time) PostPredicate {
+	return PostPredicate{expr: "{table}.updated_at != ?", args: []interface{}{updatedAt}}
+}
+
+// UserIDEq creates predicate "user_id = ?"
+// nolint: dupl
+func (PostPredicates) UserIDEq(userID uint) PostPredicate {
+	return PostPredicate{expr: "{table}.user_id = ?", args: []interface{}{userID}}
+}
+
+// UserIDGt creates predicate "user_id > ?"
+// nolint: dupl
+func (PostPredicates) UserIDGt(userID uint) PostPredicate {
+	return PostPredicate{expr: "{table}.user_id > ?", args: []interface{}{userID}}
+}
+
+// UserIDGte creates predicate "user_id >= ?"
+// nolint: dupl
+func (PostPredicates) UserIDGte(userID uint) PostPredicate {
+	return PostPredicate{expr: "{table}.user_id >= ?", args: []interface{}{userID}}
+}
+
+// UserIDIn creates predicate "user_id IN (?)"
+// nolint: dupl
+func (PostPredicates) UserIDIn(userID ...uint) PostPredicate {
+	if len(userID) == 0 {
+		return PostPredicate{err: errors.New("must at least pass one userID in UserIDIn")}
+	}
+	return PostPredicate{expr: "{table}.user_id IN (?)", args: []interface{}{userID}}
+}
+
+// UserIDLt creates predicate "user_id < ?"
+// nolint: dupl
+func (PostPredicates) UserIDLt(userID uint) PostPredicate {
+	return PostPredicate{expr: "{table}.user_id < ?", args: []interface{}{userID}}
+}
+
+// UserIDLte creates predicate "user_id <= ?"
+// nolint: dupl
+func (PostPredicates) UserIDLte(userID uint) PostPredicate {
+	return PostPredicate{expr: "{table}.user_id <= ?", args: []interface{}{userID}}
+}
+
+// UserIDNe creates predicate "user_id != ?"
+// nolint: dupl
+func (PostPredicates) UserIDNe(userID uint) PostPredicate {
+	return PostPredicate{expr: "{table}.user_id != ?", args: []interface{}{userID}}
+}
+
+// UserIDNotIn creates predicate "user_id NOT IN (?)"
+// nolint: dupl
+func (PostPredicates) UserIDNotIn(userID ...uint) PostPredicate {
+	if len(userID) == 0 {
+		return PostPredicate{err: errors.New("must at least pass one userID in UserIDNotIn")}
+	}
+	return PostPredicate{expr: "{table}.user_id NOT IN (?)", args: []interface{}{userID}}
+}
+
+// ===== END of Post predicates
+
+// ===== BEGIN of query set ProductQuerySet
+
+// ProductQuerySet is an queryset type for Product
+type ProductQuerySet struct {
+	db *gorm.DB
+}
+
+// NewProductQuerySet constructs new ProductQuerySet
+func NewProductQuerySet(db *gorm.DB) ProductQuerySet {
+	return ProductQuerySet{
+		db: db.Model(&Product{}),
+	}
+}
+
+func (qs ProductQuerySet) w(db *gorm.DB) ProductQuerySet {
+	return NewProductQuerySet(db)
+}
+
+func (qs ProductQuerySet) Select(fields ...ProductDBSchemaField) ProductQuerySet {
+	names := []string{}
+	for _, f := range fields {
+		names = append(names, f.String())
+	}
+
+	return qs.w(qs.db.Select(strings.Join(names, ",")))
+}
+
+// GroupBy groups rows by fields
+func (qs ProductQuerySet) GroupBy(fields ...ProductDBSchemaField) ProductQuerySet {
+	names := []string{}
+	for _, f := range fields {
+		names = append(names, f.String())
+	}
+
+	return qs.w(qs.db.Group(strings.Join(names, ",")))
+}
+
+// Where filters rows by predicate p, predicates are created by ProductQ
+// nolint: dupl
+func (qs ProductQuerySet) Where(p ProductPredicate) ProductQuerySet {
+	if p.err != nil {
+		// error is added to copy of db: qs can be reused as a base queryset
+		db := qs.db.Model(&Product{})
+		db.AddError(p.err)
+		return qs.w(db)
+	}
+	if p.expr == "" {
+		return qs
+	}
+
+	return qs.w(qs.db.Where(p.expr, p.args...))
+}
+
+// Not filters rows not matching predicate p
+// nolint: dupl
+func (qs ProductQuerySet) Not(p ProductPredicate) ProductQuerySet {
+	return qs.Where(p.Not())
+}
+
+// Create is an autogenerated method
+// nolint: dupl
+func (o *Product) Create(db *gorm.DB) error {
+	return checkQueryContext(db).Create(o).Error
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (o *Product) Delete(db *gorm.DB) error {
+	return checkQueryContext(db).Delete(o).Error
+}
+
+// All is an autogenerated method
+// nolint: dupl
+func (qs ProductQuerySet) All(ret *[]Product) error {
+	return checkQueryContext(qs.db).Find(ret).Error
+}
+
+// AvgPrice returns AVG of Price, it's zero value if there are no rows
+// nolint: dupl
+func (qs ProductQuerySet) AvgPrice() (float64, error) {
+	var ret float64
+	var res struct {
+		Value *float64
+	}
+	err := checkQueryContext(qs.db).Select("AVG(price) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// Batches calls fn for every batch of at most size rows, batches are
+// fetched by Page: ordered by primary key after queryset orders.
+// It stops on the first error of fn and returns it.
+// nolint: dupl
+func (qs ProductQuerySet) Batches(size int, fn func([]Product) error) error {
+	cursor := ""
+	for {
+		var batch []Product
+		next, err := qs.Page(cursor, size, &batch)
+		if err != nil {
+			return err
+		}
+
+		if len(batch) != 0 {
+			if err = fn(batch); err != nil {
+				return err
+			}
+		}
+
+		if next == "" {
+			return nil
+		}
+		cursor = next
+	}
+}
+
+// Count is an autogenerated method
+// nolint: dupl
+func (qs ProductQuerySet) Count() (int, error) {
+	var count int
+	db := checkQueryContext(qs.db)
+	if db.Error != nil {
+		return count, db.Error
+	}
+
+	err := db.Count(&count).Error
+	return count, err
+}
+
+// CountByPrice returns count of rows for every value of Price
+// nolint: dupl
+func (qs ProductQuerySet) CountByPrice() (map[int]int, error) {
+	var rows []struct {
+		Value int
+		Count int
+	}
+	err := checkQueryContext(qs.db).Select("price AS value, COUNT(*) AS count").Group("price").Scan(&rows).Error
+	ret := make(map[int]int, len(rows))
+	for _, r := range rows {
+		ret[r.Value] = r.Count
+	}
+	return ret, err
+}
+
+// CreateMany inserts models by multi-row inserts, conditions of queryset
+// aren't used. Generated primary keys are written back to models if
+// database supports it (all except MS SQL for gorm v1).
+// Unlike gorm Create it doesn't call hooks and doesn't read back default
+// values: zero fields having default values are skipped in insert.
+// nolint: dupl
+func (qs ProductQuerySet) CreateMany(models []Product) error {
+	rows := make([]map[string]interface{}, 0, len(models))
+	for i := range models {
+		rows = append(rows, qs.fieldPtrs(&models[i]))
+	}
+
+	return bulkInsert(checkQueryContext(qs.db), "id", rows)
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (qs ProductQuerySet) Delete() error {
+	return checkQueryContext(qs.db).Delete(Product{}).Error
+}
+
+// DeleteNum is an autogenerated method
+// nolint: dupl
+func (qs ProductQuerySet) DeleteNum() (int64, error) {
+	db := checkQueryContext(qs.db).Delete(Product{})
+	return db.RowsAffected, db.Error
+}
+
+// DeleteNumUnscoped is an autogenerated method
+// nolint: dupl
+func (qs ProductQuerySet) DeleteNumUnscoped() (int64, error) {
+	db := checkQueryContext(qs.db).Unscoped().Delete(Product{})
+	return db.RowsAffected, db.Error
+}
+
+// GetDB is an autogenerated method
+// nolint: dupl
+func (qs ProductQuerySet) GetDB() *gorm.DB {
+	return qs.db
+}
+
+// GetUpdater is an autogenerated method
+// nolint: dupl
+func (qs ProductQuerySet) GetUpdater() ProductUpdater {
+	return NewProductUpdater(qs.db)
+}
+
+// GroupByPrice is an autogenerated method
+// nolint: dupl
+func (qs ProductQuerySet) GroupByPrice() ProductQuerySet {
+	return qs.w(qs.db.Group("price"))
+}
+
+// HavingCountEq is an autogenerated method
+// nolint: dupl
+func (qs ProductQuerySet) HavingCountEq(count int) ProductQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) = ?", count))
+}
+
+// HavingCountGt is an autogenerated method
+// nolint: dupl
+func (qs ProductQuerySet) HavingCountGt(count int) ProductQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) > ?", count))
+}
+
+// HavingCountGte is an autogenerated method
+// nolint: dupl
+func (qs ProductQuerySet) HavingCountGte(count int) ProductQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) >= ?", count))
+}
+
+// HavingCountLt is an autogenerated method
+// nolint: dupl
+func (qs ProductQuerySet) HavingCountLt(count int) ProductQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) < ?", count))
+}
+
+// HavingCountLte is an autogenerated method
+// nolint: dupl
+func (qs ProductQuerySet) HavingCountLte(count int) ProductQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) <= ?", count))
+}
+
+// HavingCountNe is an autogenerated method
+// nolint: dupl
+func (qs ProductQuerySet) HavingCountNe(count int) ProductQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) != ?", count))
+}
+
+// IDEq is an autogenerated method
+// nolint: dupl
+func (qs ProductQuerySet) IDEq(ID uint) ProductQuerySet {
+	return qs.w(qs.db.Where("id = ?", ID))
+}
+
+// IDIn is an autogenerated method
+// nolint: dupl
+func (qs ProductQuerySet) IDIn(ID ...uint) ProductQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("id IN (?)", ID))
+}
+
+// IDInQuery filters rows with ID IN values selected by subquery q
+// nolint: dupl
+func (qs ProductQuerySet) IDInQuery(q UintSubquery) ProductQuerySet {
+	return qs.w(qs.db.Where("id IN (?)", q.expr()))
+}
+
+// InTx returns copy bound to transaction tx: queries are executed in it.
+// Gorm v1 can't move conditions into another connection, so with gorm v1
+// InTx must be called before any condition is set, otherwise queries fail.
+// Context and preloads are moved into transaction by both gorm versions.
+// nolint: dupl
+func (qs ProductQuerySet) InTx(tx *gorm.DB) ProductQuerySet {
+	if !reflect.DeepEqual(qs.db.QueryExpr(), NewProductQuerySet(qs.db.New()).db.QueryExpr()) {
+		tx = tx.New()
+		tx.AddError(errors.New("InTx must be called before any condition is set: " +
+			"gorm v1 can't move conditions into transaction"))
+	}
+	tx = txWithSettings(qs.db, tx)
+	return NewProductQuerySet(tx)
+}
+
+// Iterate scans rows one by one and calls fn for every row without
+// loading all rows into memory. It stops on the first error of fn and
+// returns it. Preloads aren't applied.
+// nolint: dupl
+func (qs ProductQuerySet) Iterate(fn func(*Product) error) error {
+	db := checkQueryContext(qs.db)
+	if db.Error != nil {
+		return db.Error // gorm v1 doesn't check it in Rows
+	}
+
+	rows, err := db.Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var o Product
+		if err = db.ScanRows(rows, &o); err != nil {
+			return err
+		}
+		if err = fn(&o); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// Limit is an autogenerated method
+// nolint: dupl
+func (qs ProductQuerySet) Limit(limit int) ProductQuerySet {
+	return qs.w(qs.db.Limit(limit))
+}
+
+// MaxPrice returns MAX of Price, it's zero value if there are no rows
+// nolint: dupl
+func (qs ProductQuerySet) MaxPrice() (int, error) {
+	var ret int
+	var res struct {
+		Value *int
+	}
+	err := checkQueryContext(qs.db).Select("MAX(price) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// MinPrice returns MIN of Price, it's zero value if there are no rows
+// nolint: dupl
+func (qs ProductQuerySet) MinPrice() (int, error) {
+	var ret int
+	var res struct {
+		Value *int
+	}
+	err := checkQueryContext(qs.db).Select("MIN(price) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// NameEq is an autogenerated method
+// nolint: dupl
+func (qs ProductQuerySet) NameEq(name string) ProductQuerySet {
+	return qs.w(qs.db.Where("name = ?", name))
+}
+
+// NameLike is an autogenerated method
+// nolint: dupl
+func (qs ProductQuerySet) NameLike(name string) ProductQuerySet {
+	return qs.w(qs.db.Where("name LIKE ?", name))
+}
+
+// NoteIsNull is an autogenerated method
+// nolint: dupl
+func (qs ProductQuerySet) NoteIsNull() ProductQuerySet {
+	return qs.w(qs.db.Where("note IS NULL"))
+}
+
+// Offset is an autogenerated method
+// nolint: dupl
+func (qs ProductQuerySet) Offset(offset int) ProductQuerySet {
+	return qs.w(qs.db.Offset(offset))
+}
+
+// One is used to retrieve one result. It returns gorm.ErrRecordNotFound
+// if nothing was fetched
+func (qs ProductQuerySet) One(ret *Product) error {
+	return checkQueryContext(qs.db).First(ret).Error
+}
+
+// OrderAscByID is an autogenerated method
+// nolint: dupl
+func (qs ProductQuerySet) OrderAscByID() ProductQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("id ASC"), "id", false, false))
+}
+
+// OrderAscByPrice is an autogenerated method
+// nolint: dupl
+func (qs ProductQuerySet) OrderAscByPrice() ProductQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("price ASC"), "price", false, false))
+}
+
+// OrderDescByID is an autogenerated method
+// nolint: dupl
+func (qs ProductQuerySet) OrderDescByID() ProductQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("id DESC"), "id", true, false))
+}
+
+// OrderDescByPrice is an autogenerated method
+// nolint: dupl
+func (qs ProductQuerySet) OrderDescByPrice() ProductQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("price DESC"), "price", true, false))
+}
+
+// Page fetches at most size rows following cursor into ret, it uses
+// orders set by OrderAscBy/OrderDescBy methods and primary key to break ties.
+// Ordered fields can't be nullable: rows with NULL can't be compared with
+// cursor, so error is returned for them. Empty cursor means the first page. It returns cursor of the next page or
+// empty string if there are no more rows.
+// nolint: dupl
+func (qs ProductQuerySet) Page(cursor string, size int, ret *[]Product) (string, error) {
+	if size <= 0 {
+		return "", errors.New("page size must be positive")
+	}
+
+	var last Product
+	db, orders, err := pageQuery(qs.db, "", "id", cursor, qs.fieldPtrs(&last))
+	if err != nil {
+		return "", err
+	}
+
+	if err = checkQueryContext(db).Limit(size + 1).Find(ret).Error; err != nil {
+		return "", err
+	}
+	if len(*ret) <= size {
+		return "", nil
+	}
+
+	*ret = (*ret)[:size]
+	return encodePageCursor(orders, qs.fieldPtrs(&(*ret)[size-1]))
+}
+
+// PriceGte is an autogenerated method
+// nolint: dupl
+func (qs ProductQuerySet) PriceGte(price int) ProductQuerySet {
+	return qs.w(qs.db.Where("price >= ?", price))
+}
+
+// PriceLte is an autogenerated method
+// nolint: dupl
+func (qs ProductQuerySet) PriceLte(price int) ProductQuerySet {
+	return qs.w(qs.db.Where("price <= ?", price))
+}
+
+// SelectID returns subquery selecting ID of rows of queryset
+// for InQuery and NotInQuery filters
+// nolint: dupl
+func (qs ProductQuerySet) SelectID() UintSubquery {
+	return UintSubquery{db: qs.db.Select("id")}
+}
+
+// SumPrice returns SUM of Price, it's zero value if there are no rows
+// nolint: dupl
+func (qs ProductQuerySet) SumPrice() (int, error) {
+	var ret int
+	var res struct {
+		Value *int
+	}
+	err := checkQueryContext(qs.db).Select("SUM(price) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// WithContext returns copy executing queries with context ctx.
+// Gorm v1 doesn't support context: its error is checked only before query.
+// nolint: dupl
+func (qs ProductQuerySet) WithContext(ctx context.Context) ProductQuerySet {
+	return qs.w(DBWithContext(ctx, qs.db))
+}
+
+// fieldPtrs returns pointers to fields of o by their columns
+// nolint: dupl
+func (qs ProductQuerySet) fieldPtrs(o *Product) map[string]interface{} {
+	return map[string]interface{}{
+		"id":       &o.ID,
+		"name":     &o.Name,
+		"price":    &o.Price,
+		"note":     &o.Note,
+		"internal": &o.Internal,
+	}
+}
+
+// InTx returns copy bound to transaction tx: queries are executed in it.
+// Gorm v1 can't move conditions into another connection, so with gorm v1
+// InTx must be called before any condition is set, otherwise queries fail.
+// Context and preloads are moved into transaction by both gorm versions.
+// nolint: dupl
+func (u ProductUpdater) InTx(tx *gorm.DB) ProductUpdater {
+	if !reflect.DeepEqual(u.db.QueryExpr(), u.db.New().Model(&Product{}).QueryExpr()) {
+		tx = tx.New()
+		tx.AddError(errors.New("InTx must be called before any condition is set: " +
+			"gorm v1 can't move conditions into transaction"))
+	}
+	tx = txWithSettings(u.db, tx)
+	u.db = tx.Model(&Product{})
+	return u
+}
+
+// SetID is an autogenerated method
+// nolint: dupl
+func (u ProductUpdater) SetID(ID uint) ProductUpdater {
+	u.fields[string(ProductDBSchema.ID)] = ID
+	return u
+}
+
+// SetInternal is an autogenerated method
+// nolint: dupl
+func (u ProductUpdater) SetInternal(internal string) ProductUpdater {
+	u.fields[string(ProductDBSchema.Internal)] = internal
+	return u
+}
+
+// SetName is an autogenerated method
+// nolint: dupl
+func (u ProductUpdater) SetName(name string) ProductUpdater {
+	u.fields[string(ProductDBSchema.Name)] = name
+	return u
+}
+
+// SetNote is an autogenerated method
+// nolint: dupl
+func (u ProductUpdater) SetNote(note *string) ProductUpdater {
+	u.fields[string(ProductDBSchema.Note)] = note
+	return u
+}
+
+// SetPrice is an autogenerated method
+// nolint: dupl
+func (u ProductUpdater) SetPrice(price int) ProductUpdater {
+	u.fields[string(ProductDBSchema.Price)] = price
+	return u
+}
+
+// Update is an autogenerated method
+// nolint: dupl
+func (u ProductUpdater) Update() error {
+	return checkQueryContext(u.db).Updates(u.fields).Error
+}
+
+// UpdateNum is an autogenerated method
+// nolint: dupl
+func (u ProductUpdater) UpdateNum() (int64, error) {
+	db := checkQueryContext(u.db).Updates(u.fields)
+	return db.RowsAffected, db.Error
+}
+
+// WithContext returns copy executing queries with context ctx.
+// Gorm v1 doesn't support context: its error is checked only before query.
+// nolint: dupl
+func (u ProductUpdater) WithContext(ctx context.Context) ProductUpdater {
+	u.db = DBWithContext(ctx, u.db)
+	return u
+}
+
+// ===== END of query set ProductQuerySet
+
+// ===== BEGIN of Product modifiers
+
+// ProductDBSchemaField describes database schema field. It requires for method 'Update'
+type ProductDBSchemaField string
+
+// String method returns string representation of field.
+// nolint: dupl
+func (f ProductDBSchemaField) String() string {
+	return string(f)
+}
+
+// ProductDBSchema stores db field names of Product
+var ProductDBSchema = struct {
+	ID       ProductDBSchemaField
+	Name     ProductDBSchemaField
+	Price    ProductDBSchemaField
+	Note     ProductDBSchemaField
+	Internal ProductDBSchemaField
+}{
+
+	ID:       ProductDBSchemaField("id"),
+	Name:     ProductDBSchemaField("name"),
+	Price:    ProductDBSchemaField("price"),
+	Note:     ProductDBSchemaField("note"),
+	Internal: ProductDBSchemaField("internal"),
+}
+
+// Update updates Product fields by primary key
+// nolint: dupl
+func (o *Product) Update(db *gorm.DB, fields ...ProductDBSchemaField) error {
+	dbNameToFieldName := map[string]interface{}{
+		"id":       o.ID,
+		"name":     o.Name,
+		"price":    o.Price,
+		"note":     o.Note,
+		"internal": o.Internal,
+	}
+	u := map[string]interface{}{}
+	for _, f := range fields {
+		fs := f.String()
+		u[fs] = dbNameToFieldName[fs]
+	}
+	if err := checkQueryContext(db).Model(o).Updates(u).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return err
+		}
+
+		return fmt.Errorf("can't update Product %v fields %v: %s",
+			o, fields, err)
+	}
+
+	return nil
+}
+
+// Upsert inserts Product or updates updateFields of existing row
+// if insert violates unique constraint on conflictFields. Row isn't
+// changed if there are no updateFields: any conflict is ignored then if
+// there are no conflictFields. MySQL ignores conflictFields: it checks
+// all unique constraints, other databases require them to update row.
+// nolint: dupl
+func (o *Product) Upsert(db *gorm.DB, conflictFields []ProductDBSchemaField,
+	updateFields ...ProductDBSchemaField) error {
+
+	conflictColumns := make([]string, 0, len(conflictFields))
+	for _, f := range conflictFields {
+		conflictColumns = append(conflictColumns, f.String())
+	}
+	updateColumns := make([]string, 0, len(updateFields))
+	for _, f := range updateFields {
+		updateColumns = append(updateColumns, f.String())
+	}
+
+	qs := NewProductQuerySet(db)
+	return upsert(checkQueryContext(qs.db), "id", qs.fieldPtrs(o),
+		conflictColumns, updateColumns)
+}
+
+// ProductUpdater is an Product updates manager
+type ProductUpdater struct {
+	fields map[string]interface{}
+	db     *gorm.DB
+}
+
+// NewProductUpdater creates new Product updater
+// nolint: dupl
+func NewProductUpdater(db *gorm.DB) ProductUpdater {
+	return ProductUpdater{
+		fields: map[string]interface{}{},
+		db:     db.Model(&Product{}),
+	}
+}
+
+// ===== END of Product modifiers
+
+// ===== BEGIN of Product predicates
+
+// ProductPredicate is a condition on Product fields for Where and Not
+// methods of ProductQuerySet: predicates are created by ProductQ and
+// combined by And, Or and Not. Zero predicate matches all rows.
+type ProductPredicate struct {
+	expr string
+	args []interface{}
+	err  error
+}
+
+// join combines predicates by operation op, empty predicates are skipped
+// nolint: dupl
+func (p ProductPredicate) join(op string, preds []ProductPredicate) ProductPredicate {
+	for _, q := range preds {
+		if p.err == nil {
+			p.err = q.err
+		}
+		if q.expr == "" {
+			continue
+		}
+		if p.expr == "" {
+			p.expr, p.args = q.expr, q.args
+			continue
+		}
+
+		p.expr = "(" + p.expr + ") " + op + " (" + q.expr + ")"
+		p.args = append(append([]interface{}{}, p.args...), q.args...)
+	}
+
+	return p
+}
+
+// And returns predicate matching rows matched by p and all preds
+// nolint: dupl
+func (p ProductPredicate) And(preds ...ProductPredicate) ProductPredicate {
+	return p.join("AND", preds)
+}
+
+// Or returns predicate matching rows matched by p or any of preds
+// nolint: dupl
+func (p ProductPredicate) Or(preds ...ProductPredicate) ProductPredicate {
+	return p.join("OR", preds)
+}
+
+// Not returns predicate matching rows not matched by p
+// nolint: dupl
+func (p ProductPredicate) Not() ProductPredicate {
+	if p.expr != "" {
+		p.expr = "NOT (" + p.expr + ")"
+	}
+
+	return p
+}
+
+// ProductPredicates creates predicates by Product fields
+type ProductPredicates struct{}
+
+// ProductQ creates predicates by Product fields
+var ProductQ ProductPredicates
+
+// IDEq creates predicate "id = ?"
+// nolint: dupl
+func (ProductPredicates) IDEq(ID uint) ProductPredicate {
+	return ProductPredicate{expr: "id = ?", args: []interface{}{ID}}
+}
+
+// IDIn creates predicate "id IN (?)"
+// nolint: dupl
+func (ProductPredicates) IDIn(ID ...uint) ProductPredicate {
+	if len(ID) == 0 {
+		return ProductPredicate{err: errors.New("must at least pass one ID in IDIn")}
+	}
+	return ProductPredicate{expr: "id IN (?)", args: []interface{}{ID}}
+}
+
+// NameEq creates predicate "name = ?"
+// nolint: dupl
+func (ProductPredicates) NameEq(name string) ProductPredicate {
+	return ProductPredicate{expr: "name = ?", args: []interface{}{name}}
+}
+
+// NameLike creates predicate "name LIKE ?"
+// nolint: dupl
+func (ProductPredicates) NameLike(name string) ProductPredicate {
+	return ProductPredicate{expr: "name LIKE ?", args: []interface{}{name}}
+}
+
+// NoteIsNull creates predicate "note IS NULL"
+// nolint: dupl
+func (ProductPredicates) NoteIsNull() ProductPredicate {
+	return ProductPredicate{expr: "note IS NULL"}
+}
+
+// PriceGte creates predicate "price >= ?"
+// nolint: dupl
+func (ProductPredicates) PriceGte(price int) ProductPredicate {
+	return ProductPredicate{expr: "price >= ?", args: []interface{}{price}}
+}
+
+// PriceLte creates predicate "price <= ?"
+// nolint: dupl
+func (ProductPredicates) PriceLte(price int) ProductPredicate {
+	return ProductPredicate{expr: "price <= ?", args: []interface{}{price}}
+}
+
+// ===== END of Product predicates
+
+// ===== BEGIN of query set ProfileQuerySet
+
+// ProfileQuerySet is an queryset type for Profile
+type ProfileQuerySet struct {
+	db *gorm.DB
+}
+
+// NewProfileQuerySet constructs new ProfileQuerySet
+func NewProfileQuerySet(db *gorm.DB) ProfileQuerySet {
+	return ProfileQuerySet{
+		db: db.Model(&Profile{}),
+	}
+}
+
+func (qs ProfileQuerySet) w(db *gorm.DB) ProfileQuerySet {
+	return NewProfileQuerySet(db)
+}
+
+func (qs ProfileQuerySet) Select(fields ...ProfileDBSchemaField) ProfileQuerySet {
+	names := []string{}
+	for _, f := range fields {
+		names = append(names, qs.column(f.String()))
+	}
+
+	return qs.w(qs.db.Select(strings.Join(names, ",")))
+}
+
+// GroupBy groups rows by fields
+func (qs ProfileQuerySet) GroupBy(fields ...ProfileDBSchemaField) ProfileQuerySet {
+	names := []string{}
+	for _, f := range fields {
+		names = append(names, qs.column(f.String()))
+	}
+
+	return qs.w(qs.db.Group(strings.Join(names, ",")))
+}
+
+// Where filters rows by predicate p, predicates are created by ProfileQ
+// nolint: dupl
+func (qs ProfileQuerySet) Where(p ProfilePredicate) ProfileQuerySet {
+	if p.err != nil {
+		// error is added to copy of db: qs can be reused as a base queryset
+		db := qs.db.Model(&Profile{})
+		db.AddError(p.err)
+		return qs.w(db)
+	}
+	if p.expr == "" {
+		return qs
+	}
+
+	expr := strings.Replace(p.expr, "{table}", qs.quotedTableName(&Profile{}), -1)
+	return qs.w(qs.db.Where(expr, p.args...))
+}
+
+// Not filters rows not matching predicate p
+// nolint: dupl
+func (qs ProfileQuerySet) Not(p ProfilePredicate) ProfileQuerySet {
+	return qs.Where(p.Not())
+}
+
+// Create is an autogenerated method
+// nolint: dupl
+func (o *Profile) Create(db *gorm.DB) error {
+	if err := o.Validate(); err != nil {
+		return err
+	}
+
+	return checkQueryContext(db).Create(o).Error
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (o *Profile) Delete(db *gorm.DB) error {
+	return checkQueryContext(db).Delete(o).Error
+}
+
+// All is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) All(ret *[]Profile) error {
+	return checkQueryContext(qs.db).Find(ret).Error
+}
+
+// AvgRating returns AVG of Rating, it's zero value if there are no rows
+// nolint: dupl
+func (qs ProfileQuerySet) AvgRating() (float64, error) {
+	var ret float64
+	var res struct {
+		Value *float64
+	}
+	err := checkQueryContext(qs.db).Select("AVG(" + qs.column("rating") + ") AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// Batches calls fn for every batch of at most size rows, batches are
+// fetched by Page: ordered by primary key after queryset orders.
+// It stops on the first error of fn and returns it.
+// nolint: dupl
+func (qs ProfileQuerySet) Batches(size int, fn func([]Profile) error) error {
+	cursor := ""
+	for {
+		var batch []Profile
+		next, err := qs.Page(cursor, size, &batch)
+		if err != nil {
+			return err
+		}
+
+		if len(batch) != 0 {
+			if err = fn(batch); err != nil {
+				return err
+			}
+		}
+
+		if next == "" {
+			return nil
+		}
+		cursor = next
+	}
+}
+
+// BioEndsWith filters rows with Bio ending with the argument
+// nolint: dupl
+func (qs ProfileQuerySet) BioEndsWith(bio string) ProfileQuerySet {
+	return qs.w(whereLike(qs.db, qs.column("bio"), "%", bio, "", false))
+}
+
+// BioEq is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) BioEq(bio string) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("bio")+" = ?", bio))
+}
+
+// BioGt is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) BioGt(bio string) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("bio")+" > ?", bio))
+}
+
+// BioGte is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) BioGte(bio string) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("bio")+" >= ?", bio))
+}
+
+// BioIContains filters rows with Bio containing the argument, case is ignored
+// nolint: dupl
+func (qs ProfileQuerySet) BioIContains(bio string) ProfileQuerySet {
+	return qs.w(whereLike(qs.db, qs.column("bio"), "%", bio, "%", true))
+}
+
+// BioIEq filters rows with Bio equal to the argument, case is ignored
+// nolint: dupl
+func (qs ProfileQuerySet) BioIEq(bio string) ProfileQuerySet {
+	return qs.w(whereLike(qs.db, qs.column("bio"), "", bio, "", true))
+}
+
+// BioIn is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) BioIn(bio ...string) ProfileQuerySet {
+	if len(bio) == 0 {
+		qs.db.AddError(errors.New("must at least pass one bio in BioIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where(qs.column("bio")+" IN (?)", bio))
+}
+
+// BioInQuery filters rows with Bio IN values selected by subquery q
+// nolint: dupl
+func (qs ProfileQuerySet) BioInQuery(q StringSubquery) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("bio")+" IN (?)", q.expr()))
+}
+
+// BioIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) BioIsNotNull() ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("bio") + " IS NOT NULL"))
+}
+
+// BioIsNull is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) BioIsNull() ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("bio") + " IS NULL"))
+}
+
+// BioLike is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) BioLike(bio string) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("bio")+" LIKE ?", bio))
+}
+
+// BioLt is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) BioLt(bio string) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("bio")+" < ?", bio))
+}
+
+// BioLte is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) BioLte(bio string) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("bio")+" <= ?", bio))
+}
+
+// BioNe is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) BioNe(bio string) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("bio")+" != ?", bio))
+}
+
+// BioNotIn is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) BioNotIn(bio ...string) ProfileQuerySet {
+	if len(bio) == 0 {
+		qs.db.AddError(errors.New("must at least pass one bio in BioNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where(qs.column("bio")+" NOT IN (?)", bio))
+}
+
+// BioNotInQuery filters rows with Bio NOT IN values selected by subquery q
+// nolint: dupl
+func (qs ProfileQuerySet) BioNotInQuery(q StringSubquery) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("bio")+" NOT IN (?)", q.expr()))
+}
+
+// BioNotlike is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) BioNotlike(bio string) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("bio")+" NOT LIKE ?", bio))
+}
+
+// BioStartsWith filters rows with Bio starting with the argument
+// nolint: dupl
+func (qs ProfileQuerySet) BioStartsWith(bio string) ProfileQuerySet {
+	return qs.w(whereLike(qs.db, qs.column("bio"), "", bio, "%", false))
+}
+
+// Count is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) Count() (int, error) {
+	var count int
+	db := checkQueryContext(qs.db)
+	if db.Error != nil {
+		return count, db.Error
+	}
+
+	err := db.Count(&count).Error
+	return count, err
+}
+
+// CountByID returns count of rows for every value of ID
+// nolint: dupl
+func (qs ProfileQuerySet) CountByID() (map[uint]int, error) {
+	var rows []struct {
+		Value uint
+		Count int
+	}
+	err := checkQueryContext(qs.db).Select(qs.column("id") + " AS value, COUNT(*) AS count").Group(qs.column("id")).Scan(&rows).Error
+	ret := make(map[uint]int, len(rows))
+	for _, r := range rows {
+		ret[r.Value] = r.Count
+	}
+	return ret, err
+}
+
+// CountByOwnerEmail returns count of rows for every value of OwnerEmail
+// nolint: dupl
+func (qs ProfileQuerySet) CountByOwnerEmail() (map[string]int, error) {
+	var rows []struct {
+		Value string
+		Count int
+	}
+	err := checkQueryContext(qs.db).Select("owner_join.email AS value, COUNT(*) AS count").Group("owner_join.email").Scan(&rows).Error
+	ret := make(map[string]int, len(rows))
+	for _, r := range rows {
+		ret[r.Value] = r.Count
+	}
+	return ret, err
+}
+
+// CountByOwnerID returns count of rows for every value of OwnerID
+// nolint: dupl
+func (qs ProfileQuerySet) CountByOwnerID() (map[uint]int, error) {
+	var rows []struct {
+		Value uint
+		Count int
+	}
+	err := checkQueryContext(qs.db).Select("owner_join.id AS value, COUNT(*) AS count").Group("owner_join.id").Scan(&rows).Error
+	ret := make(map[uint]int, len(rows))
+	for _, r := range rows {
+		ret[r.Value] = r.Count
+	}
+	return ret, err
+}
+
+// CountByOwnerName returns count of rows for every value of OwnerName
+// nolint: dupl
+func (qs ProfileQuerySet) CountByOwnerName() (map[string]int, error) {
+	var rows []struct {
+		Value string
+		Count int
+	}
+	err := checkQueryContext(qs.db).Select("owner_join.name AS value, COUNT(*) AS count").Group("owner_join.name").Scan(&rows).Error
+	ret := make(map[string]int, len(rows))
+	for _, r := range rows {
+		ret[r.Value] = r.Count
+	}
+	return ret, err
+}
+
+// CreateMany inserts models by multi-row inserts, conditions of queryset
+// aren't used. Generated primary keys are written back to models if
+// database supports it (all except MS SQL for gorm v1).
+// Unlike gorm Create it doesn't call hooks and doesn't read back default
+// values: zero fields having default values are skipped in insert.
+// nolint: dupl
+func (qs ProfileQuerySet) CreateMany(models []Profile) error {
+	for i := range models {
+		if err := models[i].Validate(); err != nil {
+			return err
+		}
+	}
+
+	rows := make([]map[string]interface{}, 0, len(models))
+	for i := range models {
+		rows = append(rows, qs.fieldPtrs(&models[i]))
+	}
+
+	return bulkInsert(checkQueryContext(qs.db), "id", rows)
+}
+
+// CreatedAtBetween filters rows with CreatedAt in range [from, to]
+// nolint: dupl
+func (qs ProfileQuerySet) CreatedAtBetween(from time.Time, to time.Time) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("created_at")+" BETWEEN ? AND ?", from, to))
+}
+
+// CreatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) CreatedAtEq(createdAt time.Time) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("created_at")+" = ?", createdAt))
+}
+
+// CreatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) CreatedAtGt(createdAt time.Time) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("created_at")+" > ?", createdAt))
+}
+
+// CreatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) CreatedAtGte(createdAt time.Time) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("created_at")+" >= ?", createdAt))
+}
+
+// CreatedAtInRange filters rows with CreatedAt in range [from, to)
+// nolint: dupl
+func (qs ProfileQuerySet) CreatedAtInRange(from time.Time, to time.Time) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("created_at")+" >= ? AND "+qs.column("created_at")+" < ?", from, to))
+}
+
+// CreatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) CreatedAtLt(createdAt time.Time) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("created_at")+" < ?", createdAt))
+}
+
+// CreatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) CreatedAtLte(createdAt time.Time) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("created_at")+" <= ?", createdAt))
+}
+
+// CreatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) CreatedAtNe(createdAt time.Time) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("created_at")+" != ?", createdAt))
+}
+
+// CreatedAtWithin filters rows with CreatedAt not earlier than d ago
+// nolint: dupl
+func (qs ProfileQuerySet) CreatedAtWithin(d time.Duration) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("created_at")+" >= ?", time.Now().Add(-d)))
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) Delete() error {
+	return checkQueryContext(qs.db).Delete(Profile{}).Error
+}
+
+// DeleteNum is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) DeleteNum() (int64, error) {
+	db := checkQueryContext(qs.db).Delete(Profile{})
+	return db.RowsAffected, db.Error
+}
+
+// DeleteNumUnscoped is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) DeleteNumUnscoped() (int64, error) {
+	db := checkQueryContext(qs.db).Unscoped().Delete(Profile{})
+	return db.RowsAffected, db.Error
+}
+
+// DeletedAtBetween filters rows with DeletedAt in range [from, to]
+// nolint: dupl
+func (qs ProfileQuerySet) DeletedAtBetween(from time.Time, to time.Time) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("deleted_at")+" BETWEEN ? AND ?", from, to))
+}
+
+// DeletedAtEq is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) DeletedAtEq(deletedAt time.Time) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("deleted_at")+" = ?", deletedAt))
+}
+
+// DeletedAtGt is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) DeletedAtGt(deletedAt time.Time) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("deleted_at")+" > ?", deletedAt))
+}
+
+// DeletedAtGte is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) DeletedAtGte(deletedAt time.Time) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("deleted_at")+" >= ?", deletedAt))
+}
+
+// DeletedAtInRange filters rows with DeletedAt in range [from, to)
+// nolint: dupl
+func (qs ProfileQuerySet) DeletedAtInRange(from time.Time, to time.Time) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("deleted_at")+" >= ? AND "+qs.column("deleted_at")+" < ?", from, to))
+}
+
+// DeletedAtIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) DeletedAtIsNotNull() ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("deleted_at") + " IS NOT NULL"))
+}
+
+// DeletedAtIsNull is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) DeletedAtIsNull() ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("deleted_at") + " IS NULL"))
+}
+
+// DeletedAtLt is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) DeletedAtLt(deletedAt time.Time) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("deleted_at")+" < ?", deletedAt))
+}
+
+// DeletedAtLte is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) DeletedAtLte(deletedAt time.Time) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("deleted_at")+" <= ?", deletedAt))
+}
+
+// DeletedAtNe is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) DeletedAtNe(deletedAt time.Time) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("deleted_at")+" != ?", deletedAt))
+}
+
+// DeletedAtWithin filters rows with DeletedAt not earlier than d ago
+// nolint: dupl
+func (qs ProfileQuerySet) DeletedAtWithin(d time.Duration) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("deleted_at")+" >= ?", time.Now().Add(-d)))
+}
+
+// GetDB is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) GetDB() *gorm.DB {
+	return qs.db
+}
+
+// GetUpdater is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) GetUpdater() ProfileUpdater {
+	return NewProfileUpdater(qs.db)
+}
+
+// GroupByBio is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) GroupByBio() ProfileQuerySet {
+	return qs.w(qs.db.Group(qs.column("bio")))
+}
+
+// GroupByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) GroupByCreatedAt() ProfileQuerySet {
+	return qs.w(qs.db.Group(qs.column("created_at")))
+}
+
+// GroupByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) GroupByDeletedAt() ProfileQuerySet {
+	return qs.w(qs.db.Group(qs.column("deleted_at")))
+}
+
+// GroupByID is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) GroupByID() ProfileQuerySet {
+	return qs.w(qs.db.Group(qs.column("id")))
+}
+
+// GroupByLocation is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) GroupByLocation() ProfileQuerySet {
+	return qs.w(qs.db.Group(qs.column("location")))
+}
+
+// GroupByOwnerCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) GroupByOwnerCreatedAt() ProfileQuerySet {
+	return qs.w(qs.db.Group("owner_join.created_at"))
+}
+
+// GroupByOwnerDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) GroupByOwnerDeletedAt() ProfileQuerySet {
+	return qs.w(qs.db.Group("owner_join.deleted_at"))
+}
+
+// GroupByOwnerEmail is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) GroupByOwnerEmail() ProfileQuerySet {
+	return qs.w(qs.db.Group("owner_join.email"))
+}
+
+// GroupByOwnerID is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) GroupByOwnerID() ProfileQuerySet {
+	return qs.w(qs.db.Group("owner_join.id"))
+}
+
+// GroupByOwnerName is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) GroupByOwnerName() ProfileQuerySet {
+	return qs.w(qs.db.Group("owner_join.name"))
+}
+
+// GroupByOwnerRef is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) GroupByOwnerRef() ProfileQuerySet {
+	return qs.w(qs.db.Group(qs.column("owner_ref")))
+}
+
+// GroupByOwnerSurname is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) GroupByOwnerSurname() ProfileQuerySet {
+	return qs.w(qs.db.Group("owner_join.user_surname"))
+}
+
+// GroupByOwnerUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) GroupByOwnerUpdatedAt() ProfileQuerySet {
+	return qs.w(qs.db.Group("owner_join.updated_at"))
+}
+
+// GroupByRating is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) GroupByRating() ProfileQuerySet {
+	return qs.w(qs.db.Group(qs.column("rating")))
+}
+
+// GroupByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) GroupByUpdatedAt() ProfileQuerySet {
+	return qs.w(qs.db.Group(qs.column("updated_at")))
+}
+
+// HavingCountEq is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) HavingCountEq(count int) ProfileQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) = ?", count))
+}
+
+// HavingCountGt is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) HavingCountGt(count int) ProfileQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) > ?", count))
+}
+
+// HavingCountGte is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) HavingCountGte(count int) ProfileQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) >= ?", count))
+}
+
+// HavingCountLt is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) HavingCountLt(count int) ProfileQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) < ?", count))
+}
+
+// HavingCountLte is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) HavingCountLte(count int) ProfileQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) <= ?", count))
+}
+
+// HavingCountNe is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) HavingCountNe(count int) ProfileQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) != ?", count))
+}
+
+// IDBetween filters rows with ID in range [from, to]
+// nolint: dupl
+func (qs ProfileQuerySet) IDBetween(from uint, to uint) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("id")+" BETWEEN ? AND ?", from, to))
+}
+
+// IDEq is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) IDEq(ID uint) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("id")+" = ?", ID))
+}
+
+// IDGt is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) IDGt(ID uint) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("id")+" > ?", ID))
+}
+
+// IDGte is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) IDGte(ID uint) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("id")+" >= ?", ID))
+}
+
+// IDIn is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) IDIn(ID ...uint) ProfileQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where(qs.column("id")+" IN (?)", ID))
+}
+
+// IDInQuery filters rows with ID IN values selected by subquery q
+// nolint: dupl
+func (qs ProfileQuerySet) IDInQuery(q UintSubquery) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("id")+" IN (?)", q.expr()))
+}
+
+// IDInRange filters rows with ID in range [from, to)
+// nolint: dupl
+func (qs ProfileQuerySet) IDInRange(from uint, to uint) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("id")+" >= ? AND "+qs.column("id")+" < ?", from, to))
+}
+
+// IDLt is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) IDLt(ID uint) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("id")+" < ?", ID))
+}
+
+// IDLte is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) IDLte(ID uint) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("id")+" <= ?", ID))
+}
+
+// IDNe is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) IDNe(ID uint) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("id")+" != ?", ID))
+}
+
+// IDNotIn is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) IDNotIn(ID ...uint) ProfileQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where(qs.column("id")+" NOT IN (?)", ID))
+}
+
+// IDNotInQuery filters rows with ID NOT IN values selected by subquery q
+// nolint: dupl
+func (qs ProfileQuerySet) IDNotInQuery(q UintSubquery) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("id")+" NOT IN (?)", q.expr()))
+}
+
+// InTx returns copy bound to transaction tx: queries are executed in it.
+// Gorm v1 can't move conditions into another connection, so with gorm v1
+// InTx must be called before any condition is set, otherwise queries fail.
+// Context and preloads are moved into transaction by both gorm versions.
+// nolint: dupl
+func (qs ProfileQuerySet) InTx(tx *gorm.DB) ProfileQuerySet {
+	if !reflect.DeepEqual(qs.db.QueryExpr(), NewProfileQuerySet(qs.db.New()).db.QueryExpr()) {
+		tx = tx.New()
+		tx.AddError(errors.New("InTx must be called before any condition is set: " +
+			"gorm v1 can't move conditions into transaction"))
+	}
+	tx = txWithSettings(qs.db, tx)
+	return NewProfileQuerySet(tx)
+}
+
+// Iterate scans rows one by one and calls fn for every row without
+// loading all rows into memory. It stops on the first error of fn and
+// returns it. Preloads aren't applied.
+// nolint: dupl
+func (qs ProfileQuerySet) Iterate(fn func(*Profile) error) error {
+	db := checkQueryContext(qs.db)
+	if db.Error != nil {
+		return db.Error // gorm v1 doesn't check it in Rows
+	}
+
+	rows, err := db.Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var o Profile
+		if err = db.ScanRows(rows, &o); err != nil {
+			return err
+		}
+		if err = fn(&o); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// JoinOwner joins table of Owner by alias owner_join:
+// it's needed for filtering by Owner fields
+// nolint: dupl
+func (qs ProfileQuerySet) JoinOwner() ProfileQuerySet {
+	return qs.w(qs.db.Joins(fmt.Sprintf("JOIN %s owner_join ON owner_join.id = %s.owner_ref AND owner_join.deleted_at IS NULL",
+		qs.quotedTableName(&User{}), qs.quotedTableName(&Profile{}))))
+}
+
+// LeftJoinOwner joins table of Owner by alias owner_join:
+// it's needed for filtering by Owner fields
+// nolint: dupl
+func (qs ProfileQuerySet) LeftJoinOwner() ProfileQuerySet {
+	return qs.w(qs.db.Joins(fmt.Sprintf("LEFT JOIN %s owner_join ON owner_join.id = %s.owner_ref AND owner_join.deleted_at IS NULL",
+		qs.quotedTableName(&User{}), qs.quotedTableName(&Profile{}))))
+}
+
+// Limit is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) Limit(limit int) ProfileQuerySet {
+	return qs.w(qs.db.Limit(limit))
+}
+
+// LocationEq is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) LocationEq(location Point) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("location")+" = ?", location))
+}
+
+// LocationIn is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) LocationIn(location ...Point) ProfileQuerySet {
+	if len(location) == 0 {
+		qs.db.AddError(errors.New("must at least pass one location in LocationIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where(qs.column("location")+" IN (?)", location))
+}
+
+// LocationInQuery filters rows with Location IN values selected by subquery q
+// nolint: dupl
+func (qs ProfileQuerySet) LocationInQuery(q PointSubquery) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("location")+" IN (?)", q.expr()))
+}
+
+// LocationNe is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) LocationNe(location Point) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("location")+" != ?", location))
+}
+
+// LocationNotIn is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) LocationNotIn(location ...Point) ProfileQuerySet {
+	if len(location) == 0 {
+		qs.db.AddError(errors.New("must at least pass one location in LocationNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where(qs.column("location")+" NOT IN (?)", location))
+}
+
+// LocationNotInQuery filters rows with Location NOT IN values selected by subquery q
+// nolint: dupl
+func (qs ProfileQuerySet) LocationNotInQuery(q PointSubquery) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("location")+" NOT IN (?)", q.expr()))
+}
+
+// MaxCreatedAt returns MAX of CreatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs ProfileQuerySet) MaxCreatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := checkQueryContext(qs.db).Select(qs.column("created_at")+" AS value").Where(qs.column("created_at")+" IS NOT NULL").
+		Order(qs.column("created_at")+" DESC", true).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MaxID returns MAX of ID, it's zero value if there are no rows
+// nolint: dupl
+func (qs ProfileQuerySet) MaxID() (uint, error) {
+	var ret uint
+	var res struct {
+		Value *uint
+	}
+	err := checkQueryContext(qs.db).Select("MAX(" + qs.column("id") + ") AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// MaxOwnerCreatedAt returns MAX of OwnerCreatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs ProfileQuerySet) MaxOwnerCreatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := checkQueryContext(qs.db).Select("owner_join.created_at AS value").Where("owner_join.created_at IS NOT NULL").
+		Order("owner_join.created_at DESC", true).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MaxOwnerID returns MAX of OwnerID, it's zero value if there are no rows
+// nolint: dupl
+func (qs ProfileQuerySet) MaxOwnerID() (uint, error) {
+	var ret uint
+	var res struct {
+		Value *uint
+	}
+	err := checkQueryContext(qs.db).Select("MAX(owner_join.id) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// MaxOwnerRef returns MAX of OwnerRef, it's zero value if there are no rows
+// nolint: dupl
+func (qs ProfileQuerySet) MaxOwnerRef() (uint, error) {
+	var ret uint
+	var res struct {
+		Value *uint
+	}
+	err := checkQueryContext(qs.db).Select("MAX(" + qs.column("owner_ref") + ") AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// MaxOwnerUpdatedAt returns MAX of OwnerUpdatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs ProfileQuerySet) MaxOwnerUpdatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := checkQueryContext(qs.db).Select("owner_join.updated_at AS value").Where("owner_join.updated_at IS NOT NULL").
+		Order("owner_join.updated_at DESC", true).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MaxRating returns MAX of Rating, it's zero value if there are no rows
+// nolint: dupl
+func (qs ProfileQuerySet) MaxRating() (int64, error) {
+	var ret int64
+	var res struct {
+		Value *int64
+	}
+	err := checkQueryContext(qs.db).Select("MAX(" + qs.column("rating") + ") AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// MaxUpdatedAt returns MAX of UpdatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs ProfileQuerySet) MaxUpdatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := checkQueryContext(qs.db).Select(qs.column("updated_at")+" AS value").Where(qs.column("updated_at")+" IS NOT NULL").
+		Order(qs.column("updated_at")+" DESC", true).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MinCreatedAt returns MIN of CreatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs ProfileQuerySet) MinCreatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := checkQueryContext(qs.db).Select(qs.column("created_at")+" AS value").Where(qs.column("created_at")+" IS NOT NULL").
+		Order(qs.column("created_at")+" ASC", true).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MinID returns MIN of ID, it's zero value if there are no rows
+// nolint: dupl
+func (qs ProfileQuerySet) MinID() (uint, error) {
+	var ret uint
+	var res struct {
+		Value *uint
+	}
+	err := checkQueryContext(qs.db).Select("MIN(" + qs.column("id") + ") AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// MinOwnerCreatedAt returns MIN of OwnerCreatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs ProfileQuerySet) MinOwnerCreatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := checkQueryContext(qs.db).Select("owner_join.created_at AS value").Where("owner_join.created_at IS NOT NULL").
+		Order("owner_join.created_at ASC", true).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MinOwnerID returns MIN of OwnerID, it's zero value if there are no rows
+// nolint: dupl
+func (qs ProfileQuerySet) MinOwnerID() (uint, error) {
+	var ret uint
+	var res struct {
+		Value *uint
+	}
+	err := checkQueryContext(qs.db).Select("MIN(owner_join.id) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// MinOwnerRef returns MIN of OwnerRef, it's zero value if there are no rows
+// nolint: dupl
+func (qs ProfileQuerySet) MinOwnerRef() (uint, error) {
+	var ret uint
+	var res struct {
+		Value *uint
+	}
+	err := checkQueryContext(qs.db).Select("MIN(" + qs.column("owner_ref") + ") AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// MinOwnerUpdatedAt returns MIN of OwnerUpdatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs ProfileQuerySet) MinOwnerUpdatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := checkQueryContext(qs.db).Select("owner_join.updated_at AS value").Where("owner_join.updated_at IS NOT NULL").
+		Order("owner_join.updated_at ASC", true).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MinRating returns MIN of Rating, it's zero value if there are no rows
+// nolint: dupl
+func (qs ProfileQuerySet) MinRating() (int64, error) {
+	var ret int64
+	var res struct {
+		Value *int64
+	}
+	err := checkQueryContext(qs.db).Select("MIN(" + qs.column("rating") + ") AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// MinUpdatedAt returns MIN of UpdatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs ProfileQuerySet) MinUpdatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := checkQueryContext(qs.db).Select(qs.column("updated_at")+" AS value").Where(qs.column("updated_at")+" IS NOT NULL").
+		Order(qs.column("updated_at")+" ASC", true).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// Offset is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) Offset(offset int) ProfileQuerySet {
+	return qs.w(qs.db.Offset(offset))
+}
+
+// One is used to retrieve one result. It returns gorm.ErrRecordNotFound
+// if nothing was fetched
+func (qs ProfileQuerySet) One(ret *Profile) error {
+	return checkQueryContext(qs.db).First(ret).Error
+}
+
+// OrderAscByBio is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OrderAscByBio() ProfileQuerySet {
+	return qs.w(addPageOrder(qs.db.Order(qs.column("bio")+" ASC"), "bio", false, false))
+}
+
+// OrderAscByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OrderAscByCreatedAt() ProfileQuerySet {
+	return qs.w(addPageOrder(qs.db.Order(qs.column("created_at")+" ASC"), "created_at", false, false))
+}
+
+// OrderAscByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OrderAscByDeletedAt() ProfileQuerySet {
+	return qs.w(addPageOrder(qs.db.Order(qs.column("deleted_at")+" ASC"), "deleted_at", false, false))
+}
+
+// OrderAscByID is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OrderAscByID() ProfileQuerySet {
+	return qs.w(addPageOrder(qs.db.Order(qs.column("id")+" ASC"), "id", false, false))
+}
+
+// OrderAscByLocation is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OrderAscByLocation() ProfileQuerySet {
+	return qs.w(addPageOrder(qs.db.Order(qs.column("location")+" ASC"), "location", false, false))
+}
+
+// OrderAscByOwnerCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OrderAscByOwnerCreatedAt() ProfileQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("owner_join.created_at ASC"), "owner_join.created_at", false, false))
+}
+
+// OrderAscByOwnerDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OrderAscByOwnerDeletedAt() ProfileQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("owner_join.deleted_at ASC"), "owner_join.deleted_at", false, false))
+}
+
+// OrderAscByOwnerEmail is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OrderAscByOwnerEmail() ProfileQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("owner_join.email ASC"), "owner_join.email", false, false))
+}
+
+// OrderAscByOwnerID is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OrderAscByOwnerID() ProfileQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("owner_join.id ASC"), "owner_join.id", false, false))
+}
+
+// OrderAscByOwnerName is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OrderAscByOwnerName() ProfileQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("owner_join.name ASC"), "owner_join.name", false, false))
+}
+
+// OrderAscByOwnerRef is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OrderAscByOwnerRef() ProfileQuerySet {
+	return qs.w(addPageOrder(qs.db.Order(qs.column("owner_ref")+" ASC"), "owner_ref", false, false))
+}
+
+// OrderAscByOwnerSurname is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OrderAscByOwnerSurname() ProfileQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("owner_join.user_surname ASC"), "owner_join.user_surname", false, false))
+}
+
+// OrderAscByOwnerUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OrderAscByOwnerUpdatedAt() ProfileQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("owner_join.updated_at ASC"), "owner_join.updated_at", false, false))
+}
+
+// OrderAscByRating is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OrderAscByRating() ProfileQuerySet {
+	return qs.w(addPageOrder(qs.db.Order(qs.column("rating")+" ASC"), "rating", false, false))
+}
+
+// OrderAscByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OrderAscByUpdatedAt() ProfileQuerySet {
+	return qs.w(addPageOrder(qs.db.Order(qs.column("updated_at")+" ASC"), "updated_at", false, false))
+}
+
+// OrderDescByBio is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OrderDescByBio() ProfileQuerySet {
+	return qs.w(addPageOrder(qs.db.Order(qs.column("bio")+" DESC"), "bio", true, false))
+}
+
+// OrderDescByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OrderDescByCreatedAt() ProfileQuerySet {
+	return qs.w(addPageOrder(qs.db.Order(qs.column("created_at")+" DESC"), "created_at", true, false))
+}
+
+// OrderDescByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OrderDescByDeletedAt() ProfileQuerySet {
+	return qs.w(addPageOrder(qs.db.Order(qs.column("deleted_at")+" DESC"), "deleted_at", true, false))
+}
+
+// OrderDescByID is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OrderDescByID() ProfileQuerySet {
+	return qs.w(addPageOrder(qs.db.Order(qs.column("id")+" DESC"), "id", true, false))
+}
+
+// OrderDescByLocation is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OrderDescByLocation() ProfileQuerySet {
+	return qs.w(addPageOrder(qs.db.Order(qs.column("location")+" DESC"), "location", true, false))
+}
+
+// OrderDescByOwnerCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OrderDescByOwnerCreatedAt() ProfileQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("owner_join.created_at DESC"), "owner_join.created_at", true, false))
+}
+
+// OrderDescByOwnerDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OrderDescByOwnerDeletedAt() ProfileQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("owner_join.deleted_at DESC"), "owner_join.deleted_at", true, false))
+}
+
+// OrderDescByOwnerEmail is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OrderDescByOwnerEmail() ProfileQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("owner_join.email DESC"), "owner_join.email", true, false))
+}
+
+// OrderDescByOwnerID is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OrderDescByOwnerID() ProfileQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("owner_join.id DESC"), "owner_join.id", true, false))
+}
+
+// OrderDescByOwnerName is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OrderDescByOwnerName() ProfileQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("owner_join.name DESC"), "owner_join.name", true, false))
+}
+
+// OrderDescByOwnerRef is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OrderDescByOwnerRef() ProfileQuerySet {
+	return qs.w(addPageOrder(qs.db.Order(qs.column("owner_ref")+" DESC"), "owner_ref", true, false))
+}
+
+// OrderDescByOwnerSurname is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OrderDescByOwnerSurname() ProfileQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("owner_join.user_surname DESC"), "owner_join.user_surname", true, false))
+}
+
+// OrderDescByOwnerUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OrderDescByOwnerUpdatedAt() ProfileQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("owner_join.updated_at DESC"), "owner_join.updated_at", true, false))
+}
+
+// OrderDescByRating is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OrderDescByRating() ProfileQuerySet {
+	return qs.w(addPageOrder(qs.db.Order(qs.column("rating")+" DESC"), "rating", true, false))
+}
+
+// OrderDescByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OrderDescByUpdatedAt() ProfileQuerySet {
+	return qs.w(addPageOrder(qs.db.Order(qs.column("updated_at")+" DESC"), "updated_at", true, false))
+}
+
+// OwnerCreatedAtBetween filters rows with OwnerCreatedAt in range [from, to]
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerCreatedAtBetween(from time.Time, to time.Time) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.created_at BETWEEN ? AND ?", from, to))
+}
+
+// OwnerCreatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerCreatedAtEq(ownerCreatedAt time.Time) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.created_at = ?", ownerCreatedAt))
+}
+
+// OwnerCreatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerCreatedAtGt(ownerCreatedAt time.Time) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.created_at > ?", ownerCreatedAt))
+}
+
+// OwnerCreatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerCreatedAtGte(ownerCreatedAt time.Time) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.created_at >= ?", ownerCreatedAt))
+}
+
+// OwnerCreatedAtInRange filters rows with OwnerCreatedAt in range [from, to)
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerCreatedAtInRange(from time.Time, to time.Time) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.created_at >= ? AND owner_join.created_at < ?", from, to))
+}
+
+// OwnerCreatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerCreatedAtLt(ownerCreatedAt time.Time) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.created_at < ?", ownerCreatedAt))
+}
+
+// OwnerCreatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerCreatedAtLte(ownerCreatedAt time.Time) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.created_at <= ?", ownerCreatedAt))
+}
+
+// OwnerCreatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerCreatedAtNe(ownerCreatedAt time.Time) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.created_at != ?", ownerCreatedAt))
+}
+
+// OwnerCreatedAtWithin filters rows with OwnerCreatedAt not earlier than d ago
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerCreatedAtWithin(d time.Duration) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.created_at >= ?", time.Now().Add(-d)))
+}
+
+// OwnerDeletedAtBetween filters rows with OwnerDeletedAt in range [from, to]
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerDeletedAtBetween(from time.Time, to time.Time) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.deleted_at BETWEEN ? AND ?", from, to))
+}
+
+// OwnerDeletedAtEq is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerDeletedAtEq(ownerDeletedAt time.Time) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.deleted_at = ?", ownerDeletedAt))
+}
+
+// OwnerDeletedAtGt is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerDeletedAtGt(ownerDeletedAt time.Time) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.deleted_at > ?", ownerDeletedAt))
+}
+
+// OwnerDeletedAtGte is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerDeletedAtGte(ownerDeletedAt time.Time) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.deleted_at >= ?", ownerDeletedAt))
+}
+
+// OwnerDeletedAtInRange filters rows with OwnerDeletedAt in range [from, to)
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerDeletedAtInRange(from time.Time, to time.Time) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.deleted_at >= ? AND owner_join.deleted_at < ?", from, to))
+}
+
+// OwnerDeletedAtIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerDeletedAtIsNotNull() ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.deleted_at IS NOT NULL"))
+}
+
+// OwnerDeletedAtIsNull is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerDeletedAtIsNull() ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.deleted_at IS NULL"))
+}
+
+// OwnerDeletedAtLt is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerDeletedAtLt(ownerDeletedAt time.Time) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.deleted_at < ?", ownerDeletedAt))
+}
+
+// OwnerDeletedAtLte is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerDeletedAtLte(ownerDeletedAt time.Time) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.deleted_at <= ?", ownerDeletedAt))
+}
+
+// OwnerDeletedAtNe is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerDeletedAtNe(ownerDeletedAt time.Time) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.deleted_at != ?", ownerDeletedAt))
+}
+
+// OwnerDeletedAtWithin filters rows with OwnerDeletedAt not earlier than d ago
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerDeletedAtWithin(d time.Duration) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.deleted_at >= ?", time.Now().Add(-d)))
+}
+
+// OwnerEmailEndsWith filters rows with OwnerEmail ending with the argument
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerEmailEndsWith(ownerEmail string) ProfileQuerySet {
+	return qs.w(whereLike(qs.db, "owner_join.email", "%", ownerEmail, "", false))
+}
+
+// OwnerEmailEq is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerEmailEq(ownerEmail string) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.email = ?", ownerEmail))
+}
+
+// OwnerEmailGt is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerEmailGt(ownerEmail string) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.email > ?", ownerEmail))
+}
+
+// OwnerEmailGte is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerEmailGte(ownerEmail string) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.email >= ?", ownerEmail))
+}
+
+// OwnerEmailIContains filters rows with OwnerEmail containing the argument, case is ignored
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerEmailIContains(ownerEmail string) ProfileQuerySet {
+	return qs.w(whereLike(qs.db, "owner_join.email", "%", ownerEmail, "%", true))
+}
+
+// OwnerEmailIEq filters rows with OwnerEmail equal to the argument, case is ignored
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerEmailIEq(ownerEmail string) ProfileQuerySet {
+	return qs.w(whereLike(qs.db, "owner_join.email", "", ownerEmail, "", true))
+}
+
+// OwnerEmailIn is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerEmailIn(ownerEmail ...string) ProfileQuerySet {
+	if len(ownerEmail) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ownerEmail in OwnerEmailIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("owner_join.email IN (?)", ownerEmail))
+}
+
+// OwnerEmailInQuery filters rows with OwnerEmail IN values selected by subquery q
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerEmailInQuery(q StringSubquery) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.email IN (?)", q.expr()))
+}
+
+// OwnerEmailLike is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerEmailLike(ownerEmail string) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.email LIKE ?", ownerEmail))
+}
+
+// OwnerEmailLt is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerEmailLt(ownerEmail string) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.email < ?", ownerEmail))
+}
+
+// OwnerEmailLte is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerEmailLte(ownerEmail string) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.email <= ?", ownerEmail))
+}
+
+// OwnerEmailNe is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerEmailNe(ownerEmail string) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.email != ?", ownerEmail))
+}
+
+// OwnerEmailNotIn is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerEmailNotIn(ownerEmail ...string) ProfileQuerySet {
+	if len(ownerEmail) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ownerEmail in OwnerEmailNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("owner_join.email NOT IN (?)", ownerEmail))
+}
+
+// OwnerEmailNotInQuery filters rows with OwnerEmail NOT IN values selected by subquery q
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerEmailNotInQuery(q StringSubquery) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.email NOT IN (?)", q.expr()))
+}
+
+// OwnerEmailNotlike is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerEmailNotlike(ownerEmail string) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.email NOT LIKE ?", ownerEmail))
+}
+
+// OwnerEmailStartsWith filters rows with OwnerEmail starting with the argument
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerEmailStartsWith(ownerEmail string) ProfileQuerySet {
+	return qs.w(whereLike(qs.db, "owner_join.email", "", ownerEmail, "%", false))
+}
+
+// OwnerIDBetween filters rows with OwnerID in range [from, to]
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerIDBetween(from uint, to uint) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.id BETWEEN ? AND ?", from, to))
+}
+
+// OwnerIDEq is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerIDEq(ownerID uint) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.id = ?", ownerID))
+}
+
+// OwnerIDGt is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerIDGt(ownerID uint) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.id > ?", ownerID))
+}
+
+// OwnerIDGte is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerIDGte(ownerID uint) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.id >= ?", ownerID))
+}
+
+// OwnerIDIn is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerIDIn(ownerID ...uint) ProfileQuerySet {
+	if len(ownerID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ownerID in OwnerIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("owner_join.id IN (?)", ownerID))
+}
+
+// OwnerIDInQuery filters rows with OwnerID IN values selected by subquery q
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerIDInQuery(q UintSubquery) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.id IN (?)", q.expr()))
+}
+
+// OwnerIDInRange filters rows with OwnerID in range [from, to)
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerIDInRange(from uint, to uint) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.id >= ? AND owner_join.id < ?", from, to))
+}
+
+// OwnerIDLt is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerIDLt(ownerID uint) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.id < ?", ownerID))
+}
+
+// OwnerIDLte is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerIDLte(ownerID uint) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.id <= ?", ownerID))
+}
+
+// OwnerIDNe is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerIDNe(ownerID uint) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.id != ?", ownerID))
+}
+
+// OwnerIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerIDNotIn(ownerID ...uint) ProfileQuerySet {
+	if len(ownerID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ownerID in OwnerIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("owner_join.id NOT IN (?)", ownerID))
+}
+
+// OwnerIDNotInQuery filters rows with OwnerID NOT IN values selected by subquery q
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerIDNotInQuery(q UintSubquery) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.id NOT IN (?)", q.expr()))
+}
+
+// OwnerIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerIsNotNull() ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("owner") + " IS NOT NULL"))
+}
+
+// OwnerIsNull is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerIsNull() ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("owner") + " IS NULL"))
+}
+
+// OwnerNameEndsWith filters rows with OwnerName ending with the argument
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerNameEndsWith(ownerName string) ProfileQuerySet {
+	return qs.w(whereLike(qs.db, "owner_join.name", "%", ownerName, "", false))
+}
+
+// OwnerNameEq is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerNameEq(ownerName string) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.name = ?", ownerName))
+}
+
+// OwnerNameGt is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerNameGt(ownerName string) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.name > ?", ownerName))
+}
+
+// OwnerNameGte is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerNameGte(ownerName string) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.name >= ?", ownerName))
+}
+
+// OwnerNameIContains filters rows with OwnerName containing the argument, case is ignored
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerNameIContains(ownerName string) ProfileQuerySet {
+	return qs.w(whereLike(qs.db, "owner_join.name", "%", ownerName, "%", true))
+}
+
+// OwnerNameIEq filters rows with OwnerName equal to the argument, case is ignored
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerNameIEq(ownerName string) ProfileQuerySet {
+	return qs.w(whereLike(qs.db, "owner_join.name", "", ownerName, "", true))
+}
+
+// OwnerNameIn is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerNameIn(ownerName ...string) ProfileQuerySet {
+	if len(ownerName) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ownerName in OwnerNameIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("owner_join.name IN (?)", ownerName))
+}
+
+// OwnerNameInQuery filters rows with OwnerName IN values selected by subquery q
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerNameInQuery(q StringSubquery) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.name IN (?)", q.expr()))
+}
+
+// OwnerNameLike is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerNameLike(ownerName string) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.name LIKE ?", ownerName))
+}
+
+// OwnerNameLt is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerNameLt(ownerName string) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.name < ?", ownerName))
+}
+
+// OwnerNameLte is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerNameLte(ownerName string) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.name <= ?", ownerName))
+}
+
+// OwnerNameNe is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerNameNe(ownerName string) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.name != ?", ownerName))
+}
+
+// OwnerNameNotIn is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerNameNotIn(ownerName ...string) ProfileQuerySet {
+	if len(ownerName) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ownerName in OwnerNameNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("owner_join.name NOT IN (?)", ownerName))
+}
+
+// OwnerNameNotInQuery filters rows with OwnerName NOT IN values selected by subquery q
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerNameNotInQuery(q StringSubquery) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.name NOT IN (?)", q.expr()))
+}
+
+// OwnerNameNotlike is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerNameNotlike(ownerName string) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.name NOT LIKE ?", ownerName))
+}
+
+// OwnerNameStartsWith filters rows with OwnerName starting with the argument
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerNameStartsWith(ownerName string) ProfileQuerySet {
+	return qs.w(whereLike(qs.db, "owner_join.name", "", ownerName, "%", false))
+}
+
+// OwnerRefBetween filters rows with OwnerRef in range [from, to]
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerRefBetween(from uint, to uint) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("owner_ref")+" BETWEEN ? AND ?", from, to))
+}
+
+// OwnerRefEq is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerRefEq(ownerRef uint) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("owner_ref")+" = ?", ownerRef))
+}
+
+// OwnerRefGt is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerRefGt(ownerRef uint) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("owner_ref")+" > ?", ownerRef))
+}
+
+// OwnerRefGte is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerRefGte(ownerRef uint) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("owner_ref")+" >= ?", ownerRef))
+}
+
+// OwnerRefIn is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerRefIn(ownerRef ...uint) ProfileQuerySet {
+	if len(ownerRef) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ownerRef in OwnerRefIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where(qs.column("owner_ref")+" IN (?)", ownerRef))
+}
+
+// OwnerRefInQuery filters rows with OwnerRef IN values selected by subquery q
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerRefInQuery(q UintSubquery) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("owner_ref")+" IN (?)", q.expr()))
+}
+
+// OwnerRefInRange filters rows with OwnerRef in range [from, to)
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerRefInRange(from uint, to uint) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("owner_ref")+" >= ? AND "+qs.column("owner_ref")+" < ?", from, to))
+}
+
+// OwnerRefIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerRefIsNotNull() ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("owner_ref") + " IS NOT NULL"))
+}
+
+// OwnerRefIsNull is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerRefIsNull() ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("owner_ref") + " IS NULL"))
+}
+
+// OwnerRefLt is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerRefLt(ownerRef uint) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("owner_ref")+" < ?", ownerRef))
+}
+
+// OwnerRefLte is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerRefLte(ownerRef uint) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("owner_ref")+" <= ?", ownerRef))
+}
+
+// OwnerRefNe is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerRefNe(ownerRef uint) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("owner_ref")+" != ?", ownerRef))
+}
+
+// OwnerRefNotIn is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerRefNotIn(ownerRef ...uint) ProfileQuerySet {
+	if len(ownerRef) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ownerRef in OwnerRefNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where(qs.column("owner_ref")+" NOT IN (?)", ownerRef))
+}
+
+// OwnerRefNotInQuery filters rows with OwnerRef NOT IN values selected by subquery q
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerRefNotInQuery(q UintSubquery) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("owner_ref")+" NOT IN (?)", q.expr()))
+}
+
+// OwnerSurnameEndsWith filters rows with OwnerSurname ending with the argument
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerSurnameEndsWith(ownerSurname string) ProfileQuerySet {
+	return qs.w(whereLike(qs.db, "owner_join.user_surname", "%", ownerSurname, "", false))
+}
+
+// OwnerSurnameEq is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerSurnameEq(ownerSurname string) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.user_surname = ?", ownerSurname))
+}
+
+// OwnerSurnameGt is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerSurnameGt(ownerSurname string) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.user_surname > ?", ownerSurname))
+}
+
+// OwnerSurnameGte is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerSurnameGte(ownerSurname string) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.user_surname >= ?", ownerSurname))
+}
+
+// OwnerSurnameIContains filters rows with OwnerSurname containing the argument, case is ignored
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerSurnameIContains(ownerSurname string) ProfileQuerySet {
+	return qs.w(whereLike(qs.db, "owner_join.user_surname", "%", ownerSurname, "%", true))
+}
+
+// OwnerSurnameIEq filters rows with OwnerSurname equal to the argument, case is ignored
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerSurnameIEq(ownerSurname string) ProfileQuerySet {
+	return qs.w(whereLike(qs.db, "owner_join.user_surname", "", ownerSurname, "", true))
+}
+
+// OwnerSurnameIn is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerSurnameIn(ownerSurname ...string) ProfileQuerySet {
+	if len(ownerSurname) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ownerSurname in OwnerSurnameIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("owner_join.user_surname IN (?)", ownerSurname))
+}
+
+// OwnerSurnameInQuery filters rows with OwnerSurname IN values selected by subquery q
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerSurnameInQuery(q StringSubquery) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.user_surname IN (?)", q.expr()))
+}
+
+// OwnerSurnameIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerSurnameIsNotNull() ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.user_surname IS NOT NULL"))
+}
+
+// OwnerSurnameIsNull is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerSurnameIsNull() ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.user_surname IS NULL"))
+}
+
+// OwnerSurnameLike is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerSurnameLike(ownerSurname string) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.user_surname LIKE ?", ownerSurname))
+}
+
+// OwnerSurnameLt is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerSurnameLt(ownerSurname string) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.user_surname < ?", ownerSurname))
+}
+
+// OwnerSurnameLte is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerSurnameLte(ownerSurname string) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.user_surname <= ?", ownerSurname))
+}
+
+// OwnerSurnameNe is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerSurnameNe(ownerSurname string) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.user_surname != ?", ownerSurname))
+}
+
+// OwnerSurnameNotIn is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerSurnameNotIn(ownerSurname ...string) ProfileQuerySet {
+	if len(ownerSurname) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ownerSurname in OwnerSurnameNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("owner_join.user_surname NOT IN (?)", ownerSurname))
+}
+
+// OwnerSurnameNotInQuery filters rows with OwnerSurname NOT IN values selected by subquery q
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerSurnameNotInQuery(q StringSubquery) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.user_surname NOT IN (?)", q.expr()))
+}
+
+// OwnerSurnameNotlike is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerSurnameNotlike(ownerSurname string) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.user_surname NOT LIKE ?", ownerSurname))
+}
+
+// OwnerSurnameStartsWith filters rows with OwnerSurname starting with the argument
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerSurnameStartsWith(ownerSurname string) ProfileQuerySet {
+	return qs.w(whereLike(qs.db, "owner_join.user_surname", "", ownerSurname, "%", false))
+}
+
+// OwnerUpdatedAtBetween filters rows with OwnerUpdatedAt in range [from, to]
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerUpdatedAtBetween(from time.Time, to time.Time) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.updated_at BETWEEN ? AND ?", from, to))
+}
+
+// OwnerUpdatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerUpdatedAtEq(ownerUpdatedAt time.Time) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.updated_at = ?", ownerUpdatedAt))
+}
+
+// OwnerUpdatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerUpdatedAtGt(ownerUpdatedAt time.Time) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.updated_at > ?", ownerUpdatedAt))
+}
+
+// OwnerUpdatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerUpdatedAtGte(ownerUpdatedAt time.Time) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.updated_at >= ?", ownerUpdatedAt))
+}
+
+// OwnerUpdatedAtInRange filters rows with OwnerUpdatedAt in range [from, to)
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerUpdatedAtInRange(from time.Time, to time.Time) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.updated_at >= ? AND owner_join.updated_at < ?", from, to))
+}
+
+// OwnerUpdatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerUpdatedAtLt(ownerUpdatedAt time.Time) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.updated_at < ?", ownerUpdatedAt))
+}
+
+// OwnerUpdatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerUpdatedAtLte(ownerUpdatedAt time.Time) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.updated_at <= ?", ownerUpdatedAt))
+}
+
+// OwnerUpdatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerUpdatedAtNe(ownerUpdatedAt time.Time) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.updated_at != ?", ownerUpdatedAt))
+}
+
+// OwnerUpdatedAtWithin filters rows with OwnerUpdatedAt not earlier than d ago
+// nolint: dupl
+func (qs ProfileQuerySet) OwnerUpdatedAtWithin(d time.Duration) ProfileQuerySet {
+	return qs.w(qs.db.Where("owner_join.updated_at >= ?", time.Now().Add(-d)))
+}
+
+// Page fetches at most size rows following cursor into ret, it uses
+// orders set by OrderAscBy/OrderDescBy methods and primary key to break ties.
+// Ordered fields can't be nullable: rows with NULL can't be compared with
+// cursor, so error is returned for them. Empty cursor means the first page. It returns cursor of the next page or
+// empty string if there are no more rows.
+// nolint: dupl
+func (qs ProfileQuerySet) Page(cursor string, size int, ret *[]Profile) (string, error) {
+	if size <= 0 {
+		return "", errors.New("page size must be positive")
+	}
+
+	var last Profile
+	db, orders, err := pageQuery(qs.db, qs.quotedTableName(&Profile{})+".", "id", cursor, qs.fieldPtrs(&last))
+	if err != nil {
+		return "", err
+	}
+
+	if err = checkQueryContext(db).Limit(size + 1).Find(ret).Error; err != nil {
+		return "", err
+	}
+	if len(*ret) <= size {
+		return "", nil
+	}
+
+	*ret = (*ret)[:size]
+	return encodePageCursor(orders, qs.fieldPtrs(&(*ret)[size-1]))
+}
+
+// PreloadOwner is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) PreloadOwner() ProfileQuerySet {
+	return qs.w(preload(qs.db, "Owner"))
+}
+
+// PreloadOwnerWhere preloads Owner filtered, ordered or limited by f:
+// limit is applied to all preloaded objects, not to objects of every row
+// nolint: dupl
+func (qs ProfileQuerySet) PreloadOwnerWhere(f func(UserQuerySet) UserQuerySet) ProfileQuerySet {
+	return qs.w(preload(qs.db, "Owner", func(db *gorm.DB) *gorm.DB {
+		return f(NewUserQuerySet(db)).db
+	}))
+}
+
+// RatingBetween filters rows with Rating in range [from, to]
+// nolint: dupl
+func (qs ProfileQuerySet) RatingBetween(from int64, to int64) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("rating")+" BETWEEN ? AND ?", from, to))
+}
+
+// RatingEq is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) RatingEq(rating int64) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("rating")+" = ?", rating))
+}
+
+// RatingGt is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) RatingGt(rating int64) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("rating")+" > ?", rating))
+}
+
+// RatingGte is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) RatingGte(rating int64) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("rating")+" >= ?", rating))
+}
+
+// RatingIn is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) RatingIn(rating ...int64) ProfileQuerySet {
+	if len(rating) == 0 {
+		qs.db.AddError(errors.New("must at least pass one rating in RatingIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where(qs.column("rating")+" IN (?)", rating))
+}
+
+// RatingInQuery filters rows with Rating IN values selected by subquery q
+// nolint: dupl
+func (qs ProfileQuerySet) RatingInQuery(q Int64Subquery) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("rating")+" IN (?)", q.expr()))
+}
+
+// RatingInRange filters rows with Rating in range [from, to)
+// nolint: dupl
+func (qs ProfileQuerySet) RatingInRange(from int64, to int64) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("rating")+" >= ? AND "+qs.column("rating")+" < ?", from, to))
+}
+
+// RatingIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) RatingIsNotNull() ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("rating") + " IS NOT NULL"))
+}
+
+// RatingIsNull is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) RatingIsNull() ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("rating") + " IS NULL"))
+}
+
+// RatingLt is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) RatingLt(rating int64) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("rating")+" < ?", rating))
+}
+
+// RatingLte is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) RatingLte(rating int64) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("rating")+" <= ?", rating))
+}
+
+// RatingNe is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) RatingNe(rating int64) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("rating")+" != ?", rating))
+}
+
+// RatingNotIn is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) RatingNotIn(rating ...int64) ProfileQuerySet {
+	if len(rating) == 0 {
+		qs.db.AddError(errors.New("must at least pass one rating in RatingNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where(qs.column("rating")+" NOT IN (?)", rating))
+}
+
+// RatingNotInQuery filters rows with Rating NOT IN values selected by subquery q
+// nolint: dupl
+func (qs ProfileQuerySet) RatingNotInQuery(q Int64Subquery) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("rating")+" NOT IN (?)", q.expr()))
+}
+
+// SelectID returns subquery selecting ID of rows of queryset
+// for InQuery and NotInQuery filters
+// nolint: dupl
+func (qs ProfileQuerySet) SelectID() UintSubquery {
+	return UintSubquery{db: qs.db.Select(qs.column("id"))}
+}
+
+// SelectLocation returns subquery selecting Location of rows of queryset
+// for InQuery and NotInQuery filters
+// nolint: dupl
+func (qs ProfileQuerySet) SelectLocation() PointSubquery {
+	return PointSubquery{db: qs.db.Select(qs.column("location"))}
+}
+
+// SelectOwnerEmail returns subquery selecting OwnerEmail of rows of queryset
+// for InQuery and NotInQuery filters
+// nolint: dupl
+func (qs ProfileQuerySet) SelectOwnerEmail() StringSubquery {
+	return StringSubquery{db: qs.db.Select("owner_join.email")}
+}
+
+// SelectOwnerID returns subquery selecting OwnerID of rows of queryset
+// for InQuery and NotInQuery filters
+// nolint: dupl
+func (qs ProfileQuerySet) SelectOwnerID() UintSubquery {
+	return UintSubquery{db: qs.db.Select("owner_join.id")}
+}
+
+// SelectOwnerName returns subquery selecting OwnerName of rows of queryset
+// for InQuery and NotInQuery filters
+// nolint: dupl
+func (qs ProfileQuerySet) SelectOwnerName() StringSubquery {
+	return StringSubquery{db: qs.db.Select("owner_join.name")}
+}
+
+// SumRating returns SUM of Rating, it's zero value if there are no rows
+// nolint: dupl
+func (qs ProfileQuerySet) SumRating() (int64, error) {
+	var ret int64
+	var res struct {
+		Value *int64
+	}
+	err := checkQueryContext(qs.db).Select("SUM(" + qs.column("rating") + ") AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// UpdatedAtBetween filters rows with UpdatedAt in range [from, to]
+// nolint: dupl
+func (qs ProfileQuerySet) UpdatedAtBetween(from time.Time, to time.Time) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("updated_at")+" BETWEEN ? AND ?", from, to))
+}
+
+// UpdatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) UpdatedAtEq(updatedAt time.Time) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("updated_at")+" = ?", updatedAt))
+}
+
+// UpdatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) UpdatedAtGt(updatedAt time.Time) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("updated_at")+" > ?", updatedAt))
+}
+
+// UpdatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) UpdatedAtGte(updatedAt time.Time) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("updated_at")+" >= ?", updatedAt))
+}
+
+// UpdatedAtInRange filters rows with UpdatedAt in range [from, to)
+// nolint: dupl
+func (qs ProfileQuerySet) UpdatedAtInRange(from time.Time, to time.Time) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("updated_at")+" >= ? AND "+qs.column("updated_at")+" < ?", from, to))
+}
+
+// UpdatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) UpdatedAtLt(updatedAt time.Time) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("updated_at")+" < ?", updatedAt))
+}
+
+// UpdatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) UpdatedAtLte(updatedAt time.Time) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("updated_at")+" <= ?", updatedAt))
+}
+
+// UpdatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs ProfileQuerySet) UpdatedAtNe(updatedAt time.Time) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("updated_at")+" != ?", updatedAt))
+}
+
+// UpdatedAtWithin filters rows with UpdatedAt not earlier than d ago
+// nolint: dupl
+func (qs ProfileQuerySet) UpdatedAtWithin(d time.Duration) ProfileQuerySet {
+	return qs.w(qs.db.Where(qs.column("updated_at")+" >= ?", time.Now().Add(-d)))
+}
+
+// WithContext returns copy executing queries with context ctx.
+// Gorm v1 doesn't support context: its error is checked only before query.
+// nolint: dupl
+func (qs ProfileQuerySet) WithContext(ctx context.Context) ProfileQuerySet {
+	return qs.w(DBWithContext(ctx, qs.db))
+}
+
+// column returns column name qualified by quoted table of model
+// nolint: dupl
+func (qs ProfileQuerySet) column(name string) string {
+	return qs.quotedTableName(&Profile{}) + "." + name
+}
+
+// fieldPtrs returns pointers to fields of o by their columns
+// nolint: dupl
+func (qs ProfileQuerySet) fieldPtrs(o *Profile) map[string]interface{} {
+	return map[string]interface{}{
+		"id":         &o.ID,
+		"created_at": &o.CreatedAt,
+		"updated_at": &o.UpdatedAt,
+		"deleted_at": &o.DeletedAt,
+		"bio":        &o.Bio,
+		"rating":     &o.Rating,
+		"location":   &o.Location,
+		"owner_ref":  &o.OwnerRef,
+	}
+}
+
+// quotedTableName returns quoted name of model's table
+// nolint: dupl
+func (qs ProfileQuerySet) quotedTableName(model interface{}) string {
+	return qs.db.New().NewScope(model).QuotedTableName()
+}
+
+// InTx returns copy bound to transaction tx: queries are executed in it.
+// Gorm v1 can't move conditions into another connection, so with gorm v1
+// InTx must be called before any condition is set, otherwise queries fail.
+// Context and preloads are moved into transaction by both gorm versions.
+// nolint: dupl
+func (u ProfileUpdater) InTx(tx *gorm.DB) ProfileUpdater {
+	if !reflect.DeepEqual(u.db.QueryExpr(), u.db.New().Model(&Profile{}).QueryExpr()) {
+		tx = tx.New()
+		tx.AddError(errors.New("InTx must be called before any condition is set: " +
+			"gorm v1 can't move conditions into transaction"))
+	}
+	tx = txWithSettings(u.db, tx)
+	u.db = tx.Model(&Profile{})
+	return u
+}
+
+// SetBio is an autogenerated method
+// nolint: dupl
+func (u ProfileUpdater) SetBio(bio string) ProfileUpdater {
+	u.fields[string(ProfileDBSchema.Bio)] = bio
+	return u
+}
+
+// SetBioNull sets Bio to NULL
+// nolint: dupl
+func (u ProfileUpdater) SetBioNull() ProfileUpdater {
+	u.fields[string(ProfileDBSchema.Bio)] = nil
+	return u
+}
+
+// SetCreatedAt is an autogenerated method
+// nolint: dupl
+func (u ProfileUpdater) SetCreatedAt(createdAt time.Time) ProfileUpdater {
+	u.fields[string(ProfileDBSchema.CreatedAt)] = createdAt
+	return u
+}
+
+// SetDeletedAt is an autogenerated method
+// nolint: dupl
+func (u ProfileUpdater) SetDeletedAt(deletedAt *time.Time) ProfileUpdater {
+	u.fields[string(ProfileDBSchema.DeletedAt)] = deletedAt
+	return u
+}
+
+// SetID is an autogenerated method
+// nolint: dupl
+func (u ProfileUpdater) SetID(ID uint) ProfileUpdater {
+	u.fields[string(ProfileDBSchema.ID)] = ID
+	return u
+}
+
+// SetLocation is an autogenerated method
+// nolint: dupl
+func (u ProfileUpdater) SetLocation(location Point) ProfileUpdater {
+	u.fields[string(ProfileDBSchema.Location)] = location
+	return u
+}
+
+// SetOwner sets foreign key OwnerRef to ID of owner, nil clears it
+// nolint: dupl
+func (u ProfileUpdater) SetOwner(owner *User) ProfileUpdater {
+	var id *uint
+	if owner != nil {
+		v := owner.ID
+		id = &v
+	}
+	u.fields[string(ProfileDBSchema.OwnerRef)] = id
+	return u
+}
+
+// SetOwnerID sets foreign key OwnerRef of Owner
+// nolint: dupl
+func (u ProfileUpdater) SetOwnerID(ownerID *uint) ProfileUpdater {
+	u.fields[string(ProfileDBSchema.OwnerRef)] = ownerID
+	return u
+}
+
+// SetOwnerRef is an autogenerated method
+// nolint: dupl
+func (u ProfileUpdater) SetOwnerRef(ownerRef *uint) ProfileUpdater {
+	u.fields[string(ProfileDBSchema.OwnerRef)] = ownerRef
+	return u
+}
+
+// SetRating is an autogenerated method
+// nolint: dupl
+func (u ProfileUpdater) SetRating(rating int64) ProfileUpdater {
+	u.fields[string(ProfileDBSchema.Rating)] = rating
+	return u
+}
+
+// SetRatingNull sets Rating to NULL
+// nolint: dupl
+func (u ProfileUpdater) SetRatingNull() ProfileUpdater {
+	u.fields[string(ProfileDBSchema.Rating)] = nil
+	return u
+}
+
+// SetUpdatedAt is an autogenerated method
+// nolint: dupl
+func (u ProfileUpdater) SetUpdatedAt(updatedAt time.Time) ProfileUpdater {
+	u.fields[string(ProfileDBSchema.UpdatedAt)] = updatedAt
+	return u
+}
+
+// Update is an autogenerated method
+// nolint: dupl
+func (u ProfileUpdater) Update() error {
+	if err := u.Validate(); err != nil {
+		return err
+	}
+
+	return checkQueryContext(u.db).Updates(u.fields).Error
+}
+
+// UpdateNum is an autogenerated method
+// nolint: dupl
+func (u ProfileUpdater) UpdateNum() (int64, error) {
+	if err := u.Validate(); err != nil {
+		return 0, err
+	}
+
+	db := checkQueryContext(u.db).Updates(u.fields)
+	return db.RowsAffected, db.Error
+}
+
+// WithContext returns copy executing queries with context ctx.
+// Gorm v1 doesn't support context: its error is checked only before query.
+// nolint: dupl
+func (u ProfileUpdater) WithContext(ctx context.Context) ProfileUpdater {
+	u.db = DBWithContext(ctx, u.db)
+	return u
+}
+
+// ===== END of query set ProfileQuerySet
+
+// ===== BEGIN of Profile modifiers
+
+// ProfileDBSchemaField describes database schema field. It requires for method 'Update'
+type ProfileDBSchemaField string
+
+// String method returns string representation of field.
+// nolint: dupl
+func (f ProfileDBSchemaField) String() string {
+	return string(f)
+}
+
+// ProfileDBSchema stores db field names of Profile
+var ProfileDBSchema = struct {
+	ID        ProfileDBSchemaField
+	CreatedAt ProfileDBSchemaField
+	UpdatedAt ProfileDBSchemaField
+	DeletedAt ProfileDBSchemaField
+	Bio       ProfileDBSchemaField
+	Rating    ProfileDBSchemaField
+	Location  ProfileDBSchemaField
+	OwnerRef  ProfileDBSchemaField
+	Owner     ProfileDBSchemaField
+}{
+
+	ID:        ProfileDBSchemaField("id"),
+	CreatedAt: ProfileDBSchemaField("created_at"),
+	UpdatedAt: ProfileDBSchemaField("updated_at"),
+	DeletedAt: ProfileDBSchemaField("deleted_at"),
+	Bio:       ProfileDBSchemaField("bio"),
+	Rating:    ProfileDBSchemaField("rating"),
+	Location:  ProfileDBSchemaField("location"),
+	OwnerRef:  ProfileDBSchemaField("owner_ref"),
+	Owner:     ProfileDBSchemaField("owner"),
+}
+
+// Update updates Profile fields by primary key
+// nolint: dupl
+func (o *Profile) Update(db *gorm.DB, fields ...ProfileDBSchemaField) error {
+	dbNameToFieldName := map[string]interface{}{
+		"id":         o.ID,
+		"created_at": o.CreatedAt,
+		"updated_at": o.UpdatedAt,
+		"deleted_at": o.DeletedAt,
+		"bio":        o.Bio,
+		"rating":     o.Rating,
+		"location":   o.Location,
+		"owner_ref":  o.OwnerRef,
+		"owner":      o.Owner,
+	}
+	u := map[string]interface{}{}
+	for _, f := range fields {
+		fs := f.String()
+		u[fs] = dbNameToFieldName[fs]
+	}
+	if err := validateProfileFields(u); err != nil {
+		return err
+	}
+	if err := checkQueryContext(db).Model(o).Updates(u).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return err
+		}
+
+		return fmt.Errorf("can't update Profile %v fields %v: %s",
+			o, fields, err)
+	}
+
+	return nil
+}
+
+// Upsert inserts Profile or updates updateFields of existing row
+// if insert violates unique constraint on conflictFields. Row isn't
+// changed if there are no updateFields: any conflict is ignored then if
+// there are no conflictFields. MySQL ignores conflictFields: it checks
+// all unique constraints, other databases require them to update row.
+// nolint: dupl
+func (o *Profile) Upsert(db *gorm.DB, conflictFields []ProfileDBSchemaField,
+	updateFields ...ProfileDBSchemaField) error {
+	if err := o.Validate(); err != nil {
+		return err
+	}
+
+	conflictColumns := make([]string, 0, len(conflictFields))
+	for _, f := range conflictFields {
+		conflictColumns = append(conflictColumns, f.String())
+	}
+	updateColumns := make([]string, 0, len(updateFields))
+	for _, f := range updateFields {
+		updateColumns = append(updateColumns, f.String())
+	}
+
+	qs := NewProfileQuerySet(db)
+	return upsert(checkQueryContext(qs.db), "id", qs.fieldPtrs(o),
+		conflictColumns, updateColumns)
+}
+
+// ProfileUpdater is an Profile updates manager
+type ProfileUpdater struct {
+	fields map[string]interface{}
+	db     *gorm.DB
+}
+
+// NewProfileUpdater creates new Profile updater
+// nolint: dupl
+func NewProfileUpdater(db *gorm.DB) ProfileUpdater {
+	return ProfileUpdater{
+		fields: map[string]interface{}{},
+		db:     db.Model(&Profile{}),
+	}
+}
+
+// validateProfileFields validates values of Profile fields by their columns
+func validateProfileFields(fields map[string]interface{}) error {
+	if value, ok := fields[string(ProfileDBSchema.Bio)]; ok {
+		v, ok := value.(sql.NullString)
+		if !ok {
+			// updater sets value or NULL
+			v.String, v.Valid = value.(string)
+		}
+		if v.Valid {
+			if utf8.RuneCountInString(string(v.String)) > 8 {
+				return ValidationError{Field: "Bio", Reason: "length must be at most 8"}
+			}
+		}
+	}
+	if value, ok := fields[string(ProfileDBSchema.Rating)]; ok {
+		v, ok := value.(sql.NullInt64)
+		if !ok {
+			// updater sets value or NULL
+			v.Int64, v.Valid = value.(int64)
+		}
+		if v.Valid {
+			if v.Int64 < 1 {
+				return ValidationError{Field: "Rating", Reason: "must be at least 1"}
+			}
+			if v.Int64 > 5 {
+				return ValidationError{Field: "Rating", Reason: "must be at most 5"}
+			}
+		}
+	}
+
+	return nil
+}
+
+// Validate checks Profile by rules from tags, it's called
+// before writes to database
+func (o *Profile) Validate() error {
+	return validateProfileFields(map[string]interface{}{
+		string(ProfileDBSchema.Bio):    o.Bio,
+		string(ProfileDBSchema.Rating): o.Rating,
+	})
+}
+
+// Validate checks fields set in updater by rules from tags,
+// it's called before update
+func (u ProfileUpdater) Validate() error {
+	return validateProfileFields(u.fields)
+}
+
+// ===== END of Profile modifiers
+
+// ===== BEGIN of Profile predicates
+
+// ProfilePredicate is a condition on Profile fields for Where and Not
+// methods of ProfileQuerySet: predicates are created by ProfileQ and
+// combined by And, Or and Not. Zero predicate matches all rows.
+type ProfilePredicate struct {
+	expr string
+	args []interface{}
+	err  error
+}
+
+// join combines predicates by operation op, empty predicates are skipped
+// nolint: dupl
+func (p ProfilePredicate) join(op string, preds []ProfilePredicate) ProfilePredicate {
+	for _, q := range preds {
+		if p.err == nil {
+			p.err = q.err
+		}
+		if q.expr == "" {
+			continue
+		}
+		if p.expr == "" {
+			p.expr, p.args = q.expr, q.args
+			continue
+		}
+
+		p.expr = "(" + p.expr + ") " + op + " (" + q.expr + ")"
+		p.args = append(append([]interface{}{}, p.args...), q.args...)
+	}
+
+	return p
+}
+
+// And returns predicate matching rows matched by p and all preds
+// nolint: dupl
+func (p ProfilePredicate) And(preds ...ProfilePredicate) ProfilePredicate {
+	return p.join("AND", preds)
+}
+
+// Or returns predicate matching rows matched by p or any of preds
+// nolint: dupl
+func (p ProfilePredicate) Or(preds ...ProfilePredicate) ProfilePredicate {
+	return p.join("OR", preds)
+}
+
+// Not returns predicate matching rows not matched by p
+// nolint: dupl
+func (p ProfilePredicate) Not() ProfilePredicate {
+	if p.expr != "" {
+		p.expr = "NOT (" + p.expr + ")"
+	}
+
+	return p
+}
+
+// ProfilePredicates creates predicates by Profile fields
+type ProfilePredicates struct{}
+
+// ProfileQ creates predicates by Profile fields
+var ProfileQ ProfilePredicates
+
+// BioEq creates predicate "bio = ?"
+// nolint: dupl
+func (ProfilePredicates) BioEq(bio string) ProfilePredicate {
+	return ProfilePredicate{expr: "{table}.bio = ?", args: []interface{}{bio}}
+}
+
+// BioGt creates predicate "bio > ?"
+// nolint: dupl
+func (ProfilePredicates) BioGt(bio string) ProfilePredicate {
+	return ProfilePredicate{expr: "{table}.bio > ?", args: []interface{}{bio}}
+}
+
+// BioGte creates predicate "bio >= ?"
+// nolint: dupl
+func (ProfilePredicates) BioGte(bio string) ProfilePredicate {
+	return ProfilePredicate{expr: "{table}.bio >= ?", args: []interface{}{bio}}
+}
+
+// BioIn creates predicate "bio IN (?)"
+// nolint: dupl
+func (ProfilePredicates) BioIn(bio ...string) ProfilePredicate {
+	if len(bio) == 0 {
+		return ProfilePredicate{err: errors.New("must at least pass one bio in BioIn")}
+	}
+	return ProfilePredicate{expr: "{table}.bio IN (?)", args: []interface{}{bio}}
+}
+
+// BioIsNotNull creates predicate "bio IS NOT NULL"
+// nolint: dupl
+func (ProfilePredicates) BioIsNotNull() ProfilePredicate {
+	return ProfilePredicate{expr: "{table}.bio IS NOT NULL"}
+}
+
+// BioIsNull creates predicate "bio IS NULL"
+// nolint: dupl
+func (ProfilePredicates) BioIsNull() ProfilePredicate {
+	return ProfilePredicate{expr: "{table}.bio IS NULL"}
+}
+
+// BioLike creates predicate "bio LIKE ?"
+// nolint: dupl
+func (ProfilePredicates) BioLike(bio string) ProfilePredicate {
+	return ProfilePredicate{expr: "{table}.bio LIKE ?", args: []interface{}{bio}}
+}
+
+// BioLt creates predicate "bio < ?"
+// nolint: dupl
+func (ProfilePredicates) BioLt(bio string) ProfilePredicate {
+	return ProfilePredicate{expr: "{table}.bio < ?", args: []interface{}{bio}}
+}
+
+// BioLte creates predicate "bio <= ?"
+// nolint: dupl
+func (ProfilePredicates) BioLte(bio string) ProfilePredicate {
+	return ProfilePredicate{expr: "{table}.bio <= ?", args: []interface{}{bio}}
+}
+
+// BioNe creates predicate "bio != ?"
+// nolint: dupl
+func (ProfilePredicates) BioNe(bio string) ProfilePredicate {
+	return ProfilePredicate{expr: "{table}.bio != ?", args: []interface{}{bio}}
+}
+
+// BioNotIn creates predicate "bio NOT IN (?)"
+// nolint: dupl
+func (ProfilePredicates) BioNotIn(bio ...string) ProfilePredicate {
+	if len(bio) == 0 {
+		return ProfilePredicate{err: errors.New("must at least pass one bio in BioNotIn")}
+	}
+	return ProfilePredicate{expr: "{table}.bio NOT IN (?)", args: []interface{}{bio}}
+}
+
+// BioNotlike creates predicate "bio NOT LIKE ?"
+// nolint: dupl
+func (ProfilePredicates) BioNotlike(bio string) ProfilePredicate {
+	return ProfilePredicate{expr: "{table}.bio NOT LIKE ?", args: []interface{}{bio}}
+}
+
+// CreatedAtEq creates predicate "created_at = ?"
+// nolint: dupl
+func (ProfilePredicates) CreatedAtEq(createdAt time.Time) ProfilePredicate {
+	return ProfilePredicate{expr: "{table}.created_at = ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtGt creates predicate "created_at > ?"
+// nolint: dupl
+func (ProfilePredicates) CreatedAtGt(createdAt time.Time) ProfilePredicate {
+	return ProfilePredicate{expr: "{table}.created_at > ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtGte creates predicate "created_at >= ?"
+// nolint: dupl
+func (ProfilePredicates) CreatedAtGte(createdAt time.Time) ProfilePredicate {
+	return ProfilePredicate{expr: "{table}.created_at >= ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtLt creates predicate "created_at < ?"
+// nolint: dupl
+func (ProfilePredicates) CreatedAtLt(createdAt time.Time) ProfilePredicate {
+	return ProfilePredicate{expr: "{table}.created_at < ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtLte creates predicate "created_at <= ?"
+// nolint: dupl
+func (ProfilePredicates) CreatedAtLte(createdAt time.Time) ProfilePredicate {
+	return ProfilePredicate{expr: "{table}.created_at <= ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtNe creates predicate "created_at != ?"
+// nolint: dupl
+func (ProfilePredicates) CreatedAtNe(createdAt time.Time) ProfilePredicate {
+	return ProfilePredicate{expr: "{table}.created_at != ?", args: []interface{}{createdAt}}
+}
+
+// DeletedAtEq creates predicate "deleted_at = ?"
+// nolint: dupl
+func (ProfilePredicates) DeletedAtEq(deletedAt time.Time) ProfilePredicate {
+	return ProfilePredicate{expr: "{table}.deleted_at = ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtGt creates predicate "deleted_at > ?"
+// nolint: dupl
+func (ProfilePredicates) DeletedAtGt(deletedAt time.Time) ProfilePredicate {
+	return ProfilePredicate{expr: "{table}.deleted_at > ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtGte creates predicate "deleted_at >= ?"
+// nolint: dupl
+func (ProfilePredicates) DeletedAtGte(deletedAt time.Time) ProfilePredicate {
+	return ProfilePredicate{expr: "{table}.deleted_at >= ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtIsNotNull creates predicate "deleted_at IS NOT NULL"
+// nolint: dupl
+func (ProfilePredicates) DeletedAtIsNotNull() ProfilePredicate {
+	return ProfilePredicate{expr: "{table}.deleted_at IS NOT NULL"}
+}
+
+// DeletedAtIsNull creates predicate "deleted_at IS NULL"
+// nolint: dupl
+func (ProfilePredicates) DeletedAtIsNull() ProfilePredicate {
+	return ProfilePredicate{expr: "{table}.deleted_at IS NULL"}
+}
+
+// DeletedAtLt creates predicate "deleted_at < ?"
+// nolint: dupl
+func (ProfilePredicates) DeletedAtLt(deletedAt time.Time) ProfilePredicate {
+	return ProfilePredicate{expr: "{table}.deleted_at < ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtLte creates predicate "deleted_at <= ?"
+// nolint: dupl
+func (ProfilePredicates) DeletedAtLte(deletedAt time.Time) ProfilePredicate {
+	return ProfilePredicate{expr: "{table}.deleted_at <= ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtNe creates predicate "deleted_at != ?"
+// nolint: dupl
+func (ProfilePredicates) DeletedAtNe(deletedAt time.Time) ProfilePredicate {
+	return ProfilePredicate{expr: "{table}.deleted_at != ?", args: []interface{}{deletedAt}}
+}
+
+// IDEq creates predicate "id = ?"
+// nolint: dupl
+func (ProfilePredicates) IDEq(ID uint) ProfilePredicate {
+	return ProfilePredicate{expr: "{table}.id = ?", args: []interface{}{ID}}
+}
+
+// IDGt creates predicate "id > ?"
+// nolint: dupl
+func (ProfilePredicates) IDGt(ID uint) ProfilePredicate {
+	return ProfilePredicate{expr: "{table}.id > ?", args: []interface{}{ID}}
+}
+
+// IDGte creates predicate "id >= ?"
+// nolint: dupl
+func (ProfilePredicates) IDGte(ID uint) ProfilePredicate {
+	return ProfilePredicate{expr: "{table}.id >= ?", args: []interface{}{ID}}
+}
+
+// IDIn creates predicate "id IN (?)"
+// nolint: dupl
+func (ProfilePredicates) IDIn(ID ...uint) ProfilePredicate {
+	if len(ID) == 0 {
+		return ProfilePredicate{err: errors.New("must at least pass one ID in IDIn")}
+	}
+	return ProfilePredicate{expr: "{table}.id IN (?)", args: []interface{}{ID}}
+}
+
+// IDLt creates predicate "id < ?"
+// nolint: dupl
+func (ProfilePredicates) IDLt(ID uint) ProfilePredicate {
+	return ProfilePredicate{expr: "{table}.id < ?", args: []interface{}{ID}}
+}
+
+// IDLte creates predicate "id <= ?"
+// nolint: dupl
+func (ProfilePredicates) IDLte(ID uint) ProfilePredicate {
+	return ProfilePredicate{expr: "{table}.id <= ?", args: []interface{}{ID}}
+}
+
+// IDNe creates predicate "id != ?"
+// nolint: dupl
+func (ProfilePredicates) IDNe(ID uint) ProfilePredicate {
+	return ProfilePredicate{expr: "{table}.id != ?", args: []interface{}{ID}}
+}
+
+// IDNotIn creates predicate "id NOT IN (?)"
+// nolint: dupl
+func (ProfilePredicates) IDNotIn(ID ...uint) ProfilePredicate {
+	if len(ID) == 0 {
+		return ProfilePredicate{err: errors.New("must at least pass one ID in IDNotIn")}
+	}
+	return ProfilePredicate{expr: "{table}.id NOT IN (?)", args: []interface{}{ID}}
+}
+
+// LocationEq creates predicate "location = ?"
+// nolint: dupl
+func (ProfilePredicates) LocationEq(location Point) ProfilePredicate {
+	return ProfilePredicate{expr: "{table}.location = ?", args: []interface{}{location}}
+}
+
+// LocationIn creates predicate "location IN (?)"
+// nolint: dupl
+func (ProfilePredicates) LocationIn(location ...Point) ProfilePredicate {
+	if len(location) == 0 {
+		return ProfilePredicate{err: errors.New("must at least pass one location in LocationIn")}
+	}
+	return ProfilePredicate{expr: "{table}.location IN (?)", args: []interface{}{location}}
+}
+
+// LocationNe creates predicate "location != ?"
+// nolint: dupl
+func (ProfilePredicates) LocationNe(location Point) ProfilePredicate {
+	return ProfilePredicate{expr: "{table}.location != ?", args: []interface{}{location}}
+}
+
+// LocationNotIn creates predicate "location NOT IN (?)"
+// nolint: dupl
+func (ProfilePredicates) LocationNotIn(location ...Point) ProfilePredicate {
+	if len(location) == 0 {
+		return ProfilePredicate{err: errors.New("must at least pass one location in LocationNotIn")}
+	}
+	return ProfilePredicate{expr: "{table}.location NOT IN (?)", args: []interface{}{location}}
+}
+
+// OwnerIsNotNull creates predicate "owner IS NOT NULL"
+// nolint: dupl
+func (ProfilePredicates) OwnerIsNotNull() ProfilePredicate {
+	return ProfilePredicate{expr: "{table}.owner IS NOT NULL"}
+}
+
+// OwnerIsNull creates predicate "owner IS NULL"
+// nolint: dupl
+func (ProfilePredicates) OwnerIsNull() ProfilePredicate {
+	return ProfilePredicate{expr: "{table}.owner IS NULL"}
+}
+
+// OwnerRefEq creates predicate "owner_ref = ?"
+// nolint: dupl
+func (ProfilePredicates) OwnerRefEq(ownerRef uint) ProfilePredicate {
+	return ProfilePredicate{expr: "{table}.owner_ref = ?", args: []interface{}{ownerRef}}
+}
+
+// OwnerRefGt creates predicate "owner_ref > ?"
+// nolint: dupl
+func (ProfilePredicates) OwnerRefGt(ownerRef uint) ProfilePredicate {
+	return ProfilePredicate{expr: "{table}.owner_ref > ?", args: []interface{}{ownerRef}}
+}
+
+// OwnerRefGte creates predicate "owner_ref >= ?"
+// nolint: dupl
+func (ProfilePredicates) OwnerRefGte(ownerRef uint) ProfilePredicate {
+	return ProfilePredicate{expr: "{table}.owner_ref >= ?", args: []interface{}{ownerRef}}
+}
+
+// OwnerRefIn creates predicate "owner_ref IN (?)"
+// nolint: dupl
+func (ProfilePredicates) OwnerRefIn(ownerRef ...uint) ProfilePredicate {
+	if len(ownerRef) == 0 {
+		return ProfilePredicate{err: errors.New("must at least pass one ownerRef in OwnerRefIn")}
+	}
+	return ProfilePredicate{expr: "{table}.owner_ref IN (?)", args: []interface{}{ownerRef}}
+}
+
+// OwnerRefIsNotNull creates predicate "owner_ref IS NOT NULL"
+// nolint: dupl
+func (ProfilePredicates) OwnerRefIsNotNull() ProfilePredicate {
+	return ProfilePredicate{expr: "{table}.owner_ref IS NOT NULL"}
+}
+
+// OwnerRefIsNull creates predicate "owner_ref IS NULL"
+// nolint: dupl
+func (ProfilePredicates) OwnerRefIsNull() ProfilePredicate {
+	return ProfilePredicate{expr: "{table}.owner_ref IS NULL"}
+}
+
+// OwnerRefLt creates predicate "owner_ref < ?"
+// nolint: dupl
+func (ProfilePredicates) OwnerRefLt(ownerRef uint) ProfilePredicate {
+	return ProfilePredicate{expr: "{table}.owner_ref < ?", args: []interface{}{ownerRef}}
+}
+
+// OwnerRefLte creates predicate "owner_ref <= ?"
+// nolint: dupl
+func (ProfilePredicates) OwnerRefLte(ownerRef uint) ProfilePredicate {
+	return ProfilePredicate{expr: "{table}.owner_ref <= ?", args: []interface{}{ownerRef}}
+}
+
+// OwnerRefNe creates predicate "owner_ref != ?"
+// nolint: dupl
+func (ProfilePredicates) OwnerRefNe(ownerRef uint) ProfilePredicate {
+	return ProfilePredicate{expr: "{table}.owner_ref != ?", args: []interface{}{ownerRef}}
+}
+
+// OwnerRefNotIn creates predicate "owner_ref NOT IN (?)"
+// nolint: dupl
+func (ProfilePredicates) OwnerRefNotIn(ownerRef ...uint) ProfilePredicate {
+	if len(ownerRef) == 0 {
+		return ProfilePredicate{err: errors.New("must at least pass one ownerRef in OwnerRefNotIn")}
+	}
+	return ProfilePredicate{expr: "{table}.owner_ref NOT IN (?)", args: []interface{}{ownerRef}}
+}
+
+// RatingEq creates predicate "rating = ?"
+// nolint: dupl
+func (ProfilePredicates) RatingEq(rating int64) ProfilePredicate {
+	return ProfilePredicate{expr: "{table}.rating = ?", args: []interface{}{rating}}
+}
+
+// RatingGt creates predicate "rating > ?"
+// nolint: dupl
+func (ProfilePredicates) RatingGt(rating int64) ProfilePredicate {
+	return ProfilePredicate{expr: "{table}.rating > ?", args: []interface{}{rating}}
+}
+
+// RatingGte creates predicate "rating >= ?"
+// nolint: dupl
+func (ProfilePredicates) RatingGte(rating int64) ProfilePredicate {
+	return ProfilePredicate{expr: "{table}.rating >= ?", args: []interface{}{rating}}
+}
+
+// RatingIn creates predicate "rating IN (?)"
+// nolint: dupl
+func (ProfilePredicates) RatingIn(rating ...int64) ProfilePredicate {
+	if len(rating) == 0 {
+		return ProfilePredicate{err: errors.New("must at least pass one rating in RatingIn")}
+	}
+	return ProfilePredicate{expr: "{table}.rating IN (?)", args: []interface{}{rating}}
+}
+
+// RatingIsNotNull creates predicate "rating IS NOT NULL"
+// nolint: dupl
+func (ProfilePredicates) RatingIsNotNull() ProfilePredicate {
+	return ProfilePredicate{expr: "{table}.rating IS NOT NULL"}
+}
+
+// RatingIsNull creates predicate "rating IS NULL"
+// nolint: dupl
+func (ProfilePredicates) RatingIsNull() ProfilePredicate {
+	return ProfilePredicate{expr: "{table}.rating IS NULL"}
+}
+
+// RatingLt creates predicate "rating < ?"
+// nolint: dupl
+func (ProfilePredicates) RatingLt(rating int64) ProfilePredicate {
+	return ProfilePredicate{expr: "{table}.rating < ?", args: []interface{}{rating}}
+}
+
+// RatingLte creates predicate "rating <= ?"
+// nolint: dupl
+func (ProfilePredicates) RatingLte(rating int64) ProfilePredicate {
+	return ProfilePredicate{expr: "{table}.rating <= ?", args: []interface{}{rating}}
+}
+
+// RatingNe creates predicate "rating != ?"
+// nolint: dupl
+func (ProfilePredicates) RatingNe(rating int64) ProfilePredicate {
+	return ProfilePredicate{expr: "{table}.rating != ?", args: []interface{}{rating}}
+}
+
+// RatingNotIn creates predicate "rating NOT IN (?)"
+// nolint: dupl
+func (ProfilePredicates) RatingNotIn(rating ...int64) ProfilePredicate {
+	if len(rating) == 0 {
+		return ProfilePredicate{err: errors.New("must at least pass one rating in RatingNotIn")}
+	}
+	return ProfilePredicate{expr: "{table}.rating NOT IN (?)", args: []interface{}{rating}}
+}
+
+// UpdatedAtEq creates predicate "updated_at = ?"
+// nolint: dupl
+func (ProfilePredicates) UpdatedAtEq(updatedAt time.Time) ProfilePredicate {
+	return ProfilePredicate{expr: "{table}.updated_at = ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtGt creates predicate "updated_at > ?"
+// nolint: dupl
+func (ProfilePredicates) UpdatedAtGt(updatedAt time.Time) ProfilePredicate {
+	return ProfilePredicate{expr: "{table}.updated_at > ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtGte creates predicate "updated_at >= ?"
+// nolint: dupl
+func (ProfilePredicates) UpdatedAtGte(updatedAt time.Time) ProfilePredicate {
+	return ProfilePredicate{expr: "{table}.updated_at >= ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtLt creates predicate "updated_at < ?"
+// nolint: dupl
+func (ProfilePredicates) UpdatedAtLt(updatedAt time.Time) ProfilePredicate {
+	return ProfilePredicate{expr: "{table}.updated_at < ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtLte creates predicate "updated_at <= ?"
+// nolint: dupl
+func (ProfilePredicates) UpdatedAtLte(updatedAt time.Time) ProfilePredicate {
+	return ProfilePredicate{expr: "{table}.updated_at <= ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtNe creates predicate "updated_at != ?"
+// nolint: dupl
+func (ProfilePredicates) UpdatedAtNe(updatedAt time.Time) ProfilePredicate {
+	return ProfilePredicate{expr: "{table}.updated_at != ?", args: []interface{}{updatedAt}}
+}
+
+// ===== END of Profile predicates
+
+// ===== BEGIN of query set UserQuerySet
+
+// UserQuerySet is an queryset type for User
+type UserQuerySet struct {
+	db *gorm.DB
+}
+
+// NewUserQuerySet constructs new UserQuerySet
+func NewUserQuerySet(db *gorm.DB) UserQuerySet {
+	return UserQuerySet{
+		db: db.Model(&User{}),
+	}
+}
+
+func (qs UserQuerySet) w(db *gorm.DB) UserQuerySet {
+	return NewUserQuerySet(db)
+}
+
+func (qs UserQuerySet) Select(fields ...UserDBSchemaField) UserQuerySet {
+	names := []string{}
+	for _, f := range fields {
+		names = append(names, f.String())
+	}
+
+	return qs.w(qs.db.Select(strings.Join(names, ",")))
+}
+
+// GroupBy groups rows by fields
+func (qs UserQuerySet) GroupBy(fields ...UserDBSchemaField) UserQuerySet {
+	names := []string{}
+	for _, f := range fields {
+		names = append(names, f.String())
+	}
+
+	return qs.w(qs.db.Group(strings.Join(names, ",")))
+}
+
+// Where filters rows by predicate p, predicates are created by UserQ
+// nolint: dupl
+func (qs UserQuerySet) Where(p UserPredicate) UserQuerySet {
+	if p.err != nil {
+		// error is added to copy of db: qs can be reused as a base queryset
+		db := qs.db.Model(&User{})
+		db.AddError(p.err)
+		return qs.w(db)
+	}
+	if p.expr == "" {
+		return qs
+	}
+
+	return qs.w(qs.db.Where(p.expr, p.args...))
+}
+
+// Not filters rows not matching predicate p
+// nolint: dupl
+func (qs UserQuerySet) Not(p UserPredicate) UserQuerySet {
+	return qs.Where(p.Not())
+}
+
+// AppendPosts adds posts to Posts of o: posts are saved and foreign keys of Post are set
+// nolint: dupl
+func (o *User) AppendPosts(db *gorm.DB, posts ...*Post) error {
+	return checkQueryContext(db).Model(o).Association("Posts").Append(posts).Error
+}
+
+// ClearPosts removes all objects from Posts of o: foreign keys of Post are cleared,
+// objects aren't deleted
+// nolint: dupl
+func (o *User) ClearPosts(db *gorm.DB) error {
+	return checkQueryContext(db).Model(o).Association("Posts").Clear().Error
+}
+
+// CountPosts returns number of objects in Posts of o
+// nolint: dupl
+func (o *User) CountPosts(db *gorm.DB) (int, error) {
+	a := checkQueryContext(db).Model(o).Association("Posts")
+	n := a.Count()
+	return n, a.Error
+}
+
+// Create is an autogenerated method
+// nolint: dupl
+func (o *User) Create(db *gorm.DB) error {
+	return checkQueryContext(db).Create(o).Error
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (o *User) Delete(db *gorm.DB) error {
+	return checkQueryContext(db).Delete(o).Error
+}
+
+// RemovePosts removes posts from Posts of o: foreign keys of Post are cleared,
+// objects aren't deleted
+// nolint: dupl
+func (o *User) RemovePosts(db *gorm.DB, posts ...*Post) error {
+	return checkQueryContext(db).Model(o).Association("Posts").Delete(posts).Error
+}
+
+// ReplacePosts replaces Posts of o by posts: other objects are removed
+// from association but aren't deleted
+// nolint: dupl
+func (o *User) ReplacePosts(db *gorm.DB, posts ...*Post) error {
+	return checkQueryContext(db).Model(o).Association("Posts").Replace(posts).Error
+}
+
+// All is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) All(ret *[]User) error {
+	return checkQueryContext(qs.db).Find(ret).Error
+}
+
+// Batches calls fn for every batch of at most size rows, batches are
+// fetched by Page: ordered by primary key after queryset orders.
+// It stops on the first error of fn and returns it.
+// nolint: dupl
+func (qs UserQuerySet) Batches(size int, fn func([]User) error) error {
+	cursor := ""
+	for {
+		var batch []User
+		next, err := qs.Page(cursor, size, &batch)
+		if err != nil {
+			return err
+		}
+
+		if len(batch) != 0 {
+			if err = fn(batch); err != nil {
+				return err
+			}
+		}
+
+		if next == "" {
+			return nil
+		}
+		cursor = next
+	}
+}
+
+// Count is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) Count() (int, error) {
+	var count int
+	db := checkQueryContext(qs.db)
+	if db.Error != nil {
+		return count, db.Error
+	}
+
+	err := db.Count(&count).Error
+	return count, err
+}
+
+// CountByEmail returns count of rows for every value of Email
+// nolint: dupl
+func (qs UserQuerySet) CountByEmail() (map[string]int, error) {
+	var rows []struct {
+		Value string
+		Count int
+	}
+	err := checkQueryContext(qs.db).Select("email AS value, COUNT(*) AS count").Group("email").Scan(&rows).Error
+	ret := make(map[string]int, len(rows))
+	for _, r := range rows {
+		ret[r.Value] = r.Count
+	}
+	return ret, err
+}
+
+// CountByID returns count of rows for every value of ID
+// nolint: dupl
+func (qs UserQuerySet) CountByID() (map[uint]int, error) {
+	var rows []struct {
+		Value uint
+		Count int
+	}
+	err := checkQueryContext(qs.db).Select("id AS value, COUNT(*) AS count").Group("id").Scan(&rows).Error
+	ret := make(map[uint]int, len(rows))
+	for _, r := range rows {
+		ret[r.Value] = r.Count
+	}
+	return ret, err
+}
+
+// CountByName returns count of rows for every value of Name
+// nolint: dupl
+func (qs UserQuerySet) CountByName() (map[string]int, error) {
+	var rows []struct {
+		Value string
+		Count int
+	}
+	err := checkQueryContext(qs.db).Select("name AS value, COUNT(*) AS count").Group("name").Scan(&rows).Error
+	ret := make(map[string]int, len(rows))
+	for _, r := range rows {
+		ret[r.Value] = r.Count
+	}
+	return ret, err
+}
+
+// CreateMany inserts models by multi-row inserts, conditions of queryset
+// aren't used. Generated primary keys are written back to models if
+// database supports it (all except MS SQL for gorm v1).
+// Unlike gorm Create it doesn't call hooks and doesn't read back default
+// values: zero fields having default values are skipped in insert.
+// nolint: dupl
+func (qs UserQuerySet) CreateMany(models []User) error {
+	rows := make([]map[string]interface{}, 0, len(models))
+	for i := range models {
+		rows = append(rows, qs.fieldPtrs(&models[i]))
+	}
+
+	return bulkInsert(checkQueryContext(qs.db), "id", rows)
+}
+
+// CreatedAtBetween filters rows with CreatedAt in range [from, to]
+// nolint: dupl
+func (qs UserQuerySet) CreatedAtBetween(from time.Time, to time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("created_at BETWEEN ? AND ?", from, to))
+}
+
+// CreatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) CreatedAtEq(createdAt time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("created_at = ?", createdAt))
+}
+
+// CreatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) CreatedAtGt(createdAt time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("created_at > ?", createdAt))
+}
+
+// CreatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) CreatedAtGte(createdAt time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("created_at >= ?", createdAt))
+}
+
+// CreatedAtInRange filters rows with CreatedAt in range [from, to)
+// nolint: dupl
+func (qs UserQuerySet) CreatedAtInRange(from time.Time, to time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("created_at >= ? AND created_at < ?", from, to))
+}
+
+// CreatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) CreatedAtLt(createdAt time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("created_at < ?", createdAt))
+}
+
+// CreatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) CreatedAtLte(createdAt time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("created_at <= ?", createdAt))
+}
+
+// CreatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) CreatedAtNe(createdAt time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("created_at != ?", createdAt))
+}
+
+// CreatedAtWithin filters rows with CreatedAt not earlier than d ago
+// nolint: dupl
+func (qs UserQuerySet) CreatedAtWithin(d time.Duration) UserQuerySet {
+	return qs.w(qs.db.Where("created_at >= ?", time.Now().Add(-d)))
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) Delete() error {
+	return checkQueryContext(qs.db).Delete(User{}).Error
+}
+
+// DeleteNum is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) DeleteNum() (int64, error) {
+	db := checkQueryContext(qs.db).Delete(User{})
+	return db.RowsAffected, db.Error
+}
+
+// DeleteNumUnscoped is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) DeleteNumUnscoped() (int64, error) {
+	db := checkQueryContext(qs.db).Unscoped().Delete(User{})
+	return db.RowsAffected, db.Error
+}
+
+// DeletedAtBetween filters rows with DeletedAt in range [from, to]
+// nolint: dupl
+func (qs UserQuerySet) DeletedAtBetween(from time.Time, to time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("deleted_at BETWEEN ? AND ?", from, to))
+}
+
+// DeletedAtEq is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) DeletedAtEq(deletedAt time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("deleted_at = ?", deletedAt))
+}
+
+// DeletedAtGt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) DeletedAtGt(deletedAt time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("deleted_at > ?", deletedAt))
+}
+
+// DeletedAtGte is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) DeletedAtGte(deletedAt time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("deleted_at >= ?", deletedAt))
+}
+
+// DeletedAtInRange filters rows with DeletedAt in range [from, to)
+// nolint: dupl
+func (qs UserQuerySet) DeletedAtInRange(from time.Time, to time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("deleted_at >= ? AND deleted_at < ?", from, to))
+}
+
+// DeletedAtIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) DeletedAtIsNotNull() UserQuerySet {
+	return qs.w(qs.db.Where("deleted_at IS NOT NULL"))
+}
+
+// DeletedAtIsNull is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) DeletedAtIsNull() UserQuerySet {
+	return qs.w(qs.db.Where("deleted_at IS NULL"))
+}
+
+// DeletedAtLt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) DeletedAtLt(deletedAt time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("deleted_at < ?", deletedAt))
+}
+
+// DeletedAtLte is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) DeletedAtLte(deletedAt time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("deleted_at <= ?", deletedAt))
+}
+
+// DeletedAtNe is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) DeletedAtNe(deletedAt time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("deleted_at != ?", deletedAt))
+}
+
+// DeletedAtWithin filters rows with DeletedAt not earlier than d ago
+// nolint: dupl
+func (qs UserQuerySet) DeletedAtWithin(d time.Duration) UserQuerySet {
+	return qs.w(qs.db.Where("deleted_at >= ?", time.Now().Add(-d)))
+}
+
+// EmailEndsWith filters rows with Email ending with the argument
+// nolint: dupl
+func (qs UserQuerySet) EmailEndsWith(email string) UserQuerySet {
+	return qs.w(whereLike(qs.db, "email", "%", email, "", false))
+}
+
+// EmailEq is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) EmailEq(email string) UserQuerySet {
+	return qs.w(qs.db.Where("email = ?", email))
+}
+
+// EmailGt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) EmailGt(email string) UserQuerySet {
+	return qs.w(qs.db.Where("email > ?", email))
+}
+
+// EmailGte is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) EmailGte(email string) UserQuerySet {
+	return qs.w(qs.db.Where("email >= ?", email))
+}
+
+// EmailIContains filters rows with Email containing the argument, case is ignored
+// nolint: dupl
+func (qs UserQuerySet) EmailIContains(email string) UserQuerySet {
+	return qs.w(whereLike(qs.db, "email", "%", email, "%", true))
+}
+
+// EmailIEq filters rows with Email equal to the argument, case is ignored
+// nolint: dupl
+func (qs UserQuerySet) EmailIEq(email string) UserQuerySet {
+	return qs.w(whereLike(qs.db, "email", "", email, "", true))
+}
+
+// EmailIn is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) EmailIn(email ...string) UserQuerySet {
+	if len(email) == 0 {
+		qs.db.AddError(errors.New("must at least pass one email in EmailIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("email IN (?)", email))
+}
+
+// EmailInQuery filters rows with Email IN values selected by subquery q
+// nolint: dupl
+func (qs UserQuerySet) EmailInQuery(q StringSubquery) UserQuerySet {
+	return qs.w(qs.db.Where("email IN (?)", q.expr()))
+}
+
+// EmailLike is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) EmailLike(email string) UserQuerySet {
+	return qs.w(qs.db.Where("email LIKE ?", email))
+}
+
+// EmailLt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) EmailLt(email string) UserQuerySet {
+	return qs.w(qs.db.Where("email < ?", email))
+}
+
+// EmailLte is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) EmailLte(email string) UserQuerySet {
+	return qs.w(qs.db.Where("email <= ?", email))
+}
+
+// EmailNe is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) EmailNe(email string) UserQuerySet {
+	return qs.w(qs.db.Where("email != ?", email))
+}
+
+// EmailNotIn is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) EmailNotIn(email ...string) UserQuerySet {
+	if len(email) == 0 {
+		qs.db.AddError(errors.New("must at least pass one email in EmailNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("email NOT IN (?)", email))
+}
+
+// EmailNotInQuery filters rows with Email NOT IN values selected by subquery q
+// nolint: dupl
+func (qs UserQuerySet) EmailNotInQuery(q StringSubquery) UserQuerySet {
+	return qs.w(qs.db.Where("email NOT IN (?)", q.expr()))
+}
+
+// EmailNotlike is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) EmailNotlike(email string) UserQuerySet {
+	return qs.w(qs.db.Where("email NOT LIKE ?", email))
+}
+
+// EmailStartsWith filters rows with Email starting with the argument
+// nolint: dupl
+func (qs UserQuerySet) EmailStartsWith(email string) UserQuerySet {
+	return qs.w(whereLike(qs.db, "email", "", email, "%", false))
+}
+
+// GetDB is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) GetDB() *gorm.DB {
+	return qs.db
+}
+
+// GetUpdater is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) GetUpdater() UserUpdater {
+	return NewUserUpdater(qs.db)
+}
+
+// GroupByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) GroupByCreatedAt() UserQuerySet {
+	return qs.w(qs.db.Group("created_at"))
+}
+
+// GroupByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) GroupByDeletedAt() UserQuerySet {
+	return qs.w(qs.db.Group("deleted_at"))
+}
+
+// GroupByEmail is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) GroupByEmail() UserQuerySet {
+	return qs.w(qs.db.Group("email"))
+}
+
+// GroupByID is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) GroupByID() UserQuerySet {
+	return qs.w(qs.db.Group("id"))
+}
+
+// GroupByName is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) GroupByName() UserQuerySet {
+	return qs.w(qs.db.Group("name"))
+}
+
+// GroupBySurname is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) GroupBySurname() UserQuerySet {
+	return qs.w(qs.db.Group("user_surname"))
+}
+
+// GroupByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) GroupByUpdatedAt() UserQuerySet {
+	return qs.w(qs.db.Group("updated_at"))
+}
+
+// HavingCountEq is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) HavingCountEq(count int) UserQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) = ?", count))
+}
+
+// HavingCountGt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) HavingCountGt(count int) UserQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) > ?", count))
+}
+
+// HavingCountGte is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) HavingCountGte(count int) UserQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) >= ?", count))
+}
+
+// HavingCountLt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) HavingCountLt(count int) UserQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) < ?", count))
+}
+
+// HavingCountLte is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) HavingCountLte(count int) UserQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) <= ?", count))
+}
+
+// HavingCountNe is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) HavingCountNe(count int) UserQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) != ?", count))
+}
+
+// IDBetween filters rows with ID in range [from, to]
+// nolint: dupl
+func (qs UserQuerySet) IDBetween(from uint, to uint) UserQuerySet {
+	return qs.w(qs.db.Where("id BETWEEN ? AND ?", from, to))
+}
+
+// IDEq is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) IDEq(ID uint) UserQuerySet {
+	return qs.w(qs.db.Where("id = ?", ID))
+}
+
+// IDGt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) IDGt(ID uint) UserQuerySet {
+	return qs.w(qs.db.Where("id > ?", ID))
+}
+
+// IDGte is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) IDGte(ID uint) UserQuerySet {
+	return qs.w(qs.db.Where("id >= ?", ID))
+}
+
+// IDIn is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) IDIn(ID ...uint) UserQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("id IN (?)", ID))
+}
+
+// IDInQuery filters rows with ID IN values selected by subquery q
+// nolint: dupl
+func (qs UserQuerySet) IDInQuery(q UintSubquery) UserQuerySet {
+	return qs.w(qs.db.Where("id IN (?)", q.expr()))
+}
+
+// IDInRange filters rows with ID in range [from, to)
+// nolint: dupl
+func (qs UserQuerySet) IDInRange(from uint, to uint) UserQuerySet {
+	return qs.w(qs.db.Where("id >= ? AND id < ?", from, to))
+}
+
+// IDLt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) IDLt(ID uint) UserQuerySet {
+	return qs.w(qs.db.Where("id < ?", ID))
+}
+
+// IDLte is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) IDLte(ID uint) UserQuerySet {
+	return qs.w(qs.db.Where("id <= ?", ID))
+}
+
+// IDNe is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) IDNe(ID uint) UserQuerySet {
+	return qs.w(qs.db.Where("id != ?", ID))
+}
+
+// IDNotIn is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) IDNotIn(ID ...uint) UserQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("id NOT IN (?)", ID))
+}
+
+// IDNotInQuery filters rows with ID NOT IN values selected by subquery q
+// nolint: dupl
+func (qs UserQuerySet) IDNotInQuery(q UintSubquery) UserQuerySet {
+	return qs.w(qs.db.Where("id NOT IN (?)", q.expr()))
+}
+
+// InTx returns copy bound to transaction tx: queries are executed in it.
+// Gorm v1 can't move conditions into another connection, so with gorm v1
+// InTx must be called before any condition is set, otherwise queries fail.
+// Context and preloads are moved into transaction by both gorm versions.
+// nolint: dupl
+func (qs UserQuerySet) InTx(tx *gorm.DB) UserQuerySet {
+	if !reflect.DeepEqual(qs.db.QueryExpr(), NewUserQuerySet(qs.db.New()).db.QueryExpr()) {
+		tx = tx.New()
+		tx.AddError(errors.New("InTx must be called before any condition is set: " +
+			"gorm v1 can't move conditions into transaction"))
+	}
+	tx = txWithSettings(qs.db, tx)
+	return NewUserQuerySet(tx)
+}
+
+// Iterate scans rows one by one and calls fn for every row without
+// loading all rows into memory. It stops on the first error of fn and
+// returns it. Preloads aren't applied.
+// nolint: dupl
+func (qs UserQuerySet) Iterate(fn func(*User) error) error {
+	db := checkQueryContext(qs.db)
+	if db.Error != nil {
+		return db.Error // gorm v1 doesn't check it in Rows
+	}
+
+	rows, err := db.Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var o User
+		if err = db.ScanRows(rows, &o); err != nil {
+			return err
+		}
+		if err = fn(&o); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// Limit is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) Limit(limit int) UserQuerySet {
+	return qs.w(qs.db.Limit(limit))
+}
+
+// MaxCreatedAt returns MAX of CreatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs UserQuerySet) MaxCreatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := checkQueryContext(qs.db).Select("created_at AS value").Where("created_at IS NOT NULL").
+		Order("created_at DESC", true).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MaxID returns MAX of ID, it's zero value if there are no rows
+// nolint: dupl
+func (qs UserQuerySet) MaxID() (uint, error) {
+	var ret uint
+	var res struct {
+		Value *uint
+	}
+	err := checkQueryContext(qs.db).Select("MAX(id) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// MaxUpdatedAt returns MAX of UpdatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs UserQuerySet) MaxUpdatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := checkQueryContext(qs.db).Select("updated_at AS value").Where("updated_at IS NOT NULL").
+		Order("updated_at DESC", true).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MinCreatedAt returns MIN of CreatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs UserQuerySet) MinCreatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := checkQueryContext(qs.db).Select("created_at AS value").Where("created_at IS NOT NULL").
+		Order("created_at ASC", true).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MinID returns MIN of ID, it's zero value if there are no rows
+// nolint: dupl
+func (qs UserQuerySet) MinID() (uint, error) {
+	var ret uint
+	var res struct {
+		Value *uint
+	}
+	err := checkQueryContext(qs.db).Select("MIN(id) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// MinUpdatedAt returns MIN of UpdatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs UserQuerySet) MinUpdatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := checkQueryContext(qs.db).Select("updated_at AS value").Where("updated_at IS NOT NULL").
+		Order("updated_at ASC", true).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// NameEndsWith filters rows with Name ending with the argument
+// nolint: dupl
+func (qs UserQuerySet) NameEndsWith(name string) UserQuerySet {
+	return qs.w(whereLike(qs.db, "name", "%", name, "", false))
+}
+
+// NameEq is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) NameEq(name string) UserQuerySet {
+	return qs.w(qs.db.Where("name = ?", name))
+}
+
+// NameGt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) NameGt(name string) UserQuerySet {
+	return qs.w(qs.db.Where("name > ?", name))
+}
+
+// NameGte is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) NameGte(name string) UserQuerySet {
+	return qs.w(qs.db.Where("name >= ?", name))
+}
+
+// NameIContains filters rows with Name containing the argument, case is ignored
+// nolint: dupl
+func (qs UserQuerySet) NameIContains(name string) UserQuerySet {
+	return qs.w(whereLike(qs.db, "name", "%", name, "%", true))
+}
+
+// NameIEq filters rows with Name equal to the argument, case is ignored
+// nolint: dupl
+func (qs UserQuerySet) NameIEq(name string) UserQuerySet {
+	return qs.w(whereLike(qs.db, "name", "", name, "", true))
+}
+
+// NameIn is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) NameIn(name ...string) UserQuerySet {
+	if len(name) == 0 {
+		qs.db.AddError(errors.New("must at least pass one name in NameIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("name IN (?)", name))
+}
+
+// NameInQuery filters rows with Name IN values selected by subquery q
+// nolint: dupl
+func (qs UserQuerySet) NameInQuery(q StringSubquery) UserQuerySet {
+	return qs.w(qs.db.Where("name IN (?)", q.expr()))
+}
+
+// NameLike is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) NameLike(name string) UserQuerySet {
+	return qs.w(qs.db.Where("name LIKE ?", name))
+}
+
+// NameLt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) NameLt(name string) UserQuerySet {
+	return qs.w(qs.db.Where("name < ?", name))
+}
+
+// NameLte is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) NameLte(name string) UserQuerySet {
+	return qs.w(qs.db.Where("name <= ?", name))
+}
+
+// NameNe is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) NameNe(name string) UserQuerySet {
+	return qs.w(qs.db.Where("name != ?", name))
+}
+
+// NameNotIn is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) NameNotIn(name ...string) UserQuerySet {
+	if len(name) == 0 {
+		qs.db.AddError(errors.New("must at least pass one name in NameNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("name NOT IN (?)", name))
+}
+
+// NameNotInQuery filters rows with Name NOT IN values selected by subquery q
+// nolint: dupl
+func (qs UserQuerySet) NameNotInQuery(q StringSubquery) UserQuerySet {
+	return qs.w(qs.db.Where("name NOT IN (?)", q.expr()))
+}
+
+// NameNotlike is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) NameNotlike(name string) UserQuerySet {
+	return qs.w(qs.db.Where("name NOT LIKE ?", name))
+}
+
+// NameStartsWith filters rows with Name starting with the argument
+// nolint: dupl
+func (qs UserQuerySet) NameStartsWith(name string) UserQuerySet {
+	return qs.w(whereLike(qs.db, "name", "", name, "%", false))
+}
+
+// Offset is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) Offset(offset int) UserQuerySet {
+	return qs.w(qs.db.Offset(offset))
+}
+
+// One is used to retrieve one result. It returns gorm.ErrRecordNotFound
+// if nothing was fetched
+func (qs UserQuerySet) One(ret *User) error {
+	return checkQueryContext(qs.db).First(ret).Error
+}
+
+// OrderAscByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) OrderAscByCreatedAt() UserQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("created_at ASC"), "created_at", false, false))
+}
+
+// OrderAscByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) OrderAscByDeletedAt() UserQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("deleted_at ASC"), "deleted_at", false, false))
+}
+
+// OrderAscByEmail is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) OrderAscByEmail() UserQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("email ASC"), "email", false, false))
+}
+
+// OrderAscByID is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) OrderAscByID() UserQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("id ASC"), "id", false, false))
+}
+
+// OrderAscByName is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) OrderAscByName() UserQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("name ASC"), "name", false, false))
+}
+
+// OrderAscBySurname is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) OrderAscBySurname() UserQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("user_surname ASC"), "user_surname", false, false))
+}
+
+// OrderAscByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) OrderAscByUpdatedAt() UserQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("updated_at ASC"), "updated_at", false, false))
+}
+
+// OrderDescByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) OrderDescByCreatedAt() UserQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("created_at DESC"), "created_at", true, false))
+}
+
+// OrderDescByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) OrderDescByDeletedAt() UserQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("deleted_at DESC"), "deleted_at", true, false))
+}
+
+// OrderDescByEmail is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) OrderDescByEmail() UserQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("email DESC"), "email", true, false))
+}
+
+// OrderDescByID is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) OrderDescByID() UserQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("id DESC"), "id", true, false))
+}
+
+// OrderDescByName is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) OrderDescByName() UserQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("name DESC"), "name", true, false))
+}
+
+// OrderDescBySurname is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) OrderDescBySurname() UserQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("user_surname DESC"), "user_surname", true, false))
+}
+
+// OrderDescByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) OrderDescByUpdatedAt() UserQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("updated_at DESC"), "updated_at", true, false))
+}
+
+// Page fetches at most size rows following cursor into ret, it uses
+// orders set by OrderAscBy/OrderDescBy methods and primary key to break ties.
+// Ordered fields can't be nullable: rows with NULL can't be compared with
+// cursor, so error is returned for them. Empty cursor means the first page. It returns cursor of the next page or
+// empty string if there are no more rows.
+// nolint: dupl
+func (qs UserQuerySet) Page(cursor string, size int, ret *[]User) (string, error) {
+	if size <= 0 {
+		return "", errors.New("page size must be positive")
+	}
+
+	var last User
+	db, orders, err := pageQuery(qs.db, "", "id", cursor, qs.fieldPtrs(&last))
+	if err != nil {
+		return "", err
+	}
+
+	if err = checkQueryContext(db).Limit(size + 1).Find(ret).Error; err != nil {
+		return "", err
+	}
+	if len(*ret) <= size {
+		return "", nil
+	}
+
+	*ret = (*ret)[:size]
+	return encodePageCursor(orders, qs.fieldPtrs(&(*ret)[size-1]))
+}
+
+// PreloadPosts is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) PreloadPosts() UserQuerySet {
+	return qs.w(preload(qs.db, "Posts"))
+}
+
+// PreloadPostsWhere preloads Posts filtered, ordered or limited by f:
+// limit is applied to all preloaded objects, not to objects of every row
+// nolint: dupl
+func (qs UserQuerySet) PreloadPostsWhere(f func(PostQuerySet) PostQuerySet) UserQuerySet {
+	return qs.w(preload(qs.db, "Posts", func(db *gorm.DB) *gorm.DB {
+		return f(NewPostQuerySet(db)).db
+	}))
+}
+
+// SelectEmail returns subquery selecting Email of rows of queryset
+// for InQuery and NotInQuery filters
+// nolint: dupl
+func (qs UserQuerySet) SelectEmail() StringSubquery {
+	return StringSubquery{db: qs.db.Select("email")}
+}
+
+// SelectID returns subquery selecting ID of rows of queryset
+// for InQuery and NotInQuery filters
+// nolint: dupl
+func (qs UserQuerySet) SelectID() UintSubquery {
+	return UintSubquery{db: qs.db.Select("id")}
+}
+
+// SelectName returns subquery selecting Name of rows of queryset
+// for InQuery and NotInQuery filters
+// nolint: dupl
+func (qs UserQuerySet) SelectName() StringSubquery {
+	return StringSubquery{db: qs.db.Select("name")}
+}
+
+// SurnameEndsWith filters rows with Surname ending with the argument
+// nolint: dupl
+func (qs UserQuerySet) SurnameEndsWith(surname string) UserQuerySet {
+	return qs.w(whereLike(qs.db, "user_surname", "%", surname, "", false))
+}
+
+// SurnameEq is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) SurnameEq(surname string) UserQuerySet {
+	return qs.w(qs.db.Where("user_surname = ?", surname))
+}
+
+// SurnameGt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) SurnameGt(surname string) UserQuerySet {
+	return qs.w(qs.db.Where("user_surname > ?", surname))
+}
+
+// SurnameGte is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) SurnameGte(surname string) UserQuerySet {
+	return qs.w(qs.db.Where("user_surname >= ?", surname))
+}
+
+// SurnameIContains filters rows with Surname containing the argument, case is ignored
+// nolint: dupl
+func (qs UserQuerySet) SurnameIContains(surname string) UserQuerySet {
+	return qs.w(whereLike(qs.db, "user_surname", "%", surname, "%", true))
+}
+
+// SurnameIEq filters rows with Surname equal to the argument, case is ignored
+// nolint: dupl
+func (qs UserQuerySet) SurnameIEq(surname string) UserQuerySet {
+	return qs.w(whereLike(qs.db, "user_surname", "", surname, "", true))
+}
+
+// SurnameIn is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) SurnameIn(surname ...string) UserQuerySet {
+	if len(surname) == 0 {
+		qs.db.AddError(errors.New("must at least pass one surname in SurnameIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("user_surname IN (?)", surname))
+}
+
+// SurnameInQuery filters rows with Surname IN values selected by subquery q
+// nolint: dupl
+func (qs UserQuerySet) SurnameInQuery(q StringSubquery) UserQuerySet {
+	return qs.w(qs.db.Where("user_surname IN (?)", q.expr()))
+}
+
+// SurnameIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) SurnameIsNotNull() UserQuerySet {
+	return qs.w(qs.db.Where("user_surname IS NOT NULL"))
+}
+
+// SurnameIsNull is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) SurnameIsNull() UserQuerySet {
+	return qs.w(qs.db.Where("user_surname IS NULL"))
+}
+
+// SurnameLike is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) SurnameLike(surname string) UserQuerySet {
+	return qs.w(qs.db.Where("user_surname LIKE ?", surname))
+}
+
+// SurnameLt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) SurnameLt(surname string) UserQuerySet {
+	return qs.w(qs.db.Where("user_surname < ?", surname))
+}
+
+// SurnameLte is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) SurnameLte(surname string) UserQuerySet {
+	return qs.w(qs.db.Where("user_surname <= ?", surname))
+}
+
+// SurnameNe is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) SurnameNe(surname string) UserQuerySet {
+	return qs.w(qs.db.Where("user_surname != ?", surname))
+}
+
+// SurnameNotIn is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) SurnameNotIn(surname ...string) UserQuerySet {
+	if len(surname) == 0 {
+		qs.db.AddError(errors.New("must at least pass one surname in SurnameNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("user_surname NOT IN (?)", surname))
+}
+
+// SurnameNotInQuery filters rows with Surname NOT IN values selected by subquery q
+// nolint: dupl
+func (qs UserQuerySet) SurnameNotInQuery(q StringSubquery) UserQuerySet {
+	return qs.w(qs.db.Where("user_surname NOT IN (?)", q.expr()))
+}
+
+// SurnameNotlike is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) SurnameNotlike(surname string) UserQuerySet {
+	return qs.w(qs.db.Where("user_surname NOT LIKE ?", surname))
+}
+
+// SurnameStartsWith filters rows with Surname starting with the argument
+// nolint: dupl
+func (qs UserQuerySet) SurnameStartsWith(surname string) UserQuerySet {
+	return qs.w(whereLike(qs.db, "user_surname", "", surname, "%", false))
+}
+
+// UpdatedAtBetween filters rows with UpdatedAt in range [from, to]
+// nolint: dupl
+func (qs UserQuerySet) UpdatedAtBetween(from time.Time, to time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("updated_at BETWEEN ? AND ?", from, to))
+}
+
+// UpdatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) UpdatedAtEq(updatedAt time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("updated_at = ?", updatedAt))
+}
+
+// UpdatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) UpdatedAtGt(updatedAt time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("updated_at > ?", updatedAt))
+}
+
+// UpdatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) UpdatedAtGte(updatedAt time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("updated_at >= ?", updatedAt))
+}
+
+// UpdatedAtInRange filters rows with UpdatedAt in range [from, to)
+// nolint: dupl
+func (qs UserQuerySet) UpdatedAtInRange(from time.Time, to time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("updated_at >= ? AND updated_at < ?", from, to))
+}
+
+// UpdatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) UpdatedAtLt(updatedAt time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("updated_at < ?", updatedAt))
+}
+
+// UpdatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) UpdatedAtLte(updatedAt time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("updated_at <= ?", updatedAt))
+}
+
+// UpdatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) UpdatedAtNe(updatedAt time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("updated_at != ?", updatedAt))
+}
+
+// UpdatedAtWithin filters rows with UpdatedAt not earlier than d ago
+// nolint: dupl
+func (qs UserQuerySet) UpdatedAtWithin(d time.Duration) UserQuerySet {
+	return qs.w(qs.db.Where("updated_at >= ?", time.Now().Add(-d)))
+}
+
+// WithContext returns copy executing queries with context ctx.
+// Gorm v1 doesn't support context: its error is checked only before query.
+// nolint: dupl
+func (qs UserQuerySet) WithContext(ctx context.Context) UserQuerySet {
+	return qs.w(DBWithContext(ctx, qs.db))
+}
+
+// fieldPtrs returns pointers to fields of o by their columns
+// nolint: dupl
+func (qs UserQuerySet) fieldPtrs(o *User) map[string]interface{} {
+	return map[string]interface{}{
+		"id":           &o.ID,
+		"created_at":   &o.CreatedAt,
+		"updated_at":   &o.UpdatedAt,
+		"deleted_at":   &o.DeletedAt,
+		"name":         &o.Name,
+		"user_surname": &o.Surname,
+		"email":        &o.Email,
+	}
+}
+
+// InTx returns copy bound to transaction tx: queries are executed in it.
+// Gorm v1 can't move conditions into another connection, so with gorm v1
+// InTx must be called before any condition is set, otherwise queries fail.
+// Context and preloads are moved into transaction by both gorm versions.
+// nolint: dupl
+func (u UserUpdater) InTx(tx *gorm.DB) UserUpdater {
+	if !reflect.DeepEqual(u.db.QueryExpr(), u.db.New().Model(&User{}).QueryExpr()) {
+		tx = tx.New()
+		tx.AddError(errors.New("InTx must be called before any condition is set: " +
+			"gorm v1 can't move conditions into transaction"))
+	}
+	tx = txWithSettings(u.db, tx)
+	u.db = tx.Model(&User{})
+	return u
+}
+
+// SetCreatedAt is an autogenerated method
+// nolint: dupl
+func (u UserUpdater) SetCreatedAt(createdAt time.Time) UserUpdater {
+	u.fields[string(UserDBSchema.CreatedAt)] = createdAt
+	return u
+}
+
+// SetDeletedAt is an autogenerated method
+// nolint: dupl
+func (u UserUpdater) SetDeletedAt(deletedAt *time.Time) UserUpdater {
+	u.fields[string(UserDBSchema.DeletedAt)] = deletedAt
+	return u
+}
+
+// SetEmail is an autogenerated method
+// nolint: dupl
+func (u UserUpdater) SetEmail(email string) UserUpdater {
+	u.fields[string(UserDBSchema.Email)] = email
+	return u
+}
+
+// SetID is an autogenerated method
+// nolint: dupl
+func (u UserUpdater) SetID(ID uint) UserUpdater {
+	u.fields[string(UserDBSchema.ID)] = ID
+	return u
+}
+
+// SetName is an autogenerated method
+// nolint: dupl
+func (u UserUpdater) SetName(name string) UserUpdater {
+	u.fields[string(UserDBSchema.Name)] = name
+	return u
+}
+
+// SetSurname is an autogenerated method
+// nolint: dupl
+func (u UserUpdater) SetSurname(surname *string) UserUpdater {
+	u.fields[string(UserDBSchema.Surname)] = surname
+	return u
+}
+
+// SetUpdatedAt is an autogenerated method
+// nolint: dupl
+func (u UserUpdater) SetUpdatedAt(updatedAt time.Time) UserUpdater {
+	u.fields[string(UserDBSchema.UpdatedAt)] = updatedAt
+	return u
+}
+
+// Update is an autogenerated method
+// nolint: dupl
+func (u UserUpdater) Update() error {
+	return checkQueryContext(u.db).Updates(u.fields).Error
+}
+
+// UpdateNum is an autogenerated method
+// nolint: dupl
+func (u UserUpdater) UpdateNum() (int64, error) {
+	db := checkQueryContext(u.db).Updates(u.fields)
+	return db.RowsAffected, db.Error
+}
+
+// WithContext returns copy executing queries with context ctx.
+// Gorm v1 doesn't support context: its error is checked only before query.
+// nolint: dupl
+func (u UserUpdater) WithContext(ctx context.Context) UserUpdater {
+	u.db = DBWithContext(ctx, u.db)
+	return u
+}
+
+// ===== END of query set UserQuerySet
+
+// ===== BEGIN of User modifiers
+
+// UserDBSchemaField describes database schema field. It requires for method 'Update'
+type UserDBSchemaField string
+
+// String method returns string representation of field.
+// nolint: dupl
+func (f UserDBSchemaField) String() string {
+	return string(f)
+}
+
+// UserDBSchema stores db field names of User
+var UserDBSchema = struct {
+	ID        UserDBSchemaField
+	CreatedAt UserDBSchemaField
+	UpdatedAt UserDBSchemaField
+	DeletedAt UserDBSchemaField
+	Name      UserDBSchemaField
+	Surname   UserDBSchemaField
+	Email     UserDBSchemaField
+}{
+
+	ID:        UserDBSchemaField("id"),
+	CreatedAt: UserDBSchemaField("created_at"),
+	UpdatedAt: UserDBSchemaField("updated_at"),
+	DeletedAt: UserDBSchemaField("deleted_at"),
+	Name:      UserDBSchemaField("name"),
+	Surname:   UserDBSchemaField("user_surname"),
+	Email:     UserDBSchemaField("email"),
+}
+
+// Update updates User fields by primary key
+// nolint: dupl
+func (o *User) Update(db *gorm.DB, fields ...UserDBSchemaField) error {
+	dbNameToFieldName := map[string]interface{}{
+		"id":           o.ID,
+		"created_at":   o.CreatedAt,
+		"updated_at":   o.UpdatedAt,
+		"deleted_at":   o.DeletedAt,
+		"name":         o.Name,
+		"user_surname": o.Surname,
+		"email":        o.Email,
+	}
+	u := map[string]interface{}{}
+	for _, f := range fields {
+		fs := f.String()
+		u[fs] = dbNameToFieldName[fs]
+	}
+	if err := checkQueryContext(db).Model(o).Updates(u).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return err
+		}
+
+		return fmt.Errorf("can't update User %v fields %v: %s",
+			o, fields, err)
+	}
+
+	return nil
+}
+
+// Upsert inserts User or updates updateFields of existing row
+// if insert violates unique constraint on conflictFields. Row isn't
+// changed if there are no updateFields: any conflict is ignored then if
+// there are no conflictFields. MySQL ignores conflictFields: it checks
+// all unique constraints, other databases require them to update row.
+// nolint: dupl
+func (o *User) Upsert(db *gorm.DB, conflictFields []UserDBSchemaField,
+	updateFields ...UserDBSchemaField) error {
+
+	conflictColumns := make([]string, 0, len(conflictFields))
+	for _, f := range conflictFields {
+		conflictColumns = append(conflictColumns, f.String())
+	}
+	updateColumns := make([]string, 0, len(updateFields))
+	for _, f := range updateFields {
+		updateColumns = append(updateColumns, f.String())
+	}
+
+	qs := NewUserQuerySet(db)
+	return upsert(checkQueryContext(qs.db), "id", qs.fieldPtrs(o),
+		conflictColumns, updateColumns)
+}
+
+// UserUpdater is an User updates manager
+type UserUpdater struct {
+	fields map[string]interface{}
+	db     *gorm.DB
+}
+
+// NewUserUpdater creates new User updater
+// nolint: dupl
+func NewUserUpdater(db *gorm.DB) UserUpdater {
+	return UserUpdater{
+		fields: map[string]interface{}{},
+		db:     db.Model(&User{}),
+	}
+}
+
+// ===== END of User modifiers
+
+// ===== BEGIN of User predicates
+
+// UserPredicate is a condition on User fields for Where and Not
+// methods of UserQuerySet: predicates are created by UserQ and
+// combined by And, Or and Not. Zero predicate matches all rows.
+type UserPredicate struct {
+	expr string
+	args []interface{}
+	err  error
+}
+
+// join combines predicates by operation op, empty predicates are skipped
+// nolint: dupl
+func (p UserPredicate) join(op string, preds []UserPredicate) UserPredicate {
+	for _, q := range preds {
+		if p.err == nil {
+			p.err = q.err
+		}
+		if q.expr == "" {
+			continue
+		}
+		if p.expr == "" {
+			p.expr, p.args = q.expr, q.args
+			continue
+		}
+
+		p.expr = "(" + p.expr + ") " + op + " (" + q.expr + ")"
+		p.args = append(append([]interface{}{}, p.args...), q.args...)
+	}
+
+	return p
+}
+
+// And returns predicate matching rows matched by p and all preds
+// nolint: dupl
+func (p UserPredicate) And(preds ...UserPredicate) UserPredicate {
+	return p.join("AND", preds)
+}
+
+// Or returns predicate matching rows matched by p or any of preds
+// nolint: dupl
+func (p UserPredicate) Or(preds ...UserPredicate) UserPredicate {
+	return p.join("OR", preds)
+}
+
+// Not returns predicate matching rows not matched by p
+// nolint: dupl
+func (p UserPredicate) Not() UserPredicate {
+	if p.expr != "" {
+		p.expr = "NOT (" + p.expr + ")"
+	}
+
+	return p
+}
+
+// UserPredicates creates predicates by User fields
+type UserPredicates struct{}
+
+// UserQ creates predicates by User fields
+var UserQ UserPredicates
+
+// CreatedAtEq creates predicate "created_at = ?"
+// nolint: dupl
+func (UserPredicates) CreatedAtEq(createdAt time.Time) UserPredicate {
+	return UserPredicate{expr: "created_at = ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtGt creates predicate "created_at > ?"
+// nolint: dupl
+func (UserPredicates) CreatedAtGt(createdAt time.Time) UserPredicate {
+	return UserPredicate{expr: "created_at > ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtGte creates predicate "created_at >= ?"
+// nolint: dupl
+func (UserPredicates) CreatedAtGte(createdAt time.Time) UserPredicate {
+	return UserPredicate{expr: "created_at >= ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtLt creates predicate "created_at < ?"
+// nolint: dupl
+func (UserPredicates) CreatedAtLt(createdAt time.Time) UserPredicate {
+	return UserPredicate{expr: "created_at < ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtLte creates predicate "created_at <= ?"
+// nolint: dupl
+func (UserPredicates) CreatedAtLte(createdAt time.Time) UserPredicate {
+	return UserPredicate{expr: "created_at <= ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtNe creates predicate "created_at != ?"
+// nolint: dupl
+func (UserPredicates) CreatedAtNe(createdAt time.Time) UserPredicate {
+	return UserPredicate{expr: "created_at != ?", args: []interface{}{createdAt}}
+}
+
+// DeletedAtEq creates predicate "deleted_at = ?"
+// nolint: dupl
+func (UserPredicates) DeletedAtEq(deletedAt time.Time) UserPredicate {
+	return UserPredicate{expr: "deleted_at = ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtGt creates predicate "deleted_at > ?"
+// nolint: dupl
+func (UserPredicates) DeletedAtGt(deletedAt time.Time) UserPredicate {
+	return UserPredicate{expr: "deleted_at > ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtGte creates predicate "deleted_at >= ?"
+// nolint: dupl
+func (UserPredicates) DeletedAtGte(deletedAt time.Time) UserPredicate {
+	return UserPredicate{expr: "deleted_at >= ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtIsNotNull creates predicate "deleted_at IS NOT NULL"
+// nolint: dupl
+func (UserPredicates) DeletedAtIsNotNull() UserPredicate {
+	return UserPredicate{expr: "deleted_at IS NOT NULL"}
+}
+
+// DeletedAtIsNull creates predicate "deleted_at IS NULL"
+// nolint: dupl
+func (UserPredicates) DeletedAtIsNull() UserPredicate {
+	return UserPredicate{expr: "deleted_at IS NULL"}
+}
+
+// DeletedAtLt creates predicate "deleted_at < ?"
+// nolint: dupl
+func (UserPredicates) DeletedAtLt(deletedAt time.Time) UserPredicate {
+	return UserPredicate{expr: "deleted_at < ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtLte creates predicate "deleted_at <= ?"
+// nolint: dupl
+func (UserPredicates) DeletedAtLte(deletedAt time.Time) UserPredicate {
+	return UserPredicate{expr: "deleted_at <= ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtNe creates predicate "deleted_at != ?"
+// nolint: dupl
+func (UserPredicates) DeletedAtNe(deletedAt time.Time) UserPredicate {
+	return UserPredicate{expr: "deleted_at != ?", args: []interface{}{deletedAt}}
+}
+
+// EmailEq creates predicate "email = ?"
+// nolint: dupl
+func (UserPredicates) EmailEq(email string) UserPredicate {
+	return UserPredicate{expr: "email = ?", args: []interface{}{email}}
+}
+
+// EmailGt creates predicate "email > ?"
+// nolint: dupl
+func (UserPredicates) EmailGt(email string) UserPredicate {
+	return UserPredicate{expr: "email > ?", args: []interface{}{email}}
+}
+
+// EmailGte creates predicate "email >= ?"
+// nolint: dupl
+func (UserPredicates) EmailGte(email string) UserPredicate {
+	return UserPredicate{expr: "email >= ?", args: []interface{}{email}}
+}
+
+// EmailIn creates predicate "email IN (?)"
+// nolint: dupl
+func (UserPredicates) EmailIn(email ...string) UserPredicate {
+	if len(email) == 0 {
+		return UserPredicate{err: errors.New("must at least pass one email in EmailIn")}
+	}
+	return UserPredicate{expr: "email IN (?)", args: []interface{}{email}}
+}
+
+// EmailLike creates predicate "email LIKE ?"
+// nolint: dupl
+func (UserPredicates) EmailLike(email string) UserPredicate {
+	return UserPredicate{expr: "email LIKE ?", args: []interface{}{email}}
+}
+
+// EmailLt creates predicate "email < ?"
+// nolint: dupl
+func (UserPredicates) EmailLt(email string) UserPredicate {
+	return UserPredicate{expr: "email < ?", args: []interface{}{email}}
+}
+
+// EmailLte creates predicate "email <= ?"
+// nolint: dupl
+func (UserPredicates) EmailLte(email string) UserPredicate {
+	return UserPredicate{expr: "email <= ?", args: []interface{}{email}}
+}
+
+// EmailNe creates predicate "email != ?"
+// nolint: dupl
+func (UserPredicates) EmailNe(email string) UserPredicate {
+	return UserPredicate{expr: "email != ?", args: []interface{}{email}}
+}
+
+// EmailNotIn creates predicate "email NOT IN (?)"
+// nolint: dupl
+func (UserPredicates) EmailNotIn(email ...string) UserPredicate {
+	if len(email) == 0 {
+		return UserPredicate{err: errors.New("must at least pass one email in EmailNotIn")}
+	}
+	return UserPredicate{expr: "email NOT IN (?)", args: []interface{}{email}}
+}
+
+// EmailNotlike creates predicate "email NOT LIKE ?"
+// nolint: dupl
+func (UserPredicates) EmailNotlike(email string) UserPredicate {
+	return UserPredicate{expr: "email NOT LIKE ?", args: []interface{}{email}}
+}
+
+// IDEq creates predicate "id = ?"
+// nolint: dupl
+func (UserPredicates) IDEq(ID uint) UserPredicate {
+	return UserPredicate{expr: "id = ?", args: []interface{}{ID}}
+}
+
+// IDGt creates predicate "id > ?"
+// nolint: dupl
+func (UserPredicates) IDGt(ID uint) UserPredicate {
+	return UserPredicate{expr: "id > ?", args: []interface{}{ID}}
+}
+
+// IDGte creates predicate "id >= ?"
+// nolint: dupl
+func (UserPredicates) IDGte(ID uint) UserPredicate {
+	return UserPredicate{expr: "id >= ?", args: []interface{}{ID}}
+}
+
+// IDIn creates predicate "id IN (?)"
+// nolint: dupl
+func (UserPredicates) IDIn(ID ...uint) UserPredicate {
+	if len(ID) == 0 {
+		return UserPredicate{err: errors.New("must at least pass one ID in IDIn")}
+	}
+	return UserPredicate{expr: "id IN (?)", args: []interface{}{ID}}
+}
+
+// IDLt creates predicate "id < ?"
+// nolint: dupl
+func (UserPredicates) IDLt(ID uint) UserPredicate {
+	return UserPredicate{expr: "id < ?", args: []interface{}{ID}}
+}
+
+// IDLte creates predicate "id <= ?"
+// nolint: dupl
+func (UserPredicates) IDLte(ID uint) UserPredicate {
+	return UserPredicate{expr: "id <= ?", args: []interface{}{ID}}
+}
+
+// IDNe creates predicate "id != ?"
+// nolint: dupl
+func (UserPredicates) IDNe(ID uint) UserPredicate {
+	return UserPredicate{expr: "id != ?", args: []interface{}{ID}}
+}
+
+// IDNotIn creates predicate "id NOT IN (?)"
+// nolint: dupl
+func (UserPredicates) IDNotIn(ID ...uint) UserPredicate {
+	if len(ID) == 0 {
+		return UserPredicate{err: errors.New("must at least pass one ID in IDNotIn")}
+	}
+	return UserPredicate{expr: "id NOT IN (?)", args: []interface{}{ID}}
+}
+
+// NameEq creates predicate "name = ?"
+// nolint: dupl
+func (UserPredicates) NameEq(name string) UserPredicate {
+	return UserPredicate{expr: "name = ?", args: []interface{}{name}}
+}
+
+// NameGt creates predicate "name > ?"
+// nolint: dupl
+func (UserPredicates) NameGt(name string) UserPredicate {
+	return UserPredicate{expr: "name > ?", args: []interface{}{name}}
+}
+
+// NameGte creates predicate "name >= ?"
+// nolint: dupl
+func (UserPredicates) NameGte(name string) UserPredicate {
+	return UserPredicate{expr: "name >= ?", args: []interface{}{name}}
+}
+
+// NameIn creates predicate "name IN (?)"
+// nolint: dupl
+func (UserPredicates) NameIn(name ...string) UserPredicate {
+	if len(name) == 0 {
+		return UserPredicate{err: errors.New("must at least pass one name in NameIn")}
+	}
+	return UserPredicate{expr: "name IN (?)", args: []interface{}{name}}
+}
+
+// NameLike creates predicate "name LIKE ?"
+// nolint: dupl
+func (UserPredicates) NameLike(name string) UserPredicate {
+	return UserPredicate{expr: "name LIKE ?", args: []interface{}{name}}
+}
+
+// NameLt creates predicate "name < ?"
+// nolint: dupl
+func (UserPredicates) NameLt(name string) UserPredicate {
+	return UserPredicate{expr: "name < ?", args: []interface{}{name}}
+}
+
+// NameLte creates predicate "name <= ?"
+// nolint: dupl
+func (UserPredicates) NameLte(name string) UserPredicate {
+	return UserPredicate{expr: "name <= ?", args: []interface{}{name}}
+}
+
+// NameNe creates predicate "name != ?"
+// nolint: dupl
+func (UserPredicates) NameNe(name string) UserPredicate {
+	return UserPredicate{expr: "name != ?", args: []interface{}{name}}
+}
+
+// NameNotIn creates predicate "name NOT IN (?)"
+// nolint: dupl
+func (UserPredicates) NameNotIn(name ...string) UserPredicate {
+	if len(name) == 0 {
+		return UserPredicate{err: errors.New("must at least pass one name in NameNotIn")}
+	}
+	return UserPredicate{expr: "name NOT IN (?)", args: []interface{}{name}}
+}
+
+// NameNotlike creates predicate "name NOT LIKE ?"
+// nolint: dupl
+func (UserPredicates) NameNotlike(name string) UserPredicate {
+	return UserPredicate{expr: "name NOT LIKE ?", args: []interface{}{name}}
+}
+
+// SurnameEq creates predicate "user_surname = ?"
+// nolint: dupl
+func (UserPredicates) SurnameEq(surname string) UserPredicate {
+	return UserPredicate{expr: "user_surname = ?", args: []interface{}{surname}}
+}
+
+// SurnameGt creates predicate "user_surname > ?"
+// nolint: dupl
+func (UserPredicates) SurnameGt(surname string) UserPredicate {
+	return UserPredicate{expr: "user_surname > ?", args: []interface{}{surname}}
+}
+
+// SurnameGte creates predicate "user_surname >= ?"
+// nolint: dupl
+func (UserPredicates) SurnameGte(surname string) UserPredicate {
+	return UserPredicate{expr: "user_surname >= ?", args: []interface{}{surname}}
+}
+
+// SurnameIn creates predicate "user_surname IN (?)"
+// nolint: dupl
+func (UserPredicates) SurnameIn(surname ...string) UserPredicate {
+	if len(surname) == 0 {
+		return UserPredicate{err: errors.New("must at least pass one surname in SurnameIn")}
+	}
+	return UserPredicate{expr: "user_surname IN (?)", args: []interface{}{surname}}
+}
+
+// SurnameIsNotNull creates predicate "user_surname IS NOT NULL"
+// nolint: dupl
+func (UserPredicates) SurnameIsNotNull() UserPredicate {
+	return UserPredicate{expr: "user_surname IS NOT NULL"}
+}
+
+// SurnameIsNull creates predicate "user_surname IS NULL"
+// nolint: dupl
+func (UserPredicates) SurnameIsNull() UserPredicate {
+	return UserPredicate{expr: "user_surname IS NULL"}
+}
+
+// SurnameLike creates predicate "user_surname LIKE ?"
+// nolint: dupl
+func (UserPredicates) SurnameLike(surname string) UserPredicate {
+	return UserPredicate{expr: "user_surname LIKE ?", args: []interface{}{surname}}
+}
+
+// SurnameLt creates predicate "user_surname < ?"
+// nolint: dupl
+func (UserPredicates) SurnameLt(surname string) UserPredicate {
+	return UserPredicate{expr: "user_surname < ?", args: []interface{}{surname}}
+}
+
+// SurnameLte creates predicate "user_surname <= ?"
+// nolint: dupl
+func (UserPredicates) SurnameLte(surname string) UserPredicate {
+	return UserPredicate{expr: "user_surname <= ?", args: []interface{}{surname}}
+}
+
+// SurnameNe creates predicate "user_surname != ?"
+// nolint: dupl
+func (UserPredicates) SurnameNe(surname string) UserPredicate {
+	return UserPredicate{expr: "user_surname != ?", args: []interface{}{surname}}
+}
+
+// SurnameNotIn creates predicate "user_surname NOT IN (?)"
+// nolint: dupl
+func (UserPredicates) SurnameNotIn(surname ...string) UserPredicate {
+	if len(surname) == 0 {
+		return UserPredicate{err: errors.New("must at least pass one surname in SurnameNotIn")}
+	}
+	return UserPredicate{expr: "user_surname NOT IN (?)", args: []interface{}{surname}}
+}
+
+// SurnameNotlike creates predicate "user_surname NOT LIKE ?"
+// nolint: dupl
+func (UserPredicates) SurnameNotlike(surname string) UserPredicate {
+	return UserPredicate{expr: "user_surname NOT LIKE ?", args: []interface{}{surname}}
+}
+
+// UpdatedAtEq creates predicate "updated_at = ?"
+// nolint: dupl
+func (UserPredicates) UpdatedAtEq(updatedAt time.Time) UserPredicate {
+	return UserPredicate{expr: "updated_at = ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtGt creates predicate "updated_at > ?"
+// nolint: dupl
+func (UserPredicates) UpdatedAtGt(updatedAt time.Time) UserPredicate {
+	return UserPredicate{expr: "updated_at > ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtGte creates predicate "updated_at >= ?"
+// nolint: dupl
+func (UserPredicates) UpdatedAtGte(updatedAt time.Time) UserPredicate {
+	return UserPredicate{expr: "updated_at >= ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtLt creates predicate "updated_at < ?"
+// nolint: dupl
+func (UserPredicates) UpdatedAtLt(updatedAt time.Time) UserPredicate {
+	return UserPredicate{expr: "updated_at < ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtLte creates predicate "updated_at <= ?"
+// nolint: dupl
+func (UserPredicates) UpdatedAtLte(updatedAt time.Time) UserPredicate {
+	return UserPredicate{expr: "updated_at <= ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtNe creates predicate "updated_at != ?"
+// nolint: dupl
+func (UserPredicates) UpdatedAtNe(updatedAt time.Time) UserPredicate {
+	return UserPredicate{expr: "updated_at != ?", args: []interface{}{updatedAt}}
+}
+
+// ===== END of User predicates
+
+// Int64Subquery is a subquery selecting single column, it's created by Select
+// methods of querysets and passed to their InQuery and NotInQuery filters
+type Int64Subquery struct {
+	db *gorm.DB
+}
+
+func (q Int64Subquery) expr() interface{} {
+	return q.db.QueryExpr()
+}
+
+// IntSubquery is a subquery selecting single column, it's created by Select
+// methods of querysets and passed to their InQuery and NotInQuery filters
+type IntSubquery struct {
+	db *gorm.DB
+}
+
+func (q IntSubquery) expr() interface{} {
+	return q.db.QueryExpr()
+}
+
+// PointSubquery is a subquery selecting single column, it's created by Select
+// methods of querysets and passed to their InQuery and NotInQuery filters
+type PointSubquery struct {
+	db *gorm.DB
+}
+
+func (q PointSubquery) expr() interface{} {
+	return q.db.QueryExpr()
+}
+
+// StringSubquery is a subquery selecting single column, it's created by Select
+// methods of querysets and passed to their InQuery and NotInQuery filters
+type StringSubquery struct {
+	db *gorm.DB
+}
+
+func (q StringSubquery) expr() interface{} {
+	return q.db.QueryExpr()
+}
+
+// TmpStringDefSubquery is a subquery selecting single column, it's created by Select
+// methods of querysets and passed to their InQuery and NotInQuery filters
+type TmpStringDefSubquery struct {
+	db *gorm.DB
+}
+
+func (q TmpStringDefSubquery) expr() interface{} {
+	return q.db.QueryExpr()
+}
+
+// UintSubquery is a subquery selecting single column, it's created by Select
+// methods of querysets and passed to their InQuery and NotInQuery filters
+type UintSubquery struct {
+	db *gorm.DB
+}
+
+func (q UintSubquery) expr() interface{} {
+	return q.db.QueryExpr()
+}
+
+// ===== END of all query sets
+
+// ===== BEGIN of package helpers
+
+// WithTx runs fn in transaction: it's committed if fn returns nil and
+// rolled back if fn returns error or panics. If db is already a transaction,
+// savepoint is used instead of a new transaction: nested calls are
+// rolled back independently.
+func WithTx(db *gorm.DB, fn func(tx *gorm.DB) error) error {
+	if _, ok := db.CommonDB().(interface{ Rollback() error }); ok {
+		return withTxSavepoint(db, fn)
+	}
+
+	tx := db.Begin()
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	finished := false
+	defer func() {
+		if !finished {
+			tx.Rollback()
+		}
+	}()
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+
+	finished = true
+	return tx.Commit().Error
+}
+
+func withTxSavepoint(db *gorm.DB, fn func(tx *gorm.DB) error) error {
+	const depthKey = "goqueryset:savepoint_depth"
+	depth, _ := db.Get(depthKey)
+	n, _ := depth.(int)
+	savepoint := fmt.Sprintf("goqueryset_sp%d", n+1)
+
+	if err := db.Exec("SAVEPOINT " + savepoint).Error; err != nil {
+		return err
+	}
+
+	released := false
+	defer func() {
+		if !released {
+			db.Exec("ROLLBACK TO SAVEPOINT " + savepoint)
+		}
+	}()
+
+	if err := fn(db.Set(depthKey, n+1)); err != nil {
+		return err
+	}
+
+	released = true
+	return db.Exec("RELEASE SAVEPOINT " + savepoint).Error
+}
+
+// DBWithContext returns db executing queries with context ctx: it's needed
+// to pass context to struct modifiers (Create, Delete, Update).
+// Gorm v1 doesn't support context, so its error is checked only before query.
+func DBWithContext(ctx context.Context, db *gorm.DB) *gorm.DB {
+	return db.Set(queryContextKey, ctx)
+}
+
+const queryContextKey = "goqueryset:context"
+
+// checkQueryContext returns copy of db with context error if context
+// set by DBWithContext is done: gorm doesn't execute queries then.
+func checkQueryContext(db *gorm.DB) *gorm.DB {
+	v, ok := db.Get(queryContextKey)
+	if !ok {
+		return db
+	}
+
+	ctx, ok := v.(context.Context)
+	if !ok || ctx.Err() == nil {
+		return db
+	}
+
+	db = db.Set(queryContextKey, ctx) // copy db to not change passed one
+	db.AddError(ctx.Err())
+	return db
+}
+
+const preloadsKey = "goqueryset:preloads"
+
+// preloadSpec is arguments of gorm Preload call
+type preloadSpec struct {
+	column     string
+	conditions []interface{}
+}
+
+// preload returns db preloading column and remembers preload: gorm v1
+// doesn't expose preloads, but InTx must move them into transaction
+func preload(db *gorm.DB, column string, conditions ...interface{}) *gorm.DB {
+	v, _ := db.Get(preloadsKey)
+	preloads, _ := v.([]preloadSpec)
+	preloads = append(preloads[:len(preloads):len(preloads)], preloadSpec{column: column, conditions: conditions})
+	return db.Preload(column, conditions...).Set(preloadsKey, preloads)
+}
+
+// txWithSettings returns tx with context and preloads of db: they
+// aren't in query expression of db, so InTx moves them explicitly
+func txWithSettings(db, tx *gorm.DB) *gorm.DB {
+	if ctx, ok := db.Get(queryContextKey); ok {
+		tx = tx.Set(queryContextKey, ctx)
+	}
+
+	v, _ := db.Get(preloadsKey)
+	preloads, _ := v.([]preloadSpec)
+	for _, p := range preloads {
+		tx = preload(tx, p.column, p.conditions...)
+	}
+	return tx
+}
+
+const pageOrdersKey = "goqueryset:page_orders"
+
+// pageOrder is an order of queryset remembered for keyset pagination
+type pageOrder struct {
+	column   string
+	desc     bool
+	nullable bool
+}
+
+// addPageOrder remembers order by column in db
+func addPageOrder(db *gorm.DB, column string, desc, nullable bool) *gorm.DB {
+	v, _ := db.Get(pageOrdersKey)
+	orders, _ := v.([]pageOrder)
+	orders = append(orders[:len(orders):len(orders)], pageOrder{column: column, desc: desc, nullable: nullable})
+	return db.Set(pageOrdersKey, orders)
+}
+
+// pageQuery returns db filtered by cursor and ordered by primary key after
+// orders of db, fieldPtrs are pointers to fields of model by their columns.
+// Columns are prefixed by table in SQL, table is empty if they aren't qualified.
+func pageQuery(db *gorm.DB, table, pk, cursor string,
+	fieldPtrs map[string]interface{}) (*gorm.DB, []pageOrder, error) {
+
+	v, _ := db.Get(pageOrdersKey)
+	orders, _ := v.([]pageOrder)
+	hasPKOrder := false
+	for _, o := range orders {
+		if _, ok := fieldPtrs[o.column]; !ok {
+			return nil, nil, fmt.Errorf("can't paginate by column %s: it's not a field of model", o.column)
+		}
+		if o.nullable {
+			// NULL isn't greater or less than cursor value: such rows would be lost
+			return nil, nil, fmt.Errorf("can't paginate by column %s: it's nullable", o.column)
+		}
+		hasPKOrder = hasPKOrder || o.column == pk
+	}
+	if !hasPKOrder {
+		orders = append(orders[:len(orders):len(orders)], pageOrder{column: pk})
+		db = db.Order(table + pk + " ASC")
+	}
+
+	if cursor == "" {
+		return db, orders, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid cursor: %s", err)
+	}
+	var values []json.RawMessage
+	if err = json.Unmarshal(data, &values); err != nil {
+		return nil, nil, fmt.Errorf("invalid cursor: %s", err)
+	}
+	if len(values) != len(orders) {
+		return nil, nil, errors.New("invalid cursor: it was made for other orders")
+	}
+
+	// (a > ?) OR (a = ? AND b < ?) OR ...
+	var conds []string
+	var args []interface{}
+	var eqConds []string
+	var eqArgs []interface{}
+	for i, o := range orders {
+		ptr := fieldPtrs[o.column]
+		if err = json.Unmarshal(values[i], ptr); err != nil {
+			return nil, nil, fmt.Errorf("invalid cursor value of %s: %s", o.column, err)
+		}
+		value := reflect.ValueOf(ptr).Elem().Interface()
+
+		op := ">"
+		if o.desc {
+			op = "<"
+		}
+		conds = append(conds, "("+strings.Join(append(eqConds, table+o.column+" "+op+" ?"), " AND ")+")")
+		args = append(append(args, eqArgs...), value)
+
+		eqConds = append(eqConds, table+o.column+" = ?")
+		eqArgs = append(eqArgs, value)
+	}
+
+	return db.Where(strings.Join(conds, " OR "), args...), orders, nil
+}
+
+// encodePageCursor encodes values of ordered fields of the last fetched row
+func encodePageCursor(orders []pageOrder, fieldPtrs map[string]interface{}) (string, error) {
+	var values []interface{}
+	for _, o := range orders {
+		values = append(values, fieldPtrs[o.column])
+	}
+
+	data, err := json.Marshal(values)
+	if err != nil {
+		return "", fmt.Errorf("can't encode cursor: %s", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// ValidationError is returned by Validate methods of models and updaters
+type ValidationError struct {
+	Field  string // name of invalid field
+	Reason string
+}
+
+func (e ValidationError) Error() string {
+	return fmt.Sprintf("invalid field %s: %s", e.Field, e.Reason)
+}
+
+// checkConflictFields checks that row updated by upsert can be found: only
+// MySQL finds conflicting row without conflict fields
+func checkConflictFields(db *gorm.DB, conflictColumns, updateColumns []string) error {
+	if len(conflictColumns) == 0 && len(updateColumns) != 0 && dialectName(db) != "mysql" {
+		return errors.New("conflict fields are required to update existing row by upsert")
+	}
+
+	return nil
+}
+
+// dialectName returns name of database dialect of db
+func dialectName(db *gorm.DB) string {
+	return db.Dialect().GetName()
+}
+
+// likePatternEscaper escapes wildcards of LIKE with "!": backslash isn't
+// used because its escaping in string literals differs between databases.
+// "[" is a wildcard in SQL Server.
+var likePatternEscaper = strings.NewReplacer("!", "!!", "%", "!%", "_", "!_", "[", "![")
+
+// whereLike filters column by pattern: escaped value between prefix and
+// suffix. Case-insensitive matching uses ILIKE in PostgreSQL and LOWER elsewhere.
+func whereLike(db *gorm.DB, column, prefix, value, suffix string, caseInsensitive bool) *gorm.DB {
+	pattern := prefix + likePatternEscaper.Replace(value) + suffix
+	switch {
+	case !caseInsensitive:
+		return db.Where(column+" LIKE ? ESCAPE '!'", pattern)
+	case dialectName(db) == "postgres":
+		return db.Where(column+" ILIKE ? ESCAPE '!'", pattern)
+	default:
+		return db.Where("LOWER("+column+") LIKE LOWER(?) ESCAPE '!'", pattern)
+	}
+}
+
+// jsonColumnValue is a map or a slice stored in JSON column: it's marshaled
+// to JSON when passed to database, nil is stored as NULL
+type jsonColumnValue struct {
+	v interface{}
+}
+
+// Value implements driver.Valuer
+func (j jsonColumnValue) Value() (driver.Value, error) {
+	if reflect.ValueOf(j.v).IsNil() {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(j.v)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// whereJSONEq filters JSON column by text value at dot-separated path
+func whereJSONEq(db *gorm.DB, column, path, value string) *gorm.DB {
+	switch dialectName(db) {
+	case "postgres":
+		return db.Where(column+" #>> ? = ?", "{"+strings.Replace(path, ".", ",", -1)+"}", value)
+	case "mysql":
+		return db.Where("JSON_UNQUOTE(JSON_EXTRACT("+column+", ?)) = ?", "$."+path, value)
+	default:
+		return db.Where("CAST(JSON_EXTRACT("+column+", ?) AS TEXT) = ?", "$."+path, value)
+	}
+}
+
+// bulkInsertBatchSize returns max count of rows in one insert query:
+// databases limit count of placeholders in query
+func bulkInsertBatchSize(dialect string, columns int) int {
+	maxParams := 999 // sqlite and unknown dialects
+	switch dialect {
+	case "mysql", "postgres":
+		maxParams = 65535
+	case "mssql", "sqlserver":
+		maxParams = 2100
+	}
+
+	if columns == 0 || columns > maxParams {
+		return 1
+	}
+	return maxParams / columns
+}
+
+// isZeroField checks whether field pointed by ptr has zero value
+func isZeroField(ptr interface{}) bool {
+	v := reflect.ValueOf(ptr).Elem()
+	return reflect.DeepEqual(v.Interface(), reflect.Zero(v.Type()).Interface())
+}
+
+// setGeneratedPK sets field of primary key pointed by ptr to id
+func setGeneratedPK(ptr interface{}, id int64) {
+	v := reflect.ValueOf(ptr).Elem()
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(id)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.SetUint(uint64(id))
+	}
+}
+
+// prepareInsertRows sets timestamps like gorm does on create and returns
+// sorted columns to insert: primary key is skipped if it's generated.
+// Columns of timestamps are taken from parsed model of db.
+func prepareInsertRows(db *gorm.DB, rows []map[string]interface{}, generatedPK string) []string {
+	scope := db.NewScope(db.Value)
+	var timeColumns []string
+	for _, name := range []string{"CreatedAt", "UpdatedAt"} {
+		if f, ok := scope.FieldByName(name); ok {
+			timeColumns = append(timeColumns, f.DBName)
+		}
+	}
+
+	now := gorm.NowFunc()
+	for _, r := range rows {
+		for _, c := range timeColumns {
+			if t, ok := r[c].(*time.Time); ok && t.IsZero() {
+				*t = now
+			}
+		}
+	}
+
+	var columns []string
+	for c := range rows[0] {
+		if c != generatedPK {
+			columns = append(columns, c)
+		}
+	}
+	sort.Strings(columns)
+	return columns
+}
+
+// defaultValueColumns returns columns of db model having default values
+func defaultValueColumns(db *gorm.DB) map[string]bool {
+	ret := map[string]bool{}
+	for _, f := range db.NewScope(db.Value).GetModelStruct().StructFields {
+		if f.HasDefaultValue && !f.IsPrimaryKey {
+			ret[f.DBName] = true
+		}
+	}
+	return ret
+}
+
+// rowInsertColumns returns columns to insert row by: columns having
+// default values are skipped if they are zero like gorm does on create
+func rowInsertColumns(columns []string, row map[string]interface{}, defaults map[string]bool) []string {
+	ret := make([]string, 0, len(columns))
+	for _, c := range columns {
+		if !defaults[c] || !isZeroField(row[c]) {
+			ret = append(ret, c)
+		}
+	}
+	return ret
+}
+
+// buildInsertQuery returns query inserting rows into table of db model
+func buildInsertQuery(db *gorm.DB, columns []string, rows []map[string]interface{}) (string, []interface{}) {
+	dialect := db.Dialect()
+	quotedColumns := make([]string, 0, len(columns))
+	for _, c := range columns {
+		quotedColumns = append(quotedColumns, dialect.Quote(c))
+	}
+
+	var values []string
+	var args []interface{}
+	for _, r := range rows {
+		placeholders := make([]string, 0, len(columns))
+		for _, c := range columns {
+			args = append(args, reflect.ValueOf(r[c]).Elem().Interface())
+			placeholders = append(placeholders, dialect.BindVar(len(args)))
+		}
+		values = append(values, "("+strings.Join(placeholders, ",")+")")
+	}
+
+	query := fmt.Sprintf("INSERT INTO %s (%s) VALUES %s", db.NewScope(db.Value).QuotedTableName(),
+		strings.Join(quotedColumns, ","), strings.Join(values, ","))
+	// gorm's common dialect bind var is replaced by "?" in queries
+	query = strings.Replace(query, "$$$", "?", -1)
+	return query, args
+}
+
+// bulkInsert inserts rows (pointers to fields by columns) into table of db model
+// by batches. Primary key is generated by database if it's not set in all rows.
+func bulkInsert(db *gorm.DB, pk string, rows []map[string]interface{}) error {
+	if db.Error != nil {
+		return db.Error
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+
+	generatedPK := ""
+	if pk != "" {
+		zeroPKs := 0
+		for _, r := range rows {
+			if isZeroField(r[pk]) {
+				zeroPKs++
+			}
+		}
+		if zeroPKs != 0 && zeroPKs != len(rows) {
+			return errors.New("primary key must be set in all rows or in none of them")
+		}
+		if zeroPKs != 0 {
+			generatedPK = pk
+		}
+	}
+
+	columns := prepareInsertRows(db, rows, generatedPK)
+	defaults := defaultValueColumns(db)
+	for start := 0; start < len(rows); {
+		// batch is made of consecutive rows with the same columns:
+		// zero columns having default values are skipped
+		batchColumns := rowInsertColumns(columns, rows[start], defaults)
+		size := bulkInsertBatchSize(db.Dialect().GetName(), len(batchColumns))
+		end := start + 1
+		for end < len(rows) && end-start < size &&
+			reflect.DeepEqual(rowInsertColumns(columns, rows[end], defaults), batchColumns) {
+			end++
+		}
+
+		if err := bulkInsertBatch(db, batchColumns, generatedPK, rows[start:end]); err != nil {
+			return err
+		}
+		start = end
+	}
+
+	return nil
+}
+
+// bulkInsertBatch inserts rows by one query and writes back generated
+// primary keys if database supports it
+func bulkInsertBatch(db *gorm.DB, columns []string, generatedPK string,
+	rows []map[string]interface{}) error {
+
+	dialect := db.Dialect()
+	query, args := buildInsertQuery(db, columns, rows)
+
+	if generatedPK != "" && dialect.GetName() == "postgres" {
+		// rows are returned in order of values
+		res, err := db.CommonDB().Query(query+" RETURNING "+dialect.Quote(generatedPK), args...)
+		if err != nil {
+			return err
+		}
+		defer res.Close()
+
+		for i := 0; res.Next(); i++ {
+			var id int64
+			if err = res.Scan(&id); err != nil {
+				return err
+			}
+			setGeneratedPK(rows[i][generatedPK], id)
+		}
+		return res.Err()
+	}
+
+	res, err := db.CommonDB().Exec(query, args...)
+	if err != nil {
+		return err
+	}
+
+	if generatedPK == "" {
+		return nil
+	}
+
+	var firstID int64
+	switch dialect.GetName() {
+	case "mysql":
+		// ids of rows inserted by one query are consecutive,
+		// the first of them is returned
+		firstID, err = res.LastInsertId()
+	case "sqlite3":
+		// the last id is returned
+		firstID, err = res.LastInsertId()
+		firstID -= int64(len(rows) - 1)
+	default:
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for i, r := range rows {
+		setGeneratedPK(r[generatedPK], firstID+int64(i))
+	}
+	return nil
+}
+
+// upsert inserts row (pointers to fields by columns) into table of db model
+// or updates updateColumns of existing row if insert violates unique
+// constraint on conflictColumns. Generated primary key is written back
+// for MySQL and PostgreSQL.
+func upsert(db *gorm.DB, pk string, row map[string]interface{},
+	conflictColumns, updateColumns []string) error {
+
+	if db.Error != nil {
+		return db.Error
+	}
+	if err := checkConflictFields(db, conflictColumns, updateColumns); err != nil {
+		return err
+	}
+
+	generatedPK := ""
+	if pk != "" && isZeroField(row[pk]) {
+		generatedPK = pk
+	}
+
+	rows := []map[string]interface{}{row}
+	columns := rowInsertColumns(prepareInsertRows(db, rows, generatedPK), row, defaultValueColumns(db))
+	query, args := buildInsertQuery(db, columns, rows)
+
+	dialect := db.Dialect()
+	var sets []string
+	switch dialect.GetName() {
+	case "mysql":
+		// conflict columns are chosen by MySQL itself
+		if generatedPK != "" {
+			// make LAST_INSERT_ID return id of updated row
+			sets = append(sets, fmt.Sprintf("%s = LAST_INSERT_ID(%s)",
+				dialect.Quote(generatedPK), dialect.Quote(generatedPK)))
+		}
+		for _, c := range updateColumns {
+			sets = append(sets, fmt.Sprintf("%s = VALUES(%s)", dialect.Quote(c), dialect.Quote(c)))
+		}
+		if len(sets) == 0 {
+			// do nothing: assign column to itself
+			c := pk
+			if len(conflictColumns) != 0 {
+				c = conflictColumns[0]
+			} else if c == "" {
+				c = columns[0]
+			}
+			sets = append(sets, dialect.Quote(c)+" = "+dialect.Quote(c))
+		}
+		query += " ON DUPLICATE KEY UPDATE " + strings.Join(sets, ", ")
+	case "postgres", "sqlite3":
+		var quotedConflictColumns []string
+		for _, c := range conflictColumns {
+			quotedConflictColumns = append(quotedConflictColumns, dialect.Quote(c))
+		}
+		for _, c := range updateColumns {
+			sets = append(sets, fmt.Sprintf("%s = excluded.%s", dialect.Quote(c), dialect.Quote(c)))
+		}
+
+		query += " ON CONFLICT"
+		if len(quotedConflictColumns) != 0 {
+			query += " (" + strings.Join(quotedConflictColumns, ",") + ")"
+		}
+		if len(sets) == 0 {
+			query += " DO NOTHING"
+		} else {
+			query += " DO UPDATE SET " + strings.Join(sets, ", ")
+		}
+	default:
+		return fmt.Errorf("upsert isn't supported for dialect %s", dialect.GetName())
+	}
+
+	if generatedPK != "" && dialect.GetName() == "postgres" {
+		var id int64
+		err := db.CommonDB().QueryRow(query+" RETURNING "+dialect.Quote(generatedPK), args...).Scan(&id)
+		if err == sql.ErrNoRows {
+			return nil // conflict without update
+		}
+		if err != nil {
+			return err
+		}
+
+		setGeneratedPK(row[generatedPK], id)
+		return nil
+	}
+
+	res, err := db.CommonDB().Exec(query, args...)
+	if err != nil {
+		return err
+	}
+
+	if generatedPK != "" && dialect.GetName() == "mysql" {
+		id, err := res.LastInsertId()
+		if err != nil {
+			return err
+		}
+		if id != 0 {
+			setGeneratedPK(row[generatedPK], id)
+		}
+	}
+
+	return nil
+}
+
+// ===== END of package helpers
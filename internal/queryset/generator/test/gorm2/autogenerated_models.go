@@ -0,0 +1,10405 @@
+// Code generated by go-queryset. DO NOT EDIT.
+package gorm2
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/jirfag/go-queryset/internal/queryset/generator/tmp"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ===== BEGIN of all query sets
+
+// ===== BEGIN of query set AccountQuerySet
+
+// AccountQuerySet is an queryset type for Account
+type AccountQuerySet struct {
+	db *gorm.DB
+}
+
+// NewAccountQuerySet constructs new AccountQuerySet
+func NewAccountQuerySet(db *gorm.DB) AccountQuerySet {
+	return AccountQuerySet{
+		// new session makes every chained call clone the statement,
+		// so a queryset can be safely reused as a base for other querysets
+		db: db.Model(&Account{}).Session(&gorm.Session{}),
+	}
+}
+
+func (qs AccountQuerySet) w(db *gorm.DB) AccountQuerySet {
+	return NewAccountQuerySet(db)
+}
+
+func (qs AccountQuerySet) Select(fields ...AccountDBSchemaField) AccountQuerySet {
+	names := []string{}
+	for _, f := range fields {
+		names = append(names, f.String())
+	}
+
+	return qs.w(qs.db.Select(strings.Join(names, ",")))
+}
+
+// GroupBy groups rows by fields
+func (qs AccountQuerySet) GroupBy(fields ...AccountDBSchemaField) AccountQuerySet {
+	names := []string{}
+	for _, f := range fields {
+		names = append(names, f.String())
+	}
+
+	return qs.w(qs.db.Group(strings.Join(names, ",")))
+}
+
+// Where filters rows by predicate p, predicates are created by AccountQ
+// nolint: dupl
+func (qs AccountQuerySet) Where(p AccountPredicate) AccountQuerySet {
+	if p.err != nil {
+		// error is added to copy of db: qs can be reused as a base queryset
+		db := qs.db.Session(&gorm.Session{})
+		db.AddError(p.err)
+		return qs.w(db)
+	}
+	if p.expr == "" {
+		return qs
+	}
+
+	return qs.w(qs.db.Where(p.expr, p.args...))
+}
+
+// Not filters rows not matching predicate p
+// nolint: dupl
+func (qs AccountQuerySet) Not(p AccountPredicate) AccountQuerySet {
+	return qs.Where(p.Not())
+}
+
+// Create is an autogenerated method
+// nolint: dupl
+func (o *Account) Create(db *gorm.DB) error {
+	if err := o.Validate(); err != nil {
+		return err
+	}
+
+	return db.Create(o).Error
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (o *Account) Delete(db *gorm.DB) error {
+	return db.Delete(o).Error
+}
+
+// AgeBetween filters rows with Age in range [from, to]
+// nolint: dupl
+func (qs AccountQuerySet) AgeBetween(from int, to int) AccountQuerySet {
+	return qs.w(qs.db.Where("age BETWEEN ? AND ?", from, to))
+}
+
+// AgeEq is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) AgeEq(age int) AccountQuerySet {
+	return qs.w(qs.db.Where("age = ?", age))
+}
+
+// AgeGt is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) AgeGt(age int) AccountQuerySet {
+	return qs.w(qs.db.Where("age > ?", age))
+}
+
+// AgeGte is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) AgeGte(age int) AccountQuerySet {
+	return qs.w(qs.db.Where("age >= ?", age))
+}
+
+// AgeIn is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) AgeIn(age ...int) AccountQuerySet {
+	if len(age) == 0 {
+		qs.db.AddError(errors.New("must at least pass one age in AgeIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("age IN (?)", age))
+}
+
+// AgeInQuery filters rows with Age IN values selected by subquery q
+// nolint: dupl
+func (qs AccountQuerySet) AgeInQuery(q IntSubquery) AccountQuerySet {
+	return qs.w(qs.db.Where("age IN (?)", q.expr()))
+}
+
+// AgeInRange filters rows with Age in range [from, to)
+// nolint: dupl
+func (qs AccountQuerySet) AgeInRange(from int, to int) AccountQuerySet {
+	return qs.w(qs.db.Where("age >= ? AND age < ?", from, to))
+}
+
+// AgeLt is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) AgeLt(age int) AccountQuerySet {
+	return qs.w(qs.db.Where("age < ?", age))
+}
+
+// AgeLte is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) AgeLte(age int) AccountQuerySet {
+	return qs.w(qs.db.Where("age <= ?", age))
+}
+
+// AgeNe is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) AgeNe(age int) AccountQuerySet {
+	return qs.w(qs.db.Where("age != ?", age))
+}
+
+// AgeNotIn is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) AgeNotIn(age ...int) AccountQuerySet {
+	if len(age) == 0 {
+		qs.db.AddError(errors.New("must at least pass one age in AgeNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("age NOT IN (?)", age))
+}
+
+// AgeNotInQuery filters rows with Age NOT IN values selected by subquery q
+// nolint: dupl
+func (qs AccountQuerySet) AgeNotInQuery(q IntSubquery) AccountQuerySet {
+	return qs.w(qs.db.Where("age NOT IN (?)", q.expr()))
+}
+
+// All is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) All(ret *[]Account) error {
+	return qs.db.Find(ret).Error
+}
+
+// AvgAge returns AVG of Age, it's zero value if there are no rows
+// nolint: dupl
+func (qs AccountQuerySet) AvgAge() (float64, error) {
+	var ret float64
+	var res struct {
+		Value *float64
+	}
+	err := qs.db.Select("AVG(age) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// Batches calls fn for every batch of at most size rows, batches are
+// fetched by Page: ordered by primary key after queryset orders.
+// It stops on the first error of fn and returns it.
+// nolint: dupl
+func (qs AccountQuerySet) Batches(size int, fn func([]Account) error) error {
+	cursor := ""
+	for {
+		var batch []Account
+		next, err := qs.Page(cursor, size, &batch)
+		if err != nil {
+			return err
+		}
+
+		if len(batch) != 0 {
+			if err = fn(batch); err != nil {
+				return err
+			}
+		}
+
+		if next == "" {
+			return nil
+		}
+		cursor = next
+	}
+}
+
+// Count is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) Count() (int64, error) {
+	var count int64
+	err := qs.db.Count(&count).Error
+	return count, err
+}
+
+// CountByAge returns count of rows for every value of Age
+// nolint: dupl
+func (qs AccountQuerySet) CountByAge() (map[int]int, error) {
+	var rows []struct {
+		Value int
+		Count int
+	}
+	err := qs.db.Select("age AS value, COUNT(*) AS count").Group("age").Scan(&rows).Error
+	ret := make(map[int]int, len(rows))
+	for _, r := range rows {
+		ret[r.Value] = r.Count
+	}
+	return ret, err
+}
+
+// CountByID returns count of rows for every value of ID
+// nolint: dupl
+func (qs AccountQuerySet) CountByID() (map[uint]int, error) {
+	var rows []struct {
+		Value uint
+		Count int
+	}
+	err := qs.db.Select("id AS value, COUNT(*) AS count").Group("id").Scan(&rows).Error
+	ret := make(map[uint]int, len(rows))
+	for _, r := range rows {
+		ret[r.Value] = r.Count
+	}
+	return ret, err
+}
+
+// CountByLogin returns count of rows for every value of Login
+// nolint: dupl
+func (qs AccountQuerySet) CountByLogin() (map[string]int, error) {
+	var rows []struct {
+		Value string
+		Count int
+	}
+	err := qs.db.Select("login AS value, COUNT(*) AS count").Group("login").Scan(&rows).Error
+	ret := make(map[string]int, len(rows))
+	for _, r := range rows {
+		ret[r.Value] = r.Count
+	}
+	return ret, err
+}
+
+// CreateMany inserts models by multi-row inserts, conditions of queryset
+// aren't used. Generated primary keys are written back to models if
+// database supports it (all except MS SQL for gorm v1).
+// nolint: dupl
+func (qs AccountQuerySet) CreateMany(models []Account) error {
+	for i := range models {
+		if err := models[i].Validate(); err != nil {
+			return err
+		}
+	}
+
+	if len(models) == 0 {
+		return nil
+	}
+
+	size := bulkInsertBatchSize(qs.db.Dialector.Name(), len(qs.fieldPtrs(&models[0])))
+	// new db to not use conditions of queryset
+	return qs.db.Session(&gorm.Session{NewDB: true}).CreateInBatches(&models, size).Error
+}
+
+// CreatedAtBetween filters rows with CreatedAt in range [from, to]
+// nolint: dupl
+func (qs AccountQuerySet) CreatedAtBetween(from time.Time, to time.Time) AccountQuerySet {
+	return qs.w(qs.db.Where("created_at BETWEEN ? AND ?", from, to))
+}
+
+// CreatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) CreatedAtEq(createdAt time.Time) AccountQuerySet {
+	return qs.w(qs.db.Where("created_at = ?", createdAt))
+}
+
+// CreatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) CreatedAtGt(createdAt time.Time) AccountQuerySet {
+	return qs.w(qs.db.Where("created_at > ?", createdAt))
+}
+
+// CreatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) CreatedAtGte(createdAt time.Time) AccountQuerySet {
+	return qs.w(qs.db.Where("created_at >= ?", createdAt))
+}
+
+// CreatedAtInRange filters rows with CreatedAt in range [from, to)
+// nolint: dupl
+func (qs AccountQuerySet) CreatedAtInRange(from time.Time, to time.Time) AccountQuerySet {
+	return qs.w(qs.db.Where("created_at >= ? AND created_at < ?", from, to))
+}
+
+// CreatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) CreatedAtLt(createdAt time.Time) AccountQuerySet {
+	return qs.w(qs.db.Where("created_at < ?", createdAt))
+}
+
+// CreatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) CreatedAtLte(createdAt time.Time) AccountQuerySet {
+	return qs.w(qs.db.Where("created_at <= ?", createdAt))
+}
+
+// CreatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) CreatedAtNe(createdAt time.Time) AccountQuerySet {
+	return qs.w(qs.db.Where("created_at != ?", createdAt))
+}
+
+// CreatedAtWithin filters rows with CreatedAt not earlier than d ago
+// nolint: dupl
+func (qs AccountQuerySet) CreatedAtWithin(d time.Duration) AccountQuerySet {
+	return qs.w(qs.db.Where("created_at >= ?", time.Now().Add(-d)))
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) Delete() error {
+	return qs.db.Delete(&Account{}).Error
+}
+
+// DeleteNum is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) DeleteNum() (int64, error) {
+	db := qs.db.Delete(&Account{})
+	return db.RowsAffected, db.Error
+}
+
+// DeleteNumUnscoped is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) DeleteNumUnscoped() (int64, error) {
+	db := qs.db.Unscoped().Delete(&Account{})
+	return db.RowsAffected, db.Error
+}
+
+// DeletedAtBetween filters rows with DeletedAt in range [from, to]
+// nolint: dupl
+func (qs AccountQuerySet) DeletedAtBetween(from time.Time, to time.Time) AccountQuerySet {
+	return qs.w(qs.db.Where("deleted_at BETWEEN ? AND ?", from, to))
+}
+
+// DeletedAtEq is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) DeletedAtEq(deletedAt time.Time) AccountQuerySet {
+	return qs.w(qs.db.Where("deleted_at = ?", deletedAt))
+}
+
+// DeletedAtGt is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) DeletedAtGt(deletedAt time.Time) AccountQuerySet {
+	return qs.w(qs.db.Where("deleted_at > ?", deletedAt))
+}
+
+// DeletedAtGte is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) DeletedAtGte(deletedAt time.Time) AccountQuerySet {
+	return qs.w(qs.db.Where("deleted_at >= ?", deletedAt))
+}
+
+// DeletedAtInRange filters rows with DeletedAt in range [from, to)
+// nolint: dupl
+func (qs AccountQuerySet) DeletedAtInRange(from time.Time, to time.Time) AccountQuerySet {
+	return qs.w(qs.db.Where("deleted_at >= ? AND deleted_at < ?", from, to))
+}
+
+// DeletedAtIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) DeletedAtIsNotNull() AccountQuerySet {
+	return qs.w(qs.db.Where("deleted_at IS NOT NULL"))
+}
+
+// DeletedAtIsNull is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) DeletedAtIsNull() AccountQuerySet {
+	return qs.w(qs.db.Where("deleted_at IS NULL"))
+}
+
+// DeletedAtLt is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) DeletedAtLt(deletedAt time.Time) AccountQuerySet {
+	return qs.w(qs.db.Where("deleted_at < ?", deletedAt))
+}
+
+// DeletedAtLte is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) DeletedAtLte(deletedAt time.Time) AccountQuerySet {
+	return qs.w(qs.db.Where("deleted_at <= ?", deletedAt))
+}
+
+// DeletedAtNe is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) DeletedAtNe(deletedAt time.Time) AccountQuerySet {
+	return qs.w(qs.db.Where("deleted_at != ?", deletedAt))
+}
+
+// DeletedAtWithin filters rows with DeletedAt not earlier than d ago
+// nolint: dupl
+func (qs AccountQuerySet) DeletedAtWithin(d time.Duration) AccountQuerySet {
+	return qs.w(qs.db.Where("deleted_at >= ?", time.Now().Add(-d)))
+}
+
+// GetDB is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) GetDB() *gorm.DB {
+	return qs.db
+}
+
+// GetUpdater is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) GetUpdater() AccountUpdater {
+	return NewAccountUpdater(qs.db)
+}
+
+// GroupByAge is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) GroupByAge() AccountQuerySet {
+	return qs.w(qs.db.Group("age"))
+}
+
+// GroupByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) GroupByCreatedAt() AccountQuerySet {
+	return qs.w(qs.db.Group("created_at"))
+}
+
+// GroupByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) GroupByDeletedAt() AccountQuerySet {
+	return qs.w(qs.db.Group("deleted_at"))
+}
+
+// GroupByID is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) GroupByID() AccountQuerySet {
+	return qs.w(qs.db.Group("id"))
+}
+
+// GroupByLogin is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) GroupByLogin() AccountQuerySet {
+	return qs.w(qs.db.Group("login"))
+}
+
+// GroupByNickname is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) GroupByNickname() AccountQuerySet {
+	return qs.w(qs.db.Group("nickname"))
+}
+
+// GroupByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) GroupByUpdatedAt() AccountQuerySet {
+	return qs.w(qs.db.Group("updated_at"))
+}
+
+// HavingCountEq is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) HavingCountEq(count int) AccountQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) = ?", count))
+}
+
+// HavingCountGt is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) HavingCountGt(count int) AccountQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) > ?", count))
+}
+
+// HavingCountGte is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) HavingCountGte(count int) AccountQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) >= ?", count))
+}
+
+// HavingCountLt is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) HavingCountLt(count int) AccountQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) < ?", count))
+}
+
+// HavingCountLte is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) HavingCountLte(count int) AccountQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) <= ?", count))
+}
+
+// HavingCountNe is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) HavingCountNe(count int) AccountQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) != ?", count))
+}
+
+// IDBetween filters rows with ID in range [from, to]
+// nolint: dupl
+func (qs AccountQuerySet) IDBetween(from uint, to uint) AccountQuerySet {
+	return qs.w(qs.db.Where("id BETWEEN ? AND ?", from, to))
+}
+
+// IDEq is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) IDEq(ID uint) AccountQuerySet {
+	return qs.w(qs.db.Where("id = ?", ID))
+}
+
+// IDGt is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) IDGt(ID uint) AccountQuerySet {
+	return qs.w(qs.db.Where("id > ?", ID))
+}
+
+// IDGte is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) IDGte(ID uint) AccountQuerySet {
+	return qs.w(qs.db.Where("id >= ?", ID))
+}
+
+// IDIn is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) IDIn(ID ...uint) AccountQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("id IN (?)", ID))
+}
+
+// IDInQuery filters rows with ID IN values selected by subquery q
+// nolint: dupl
+func (qs AccountQuerySet) IDInQuery(q UintSubquery) AccountQuerySet {
+	return qs.w(qs.db.Where("id IN (?)", q.expr()))
+}
+
+// IDInRange filters rows with ID in range [from, to)
+// nolint: dupl
+func (qs AccountQuerySet) IDInRange(from uint, to uint) AccountQuerySet {
+	return qs.w(qs.db.Where("id >= ? AND id < ?", from, to))
+}
+
+// IDLt is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) IDLt(ID uint) AccountQuerySet {
+	return qs.w(qs.db.Where("id < ?", ID))
+}
+
+// IDLte is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) IDLte(ID uint) AccountQuerySet {
+	return qs.w(qs.db.Where("id <= ?", ID))
+}
+
+// IDNe is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) IDNe(ID uint) AccountQuerySet {
+	return qs.w(qs.db.Where("id != ?", ID))
+}
+
+// IDNotIn is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) IDNotIn(ID ...uint) AccountQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("id NOT IN (?)", ID))
+}
+
+// IDNotInQuery filters rows with ID NOT IN values selected by subquery q
+// nolint: dupl
+func (qs AccountQuerySet) IDNotInQuery(q UintSubquery) AccountQuerySet {
+	return qs.w(qs.db.Where("id NOT IN (?)", q.expr()))
+}
+
+// InTx returns copy bound to transaction tx: queries are executed in it.
+// Gorm v1 can't move conditions into another connection, so with gorm v1
+// InTx must be called before any condition is set, otherwise queries fail.
+// Context and preloads are moved into transaction by both gorm versions.
+// nolint: dupl
+func (qs AccountQuerySet) InTx(tx *gorm.DB) AccountQuerySet {
+	ctx := tx.Statement.Context
+	if qs.db.Statement.Context != context.Background() {
+		ctx = qs.db.Statement.Context // set by WithContext
+	}
+	db := qs.db.Session(&gorm.Session{Context: ctx})
+	db.Statement.ConnPool = tx.Statement.ConnPool
+	if tx.Error != nil {
+		db.AddError(tx.Error)
+	}
+	return qs.w(db)
+}
+
+// Iterate scans rows one by one and calls fn for every row without
+// loading all rows into memory. It stops on the first error of fn and
+// returns it. Preloads aren't applied.
+// nolint: dupl
+func (qs AccountQuerySet) Iterate(fn func(*Account) error) error {
+	db := qs.db
+	if db.Error != nil {
+		return db.Error // gorm v1 doesn't check it in Rows
+	}
+
+	rows, err := db.Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var o Account
+		if err = db.ScanRows(rows, &o); err != nil {
+			return err
+		}
+		if err = fn(&o); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// Limit is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) Limit(limit int) AccountQuerySet {
+	return qs.w(qs.db.Limit(limit))
+}
+
+// LoginEndsWith filters rows with Login ending with the argument
+// nolint: dupl
+func (qs AccountQuerySet) LoginEndsWith(login string) AccountQuerySet {
+	return qs.w(whereLike(qs.db, "login", "%", login, "", false))
+}
+
+// LoginEq is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) LoginEq(login string) AccountQuerySet {
+	return qs.w(qs.db.Where("login = ?", login))
+}
+
+// LoginGt is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) LoginGt(login string) AccountQuerySet {
+	return qs.w(qs.db.Where("login > ?", login))
+}
+
+// LoginGte is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) LoginGte(login string) AccountQuerySet {
+	return qs.w(qs.db.Where("login >= ?", login))
+}
+
+// LoginIContains filters rows with Login containing the argument, case is ignored
+// nolint: dupl
+func (qs AccountQuerySet) LoginIContains(login string) AccountQuerySet {
+	return qs.w(whereLike(qs.db, "login", "%", login, "%", true))
+}
+
+// LoginIEq filters rows with Login equal to the argument, case is ignored
+// nolint: dupl
+func (qs AccountQuerySet) LoginIEq(login string) AccountQuerySet {
+	return qs.w(whereLike(qs.db, "login", "", login, "", true))
+}
+
+// LoginIn is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) LoginIn(login ...string) AccountQuerySet {
+	if len(login) == 0 {
+		qs.db.AddError(errors.New("must at least pass one login in LoginIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("login IN (?)", login))
+}
+
+// LoginInQuery filters rows with Login IN values selected by subquery q
+// nolint: dupl
+func (qs AccountQuerySet) LoginInQuery(q StringSubquery) AccountQuerySet {
+	return qs.w(qs.db.Where("login IN (?)", q.expr()))
+}
+
+// LoginLike is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) LoginLike(login string) AccountQuerySet {
+	return qs.w(qs.db.Where("login LIKE ?", login))
+}
+
+// LoginLt is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) LoginLt(login string) AccountQuerySet {
+	return qs.w(qs.db.Where("login < ?", login))
+}
+
+// LoginLte is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) LoginLte(login string) AccountQuerySet {
+	return qs.w(qs.db.Where("login <= ?", login))
+}
+
+// LoginNe is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) LoginNe(login string) AccountQuerySet {
+	return qs.w(qs.db.Where("login != ?", login))
+}
+
+// LoginNotIn is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) LoginNotIn(login ...string) AccountQuerySet {
+	if len(login) == 0 {
+		qs.db.AddError(errors.New("must at least pass one login in LoginNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("login NOT IN (?)", login))
+}
+
+// LoginNotInQuery filters rows with Login NOT IN values selected by subquery q
+// nolint: dupl
+func (qs AccountQuerySet) LoginNotInQuery(q StringSubquery) AccountQuerySet {
+	return qs.w(qs.db.Where("login NOT IN (?)", q.expr()))
+}
+
+// LoginNotlike is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) LoginNotlike(login string) AccountQuerySet {
+	return qs.w(qs.db.Where("login NOT LIKE ?", login))
+}
+
+// LoginStartsWith filters rows with Login starting with the argument
+// nolint: dupl
+func (qs AccountQuerySet) LoginStartsWith(login string) AccountQuerySet {
+	return qs.w(whereLike(qs.db, "login", "", login, "%", false))
+}
+
+// MaxAge returns MAX of Age, it's zero value if there are no rows
+// nolint: dupl
+func (qs AccountQuerySet) MaxAge() (int, error) {
+	var ret int
+	var res struct {
+		Value *int
+	}
+	err := qs.db.Select("MAX(age) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// MaxCreatedAt returns MAX of CreatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs AccountQuerySet) MaxCreatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := qs.db.Select("created_at AS value").Where("created_at IS NOT NULL").
+		Order(clause.OrderByColumn{
+			Column:  clause.Column{Name: "created_at", Raw: true},
+			Desc:    true,
+			Reorder: true,
+		}).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MaxID returns MAX of ID, it's zero value if there are no rows
+// nolint: dupl
+func (qs AccountQuerySet) MaxID() (uint, error) {
+	var ret uint
+	var res struct {
+		Value *uint
+	}
+	err := qs.db.Select("MAX(id) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// MaxUpdatedAt returns MAX of UpdatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs AccountQuerySet) MaxUpdatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := qs.db.Select("updated_at AS value").Where("updated_at IS NOT NULL").
+		Order(clause.OrderByColumn{
+			Column:  clause.Column{Name: "updated_at", Raw: true},
+			Desc:    true,
+			Reorder: true,
+		}).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MinAge returns MIN of Age, it's zero value if there are no rows
+// nolint: dupl
+func (qs AccountQuerySet) MinAge() (int, error) {
+	var ret int
+	var res struct {
+		Value *int
+	}
+	err := qs.db.Select("MIN(age) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// MinCreatedAt returns MIN of CreatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs AccountQuerySet) MinCreatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := qs.db.Select("created_at AS value").Where("created_at IS NOT NULL").
+		Order(clause.OrderByColumn{
+			Column:  clause.Column{Name: "created_at", Raw: true},
+			Desc:    false,
+			Reorder: true,
+		}).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MinID returns MIN of ID, it's zero value if there are no rows
+// nolint: dupl
+func (qs AccountQuerySet) MinID() (uint, error) {
+	var ret uint
+	var res struct {
+		Value *uint
+	}
+	err := qs.db.Select("MIN(id) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// MinUpdatedAt returns MIN of UpdatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs AccountQuerySet) MinUpdatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := qs.db.Select("updated_at AS value").Where("updated_at IS NOT NULL").
+		Order(clause.OrderByColumn{
+			Column:  clause.Column{Name: "updated_at", Raw: true},
+			Desc:    false,
+			Reorder: true,
+		}).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// NicknameEndsWith filters rows with Nickname ending with the argument
+// nolint: dupl
+func (qs AccountQuerySet) NicknameEndsWith(nickname string) AccountQuerySet {
+	return qs.w(whereLike(qs.db, "nickname", "%", nickname, "", false))
+}
+
+// NicknameEq is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) NicknameEq(nickname string) AccountQuerySet {
+	return qs.w(qs.db.Where("nickname = ?", nickname))
+}
+
+// NicknameGt is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) NicknameGt(nickname string) AccountQuerySet {
+	return qs.w(qs.db.Where("nickname > ?", nickname))
+}
+
+// NicknameGte is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) NicknameGte(nickname string) AccountQuerySet {
+	return qs.w(qs.db.Where("nickname >= ?", nickname))
+}
+
+// NicknameIContains filters rows with Nickname containing the argument, case is ignored
+// nolint: dupl
+func (qs AccountQuerySet) NicknameIContains(nickname string) AccountQuerySet {
+	return qs.w(whereLike(qs.db, "nickname", "%", nickname, "%", true))
+}
+
+// NicknameIEq filters rows with Nickname equal to the argument, case is ignored
+// nolint: dupl
+func (qs AccountQuerySet) NicknameIEq(nickname string) AccountQuerySet {
+	return qs.w(whereLike(qs.db, "nickname", "", nickname, "", true))
+}
+
+// NicknameIn is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) NicknameIn(nickname ...string) AccountQuerySet {
+	if len(nickname) == 0 {
+		qs.db.AddError(errors.New("must at least pass one nickname in NicknameIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("nickname IN (?)", nickname))
+}
+
+// NicknameInQuery filters rows with Nickname IN values selected by subquery q
+// nolint: dupl
+func (qs AccountQuerySet) NicknameInQuery(q StringSubquery) AccountQuerySet {
+	return qs.w(qs.db.Where("nickname IN (?)", q.expr()))
+}
+
+// NicknameIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) NicknameIsNotNull() AccountQuerySet {
+	return qs.w(qs.db.Where("nickname IS NOT NULL"))
+}
+
+// NicknameIsNull is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) NicknameIsNull() AccountQuerySet {
+	return qs.w(qs.db.Where("nickname IS NULL"))
+}
+
+// NicknameLike is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) NicknameLike(nickname string) AccountQuerySet {
+	return qs.w(qs.db.Where("nickname LIKE ?", nickname))
+}
+
+// NicknameLt is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) NicknameLt(nickname string) AccountQuerySet {
+	return qs.w(qs.db.Where("nickname < ?", nickname))
+}
+
+// NicknameLte is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) NicknameLte(nickname string) AccountQuerySet {
+	return qs.w(qs.db.Where("nickname <= ?", nickname))
+}
+
+// NicknameNe is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) NicknameNe(nickname string) AccountQuerySet {
+	return qs.w(qs.db.Where("nickname != ?", nickname))
+}
+
+// NicknameNotIn is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) NicknameNotIn(nickname ...string) AccountQuerySet {
+	if len(nickname) == 0 {
+		qs.db.AddError(errors.New("must at least pass one nickname in NicknameNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("nickname NOT IN (?)", nickname))
+}
+
+// NicknameNotInQuery filters rows with Nickname NOT IN values selected by subquery q
+// nolint: dupl
+func (qs AccountQuerySet) NicknameNotInQuery(q StringSubquery) AccountQuerySet {
+	return qs.w(qs.db.Where("nickname NOT IN (?)", q.expr()))
+}
+
+// NicknameNotlike is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) NicknameNotlike(nickname string) AccountQuerySet {
+	return qs.w(qs.db.Where("nickname NOT LIKE ?", nickname))
+}
+
+// NicknameStartsWith filters rows with Nickname starting with the argument
+// nolint: dupl
+func (qs AccountQuerySet) NicknameStartsWith(nickname string) AccountQuerySet {
+	return qs.w(whereLike(qs.db, "nickname", "", nickname, "%", false))
+}
+
+// Offset is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) Offset(offset int) AccountQuerySet {
+	return qs.w(qs.db.Offset(offset))
+}
+
+// One is used to retrieve one result. It returns gorm.ErrRecordNotFound
+// if nothing was fetched
+func (qs AccountQuerySet) One(ret *Account) error {
+	return qs.db.First(ret).Error
+}
+
+// OrderAscByAge is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) OrderAscByAge() AccountQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("age ASC"), "age", false, false))
+}
+
+// OrderAscByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) OrderAscByCreatedAt() AccountQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("created_at ASC"), "created_at", false, false))
+}
+
+// OrderAscByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) OrderAscByDeletedAt() AccountQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("deleted_at ASC"), "deleted_at", false, false))
+}
+
+// OrderAscByID is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) OrderAscByID() AccountQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("id ASC"), "id", false, false))
+}
+
+// OrderAscByLogin is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) OrderAscByLogin() AccountQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("login ASC"), "login", false, false))
+}
+
+// OrderAscByNickname is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) OrderAscByNickname() AccountQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("nickname ASC"), "nickname", false, false))
+}
+
+// OrderAscByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) OrderAscByUpdatedAt() AccountQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("updated_at ASC"), "updated_at", false, false))
+}
+
+// OrderDescByAge is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) OrderDescByAge() AccountQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("age DESC"), "age", true, false))
+}
+
+// OrderDescByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) OrderDescByCreatedAt() AccountQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("created_at DESC"), "created_at", true, false))
+}
+
+// OrderDescByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) OrderDescByDeletedAt() AccountQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("deleted_at DESC"), "deleted_at", true, false))
+}
+
+// OrderDescByID is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) OrderDescByID() AccountQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("id DESC"), "id", true, false))
+}
+
+// OrderDescByLogin is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) OrderDescByLogin() AccountQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("login DESC"), "login", true, false))
+}
+
+// OrderDescByNickname is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) OrderDescByNickname() AccountQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("nickname DESC"), "nickname", true, false))
+}
+
+// OrderDescByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) OrderDescByUpdatedAt() AccountQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("updated_at DESC"), "updated_at", true, false))
+}
+
+// Page fetches at most size rows following cursor into ret, it uses
+// orders set by OrderAscBy/OrderDescBy methods and primary key to break ties.
+// Ordered fields can't be nullable: rows with NULL can't be compared with
+// cursor, so error is returned for them. Empty cursor means the first page. It returns cursor of the next page or
+// empty string if there are no more rows.
+// nolint: dupl
+func (qs AccountQuerySet) Page(cursor string, size int, ret *[]Account) (string, error) {
+	if size <= 0 {
+		return "", errors.New("page size must be positive")
+	}
+
+	var last Account
+	db, orders, err := pageQuery(qs.db, "", "id", cursor, qs.fieldPtrs(&last))
+	if err != nil {
+		return "", err
+	}
+
+	if err = db.Limit(size + 1).Find(ret).Error; err != nil {
+		return "", err
+	}
+	if len(*ret) <= size {
+		return "", nil
+	}
+
+	*ret = (*ret)[:size]
+	return encodePageCursor(orders, qs.fieldPtrs(&(*ret)[size-1]))
+}
+
+// SelectAge returns subquery selecting Age of rows of queryset
+// for InQuery and NotInQuery filters
+// nolint: dupl
+func (qs AccountQuerySet) SelectAge() IntSubquery {
+	return IntSubquery{db: qs.db.Select("age")}
+}
+
+// SelectID returns subquery selecting ID of rows of queryset
+// for InQuery and NotInQuery filters
+// nolint: dupl
+func (qs AccountQuerySet) SelectID() UintSubquery {
+	return UintSubquery{db: qs.db.Select("id")}
+}
+
+// SelectLogin returns subquery selecting Login of rows of queryset
+// for InQuery and NotInQuery filters
+// nolint: dupl
+func (qs AccountQuerySet) SelectLogin() StringSubquery {
+	return StringSubquery{db: qs.db.Select("login")}
+}
+
+// SumAge returns SUM of Age, it's zero value if there are no rows
+// nolint: dupl
+func (qs AccountQuerySet) SumAge() (int, error) {
+	var ret int
+	var res struct {
+		Value *int
+	}
+	err := qs.db.Select("SUM(age) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// UpdatedAtBetween filters rows with UpdatedAt in range [from, to]
+// nolint: dupl
+func (qs AccountQuerySet) UpdatedAtBetween(from time.Time, to time.Time) AccountQuerySet {
+	return qs.w(qs.db.Where("updated_at BETWEEN ? AND ?", from, to))
+}
+
+// UpdatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) UpdatedAtEq(updatedAt time.Time) AccountQuerySet {
+	return qs.w(qs.db.Where("updated_at = ?", updatedAt))
+}
+
+// UpdatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) UpdatedAtGt(updatedAt time.Time) AccountQuerySet {
+	return qs.w(qs.db.Where("updated_at > ?", updatedAt))
+}
+
+// UpdatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) UpdatedAtGte(updatedAt time.Time) AccountQuerySet {
+	return qs.w(qs.db.Where("updated_at >= ?", updatedAt))
+}
+
+// UpdatedAtInRange filters rows with UpdatedAt in range [from, to)
+// nolint: dupl
+func (qs AccountQuerySet) UpdatedAtInRange(from time.Time, to time.Time) AccountQuerySet {
+	return qs.w(qs.db.Where("updated_at >= ? AND updated_at < ?", from, to))
+}
+
+// UpdatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) UpdatedAtLt(updatedAt time.Time) AccountQuerySet {
+	return qs.w(qs.db.Where("updated_at < ?", updatedAt))
+}
+
+// UpdatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) UpdatedAtLte(updatedAt time.Time) AccountQuerySet {
+	return qs.w(qs.db.Where("updated_at <= ?", updatedAt))
+}
+
+// UpdatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs AccountQuerySet) UpdatedAtNe(updatedAt time.Time) AccountQuerySet {
+	return qs.w(qs.db.Where("updated_at != ?", updatedAt))
+}
+
+// UpdatedAtWithin filters rows with UpdatedAt not earlier than d ago
+// nolint: dupl
+func (qs AccountQuerySet) UpdatedAtWithin(d time.Duration) AccountQuerySet {
+	return qs.w(qs.db.Where("updated_at >= ?", time.Now().Add(-d)))
+}
+
+// WithContext returns copy executing queries with context ctx.
+// Gorm v1 doesn't support context: its error is checked only before query.
+// nolint: dupl
+func (qs AccountQuerySet) WithContext(ctx context.Context) AccountQuerySet {
+	return qs.w(DBWithContext(ctx, qs.db))
+}
+
+// fieldPtrs returns pointers to fields of o by their columns
+// nolint: dupl
+func (qs AccountQuerySet) fieldPtrs(o *Account) map[string]interface{} {
+	return map[string]interface{}{
+		"id":         &o.ID,
+		"created_at": &o.CreatedAt,
+		"updated_at": &o.UpdatedAt,
+		"deleted_at": &o.DeletedAt,
+		"login":      &o.Login,
+		"nickname":   &o.Nickname,
+		"age":        &o.Age,
+	}
+}
+
+// InTx returns copy bound to transaction tx: queries are executed in it.
+// Gorm v1 can't move conditions into another connection, so with gorm v1
+// InTx must be called before any condition is set, otherwise queries fail.
+// Context and preloads are moved into transaction by both gorm versions.
+// nolint: dupl
+func (u AccountUpdater) InTx(tx *gorm.DB) AccountUpdater {
+	ctx := tx.Statement.Context
+	if u.db.Statement.Context != context.Background() {
+		ctx = u.db.Statement.Context // set by WithContext
+	}
+	db := u.db.Session(&gorm.Session{Context: ctx})
+	db.Statement.ConnPool = tx.Statement.ConnPool
+	if tx.Error != nil {
+		db.AddError(tx.Error)
+	}
+	u.db = db
+	return u
+}
+
+// SetAge is an autogenerated method
+// nolint: dupl
+func (u AccountUpdater) SetAge(age int) AccountUpdater {
+	u.fields[string(AccountDBSchema.Age)] = age
+	return u
+}
+
+// SetCreatedAt is an autogenerated method
+// nolint: dupl
+func (u AccountUpdater) SetCreatedAt(createdAt time.Time) AccountUpdater {
+	u.fields[string(AccountDBSchema.CreatedAt)] = createdAt
+	return u
+}
+
+// SetDeletedAt is an autogenerated method
+// nolint: dupl
+func (u AccountUpdater) SetDeletedAt(deletedAt time.Time) AccountUpdater {
+	u.fields[string(AccountDBSchema.DeletedAt)] = deletedAt
+	return u
+}
+
+// SetDeletedAtNull sets DeletedAt to NULL
+// nolint: dupl
+func (u AccountUpdater) SetDeletedAtNull() AccountUpdater {
+	u.fields[string(AccountDBSchema.DeletedAt)] = nil
+	return u
+}
+
+// SetID is an autogenerated method
+// nolint: dupl
+func (u AccountUpdater) SetID(ID uint) AccountUpdater {
+	u.fields[string(AccountDBSchema.ID)] = ID
+	return u
+}
+
+// SetLogin is an autogenerated method
+// nolint: dupl
+func (u AccountUpdater) SetLogin(login string) AccountUpdater {
+	u.fields[string(AccountDBSchema.Login)] = login
+	return u
+}
+
+// SetNickname is an autogenerated method
+// nolint: dupl
+func (u AccountUpdater) SetNickname(nickname *string) AccountUpdater {
+	u.fields[string(AccountDBSchema.Nickname)] = nickname
+	return u
+}
+
+// SetUpdatedAt is an autogenerated method
+// nolint: dupl
+func (u AccountUpdater) SetUpdatedAt(updatedAt time.Time) AccountUpdater {
+	u.fields[string(AccountDBSchema.UpdatedAt)] = updatedAt
+	return u
+}
+
+// Update is an autogenerated method
+// nolint: dupl
+func (u AccountUpdater) Update() error {
+	if err := u.Validate(); err != nil {
+		return err
+	}
+
+	return u.db.Updates(u.fields).Error
+}
+
+// UpdateNum is an autogenerated method
+// nolint: dupl
+func (u AccountUpdater) UpdateNum() (int64, error) {
+	if err := u.Validate(); err != nil {
+		return 0, err
+	}
+
+	db := u.db.Updates(u.fields)
+	return db.RowsAffected, db.Error
+}
+
+// WithContext returns copy executing queries with context ctx.
+// Gorm v1 doesn't support context: its error is checked only before query.
+// nolint: dupl
+func (u AccountUpdater) WithContext(ctx context.Context) AccountUpdater {
+	u.db = DBWithContext(ctx, u.db)
+	return u
+}
+
+// ===== END of query set AccountQuerySet
+
+// ===== BEGIN of Account modifiers
+
+// AccountDBSchemaField describes database schema field. It requires for method 'Update'
+type AccountDBSchemaField string
+
+// String method returns string representation of field.
+// nolint: dupl
+func (f AccountDBSchemaField) String() string {
+	return string(f)
+}
+
+// AccountDBSchema stores db field names of Account
+var AccountDBSchema = struct {
+	ID        AccountDBSchemaField
+	CreatedAt AccountDBSchemaField
+	UpdatedAt AccountDBSchemaField
+	DeletedAt AccountDBSchemaField
+	Login     AccountDBSchemaField
+	Nickname  AccountDBSchemaField
+	Age       AccountDBSchemaField
+}{
+
+	ID:        AccountDBSchemaField("id"),
+	CreatedAt: AccountDBSchemaField("created_at"),
+	UpdatedAt: AccountDBSchemaField("updated_at"),
+	DeletedAt: AccountDBSchemaField("deleted_at"),
+	Login:     AccountDBSchemaField("login"),
+	Nickname:  AccountDBSchemaField("nickname"),
+	Age:       AccountDBSchemaField("age"),
+}
+
+// Update updates Account fields by primary key
+// nolint: dupl
+func (o *Account) Update(db *gorm.DB, fields ...AccountDBSchemaField) error {
+	dbNameToFieldName := map[string]interface{}{
+		"id":         o.ID,
+		"created_at": o.CreatedAt,
+		"updated_at": o.UpdatedAt,
+		"deleted_at": o.DeletedAt,
+		"login":      o.Login,
+		"nickname":   o.Nickname,
+		"age":        o.Age,
+	}
+	u := map[string]interface{}{}
+	for _, f := range fields {
+		fs := f.String()
+		u[fs] = dbNameToFieldName[fs]
+	}
+	if err := validateAccountFields(u); err != nil {
+		return err
+	}
+	if err := db.Model(o).Updates(u).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+
+		return fmt.Errorf("can't update Account %v fields %v: %s",
+			o, fields, err)
+	}
+
+	return nil
+}
+
+// Upsert inserts Account or updates updateFields of existing row
+// if insert violates unique constraint on conflictFields. Row isn't
+// changed if there are no updateFields: any conflict is ignored then if
+// there are no conflictFields. MySQL ignores conflictFields: it checks
+// all unique constraints, other databases require them to update row.
+// nolint: dupl
+func (o *Account) Upsert(db *gorm.DB, conflictFields []AccountDBSchemaField,
+	updateFields ...AccountDBSchemaField) error {
+	if err := o.Validate(); err != nil {
+		return err
+	}
+
+	conflictColumns := make([]string, 0, len(conflictFields))
+	for _, f := range conflictFields {
+		conflictColumns = append(conflictColumns, f.String())
+	}
+	updateColumns := make([]string, 0, len(updateFields))
+	for _, f := range updateFields {
+		updateColumns = append(updateColumns, f.String())
+	}
+
+	if err := checkConflictFields(db, conflictColumns, updateColumns); err != nil {
+		return err
+	}
+
+	onConflict := clause.OnConflict{
+		DoNothing: len(updateColumns) == 0,
+	}
+	for _, c := range conflictColumns {
+		onConflict.Columns = append(onConflict.Columns, clause.Column{Name: c})
+	}
+	if len(updateColumns) != 0 {
+		onConflict.DoUpdates = clause.AssignmentColumns(updateColumns)
+	}
+
+	return db.Clauses(onConflict).Create(o).Error
+}
+
+// AccountUpdater is an Account updates manager
+type AccountUpdater struct {
+	fields map[string]interface{}
+	db     *gorm.DB
+}
+
+// NewAccountUpdater creates new Account updater
+// nolint: dupl
+func NewAccountUpdater(db *gorm.DB) AccountUpdater {
+	return AccountUpdater{
+		fields: map[string]interface{}{},
+		db:     db.Model(&Account{}),
+	}
+}
+
+// validateAccountFields validates values of Account fields by their columns
+func validateAccountFields(fields map[string]interface{}) error {
+	if value, ok := fields[string(AccountDBSchema.Login)]; ok {
+		v := value.(string)
+		if utf8.RuneCountInString(string(v)) > 8 {
+			return ValidationError{Field: "Login", Reason: "length must be at most 8"}
+		}
+		if v == "" {
+			return ValidationError{Field: "Login", Reason: "is required"}
+		}
+	}
+	if value, ok := fields[string(AccountDBSchema.Nickname)]; ok {
+		v := value.(*string)
+		if v == nil {
+			return ValidationError{Field: "Nickname", Reason: "must be not null"}
+		}
+		if v != nil {
+			if utf8.RuneCountInString(string(*v)) > 16 {
+				return ValidationError{Field: "Nickname", Reason: "length must be at most 16"}
+			}
+		}
+	}
+	if value, ok := fields[string(AccountDBSchema.Age)]; ok {
+		v := value.(int)
+		if v < 18 {
+			return ValidationError{Field: "Age", Reason: "must be at least 18"}
+		}
+		if v > 150 {
+			return ValidationError{Field: "Age", Reason: "must be at most 150"}
+		}
+	}
+
+	return nil
+}
+
+// Validate checks Account by rules from tags, it's called
+// before writes to database
+func (o *Account) Validate() error {
+	return validateAccountFields(map[string]interface{}{
+		string(AccountDBSchema.Login):    o.Login,
+		string(AccountDBSchema.Nickname): o.Nickname,
+		string(AccountDBSchema.Age):      o.Age,
+	})
+}
+
+// Validate checks fields set in updater by rules from tags,
+// it's called before update
+func (u AccountUpdater) Validate() error {
+	return validateAccountFields(u.fields)
+}
+
+// ===== END of Account modifiers
+
+// ===== BEGIN of Account predicates
+
+// AccountPredicate is a condition on Account fields for Where and Not
+// methods of AccountQuerySet: predicates are created by AccountQ and
+// combined by And, Or and Not. Zero predicate matches all rows.
+type AccountPredicate struct {
+	expr string
+	args []interface{}
+	err  error
+}
+
+// join combines predicates by operation op, empty predicates are skipped
+// nolint: dupl
+func (p AccountPredicate) join(op string, preds []AccountPredicate) AccountPredicate {
+	for _, q := range preds {
+		if p.err == nil {
+			p.err = q.err
+		}
+		if q.expr == "" {
+			continue
+		}
+		if p.expr == "" {
+			p.expr, p.args = q.expr, q.args
+			continue
+		}
+
+		p.expr = "(" + p.expr + ") " + op + " (" + q.expr + ")"
+		p.args = append(append([]interface{}{}, p.args...), q.args...)
+	}
+
+	return p
+}
+
+// And returns predicate matching rows matched by p and all preds
+// nolint: dupl
+func (p AccountPredicate) And(preds ...AccountPredicate) AccountPredicate {
+	return p.join("AND", preds)
+}
+
+// Or returns predicate matching rows matched by p or any of preds
+// nolint: dupl
+func (p AccountPredicate) Or(preds ...AccountPredicate) AccountPredicate {
+	return p.join("OR", preds)
+}
+
+// Not returns predicate matching rows not matched by p
+// nolint: dupl
+func (p AccountPredicate) Not() AccountPredicate {
+	if p.expr != "" {
+		p.expr = "NOT (" + p.expr + ")"
+	}
+
+	return p
+}
+
+// AccountPredicates creates predicates by Account fields
+type AccountPredicates struct{}
+
+// AccountQ creates predicates by Account fields
+var AccountQ AccountPredicates
+
+// AgeEq creates predicate "age = ?"
+// nolint: dupl
+func (AccountPredicates) AgeEq(age int) AccountPredicate {
+	return AccountPredicate{expr: "age = ?", args: []interface{}{age}}
+}
+
+// AgeGt creates predicate "age > ?"
+// nolint: dupl
+func (AccountPredicates) AgeGt(age int) AccountPredicate {
+	return AccountPredicate{expr: "age > ?", args: []interface{}{age}}
+}
+
+// AgeGte creates predicate "age >= ?"
+// nolint: dupl
+func (AccountPredicates) AgeGte(age int) AccountPredicate {
+	return AccountPredicate{expr: "age >= ?", args: []interface{}{age}}
+}
+
+// AgeIn creates predicate "age IN (?)"
+// nolint: dupl
+func (AccountPredicates) AgeIn(age ...int) AccountPredicate {
+	if len(age) == 0 {
+		return AccountPredicate{err: errors.New("must at least pass one age in AgeIn")}
+	}
+	return AccountPredicate{expr: "age IN (?)", args: []interface{}{age}}
+}
+
+// AgeLt creates predicate "age < ?"
+// nolint: dupl
+func (AccountPredicates) AgeLt(age int) AccountPredicate {
+	return AccountPredicate{expr: "age < ?", args: []interface{}{age}}
+}
+
+// AgeLte creates predicate "age <= ?"
+// nolint: dupl
+func (AccountPredicates) AgeLte(age int) AccountPredicate {
+	return AccountPredicate{expr: "age <= ?", args: []interface{}{age}}
+}
+
+// AgeNe creates predicate "age != ?"
+// nolint: dupl
+func (AccountPredicates) AgeNe(age int) AccountPredicate {
+	return AccountPredicate{expr: "age != ?", args: []interface{}{age}}
+}
+
+// AgeNotIn creates predicate "age NOT IN (?)"
+// nolint: dupl
+func (AccountPredicates) AgeNotIn(age ...int) AccountPredicate {
+	if len(age) == 0 {
+		return AccountPredicate{err: errors.New("must at least pass one age in AgeNotIn")}
+	}
+	return AccountPredicate{expr: "age NOT IN (?)", args: []interface{}{age}}
+}
+
+// CreatedAtEq creates predicate "created_at = ?"
+// nolint: dupl
+func (AccountPredicates) CreatedAtEq(createdAt time.Time) AccountPredicate {
+	return AccountPredicate{expr: "created_at = ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtGt creates predicate "created_at > ?"
+// nolint: dupl
+func (AccountPredicates) CreatedAtGt(createdAt time.Time) AccountPredicate {
+	return AccountPredicate{expr: "created_at > ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtGte creates predicate "created_at >= ?"
+// nolint: dupl
+func (AccountPredicates) CreatedAtGte(createdAt time.Time) AccountPredicate {
+	return AccountPredicate{expr: "created_at >= ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtLt creates predicate "created_at < ?"
+// nolint: dupl
+func (AccountPredicates) CreatedAtLt(createdAt time.Time) AccountPredicate {
+	return AccountPredicate{expr: "created_at < ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtLte creates predicate "created_at <= ?"
+// nolint: dupl
+func (AccountPredicates) CreatedAtLte(createdAt time.Time) AccountPredicate {
+	return AccountPredicate{expr: "created_at <= ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtNe creates predicate "created_at != ?"
+// nolint: dupl
+func (AccountPredicates) CreatedAtNe(createdAt time.Time) AccountPredicate {
+	return AccountPredicate{expr: "created_at != ?", args: []interface{}{createdAt}}
+}
+
+// DeletedAtEq creates predicate "deleted_at = ?"
+// nolint: dupl
+func (AccountPredicates) DeletedAtEq(deletedAt time.Time) AccountPredicate {
+	return AccountPredicate{expr: "deleted_at = ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtGt creates predicate "deleted_at > ?"
+// nolint: dupl
+func (AccountPredicates) DeletedAtGt(deletedAt time.Time) AccountPredicate {
+	return AccountPredicate{expr: "deleted_at > ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtGte creates predicate "deleted_at >= ?"
+// nolint: dupl
+func (AccountPredicates) DeletedAtGte(deletedAt time.Time) AccountPredicate {
+	return AccountPredicate{expr: "deleted_at >= ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtIsNotNull creates predicate "deleted_at IS NOT NULL"
+// nolint: dupl
+func (AccountPredicates) DeletedAtIsNotNull() AccountPredicate {
+	return AccountPredicate{expr: "deleted_at IS NOT NULL"}
+}
+
+// DeletedAtIsNull creates predicate "deleted_at IS NULL"
+// nolint: dupl
+func (AccountPredicates) DeletedAtIsNull() AccountPredicate {
+	return AccountPredicate{expr: "deleted_at IS NULL"}
+}
+
+// DeletedAtLt creates predicate "deleted_at < ?"
+// nolint: dupl
+func (AccountPredicates) DeletedAtLt(deletedAt time.Time) AccountPredicate {
+	return AccountPredicate{expr: "deleted_at < ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtLte creates predicate "deleted_at <= ?"
+// nolint: dupl
+func (AccountPredicates) DeletedAtLte(deletedAt time.Time) AccountPredicate {
+	return AccountPredicate{expr: "deleted_at <= ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtNe creates predicate "deleted_at != ?"
+// nolint: dupl
+func (AccountPredicates) DeletedAtNe(deletedAt time.Time) AccountPredicate {
+	return AccountPredicate{expr: "deleted_at != ?", args: []interface{}{deletedAt}}
+}
+
+// IDEq creates predicate "id = ?"
+// nolint: dupl
+func (AccountPredicates) IDEq(ID uint) AccountPredicate {
+	return AccountPredicate{expr: "id = ?", args: []interface{}{ID}}
+}
+
+// IDGt creates predicate "id > ?"
+// nolint: dupl
+func (AccountPredicates) IDGt(ID uint) AccountPredicate {
+	return AccountPredicate{expr: "id > ?", args: []interface{}{ID}}
+}
+
+// IDGte creates predicate "id >= ?"
+// nolint: dupl
+func (AccountPredicates) IDGte(ID uint) AccountPredicate {
+	return AccountPredicate{expr: "id >= ?", args: []interface{}{ID}}
+}
+
+// IDIn creates predicate "id IN (?)"
+// nolint: dupl
+func (AccountPredicates) IDIn(ID ...uint) AccountPredicate {
+	if len(ID) == 0 {
+		return AccountPredicate{err: errors.New("must at least pass one ID in IDIn")}
+	}
+	return AccountPredicate{expr: "id IN (?)", args: []interface{}{ID}}
+}
+
+// IDLt creates predicate "id < ?"
+// nolint: dupl
+func (AccountPredicates) IDLt(ID uint) AccountPredicate {
+	return AccountPredicate{expr: "id < ?", args: []interface{}{ID}}
+}
+
+// IDLte creates predicate "id <= ?"
+// nolint: dupl
+func (AccountPredicates) IDLte(ID uint) AccountPredicate {
+	return AccountPredicate{expr: "id <= ?", args: []interface{}{ID}}
+}
+
+// IDNe creates predicate "id != ?"
+// nolint: dupl
+func (AccountPredicates) IDNe(ID uint) AccountPredicate {
+	return AccountPredicate{expr: "id != ?", args: []interface{}{ID}}
+}
+
+// IDNotIn creates predicate "id NOT IN (?)"
+// nolint: dupl
+func (AccountPredicates) IDNotIn(ID ...uint) AccountPredicate {
+	if len(ID) == 0 {
+		return AccountPredicate{err: errors.New("must at least pass one ID in IDNotIn")}
+	}
+	return AccountPredicate{expr: "id NOT IN (?)", args: []interface{}{ID}}
+}
+
+// LoginEq creates predicate "login = ?"
+// nolint: dupl
+func (AccountPredicates) LoginEq(login string) AccountPredicate {
+	return AccountPredicate{expr: "login = ?", args: []interface{}{login}}
+}
+
+// LoginGt creates predicate "login > ?"
+// nolint: dupl
+func (AccountPredicates) LoginGt(login string) AccountPredicate {
+	return AccountPredicate{expr: "login > ?", args: []interface{}{login}}
+}
+
+// LoginGte creates predicate "login >= ?"
+// nolint: dupl
+func (AccountPredicates) LoginGte(login string) AccountPredicate {
+	return AccountPredicate{expr: "login >= ?", args: []interface{}{login}}
+}
+
+// LoginIn creates predicate "login IN (?)"
+// nolint: dupl
+func (AccountPredicates) LoginIn(login ...string) AccountPredicate {
+	if len(login) == 0 {
+		return AccountPredicate{err: errors.New("must at least pass one login in LoginIn")}
+	}
+	return AccountPredicate{expr: "login IN (?)", args: []interface{}{login}}
+}
+
+// LoginLike creates predicate "login LIKE ?"
+// nolint: dupl
+func (AccountPredicates) LoginLike(login string) AccountPredicate {
+	return AccountPredicate{expr: "login LIKE ?", args: []interface{}{login}}
+}
+
+// LoginLt creates predicate "login < ?"
+// nolint: dupl
+func (AccountPredicates) LoginLt(login string) AccountPredicate {
+	return AccountPredicate{expr: "login < ?", args: []interface{}{login}}
+}
+
+// LoginLte creates predicate "login <= ?"
+// nolint: dupl
+func (AccountPredicates) LoginLte(login string) AccountPredicate {
+	return AccountPredicate{expr: "login <= ?", args: []interface{}{login}}
+}
+
+// LoginNe creates predicate "login != ?"
+// nolint: dupl
+func (AccountPredicates) LoginNe(login string) AccountPredicate {
+	return AccountPredicate{expr: "login != ?", args: []interface{}{login}}
+}
+
+// LoginNotIn creates predicate "login NOT IN (?)"
+// nolint: dupl
+func (AccountPredicates) LoginNotIn(login ...string) AccountPredicate {
+	if len(login) == 0 {
+		return AccountPredicate{err: errors.New("must at least pass one login in LoginNotIn")}
+	}
+	return AccountPredicate{expr: "login NOT IN (?)", args: []interface{}{login}}
+}
+
+// LoginNotlike creates predicate "login NOT LIKE ?"
+// nolint: dupl
+func (AccountPredicates) LoginNotlike(login string) AccountPredicate {
+	return AccountPredicate{expr: "login NOT LIKE ?", args: []interface{}{login}}
+}
+
+// NicknameEq creates predicate "nickname = ?"
+// nolint: dupl
+func (AccountPredicates) NicknameEq(nickname string) AccountPredicate {
+	return AccountPredicate{expr: "nickname = ?", args: []interface{}{nickname}}
+}
+
+// NicknameGt creates predicate "nickname > ?"
+// nolint: dupl
+func (AccountPredicates) NicknameGt(nickname string) AccountPredicate {
+	return AccountPredicate{expr: "nickname > ?", args: []interface{}{nickname}}
+}
+
+// NicknameGte creates predicate "nickname >= ?"
+// nolint: dupl
+func (AccountPredicates) NicknameGte(nickname string) AccountPredicate {
+	return AccountPredicate{expr: "nickname >= ?", args: []interface{}{nickname}}
+}
+
+// NicknameIn creates predicate "nickname IN (?)"
+// nolint: dupl
+func (AccountPredicates) NicknameIn(nickname ...string) AccountPredicate {
+	if len(nickname) == 0 {
+		return AccountPredicate{err: errors.New("must at least pass one nickname in NicknameIn")}
+	}
+	return AccountPredicate{expr: "nickname IN (?)", args: []interface{}{nickname}}
+}
+
+// NicknameIsNotNull creates predicate "nickname IS NOT NULL"
+// nolint: dupl
+func (AccountPredicates) NicknameIsNotNull() AccountPredicate {
+	return AccountPredicate{expr: "nickname IS NOT NULL"}
+}
+
+// NicknameIsNull creates predicate "nickname IS NULL"
+// nolint: dupl
+func (AccountPredicates) NicknameIsNull() AccountPredicate {
+	return AccountPredicate{expr: "nickname IS NULL"}
+}
+
+// NicknameLike creates predicate "nickname LIKE ?"
+// nolint: dupl
+func (AccountPredicates) NicknameLike(nickname string) AccountPredicate {
+	return AccountPredicate{expr: "nickname LIKE ?", args: []interface{}{nickname}}
+}
+
+// NicknameLt creates predicate "nickname < ?"
+// nolint: dupl
+func (AccountPredicates) NicknameLt(nickname string) AccountPredicate {
+	return AccountPredicate{expr: "nickname < ?", args: []interface{}{nickname}}
+}
+
+// NicknameLte creates predicate "nickname <= ?"
+// nolint: dupl
+func (AccountPredicates) NicknameLte(nickname string) AccountPredicate {
+	return AccountPredicate{expr: "nickname <= ?", args: []interface{}{nickname}}
+}
+
+// NicknameNe creates predicate "nickname != ?"
+// nolint: dupl
+func (AccountPredicates) NicknameNe(nickname string) AccountPredicate {
+	return AccountPredicate{expr: "nickname != ?", args: []interface{}{nickname}}
+}
+
+// NicknameNotIn creates predicate "nickname NOT IN (?)"
+// nolint: dupl
+func (AccountPredicates) NicknameNotIn(nickname ...string) AccountPredicate {
+	if len(nickname) == 0 {
+		return AccountPredicate{err: errors.New("must at least pass one nickname in NicknameNotIn")}
+	}
+	return AccountPredicate{expr: "nickname NOT IN (?)", args: []interface{}{nickname}}
+}
+
+// NicknameNotlike creates predicate "nickname NOT LIKE ?"
+// nolint: dupl
+func (AccountPredicates) NicknameNotlike(nickname string) AccountPredicate {
+	return AccountPredicate{expr: "nickname NOT LIKE ?", args: []interface{}{nickname}}
+}
+
+// UpdatedAtEq creates predicate "updated_at = ?"
+// nolint: dupl
+func (AccountPredicates) UpdatedAtEq(updatedAt time.Time) AccountPredicate {
+	return AccountPredicate{expr: "updated_at = ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtGt creates predicate "updated_at > ?"
+// nolint: dupl
+func (AccountPredicates) UpdatedAtGt(updatedAt time.Time) AccountPredicate {
+	return AccountPredicate{expr: "updated_at > ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtGte creates predicate "updated_at >= ?"
+// nolint: dupl
+func (AccountPredicates) UpdatedAtGte(updatedAt time.Time) AccountPredicate {
+	return AccountPredicate{expr: "updated_at >= ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtLt creates predicate "updated_at < ?"
+// nolint: dupl
+func (AccountPredicates) UpdatedAtLt(updatedAt time.Time) AccountPredicate {
+	return AccountPredicate{expr: "updated_at < ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtLte creates predicate "updated_at <= ?"
+// nolint: dupl
+func (AccountPredicates) UpdatedAtLte(updatedAt time.Time) AccountPredicate {
+	return AccountPredicate{expr: "updated_at <= ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtNe creates predicate "updated_at != ?"
+// nolint: dupl
+func (AccountPredicates) UpdatedAtNe(updatedAt time.Time) AccountPredicate {
+	return AccountPredicate{expr: "updated_at != ?", args: []interface{}{updatedAt}}
+}
+
+// ===== END of Account predicates
+
+// ===== BEGIN of query set BlogQuerySet
+
+// BlogQuerySet is an queryset type for Blog
+type BlogQuerySet struct {
+	db *gorm.DB
+}
+
+// NewBlogQuerySet constructs new BlogQuerySet
+func NewBlogQuerySet(db *gorm.DB) BlogQuerySet {
+	return BlogQuerySet{
+		// new session makes every chained call clone the statement,
+		// so a queryset can be safely reused as a base for other querysets
+		db: db.Model(&Blog{}).Session(&gorm.Session{}),
+	}
+}
+
+func (qs BlogQuerySet) w(db *gorm.DB) BlogQuerySet {
+	return NewBlogQuerySet(db)
+}
+
+func (qs BlogQuerySet) Select(fields ...BlogDBSchemaField) BlogQuerySet {
+	names := []string{}
+	for _, f := range fields {
+		names = append(names, f.String())
+	}
+
+	return qs.w(qs.db.Select(strings.Join(names, ",")))
+}
+
+// GroupBy groups rows by fields
+func (qs BlogQuerySet) GroupBy(fields ...BlogDBSchemaField) BlogQuerySet {
+	names := []string{}
+	for _, f := range fields {
+		names = append(names, f.String())
+	}
+
+	return qs.w(qs.db.Group(strings.Join(names, ",")))
+}
+
+// Where filters rows by predicate p, predicates are created by BlogQ
+// nolint: dupl
+func (qs BlogQuerySet) Where(p BlogPredicate) BlogQuerySet {
+	if p.err != nil {
+		// error is added to copy of db: qs can be reused as a base queryset
+		db := qs.db.Session(&gorm.Session{})
+		db.AddError(p.err)
+		return qs.w(db)
+	}
+	if p.expr == "" {
+		return qs
+	}
+
+	return qs.w(qs.db.Where(p.expr, p.args...))
+}
+
+// Not filters rows not matching predicate p
+// nolint: dupl
+func (qs BlogQuerySet) Not(p BlogPredicate) BlogQuerySet {
+	return qs.Where(p.Not())
+}
+
+// AppendEditors adds editors to Editors of o: editors are saved and rows of join table blog_editors are set
+// nolint: dupl
+func (o *Blog) AppendEditors(db *gorm.DB, editors ...*User) error {
+	return db.Model(o).Association("Editors").Append(editors)
+}
+
+// ClearEditors removes all objects from Editors of o: rows of join table blog_editors are cleared,
+// objects aren't deleted
+// nolint: dupl
+func (o *Blog) ClearEditors(db *gorm.DB) error {
+	return db.Model(o).Association("Editors").Clear()
+}
+
+// CountEditors returns number of objects in Editors of o
+// nolint: dupl
+func (o *Blog) CountEditors(db *gorm.DB) (int64, error) {
+	a := db.Model(o).Association("Editors")
+	n := a.Count()
+	return n, a.Error
+}
+
+// Create is an autogenerated method
+// nolint: dupl
+func (o *Blog) Create(db *gorm.DB) error {
+	return db.Create(o).Error
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (o *Blog) Delete(db *gorm.DB) error {
+	return db.Delete(o).Error
+}
+
+// RemoveEditors removes editors from Editors of o: rows of join table blog_editors are cleared,
+// objects aren't deleted
+// nolint: dupl
+func (o *Blog) RemoveEditors(db *gorm.DB, editors ...*User) error {
+	return db.Model(o).Association("Editors").Delete(editors)
+}
+
+// ReplaceEditors replaces Editors of o by editors: other objects are removed
+// from association but aren't deleted
+// nolint: dupl
+func (o *Blog) ReplaceEditors(db *gorm.DB, editors ...*User) error {
+	return db.Model(o).Association("Editors").Replace(editors)
+}
+
+// All is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) All(ret *[]Blog) error {
+	return qs.db.Find(ret).Error
+}
+
+// Batches calls fn for every batch of at most size rows, batches are
+// fetched by Page: ordered by primary key after queryset orders.
+// It stops on the first error of fn and returns it.
+// nolint: dupl
+func (qs BlogQuerySet) Batches(size int, fn func([]Blog) error) error {
+	cursor := ""
+	for {
+		var batch []Blog
+		next, err := qs.Page(cursor, size, &batch)
+		if err != nil {
+			return err
+		}
+
+		if len(batch) != 0 {
+			if err = fn(batch); err != nil {
+				return err
+			}
+		}
+
+		if next == "" {
+			return nil
+		}
+		cursor = next
+	}
+}
+
+// Count is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) Count() (int64, error) {
+	var count int64
+	err := qs.db.Count(&count).Error
+	return count, err
+}
+
+// CountByID returns count of rows for every value of ID
+// nolint: dupl
+func (qs BlogQuerySet) CountByID() (map[uint]int, error) {
+	var rows []struct {
+		Value uint
+		Count int
+	}
+	err := qs.db.Select("id AS value, COUNT(*) AS count").Group("id").Scan(&rows).Error
+	ret := make(map[uint]int, len(rows))
+	for _, r := range rows {
+		ret[r.Value] = r.Count
+	}
+	return ret, err
+}
+
+// CountByName returns count of rows for every value of Name
+// nolint: dupl
+func (qs BlogQuerySet) CountByName() (map[string]int, error) {
+	var rows []struct {
+		Value string
+		Count int
+	}
+	err := qs.db.Select("myname AS value, COUNT(*) AS count").Group("myname").Scan(&rows).Error
+	ret := make(map[string]int, len(rows))
+	for _, r := range rows {
+		ret[r.Value] = r.Count
+	}
+	return ret, err
+}
+
+// CreateMany inserts models by multi-row inserts, conditions of queryset
+// aren't used. Generated primary keys are written back to models if
+// database supports it (all except MS SQL for gorm v1).
+// nolint: dupl
+func (qs BlogQuerySet) CreateMany(models []Blog) error {
+	if len(models) == 0 {
+		return nil
+	}
+
+	size := bulkInsertBatchSize(qs.db.Dialector.Name(), len(qs.fieldPtrs(&models[0])))
+	// new db to not use conditions of queryset
+	return qs.db.Session(&gorm.Session{NewDB: true}).CreateInBatches(&models, size).Error
+}
+
+// CreatedAtBetween filters rows with CreatedAt in range [from, to]
+// nolint: dupl
+func (qs BlogQuerySet) CreatedAtBetween(from time.Time, to time.Time) BlogQuerySet {
+	return qs.w(qs.db.Where("created_at BETWEEN ? AND ?", from, to))
+}
+
+// CreatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) CreatedAtEq(createdAt time.Time) BlogQuerySet {
+	return qs.w(qs.db.Where("created_at = ?", createdAt))
+}
+
+// CreatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) CreatedAtGt(createdAt time.Time) BlogQuerySet {
+	return qs.w(qs.db.Where("created_at > ?", createdAt))
+}
+
+// CreatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) CreatedAtGte(createdAt time.Time) BlogQuerySet {
+	return qs.w(qs.db.Where("created_at >= ?", createdAt))
+}
+
+// CreatedAtInRange filters rows with CreatedAt in range [from, to)
+// nolint: dupl
+func (qs BlogQuerySet) CreatedAtInRange(from time.Time, to time.Time) BlogQuerySet {
+	return qs.w(qs.db.Where("created_at >= ? AND created_at < ?", from, to))
+}
+
+// CreatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) CreatedAtLt(createdAt time.Time) BlogQuerySet {
+	return qs.w(qs.db.Where("created_at < ?", createdAt))
+}
+
+// CreatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) CreatedAtLte(createdAt time.Time) BlogQuerySet {
+	return qs.w(qs.db.Where("created_at <= ?", createdAt))
+}
+
+// CreatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) CreatedAtNe(createdAt time.Time) BlogQuerySet {
+	return qs.w(qs.db.Where("created_at != ?", createdAt))
+}
+
+// CreatedAtWithin filters rows with CreatedAt not earlier than d ago
+// nolint: dupl
+func (qs BlogQuerySet) CreatedAtWithin(d time.Duration) BlogQuerySet {
+	return qs.w(qs.db.Where("created_at >= ?", time.Now().Add(-d)))
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) Delete() error {
+	return qs.db.Delete(&Blog{}).Error
+}
+
+// DeleteNum is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) DeleteNum() (int64, error) {
+	db := qs.db.Delete(&Blog{})
+	return db.RowsAffected, db.Error
+}
+
+// DeleteNumUnscoped is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) DeleteNumUnscoped() (int64, error) {
+	db := qs.db.Unscoped().Delete(&Blog{})
+	return db.RowsAffected, db.Error
+}
+
+// DeletedAtBetween filters rows with DeletedAt in range [from, to]
+// nolint: dupl
+func (qs BlogQuerySet) DeletedAtBetween(from time.Time, to time.Time) BlogQuerySet {
+	return qs.w(qs.db.Where("deleted_at BETWEEN ? AND ?", from, to))
+}
+
+// DeletedAtEq is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) DeletedAtEq(deletedAt time.Time) BlogQuerySet {
+	return qs.w(qs.db.Where("deleted_at = ?", deletedAt))
+}
+
+// DeletedAtGt is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) DeletedAtGt(deletedAt time.Time) BlogQuerySet {
+	return qs.w(qs.db.Where("deleted_at > ?", deletedAt))
+}
+
+// DeletedAtGte is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) DeletedAtGte(deletedAt time.Time) BlogQuerySet {
+	return qs.w(qs.db.Where("deleted_at >= ?", deletedAt))
+}
+
+// DeletedAtInRange filters rows with DeletedAt in range [from, to)
+// nolint: dupl
+func (qs BlogQuerySet) DeletedAtInRange(from time.Time, to time.Time) BlogQuerySet {
+	return qs.w(qs.db.Where("deleted_at >= ? AND deleted_at < ?", from, to))
+}
+
+// DeletedAtIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) DeletedAtIsNotNull() BlogQuerySet {
+	return qs.w(qs.db.Where("deleted_at IS NOT NULL"))
+}
+
+// DeletedAtIsNull is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) DeletedAtIsNull() BlogQuerySet {
+	return qs.w(qs.db.Where("deleted_at IS NULL"))
+}
+
+// DeletedAtLt is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) DeletedAtLt(deletedAt time.Time) BlogQuerySet {
+	return qs.w(qs.db.Where("deleted_at < ?", deletedAt))
+}
+
+// DeletedAtLte is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) DeletedAtLte(deletedAt time.Time) BlogQuerySet {
+	return qs.w(qs.db.Where("deleted_at <= ?", deletedAt))
+}
+
+// DeletedAtNe is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) DeletedAtNe(deletedAt time.Time) BlogQuerySet {
+	return qs.w(qs.db.Where("deleted_at != ?", deletedAt))
+}
+
+// DeletedAtWithin filters rows with DeletedAt not earlier than d ago
+// nolint: dupl
+func (qs BlogQuerySet) DeletedAtWithin(d time.Duration) BlogQuerySet {
+	return qs.w(qs.db.Where("deleted_at >= ?", time.Now().Add(-d)))
+}
+
+// GetDB is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) GetDB() *gorm.DB {
+	return qs.db
+}
+
+// GetUpdater is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) GetUpdater() BlogUpdater {
+	return NewBlogUpdater(qs.db)
+}
+
+// GroupByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) GroupByCreatedAt() BlogQuerySet {
+	return qs.w(qs.db.Group("created_at"))
+}
+
+// GroupByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) GroupByDeletedAt() BlogQuerySet {
+	return qs.w(qs.db.Group("deleted_at"))
+}
+
+// GroupByID is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) GroupByID() BlogQuerySet {
+	return qs.w(qs.db.Group("id"))
+}
+
+// GroupByName is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) GroupByName() BlogQuerySet {
+	return qs.w(qs.db.Group("myname"))
+}
+
+// GroupByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) GroupByUpdatedAt() BlogQuerySet {
+	return qs.w(qs.db.Group("updated_at"))
+}
+
+// HavingCountEq is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) HavingCountEq(count int) BlogQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) = ?", count))
+}
+
+// HavingCountGt is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) HavingCountGt(count int) BlogQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) > ?", count))
+}
+
+// HavingCountGte is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) HavingCountGte(count int) BlogQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) >= ?", count))
+}
+
+// HavingCountLt is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) HavingCountLt(count int) BlogQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) < ?", count))
+}
+
+// HavingCountLte is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) HavingCountLte(count int) BlogQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) <= ?", count))
+}
+
+// HavingCountNe is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) HavingCountNe(count int) BlogQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) != ?", count))
+}
+
+// IDBetween filters rows with ID in range [from, to]
+// nolint: dupl
+func (qs BlogQuerySet) IDBetween(from uint, to uint) BlogQuerySet {
+	return qs.w(qs.db.Where("id BETWEEN ? AND ?", from, to))
+}
+
+// IDEq is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) IDEq(ID uint) BlogQuerySet {
+	return qs.w(qs.db.Where("id = ?", ID))
+}
+
+// IDGt is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) IDGt(ID uint) BlogQuerySet {
+	return qs.w(qs.db.Where("id > ?", ID))
+}
+
+// IDGte is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) IDGte(ID uint) BlogQuerySet {
+	return qs.w(qs.db.Where("id >= ?", ID))
+}
+
+// IDIn is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) IDIn(ID ...uint) BlogQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("id IN (?)", ID))
+}
+
+// IDInQuery filters rows with ID IN values selected by subquery q
+// nolint: dupl
+func (qs BlogQuerySet) IDInQuery(q UintSubquery) BlogQuerySet {
+	return qs.w(qs.db.Where("id IN (?)", q.expr()))
+}
+
+// IDInRange filters rows with ID in range [from, to)
+// nolint: dupl
+func (qs BlogQuerySet) IDInRange(from uint, to uint) BlogQuerySet {
+	return qs.w(qs.db.Where("id >= ? AND id < ?", from, to))
+}
+
+// IDLt is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) IDLt(ID uint) BlogQuerySet {
+	return qs.w(qs.db.Where("id < ?", ID))
+}
+
+// IDLte is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) IDLte(ID uint) BlogQuerySet {
+	return qs.w(qs.db.Where("id <= ?", ID))
+}
+
+// IDNe is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) IDNe(ID uint) BlogQuerySet {
+	return qs.w(qs.db.Where("id != ?", ID))
+}
+
+// IDNotIn is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) IDNotIn(ID ...uint) BlogQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("id NOT IN (?)", ID))
+}
+
+// IDNotInQuery filters rows with ID NOT IN values selected by subquery q
+// nolint: dupl
+func (qs BlogQuerySet) IDNotInQuery(q UintSubquery) BlogQuerySet {
+	return qs.w(qs.db.Where("id NOT IN (?)", q.expr()))
+}
+
+// InTx returns copy bound to transaction tx: queries are executed in it.
+// Gorm v1 can't move conditions into another connection, so with gorm v1
+// InTx must be called before any condition is set, otherwise queries fail.
+// Context and preloads are moved into transaction by both gorm versions.
+// nolint: dupl
+func (qs BlogQuerySet) InTx(tx *gorm.DB) BlogQuerySet {
+	ctx := tx.Statement.Context
+	if qs.db.Statement.Context != context.Background() {
+		ctx = qs.db.Statement.Context // set by WithContext
+	}
+	db := qs.db.Session(&gorm.Session{Context: ctx})
+	db.Statement.ConnPool = tx.Statement.ConnPool
+	if tx.Error != nil {
+		db.AddError(tx.Error)
+	}
+	return qs.w(db)
+}
+
+// Iterate scans rows one by one and calls fn for every row without
+// loading all rows into memory. It stops on the first error of fn and
+// returns it. Preloads aren't applied.
+// nolint: dupl
+func (qs BlogQuerySet) Iterate(fn func(*Blog) error) error {
+	db := qs.db
+	if db.Error != nil {
+		return db.Error // gorm v1 doesn't check it in Rows
+	}
+
+	rows, err := db.Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var o Blog
+		if err = db.ScanRows(rows, &o); err != nil {
+			return err
+		}
+		if err = fn(&o); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// Limit is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) Limit(limit int) BlogQuerySet {
+	return qs.w(qs.db.Limit(limit))
+}
+
+// MaxCreatedAt returns MAX of CreatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs BlogQuerySet) MaxCreatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := qs.db.Select("created_at AS value").Where("created_at IS NOT NULL").
+		Order(clause.OrderByColumn{
+			Column:  clause.Column{Name: "created_at", Raw: true},
+			Desc:    true,
+			Reorder: true,
+		}).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MaxID returns MAX of ID, it's zero value if there are no rows
+// nolint: dupl
+func (qs BlogQuerySet) MaxID() (uint, error) {
+	var ret uint
+	var res struct {
+		Value *uint
+	}
+	err := qs.db.Select("MAX(id) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// MaxUpdatedAt returns MAX of UpdatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs BlogQuerySet) MaxUpdatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := qs.db.Select("updated_at AS value").Where("updated_at IS NOT NULL").
+		Order(clause.OrderByColumn{
+			Column:  clause.Column{Name: "updated_at", Raw: true},
+			Desc:    true,
+			Reorder: true,
+		}).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MinCreatedAt returns MIN of CreatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs BlogQuerySet) MinCreatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := qs.db.Select("created_at AS value").Where("created_at IS NOT NULL").
+		Order(clause.OrderByColumn{
+			Column:  clause.Column{Name: "created_at", Raw: true},
+			Desc:    false,
+			Reorder: true,
+		}).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MinID returns MIN of ID, it's zero value if there are no rows
+// nolint: dupl
+func (qs BlogQuerySet) MinID() (uint, error) {
+	var ret uint
+	var res struct {
+		Value *uint
+	}
+	err := qs.db.Select("MIN(id) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// MinUpdatedAt returns MIN of UpdatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs BlogQuerySet) MinUpdatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := qs.db.Select("updated_at AS value").Where("updated_at IS NOT NULL").
+		Order(clause.OrderByColumn{
+			Column:  clause.Column{Name: "updated_at", Raw: true},
+			Desc:    false,
+			Reorder: true,
+		}).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// NameEndsWith filters rows with Name ending with the argument
+// nolint: dupl
+func (qs BlogQuerySet) NameEndsWith(name string) BlogQuerySet {
+	return qs.w(whereLike(qs.db, "myname", "%", name, "", false))
+}
+
+// NameEq is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) NameEq(name string) BlogQuerySet {
+	return qs.w(qs.db.Where("myname = ?", name))
+}
+
+// NameGt is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) NameGt(name string) BlogQuerySet {
+	return qs.w(qs.db.Where("myname > ?", name))
+}
+
+// NameGte is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) NameGte(name string) BlogQuerySet {
+	return qs.w(qs.db.Where("myname >= ?", name))
+}
+
+// NameIContains filters rows with Name containing the argument, case is ignored
+// nolint: dupl
+func (qs BlogQuerySet) NameIContains(name string) BlogQuerySet {
+	return qs.w(whereLike(qs.db, "myname", "%", name, "%", true))
+}
+
+// NameIEq filters rows with Name equal to the argument, case is ignored
+// nolint: dupl
+func (qs BlogQuerySet) NameIEq(name string) BlogQuerySet {
+	return qs.w(whereLike(qs.db, "myname", "", name, "", true))
+}
+
+// NameIn is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) NameIn(name ...string) BlogQuerySet {
+	if len(name) == 0 {
+		qs.db.AddError(errors.New("must at least pass one name in NameIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("myname IN (?)", name))
+}
+
+// NameInQuery filters rows with Name IN values selected by subquery q
+// nolint: dupl
+func (qs BlogQuerySet) NameInQuery(q StringSubquery) BlogQuerySet {
+	return qs.w(qs.db.Where("myname IN (?)", q.expr()))
+}
+
+// NameLike is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) NameLike(name string) BlogQuerySet {
+	return qs.w(qs.db.Where("myname LIKE ?", name))
+}
+
+// NameLt is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) NameLt(name string) BlogQuerySet {
+	return qs.w(qs.db.Where("myname < ?", name))
+}
+
+// NameLte is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) NameLte(name string) BlogQuerySet {
+	return qs.w(qs.db.Where("myname <= ?", name))
+}
+
+// NameNe is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) NameNe(name string) BlogQuerySet {
+	return qs.w(qs.db.Where("myname != ?", name))
+}
+
+// NameNotIn is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) NameNotIn(name ...string) BlogQuerySet {
+	if len(name) == 0 {
+		qs.db.AddError(errors.New("must at least pass one name in NameNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("myname NOT IN (?)", name))
+}
+
+// NameNotInQuery filters rows with Name NOT IN values selected by subquery q
+// nolint: dupl
+func (qs BlogQuerySet) NameNotInQuery(q StringSubquery) BlogQuerySet {
+	return qs.w(qs.db.Where("myname NOT IN (?)", q.expr()))
+}
+
+// NameNotlike is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) NameNotlike(name string) BlogQuerySet {
+	return qs.w(qs.db.Where("myname NOT LIKE ?", name))
+}
+
+// NameStartsWith filters rows with Name starting with the argument
+// nolint: dupl
+func (qs BlogQuerySet) NameStartsWith(name string) BlogQuerySet {
+	return qs.w(whereLike(qs.db, "myname", "", name, "%", false))
+}
+
+// Offset is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) Offset(offset int) BlogQuerySet {
+	return qs.w(qs.db.Offset(offset))
+}
+
+// One is used to retrieve one result. It returns gorm.ErrRecordNotFound
+// if nothing was fetched
+func (qs BlogQuerySet) One(ret *Blog) error {
+	return qs.db.First(ret).Error
+}
+
+// OrderAscByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) OrderAscByCreatedAt() BlogQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("created_at ASC"), "created_at", false, false))
+}
+
+// OrderAscByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) OrderAscByDeletedAt() BlogQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("deleted_at ASC"), "deleted_at", false, false))
+}
+
+// OrderAscByID is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) OrderAscByID() BlogQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("id ASC"), "id", false, false))
+}
+
+// OrderAscByName is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) OrderAscByName() BlogQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("myname ASC"), "myname", false, false))
+}
+
+// OrderAscByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) OrderAscByUpdatedAt() BlogQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("updated_at ASC"), "updated_at", false, false))
+}
+
+// OrderDescByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) OrderDescByCreatedAt() BlogQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("created_at DESC"), "created_at", true, false))
+}
+
+// OrderDescByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) OrderDescByDeletedAt() BlogQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("deleted_at DESC"), "deleted_at", true, false))
+}
+
+// OrderDescByID is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) OrderDescByID() BlogQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("id DESC"), "id", true, false))
+}
+
+// OrderDescByName is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) OrderDescByName() BlogQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("myname DESC"), "myname", true, false))
+}
+
+// OrderDescByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) OrderDescByUpdatedAt() BlogQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("updated_at DESC"), "updated_at", true, false))
+}
+
+// Page fetches at most size rows following cursor into ret, it uses
+// orders set by OrderAscBy/OrderDescBy methods and primary key to break ties.
+// Ordered fields can't be nullable: rows with NULL can't be compared with
+// cursor, so error is returned for them. Empty cursor means the first page. It returns cursor of the next page or
+// empty string if there are no more rows.
+// nolint: dupl
+func (qs BlogQuerySet) Page(cursor string, size int, ret *[]Blog) (string, error) {
+	if size <= 0 {
+		return "", errors.New("page size must be positive")
+	}
+
+	var last Blog
+	db, orders, err := pageQuery(qs.db, "", "id", cursor, qs.fieldPtrs(&last))
+	if err != nil {
+		return "", err
+	}
+
+	if err = db.Limit(size + 1).Find(ret).Error; err != nil {
+		return "", err
+	}
+	if len(*ret) <= size {
+		return "", nil
+	}
+
+	*ret = (*ret)[:size]
+	return encodePageCursor(orders, qs.fieldPtrs(&(*ret)[size-1]))
+}
+
+// PreloadEditors is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) PreloadEditors() BlogQuerySet {
+	return qs.w(qs.db.Preload("Editors"))
+}
+
+// PreloadEditorsWhere preloads Editors filtered, ordered or limited by f:
+// limit is applied to all preloaded objects, not to objects of every row
+// nolint: dupl
+func (qs BlogQuerySet) PreloadEditorsWhere(f func(UserQuerySet) UserQuerySet) BlogQuerySet {
+	return qs.w(qs.db.Preload("Editors", func(db *gorm.DB) *gorm.DB {
+		return f(NewUserQuerySet(db)).db
+	}))
+}
+
+// SelectID returns subquery selecting ID of rows of queryset
+// for InQuery and NotInQuery filters
+// nolint: dupl
+func (qs BlogQuerySet) SelectID() UintSubquery {
+	return UintSubquery{db: qs.db.Select("id")}
+}
+
+// SelectName returns subquery selecting Name of rows of queryset
+// for InQuery and NotInQuery filters
+// nolint: dupl
+func (qs BlogQuerySet) SelectName() StringSubquery {
+	return StringSubquery{db: qs.db.Select("myname")}
+}
+
+// UpdatedAtBetween filters rows with UpdatedAt in range [from, to]
+// nolint: dupl
+func (qs BlogQuerySet) UpdatedAtBetween(from time.Time, to time.Time) BlogQuerySet {
+	return qs.w(qs.db.Where("updated_at BETWEEN ? AND ?", from, to))
+}
+
+// UpdatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) UpdatedAtEq(updatedAt time.Time) BlogQuerySet {
+	return qs.w(qs.db.Where("updated_at = ?", updatedAt))
+}
+
+// UpdatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) UpdatedAtGt(updatedAt time.Time) BlogQuerySet {
+	return qs.w(qs.db.Where("updated_at > ?", updatedAt))
+}
+
+// UpdatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) UpdatedAtGte(updatedAt time.Time) BlogQuerySet {
+	return qs.w(qs.db.Where("updated_at >= ?", updatedAt))
+}
+
+// UpdatedAtInRange filters rows with UpdatedAt in range [from, to)
+// nolint: dupl
+func (qs BlogQuerySet) UpdatedAtInRange(from time.Time, to time.Time) BlogQuerySet {
+	return qs.w(qs.db.Where("updated_at >= ? AND updated_at < ?", from, to))
+}
+
+// UpdatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) UpdatedAtLt(updatedAt time.Time) BlogQuerySet {
+	return qs.w(qs.db.Where("updated_at < ?", updatedAt))
+}
+
+// UpdatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) UpdatedAtLte(updatedAt time.Time) BlogQuerySet {
+	return qs.w(qs.db.Where("updated_at <= ?", updatedAt))
+}
+
+// UpdatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs BlogQuerySet) UpdatedAtNe(updatedAt time.Time) BlogQuerySet {
+	return qs.w(qs.db.Where("updated_at != ?", updatedAt))
+}
+
+// UpdatedAtWithin filters rows with UpdatedAt not earlier than d ago
+// nolint: dupl
+func (qs BlogQuerySet) UpdatedAtWithin(d time.Duration) BlogQuerySet {
+	return qs.w(qs.db.Where("updated_at >= ?", time.Now().Add(-d)))
+}
+
+// WithContext returns copy executing queries with context ctx.
+// Gorm v1 doesn't support context: its error is checked only before query.
+// nolint: dupl
+func (qs BlogQuerySet) WithContext(ctx context.Context) BlogQuerySet {
+	return qs.w(DBWithContext(ctx, qs.db))
+}
+
+// fieldPtrs returns pointers to fields of o by their columns
+// nolint: dupl
+func (qs BlogQuerySet) fieldPtrs(o *Blog) map[string]interface{} {
+	return map[string]interface{}{
+		"id":         &o.ID,
+		"created_at": &o.CreatedAt,
+		"updated_at": &o.UpdatedAt,
+		"deleted_at": &o.DeletedAt,
+		"myname":     &o.Name,
+	}
+}
+
+// InTx returns copy bound to transaction tx: queries are executed in it.
+// Gorm v1 can't move conditions into another connection, so with gorm v1
+// InTx must be called before any condition is set, otherwise queries fail.
+// Context and preloads are moved into transaction by both gorm versions.
+// nolint: dupl
+func (u BlogUpdater) InTx(tx *gorm.DB) BlogUpdater {
+	ctx := tx.Statement.Context
+	if u.db.Statement.Context != context.Background() {
+		ctx = u.db.Statement.Context // set by WithContext
+	}
+	db := u.db.Session(&gorm.Session{Context: ctx})
+	db.Statement.ConnPool = tx.Statement.ConnPool
+	if tx.Error != nil {
+		db.AddError(tx.Error)
+	}
+	u.db = db
+	return u
+}
+
+// SetCreatedAt is an autogenerated method
+// nolint: dupl
+func (u BlogUpdater) SetCreatedAt(createdAt time.Time) BlogUpdater {
+	u.fields[string(BlogDBSchema.CreatedAt)] = createdAt
+	return u
+}
+
+// SetDeletedAt is an autogenerated method
+// nolint: dupl
+func (u BlogUpdater) SetDeletedAt(deletedAt time.Time) BlogUpdater {
+	u.fields[string(BlogDBSchema.DeletedAt)] = deletedAt
+	return u
+}
+
+// SetDeletedAtNull sets DeletedAt to NULL
+// nolint: dupl
+func (u BlogUpdater) SetDeletedAtNull() BlogUpdater {
+	u.fields[string(BlogDBSchema.DeletedAt)] = nil
+	return u
+}
+
+// SetID is an autogenerated method
+// nolint: dupl
+func (u BlogUpdater) SetID(ID uint) BlogUpdater {
+	u.fields[string(BlogDBSchema.ID)] = ID
+	return u
+}
+
+// SetName is an autogenerated method
+// nolint: dupl
+func (u BlogUpdater) SetName(name string) BlogUpdater {
+	u.fields[string(BlogDBSchema.Name)] = name
+	return u
+}
+
+// SetUpdatedAt is an autogenerated method
+// nolint: dupl
+func (u BlogUpdater) SetUpdatedAt(updatedAt time.Time) BlogUpdater {
+	u.fields[string(BlogDBSchema.UpdatedAt)] = updatedAt
+	return u
+}
+
+// Update is an autogenerated method
+// nolint: dupl
+func (u BlogUpdater) Update() error {
+	return u.db.Updates(u.fields).Error
+}
+
+// UpdateNum is an autogenerated method
+// nolint: dupl
+func (u BlogUpdater) UpdateNum() (int64, error) {
+	db := u.db.Updates(u.fields)
+	return db.RowsAffected, db.Error
+}
+
+// WithContext returns copy executing queries with context ctx.
+// Gorm v1 doesn't support context: its error is checked only before query.
+// nolint: dupl
+func (u BlogUpdater) WithContext(ctx context.Context) BlogUpdater {
+	u.db = DBWithContext(ctx, u.db)
+	return u
+}
+
+// ===== END of query set BlogQuerySet
+
+// ===== BEGIN of Blog modifiers
+
+// BlogDBSchemaField describes database schema field. It requires for method 'Update'
+type BlogDBSchemaField string
+
+// String method returns string representation of field.
+// nolint: dupl
+func (f BlogDBSchemaField) String() string {
+	return string(f)
+}
+
+// BlogDBSchema stores db field names of Blog
+var BlogDBSchema = struct {
+	ID        BlogDBSchemaField
+	CreatedAt BlogDBSchemaField
+	UpdatedAt BlogDBSchemaField
+	DeletedAt BlogDBSchemaField
+	Name      BlogDBSchemaField
+}{
+
+	ID:        BlogDBSchemaField("id"),
+	CreatedAt: BlogDBSchemaField("created_at"),
+	UpdatedAt: BlogDBSchemaField("updated_at"),
+	DeletedAt: BlogDBSchemaField("deleted_at"),
+	Name:      BlogDBSchemaField("myname"),
+}
+
+// Update updates Blog fields by primary key
+// nolint: dupl
+func (o *Blog) Update(db *gorm.DB, fields ...BlogDBSchemaField) error {
+	dbNameToFieldName := map[string]interface{}{
+		"id":         o.ID,
+		"created_at": o.CreatedAt,
+		"updated_at": o.UpdatedAt,
+		"deleted_at": o.DeletedAt,
+		"myname":     o.Name,
+	}
+	u := map[string]interface{}{}
+	for _, f := range fields {
+		fs := f.String()
+		u[fs] = dbNameToFieldName[fs]
+	}
+	if err := db.Model(o).Updates(u).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+
+		return fmt.Errorf("can't update Blog %v fields %v: %s",
+			o, fields, err)
+	}
+
+	return nil
+}
+
+// Upsert inserts Blog or updates updateFields of existing row
+// if insert violates unique constraint on conflictFields. Row isn't
+// changed if there are no updateFields: any conflict is ignored then if
+// there are no conflictFields. MySQL ignores conflictFields: it checks
+// all unique constraints, other databases require them to update row.
+// nolint: dupl
+func (o *Blog) Upsert(db *gorm.DB, conflictFields []BlogDBSchemaField,
+	updateFields ...BlogDBSchemaField) error {
+
+	conflictColumns := make([]string, 0, len(conflictFields))
+	for _, f := range conflictFields {
+		conflictColumns = append(conflictColumns, f.String())
+	}
+	updateColumns := make([]string, 0, len(updateFields))
+	for _, f := range updateFields {
+		updateColumns = append(updateColumns, f.String())
+	}
+
+	if err := checkConflictFields(db, conflictColumns, updateColumns); err != nil {
+		return err
+	}
+
+	onConflict := clause.OnConflict{
+		DoNothing: len(updateColumns) == 0,
+	}
+	for _, c := range conflictColumns {
+		onConflict.Columns = append(onConflict.Columns, clause.Column{Name: c})
+	}
+	if len(updateColumns) != 0 {
+		onConflict.DoUpdates = clause.AssignmentColumns(updateColumns)
+	}
+
+	return db.Clauses(onConflict).Create(o).Error
+}
+
+// BlogUpdater is an Blog updates manager
+type BlogUpdater struct {
+	fields map[string]interface{}
+	db     *gorm.DB
+}
+
+// NewBlogUpdater creates new Blog updater
+// nolint: dupl
+func NewBlogUpdater(db *gorm.DB) BlogUpdater {
+	return BlogUpdater{
+		fields: map[string]interface{}{},
+		db:     db.Model(&Blog{}),
+	}
+}
+
+// ===== END of Blog modifiers
+
+// ===== BEGIN of Blog predicates
+
+// BlogPredicate is a condition on Blog fields for Where and Not
+// methods of BlogQuerySet: predicates are created by BlogQ and
+// combined by And, Or and Not. Zero predicate matches all rows.
+type BlogPredicate struct {
+	expr string
+	args []interface{}
+	err  error
+}
+
+// join combines predicates by operation op, empty predicates are skipped
+// nolint: dupl
+func (p BlogPredicate) join(op string, preds []BlogPredicate) BlogPredicate {
+	for _, q := range preds {
+		if p.err == nil {
+			p.err = q.err
+		}
+		if q.expr == "" {
+			continue
+		}
+		if p.expr == "" {
+			p.expr, p.args = q.expr, q.args
+			continue
+		}
+
+		p.expr = "(" + p.expr + ") " + op + " (" + q.expr + ")"
+		p.args = append(append([]interface{}{}, p.args...), q.args...)
+	}
+
+	return p
+}
+
+// And returns predicate matching rows matched by p and all preds
+// nolint: dupl
+func (p BlogPredicate) And(preds ...BlogPredicate) BlogPredicate {
+	return p.join("AND", preds)
+}
+
+// Or returns predicate matching rows matched by p or any of preds
+// nolint: dupl
+func (p BlogPredicate) Or(preds ...BlogPredicate) BlogPredicate {
+	return p.join("OR", preds)
+}
+
+// Not returns predicate matching rows not matched by p
+// nolint: dupl
+func (p BlogPredicate) Not() BlogPredicate {
+	if p.expr != "" {
+		p.expr = "NOT (" + p.expr + ")"
+	}
+
+	return p
+}
+
+// BlogPredicates creates predicates by Blog fields
+type BlogPredicates struct{}
+
+// BlogQ creates predicates by Blog fields
+var BlogQ BlogPredicates
+
+// CreatedAtEq creates predicate "created_at = ?"
+// nolint: dupl
+func (BlogPredicates) CreatedAtEq(createdAt time.Time) BlogPredicate {
+	return BlogPredicate{expr: "created_at = ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtGt creates predicate "created_at > ?"
+// nolint: dupl
+func (BlogPredicates) CreatedAtGt(createdAt time.Time) BlogPredicate {
+	return BlogPredicate{expr: "created_at > ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtGte creates predicate "created_at >= ?"
+// nolint: dupl
+func (BlogPredicates) CreatedAtGte(createdAt time.Time) BlogPredicate {
+	return BlogPredicate{expr: "created_at >= ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtLt creates predicate "created_at < ?"
+// nolint: dupl
+func (BlogPredicates) CreatedAtLt(createdAt time.Time) BlogPredicate {
+	return BlogPredicate{expr: "created_at < ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtLte creates predicate "created_at <= ?"
+// nolint: dupl
+func (BlogPredicates) CreatedAtLte(createdAt time.Time) BlogPredicate {
+	return BlogPredicate{expr: "created_at <= ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtNe creates predicate "created_at != ?"
+// nolint: dupl
+func (BlogPredicates) CreatedAtNe(createdAt time.Time) BlogPredicate {
+	return BlogPredicate{expr: "created_at != ?", args: []interface{}{createdAt}}
+}
+
+// DeletedAtEq creates predicate "deleted_at = ?"
+// nolint: dupl
+func (BlogPredicates) DeletedAtEq(deletedAt time.Time) BlogPredicate {
+	return BlogPredicate{expr: "deleted_at = ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtGt creates predicate "deleted_at > ?"
+// nolint: dupl
+func (BlogPredicates) DeletedAtGt(deletedAt time.Time) BlogPredicate {
+	return BlogPredicate{expr: "deleted_at > ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtGte creates predicate "deleted_at >= ?"
+// nolint: dupl
+func (BlogPredicates) DeletedAtGte(deletedAt time.Time) BlogPredicate {
+	return BlogPredicate{expr: "deleted_at >= ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtIsNotNull creates predicate "deleted_at IS NOT NULL"
+// nolint: dupl
+func (BlogPredicates) DeletedAtIsNotNull() BlogPredicate {
+	return BlogPredicate{expr: "deleted_at IS NOT NULL"}
+}
+
+// DeletedAtIsNull creates predicate "deleted_at IS NULL"
+// nolint: dupl
+func (BlogPredicates) DeletedAtIsNull() BlogPredicate {
+	return BlogPredicate{expr: "deleted_at IS NULL"}
+}
+
+// DeletedAtLt creates predicate "deleted_at < ?"
+// nolint: dupl
+func (BlogPredicates) DeletedAtLt(deletedAt time.Time) BlogPredicate {
+	return BlogPredicate{expr: "deleted_at < ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtLte creates predicate "deleted_at <= ?"
+// nolint: dupl
+func (BlogPredicates) DeletedAtLte(deletedAt time.Time) BlogPredicate {
+	return BlogPredicate{expr: "deleted_at <= ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtNe creates predicate "deleted_at != ?"
+// nolint: dupl
+func (BlogPredicates) DeletedAtNe(deletedAt time.Time) BlogPredicate {
+	return BlogPredicate{expr: "deleted_at != ?", args: []interface{}{deletedAt}}
+}
+
+// IDEq creates predicate "id = ?"
+// nolint: dupl
+func (BlogPredicates) IDEq(ID uint) BlogPredicate {
+	return BlogPredicate{expr: "id = ?", args: []interface{}{ID}}
+}
+
+// IDGt creates predicate "id > ?"
+// nolint: dupl
+func (BlogPredicates) IDGt(ID uint) BlogPredicate {
+	return BlogPredicate{expr: "id > ?", args: []interface{}{ID}}
+}
+
+// IDGte creates predicate "id >= ?"
+// nolint: dupl
+func (BlogPredicates) IDGte(ID uint) BlogPredicate {
+	return BlogPredicate{expr: "id >= ?", args: []interface{}{ID}}
+}
+
+// IDIn creates predicate "id IN (?)"
+// nolint: dupl
+func (BlogPredicates) IDIn(ID ...uint) BlogPredicate {
+	if len(ID) == 0 {
+		return BlogPredicate{err: errors.New("must at least pass one ID in IDIn")}
+	}
+	return BlogPredicate{expr: "id IN (?)", args: []interface{}{ID}}
+}
+
+// IDLt creates predicate "id < ?"
+// nolint: dupl
+func (BlogPredicates) IDLt(ID uint) BlogPredicate {
+	return BlogPredicate{expr: "id < ?", args: []interface{}{ID}}
+}
+
+// IDLte creates predicate "id <= ?"
+// nolint: dupl
+func (BlogPredicates) IDLte(ID uint) BlogPredicate {
+	return BlogPredicate{expr: "id <= ?", args: []interface{}{ID}}
+}
+
+// IDNe creates predicate "id != ?"
+// nolint: dupl
+func (BlogPredicates) IDNe(ID uint) BlogPredicate {
+	return BlogPredicate{expr: "id != ?", args: []interface{}{ID}}
+}
+
+// IDNotIn creates predicate "id NOT IN (?)"
+// nolint: dupl
+func (BlogPredicates) IDNotIn(ID ...uint) BlogPredicate {
+	if len(ID) == 0 {
+		return BlogPredicate{err: errors.New("must at least pass one ID in IDNotIn")}
+	}
+	return BlogPredicate{expr: "id NOT IN (?)", args: []interface{}{ID}}
+}
+
+// NameEq creates predicate "myname = ?"
+// nolint: dupl
+func (BlogPredicates) NameEq(name string) BlogPredicate {
+	return BlogPredicate{expr: "myname = ?", args: []interface{}{name}}
+}
+
+// NameGt creates predicate "myname > ?"
+// nolint: dupl
+func (BlogPredicates) NameGt(name string) BlogPredicate {
+	return BlogPredicate{expr: "myname > ?", args: []interface{}{name}}
+}
+
+// NameGte creates predicate "myname >= ?"
+// nolint: dupl
+func (BlogPredicates) NameGte(name string) BlogPredicate {
+	return BlogPredicate{expr: "myname >= ?", args: []interface{}{name}}
+}
+
+// NameIn creates predicate "myname IN (?)"
+// nolint: dupl
+func (BlogPredicates) NameIn(name ...string) BlogPredicate {
+	if len(name) == 0 {
+		return BlogPredicate{err: errors.New("must at least pass one name in NameIn")}
+	}
+	return BlogPredicate{expr: "myname IN (?)", args: []interface{}{name}}
+}
+
+// NameLike creates predicate "myname LIKE ?"
+// nolint: dupl
+func (BlogPredicates) NameLike(name string) BlogPredicate {
+	return BlogPredicate{expr: "myname LIKE ?", args: []interface{}{name}}
+}
+
+// NameLt creates predicate "myname < ?"
+// nolint: dupl
+func (BlogPredicates) NameLt(name string) BlogPredicate {
+	return BlogPredicate{expr: "myname < ?", args: []interface{}{name}}
+}
+
+// NameLte creates predicate "myname <= ?"
+// nolint: dupl
+func (BlogPredicates) NameLte(name string) BlogPredicate {
+	return BlogPredicate{expr: "myname <= ?", args: []interface{}{name}}
+}
+
+// NameNe creates predicate "myname != ?"
+// nolint: dupl
+func (BlogPredicates) NameNe(name string) BlogPredicate {
+	return BlogPredicate{expr: "myname != ?", args: []interface{}{name}}
+}
+
+// NameNotIn creates predicate "myname NOT IN (?)"
+// nolint: dupl
+func (BlogPredicates) NameNotIn(name ...string) BlogPredicate {
+	if len(name) == 0 {
+		return BlogPredicate{err: errors.New("must at least pass one name in NameNotIn")}
+	}
+	return BlogPredicate{expr: "myname NOT IN (?)", args: []interface{}{name}}
+}
+
+// NameNotlike creates predicate "myname NOT LIKE ?"
+// nolint: dupl
+func (BlogPredicates) NameNotlike(name string) BlogPredicate {
+	return BlogPredicate{expr: "myname NOT LIKE ?", args: []interface{}{name}}
+}
+
+// UpdatedAtEq creates predicate "updated_at = ?"
+// nolint: dupl
+func (BlogPredicates) UpdatedAtEq(updatedAt time.Time) BlogPredicate {
+	return BlogPredicate{expr: "updated_at = ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtGt creates predicate "updated_at > ?"
+// nolint: dupl
+func (BlogPredicates) UpdatedAtGt(updatedAt time.Time) BlogPredicate {
+	return BlogPredicate{expr: "updated_at > ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtGte creates predicate "updated_at >= ?"
+// nolint: dupl
+func (BlogPredicates) UpdatedAtGte(updatedAt time.Time) BlogPredicate {
+	return BlogPredicate{expr: "updated_at >= ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtLt creates predicate "updated_at < ?"
+// nolint: dupl
+func (BlogPredicates) UpdatedAtLt(updatedAt time.Time) BlogPredicate {
+	return BlogPredicate{expr: "updated_at < ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtLte creates predicate "updated_at <= ?"
+// nolint: dupl
+func (BlogPredicates) UpdatedAtLte(updatedAt time.Time) BlogPredicate {
+	return BlogPredicate{expr: "updated_at <= ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtNe creates predicate "updated_at != ?"
+// nolint: dupl
+func (BlogPredicates) UpdatedAtNe(updatedAt time.Time) BlogPredicate {
+	return BlogPredicate{expr: "updated_at != ?", args: []interface{}{updatedAt}}
+}
+
+// ===== END of Blog predicates
+
+// ===== BEGIN of query set DocumentQuerySet
+
+// DocumentQuerySet is an queryset type for Document
+type DocumentQuerySet struct {
+	db *gorm.DB
+}
+
+// NewDocumentQuerySet constructs new DocumentQuerySet
+func NewDocumentQuerySet(db *gorm.DB) DocumentQuerySet {
+	return DocumentQuerySet{
+		// new session makes every chained call clone the statement,
+		// so a queryset can be safely reused as a base for other querysets
+		db: db.Model(&Document{}).Session(&gorm.Session{}),
+	}
+}
+
+func (qs DocumentQuerySet) w(db *gorm.DB) DocumentQuerySet {
+	return NewDocumentQuerySet(db)
+}
+
+func (qs DocumentQuerySet) Select(fields ...DocumentDBSchemaField) DocumentQuerySet {
+	names := []string{}
+	for _, f := range fields {
+		names = append(names, f.String())
+	}
+
+	return qs.w(qs.db.Select(strings.Join(names, ",")))
+}
+
+// GroupBy groups rows by fields
+func (qs DocumentQuerySet) GroupBy(fields ...DocumentDBSchemaField) DocumentQuerySet {
+	names := []string{}
+	for _, f := range fields {
+		names = append(names, f.String())
+	}
+
+	return qs.w(qs.db.Group(strings.Join(names, ",")))
+}
+
+// Where filters rows by predicate p, predicates are created by DocumentQ
+// nolint: dupl
+func (qs DocumentQuerySet) Where(p DocumentPredicate) DocumentQuerySet {
+	if p.err != nil {
+		// error is added to copy of db: qs can be reused as a base queryset
+		db := qs.db.Session(&gorm.Session{})
+		db.AddError(p.err)
+		return qs.w(db)
+	}
+	if p.expr == "" {
+		return qs
+	}
+
+	return qs.w(qs.db.Where(p.expr, p.args...))
+}
+
+// Not filters rows not matching predicate p
+// nolint: dupl
+func (qs DocumentQuerySet) Not(p DocumentPredicate) DocumentQuerySet {
+	return qs.Where(p.Not())
+}
+
+// Create is an autogenerated method
+// nolint: dupl
+func (o *Document) Create(db *gorm.DB) error {
+	return db.Create(o).Error
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (o *Document) Delete(db *gorm.DB) error {
+	return db.Delete(o).Error
+}
+
+// All is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) All(ret *[]Document) error {
+	return qs.db.Find(ret).Error
+}
+
+// AttrsIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) AttrsIsNotNull() DocumentQuerySet {
+	return qs.w(qs.db.Where("attrs IS NOT NULL"))
+}
+
+// AttrsIsNull is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) AttrsIsNull() DocumentQuerySet {
+	return qs.w(qs.db.Where("attrs IS NULL"))
+}
+
+// AttrsJSONEq filters rows with text value of Attrs at path equal to value,
+// path is a dot-separated list of keys, e.g. "address.city"
+// nolint: dupl
+func (qs DocumentQuerySet) AttrsJSONEq(path string, value string) DocumentQuerySet {
+	return qs.w(whereJSONEq(qs.db, "attrs", path, value))
+}
+
+// Batches calls fn for every batch of at most size rows, batches are
+// fetched by Page: ordered by primary key after queryset orders.
+// It stops on the first error of fn and returns it.
+// nolint: dupl
+func (qs DocumentQuerySet) Batches(size int, fn func([]Document) error) error {
+	cursor := ""
+	for {
+		var batch []Document
+		next, err := qs.Page(cursor, size, &batch)
+		if err != nil {
+			return err
+		}
+
+		if len(batch) != 0 {
+			if err = fn(batch); err != nil {
+				return err
+			}
+		}
+
+		if next == "" {
+			return nil
+		}
+		cursor = next
+	}
+}
+
+// Count is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) Count() (int64, error) {
+	var count int64
+	err := qs.db.Count(&count).Error
+	return count, err
+}
+
+// CountByID returns count of rows for every value of ID
+// nolint: dupl
+func (qs DocumentQuerySet) CountByID() (map[uint]int, error) {
+	var rows []struct {
+		Value uint
+		Count int
+	}
+	err := qs.db.Select("id AS value, COUNT(*) AS count").Group("id").Scan(&rows).Error
+	ret := make(map[uint]int, len(rows))
+	for _, r := range rows {
+		ret[r.Value] = r.Count
+	}
+	return ret, err
+}
+
+// CreateMany inserts models by multi-row inserts, conditions of queryset
+// aren't used. Generated primary keys are written back to models if
+// database supports it (all except MS SQL for gorm v1).
+// nolint: dupl
+func (qs DocumentQuerySet) CreateMany(models []Document) error {
+	if len(models) == 0 {
+		return nil
+	}
+
+	size := bulkInsertBatchSize(qs.db.Dialector.Name(), len(qs.fieldPtrs(&models[0])))
+	// new db to not use conditions of queryset
+	return qs.db.Session(&gorm.Session{NewDB: true}).CreateInBatches(&models, size).Error
+}
+
+// CreatedAtBetween filters rows with CreatedAt in range [from, to]
+// nolint: dupl
+func (qs DocumentQuerySet) CreatedAtBetween(from time.Time, to time.Time) DocumentQuerySet {
+	return qs.w(qs.db.Where("created_at BETWEEN ? AND ?", from, to))
+}
+
+// CreatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) CreatedAtEq(createdAt time.Time) DocumentQuerySet {
+	return qs.w(qs.db.Where("created_at = ?", createdAt))
+}
+
+// CreatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) CreatedAtGt(createdAt time.Time) DocumentQuerySet {
+	return qs.w(qs.db.Where("created_at > ?", createdAt))
+}
+
+// CreatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) CreatedAtGte(createdAt time.Time) DocumentQuerySet {
+	return qs.w(qs.db.Where("created_at >= ?", createdAt))
+}
+
+// CreatedAtInRange filters rows with CreatedAt in range [from, to)
+// nolint: dupl
+func (qs DocumentQuerySet) CreatedAtInRange(from time.Time, to time.Time) DocumentQuerySet {
+	return qs.w(qs.db.Where("created_at >= ? AND created_at < ?", from, to))
+}
+
+// CreatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) CreatedAtLt(createdAt time.Time) DocumentQuerySet {
+	return qs.w(qs.db.Where("created_at < ?", createdAt))
+}
+
+// CreatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) CreatedAtLte(createdAt time.Time) DocumentQuerySet {
+	return qs.w(qs.db.Where("created_at <= ?", createdAt))
+}
+
+// CreatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) CreatedAtNe(createdAt time.Time) DocumentQuerySet {
+	return qs.w(qs.db.Where("created_at != ?", createdAt))
+}
+
+// CreatedAtWithin filters rows with CreatedAt not earlier than d ago
+// nolint: dupl
+func (qs DocumentQuerySet) CreatedAtWithin(d time.Duration) DocumentQuerySet {
+	return qs.w(qs.db.Where("created_at >= ?", time.Now().Add(-d)))
+}
+
+// DataIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) DataIsNotNull() DocumentQuerySet {
+	return qs.w(qs.db.Where("data IS NOT NULL"))
+}
+
+// DataIsNull is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) DataIsNull() DocumentQuerySet {
+	return qs.w(qs.db.Where("data IS NULL"))
+}
+
+// DataJSONEq filters rows with text value of Data at path equal to value,
+// path is a dot-separated list of keys, e.g. "address.city"
+// nolint: dupl
+func (qs DocumentQuerySet) DataJSONEq(path string, value string) DocumentQuerySet {
+	return qs.w(whereJSONEq(qs.db, "data", path, value))
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) Delete() error {
+	return qs.db.Delete(&Document{}).Error
+}
+
+// DeleteNum is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) DeleteNum() (int64, error) {
+	db := qs.db.Delete(&Document{})
+	return db.RowsAffected, db.Error
+}
+
+// DeleteNumUnscoped is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) DeleteNumUnscoped() (int64, error) {
+	db := qs.db.Unscoped().Delete(&Document{})
+	return db.RowsAffected, db.Error
+}
+
+// DeletedAtBetween filters rows with DeletedAt in range [from, to]
+// nolint: dupl
+func (qs DocumentQuerySet) DeletedAtBetween(from time.Time, to time.Time) DocumentQuerySet {
+	return qs.w(qs.db.Where("deleted_at BETWEEN ? AND ?", from, to))
+}
+
+// DeletedAtEq is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) DeletedAtEq(deletedAt time.Time) DocumentQuerySet {
+	return qs.w(qs.db.Where("deleted_at = ?", deletedAt))
+}
+
+// DeletedAtGt is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) DeletedAtGt(deletedAt time.Time) DocumentQuerySet {
+	return qs.w(qs.db.Where("deleted_at > ?", deletedAt))
+}
+
+// DeletedAtGte is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) DeletedAtGte(deletedAt time.Time) DocumentQuerySet {
+	return qs.w(qs.db.Where("deleted_at >= ?", deletedAt))
+}
+
+// DeletedAtInRange filters rows with DeletedAt in range [from, to)
+// nolint: dupl
+func (qs DocumentQuerySet) DeletedAtInRange(from time.Time, to time.Time) DocumentQuerySet {
+	return qs.w(qs.db.Where("deleted_at >= ? AND deleted_at < ?", from, to))
+}
+
+// DeletedAtIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) DeletedAtIsNotNull() DocumentQuerySet {
+	return qs.w(qs.db.Where("deleted_at IS NOT NULL"))
+}
+
+// DeletedAtIsNull is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) DeletedAtIsNull() DocumentQuerySet {
+	return qs.w(qs.db.Where("deleted_at IS NULL"))
+}
+
+// DeletedAtLt is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) DeletedAtLt(deletedAt time.Time) DocumentQuerySet {
+	return qs.w(qs.db.Where("deleted_at < ?", deletedAt))
+}
+
+// DeletedAtLte is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) DeletedAtLte(deletedAt time.Time) DocumentQuerySet {
+	return qs.w(qs.db.Where("deleted_at <= ?", deletedAt))
+}
+
+// DeletedAtNe is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) DeletedAtNe(deletedAt time.Time) DocumentQuerySet {
+	return qs.w(qs.db.Where("deleted_at != ?", deletedAt))
+}
+
+// DeletedAtWithin filters rows with DeletedAt not earlier than d ago
+// nolint: dupl
+func (qs DocumentQuerySet) DeletedAtWithin(d time.Duration) DocumentQuerySet {
+	return qs.w(qs.db.Where("deleted_at >= ?", time.Now().Add(-d)))
+}
+
+// GetDB is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) GetDB() *gorm.DB {
+	return qs.db
+}
+
+// GetUpdater is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) GetUpdater() DocumentUpdater {
+	return NewDocumentUpdater(qs.db)
+}
+
+// GroupByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) GroupByCreatedAt() DocumentQuerySet {
+	return qs.w(qs.db.Group("created_at"))
+}
+
+// GroupByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) GroupByDeletedAt() DocumentQuerySet {
+	return qs.w(qs.db.Group("deleted_at"))
+}
+
+// GroupByID is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) GroupByID() DocumentQuerySet {
+	return qs.w(qs.db.Group("id"))
+}
+
+// GroupByNote is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) GroupByNote() DocumentQuerySet {
+	return qs.w(qs.db.Group("note"))
+}
+
+// GroupByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) GroupByUpdatedAt() DocumentQuerySet {
+	return qs.w(qs.db.Group("updated_at"))
+}
+
+// HavingCountEq is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) HavingCountEq(count int) DocumentQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) = ?", count))
+}
+
+// HavingCountGt is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) HavingCountGt(count int) DocumentQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) > ?", count))
+}
+
+// HavingCountGte is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) HavingCountGte(count int) DocumentQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) >= ?", count))
+}
+
+// HavingCountLt is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) HavingCountLt(count int) DocumentQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) < ?", count))
+}
+
+// HavingCountLte is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) HavingCountLte(count int) DocumentQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) <= ?", count))
+}
+
+// HavingCountNe is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) HavingCountNe(count int) DocumentQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) != ?", count))
+}
+
+// IDBetween filters rows with ID in range [from, to]
+// nolint: dupl
+func (qs DocumentQuerySet) IDBetween(from uint, to uint) DocumentQuerySet {
+	return qs.w(qs.db.Where("id BETWEEN ? AND ?", from, to))
+}
+
+// IDEq is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) IDEq(ID uint) DocumentQuerySet {
+	return qs.w(qs.db.Where("id = ?", ID))
+}
+
+// IDGt is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) IDGt(ID uint) DocumentQuerySet {
+	return qs.w(qs.db.Where("id > ?", ID))
+}
+
+// IDGte is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) IDGte(ID uint) DocumentQuerySet {
+	return qs.w(qs.db.Where("id >= ?", ID))
+}
+
+// IDIn is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) IDIn(ID ...uint) DocumentQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("id IN (?)", ID))
+}
+
+// IDInQuery filters rows with ID IN values selected by subquery q
+// nolint: dupl
+func (qs DocumentQuerySet) IDInQuery(q UintSubquery) DocumentQuerySet {
+	return qs.w(qs.db.Where("id IN (?)", q.expr()))
+}
+
+// IDInRange filters rows with ID in range [from, to)
+// nolint: dupl
+func (qs DocumentQuerySet) IDInRange(from uint, to uint) DocumentQuerySet {
+	return qs.w(qs.db.Where("id >= ? AND id < ?", from, to))
+}
+
+// IDLt is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) IDLt(ID uint) DocumentQuerySet {
+	return qs.w(qs.db.Where("id < ?", ID))
+}
+
+// IDLte is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) IDLte(ID uint) DocumentQuerySet {
+	return qs.w(qs.db.Where("id <= ?", ID))
+}
+
+// IDNe is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) IDNe(ID uint) DocumentQuerySet {
+	return qs.w(qs.db.Where("id != ?", ID))
+}
+
+// IDNotIn is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) IDNotIn(ID ...uint) DocumentQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("id NOT IN (?)", ID))
+}
+
+// IDNotInQuery filters rows with ID NOT IN values selected by subquery q
+// nolint: dupl
+func (qs DocumentQuerySet) IDNotInQuery(q UintSubquery) DocumentQuerySet {
+	return qs.w(qs.db.Where("id NOT IN (?)", q.expr()))
+}
+
+// InTx returns copy bound to transaction tx: queries are executed in it.
+// Gorm v1 can't move conditions into another connection, so with gorm v1
+// InTx must be called before any condition is set, otherwise queries fail.
+// Context and preloads are moved into transaction by both gorm versions.
+// nolint: dupl
+func (qs DocumentQuerySet) InTx(tx *gorm.DB) DocumentQuerySet {
+	ctx := tx.Statement.Context
+	if qs.db.Statement.Context != context.Background() {
+		ctx = qs.db.Statement.Context // set by WithContext
+	}
+	db := qs.db.Session(&gorm.Session{Context: ctx})
+	db.Statement.ConnPool = tx.Statement.ConnPool
+	if tx.Error != nil {
+		db.AddError(tx.Error)
+	}
+	return qs.w(db)
+}
+
+// Iterate scans rows one by one and calls fn for every row without
+// loading all rows into memory. It stops on the first error of fn and
+// returns it. Preloads aren't applied.
+// nolint: dupl
+func (qs DocumentQuerySet) Iterate(fn func(*Document) error) error {
+	db := qs.db
+	if db.Error != nil {
+		return db.Error // gorm v1 doesn't check it in Rows
+	}
+
+	rows, err := db.Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var o Document
+		if err = db.ScanRows(rows, &o); err != nil {
+			return err
+		}
+		if err = fn(&o); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// Limit is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) Limit(limit int) DocumentQuerySet {
+	return qs.w(qs.db.Limit(limit))
+}
+
+// MaxCreatedAt returns MAX of CreatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs DocumentQuerySet) MaxCreatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := qs.db.Select("created_at AS value").Where("created_at IS NOT NULL").
+		Order(clause.OrderByColumn{
+			Column:  clause.Column{Name: "created_at", Raw: true},
+			Desc:    true,
+			Reorder: true,
+		}).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MaxID returns MAX of ID, it's zero value if there are no rows
+// nolint: dupl
+func (qs DocumentQuerySet) MaxID() (uint, error) {
+	var ret uint
+	var res struct {
+		Value *uint
+	}
+	err := qs.db.Select("MAX(id) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// MaxUpdatedAt returns MAX of UpdatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs DocumentQuerySet) MaxUpdatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := qs.db.Select("updated_at AS value").Where("updated_at IS NOT NULL").
+		Order(clause.OrderByColumn{
+			Column:  clause.Column{Name: "updated_at", Raw: true},
+			Desc:    true,
+			Reorder: true,
+		}).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MinCreatedAt returns MIN of CreatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs DocumentQuerySet) MinCreatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := qs.db.Select("created_at AS value").Where("created_at IS NOT NULL").
+		Order(clause.OrderByColumn{
+			Column:  clause.Column{Name: "created_at", Raw: true},
+			Desc:    false,
+			Reorder: true,
+		}).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MinID returns MIN of ID, it's zero value if there are no rows
+// nolint: dupl
+func (qs DocumentQuerySet) MinID() (uint, error) {
+	var ret uint
+	var res struct {
+		Value *uint
+	}
+	err := qs.db.Select("MIN(id) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// MinUpdatedAt returns MIN of UpdatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs DocumentQuerySet) MinUpdatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := qs.db.Select("updated_at AS value").Where("updated_at IS NOT NULL").
+		Order(clause.OrderByColumn{
+			Column:  clause.Column{Name: "updated_at", Raw: true},
+			Desc:    false,
+			Reorder: true,
+		}).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// NoteEndsWith filters rows with Note ending with the argument
+// nolint: dupl
+func (qs DocumentQuerySet) NoteEndsWith(note string) DocumentQuerySet {
+	return qs.w(whereLike(qs.db, "note", "%", note, "", false))
+}
+
+// NoteEq is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) NoteEq(note string) DocumentQuerySet {
+	return qs.w(qs.db.Where("note = ?", note))
+}
+
+// NoteGt is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) NoteGt(note string) DocumentQuerySet {
+	return qs.w(qs.db.Where("note > ?", note))
+}
+
+// NoteGte is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) NoteGte(note string) DocumentQuerySet {
+	return qs.w(qs.db.Where("note >= ?", note))
+}
+
+// NoteIContains filters rows with Note containing the argument, case is ignored
+// nolint: dupl
+func (qs DocumentQuerySet) NoteIContains(note string) DocumentQuerySet {
+	return qs.w(whereLike(qs.db, "note", "%", note, "%", true))
+}
+
+// NoteIEq filters rows with Note equal to the argument, case is ignored
+// nolint: dupl
+func (qs DocumentQuerySet) NoteIEq(note string) DocumentQuerySet {
+	return qs.w(whereLike(qs.db, "note", "", note, "", true))
+}
+
+// NoteIn is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) NoteIn(note ...string) DocumentQuerySet {
+	if len(note) == 0 {
+		qs.db.AddError(errors.New("must at least pass one note in NoteIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("note IN (?)", note))
+}
+
+// NoteInQuery filters rows with Note IN values selected by subquery q
+// nolint: dupl
+func (qs DocumentQuerySet) NoteInQuery(q StringSubquery) DocumentQuerySet {
+	return qs.w(qs.db.Where("note IN (?)", q.expr()))
+}
+
+// NoteIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) NoteIsNotNull() DocumentQuerySet {
+	return qs.w(qs.db.Where("note IS NOT NULL"))
+}
+
+// NoteIsNull is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) NoteIsNull() DocumentQuerySet {
+	return qs.w(qs.db.Where("note IS NULL"))
+}
+
+// NoteLike is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) NoteLike(note string) DocumentQuerySet {
+	return qs.w(qs.db.Where("note LIKE ?", note))
+}
+
+// NoteLt is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) NoteLt(note string) DocumentQuerySet {
+	return qs.w(qs.db.Where("note < ?", note))
+}
+
+// NoteLte is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) NoteLte(note string) DocumentQuerySet {
+	return qs.w(qs.db.Where("note <= ?", note))
+}
+
+// NoteNe is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) NoteNe(note string) DocumentQuerySet {
+	return qs.w(qs.db.Where("note != ?", note))
+}
+
+// NoteNotIn is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) NoteNotIn(note ...string) DocumentQuerySet {
+	if len(note) == 0 {
+		qs.db.AddError(errors.New("must at least pass one note in NoteNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("note NOT IN (?)", note))
+}
+
+// NoteNotInQuery filters rows with Note NOT IN values selected by subquery q
+// nolint: dupl
+func (qs DocumentQuerySet) NoteNotInQuery(q StringSubquery) DocumentQuerySet {
+	return qs.w(qs.db.Where("note NOT IN (?)", q.expr()))
+}
+
+// NoteNotlike is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) NoteNotlike(note string) DocumentQuerySet {
+	return qs.w(qs.db.Where("note NOT LIKE ?", note))
+}
+
+// NoteStartsWith filters rows with Note starting with the argument
+// nolint: dupl
+func (qs DocumentQuerySet) NoteStartsWith(note string) DocumentQuerySet {
+	return qs.w(whereLike(qs.db, "note", "", note, "%", false))
+}
+
+// Offset is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) Offset(offset int) DocumentQuerySet {
+	return qs.w(qs.db.Offset(offset))
+}
+
+// One is used to retrieve one result. It returns gorm.ErrRecordNotFound
+// if nothing was fetched
+func (qs DocumentQuerySet) One(ret *Document) error {
+	return qs.db.First(ret).Error
+}
+
+// OrderAscByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) OrderAscByCreatedAt() DocumentQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("created_at ASC"), "created_at", false, false))
+}
+
+// OrderAscByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) OrderAscByDeletedAt() DocumentQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("deleted_at ASC"), "deleted_at", false, false))
+}
+
+// OrderAscByID is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) OrderAscByID() DocumentQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("id ASC"), "id", false, false))
+}
+
+// OrderAscByNote is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) OrderAscByNote() DocumentQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("note ASC"), "note", false, false))
+}
+
+// OrderAscByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) OrderAscByUpdatedAt() DocumentQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("updated_at ASC"), "updated_at", false, false))
+}
+
+// OrderDescByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) OrderDescByCreatedAt() DocumentQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("created_at DESC"), "created_at", true, false))
+}
+
+// OrderDescByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) OrderDescByDeletedAt() DocumentQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("deleted_at DESC"), "deleted_at", true, false))
+}
+
+// OrderDescByID is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) OrderDescByID() DocumentQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("id DESC"), "id", true, false))
+}
+
+// OrderDescByNote is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) OrderDescByNote() DocumentQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("note DESC"), "note", true, false))
+}
+
+// OrderDescByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) OrderDescByUpdatedAt() DocumentQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("updated_at DESC"), "updated_at", true, false))
+}
+
+// Page fetches at most size rows following cursor into ret, it uses
+// orders set by OrderAscBy/OrderDescBy methods and primary key to break ties.
+// Ordered fields can't be nullable: rows with NULL can't be compared with
+// cursor, so error is returned for them. Empty cursor means the first page. It returns cursor of the next page or
+// empty string if there are no more rows.
+// nolint: dupl
+func (qs DocumentQuerySet) Page(cursor string, size int, ret *[]Document) (string, error) {
+	if size <= 0 {
+		return "", errors.New("page size must be positive")
+	}
+
+	var last Document
+	db, orders, err := pageQuery(qs.db, "", "id", cursor, qs.fieldPtrs(&last))
+	if err != nil {
+		return "", err
+	}
+
+	if err = db.Limit(size + 1).Find(ret).Error; err != nil {
+		return "", err
+	}
+	if len(*ret) <= size {
+		return "", nil
+	}
+
+	*ret = (*ret)[:size]
+	return encodePageCursor(orders, qs.fieldPtrs(&(*ret)[size-1]))
+}
+
+// SelectID returns subquery selecting ID of rows of queryset
+// for InQuery and NotInQuery filters
+// nolint: dupl
+func (qs DocumentQuerySet) SelectID() UintSubquery {
+	return UintSubquery{db: qs.db.Select("id")}
+}
+
+// UpdatedAtBetween filters rows with UpdatedAt in range [from, to]
+// nolint: dupl
+func (qs DocumentQuerySet) UpdatedAtBetween(from time.Time, to time.Time) DocumentQuerySet {
+	return qs.w(qs.db.Where("updated_at BETWEEN ? AND ?", from, to))
+}
+
+// UpdatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) UpdatedAtEq(updatedAt time.Time) DocumentQuerySet {
+	return qs.w(qs.db.Where("updated_at = ?", updatedAt))
+}
+
+// UpdatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) UpdatedAtGt(updatedAt time.Time) DocumentQuerySet {
+	return qs.w(qs.db.Where("updated_at > ?", updatedAt))
+}
+
+// UpdatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) UpdatedAtGte(updatedAt time.Time) DocumentQuerySet {
+	return qs.w(qs.db.Where("updated_at >= ?", updatedAt))
+}
+
+// UpdatedAtInRange filters rows with UpdatedAt in range [from, to)
+// nolint: dupl
+func (qs DocumentQuerySet) UpdatedAtInRange(from time.Time, to time.Time) DocumentQuerySet {
+	return qs.w(qs.db.Where("updated_at >= ? AND updated_at < ?", from, to))
+}
+
+// UpdatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) UpdatedAtLt(updatedAt time.Time) DocumentQuerySet {
+	return qs.w(qs.db.Where("updated_at < ?", updatedAt))
+}
+
+// UpdatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) UpdatedAtLte(updatedAt time.Time) DocumentQuerySet {
+	return qs.w(qs.db.Where("updated_at <= ?", updatedAt))
+}
+
+// UpdatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs DocumentQuerySet) UpdatedAtNe(updatedAt time.Time) DocumentQuerySet {
+	return qs.w(qs.db.Where("updated_at != ?", updatedAt))
+}
+
+// UpdatedAtWithin filters rows with UpdatedAt not earlier than d ago
+// nolint: dupl
+func (qs DocumentQuerySet) UpdatedAtWithin(d time.Duration) DocumentQuerySet {
+	return qs.w(qs.db.Where("updated_at >= ?", time.Now().Add(-d)))
+}
+
+// WithContext returns copy executing queries with context ctx.
+// Gorm v1 doesn't support context: its error is checked only before query.
+// nolint: dupl
+func (qs DocumentQuerySet) WithContext(ctx context.Context) DocumentQuerySet {
+	return qs.w(DBWithContext(ctx, qs.db))
+}
+
+// fieldPtrs returns pointers to fields of o by their columns
+// nolint: dupl
+func (qs DocumentQuerySet) fieldPtrs(o *Document) map[string]interface{} {
+	return map[string]interface{}{
+		"id":         &o.ID,
+		"created_at": &o.CreatedAt,
+		"updated_at": &o.UpdatedAt,
+		"deleted_at": &o.DeletedAt,
+		"data":       &o.Data,
+		"attrs":      &jsonColumnValue{o.Attrs},
+		"note":       &o.Note,
+	}
+}
+
+// InTx returns copy bound to transaction tx: queries are executed in it.
+// Gorm v1 can't move conditions into another connection, so with gorm v1
+// InTx must be called before any condition is set, otherwise queries fail.
+// Context and preloads are moved into transaction by both gorm versions.
+// nolint: dupl
+func (u DocumentUpdater) InTx(tx *gorm.DB) DocumentUpdater {
+	ctx := tx.Statement.Context
+	if u.db.Statement.Context != context.Background() {
+		ctx = u.db.Statement.Context // set by WithContext
+	}
+	db := u.db.Session(&gorm.Session{Context: ctx})
+	db.Statement.ConnPool = tx.Statement.ConnPool
+	if tx.Error != nil {
+		db.AddError(tx.Error)
+	}
+	u.db = db
+	return u
+}
+
+// SetAttrs is an autogenerated method
+// nolint: dupl
+func (u DocumentUpdater) SetAttrs(attrs map[string]string) DocumentUpdater {
+	u.fields[string(DocumentDBSchema.Attrs)] = jsonColumnValue{attrs}
+	return u
+}
+
+// SetCreatedAt is an autogenerated method
+// nolint: dupl
+func (u DocumentUpdater) SetCreatedAt(createdAt time.Time) DocumentUpdater {
+	u.fields[string(DocumentDBSchema.CreatedAt)] = createdAt
+	return u
+}
+
+// SetData is an autogenerated method
+// nolint: dupl
+func (u DocumentUpdater) SetData(data json.RawMessage) DocumentUpdater {
+	u.fields[string(DocumentDBSchema.Data)] = data
+	return u
+}
+
+// SetDeletedAt is an autogenerated method
+// nolint: dupl
+func (u DocumentUpdater) SetDeletedAt(deletedAt time.Time) DocumentUpdater {
+	u.fields[string(DocumentDBSchema.DeletedAt)] = deletedAt
+	return u
+}
+
+// SetDeletedAtNull sets DeletedAt to NULL
+// nolint: dupl
+func (u DocumentUpdater) SetDeletedAtNull() DocumentUpdater {
+	u.fields[string(DocumentDBSchema.DeletedAt)] = nil
+	return u
+}
+
+// SetID is an autogenerated method
+// nolint: dupl
+func (u DocumentUpdater) SetID(ID uint) DocumentUpdater {
+	u.fields[string(DocumentDBSchema.ID)] = ID
+	return u
+}
+
+// SetNote is an autogenerated method
+// nolint: dupl
+func (u DocumentUpdater) SetNote(note string) DocumentUpdater {
+	u.fields[string(DocumentDBSchema.Note)] = note
+	return u
+}
+
+// SetNoteNull sets Note to NULL
+// nolint: dupl
+func (u DocumentUpdater) SetNoteNull() DocumentUpdater {
+	u.fields[string(DocumentDBSchema.Note)] = nil
+	return u
+}
+
+// SetUpdatedAt is an autogenerated method
+// nolint: dupl
+func (u DocumentUpdater) SetUpdatedAt(updatedAt time.Time) DocumentUpdater {
+	u.fields[string(DocumentDBSchema.UpdatedAt)] = updatedAt
+	return u
+}
+
+// Update is an autogenerated method
+// nolint: dupl
+func (u DocumentUpdater) Update() error {
+	return u.db.Updates(u.fields).Error
+}
+
+// UpdateNum is an autogenerated method
+// nolint: dupl
+func (u DocumentUpdater) UpdateNum() (int64, error) {
+	db := u.db.Updates(u.fields)
+	return db.RowsAffected, db.Error
+}
+
+// WithContext returns copy executing queries with context ctx.
+// Gorm v1 doesn't support context: its error is checked only before query.
+// nolint: dupl
+func (u DocumentUpdater) WithContext(ctx context.Context) DocumentUpdater {
+	u.db = DBWithContext(ctx, u.db)
+	return u
+}
+
+// ===== END of query set DocumentQuerySet
+
+// ===== BEGIN of Document modifiers
+
+// DocumentDBSchemaField describes database schema field. It requires for method 'Update'
+type DocumentDBSchemaField string
+
+// String method returns string representation of field.
+// nolint: dupl
+func (f DocumentDBSchemaField) String() string {
+	return string(f)
+}
+
+// DocumentDBSchema stores db field names of Document
+var DocumentDBSchema = struct {
+	ID        DocumentDBSchemaField
+	CreatedAt DocumentDBSchemaField
+	UpdatedAt DocumentDBSchemaField
+	DeletedAt DocumentDBSchemaField
+	Data      DocumentDBSchemaField
+	Attrs     DocumentDBSchemaField
+	Note      DocumentDBSchemaField
+}{
+
+	ID:        DocumentDBSchemaField("id"),
+	CreatedAt: DocumentDBSchemaField("created_at"),
+	UpdatedAt: DocumentDBSchemaField("updated_at"),
+	DeletedAt: DocumentDBSchemaField("deleted_at"),
+	Data:      DocumentDBSchemaField("data"),
+	Attrs:     DocumentDBSchemaField("attrs"),
+	Note:      DocumentDBSchemaField("note"),
+}
+
+// Update updates Document fields by primary key
+// nolint: dupl
+func (o *Document) Update(db *gorm.DB, fields ...DocumentDBSchemaField) error {
+	dbNameToFieldName := map[string]interface{}{
+		"id":         o.ID,
+		"created_at": o.CreatedAt,
+		"updated_at": o.UpdatedAt,
+		"deleted_at": o.DeletedAt,
+		"data":       o.Data,
+		"attrs":      jsonColumnValue{o.Attrs},
+		"note":       o.Note,
+	}
+	u := map[string]interface{}{}
+	for _, f := range fields {
+		fs := f.String()
+		u[fs] = dbNameToFieldName[fs]
+	}
+	if err := db.Model(o).Updates(u).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+
+		return fmt.Errorf("can't update Document %v fields %v: %s",
+			o, fields, err)
+	}
+
+	return nil
+}
+
+// Upsert inserts Document or updates updateFields of existing row
+// if insert violates unique constraint on conflictFields. Row isn't
+// changed if there are no updateFields: any conflict is ignored then if
+// there are no conflictFields. MySQL ignores conflictFields: it checks
+// all unique constraints, other databases require them to update row.
+// nolint: dupl
+func (o *Document) Upsert(db *gorm.DB, conflictFields []DocumentDBSchemaField,
+	updateFields ...DocumentDBSchemaField) error {
+
+	conflictColumns := make([]string, 0, len(conflictFields))
+	for _, f := range conflictFields {
+		conflictColumns = append(conflictColumns, f.String())
+	}
+	updateColumns := make([]string, 0, len(updateFields))
+	for _, f := range updateFields {
+		updateColumns = append(updateColumns, f.String())
+	}
+
+	if err := checkConflictFields(db, conflictColumns, updateColumns); err != nil {
+		return err
+	}
+
+	onConflict := clause.OnConflict{
+		DoNothing: len(updateColumns) == 0,
+	}
+	for _, c := range conflictColumns {
+		onConflict.Columns = append(onConflict.Columns, clause.Column{Name: c})
+	}
+	if len(updateColumns) != 0 {
+		onConflict.DoUpdates = clause.AssignmentColumns(updateColumns)
+	}
+
+	return db.Clauses(onConflict).Create(o).Error
+}
+
+// DocumentUpdater is an Document updates manager
+type DocumentUpdater struct {
+	fields map[string]interface{}
+	db     *gorm.DB
+}
+
+// NewDocumentUpdater creates new Document updater
+// nolint: dupl
+func NewDocumentUpdater(db *gorm.DB) DocumentUpdater {
+	return DocumentUpdater{
+		fields: map[string]interface{}{},
+		db:     db.Model(&Document{}),
+	}
+}
+
+// ===== END of Document modifiers
+
+// ===== BEGIN of Document predicates
+
+// DocumentPredicate is a condition on Document fields for Where and Not
+// methods of DocumentQuerySet: predicates are created by DocumentQ and
+// combined by And, Or and Not. Zero predicate matches all rows.
+type DocumentPredicate struct {
+	expr string
+	args []interface{}
+	err  error
+}
+
+// join combines predicates by operation op, empty predicates are skipped
+// nolint: dupl
+func (p DocumentPredicate) join(op string, preds []DocumentPredicate) DocumentPredicate {
+	for _, q := range preds {
+		if p.err == nil {
+			p.err = q.err
+		}
+		if q.expr == "" {
+			continue
+		}
+		if p.expr == "" {
+			p.expr, p.args = q.expr, q.args
+			continue
+		}
+
+		p.expr = "(" + p.expr + ") " + op + " (" + q.expr + ")"
+		p.args = append(append([]interface{}{}, p.args...), q.args...)
+	}
+
+	return p
+}
+
+// And returns predicate matching rows matched by p and all preds
+// nolint: dupl
+func (p DocumentPredicate) And(preds ...DocumentPredicate) DocumentPredicate {
+	return p.join("AND", preds)
+}
+
+// Or returns predicate matching rows matched by p or any of preds
+// nolint: dupl
+func (p DocumentPredicate) Or(preds ...DocumentPredicate) DocumentPredicate {
+	return p.join("OR", preds)
+}
+
+// Not returns predicate matching rows not matched by p
+// nolint: dupl
+func (p DocumentPredicate) Not() DocumentPredicate {
+	if p.expr != "" {
+		p.expr = "NOT (" + p.expr + ")"
+	}
+
+	return p
+}
+
+// DocumentPredicates creates predicates by Document fields
+type DocumentPredicates struct{}
+
+// DocumentQ creates predicates by Document fields
+var DocumentQ DocumentPredicates
+
+// AttrsIsNotNull creates predicate "attrs IS NOT NULL"
+// nolint: dupl
+func (DocumentPredicates) AttrsIsNotNull() DocumentPredicate {
+	return DocumentPredicate{expr: "attrs IS NOT NULL"}
+}
+
+// AttrsIsNull creates predicate "attrs IS NULL"
+// nolint: dupl
+func (DocumentPredicates) AttrsIsNull() DocumentPredicate {
+	return DocumentPredicate{expr: "attrs IS NULL"}
+}
+
+// CreatedAtEq creates predicate "created_at = ?"
+// nolint: dupl
+func (DocumentPredicates) CreatedAtEq(createdAt time.Time) DocumentPredicate {
+	return DocumentPredicate{expr: "created_at = ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtGt creates predicate "created_at > ?"
+// nolint: dupl
+func (DocumentPredicates) CreatedAtGt(createdAt time.Time) DocumentPredicate {
+	return DocumentPredicate{expr: "created_at > ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtGte creates predicate "created_at >= ?"
+// nolint: dupl
+func (DocumentPredicates) CreatedAtGte(createdAt time.Time) DocumentPredicate {
+	return DocumentPredicate{expr: "created_at >= ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtLt creates predicate "created_at < ?"
+// nolint: dupl
+func (DocumentPredicates) CreatedAtLt(createdAt time.Time) DocumentPredicate {
+	return DocumentPredicate{expr: "created_at < ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtLte creates predicate "created_at <= ?"
+// nolint: dupl
+func (DocumentPredicates) CreatedAtLte(createdAt time.Time) DocumentPredicate {
+	return DocumentPredicate{expr: "created_at <= ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtNe creates predicate "created_at != ?"
+// nolint: dupl
+func (DocumentPredicates) CreatedAtNe(createdAt time.Time) DocumentPredicate {
+	return DocumentPredicate{expr: "created_at != ?", args: []interface{}{createdAt}}
+}
+
+// DataIsNotNull creates predicate "data IS NOT NULL"
+// nolint: dupl
+func (DocumentPredicates) DataIsNotNull() DocumentPredicate {
+	return DocumentPredicate{expr: "data IS NOT NULL"}
+}
+
+// DataIsNull creates predicate "data IS NULL"
+// nolint: dupl
+func (DocumentPredicates) DataIsNull() DocumentPredicate {
+	return DocumentPredicate{expr: "data IS NULL"}
+}
+
+// DeletedAtEq creates predicate "deleted_at = ?"
+// nolint: dupl
+func (DocumentPredicates) DeletedAtEq(deletedAt time.Time) DocumentPredicate {
+	return DocumentPredicate{expr: "deleted_at = ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtGt creates predicate "deleted_at > ?"
+// nolint: dupl
+func (DocumentPredicates) DeletedAtGt(deletedAt time.Time) DocumentPredicate {
+	return DocumentPredicate{expr: "deleted_at > ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtGte creates predicate "deleted_at >= ?"
+// nolint: dupl
+func (DocumentPredicates) DeletedAtGte(deletedAt time.Time) DocumentPredicate {
+	return DocumentPredicate{expr: "deleted_at >= ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtIsNotNull creates predicate "deleted_at IS NOT NULL"
+// nolint: dupl
+func (DocumentPredicates) DeletedAtIsNotNull() DocumentPredicate {
+	return DocumentPredicate{expr: "deleted_at IS NOT NULL"}
+}
+
+// DeletedAtIsNull creates predicate "deleted_at IS NULL"
+// nolint: dupl
+func (DocumentPredicates) DeletedAtIsNull() DocumentPredicate {
+	return DocumentPredicate{expr: "deleted_at IS NULL"}
+}
+
+// DeletedAtLt creates predicate "deleted_at < ?"
+// nolint: dupl
+func (DocumentPredicates) DeletedAtLt(deletedAt time.Time) DocumentPredicate {
+	return DocumentPredicate{expr: "deleted_at < ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtLte creates predicate "deleted_at <= ?"
+// nolint: dupl
+func (DocumentPredicates) DeletedAtLte(deletedAt time.Time) DocumentPredicate {
+	return DocumentPredicate{expr: "deleted_at <= ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtNe creates predicate "deleted_at != ?"
+// nolint: dupl
+func (DocumentPredicates) DeletedAtNe(deletedAt time.Time) DocumentPredicate {
+	return DocumentPredicate{expr: "deleted_at != ?", args: []interface{}{deletedAt}}
+}
+
+// IDEq creates predicate "id = ?"
+// nolint: dupl
+func (DocumentPredicates) IDEq(ID uint) DocumentPredicate {
+	return DocumentPredicate{expr: "id = ?", args: []interface{}{ID}}
+}
+
+// IDGt creates predicate "id > ?"
+// nolint: dupl
+func (DocumentPredicates) IDGt(ID uint) DocumentPredicate {
+	return DocumentPredicate{expr: "id > ?", args: []interface{}{ID}}
+}
+
+// IDGte creates predicate "id >= ?"
+// nolint: dupl
+func (DocumentPredicates) IDGte(ID uint) DocumentPredicate {
+	return DocumentPredicate{expr: "id >= ?", args: []interface{}{ID}}
+}
+
+// IDIn creates predicate "id IN (?)"
+// nolint: dupl
+func (DocumentPredicates) IDIn(ID ...uint) DocumentPredicate {
+	if len(ID) == 0 {
+		return DocumentPredicate{err: errors.New("must at least pass one ID in IDIn")}
+	}
+	return DocumentPredicate{expr: "id IN (?)", args: []interface{}{ID}}
+}
+
+// IDLt creates predicate "id < ?"
+// nolint: dupl
+func (DocumentPredicates) IDLt(ID uint) DocumentPredicate {
+	return DocumentPredicate{expr: "id < ?", args: []interface{}{ID}}
+}
+
+// IDLte creates predicate "id <= ?"
+// nolint: dupl
+func (DocumentPredicates) IDLte(ID uint) DocumentPredicate {
+	return DocumentPredicate{expr: "id <= ?", args: []interface{}{ID}}
+}
+
+// IDNe creates predicate "id != ?"
+// nolint: dupl
+func (DocumentPredicates) IDNe(ID uint) DocumentPredicate {
+	return DocumentPredicate{expr: "id != ?", args: []interface{}{ID}}
+}
+
+// IDNotIn creates predicate "id NOT IN (?)"
+// nolint: dupl
+func (DocumentPredicates) IDNotIn(ID ...uint) DocumentPredicate {
+	if len(ID) == 0 {
+		return DocumentPredicate{err: errors.New("must at least pass one ID in IDNotIn")}
+	}
+	return DocumentPredicate{expr: "id NOT IN (?)", args: []interface{}{ID}}
+}
+
+// NoteEq creates predicate "note = ?"
+// nolint: dupl
+func (DocumentPredicates) NoteEq(note string) DocumentPredicate {
+	return DocumentPredicate{expr: "note = ?", args: []interface{}{note}}
+}
+
+// NoteGt creates predicate "note > ?"
+// nolint: dupl
+func (DocumentPredicates) NoteGt(note string) DocumentPredicate {
+	return DocumentPredicate{expr: "note > ?", args: []interface{}{note}}
+}
+
+// NoteGte creates predicate "note >= ?"
+// nolint: dupl
+func (DocumentPredicates) NoteGte(note string) DocumentPredicate {
+	return DocumentPredicate{expr: "note >= ?", args: []interface{}{note}}
+}
+
+// NoteIn creates predicate "note IN (?)"
+// nolint: dupl
+func (DocumentPredicates) NoteIn(note ...string) DocumentPredicate {
+	if len(note) == 0 {
+		return DocumentPredicate{err: errors.New("must at least pass one note in NoteIn")}
+	}
+	return DocumentPredicate{expr: "note IN (?)", args: []interface{}{note}}
+}
+
+// NoteIsNotNull creates predicate "note IS NOT NULL"
+// nolint: dupl
+func (DocumentPredicates) NoteIsNotNull() DocumentPredicate {
+	return DocumentPredicate{expr: "note IS NOT NULL"}
+}
+
+// NoteIsNull creates predicate "note IS NULL"
+// nolint: dupl
+func (DocumentPredicates) NoteIsNull() DocumentPredicate {
+	return DocumentPredicate{expr: "note IS NULL"}
+}
+
+// NoteLike creates predicate "note LIKE ?"
+// nolint: dupl
+func (DocumentPredicates) NoteLike(note string) DocumentPredicate {
+	return DocumentPredicate{expr: "note LIKE ?", args: []interface{}{note}}
+}
+
+// NoteLt creates predicate "note < ?"
+// nolint: dupl
+func (DocumentPredicates) NoteLt(note string) DocumentPredicate {
+	return DocumentPredicate{expr: "note < ?", args: []interface{}{note}}
+}
+
+// NoteLte creates predicate "note <= ?"
+// nolint: dupl
+func (DocumentPredicates) NoteLte(note string) DocumentPredicate {
+	return DocumentPredicate{expr: "note <= ?", args: []interface{}{note}}
+}
+
+// NoteNe creates predicate "note != ?"
+// nolint: dupl
+func (DocumentPredicates) NoteNe(note string) DocumentPredicate {
+	return DocumentPredicate{expr: "note != ?", args: []interface{}{note}}
+}
+
+// NoteNotIn creates predicate "note NOT IN (?)"
+// nolint: dupl
+func (DocumentPredicates) NoteNotIn(note ...string) DocumentPredicate {
+	if len(note) == 0 {
+		return DocumentPredicate{err: errors.New("must at least pass one note in NoteNotIn")}
+	}
+	return DocumentPredicate{expr: "note NOT IN (?)", args: []interface{}{note}}
+}
+
+// NoteNotlike creates predicate "note NOT LIKE ?"
+// nolint: dupl
+func (DocumentPredicates) NoteNotlike(note string) DocumentPredicate {
+	return DocumentPredicate{expr: "note NOT LIKE ?", args: []interface{}{note}}
+}
+
+// UpdatedAtEq creates predicate "updated_at = ?"
+// nolint: dupl
+func (DocumentPredicates) UpdatedAtEq(updatedAt time.Time) DocumentPredicate {
+	return DocumentPredicate{expr: "updated_at = ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtGt creates predicate "updated_at > ?"
+// nolint: dupl
+func (DocumentPredicates) UpdatedAtGt(updatedAt time.Time) DocumentPredicate {
+	return DocumentPredicate{expr: "updated_at > ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtGte creates predicate "updated_at >= ?"
+// nolint: dupl
+func (DocumentPredicates) UpdatedAtGte(updatedAt time.Time) DocumentPredicate {
+	return DocumentPredicate{expr: "updated_at >= ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtLt creates predicate "updated_at < ?"
+// nolint: dupl
+func (DocumentPredicates) UpdatedAtLt(updatedAt time.Time) DocumentPredicate {
+	return DocumentPredicate{expr: "updated_at < ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtLte creates predicate "updated_at <= ?"
+// nolint: dupl
+func (DocumentPredicates) UpdatedAtLte(updatedAt time.Time) DocumentPredicate {
+	return DocumentPredicate{expr: "updated_at <= ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtNe creates predicate "updated_at != ?"
+// nolint: dupl
+func (DocumentPredicates) UpdatedAtNe(updatedAt time.Time) DocumentPredicate {
+	return DocumentPredicate{expr: "updated_at != ?", args: []interface{}{updatedAt}}
+}
+
+// ===== END of Document predicates
+
+// ===== BEGIN of query set PostQuerySet
+
+// PostQuerySet is an queryset type for Post
+type PostQuerySet struct {
+	db *gorm.DB
+}
+
+// NewPostQuerySet constructs new PostQuerySet
+func NewPostQuerySet(db *gorm.DB) PostQuerySet {
+	return PostQuerySet{
+		// new session makes every chained call clone the statement,
+		// so a queryset can be safely reused as a base for other querysets
+		db: db.Model(&Post{}).Session(&gorm.Session{}),
+	}
+}
+
+func (qs PostQuerySet) w(db *gorm.DB) PostQuerySet {
+	return NewPostQuerySet(db)
+}
+
+func (qs PostQuerySet) Select(fields ...PostDBSchemaField) PostQuerySet {
+	names := []string{}
+	for _, f := range fields {
+		names = append(names, qs.column(f.String()))
+	}
+
+	return qs.w(qs.db.Select(strings.Join(names, ",")))
+}
+
+// GroupBy groups rows by fields
+func (qs PostQuerySet) GroupBy(fields ...PostDBSchemaField) PostQuerySet {
+	names := []string{}
+	for _, f := range fields {
+		names = append(names, qs.column(f.String()))
+	}
+
+	return qs.w(qs.db.Group(strings.Join(names, ",")))
+}
+
+// Where filters rows by predicate p, predicates are created by PostQ
+// nolint: dupl
+func (qs PostQuerySet) Where(p PostPredicate) PostQuerySet {
+	if p.err != nil {
+		// error is added to copy of db: qs can be reused as a base queryset
+		db := qs.db.Session(&gorm.Session{})
+		db.AddError(p.err)
+		return qs.w(db)
+	}
+	if p.expr == "" {
+		return qs
+	}
+
+	expr := strings.Replace(p.expr, "{table}", qs.quotedTableName(&Post{}), -1)
+	return qs.w(qs.db.Where(expr, p.args...))
+}
+
+// Not filters rows not matching predicate p
+// nolint: dupl
+func (qs PostQuerySet) Not(p PostPredicate) PostQuerySet {
+	return qs.Where(p.Not())
+}
+
+// Create is an autogenerated method
+// nolint: dupl
+func (o *Post) Create(db *gorm.DB) error {
+	return db.Create(o).Error
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (o *Post) Delete(db *gorm.DB) error {
+	return db.Delete(o).Error
+}
+
+// All is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) All(ret *[]Post) error {
+	return qs.db.Find(ret).Error
+}
+
+// Batches calls fn for every batch of at most size rows, batches are
+// fetched by Page: ordered by primary key after queryset orders.
+// It stops on the first error of fn and returns it.
+// nolint: dupl
+func (qs PostQuerySet) Batches(size int, fn func([]Post) error) error {
+	cursor := ""
+	for {
+		var batch []Post
+		next, err := qs.Page(cursor, size, &batch)
+		if err != nil {
+			return err
+		}
+
+		if len(batch) != 0 {
+			if err = fn(batch); err != nil {
+				return err
+			}
+		}
+
+		if next == "" {
+			return nil
+		}
+		cursor = next
+	}
+}
+
+// BlogCreatedAtBetween filters rows with BlogCreatedAt in range [from, to]
+// nolint: dupl
+func (qs PostQuerySet) BlogCreatedAtBetween(from time.Time, to time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.created_at BETWEEN ? AND ?", from, to))
+}
+
+// BlogCreatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogCreatedAtEq(blogCreatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.created_at = ?", blogCreatedAt))
+}
+
+// BlogCreatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogCreatedAtGt(blogCreatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.created_at > ?", blogCreatedAt))
+}
+
+// BlogCreatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogCreatedAtGte(blogCreatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.created_at >= ?", blogCreatedAt))
+}
+
+// BlogCreatedAtInRange filters rows with BlogCreatedAt in range [from, to)
+// nolint: dupl
+func (qs PostQuerySet) BlogCreatedAtInRange(from time.Time, to time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.created_at >= ? AND blog_join.created_at < ?", from, to))
+}
+
+// BlogCreatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogCreatedAtLt(blogCreatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.created_at < ?", blogCreatedAt))
+}
+
+// BlogCreatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogCreatedAtLte(blogCreatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.created_at <= ?", blogCreatedAt))
+}
+
+// BlogCreatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogCreatedAtNe(blogCreatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.created_at != ?", blogCreatedAt))
+}
+
+// BlogCreatedAtWithin filters rows with BlogCreatedAt not earlier than d ago
+// nolint: dupl
+func (qs PostQuerySet) BlogCreatedAtWithin(d time.Duration) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.created_at >= ?", time.Now().Add(-d)))
+}
+
+// BlogDeletedAtBetween filters rows with BlogDeletedAt in range [from, to]
+// nolint: dupl
+func (qs PostQuerySet) BlogDeletedAtBetween(from time.Time, to time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.deleted_at BETWEEN ? AND ?", from, to))
+}
+
+// BlogDeletedAtEq is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogDeletedAtEq(blogDeletedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.deleted_at = ?", blogDeletedAt))
+}
+
+// BlogDeletedAtGt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogDeletedAtGt(blogDeletedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.deleted_at > ?", blogDeletedAt))
+}
+
+// BlogDeletedAtGte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogDeletedAtGte(blogDeletedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.deleted_at >= ?", blogDeletedAt))
+}
+
+// BlogDeletedAtInRange filters rows with BlogDeletedAt in range [from, to)
+// nolint: dupl
+func (qs PostQuerySet) BlogDeletedAtInRange(from time.Time, to time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.deleted_at >= ? AND blog_join.deleted_at < ?", from, to))
+}
+
+// BlogDeletedAtIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogDeletedAtIsNotNull() PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.deleted_at IS NOT NULL"))
+}
+
+// BlogDeletedAtIsNull is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogDeletedAtIsNull() PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.deleted_at IS NULL"))
+}
+
+// BlogDeletedAtLt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogDeletedAtLt(blogDeletedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.deleted_at < ?", blogDeletedAt))
+}
+
+// BlogDeletedAtLte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogDeletedAtLte(blogDeletedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.deleted_at <= ?", blogDeletedAt))
+}
+
+// BlogDeletedAtNe is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogDeletedAtNe(blogDeletedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.deleted_at != ?", blogDeletedAt))
+}
+
+// BlogDeletedAtWithin filters rows with BlogDeletedAt not earlier than d ago
+// nolint: dupl
+func (qs PostQuerySet) BlogDeletedAtWithin(d time.Duration) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.deleted_at >= ?", time.Now().Add(-d)))
+}
+
+// BlogIDBetween filters rows with BlogID in range [from, to]
+// nolint: dupl
+func (qs PostQuerySet) BlogIDBetween(from uint, to uint) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("blog_id")+" BETWEEN ? AND ?", from, to))
+}
+
+// BlogIDEq is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogIDEq(blogID uint) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("blog_id")+" = ?", blogID))
+}
+
+// BlogIDGt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogIDGt(blogID uint) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("blog_id")+" > ?", blogID))
+}
+
+// BlogIDGte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogIDGte(blogID uint) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("blog_id")+" >= ?", blogID))
+}
+
+// BlogIDIn is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogIDIn(blogID ...uint) PostQuerySet {
+	if len(blogID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one blogID in BlogIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where(qs.column("blog_id")+" IN (?)", blogID))
+}
+
+// BlogIDInQuery filters rows with BlogID IN values selected by subquery q
+// nolint: dupl
+func (qs PostQuerySet) BlogIDInQuery(q UintSubquery) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("blog_id")+" IN (?)", q.expr()))
+}
+
+// BlogIDInRange filters rows with BlogID in range [from, to)
+// nolint: dupl
+func (qs PostQuerySet) BlogIDInRange(from uint, to uint) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("blog_id")+" >= ? AND "+qs.column("blog_id")+" < ?", from, to))
+}
+
+// BlogIDIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogIDIsNotNull() PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("blog_id") + " IS NOT NULL"))
+}
+
+// BlogIDIsNull is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogIDIsNull() PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("blog_id") + " IS NULL"))
+}
+
+// BlogIDLt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogIDLt(blogID uint) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("blog_id")+" < ?", blogID))
+}
+
+// BlogIDLte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogIDLte(blogID uint) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("blog_id")+" <= ?", blogID))
+}
+
+// BlogIDNe is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogIDNe(blogID uint) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("blog_id")+" != ?", blogID))
+}
+
+// BlogIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogIDNotIn(blogID ...uint) PostQuerySet {
+	if len(blogID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one blogID in BlogIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where(qs.column("blog_id")+" NOT IN (?)", blogID))
+}
+
+// BlogIDNotInQuery filters rows with BlogID NOT IN values selected by subquery q
+// nolint: dupl
+func (qs PostQuerySet) BlogIDNotInQuery(q UintSubquery) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("blog_id")+" NOT IN (?)", q.expr()))
+}
+
+// BlogIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogIsNotNull() PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("blog") + " IS NOT NULL"))
+}
+
+// BlogIsNull is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogIsNull() PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("blog") + " IS NULL"))
+}
+
+// BlogNameEndsWith filters rows with BlogName ending with the argument
+// nolint: dupl
+func (qs PostQuerySet) BlogNameEndsWith(blogName string) PostQuerySet {
+	return qs.w(whereLike(qs.db, "blog_join.myname", "%", blogName, "", false))
+}
+
+// BlogNameEq is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogNameEq(blogName string) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.myname = ?", blogName))
+}
+
+// BlogNameGt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogNameGt(blogName string) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.myname > ?", blogName))
+}
+
+// BlogNameGte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogNameGte(blogName string) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.myname >= ?", blogName))
+}
+
+// BlogNameIContains filters rows with BlogName containing the argument, case is ignored
+// nolint: dupl
+func (qs PostQuerySet) BlogNameIContains(blogName string) PostQuerySet {
+	return qs.w(whereLike(qs.db, "blog_join.myname", "%", blogName, "%", true))
+}
+
+// BlogNameIEq filters rows with BlogName equal to the argument, case is ignored
+// nolint: dupl
+func (qs PostQuerySet) BlogNameIEq(blogName string) PostQuerySet {
+	return qs.w(whereLike(qs.db, "blog_join.myname", "", blogName, "", true))
+}
+
+// BlogNameIn is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogNameIn(blogName ...string) PostQuerySet {
+	if len(blogName) == 0 {
+		qs.db.AddError(errors.New("must at least pass one blogName in BlogNameIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("blog_join.myname IN (?)", blogName))
+}
+
+// BlogNameInQuery filters rows with BlogName IN values selected by subquery q
+// nolint: dupl
+func (qs PostQuerySet) BlogNameInQuery(q StringSubquery) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.myname IN (?)", q.expr()))
+}
+
+// BlogNameLike is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogNameLike(blogName string) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.myname LIKE ?", blogName))
+}
+
+// BlogNameLt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogNameLt(blogName string) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.myname < ?", blogName))
+}
+
+// BlogNameLte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogNameLte(blogName string) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.myname <= ?", blogName))
+}
+
+// BlogNameNe is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogNameNe(blogName string) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.myname != ?", blogName))
+}
+
+// BlogNameNotIn is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogNameNotIn(blogName ...string) PostQuerySet {
+	if len(blogName) == 0 {
+		qs.db.AddError(errors.New("must at least pass one blogName in BlogNameNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("blog_join.myname NOT IN (?)", blogName))
+}
+
+// BlogNameNotInQuery filters rows with BlogName NOT IN values selected by subquery q
+// nolint: dupl
+func (qs PostQuerySet) BlogNameNotInQuery(q StringSubquery) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.myname NOT IN (?)", q.expr()))
+}
+
+// BlogNameNotlike is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogNameNotlike(blogName string) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.myname NOT LIKE ?", blogName))
+}
+
+// BlogNameStartsWith filters rows with BlogName starting with the argument
+// nolint: dupl
+func (qs PostQuerySet) BlogNameStartsWith(blogName string) PostQuerySet {
+	return qs.w(whereLike(qs.db, "blog_join.myname", "", blogName, "%", false))
+}
+
+// BlogUpdatedAtBetween filters rows with BlogUpdatedAt in range [from, to]
+// nolint: dupl
+func (qs PostQuerySet) BlogUpdatedAtBetween(from time.Time, to time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.updated_at BETWEEN ? AND ?", from, to))
+}
+
+// BlogUpdatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogUpdatedAtEq(blogUpdatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.updated_at = ?", blogUpdatedAt))
+}
+
+// BlogUpdatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogUpdatedAtGt(blogUpdatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.updated_at > ?", blogUpdatedAt))
+}
+
+// BlogUpdatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogUpdatedAtGte(blogUpdatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.updated_at >= ?", blogUpdatedAt))
+}
+
+// BlogUpdatedAtInRange filters rows with BlogUpdatedAt in range [from, to)
+// nolint: dupl
+func (qs PostQuerySet) BlogUpdatedAtInRange(from time.Time, to time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.updated_at >= ? AND blog_join.updated_at < ?", from, to))
+}
+
+// BlogUpdatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogUpdatedAtLt(blogUpdatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.updated_at < ?", blogUpdatedAt))
+}
+
+// BlogUpdatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogUpdatedAtLte(blogUpdatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.updated_at <= ?", blogUpdatedAt))
+}
+
+// BlogUpdatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) BlogUpdatedAtNe(blogUpdatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.updated_at != ?", blogUpdatedAt))
+}
+
+// BlogUpdatedAtWithin filters rows with BlogUpdatedAt not earlier than d ago
+// nolint: dupl
+func (qs PostQuerySet) BlogUpdatedAtWithin(d time.Duration) PostQuerySet {
+	return qs.w(qs.db.Where("blog_join.updated_at >= ?", time.Now().Add(-d)))
+}
+
+// Count is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) Count() (int64, error) {
+	var count int64
+	err := qs.db.Count(&count).Error
+	return count, err
+}
+
+// CountByBlogName returns count of rows for every value of BlogName
+// nolint: dupl
+func (qs PostQuerySet) CountByBlogName() (map[string]int, error) {
+	var rows []struct {
+		Value string
+		Count int
+	}
+	err := qs.db.Select("blog_join.myname AS value, COUNT(*) AS count").Group("blog_join.myname").Scan(&rows).Error
+	ret := make(map[string]int, len(rows))
+	for _, r := range rows {
+		ret[r.Value] = r.Count
+	}
+	return ret, err
+}
+
+// CountByID returns count of rows for every value of ID
+// nolint: dupl
+func (qs PostQuerySet) CountByID() (map[uint]int, error) {
+	var rows []struct {
+		Value uint
+		Count int
+	}
+	err := qs.db.Select(qs.column("id") + " AS value, COUNT(*) AS count").Group(qs.column("id")).Scan(&rows).Error
+	ret := make(map[uint]int, len(rows))
+	for _, r := range rows {
+		ret[r.Value] = r.Count
+	}
+	return ret, err
+}
+
+// CountByStr returns count of rows for every value of Str
+// nolint: dupl
+func (qs PostQuerySet) CountByStr() (map[tmp.StringDef]int, error) {
+	var rows []struct {
+		Value tmp.StringDef
+		Count int
+	}
+	err := qs.db.Select(qs.column("str") + " AS value, COUNT(*) AS count").Group(qs.column("str")).Scan(&rows).Error
+	ret := make(map[tmp.StringDef]int, len(rows))
+	for _, r := range rows {
+		ret[r.Value] = r.Count
+	}
+	return ret, err
+}
+
+// CountByUserEmail returns count of rows for every value of UserEmail
+// nolint: dupl
+func (qs PostQuerySet) CountByUserEmail() (map[string]int, error) {
+	var rows []struct {
+		Value string
+		Count int
+	}
+	err := qs.db.Select("user_join.email AS value, COUNT(*) AS count").Group("user_join.email").Scan(&rows).Error
+	ret := make(map[string]int, len(rows))
+	for _, r := range rows {
+		ret[r.Value] = r.Count
+	}
+	return ret, err
+}
+
+// CountByUserID returns count of rows for every value of UserID
+// nolint: dupl
+func (qs PostQuerySet) CountByUserID() (map[uint]int, error) {
+	var rows []struct {
+		Value uint
+		Count int
+	}
+	err := qs.db.Select(qs.column("user_id") + " AS value, COUNT(*) AS count").Group(qs.column("user_id")).Scan(&rows).Error
+	ret := make(map[uint]int, len(rows))
+	for _, r := range rows {
+		ret[r.Value] = r.Count
+	}
+	return ret, err
+}
+
+// CountByUserName returns count of rows for every value of UserName
+// nolint: dupl
+func (qs PostQuerySet) CountByUserName() (map[string]int, error) {
+	var rows []struct {
+		Value string
+		Count int
+	}
+	err := qs.db.Select("user_join.name AS value, COUNT(*) AS count").Group("user_join.name").Scan(&rows).Error
+	ret := make(map[string]int, len(rows))
+	for _, r := range rows {
+		ret[r.Value] = r.Count
+	}
+	return ret, err
+}
+
+// CreateMany inserts models by multi-row inserts, conditions of queryset
+// aren't used. Generated primary keys are written back to models if
+// database supports it (all except MS SQL for gorm v1).
+// nolint: dupl
+func (qs PostQuerySet) CreateMany(models []Post) error {
+	if len(models) == 0 {
+		return nil
+	}
+
+	size := bulkInsertBatchSize(qs.db.Dialector.Name(), len(qs.fieldPtrs(&models[0])))
+	// new db to not use conditions of queryset
+	return qs.db.Session(&gorm.Session{NewDB: true}).CreateInBatches(&models, size).Error
+}
+
+// CreatedAtBetween filters rows with CreatedAt in range [from, to]
+// nolint: dupl
+func (qs PostQuerySet) CreatedAtBetween(from time.Time, to time.Time) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("created_at")+" BETWEEN ? AND ?", from, to))
+}
+
+// CreatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) CreatedAtEq(createdAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("created_at")+" = ?", createdAt))
+}
+
+// CreatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) CreatedAtGt(createdAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("created_at")+" > ?", createdAt))
+}
+
+// CreatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) CreatedAtGte(createdAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("created_at")+" >= ?", createdAt))
+}
+
+// CreatedAtInRange filters rows with CreatedAt in range [from, to)
+// nolint: dupl
+func (qs PostQuerySet) CreatedAtInRange(from time.Time, to time.Time) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("created_at")+" >= ? AND "+qs.column("created_at")+" < ?", from, to))
+}
+
+// CreatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) CreatedAtLt(createdAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("created_at")+" < ?", createdAt))
+}
+
+// CreatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) CreatedAtLte(createdAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("created_at")+" <= ?", createdAt))
+}
+
+// CreatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) CreatedAtNe(createdAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("created_at")+" != ?", createdAt))
+}
+
+// CreatedAtWithin filters rows with CreatedAt not earlier than d ago
+// nolint: dupl
+func (qs PostQuerySet) CreatedAtWithin(d time.Duration) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("created_at")+" >= ?", time.Now().Add(-d)))
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) Delete() error {
+	return qs.db.Delete(&Post{}).Error
+}
+
+// DeleteNum is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) DeleteNum() (int64, error) {
+	db := qs.db.Delete(&Post{})
+	return db.RowsAffected, db.Error
+}
+
+// DeleteNumUnscoped is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) DeleteNumUnscoped() (int64, error) {
+	db := qs.db.Unscoped().Delete(&Post{})
+	return db.RowsAffected, db.Error
+}
+
+// DeletedAtBetween filters rows with DeletedAt in range [from, to]
+// nolint: dupl
+func (qs PostQuerySet) DeletedAtBetween(from time.Time, to time.Time) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("deleted_at")+" BETWEEN ? AND ?", from, to))
+}
+
+// DeletedAtEq is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) DeletedAtEq(deletedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("deleted_at")+" = ?", deletedAt))
+}
+
+// DeletedAtGt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) DeletedAtGt(deletedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("deleted_at")+" > ?", deletedAt))
+}
+
+// DeletedAtGte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) DeletedAtGte(deletedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("deleted_at")+" >= ?", deletedAt))
+}
+
+// DeletedAtInRange filters rows with DeletedAt in range [from, to)
+// nolint: dupl
+func (qs PostQuerySet) DeletedAtInRange(from time.Time, to time.Time) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("deleted_at")+" >= ? AND "+qs.column("deleted_at")+" < ?", from, to))
+}
+
+// DeletedAtIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) DeletedAtIsNotNull() PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("deleted_at") + " IS NOT NULL"))
+}
+
+// DeletedAtIsNull is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) DeletedAtIsNull() PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("deleted_at") + " IS NULL"))
+}
+
+// DeletedAtLt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) DeletedAtLt(deletedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("deleted_at")+" < ?", deletedAt))
+}
+
+// DeletedAtLte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) DeletedAtLte(deletedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("deleted_at")+" <= ?", deletedAt))
+}
+
+// DeletedAtNe is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) DeletedAtNe(deletedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("deleted_at")+" != ?", deletedAt))
+}
+
+// DeletedAtWithin filters rows with DeletedAt not earlier than d ago
+// nolint: dupl
+func (qs PostQuerySet) DeletedAtWithin(d time.Duration) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("deleted_at")+" >= ?", time.Now().Add(-d)))
+}
+
+// GetDB is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) GetDB() *gorm.DB {
+	return qs.db
+}
+
+// GetUpdater is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) GetUpdater() PostUpdater {
+	return NewPostUpdater(qs.db)
+}
+
+// GroupByBlogCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) GroupByBlogCreatedAt() PostQuerySet {
+	return qs.w(qs.db.Group("blog_join.created_at"))
+}
+
+// GroupByBlogDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) GroupByBlogDeletedAt() PostQuerySet {
+	return qs.w(qs.db.Group("blog_join.deleted_at"))
+}
+
+// GroupByBlogID is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) GroupByBlogID() PostQuerySet {
+	return qs.w(qs.db.Group(qs.column("blog_id")))
+}
+
+// GroupByBlogName is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) GroupByBlogName() PostQuerySet {
+	return qs.w(qs.db.Group("blog_join.myname"))
+}
+
+// GroupByBlogUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) GroupByBlogUpdatedAt() PostQuerySet {
+	return qs.w(qs.db.Group("blog_join.updated_at"))
+}
+
+// GroupByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) GroupByCreatedAt() PostQuerySet {
+	return qs.w(qs.db.Group(qs.column("created_at")))
+}
+
+// GroupByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) GroupByDeletedAt() PostQuerySet {
+	return qs.w(qs.db.Group(qs.column("deleted_at")))
+}
+
+// GroupByID is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) GroupByID() PostQuerySet {
+	return qs.w(qs.db.Group(qs.column("id")))
+}
+
+// GroupByStr is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) GroupByStr() PostQuerySet {
+	return qs.w(qs.db.Group(qs.column("str")))
+}
+
+// GroupByTitle is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) GroupByTitle() PostQuerySet {
+	return qs.w(qs.db.Group(qs.column("title")))
+}
+
+// GroupByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) GroupByUpdatedAt() PostQuerySet {
+	return qs.w(qs.db.Group(qs.column("updated_at")))
+}
+
+// GroupByUserCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) GroupByUserCreatedAt() PostQuerySet {
+	return qs.w(qs.db.Group("user_join.created_at"))
+}
+
+// GroupByUserDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) GroupByUserDeletedAt() PostQuerySet {
+	return qs.w(qs.db.Group("user_join.deleted_at"))
+}
+
+// GroupByUserEmail is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) GroupByUserEmail() PostQuerySet {
+	return qs.w(qs.db.Group("user_join.email"))
+}
+
+// GroupByUserID is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) GroupByUserID() PostQuerySet {
+	return qs.w(qs.db.Group(qs.column("user_id")))
+}
+
+// GroupByUserName is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) GroupByUserName() PostQuerySet {
+	return qs.w(qs.db.Group("user_join.name"))
+}
+
+// GroupByUserSurname is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) GroupByUserSurname() PostQuerySet {
+	return qs.w(qs.db.Group("user_join.user_surname"))
+}
+
+// GroupByUserUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) GroupByUserUpdatedAt() PostQuerySet {
+	return qs.w(qs.db.Group("user_join.updated_at"))
+}
+
+// HavingCountEq is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) HavingCountEq(count int) PostQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) = ?", count))
+}
+
+// HavingCountGt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) HavingCountGt(count int) PostQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) > ?", count))
+}
+
+// HavingCountGte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) HavingCountGte(count int) PostQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) >= ?", count))
+}
+
+// HavingCountLt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) HavingCountLt(count int) PostQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) < ?", count))
+}
+
+// HavingCountLte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) HavingCountLte(count int) PostQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) <= ?", count))
+}
+
+// HavingCountNe is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) HavingCountNe(count int) PostQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) != ?", count))
+}
+
+// IDBetween filters rows with ID in range [from, to]
+// nolint: dupl
+func (qs PostQuerySet) IDBetween(from uint, to uint) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("id")+" BETWEEN ? AND ?", from, to))
+}
+
+// IDEq is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) IDEq(ID uint) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("id")+" = ?", ID))
+}
+
+// IDGt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) IDGt(ID uint) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("id")+" > ?", ID))
+}
+
+// IDGte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) IDGte(ID uint) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("id")+" >= ?", ID))
+}
+
+// IDIn is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) IDIn(ID ...uint) PostQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where(qs.column("id")+" IN (?)", ID))
+}
+
+// IDInQuery filters rows with ID IN values selected by subquery q
+// nolint: dupl
+func (qs PostQuerySet) IDInQuery(q UintSubquery) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("id")+" IN (?)", q.expr()))
+}
+
+// IDInRange filters rows with ID in range [from, to)
+// nolint: dupl
+func (qs PostQuerySet) IDInRange(from uint, to uint) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("id")+" >= ? AND "+qs.column("id")+" < ?", from, to))
+}
+
+// IDLt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) IDLt(ID uint) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("id")+" < ?", ID))
+}
+
+// IDLte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) IDLte(ID uint) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("id")+" <= ?", ID))
+}
+
+// IDNe is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) IDNe(ID uint) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("id")+" != ?", ID))
+}
+
+// IDNotIn is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) IDNotIn(ID ...uint) PostQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where(qs.column("id")+" NOT IN (?)", ID))
+}
+
+// IDNotInQuery filters rows with ID NOT IN values selected by subquery q
+// nolint: dupl
+func (qs PostQuerySet) IDNotInQuery(q UintSubquery) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("id")+" NOT IN (?)", q.expr()))
+}
+
+// InTx returns copy bound to transaction tx: queries are executed in it.
+// Gorm v1 can't move conditions into another connection, so with gorm v1
+// InTx must be called before any condition is set, otherwise queries fail.
+// Context and preloads are moved into transaction by both gorm versions.
+// nolint: dupl
+func (qs PostQuerySet) InTx(tx *gorm.DB) PostQuerySet {
+	ctx := tx.Statement.Context
+	if qs.db.Statement.Context != context.Background() {
+		ctx = qs.db.Statement.Context // set by WithContext
+	}
+	db := qs.db.Session(&gorm.Session{Context: ctx})
+	db.Statement.ConnPool = tx.Statement.ConnPool
+	if tx.Error != nil {
+		db.AddError(tx.Error)
+	}
+	return qs.w(db)
+}
+
+// Iterate scans rows one by one and calls fn for every row without
+// loading all rows into memory. It stops on the first error of fn and
+// returns it. Preloads aren't applied.
+// nolint: dupl
+func (qs PostQuerySet) Iterate(fn func(*Post) error) error {
+	db := qs.db
+	if db.Error != nil {
+		return db.Error // gorm v1 doesn't check it in Rows
+	}
+
+	rows, err := db.Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var o Post
+		if err = db.ScanRows(rows, &o); err != nil {
+			return err
+		}
+		if err = fn(&o); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// JoinBlog joins table of Blog by alias blog_join:
+// it's needed for filtering by Blog fields
+// nolint: dupl
+func (qs PostQuerySet) JoinBlog() PostQuerySet {
+	db := qs.db
+	if len(db.Statement.Selects) == 0 {
+		// don't let joined columns overwrite model columns with the same names
+		db = db.Select(qs.quotedTableName(&Post{}) + ".*")
+	}
+	return qs.w(db.Joins(fmt.Sprintf("JOIN %s blog_join ON blog_join.id = %s.blog_id AND blog_join.deleted_at IS NULL",
+		qs.quotedTableName(&Blog{}), qs.quotedTableName(&Post{}))))
+}
+
+// JoinUser joins table of User by alias user_join:
+// it's needed for filtering by User fields
+// nolint: dupl
+func (qs PostQuerySet) JoinUser() PostQuerySet {
+	db := qs.db
+	if len(db.Statement.Selects) == 0 {
+		// don't let joined columns overwrite model columns with the same names
+		db = db.Select(qs.quotedTableName(&Post{}) + ".*")
+	}
+	return qs.w(db.Joins(fmt.Sprintf("JOIN %s user_join ON user_join.id = %s.user_id AND user_join.deleted_at IS NULL",
+		qs.quotedTableName(&User{}), qs.quotedTableName(&Post{}))))
+}
+
+// LeftJoinBlog joins table of Blog by alias blog_join:
+// it's needed for filtering by Blog fields
+// nolint: dupl
+func (qs PostQuerySet) LeftJoinBlog() PostQuerySet {
+	db := qs.db
+	if len(db.Statement.Selects) == 0 {
+		// don't let joined columns overwrite model columns with the same names
+		db = db.Select(qs.quotedTableName(&Post{}) + ".*")
+	}
+	return qs.w(db.Joins(fmt.Sprintf("LEFT JOIN %s blog_join ON blog_join.id = %s.blog_id AND blog_join.deleted_at IS NULL",
+		qs.quotedTableName(&Blog{}), qs.quotedTableName(&Post{}))))
+}
+
+// LeftJoinUser joins table of User by alias user_join:
+// it's needed for filtering by User fields
+// nolint: dupl
+func (qs PostQuerySet) LeftJoinUser() PostQuerySet {
+	db := qs.db
+	if len(db.Statement.Selects) == 0 {
+		// don't let joined columns overwrite model columns with the same names
+		db = db.Select(qs.quotedTableName(&Post{}) + ".*")
+	}
+	return qs.w(db.Joins(fmt.Sprintf("LEFT JOIN %s user_join ON user_join.id = %s.user_id AND user_join.deleted_at IS NULL",
+		qs.quotedTableName(&User{}), qs.quotedTableName(&Post{}))))
+}
+
+// Limit is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) Limit(limit int) PostQuerySet {
+	return qs.w(qs.db.Limit(limit))
+}
+
+// MaxBlogCreatedAt returns MAX of BlogCreatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs PostQuerySet) MaxBlogCreatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := qs.db.Select("blog_join.created_at AS value").Where("blog_join.created_at IS NOT NULL").
+		Order(clause.OrderByColumn{
+			Column:  clause.Column{Name: "blog_join.created_at", Raw: true},
+			Desc:    true,
+			Reorder: true,
+		}).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MaxBlogID returns MAX of BlogID, it's zero value if there are no rows
+// nolint: dupl
+func (qs PostQuerySet) MaxBlogID() (uint, error) {
+	var ret uint
+	var res struct {
+		Value *uint
+	}
+	err := qs.db.Select("MAX(" + qs.column("blog_id") + ") AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// MaxBlogUpdatedAt returns MAX of BlogUpdatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs PostQuerySet) MaxBlogUpdatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := qs.db.Select("blog_join.updated_at AS value").Where("blog_join.updated_at IS NOT NULL").
+		Order(clause.OrderByColumn{
+			Column:  clause.Column{Name: "blog_join.updated_at", Raw: true},
+			Desc:    true,
+			Reorder: true,
+		}).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MaxCreatedAt returns MAX of CreatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs PostQuerySet) MaxCreatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := qs.db.Select(qs.column("created_at") + " AS value").Where(qs.column("created_at") + " IS NOT NULL").
+		Order(clause.OrderByColumn{
+			Column:  clause.Column{Name: qs.column("created_at"), Raw: true},
+			Desc:    true,
+			Reorder: true,
+		}).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MaxID returns MAX of ID, it's zero value if there are no rows
+// nolint: dupl
+func (qs PostQuerySet) MaxID() (uint, error) {
+	var ret uint
+	var res struct {
+		Value *uint
+	}
+	err := qs.db.Select("MAX(" + qs.column("id") + ") AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// MaxUpdatedAt returns MAX of UpdatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs PostQuerySet) MaxUpdatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := qs.db.Select(qs.column("updated_at") + " AS value").Where(qs.column("updated_at") + " IS NOT NULL").
+		Order(clause.OrderByColumn{
+			Column:  clause.Column{Name: qs.column("updated_at"), Raw: true},
+			Desc:    true,
+			Reorder: true,
+		}).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MaxUserCreatedAt returns MAX of UserCreatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs PostQuerySet) MaxUserCreatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := qs.db.Select("user_join.created_at AS value").Where("user_join.created_at IS NOT NULL").
+		Order(clause.OrderByColumn{
+			Column:  clause.Column{Name: "user_join.created_at", Raw: true},
+			Desc:    true,
+			Reorder: true,
+		}).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MaxUserID returns MAX of UserID, it's zero value if there are no rows
+// nolint: dupl
+func (qs PostQuerySet) MaxUserID() (uint, error) {
+	var ret uint
+	var res struct {
+		Value *uint
+	}
+	err := qs.db.Select("MAX(" + qs.column("user_id") + ") AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// MaxUserUpdatedAt returns MAX of UserUpdatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs PostQuerySet) MaxUserUpdatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := qs.db.Select("user_join.updated_at AS value").Where("user_join.updated_at IS NOT NULL").
+		Order(clause.OrderByColumn{
+			Column:  clause.Column{Name: "user_join.updated_at", Raw: true},
+			Desc:    true,
+			Reorder: true,
+		}).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MinBlogCreatedAt returns MIN of BlogCreatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs PostQuerySet) MinBlogCreatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := qs.db.Select("blog_join.created_at AS value").Where("blog_join.created_at IS NOT NULL").
+		Order(clause.OrderByColumn{
+			Column:  clause.Column{Name: "blog_join.created_at", Raw: true},
+			Desc:    false,
+			Reorder: true,
+		}).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MinBlogID returns MIN of BlogID, it's zero value if there are no rows
+// nolint: dupl
+func (qs PostQuerySet) MinBlogID() (uint, error) {
+	var ret uint
+	var res struct {
+		Value *uint
+	}
+	err := qs.db.Select("MIN(" + qs.column("blog_id") + ") AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// MinBlogUpdatedAt returns MIN of BlogUpdatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs PostQuerySet) MinBlogUpdatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := qs.db.Select("blog_join.updated_at AS value").Where("blog_join.updated_at IS NOT NULL").
+		Order(clause.OrderByColumn{
+			Column:  clause.Column{Name: "blog_join.updated_at", Raw: true},
+			Desc:    false,
+			Reorder: true,
+		}).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MinCreatedAt returns MIN of CreatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs PostQuerySet) MinCreatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := qs.db.Select(qs.column("created_at") + " AS value").Where(qs.column("created_at") + " IS NOT NULL").
+		Order(clause.OrderByColumn{
+			Column:  clause.Column{Name: qs.column("created_at"), Raw: true},
+			Desc:    false,
+			Reorder: true,
+		}).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MinID returns MIN of ID, it's zero value if there are no rows
+// nolint: dupl
+func (qs PostQuerySet) MinID() (uint, error) {
+	var ret uint
+	var res struct {
+		Value *uint
+	}
+	err := qs.db.Select("MIN(" + qs.column("id") + ") AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// MinUpdatedAt returns MIN of UpdatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs PostQuerySet) MinUpdatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := qs.db.Select(qs.column("updated_at") + " AS value").Where(qs.column("updated_at") + " IS NOT NULL").
+		Order(clause.OrderByColumn{
+			Column:  clause.Column{Name: qs.column("updated_at"), Raw: true},
+			Desc:    false,
+			Reorder: true,
+		}).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MinUserCreatedAt returns MIN of UserCreatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs PostQuerySet) MinUserCreatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := qs.db.Select("user_join.created_at AS value").Where("user_join.created_at IS NOT NULL").
+		Order(clause.OrderByColumn{
+			Column:  clause.Column{Name: "user_join.created_at", Raw: true},
+			Desc:    false,
+			Reorder: true,
+		}).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MinUserID returns MIN of UserID, it's zero value if there are no rows
+// nolint: dupl
+func (qs PostQuerySet) MinUserID() (uint, error) {
+	var ret uint
+	var res struct {
+		Value *uint
+	}
+	err := qs.db.Select("MIN(" + qs.column("user_id") + ") AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// MinUserUpdatedAt returns MIN of UserUpdatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs PostQuerySet) MinUserUpdatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := qs.db.Select("user_join.updated_at AS value").Where("user_join.updated_at IS NOT NULL").
+		Order(clause.OrderByColumn{
+			Column:  clause.Column{Name: "user_join.updated_at", Raw: true},
+			Desc:    false,
+			Reorder: true,
+		}).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// Offset is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) Offset(offset int) PostQuerySet {
+	return qs.w(qs.db.Offset(offset))
+}
+
+// One is used to retrieve one result. It returns gorm.ErrRecordNotFound
+// if nothing was fetched
+func (qs PostQuerySet) One(ret *Post) error {
+	return qs.db.First(ret).Error
+}
+
+// OrderAscByBlogCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderAscByBlogCreatedAt() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("blog_join.created_at ASC"), "blog_join.created_at", false, false))
+}
+
+// OrderAscByBlogDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderAscByBlogDeletedAt() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("blog_join.deleted_at ASC"), "blog_join.deleted_at", false, false))
+}
+
+// OrderAscByBlogID is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderAscByBlogID() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order(qs.column("blog_id")+" ASC"), "blog_id", false, false))
+}
+
+// OrderAscByBlogName is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderAscByBlogName() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("blog_join.myname ASC"), "blog_join.myname", false, false))
+}
+
+// OrderAscByBlogUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderAscByBlogUpdatedAt() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("blog_join.updated_at ASC"), "blog_join.updated_at", false, false))
+}
+
+// OrderAscByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderAscByCreatedAt() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order(qs.column("created_at")+" ASC"), "created_at", false, false))
+}
+
+// OrderAscByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderAscByDeletedAt() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order(qs.column("deleted_at")+" ASC"), "deleted_at", false, false))
+}
+
+// OrderAscByID is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderAscByID() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order(qs.column("id")+" ASC"), "id", false, false))
+}
+
+// OrderAscByStr is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderAscByStr() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order(qs.column("str")+" ASC"), "str", false, false))
+}
+
+// OrderAscByTitle is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderAscByTitle() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order(qs.column("title")+" ASC"), "title", false, false))
+}
+
+// OrderAscByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderAscByUpdatedAt() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order(qs.column("updated_at")+" ASC"), "updated_at", false, false))
+}
+
+// OrderAscByUserCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderAscByUserCreatedAt() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("user_join.created_at ASC"), "user_join.created_at", false, false))
+}
+
+// OrderAscByUserDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderAscByUserDeletedAt() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("user_join.deleted_at ASC"), "user_join.deleted_at", false, false))
+}
+
+// OrderAscByUserEmail is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderAscByUserEmail() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("user_join.email ASC"), "user_join.email", false, false))
+}
+
+// OrderAscByUserID is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderAscByUserID() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order(qs.column("user_id")+" ASC"), "user_id", false, false))
+}
+
+// OrderAscByUserName is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderAscByUserName() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("user_join.name ASC"), "user_join.name", false, false))
+}
+
+// OrderAscByUserSurname is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderAscByUserSurname() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("user_join.user_surname ASC"), "user_join.user_surname", false, false))
+}
+
+// OrderAscByUserUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderAscByUserUpdatedAt() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("user_join.updated_at ASC"), "user_join.updated_at", false, false))
+}
+
+// OrderDescByBlogCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderDescByBlogCreatedAt() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("blog_join.created_at DESC"), "blog_join.created_at", true, false))
+}
+
+// OrderDescByBlogDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderDescByBlogDeletedAt() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("blog_join.deleted_at DESC"), "blog_join.deleted_at", true, false))
+}
+
+// OrderDescByBlogID is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderDescByBlogID() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order(qs.column("blog_id")+" DESC"), "blog_id", true, false))
+}
+
+// OrderDescByBlogName is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderDescByBlogName() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("blog_join.myname DESC"), "blog_join.myname", true, false))
+}
+
+// OrderDescByBlogUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderDescByBlogUpdatedAt() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("blog_join.updated_at DESC"), "blog_join.updated_at", true, false))
+}
+
+// OrderDescByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderDescByCreatedAt() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order(qs.column("created_at")+" DESC"), "created_at", true, false))
+}
+
+// OrderDescByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderDescByDeletedAt() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order(qs.column("deleted_at")+" DESC"), "deleted_at", true, false))
+}
+
+// OrderDescByID is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderDescByID() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order(qs.column("id")+" DESC"), "id", true, false))
+}
+
+// OrderDescByStr is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderDescByStr() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order(qs.column("str")+" DESC"), "str", true, false))
+}
+
+// OrderDescByTitle is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderDescByTitle() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order(qs.column("title")+" DESC"), "title", true, false))
+}
+
+// OrderDescByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderDescByUpdatedAt() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order(qs.column("updated_at")+" DESC"), "updated_at", true, false))
+}
+
+// OrderDescByUserCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderDescByUserCreatedAt() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("user_join.created_at DESC"), "user_join.created_at", true, false))
+}
+
+// OrderDescByUserDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderDescByUserDeletedAt() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("user_join.deleted_at DESC"), "user_join.deleted_at", true, false))
+}
+
+// OrderDescByUserEmail is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderDescByUserEmail() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("user_join.email DESC"), "user_join.email", true, false))
+}
+
+// OrderDescByUserID is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderDescByUserID() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order(qs.column("user_id")+" DESC"), "user_id", true, false))
+}
+
+// OrderDescByUserName is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderDescByUserName() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("user_join.name DESC"), "user_join.name", true, false))
+}
+
+// OrderDescByUserSurname is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderDescByUserSurname() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("user_join.user_surname DESC"), "user_join.user_surname", true, false))
+}
+
+// OrderDescByUserUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) OrderDescByUserUpdatedAt() PostQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("user_join.updated_at DESC"), "user_join.updated_at", true, false))
+}
+
+// Page fetches at most size rows following cursor into ret, it uses
+// orders set by OrderAscBy/OrderDescBy methods and primary key to break ties.
+// Ordered fields can't be nullable: rows with NULL can't be compared with
+// cursor, so error is returned for them. Empty cursor means the first page. It returns cursor of the next page or
+// empty string if there are no more rows.
+// nolint: dupl
+func (qs PostQuerySet) Page(cursor string, size int, ret *[]Post) (string, error) {
+	if size <= 0 {
+		return "", errors.New("page size must be positive")
+	}
+
+	var last Post
+	db, orders, err := pageQuery(qs.db, qs.quotedTableName(&Post{})+".", "id", cursor, qs.fieldPtrs(&last))
+	if err != nil {
+		return "", err
+	}
+
+	if err = db.Limit(size + 1).Find(ret).Error; err != nil {
+		return "", err
+	}
+	if len(*ret) <= size {
+		return "", nil
+	}
+
+	*ret = (*ret)[:size]
+	return encodePageCursor(orders, qs.fieldPtrs(&(*ret)[size-1]))
+}
+
+// PreloadBlog is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) PreloadBlog() PostQuerySet {
+	return qs.w(qs.db.Preload("Blog"))
+}
+
+// PreloadBlogWhere preloads Blog filtered, ordered or limited by f:
+// limit is applied to all preloaded objects, not to objects of every row
+// nolint: dupl
+func (qs PostQuerySet) PreloadBlogWhere(f func(BlogQuerySet) BlogQuerySet) PostQuerySet {
+	return qs.w(qs.db.Preload("Blog", func(db *gorm.DB) *gorm.DB {
+		return f(NewBlogQuerySet(db)).db
+	}))
+}
+
+// PreloadUser is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) PreloadUser() PostQuerySet {
+	return qs.w(qs.db.Preload("User"))
+}
+
+// PreloadUserWhere preloads User filtered, ordered or limited by f:
+// limit is applied to all preloaded objects, not to objects of every row
+// nolint: dupl
+func (qs PostQuerySet) PreloadUserWhere(f func(UserQuerySet) UserQuerySet) PostQuerySet {
+	return qs.w(qs.db.Preload("User", func(db *gorm.DB) *gorm.DB {
+		return f(NewUserQuerySet(db)).db
+	}))
+}
+
+// SelectBlogName returns subquery selecting BlogName of rows of queryset
+// for InQuery and NotInQuery filters
+// nolint: dupl
+func (qs PostQuerySet) SelectBlogName() StringSubquery {
+	return StringSubquery{db: qs.db.Select("blog_join.myname")}
+}
+
+// SelectID returns subquery selecting ID of rows of queryset
+// for InQuery and NotInQuery filters
+// nolint: dupl
+func (qs PostQuerySet) SelectID() UintSubquery {
+	return UintSubquery{db: qs.db.Select(qs.column("id"))}
+}
+
+// SelectStr returns subquery selecting Str of rows of queryset
+// for InQuery and NotInQuery filters
+// nolint: dupl
+func (qs PostQuerySet) SelectStr() TmpStringDefSubquery {
+	return TmpStringDefSubquery{db: qs.db.Select(qs.column("str"))}
+}
+
+// SelectUserEmail returns subquery selecting UserEmail of rows of queryset
+// for InQuery and NotInQuery filters
+// nolint: dupl
+func (qs PostQuerySet) SelectUserEmail() StringSubquery {
+	return StringSubquery{db: qs.db.Select("user_join.email")}
+}
+
+// SelectUserID returns subquery selecting UserID of rows of queryset
+// for InQuery and NotInQuery filters
+// nolint: dupl
+func (qs PostQuerySet) SelectUserID() UintSubquery {
+	return UintSubquery{db: qs.db.Select(qs.column("user_id"))}
+}
+
+// SelectUserName returns subquery selecting UserName of rows of queryset
+// for InQuery and NotInQuery filters
+// nolint: dupl
+func (qs PostQuerySet) SelectUserName() StringSubquery {
+	return StringSubquery{db: qs.db.Select("user_join.name")}
+}
+
+// StrEndsWith filters rows with Str ending with the argument
+// nolint: dupl
+func (qs PostQuerySet) StrEndsWith(str tmp.StringDef) PostQuerySet {
+	return qs.w(whereLike(qs.db, qs.column("str"), "%", string(str), "", false))
+}
+
+// StrEq is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) StrEq(str tmp.StringDef) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("str")+" = ?", str))
+}
+
+// StrGt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) StrGt(str tmp.StringDef) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("str")+" > ?", str))
+}
+
+// StrGte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) StrGte(str tmp.StringDef) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("str")+" >= ?", str))
+}
+
+// StrIContains filters rows with Str containing the argument, case is ignored
+// nolint: dupl
+func (qs PostQuerySet) StrIContains(str tmp.StringDef) PostQuerySet {
+	return qs.w(whereLike(qs.db, qs.column("str"), "%", string(str), "%", true))
+}
+
+// StrIEq filters rows with Str equal to the argument, case is ignored
+// nolint: dupl
+func (qs PostQuerySet) StrIEq(str tmp.StringDef) PostQuerySet {
+	return qs.w(whereLike(qs.db, qs.column("str"), "", string(str), "", true))
+}
+
+// StrIn is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) StrIn(str ...tmp.StringDef) PostQuerySet {
+	if len(str) == 0 {
+		qs.db.AddError(errors.New("must at least pass one str in StrIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where(qs.column("str")+" IN (?)", str))
+}
+
+// StrInQuery filters rows with Str IN values selected by subquery q
+// nolint: dupl
+func (qs PostQuerySet) StrInQuery(q TmpStringDefSubquery) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("str")+" IN (?)", q.expr()))
+}
+
+// StrLike is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) StrLike(str tmp.StringDef) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("str")+" LIKE ?", str))
+}
+
+// StrLt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) StrLt(str tmp.StringDef) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("str")+" < ?", str))
+}
+
+// StrLte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) StrLte(str tmp.StringDef) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("str")+" <= ?", str))
+}
+
+// StrNe is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) StrNe(str tmp.StringDef) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("str")+" != ?", str))
+}
+
+// StrNotIn is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) StrNotIn(str ...tmp.StringDef) PostQuerySet {
+	if len(str) == 0 {
+		qs.db.AddError(errors.New("must at least pass one str in StrNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where(qs.column("str")+" NOT IN (?)", str))
+}
+
+// StrNotInQuery filters rows with Str NOT IN values selected by subquery q
+// nolint: dupl
+func (qs PostQuerySet) StrNotInQuery(q TmpStringDefSubquery) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("str")+" NOT IN (?)", q.expr()))
+}
+
+// StrNotlike is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) StrNotlike(str tmp.StringDef) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("str")+" NOT LIKE ?", str))
+}
+
+// StrStartsWith filters rows with Str starting with the argument
+// nolint: dupl
+func (qs PostQuerySet) StrStartsWith(str tmp.StringDef) PostQuerySet {
+	return qs.w(whereLike(qs.db, qs.column("str"), "", string(str), "%", false))
+}
+
+// TitleEndsWith filters rows with Title ending with the argument
+// nolint: dupl
+func (qs PostQuerySet) TitleEndsWith(title string) PostQuerySet {
+	return qs.w(whereLike(qs.db, qs.column("title"), "%", title, "", false))
+}
+
+// TitleEq is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) TitleEq(title string) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("title")+" = ?", title))
+}
+
+// TitleGt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) TitleGt(title string) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("title")+" > ?", title))
+}
+
+// TitleGte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) TitleGte(title string) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("title")+" >= ?", title))
+}
+
+// TitleIContains filters rows with Title containing the argument, case is ignored
+// nolint: dupl
+func (qs PostQuerySet) TitleIContains(title string) PostQuerySet {
+	return qs.w(whereLike(qs.db, qs.column("title"), "%", title, "%", true))
+}
+
+// TitleIEq filters rows with Title equal to the argument, case is ignored
+// nolint: dupl
+func (qs PostQuerySet) TitleIEq(title string) PostQuerySet {
+	return qs.w(whereLike(qs.db, qs.column("title"), "", title, "", true))
+}
+
+// TitleIn is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) TitleIn(title ...string) PostQuerySet {
+	if len(title) == 0 {
+		qs.db.AddError(errors.New("must at least pass one title in TitleIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where(qs.column("title")+" IN (?)", title))
+}
+
+// TitleInQuery filters rows with Title IN values selected by subquery q
+// nolint: dupl
+func (qs PostQuerySet) TitleInQuery(q StringSubquery) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("title")+" IN (?)", q.expr()))
+}
+
+// TitleIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) TitleIsNotNull() PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("title") + " IS NOT NULL"))
+}
+
+// TitleIsNull is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) TitleIsNull() PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("title") + " IS NULL"))
+}
+
+// TitleLike is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) TitleLike(title string) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("title")+" LIKE ?", title))
+}
+
+// TitleLt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) TitleLt(title string) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("title")+" < ?", title))
+}
+
+// TitleLte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) TitleLte(title string) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("title")+" <= ?", title))
+}
+
+// TitleNe is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) TitleNe(title string) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("title")+" != ?", title))
+}
+
+// TitleNotIn is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) TitleNotIn(title ...string) PostQuerySet {
+	if len(title) == 0 {
+		qs.db.AddError(errors.New("must at least pass one title in TitleNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where(qs.column("title")+" NOT IN (?)", title))
+}
+
+// TitleNotInQuery filters rows with Title NOT IN values selected by subquery q
+// nolint: dupl
+func (qs PostQuerySet) TitleNotInQuery(q StringSubquery) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("title")+" NOT IN (?)", q.expr()))
+}
+
+// TitleNotlike is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) TitleNotlike(title string) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("title")+" NOT LIKE ?", title))
+}
+
+// TitleStartsWith filters rows with Title starting with the argument
+// nolint: dupl
+func (qs PostQuerySet) TitleStartsWith(title string) PostQuerySet {
+	return qs.w(whereLike(qs.db, qs.column("title"), "", title, "%", false))
+}
+
+// UpdatedAtBetween filters rows with UpdatedAt in range [from, to]
+// nolint: dupl
+func (qs PostQuerySet) UpdatedAtBetween(from time.Time, to time.Time) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("updated_at")+" BETWEEN ? AND ?", from, to))
+}
+
+// UpdatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UpdatedAtEq(updatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("updated_at")+" = ?", updatedAt))
+}
+
+// UpdatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UpdatedAtGt(updatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("updated_at")+" > ?", updatedAt))
+}
+
+// UpdatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UpdatedAtGte(updatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("updated_at")+" >= ?", updatedAt))
+}
+
+// UpdatedAtInRange filters rows with UpdatedAt in range [from, to)
+// nolint: dupl
+func (qs PostQuerySet) UpdatedAtInRange(from time.Time, to time.Time) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("updated_at")+" >= ? AND "+qs.column("updated_at")+" < ?", from, to))
+}
+
+// UpdatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UpdatedAtLt(updatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("updated_at")+" < ?", updatedAt))
+}
+
+// UpdatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UpdatedAtLte(updatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("updated_at")+" <= ?", updatedAt))
+}
+
+// UpdatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UpdatedAtNe(updatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("updated_at")+" != ?", updatedAt))
+}
+
+// UpdatedAtWithin filters rows with UpdatedAt not earlier than d ago
+// nolint: dupl
+func (qs PostQuerySet) UpdatedAtWithin(d time.Duration) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("updated_at")+" >= ?", time.Now().Add(-d)))
+}
+
+// UserCreatedAtBetween filters rows with UserCreatedAt in range [from, to]
+// nolint: dupl
+func (qs PostQuerySet) UserCreatedAtBetween(from time.Time, to time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.created_at BETWEEN ? AND ?", from, to))
+}
+
+// UserCreatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserCreatedAtEq(userCreatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.created_at = ?", userCreatedAt))
+}
+
+// UserCreatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserCreatedAtGt(userCreatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.created_at > ?", userCreatedAt))
+}
+
+// UserCreatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserCreatedAtGte(userCreatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.created_at >= ?", userCreatedAt))
+}
+
+// UserCreatedAtInRange filters rows with UserCreatedAt in range [from, to)
+// nolint: dupl
+func (qs PostQuerySet) UserCreatedAtInRange(from time.Time, to time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.created_at >= ? AND user_join.created_at < ?", from, to))
+}
+
+// UserCreatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserCreatedAtLt(userCreatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.created_at < ?", userCreatedAt))
+}
+
+// UserCreatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserCreatedAtLte(userCreatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.created_at <= ?", userCreatedAt))
+}
+
+// UserCreatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserCreatedAtNe(userCreatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.created_at != ?", userCreatedAt))
+}
+
+// UserCreatedAtWithin filters rows with UserCreatedAt not earlier than d ago
+// nolint: dupl
+func (qs PostQuerySet) UserCreatedAtWithin(d time.Duration) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.created_at >= ?", time.Now().Add(-d)))
+}
+
+// UserDeletedAtBetween filters rows with UserDeletedAt in range [from, to]
+// nolint: dupl
+func (qs PostQuerySet) UserDeletedAtBetween(from time.Time, to time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.deleted_at BETWEEN ? AND ?", from, to))
+}
+
+// UserDeletedAtEq is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserDeletedAtEq(userDeletedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.deleted_at = ?", userDeletedAt))
+}
+
+// UserDeletedAtGt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserDeletedAtGt(userDeletedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.deleted_at > ?", userDeletedAt))
+}
+
+// UserDeletedAtGte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserDeletedAtGte(userDeletedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.deleted_at >= ?", userDeletedAt))
+}
+
+// UserDeletedAtInRange filters rows with UserDeletedAt in range [from, to)
+// nolint: dupl
+func (qs PostQuerySet) UserDeletedAtInRange(from time.Time, to time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.deleted_at >= ? AND user_join.deleted_at < ?", from, to))
+}
+
+// UserDeletedAtIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserDeletedAtIsNotNull() PostQuerySet {
+	return qs.w(qs.db.Where("user_join.deleted_at IS NOT NULL"))
+}
+
+// UserDeletedAtIsNull is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserDeletedAtIsNull() PostQuerySet {
+	return qs.w(qs.db.Where("user_join.deleted_at IS NULL"))
+}
+
+// UserDeletedAtLt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserDeletedAtLt(userDeletedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.deleted_at < ?", userDeletedAt))
+}
+
+// UserDeletedAtLte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserDeletedAtLte(userDeletedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.deleted_at <= ?", userDeletedAt))
+}
+
+// UserDeletedAtNe is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserDeletedAtNe(userDeletedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.deleted_at != ?", userDeletedAt))
+}
+
+// UserDeletedAtWithin filters rows with UserDeletedAt not earlier than d ago
+// nolint: dupl
+func (qs PostQuerySet) UserDeletedAtWithin(d time.Duration) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.deleted_at >= ?", time.Now().Add(-d)))
+}
+
+// UserEmailEndsWith filters rows with UserEmail ending with the argument
+// nolint: dupl
+func (qs PostQuerySet) UserEmailEndsWith(userEmail string) PostQuerySet {
+	return qs.w(whereLike(qs.db, "user_join.email", "%", userEmail, "", false))
+}
+
+// UserEmailEq is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserEmailEq(userEmail string) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.email = ?", userEmail))
+}
+
+// UserEmailGt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserEmailGt(userEmail string) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.email > ?", userEmail))
+}
+
+// UserEmailGte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserEmailGte(userEmail string) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.email >= ?", userEmail))
+}
+
+// UserEmailIContains filters rows with UserEmail containing the argument, case is ignored
+// nolint: dupl
+func (qs PostQuerySet) UserEmailIContains(userEmail string) PostQuerySet {
+	return qs.w(whereLike(qs.db, "user_join.email", "%", userEmail, "%", true))
+}
+
+// UserEmailIEq filters rows with UserEmail equal to the argument, case is ignored
+// nolint: dupl
+func (qs PostQuerySet) UserEmailIEq(userEmail string) PostQuerySet {
+	return qs.w(whereLike(qs.db, "user_join.email", "", userEmail, "", true))
+}
+
+// UserEmailIn is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserEmailIn(userEmail ...string) PostQuerySet {
+	if len(userEmail) == 0 {
+		qs.db.AddError(errors.New("must at least pass one userEmail in UserEmailIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("user_join.email IN (?)", userEmail))
+}
+
+// UserEmailInQuery filters rows with UserEmail IN values selected by subquery q
+// nolint: dupl
+func (qs PostQuerySet) UserEmailInQuery(q StringSubquery) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.email IN (?)", q.expr()))
+}
+
+// UserEmailLike is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserEmailLike(userEmail string) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.email LIKE ?", userEmail))
+}
+
+// UserEmailLt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserEmailLt(userEmail string) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.email < ?", userEmail))
+}
+
+// UserEmailLte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserEmailLte(userEmail string) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.email <= ?", userEmail))
+}
+
+// UserEmailNe is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserEmailNe(userEmail string) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.email != ?", userEmail))
+}
+
+// UserEmailNotIn is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserEmailNotIn(userEmail ...string) PostQuerySet {
+	if len(userEmail) == 0 {
+		qs.db.AddError(errors.New("must at least pass one userEmail in UserEmailNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("user_join.email NOT IN (?)", userEmail))
+}
+
+// UserEmailNotInQuery filters rows with UserEmail NOT IN values selected by subquery q
+// nolint: dupl
+func (qs PostQuerySet) UserEmailNotInQuery(q StringSubquery) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.email NOT IN (?)", q.expr()))
+}
+
+// UserEmailNotlike is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserEmailNotlike(userEmail string) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.email NOT LIKE ?", userEmail))
+}
+
+// UserEmailStartsWith filters rows with UserEmail starting with the argument
+// nolint: dupl
+func (qs PostQuerySet) UserEmailStartsWith(userEmail string) PostQuerySet {
+	return qs.w(whereLike(qs.db, "user_join.email", "", userEmail, "%", false))
+}
+
+// UserIDBetween filters rows with UserID in range [from, to]
+// nolint: dupl
+func (qs PostQuerySet) UserIDBetween(from uint, to uint) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("user_id")+" BETWEEN ? AND ?", from, to))
+}
+
+// UserIDEq is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserIDEq(userID uint) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("user_id")+" = ?", userID))
+}
+
+// UserIDGt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserIDGt(userID uint) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("user_id")+" > ?", userID))
+}
+
+// UserIDGte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserIDGte(userID uint) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("user_id")+" >= ?", userID))
+}
+
+// UserIDIn is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserIDIn(userID ...uint) PostQuerySet {
+	if len(userID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one userID in UserIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where(qs.column("user_id")+" IN (?)", userID))
+}
+
+// UserIDInQuery filters rows with UserID IN values selected by subquery q
+// nolint: dupl
+func (qs PostQuerySet) UserIDInQuery(q UintSubquery) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("user_id")+" IN (?)", q.expr()))
+}
+
+// UserIDInRange filters rows with UserID in range [from, to)
+// nolint: dupl
+func (qs PostQuerySet) UserIDInRange(from uint, to uint) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("user_id")+" >= ? AND "+qs.column("user_id")+" < ?", from, to))
+}
+
+// UserIDLt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserIDLt(userID uint) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("user_id")+" < ?", userID))
+}
+
+// UserIDLte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserIDLte(userID uint) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("user_id")+" <= ?", userID))
+}
+
+// UserIDNe is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserIDNe(userID uint) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("user_id")+" != ?", userID))
+}
+
+// UserIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserIDNotIn(userID ...uint) PostQuerySet {
+	if len(userID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one userID in UserIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where(qs.column("user_id")+" NOT IN (?)", userID))
+}
+
+// UserIDNotInQuery filters rows with UserID NOT IN values selected by subquery q
+// nolint: dupl
+func (qs PostQuerySet) UserIDNotInQuery(q UintSubquery) PostQuerySet {
+	return qs.w(qs.db.Where(qs.column("user_id")+" NOT IN (?)", q.expr()))
+}
+
+// UserNameEndsWith filters rows with UserName ending with the argument
+// nolint: dupl
+func (qs PostQuerySet) UserNameEndsWith(userName string) PostQuerySet {
+	return qs.w(whereLike(qs.db, "user_join.name", "%", userName, "", false))
+}
+
+// UserNameEq is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserNameEq(userName string) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.name = ?", userName))
+}
+
+// UserNameGt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserNameGt(userName string) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.name > ?", userName))
+}
+
+// UserNameGte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserNameGte(userName string) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.name >= ?", userName))
+}
+
+// UserNameIContains filters rows with UserName containing the argument, case is ignored
+// nolint: dupl
+func (qs PostQuerySet) UserNameIContains(userName string) PostQuerySet {
+	return qs.w(whereLike(qs.db, "user_join.name", "%", userName, "%", true))
+}
+
+// UserNameIEq filters rows with UserName equal to the argument, case is ignored
+// nolint: dupl
+func (qs PostQuerySet) UserNameIEq(userName string) PostQuerySet {
+	return qs.w(whereLike(qs.db, "user_join.name", "", userName, "", true))
+}
+
+// UserNameIn is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserNameIn(userName ...string) PostQuerySet {
+	if len(userName) == 0 {
+		qs.db.AddError(errors.New("must at least pass one userName in UserNameIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("user_join.name IN (?)", userName))
+}
+
+// UserNameInQuery filters rows with UserName IN values selected by subquery q
+// nolint: dupl
+func (qs PostQuerySet) UserNameInQuery(q StringSubquery) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.name IN (?)", q.expr()))
+}
+
+// UserNameLike is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserNameLike(userName string) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.name LIKE ?", userName))
+}
+
+// UserNameLt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserNameLt(userName string) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.name < ?", userName))
+}
+
+// UserNameLte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserNameLte(userName string) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.name <= ?", userName))
+}
+
+// UserNameNe is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserNameNe(userName string) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.name != ?", userName))
+}
+
+// UserNameNotIn is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserNameNotIn(userName ...string) PostQuerySet {
+	if len(userName) == 0 {
+		qs.db.AddError(errors.New("must at least pass one userName in UserNameNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("user_join.name NOT IN (?)", userName))
+}
+
+// UserNameNotInQuery filters rows with UserName NOT IN values selected by subquery q
+// nolint: dupl
+func (qs PostQuerySet) UserNameNotInQuery(q StringSubquery) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.name NOT IN (?)", q.expr()))
+}
+
+// UserNameNotlike is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserNameNotlike(userName string) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.name NOT LIKE ?", userName))
+}
+
+// UserNameStartsWith filters rows with UserName starting with the argument
+// nolint: dupl
+func (qs PostQuerySet) UserNameStartsWith(userName string) PostQuerySet {
+	return qs.w(whereLike(qs.db, "user_join.name", "", userName, "%", false))
+}
+
+// UserSurnameEndsWith filters rows with UserSurname ending with the argument
+// nolint: dupl
+func (qs PostQuerySet) UserSurnameEndsWith(userSurname string) PostQuerySet {
+	return qs.w(whereLike(qs.db, "user_join.user_surname", "%", userSurname, "", false))
+}
+
+// UserSurnameEq is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserSurnameEq(userSurname string) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.user_surname = ?", userSurname))
+}
+
+// UserSurnameGt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserSurnameGt(userSurname string) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.user_surname > ?", userSurname))
+}
+
+// UserSurnameGte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserSurnameGte(userSurname string) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.user_surname >= ?", userSurname))
+}
+
+// UserSurnameIContains filters rows with UserSurname containing the argument, case is ignored
+// nolint: dupl
+func (qs PostQuerySet) UserSurnameIContains(userSurname string) PostQuerySet {
+	return qs.w(whereLike(qs.db, "user_join.user_surname", "%", userSurname, "%", true))
+}
+
+// UserSurnameIEq filters rows with UserSurname equal to the argument, case is ignored
+// nolint: dupl
+func (qs PostQuerySet) UserSurnameIEq(userSurname string) PostQuerySet {
+	return qs.w(whereLike(qs.db, "user_join.user_surname", "", userSurname, "", true))
+}
+
+// UserSurnameIn is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserSurnameIn(userSurname ...string) PostQuerySet {
+	if len(userSurname) == 0 {
+		qs.db.AddError(errors.New("must at least pass one userSurname in UserSurnameIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("user_join.user_surname IN (?)", userSurname))
+}
+
+// UserSurnameInQuery filters rows with UserSurname IN values selected by subquery q
+// nolint: dupl
+func (qs PostQuerySet) UserSurnameInQuery(q StringSubquery) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.user_surname IN (?)", q.expr()))
+}
+
+// UserSurnameIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserSurnameIsNotNull() PostQuerySet {
+	return qs.w(qs.db.Where("user_join.user_surname IS NOT NULL"))
+}
+
+// UserSurnameIsNull is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserSurnameIsNull() PostQuerySet {
+	return qs.w(qs.db.Where("user_join.user_surname IS NULL"))
+}
+
+// UserSurnameLike is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserSurnameLike(userSurname string) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.user_surname LIKE ?", userSurname))
+}
+
+// UserSurnameLt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserSurnameLt(userSurname string) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.user_surname < ?", userSurname))
+}
+
+// UserSurnameLte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserSurnameLte(userSurname string) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.user_surname <= ?", userSurname))
+}
+
+// UserSurnameNe is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserSurnameNe(userSurname string) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.user_surname != ?", userSurname))
+}
+
+// UserSurnameNotIn is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserSurnameNotIn(userSurname ...string) PostQuerySet {
+	if len(userSurname) == 0 {
+		qs.db.AddError(errors.New("must at least pass one userSurname in UserSurnameNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("user_join.user_surname NOT IN (?)", userSurname))
+}
+
+// UserSurnameNotInQuery filters rows with UserSurname NOT IN values selected by subquery q
+// nolint: dupl
+func (qs PostQuerySet) UserSurnameNotInQuery(q StringSubquery) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.user_surname NOT IN (?)", q.expr()))
+}
+
+// UserSurnameNotlike is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserSurnameNotlike(userSurname string) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.user_surname NOT LIKE ?", userSurname))
+}
+
+// UserSurnameStartsWith filters rows with UserSurname starting with the argument
+// nolint: dupl
+func (qs PostQuerySet) UserSurnameStartsWith(userSurname string) PostQuerySet {
+	return qs.w(whereLike(qs.db, "user_join.user_surname", "", userSurname, "%", false))
+}
+
+// UserUpdatedAtBetween filters rows with UserUpdatedAt in range [from, to]
+// nolint: dupl
+func (qs PostQuerySet) UserUpdatedAtBetween(from time.Time, to time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.updated_at BETWEEN ? AND ?", from, to))
+}
+
+// UserUpdatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserUpdatedAtEq(userUpdatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.updated_at = ?", userUpdatedAt))
+}
+
+// UserUpdatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserUpdatedAtGt(userUpdatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.updated_at > ?", userUpdatedAt))
+}
+
+// UserUpdatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserUpdatedAtGte(userUpdatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.updated_at >= ?", userUpdatedAt))
+}
+
+// UserUpdatedAtInRange filters rows with UserUpdatedAt in range [from, to)
+// nolint: dupl
+func (qs PostQuerySet) UserUpdatedAtInRange(from time.Time, to time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.updated_at >= ? AND user_join.updated_at < ?", from, to))
+}
+
+// UserUpdatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserUpdatedAtLt(userUpdatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.updated_at < ?", userUpdatedAt))
+}
+
+// UserUpdatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserUpdatedAtLte(userUpdatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.updated_at <= ?", userUpdatedAt))
+}
+
+// UserUpdatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs PostQuerySet) UserUpdatedAtNe(userUpdatedAt time.Time) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.updated_at != ?", userUpdatedAt))
+}
+
+// UserUpdatedAtWithin filters rows with UserUpdatedAt not earlier than d ago
+// nolint: dupl
+func (qs PostQuerySet) UserUpdatedAtWithin(d time.Duration) PostQuerySet {
+	return qs.w(qs.db.Where("user_join.updated_at >= ?", time.Now().Add(-d)))
+}
+
+// WithContext returns copy executing queries with context ctx.
+// Gorm v1 doesn't support context: its error is checked only before query.
+// nolint: dupl
+func (qs PostQuerySet) WithContext(ctx context.Context) PostQuerySet {
+	return qs.w(DBWithContext(ctx, qs.db))
+}
+
+// column returns column name qualified by quoted table of model
+// nolint: dupl
+func (qs PostQuerySet) column(name string) string {
+	return qs.quotedTableName(&Post{}) + "." + name
+}
+
+// fieldPtrs returns pointers to fields of o by their columns
+// nolint: dupl
+func (qs PostQuerySet) fieldPtrs(o *Post) map[string]interface{} {
+	return map[string]interface{}{
+		"id":         &o.ID,
+		"created_at": &o.CreatedAt,
+		"updated_at": &o.UpdatedAt,
+		"deleted_at": &o.DeletedAt,
+		"blog_id":    &o.BlogID,
+		"user_id":    &o.UserID,
+		"title":      &o.Title,
+		"str":        &o.Str,
+	}
+}
+
+// quotedTableName returns quoted name of model's table
+// nolint: dupl
+func (qs PostQuerySet) quotedTableName(model interface{}) string {
+	stmt := &gorm.Statement{DB: qs.db}
+	if err := stmt.Parse(model); err != nil {
+		qs.db.AddError(err)
+		return ""
+	}
+	return qs.db.Statement.Quote(stmt.Schema.Table)
+}
+
+// InTx returns copy bound to transaction tx: queries are executed in it.
+// Gorm v1 can't move conditions into another connection, so with gorm v1
+// InTx must be called before any condition is set, otherwise queries fail.
+// Context and preloads are moved into transaction by both gorm versions.
+// nolint: dupl
+func (u PostUpdater) InTx(tx *gorm.DB) PostUpdater {
+	ctx := tx.Statement.Context
+	if u.db.Statement.Context != context.Background() {
+		ctx = u.db.Statement.Context // set by WithContext
+	}
+	db := u.db.Session(&gorm.Session{Context: ctx})
+	db.Statement.ConnPool = tx.Statement.ConnPool
+	if tx.Error != nil {
+		db.AddError(tx.Error)
+	}
+	u.db = db
+	return u
+}
+
+// SetBlog sets foreign key BlogID to ID of blog, nil clears it
+// nolint: dupl
+func (u PostUpdater) SetBlog(blog *Blog) PostUpdater {
+	var id *uint
+	if blog != nil {
+		v := blog.ID
+		id = &v
+	}
+	u.fields[string(PostDBSchema.BlogID)] = id
+	return u
+}
+
+// SetBlogID is an autogenerated method
+// nolint: dupl
+func (u PostUpdater) SetBlogID(blogID *uint) PostUpdater {
+	u.fields[string(PostDBSchema.BlogID)] = blogID
+	return u
+}
+
+// SetCreatedAt is an autogenerated method
+// nolint: dupl
+func (u PostUpdater) SetCreatedAt(createdAt time.Time) PostUpdater {
+	u.fields[string(PostDBSchema.CreatedAt)] = createdAt
+	return u
+}
+
+// SetDeletedAt is an autogenerated method
+// nolint: dupl
+func (u PostUpdater) SetDeletedAt(deletedAt time.Time) PostUpdater {
+	u.fields[string(PostDBSchema.DeletedAt)] = deletedAt
+	return u
+}
+
+// SetDeletedAtNull sets DeletedAt to NULL
+// nolint: dupl
+func (u PostUpdater) SetDeletedAtNull() PostUpdater {
+	u.fields[string(PostDBSchema.DeletedAt)] = nil
+	return u
+}
+
+// SetID is an autogenerated method
+// nolint: dupl
+func (u PostUpdater) SetID(ID uint) PostUpdater {
+	u.fields[string(PostDBSchema.ID)] = ID
+	return u
+}
+
+// SetStr is an autogenerated method
+// nolint: dupl
+func (u PostUpdater) SetStr(str tmp.StringDef) PostUpdater {
+	u.fields[string(PostDBSchema.Str)] = str
+	return u
+}
+
+// SetTitle is an autogenerated method
+// nolint: dupl
+func (u PostUpdater) SetTitle(title *string) PostUpdater {
+	u.fields[string(PostDBSchema.Title)] = title
+	return u
+}
+
+// SetUpdatedAt is an autogenerated method
+// nolint: dupl
+func (u PostUpdater) SetUpdatedAt(updatedAt time.Time) PostUpdater {
+	u.fields[string(PostDBSchema.UpdatedAt)] = updatedAt
+	return u
+}
+
+// SetUser sets foreign key UserID to ID of user, nil clears it
+// nolint: dupl
+func (u PostUpdater) SetUser(user *User) PostUpdater {
+	var id uint
+	if user != nil {
+		id = user.ID
+	}
+	u.fields[string(PostDBSchema.UserID)] = id
+	return u
+}
+
+// SetUserID is an autogenerated method
+// nolint: dupl
+func (u PostUpdater) SetUserID(userID uint) PostUpdater {
+	u.fields[string(PostDBSchema.UserID)] = userID
+	return u
+}
+
+// Update is an autogenerated method
+// nolint: dupl
+func (u PostUpdater) Update() error {
+	return u.db.Updates(u.fields).Error
+}
+
+// UpdateNum is an autogenerated method
+// nolint: dupl
+func (u PostUpdater) UpdateNum() (int64, error) {
+	db := u.db.Updates(u.fields)
+	return db.RowsAffected, db.Error
+}
+
+// WithContext returns copy executing queries with context ctx.
+// Gorm v1 doesn't support context: its error is checked only before query.
+// nolint: dupl
+func (u PostUpdater) WithContext(ctx context.Context) PostUpdater {
+	u.db = DBWithContext(ctx, u.db)
+	return u
+}
+
+// ===== END of query set PostQuerySet
+
+// ===== BEGIN of Post modifiers
+
+// PostDBSchemaField describes database schema field. It requires for method 'Update'
+type PostDBSchemaField string
+
+// String method returns string representation of field.
+// nolint: dupl
+func (f PostDBSchemaField) String() string {
+	return string(f)
+}
+
+// PostDBSchema stores db field names of Post
+var PostDBSchema = struct {
+	ID        PostDBSchemaField
+	CreatedAt PostDBSchemaField
+	UpdatedAt PostDBSchemaField
+	DeletedAt PostDBSchemaField
+	BlogID    PostDBSchemaField
+	Blog      PostDBSchemaField
+	UserID    PostDBSchemaField
+	User      PostDBSchemaField
+	Title     PostDBSchemaField
+	Str       PostDBSchemaField
+}{
+
+	ID:        PostDBSchemaField("id"),
+	CreatedAt: PostDBSchemaField("created_at"),
+	UpdatedAt: PostDBSchemaField("updated_at"),
+	DeletedAt: PostDBSchemaField("deleted_at"),
+	BlogID:    PostDBSchemaField("blog_id"),
+	Blog:      PostDBSchemaField("blog"),
+	UserID:    PostDBSchemaField("user_id"),
+	User:      PostDBSchemaField("user"),
+	Title:     PostDBSchemaField("title"),
+	Str:       PostDBSchemaField("str"),
+}
+
+// Update updates Post fields by primary key
+// nolint: dupl
+func (o *Post) Update(db *gorm.DB, fields ...PostDBSchemaField) error {
+	dbNameToFieldName := map[string]interface{}{
+		"id":         o.ID,
+		"created_at": o.CreatedAt,
+		"updated_at": o.UpdatedAt,
+		"deleted_at": o.DeletedAt,
+		"blog_id":    o.BlogID,
+		"blog":       o.Blog,
+		"user_id":    o.UserID,
+		"user":       o.User,
+		"title":      o.Title,
+		"str":        o.Str,
+	}
+	u := map[string]interface{}{}
+	for _, f := range fields {
+		fs := f.String()
+		u[fs] = dbNameToFieldName[fs]
+	}
+	if err := db.Model(o).Updates(u).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+
+		return fmt.Errorf("can't update Post %v fields %v: %s",
+			o, fields, err)
+	}
+
+	return nil
+}
+
+// Upsert inserts Post or updates updateFields of existing row
+// if insert violates unique constraint on conflictFields. Row isn't
+// changed if there are no updateFields: any conflict is ignored then if
+// there are no conflictFields. MySQL ignores conflictFields: it checks
+// all unique constraints, other databases require them to update row.
+// nolint: dupl
+func (o *Post) Upsert(db *gorm.DB, conflictFields []PostDBSchemaField,
+	updateFields ...PostDBSchemaField) error {
+
+	conflictColumns := make([]string, 0, len(conflictFields))
+	for _, f := range conflictFields {
+		conflictColumns = append(conflictColumns, f.String())
+	}
+	updateColumns := make([]string, 0, len(updateFields))
+	for _, f := range updateFields {
+		updateColumns = append(updateColumns, f.String())
+	}
+
+	if err := checkConflictFields(db, conflictColumns, updateColumns); err != nil {
+		return err
+	}
+
+	onConflict := clause.OnConflict{
+		DoNothing: len(updateColumns) == 0,
+	}
+	for _, c := range conflictColumns {
+		onConflict.Columns = append(onConflict.Columns, clause.Column{Name: c})
+	}
+	if len(updateColumns) != 0 {
+		onConflict.DoUpdates = clause.AssignmentColumns(updateColumns)
+	}
+
+	return db.Clauses(onConflict).Create(o).Error
+}
+
+// PostUpdater is an Post updates manager
+type PostUpdater struct {
+	fields map[string]interface{}
+	db     *gorm.DB
+}
+
+// NewPostUpdater creates new Post updater
+// nolint: dupl
+func NewPostUpdater(db *gorm.DB) PostUpdater {
+	return PostUpdater{
+		fields: map[string]interface{}{},
+		db:     db.Model(&Post{}),
+	}
+}
+
+// ===== END of Post modifiers
+
+// ===== BEGIN of Post predicates
+
+// PostPredicate is a condition on Post fields for Where and Not
+// methods of PostQuerySet: predicates are created by PostQ and
+// combined by And, Or and Not. Zero predicate matches all rows.
+type PostPredicate struct {
+	expr string
+	args []interface{}
+	err  error
+}
+
+// join combines predicates by operation op, empty predicates are skipped
+// nolint: dupl
+func (p PostPredicate) join(op string, preds []PostPredicate) PostPredicate {
+	for _, q := range preds {
+		if p.err == nil {
+			p.err = q.err
+		}
+		if q.expr == "" {
+			continue
+		}
+		if p.expr == "" {
+			p.expr, p.args = q.expr, q.args
+			continue
+		}
+
+		p.expr = "(" + p.expr + ") " + op + " (" + q.expr + ")"
+		p.args = append(append([]interface{}{}, p.args...), q.args...)
+	}
+
+	return p
+}
+
+// And returns predicate matching rows matched by p and all preds
+// nolint: dupl
+func (p PostPredicate) And(preds ...PostPredicate) PostPredicate {
+	return p.join("AND", preds)
+}
+
+// Or returns predicate matching rows matched by p or any of preds
+// nolint: dupl
+func (p PostPredicate) Or(preds ...PostPredicate) PostPredicate {
+	return p.join("OR", preds)
+}
+
+// Not returns predicate matching rows not matched by p
+// nolint: dupl
+func (p PostPredicate) Not() PostPredicate {
+	if p.expr != "" {
+		p.expr = "NOT (" + p.expr + ")"
+	}
+
+	return p
+}
+
+// PostPredicates creates predicates by Post fields
+type PostPredicates struct{}
+
+// PostQ creates predicates by Post fields
+var PostQ PostPredicates
+
+// BlogIDEq creates predicate "blog_id = ?"
+// nolint: dupl
+func (PostPredicates) BlogIDEq(blogID uint) PostPredicate {
+	return PostPredicate{expr: "{table}.blog_id = ?", args: []interface{}{blogID}}
+}
+
+// BlogIDGt creates predicate "blog_id > ?"
+// nolint: dupl
+func (PostPredicates) BlogIDGt(blogID uint) PostPredicate {
+	return PostPredicate{expr: "{table}.blog_id > ?", args: []interface{}{blogID}}
+}
+
+// BlogIDGte creates predicate "blog_id >= ?"
+// nolint: dupl
+func (PostPredicates) BlogIDGte(blogID uint) PostPredicate {
+	return PostPredicate{expr: "{table}.blog_id >= ?", args: []interface{}{blogID}}
+}
+
+// BlogIDIn creates predicate "blog_id IN (?)"
+// nolint: dupl
+func (PostPredicates) BlogIDIn(blogID ...uint) PostPredicate {
+	if len(blogID) == 0 {
+		return PostPredicate{err: errors.New("must at least pass one blogID in BlogIDIn")}
+	}
+	return PostPredicate{expr: "{table}.blog_id IN (?)", args: []interface{}{blogID}}
+}
+
+// BlogIDIsNotNull creates predicate "blog_id IS NOT NULL"
+// nolint: dupl
+func (PostPredicates) BlogIDIsNotNull() PostPredicate {
+	return PostPredicate{expr: "{table}.blog_id IS NOT NULL"}
+}
+
+// BlogIDIsNull creates predicate "blog_id IS NULL"
+// nolint: dupl
+func (PostPredicates) BlogIDIsNull() PostPredicate {
+	return PostPredicate{expr: "{table}.blog_id IS NULL"}
+}
+
+// BlogIDLt creates predicate "blog_id < ?"
+// nolint: dupl
+func (PostPredicates) BlogIDLt(blogID uint) PostPredicate {
+	return PostPredicate{expr: "{table}.blog_id < ?", args: []interface{}{blogID}}
+}
+
+// BlogIDLte creates predicate "blog_id <= ?"
+// nolint: dupl
+func (PostPredicates) BlogIDLte(blogID uint) PostPredicate {
+	return PostPredicate{expr: "{table}.blog_id <= ?", args: []interface{}{blogID}}
+}
+
+// BlogIDNe creates predicate "blog_id != ?"
+// nolint: dupl
+func (PostPredicates) BlogIDNe(blogID uint) PostPredicate {
+	return PostPredicate{expr: "{table}.blog_id != ?", args: []interface{}{blogID}}
+}
+
+// BlogIDNotIn creates predicate "blog_id NOT IN (?)"
+// nolint: dupl
+func (PostPredicates) BlogIDNotIn(blogID ...uint) PostPredicate {
+	if len(blogID) == 0 {
+		return PostPredicate{err: errors.New("must at least pass one blogID in BlogIDNotIn")}
+	}
+	return PostPredicate{expr: "{table}.blog_id NOT IN (?)", args: []interface{}{blogID}}
+}
+
+// BlogIsNotNull creates predicate "blog IS NOT NULL"
+// nolint: dupl
+func (PostPredicates) BlogIsNotNull() PostPredicate {
+	return PostPredicate{expr: "{table}.blog IS NOT NULL"}
+}
+
+// BlogIsNull creates predicate "blog IS NULL"
+// nolint: dupl
+func (PostPredicates) BlogIsNull() PostPredicate {
+	return PostPredicate{expr: "{table}.blog IS NULL"}
+}
+
+// CreatedAtEq creates predicate "created_at = ?"
+// nolint: dupl
+func (PostPredicates) CreatedAtEq(createdAt time.Time) PostPredicate {
+	return PostPredicate{expr: "{table}.created_at = ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtGt creates predicate "created_at > ?"
+// nolint: dupl
+func (PostPredicates) CreatedAtGt(createdAt time.Time) PostPredicate {
+	return PostPredicate{expr: "{table}.created_at > ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtGte creates predicate "created_at >= ?"
+// nolint: dupl
+func (PostPredicates) CreatedAtGte(createdAt time.Time) PostPredicate {
+	return PostPredicate{expr: "{table}.created_at >= ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtLt creates predicate "created_at < ?"
+// nolint: dupl
+func (PostPredicates) CreatedAtLt(createdAt time.Time) PostPredicate {
+	return PostPredicate{expr: "{table}.created_at < ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtLte creates predicate "created_at <= ?"
+// nolint: dupl
+func (PostPredicates) CreatedAtLte(createdAt time.Time) PostPredicate {
+	return PostPredicate{expr: "{table}.created_at <= ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtNe creates predicate "created_at != ?"
+// nolint: dupl
+func (PostPredicates) CreatedAtNe(createdAt time.Time) PostPredicate {
+	return PostPredicate{expr: "{table}.created_at != ?", args: []interface{}{createdAt}}
+}
+
+// DeletedAtEq creates predicate "deleted_at = ?"
+// nolint: dupl
+func (PostPredicates) DeletedAtEq(deletedAt time.Time) PostPredicate {
+	return PostPredicate{expr: "{table}.deleted_at = ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtGt creates predicate "deleted_at > ?"
+// nolint: dupl
+func (PostPredicates) DeletedAtGt(deletedAt time.Time) PostPredicate {
+	return PostPredicate{expr: "{table}.deleted_at > ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtGte creates predicate "deleted_at >= ?"
+// nolint: dupl
+func (PostPredicates) DeletedAtGte(deletedAt time.Time) PostPredicate {
+	return PostPredicate{expr: "{table}.deleted_at >= ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtIsNotNull creates predicate "deleted_at IS NOT NULL"
+// nolint: dupl
+func (PostPredicates) DeletedAtIsNotNull() PostPredicate {
+	return PostPredicate{expr: "{table}.deleted_at IS NOT NULL"}
+}
+
+// DeletedAtIsNull creates predicate "deleted_at IS NULL"
+// nolint: dupl
+func (PostPredicates) DeletedAtIsNull() PostPredicate {
+	return PostPredicate{expr: "{table}.deleted_at IS NULL"}
+}
+
+// DeletedAtLt creates predicate "deleted_at < ?"
+// nolint: dupl
+func (PostPredicates) DeletedAtLt(deletedAt time.Time) PostPredicate {
+	return PostPredicate{expr: "{table}.deleted_at < ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtLte creates predicate "deleted_at <= ?"
+// nolint: dupl
+func (PostPredicates) DeletedAtLte(deletedAt time.Time) PostPredicate {
+	return PostPredicate{expr: "{table}.deleted_at <= ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtNe creates predicate "deleted_at != ?"
+// nolint: dupl
+func (PostPredicates) DeletedAtNe(deletedAt time.Time) PostPredicate {
+	return PostPredicate{expr: "{table}.deleted_at != ?", args: []interface{}{deletedAt}}
+}
+
+// IDEq creates predicate "id = ?"
+// nolint: dupl
+func (PostPredicates) IDEq(ID uint) PostPredicate {
+	return PostPredicate{expr: "{table}.id = ?", args: []interface{}{ID}}
+}
+
+// IDGt creates predicate "id > ?"
+// nolint: dupl
+func (PostPredicates) IDGt(ID uint) PostPredicate {
+	return PostPredicate{expr: "{table}.id > ?", args: []interface{}{ID}}
+}
+
+// IDGte creates predicate "id >= ?"
+// nolint: dupl
+func (PostPredicates) IDGte(ID uint) PostPredicate {
+	return PostPredicate{expr: "{table}.id >= ?", args: []interface{}{ID}}
+}
+
+// IDIn creates predicate "id IN (?)"
+// nolint: dupl
+func (PostPredicates) IDIn(ID ...uint) PostPredicate {
+	if len(ID) == 0 {
+		return PostPredicate{err: errors.New("must at least pass one ID in IDIn")}
+	}
+	return PostPredicate{expr: "{table}.id IN (?)", args: []interface{}{ID}}
+}
+
+// IDLt creates predicate "id < ?"
+// nolint: dupl
+func (PostPredicates) IDLt(ID uint) PostPredicate {
+	return PostPredicate{expr: "{table}.id < ?", args: []interface{}{ID}}
+}
+
+// IDLte creates predicate "id <= ?"
+// nolint: dupl
+func (PostPredicates) IDLte(ID uint) PostPredicate {
+	return PostPredicate{expr: "{table}.id <= ?", args: []interface{}{ID}}
+}
+
+// IDNe creates predicate "id != ?"
+// nolint: dupl
+func (PostPredicates) IDNe(ID uint) PostPredicate {
+	return PostPredicate{expr: "{table}.id != ?", args: []interface{}{ID}}
+}
+
+// IDNotIn creates predicate "id NOT IN (?)"
+// nolint: dupl
+func (PostPredicates) IDNotIn(ID ...uint) PostPredicate {
+	if len(ID) == 0 {
+		return PostPredicate{err: errors.New("must at least pass one ID in IDNotIn")}
+	}
+	return PostPredicate{expr: "{table}.id NOT IN (?)", args: []interface{}{ID}}
+}
+
+// StrEq creates predicate "str = ?"
+// nolint: dupl
+func (PostPredicates) StrEq(str tmp.StringDef) PostPredicate {
+	return PostPredicate{expr: "{table}.str = ?", args: []interface{}{str}}
+}
+
+// StrGt creates predicate "str > ?"
+// nolint: dupl
+func (PostPredicates) StrGt(str tmp.StringDef) PostPredicate {
+	return PostPredicate{expr: "{table}.str > ?", args: []interface{}{str}}
+}
+
+// StrGte creates predicate "str >= ?"
+// nolint: dupl
+func (PostPredicates) StrGte(str tmp.StringDef) PostPredicate {
+	return PostPredicate{expr: "{table}.str >= ?", args: []interface{}{str}}
+}
+
+// StrIn creates predicate "str IN (?)"
+// nolint: dupl
+func (PostPredicates) StrIn(str ...tmp.StringDef) PostPredicate {
+	if len(str) == 0 {
+		return PostPredicate{err: errors.New("must at least pass one str in StrIn")}
+	}
+	return PostPredicate{expr: "{table}.str IN (?)", args: []interface{}{str}}
+}
+
+// StrLike creates predicate "str LIKE ?"
+// nolint: dupl
+func (PostPredicates) StrLike(str tmp.StringDef) PostPredicate {
+	return PostPredicate{expr: "{table}.str LIKE ?", args: []interface{}{str}}
+}
+
+// StrLt creates predicate "str < ?"
+// nolint: dupl
+func (PostPredicates) StrLt(str tmp.StringDef) PostPredicate {
+	return PostPredicate{expr: "{table}.str < ?", args: []interface{}{str}}
+}
+
+// StrLte creates predicate "str <= ?"
+// nolint: dupl
+func (PostPredicates) StrLte(str tmp.StringDef) PostPredicate {
+	return PostPredicate{expr: "{table}.str <= ?", args: []interface{}{str}}
+}
+
+// StrNe creates predicate "str != ?"
+// nolint: dupl
+func (PostPredicates) StrNe(str tmp.StringDef) PostPredicate {
+	return PostPredicate{expr: "{table}.str != ?", args: []interface{}{str}}
+}
+
+// StrNotIn creates predicate "str NOT IN (?)"
+// nolint: dupl
+func (PostPredicates) StrNotIn(str ...tmp.StringDef) PostPredicate {
+	if len(str) == 0 {
+		return PostPredicate{err: errors.New("must at least pass one str in StrNotIn")}
+	}
+	return PostPredicate{expr: "{table}.str NOT IN (?)", args: []interface{}{str}}
+}
+
+// StrNotlike creates predicate "str NOT LIKE ?"
+// nolint: dupl
+func (PostPredicates) StrNotlike(str tmp.StringDef) PostPredicate {
+	return PostPredicate{expr: "{table}.str NOT LIKE ?", args: []interface{}{str}}
+}
+
+// TitleEq creates predicate "title = ?"
+// nolint: dupl
+func (PostPredicates) TitleEq(title string) PostPredicate {
+	return PostPredicate{expr: "{table}.title = ?", args: []interface{}{title}}
+}
+
+// TitleGt creates predicate "title > ?"
+// nolint: dupl
+func (PostPredicates) TitleGt(title string) PostPredicate {
+	return PostPredicate{expr: "{table}.title > ?", args: []interface{}{title}}
+}
+
+// TitleGte creates predicate "title >= ?"
+// nolint: dupl
+func (PostPredicates) TitleGte(title string) PostPredicate {
+	return PostPredicate{expr: "{table}.title >= ?", args: []interface{}{title}}
+}
+
+// TitleIn creates predicate "title IN (?)"
+// nolint: dupl
+func (PostPredicates) TitleIn(title ...string) PostPredicate {
+	if len(title) == 0 {
+		return PostPredicate{err: errors.New("must at least pass one title in TitleIn")}
+	}
+	return PostPredicate{expr: "{table}.title IN (?)", args: []interface{}{title}}
+}
+
+// TitleIsNotNull creates predicate "title IS NOT NULL"
+// nolint: dupl
+func (PostPredicates) TitleIsNotNull() PostPredicate {
+	return PostPredicate{expr: "{table}.title IS NOT NULL"}
+}
+
+// TitleIsNull creates predicate "title IS NULL"
+// nolint: dupl
+func (PostPredicates) TitleIsNull() PostPredicate {
+	return PostPredicate{expr: "{table}.title IS NULL"}
+}
+
+// TitleLike creates predicate "title LIKE ?"
+// nolint: dupl
+func (PostPredicates) TitleLike(title string) PostPredicate {
+	return PostPredicate{expr: "{table}.title LIKE ?", args: []interface{}{title}}
+}
+
+// TitleLt creates predicate "title < ?"
+// nolint: dupl
+func (PostPredicates) TitleLt(title string) PostPredicate {
+	return PostPredicate{expr: "{table}.title < ?", args: []interface{}{title}}
+}
+
+// TitleLte creates predicate "title <= ?"
+// nolint: dupl
+func (PostPredicates) TitleLte(title string) PostPredicate {
+	return PostPredicate{expr: "{table}.title <= ?", args: []interface{}{title}}
+}
+
+// TitleNe creates predicate "title != ?"
+// nolint: dupl
+func (PostPredicates) TitleNe(title string) PostPredicate {
+	return PostPredicate{expr: "{table}.title != ?", args: []interface{}{title}}
+}
+
+// TitleNotIn creates predicate "title NOT IN (?)"
+// nolint: dupl
+func (PostPredicates) TitleNotIn(title ...string) PostPredicate {
+	if len(title) == 0 {
+		return PostPredicate{err: errors.New("must at least pass one title in TitleNotIn")}
+	}
+	return PostPredicate{expr: "{table}.title NOT IN (?)", args: []interface{}{title}}
+}
+
+// TitleNotlike creates predicate "title NOT LIKE ?"
+// nolint: dupl
+func (PostPredicates) TitleNotlike(title string) PostPredicate {
+	return PostPredicate{expr: "{table}.title NOT LIKE ?", args: []interface{}{title}}
+}
+
+// UpdatedAtEq creates predicate "updated_at = ?"
+// nolint: dupl
+func (PostPredicates) UpdatedAtEq(updatedAt time.Time) PostPredicate {
+	return PostPredicate{expr: "{table}.updated_at = ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtGt creates predicate "updated_at > ?"
+// nolint: dupl
+func (PostPredicates) UpdatedAtGt(updatedAt time.Time) PostPredicate {
+	return PostPredicate{expr: "{table}.updated_at > ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtGte creates predicate "updated_at >= ?"
+// nolint: dupl
+func (PostPredicates) UpdatedAtGte(updatedAt time.Time) PostPredicate {
+	return PostPredicate{expr: "{table}.updated_at >= ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtLt creates predicate "updated_at < ?"
+// nolint: dupl
+func (PostPredicates) UpdatedAtLt(updatedAt time.Time) PostPredicate {
+	return PostPredicate{expr: "{table}.updated_at < ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtLte creates predicate "updated_at <= ?"
+// nolint: dupl
+func (PostPredicates) UpdatedAtLte(updatedAt time.Time) PostPredicate {
+	return PostPredicate{expr: "{table}.updated_at <= ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtNe creates predicate "updated_at != ?"
+// nolint: dupl
+func (PostPredicates) UpdatedAtNe(updatedAt time.Time) PostPredicate {
+	return PostPredicate{expr: "{table}.updated_at != ?", args: []interface{}{updatedAt}}
+}
+
+// UserIDEq creates predicate "user_id = ?"
+// nolint: dupl
+func (PostPredicates) UserIDEq(userID uint) PostPredicate {
+	return PostPredicate{expr: "{table}.user_id = ?", args: []interface{}{userID}}
+}
+
+// UserIDGt creates predicate "user_id > ?"
+// nolint: dupl
+func (PostPredicates) UserIDGt(userID uint) PostPredicate {
+	return PostPredicate{expr: "{table}.user_id > ?", args: []interface{}{userID}}
+}
+
+// UserIDGte creates predicate "user_id >= ?"
+// nolint: dupl
+func (PostPredicates) UserIDGte(userID uint) PostPredicate {
+	return PostPredicate{expr: "{table}.user_id >= ?", args: []interface{}{userID}}
+}
+
+// UserIDIn creates predicate "user_id IN (?)"
+// nolint: dupl
+func (PostPredicates) UserIDIn(userID ...uint) PostPredicate {
+	if len(userID) == 0 {
+		return PostPredicate{err: errors.New("must at least pass one userID in UserIDIn")}
+	}
+	return PostPredicate{expr: "{table}.user_id IN (?)", args: []interface{}{userID}}
+}
+
+// UserIDLt creates predicate "user_id < ?"
+// nolint: dupl
+func (PostPredicates) UserIDLt(userID uint) PostPredicate {
+	return PostPredicate{expr: "{table}.user_id < ?", args: []interface{}{userID}}
+}
+
+// UserIDLte creates predicate "user_id <= ?"
+// nolint: dupl
+func (PostPredicates) UserIDLte(userID uint) PostPredicate {
+	return PostPredicate{expr: "{table}.user_id <= ?", args: []interface{}{userID}}
+}
+
+// UserIDNe creates predicate "user_id != ?"
+// nolint: dupl
+func (PostPredicates) UserIDNe(userID uint) PostPredicate {
+	return PostPredicate{expr: "{table}.user_id != ?", args: []interface{}{userID}}
+}
+
+// UserIDNotIn creates predicate "user_id NOT IN (?)"
+// nolint: dupl
+func (PostPredicates) UserIDNotIn(userID ...uint) PostPredicate {
+	if len(userID) == 0 {
+		return PostPredicate{err: errors.New("must at least pass one userID in UserIDNotIn")}
+	}
+	return PostPredicate{expr: "{table}.user_id NOT IN (?)", args: []interface{}{userID}}
+}
+
+// ===== END of Post predicates
+
+// ===== BEGIN of query set UserQuerySet
+
+// UserQuerySet is an queryset type for User
+type UserQuerySet struct {
+	db *gorm.DB
+}
+
+// NewUserQuerySet constructs new UserQuerySet
+func NewUserQuerySet(db *gorm.DB) UserQuerySet {
+	return UserQuerySet{
+		// new session makes every chained call clone the statement,
+		// so a queryset can be safely reused as a base for other querysets
+		db: db.Model(&User{}).Session(&gorm.Session{}),
+	}
+}
+
+func (qs UserQuerySet) w(db *gorm.DB) UserQuerySet {
+	return NewUserQuerySet(db)
+}
+
+func (qs UserQuerySet) Select(fields ...UserDBSchemaField) UserQuerySet {
+	names := []string{}
+	for _, f := range fields {
+		names = append(names, f.String())
+	}
+
+	return qs.w(qs.db.Select(strings.Join(names, ",")))
+}
+
+// GroupBy groups rows by fields
+func (qs UserQuerySet) GroupBy(fields ...UserDBSchemaField) UserQuerySet {
+	names := []string{}
+	for _, f := range fields {
+		names = append(names, f.String())
+	}
+
+	return qs.w(qs.db.Group(strings.Join(names, ",")))
+}
+
+// Where filters rows by predicate p, predicates are created by UserQ
+// nolint: dupl
+func (qs UserQuerySet) Where(p UserPredicate) UserQuerySet {
+	if p.err != nil {
+		// error is added to copy of db: qs can be reused as a base queryset
+		db := qs.db.Session(&gorm.Session{})
+		db.AddError(p.err)
+		return qs.w(db)
+	}
+	if p.expr == "" {
+		return qs
+	}
+
+	return qs.w(qs.db.Where(p.expr, p.args...))
+}
+
+// Not filters rows not matching predicate p
+// nolint: dupl
+func (qs UserQuerySet) Not(p UserPredicate) UserQuerySet {
+	return qs.Where(p.Not())
+}
+
+// AppendPosts adds posts to Posts of o: posts are saved and foreign keys of Post are set
+// nolint: dupl
+func (o *User) AppendPosts(db *gorm.DB, posts ...*Post) error {
+	return db.Model(o).Association("Posts").Append(posts)
+}
+
+// ClearPosts removes all objects from Posts of o: foreign keys of Post are cleared,
+// objects aren't deleted
+// nolint: dupl
+func (o *User) ClearPosts(db *gorm.DB) error {
+	return db.Model(o).Association("Posts").Clear()
+}
+
+// CountPosts returns number of objects in Posts of o
+// nolint: dupl
+func (o *User) CountPosts(db *gorm.DB) (int64, error) {
+	a := db.Model(o).Association("Posts")
+	n := a.Count()
+	return n, a.Error
+}
+
+// Create is an autogenerated method
+// nolint: dupl
+func (o *User) Create(db *gorm.DB) error {
+	return db.Create(o).Error
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (o *User) Delete(db *gorm.DB) error {
+	return db.Delete(o).Error
+}
+
+// RemovePosts removes posts from Posts of o: foreign keys of Post are cleared,
+// objects aren't deleted
+// nolint: dupl
+func (o *User) RemovePosts(db *gorm.DB, posts ...*Post) error {
+	return db.Model(o).Association("Posts").Delete(posts)
+}
+
+// ReplacePosts replaces Posts of o by posts: other objects are removed
+// from association but aren't deleted
+// nolint: dupl
+func (o *User) ReplacePosts(db *gorm.DB, posts ...*Post) error {
+	return db.Model(o).Association("Posts").Replace(posts)
+}
+
+// All is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) All(ret *[]User) error {
+	return qs.db.Find(ret).Error
+}
+
+// Batches calls fn for every batch of at most size rows, batches are
+// fetched by Page: ordered by primary key after queryset orders.
+// It stops on the first error of fn and returns it.
+// nolint: dupl
+func (qs UserQuerySet) Batches(size int, fn func([]User) error) error {
+	cursor := ""
+	for {
+		var batch []User
+		next, err := qs.Page(cursor, size, &batch)
+		if err != nil {
+			return err
+		}
+
+		if len(batch) != 0 {
+			if err = fn(batch); err != nil {
+				return err
+			}
+		}
+
+		if next == "" {
+			return nil
+		}
+		cursor = next
+	}
+}
+
+// Count is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) Count() (int64, error) {
+	var count int64
+	err := qs.db.Count(&count).Error
+	return count, err
+}
+
+// CountByEmail returns count of rows for every value of Email
+// nolint: dupl
+func (qs UserQuerySet) CountByEmail() (map[string]int, error) {
+	var rows []struct {
+		Value string
+		Count int
+	}
+	err := qs.db.Select("email AS value, COUNT(*) AS count").Group("email").Scan(&rows).Error
+	ret := make(map[string]int, len(rows))
+	for _, r := range rows {
+		ret[r.Value] = r.Count
+	}
+	return ret, err
+}
+
+// CountByID returns count of rows for every value of ID
+// nolint: dupl
+func (qs UserQuerySet) CountByID() (map[uint]int, error) {
+	var rows []struct {
+		Value uint
+		Count int
+	}
+	err := qs.db.Select("id AS value, COUNT(*) AS count").Group("id").Scan(&rows).Error
+	ret := make(map[uint]int, len(rows))
+	for _, r := range rows {
+		ret[r.Value] = r.Count
+	}
+	return ret, err
+}
+
+// CountByName returns count of rows for every value of Name
+// nolint: dupl
+func (qs UserQuerySet) CountByName() (map[string]int, error) {
+	var rows []struct {
+		Value string
+		Count int
+	}
+	err := qs.db.Select("name AS value, COUNT(*) AS count").Group("name").Scan(&rows).Error
+	ret := make(map[string]int, len(rows))
+	for _, r := range rows {
+		ret[r.Value] = r.Count
+	}
+	return ret, err
+}
+
+// CreateMany inserts models by multi-row inserts, conditions of queryset
+// aren't used. Generated primary keys are written back to models if
+// database supports it (all except MS SQL for gorm v1).
+// nolint: dupl
+func (qs UserQuerySet) CreateMany(models []User) error {
+	if len(models) == 0 {
+		return nil
+	}
+
+	size := bulkInsertBatchSize(qs.db.Dialector.Name(), len(qs.fieldPtrs(&models[0])))
+	// new db to not use conditions of queryset
+	return qs.db.Session(&gorm.Session{NewDB: true}).CreateInBatches(&models, size).Error
+}
+
+// CreatedAtBetween filters rows with CreatedAt in range [from, to]
+// nolint: dupl
+func (qs UserQuerySet) CreatedAtBetween(from time.Time, to time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("created_at BETWEEN ? AND ?", from, to))
+}
+
+// CreatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) CreatedAtEq(createdAt time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("created_at = ?", createdAt))
+}
+
+// CreatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) CreatedAtGt(createdAt time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("created_at > ?", createdAt))
+}
+
+// CreatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) CreatedAtGte(createdAt time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("created_at >= ?", createdAt))
+}
+
+// CreatedAtInRange filters rows with CreatedAt in range [from, to)
+// nolint: dupl
+func (qs UserQuerySet) CreatedAtInRange(from time.Time, to time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("created_at >= ? AND created_at < ?", from, to))
+}
+
+// CreatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) CreatedAtLt(createdAt time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("created_at < ?", createdAt))
+}
+
+// CreatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) CreatedAtLte(createdAt time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("created_at <= ?", createdAt))
+}
+
+// CreatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) CreatedAtNe(createdAt time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("created_at != ?", createdAt))
+}
+
+// CreatedAtWithin filters rows with CreatedAt not earlier than d ago
+// nolint: dupl
+func (qs UserQuerySet) CreatedAtWithin(d time.Duration) UserQuerySet {
+	return qs.w(qs.db.Where("created_at >= ?", time.Now().Add(-d)))
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) Delete() error {
+	return qs.db.Delete(&User{}).Error
+}
+
+// DeleteNum is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) DeleteNum() (int64, error) {
+	db := qs.db.Delete(&User{})
+	return db.RowsAffected, db.Error
+}
+
+// DeleteNumUnscoped is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) DeleteNumUnscoped() (int64, error) {
+	db := qs.db.Unscoped().Delete(&User{})
+	return db.RowsAffected, db.Error
+}
+
+// DeletedAtBetween filters rows with DeletedAt in range [from, to]
+// nolint: dupl
+func (qs UserQuerySet) DeletedAtBetween(from time.Time, to time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("deleted_at BETWEEN ? AND ?", from, to))
+}
+
+// DeletedAtEq is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) DeletedAtEq(deletedAt time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("deleted_at = ?", deletedAt))
+}
+
+// DeletedAtGt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) DeletedAtGt(deletedAt time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("deleted_at > ?", deletedAt))
+}
+
+// DeletedAtGte is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) DeletedAtGte(deletedAt time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("deleted_at >= ?", deletedAt))
+}
+
+// DeletedAtInRange filters rows with DeletedAt in range [from, to)
+// nolint: dupl
+func (qs UserQuerySet) DeletedAtInRange(from time.Time, to time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("deleted_at >= ? AND deleted_at < ?", from, to))
+}
+
+// DeletedAtIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) DeletedAtIsNotNull() UserQuerySet {
+	return qs.w(qs.db.Where("deleted_at IS NOT NULL"))
+}
+
+// DeletedAtIsNull is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) DeletedAtIsNull() UserQuerySet {
+	return qs.w(qs.db.Where("deleted_at IS NULL"))
+}
+
+// DeletedAtLt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) DeletedAtLt(deletedAt time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("deleted_at < ?", deletedAt))
+}
+
+// DeletedAtLte is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) DeletedAtLte(deletedAt time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("deleted_at <= ?", deletedAt))
+}
+
+// DeletedAtNe is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) DeletedAtNe(deletedAt time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("deleted_at != ?", deletedAt))
+}
+
+// DeletedAtWithin filters rows with DeletedAt not earlier than d ago
+// nolint: dupl
+func (qs UserQuerySet) DeletedAtWithin(d time.Duration) UserQuerySet {
+	return qs.w(qs.db.Where("deleted_at >= ?", time.Now().Add(-d)))
+}
+
+// EmailEndsWith filters rows with Email ending with the argument
+// nolint: dupl
+func (qs UserQuerySet) EmailEndsWith(email string) UserQuerySet {
+	return qs.w(whereLike(qs.db, "email", "%", email, "", false))
+}
+
+// EmailEq is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) EmailEq(email string) UserQuerySet {
+	return qs.w(qs.db.Where("email = ?", email))
+}
+
+// EmailGt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) EmailGt(email string) UserQuerySet {
+	return qs.w(qs.db.Where("email > ?", email))
+}
+
+// EmailGte is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) EmailGte(email string) UserQuerySet {
+	return qs.w(qs.db.Where("email >= ?", email))
+}
+
+// EmailIContains filters rows with Email containing the argument, case is ignored
+// nolint: dupl
+func (qs UserQuerySet) EmailIContains(email string) UserQuerySet {
+	return qs.w(whereLike(qs.db, "email", "%", email, "%", true))
+}
+
+// EmailIEq filters rows with Email equal to the argument, case is ignored
+// nolint: dupl
+func (qs UserQuerySet) EmailIEq(email string) UserQuerySet {
+	return qs.w(whereLike(qs.db, "email", "", email, "", true))
+}
+
+// EmailIn is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) EmailIn(email ...string) UserQuerySet {
+	if len(email) == 0 {
+		qs.db.AddError(errors.New("must at least pass one email in EmailIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("email IN (?)", email))
+}
+
+// EmailInQuery filters rows with Email IN values selected by subquery q
+// nolint: dupl
+func (qs UserQuerySet) EmailInQuery(q StringSubquery) UserQuerySet {
+	return qs.w(qs.db.Where("email IN (?)", q.expr()))
+}
+
+// EmailLike is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) EmailLike(email string) UserQuerySet {
+	return qs.w(qs.db.Where("email LIKE ?", email))
+}
+
+// EmailLt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) EmailLt(email string) UserQuerySet {
+	return qs.w(qs.db.Where("email < ?", email))
+}
+
+// EmailLte is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) EmailLte(email string) UserQuerySet {
+	return qs.w(qs.db.Where("email <= ?", email))
+}
+
+// EmailNe is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) EmailNe(email string) UserQuerySet {
+	return qs.w(qs.db.Where("email != ?", email))
+}
+
+// EmailNotIn is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) EmailNotIn(email ...string) UserQuerySet {
+	if len(email) == 0 {
+		qs.db.AddError(errors.New("must at least pass one email in EmailNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("email NOT IN (?)", email))
+}
+
+// EmailNotInQuery filters rows with Email NOT IN values selected by subquery q
+// nolint: dupl
+func (qs UserQuerySet) EmailNotInQuery(q StringSubquery) UserQuerySet {
+	return qs.w(qs.db.Where("email NOT IN (?)", q.expr()))
+}
+
+// EmailNotlike is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) EmailNotlike(email string) UserQuerySet {
+	return qs.w(qs.db.Where("email NOT LIKE ?", email))
+}
+
+// EmailStartsWith filters rows with Email starting with the argument
+// nolint: dupl
+func (qs UserQuerySet) EmailStartsWith(email string) UserQuerySet {
+	return qs.w(whereLike(qs.db, "email", "", email, "%", false))
+}
+
+// GetDB is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) GetDB() *gorm.DB {
+	return qs.db
+}
+
+// GetUpdater is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) GetUpdater() UserUpdater {
+	return NewUserUpdater(qs.db)
+}
+
+// GroupByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) GroupByCreatedAt() UserQuerySet {
+	return qs.w(qs.db.Group("created_at"))
+}
+
+// GroupByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) GroupByDeletedAt() UserQuerySet {
+	return qs.w(qs.db.Group("deleted_at"))
+}
+
+// GroupByEmail is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) GroupByEmail() UserQuerySet {
+	return qs.w(qs.db.Group("email"))
+}
+
+// GroupByID is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) GroupByID() UserQuerySet {
+	return qs.w(qs.db.Group("id"))
+}
+
+// GroupByName is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) GroupByName() UserQuerySet {
+	return qs.w(qs.db.Group("name"))
+}
+
+// GroupBySurname is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) GroupBySurname() UserQuerySet {
+	return qs.w(qs.db.Group("user_surname"))
+}
+
+// GroupByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) GroupByUpdatedAt() UserQuerySet {
+	return qs.w(qs.db.Group("updated_at"))
+}
+
+// HavingCountEq is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) HavingCountEq(count int) UserQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) = ?", count))
+}
+
+// HavingCountGt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) HavingCountGt(count int) UserQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) > ?", count))
+}
+
+// HavingCountGte is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) HavingCountGte(count int) UserQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) >= ?", count))
+}
+
+// HavingCountLt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) HavingCountLt(count int) UserQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) < ?", count))
+}
+
+// HavingCountLte is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) HavingCountLte(count int) UserQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) <= ?", count))
+}
+
+// HavingCountNe is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) HavingCountNe(count int) UserQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) != ?", count))
+}
+
+// IDBetween filters rows with ID in range [from, to]
+// nolint: dupl
+func (qs UserQuerySet) IDBetween(from uint, to uint) UserQuerySet {
+	return qs.w(qs.db.Where("id BETWEEN ? AND ?", from, to))
+}
+
+// IDEq is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) IDEq(ID uint) UserQuerySet {
+	return qs.w(qs.db.Where("id = ?", ID))
+}
+
+// IDGt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) IDGt(ID uint) UserQuerySet {
+	return qs.w(qs.db.Where("id > ?", ID))
+}
+
+// IDGte is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) IDGte(ID uint) UserQuerySet {
+	return qs.w(qs.db.Where("id >= ?", ID))
+}
+
+// IDIn is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) IDIn(ID ...uint) UserQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("id IN (?)", ID))
+}
+
+// IDInQuery filters rows with ID IN values selected by subquery q
+// nolint: dupl
+func (qs UserQuerySet) IDInQuery(q UintSubquery) UserQuerySet {
+	return qs.w(qs.db.Where("id IN (?)", q.expr()))
+}
+
+// IDInRange filters rows with ID in range [from, to)
+// nolint: dupl
+func (qs UserQuerySet) IDInRange(from uint, to uint) UserQuerySet {
+	return qs.w(qs.db.Where("id >= ? AND id < ?", from, to))
+}
+
+// IDLt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) IDLt(ID uint) UserQuerySet {
+	return qs.w(qs.db.Where("id < ?", ID))
+}
+
+// IDLte is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) IDLte(ID uint) UserQuerySet {
+	return qs.w(qs.db.Where("id <= ?", ID))
+}
+
+// IDNe is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) IDNe(ID uint) UserQuerySet {
+	return qs.w(qs.db.Where("id != ?", ID))
+}
+
+// IDNotIn is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) IDNotIn(ID ...uint) UserQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("id NOT IN (?)", ID))
+}
+
+// IDNotInQuery filters rows with ID NOT IN values selected by subquery q
+// nolint: dupl
+func (qs UserQuerySet) IDNotInQuery(q UintSubquery) UserQuerySet {
+	return qs.w(qs.db.Where("id NOT IN (?)", q.expr()))
+}
+
+// InTx returns copy bound to transaction tx: queries are executed in it.
+// Gorm v1 can't move conditions into another connection, so with gorm v1
+// InTx must be called before any condition is set, otherwise queries fail.
+// Context and preloads are moved into transaction by both gorm versions.
+// nolint: dupl
+func (qs UserQuerySet) InTx(tx *gorm.DB) UserQuerySet {
+	ctx := tx.Statement.Context
+	if qs.db.Statement.Context != context.Background() {
+		ctx = qs.db.Statement.Context // set by WithContext
+	}
+	db := qs.db.Session(&gorm.Session{Context: ctx})
+	db.Statement.ConnPool = tx.Statement.ConnPool
+	if tx.Error != nil {
+		db.AddError(tx.Error)
+	}
+	return qs.w(db)
+}
+
+// Iterate scans rows one by one and calls fn for every row without
+// loading all rows into memory. It stops on the first error of fn and
+// returns it. Preloads aren't applied.
+// nolint: dupl
+func (qs UserQuerySet) Iterate(fn func(*User) error) error {
+	db := qs.db
+	if db.Error != nil {
+		return db.Error // gorm v1 doesn't check it in Rows
+	}
+
+	rows, err := db.Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var o User
+		if err = db.ScanRows(rows, &o); err != nil {
+			return err
+		}
+		if err = fn(&o); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// Limit is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) Limit(limit int) UserQuerySet {
+	return qs.w(qs.db.Limit(limit))
+}
+
+// MaxCreatedAt returns MAX of CreatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs UserQuerySet) MaxCreatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := qs.db.Select("created_at AS value").Where("created_at IS NOT NULL").
+		Order(clause.OrderByColumn{
+			Column:  clause.Column{Name: "created_at", Raw: true},
+			Desc:    true,
+			Reorder: true,
+		}).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MaxID returns MAX of ID, it's zero value if there are no rows
+// nolint: dupl
+func (qs UserQuerySet) MaxID() (uint, error) {
+	var ret uint
+	var res struct {
+		Value *uint
+	}
+	err := qs.db.Select("MAX(id) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// MaxUpdatedAt returns MAX of UpdatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs UserQuerySet) MaxUpdatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := qs.db.Select("updated_at AS value").Where("updated_at IS NOT NULL").
+		Order(clause.OrderByColumn{
+			Column:  clause.Column{Name: "updated_at", Raw: true},
+			Desc:    true,
+			Reorder: true,
+		}).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MinCreatedAt returns MIN of CreatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs UserQuerySet) MinCreatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := qs.db.Select("created_at AS value").Where("created_at IS NOT NULL").
+		Order(clause.OrderByColumn{
+			Column:  clause.Column{Name: "created_at", Raw: true},
+			Desc:    false,
+			Reorder: true,
+		}).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// MinID returns MIN of ID, it's zero value if there are no rows
+// nolint: dupl
+func (qs UserQuerySet) MinID() (uint, error) {
+	var ret uint
+	var res struct {
+		Value *uint
+	}
+	err := qs.db.Select("MIN(id) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// MinUpdatedAt returns MIN of UpdatedAt, it's zero value if there are no rows
+// nolint: dupl
+func (qs UserQuerySet) MinUpdatedAt() (time.Time, error) {
+	var rows []struct {
+		Value time.Time
+	}
+	err := qs.db.Select("updated_at AS value").Where("updated_at IS NOT NULL").
+		Order(clause.OrderByColumn{
+			Column:  clause.Column{Name: "updated_at", Raw: true},
+			Desc:    false,
+			Reorder: true,
+		}).Limit(1).Scan(&rows).Error
+	if len(rows) == 0 {
+		var ret time.Time
+		return ret, err
+	}
+	return rows[0].Value, err
+}
+
+// NameEndsWith filters rows with Name ending with the argument
+// nolint: dupl
+func (qs UserQuerySet) NameEndsWith(name string) UserQuerySet {
+	return qs.w(whereLike(qs.db, "name", "%", name, "", false))
+}
+
+// NameEq is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) NameEq(name string) UserQuerySet {
+	return qs.w(qs.db.Where("name = ?", name))
+}
+
+// NameGt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) NameGt(name string) UserQuerySet {
+	return qs.w(qs.db.Where("name > ?", name))
+}
+
+// NameGte is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) NameGte(name string) UserQuerySet {
+	return qs.w(qs.db.Where("name >= ?", name))
+}
+
+// NameIContains filters rows with Name containing the argument, case is ignored
+// nolint: dupl
+func (qs UserQuerySet) NameIContains(name string) UserQuerySet {
+	return qs.w(whereLike(qs.db, "name", "%", name, "%", true))
+}
+
+// NameIEq filters rows with Name equal to the argument, case is ignored
+// nolint: dupl
+func (qs UserQuerySet) NameIEq(name string) UserQuerySet {
+	return qs.w(whereLike(qs.db, "name", "", name, "", true))
+}
+
+// NameIn is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) NameIn(name ...string) UserQuerySet {
+	if len(name) == 0 {
+		qs.db.AddError(errors.New("must at least pass one name in NameIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("name IN (?)", name))
+}
+
+// NameInQuery filters rows with Name IN values selected by subquery q
+// nolint: dupl
+func (qs UserQuerySet) NameInQuery(q StringSubquery) UserQuerySet {
+	return qs.w(qs.db.Where("name IN (?)", q.expr()))
+}
+
+// NameLike is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) NameLike(name string) UserQuerySet {
+	return qs.w(qs.db.Where("name LIKE ?", name))
+}
+
+// NameLt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) NameLt(name string) UserQuerySet {
+	return qs.w(qs.db.Where("name < ?", name))
+}
+
+// NameLte is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) NameLte(name string) UserQuerySet {
+	return qs.w(qs.db.Where("name <= ?", name))
+}
+
+// NameNe is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) NameNe(name string) UserQuerySet {
+	return qs.w(qs.db.Where("name != ?", name))
+}
+
+// NameNotIn is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) NameNotIn(name ...string) UserQuerySet {
+	if len(name) == 0 {
+		qs.db.AddError(errors.New("must at least pass one name in NameNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("name NOT IN (?)", name))
+}
+
+// NameNotInQuery filters rows with Name NOT IN values selected by subquery q
+// nolint: dupl
+func (qs UserQuerySet) NameNotInQuery(q StringSubquery) UserQuerySet {
+	return qs.w(qs.db.Where("name NOT IN (?)", q.expr()))
+}
+
+// NameNotlike is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) NameNotlike(name string) UserQuerySet {
+	return qs.w(qs.db.Where("name NOT LIKE ?", name))
+}
+
+// NameStartsWith filters rows with Name starting with the argument
+// nolint: dupl
+func (qs UserQuerySet) NameStartsWith(name string) UserQuerySet {
+	return qs.w(whereLike(qs.db, "name", "", name, "%", false))
+}
+
+// Offset is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) Offset(offset int) UserQuerySet {
+	return qs.w(qs.db.Offset(offset))
+}
+
+// One is used to retrieve one result. It returns gorm.ErrRecordNotFound
+// if nothing was fetched
+func (qs UserQuerySet) One(ret *User) error {
+	return qs.db.First(ret).Error
+}
+
+// OrderAscByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) OrderAscByCreatedAt() UserQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("created_at ASC"), "created_at", false, false))
+}
+
+// OrderAscByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) OrderAscByDeletedAt() UserQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("deleted_at ASC"), "deleted_at", false, false))
+}
+
+// OrderAscByEmail is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) OrderAscByEmail() UserQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("email ASC"), "email", false, false))
+}
+
+// OrderAscByID is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) OrderAscByID() UserQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("id ASC"), "id", false, false))
+}
+
+// OrderAscByName is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) OrderAscByName() UserQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("name ASC"), "name", false, false))
+}
+
+// OrderAscBySurname is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) OrderAscBySurname() UserQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("user_surname ASC"), "user_surname", false, false))
+}
+
+// OrderAscByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) OrderAscByUpdatedAt() UserQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("updated_at ASC"), "updated_at", false, false))
+}
+
+// OrderDescByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) OrderDescByCreatedAt() UserQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("created_at DESC"), "created_at", true, false))
+}
+
+// OrderDescByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) OrderDescByDeletedAt() UserQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("deleted_at DESC"), "deleted_at", true, false))
+}
+
+// OrderDescByEmail is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) OrderDescByEmail() UserQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("email DESC"), "email", true, false))
+}
+
+// OrderDescByID is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) OrderDescByID() UserQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("id DESC"), "id", true, false))
+}
+
+// OrderDescByName is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) OrderDescByName() UserQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("name DESC"), "name", true, false))
+}
+
+// OrderDescBySurname is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) OrderDescBySurname() UserQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("user_surname DESC"), "user_surname", true, false))
+}
+
+// OrderDescByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) OrderDescByUpdatedAt() UserQuerySet {
+	return qs.w(addPageOrder(qs.db.Order("updated_at DESC"), "updated_at", true, false))
+}
+
+// Page fetches at most size rows following cursor into ret, it uses
+// orders set by OrderAscBy/OrderDescBy methods and primary key to break ties.
+// Ordered fields can't be nullable: rows with NULL can't be compared with
+// cursor, so error is returned for them. Empty cursor means the first page. It returns cursor of the next page or
+// empty string if there are no more rows.
+// nolint: dupl
+func (qs UserQuerySet) Page(cursor string, size int, ret *[]User) (string, error) {
+	if size <= 0 {
+		return "", errors.New("page size must be positive")
+	}
+
+	var last User
+	db, orders, err := pageQuery(qs.db, "", "id", cursor, qs.fieldPtrs(&last))
+	if err != nil {
+		return "", err
+	}
+
+	if err = db.Limit(size + 1).Find(ret).Error; err != nil {
+		return "", err
+	}
+	if len(*ret) <= size {
+		return "", nil
+	}
+
+	*ret = (*ret)[:size]
+	return encodePageCursor(orders, qs.fieldPtrs(&(*ret)[size-1]))
+}
+
+// PreloadPosts is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) PreloadPosts() UserQuerySet {
+	return qs.w(qs.db.Preload("Posts"))
+}
+
+// PreloadPostsWhere preloads Posts filtered, ordered or limited by f:
+// limit is applied to all preloaded objects, not to objects of every row
+// nolint: dupl
+func (qs UserQuerySet) PreloadPostsWhere(f func(PostQuerySet) PostQuerySet) UserQuerySet {
+	return qs.w(qs.db.Preload("Posts", func(db *gorm.DB) *gorm.DB {
+		return f(NewPostQuerySet(db)).db
+	}))
+}
+
+// SelectEmail returns subquery selecting Email of rows of queryset
+// for InQuery and NotInQuery filters
+// nolint: dupl
+func (qs UserQuerySet) SelectEmail() StringSubquery {
+	return StringSubquery{db: qs.db.Select("email")}
+}
+
+// SelectID returns subquery selecting ID of rows of queryset
+// for InQuery and NotInQuery filters
+// nolint: dupl
+func (qs UserQuerySet) SelectID() UintSubquery {
+	return UintSubquery{db: qs.db.Select("id")}
+}
+
+// SelectName returns subquery selecting Name of rows of queryset
+// for InQuery and NotInQuery filters
+// nolint: dupl
+func (qs UserQuerySet) SelectName() StringSubquery {
+	return StringSubquery{db: qs.db.Select("name")}
+}
+
+// SurnameEndsWith filters rows with Surname ending with the argument
+// nolint: dupl
+func (qs UserQuerySet) SurnameEndsWith(surname string) UserQuerySet {
+	return qs.w(whereLike(qs.db, "user_surname", "%", surname, "", false))
+}
+
+// SurnameEq is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) SurnameEq(surname string) UserQuerySet {
+	return qs.w(qs.db.Where("user_surname = ?", surname))
+}
+
+// SurnameGt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) SurnameGt(surname string) UserQuerySet {
+	return qs.w(qs.db.Where("user_surname > ?", surname))
+}
+
+// SurnameGte is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) SurnameGte(surname string) UserQuerySet {
+	return qs.w(qs.db.Where("user_surname >= ?", surname))
+}
+
+// SurnameIContains filters rows with Surname containing the argument, case is ignored
+// nolint: dupl
+func (qs UserQuerySet) SurnameIContains(surname string) UserQuerySet {
+	return qs.w(whereLike(qs.db, "user_surname", "%", surname, "%", true))
+}
+
+// SurnameIEq filters rows with Surname equal to the argument, case is ignored
+// nolint: dupl
+func (qs UserQuerySet) SurnameIEq(surname string) UserQuerySet {
+	return qs.w(whereLike(qs.db, "user_surname", "", surname, "", true))
+}
+
+// SurnameIn is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) SurnameIn(surname ...string) UserQuerySet {
+	if len(surname) == 0 {
+		qs.db.AddError(errors.New("must at least pass one surname in SurnameIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("user_surname IN (?)", surname))
+}
+
+// SurnameInQuery filters rows with Surname IN values selected by subquery q
+// nolint: dupl
+func (qs UserQuerySet) SurnameInQuery(q StringSubquery) UserQuerySet {
+	return qs.w(qs.db.Where("user_surname IN (?)", q.expr()))
+}
+
+// SurnameIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) SurnameIsNotNull() UserQuerySet {
+	return qs.w(qs.db.Where("user_surname IS NOT NULL"))
+}
+
+// SurnameIsNull is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) SurnameIsNull() UserQuerySet {
+	return qs.w(qs.db.Where("user_surname IS NULL"))
+}
+
+// SurnameLike is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) SurnameLike(surname string) UserQuerySet {
+	return qs.w(qs.db.Where("user_surname LIKE ?", surname))
+}
+
+// SurnameLt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) SurnameLt(surname string) UserQuerySet {
+	return qs.w(qs.db.Where("user_surname < ?", surname))
+}
+
+// SurnameLte is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) SurnameLte(surname string) UserQuerySet {
+	return qs.w(qs.db.Where("user_surname <= ?", surname))
+}
+
+// SurnameNe is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) SurnameNe(surname string) UserQuerySet {
+	return qs.w(qs.db.Where("user_surname != ?", surname))
+}
+
+// SurnameNotIn is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) SurnameNotIn(surname ...string) UserQuerySet {
+	if len(surname) == 0 {
+		qs.db.AddError(errors.New("must at least pass one surname in SurnameNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("user_surname NOT IN (?)", surname))
+}
+
+// SurnameNotInQuery filters rows with Surname NOT IN values selected by subquery q
+// nolint: dupl
+func (qs UserQuerySet) SurnameNotInQuery(q StringSubquery) UserQuerySet {
+	return qs.w(qs.db.Where("user_surname NOT IN (?)", q.expr()))
+}
+
+// SurnameNotlike is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) SurnameNotlike(surname string) UserQuerySet {
+	return qs.w(qs.db.Where("user_surname NOT LIKE ?", surname))
+}
+
+// SurnameStartsWith filters rows with Surname starting with the argument
+// nolint: dupl
+func (qs UserQuerySet) SurnameStartsWith(surname string) UserQuerySet {
+	return qs.w(whereLike(qs.db, "user_surname", "", surname, "%", false))
+}
+
+// UpdatedAtBetween filters rows with UpdatedAt in range [from, to]
+// nolint: dupl
+func (qs UserQuerySet) UpdatedAtBetween(from time.Time, to time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("updated_at BETWEEN ? AND ?", from, to))
+}
+
+// UpdatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) UpdatedAtEq(updatedAt time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("updated_at = ?", updatedAt))
+}
+
+// UpdatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) UpdatedAtGt(updatedAt time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("updated_at > ?", updatedAt))
+}
+
+// UpdatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) UpdatedAtGte(updatedAt time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("updated_at >= ?", updatedAt))
+}
+
+// UpdatedAtInRange filters rows with UpdatedAt in range [from, to)
+// nolint: dupl
+func (qs UserQuerySet) UpdatedAtInRange(from time.Time, to time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("updated_at >= ? AND updated_at < ?", from, to))
+}
+
+// UpdatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) UpdatedAtLt(updatedAt time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("updated_at < ?", updatedAt))
+}
+
+// UpdatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) UpdatedAtLte(updatedAt time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("updated_at <= ?", updatedAt))
+}
+
+// UpdatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) UpdatedAtNe(updatedAt time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("updated_at != ?", updatedAt))
+}
+
+// UpdatedAtWithin filters rows with UpdatedAt not earlier than d ago
+// nolint: dupl
+func (qs UserQuerySet) UpdatedAtWithin(d time.Duration) UserQuerySet {
+	return qs.w(qs.db.Where("updated_at >= ?", time.Now().Add(-d)))
+}
+
+// WithContext returns copy executing queries with context ctx.
+// Gorm v1 doesn't support context: its error is checked only before query.
+// nolint: dupl
+func (qs UserQuerySet) WithContext(ctx context.Context) UserQuerySet {
+	return qs.w(DBWithContext(ctx, qs.db))
+}
+
+// fieldPtrs returns pointers to fields of o by their columns
+// nolint: dupl
+func (qs UserQuerySet) fieldPtrs(o *User) map[string]interface{} {
+	return map[string]interface{}{
+		"id":           &o.ID,
+		"created_at":   &o.CreatedAt,
+		"updated_at":   &o.UpdatedAt,
+		"deleted_at":   &o.DeletedAt,
+		"name":         &o.Name,
+		"user_surname": &o.Surname,
+		"email":        &o.Email,
+	}
+}
+
+// InTx returns copy bound to transaction tx: queries are executed in it.
+// Gorm v1 can't move conditions into another connection, so with gorm v1
+// InTx must be called before any condition is set, otherwise queries fail.
+// Context and preloads are moved into transaction by both gorm versions.
+// nolint: dupl
+func (u UserUpdater) InTx(tx *gorm.DB) UserUpdater {
+	ctx := tx.Statement.Context
+	if u.db.Statement.Context != context.Background() {
+		ctx = u.db.Statement.Context // set by WithContext
+	}
+	db := u.db.Session(&gorm.Session{Context: ctx})
+	db.Statement.ConnPool = tx.Statement.ConnPool
+	if tx.Error != nil {
+		db.AddError(tx.Error)
+	}
+	u.db = db
+	return u
+}
+
+// SetCreatedAt is an autogenerated method
+// nolint: dupl
+func (u UserUpdater) SetCreatedAt(createdAt time.Time) UserUpdater {
+	u.fields[string(UserDBSchema.CreatedAt)] = createdAt
+	return u
+}
+
+// SetDeletedAt is an autogenerated method
+// nolint: dupl
+func (u UserUpdater) SetDeletedAt(deletedAt time.Time) UserUpdater {
+	u.fields[string(UserDBSchema.DeletedAt)] = deletedAt
+	return u
+}
+
+// SetDeletedAtNull sets DeletedAt to NULL
+// nolint: dupl
+func (u UserUpdater) SetDeletedAtNull() UserUpdater {
+	u.fields[string(UserDBSchema.DeletedAt)] = nil
+	return u
+}
+
+// SetEmail is an autogenerated method
+// nolint: dupl
+func (u UserUpdater) SetEmail(email string) UserUpdater {
+	u.fields[string(UserDBSchema.Email)] = email
+	return u
+}
+
+// SetID is an autogenerated method
+// nolint: dupl
+func (u UserUpdater) SetID(ID uint) UserUpdater {
+	u.fields[string(UserDBSchema.ID)] = ID
+	return u
+}
+
+// SetName is an autogenerated method
+// nolint: dupl
+func (u UserUpdater) SetName(name string) UserUpdater {
+	u.fields[string(UserDBSchema.Name)] = name
+	return u
+}
+
+// SetSurname is an autogenerated method
+// nolint: dupl
+func (u UserUpdater) SetSurname(surname *string) UserUpdater {
+	u.fields[string(UserDBSchema.Surname)] = surname
+	return u
+}
+
+// SetUpdatedAt is an autogenerated method
+// nolint: dupl
+func (u UserUpdater) SetUpdatedAt(updatedAt time.Time) UserUpdater {
+	u.fields[string(UserDBSchema.UpdatedAt)] = updatedAt
+	return u
+}
+
+// Update is an autogenerated method
+// nolint: dupl
+func (u UserUpdater) Update() error {
+	return u.db.Updates(u.fields).Error
+}
+
+// UpdateNum is an autogenerated method
+// nolint: dupl
+func (u UserUpdater) UpdateNum() (int64, error) {
+	db := u.db.Updates(u.fields)
+	return db.RowsAffected, db.Error
+}
+
+// WithContext returns copy executing queries with context ctx.
+// Gorm v1 doesn't support context: its error is checked only before query.
+// nolint: dupl
+func (u UserUpdater) WithContext(ctx context.Context) UserUpdater {
+	u.db = DBWithContext(ctx, u.db)
+	return u
+}
+
+// ===== END of query set UserQuerySet
+
+// ===== BEGIN of User modifiers
+
+// UserDBSchemaField describes database schema field. It requires for method 'Update'
+type UserDBSchemaField string
+
+// String method returns string representation of field.
+// nolint: dupl
+func (f UserDBSchemaField) String() string {
+	return string(f)
+}
+
+// UserDBSchema stores db field names of User
+var UserDBSchema = struct {
+	ID        UserDBSchemaField
+	CreatedAt UserDBSchemaField
+	UpdatedAt UserDBSchemaField
+	DeletedAt UserDBSchemaField
+	Name      UserDBSchemaField
+	Surname   UserDBSchemaField
+	Email     UserDBSchemaField
+}{
+
+	ID:        UserDBSchemaField("id"),
+	CreatedAt: UserDBSchemaField("created_at"),
+	UpdatedAt: UserDBSchemaField("updated_at"),
+	DeletedAt: UserDBSchemaField("deleted_at"),
+	Name:      UserDBSchemaField("name"),
+	Surname:   UserDBSchemaField("user_surname"),
+	Email:     UserDBSchemaField("email"),
+}
+
+// Update updates User fields by primary key
+// nolint: dupl
+func (o *User) Update(db *gorm.DB, fields ...UserDBSchemaField) error {
+	dbNameToFieldName := map[string]interface{}{
+		"id":           o.ID,
+		"created_at":   o.CreatedAt,
+		"updated_at":   o.UpdatedAt,
+		"deleted_at":   o.DeletedAt,
+		"name":         o.Name,
+		"user_surname": o.Surname,
+		"email":        o.Email,
+	}
+	u := map[string]interface{}{}
+	for _, f := range fields {
+		fs := f.String()
+		u[fs] = dbNameToFieldName[fs]
+	}
+	if err := db.Model(o).Updates(u).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+
+		return fmt.Errorf("can't update User %v fields %v: %s",
+			o, fields, err)
+	}
+
+	return nil
+}
+
+// Upsert inserts User or updates updateFields of existing row
+// if insert violates unique constraint on conflictFields. Row isn't
+// changed if there are no updateFields: any conflict is ignored then if
+// there are no conflictFields. MySQL ignores conflictFields: it checks
+// all unique constraints, other databases require them to update row.
+// nolint: dupl
+func (o *User) Upsert(db *gorm.DB, conflictFields []UserDBSchemaField,
+	updateFields ...UserDBSchemaField) error {
+
+	conflictColumns := make([]string, 0, len(conflictFields))
+	for _, f := range conflictFields {
+		conflictColumns = append(conflictColumns, f.String())
+	}
+	updateColumns := make([]string, 0, len(updateFields))
+	for _, f := range updateFields {
+		updateColumns = append(updateColumns, f.String())
+	}
+
+	if err := checkConflictFields(db, conflictColumns, updateColumns); err != nil {
+		return err
+	}
+
+	onConflict := clause.OnConflict{
+		DoNothing: len(updateColumns) == 0,
+	}
+	for _, c := range conflictColumns {
+		onConflict.Columns = append(onConflict.Columns, clause.Column{Name: c})
+	}
+	if len(updateColumns) != 0 {
+		onConflict.DoUpdates = clause.AssignmentColumns(updateColumns)
+	}
+
+	return db.Clauses(onConflict).Create(o).Error
+}
+
+// UserUpdater is an User updates manager
+type UserUpdater struct {
+	fields map[string]interface{}
+	db     *gorm.DB
+}
+
+// NewUserUpdater creates new User updater
+// nolint: dupl
+func NewUserUpdater(db *gorm.DB) UserUpdater {
+	return UserUpdater{
+		fields: map[string]interface{}{},
+		db:     db.Model(&User{}),
+	}
+}
+
+// ===== END of User modifiers
+
+// ===== BEGIN of User predicates
+
+// UserPredicate is a condition on User fields for Where and Not
+// methods of UserQuerySet: predicates are created by UserQ and
+// combined by And, Or and Not. Zero predicate matches all rows.
+type UserPredicate struct {
+	expr string
+	args []interface{}
+	err  error
+}
+
+// join combines predicates by operation op, empty predicates are skipped
+// nolint: dupl
+func (p UserPredicate) join(op string, preds []UserPredicate) UserPredicate {
+	for _, q := range preds {
+		if p.err == nil {
+			p.err = q.err
+		}
+		if q.expr == "" {
+			continue
+		}
+		if p.expr == "" {
+			p.expr, p.args = q.expr, q.args
+			continue
+		}
+
+		p.expr = "(" + p.expr + ") " + op + " (" + q.expr + ")"
+		p.args = append(append([]interface{}{}, p.args...), q.args...)
+	}
+
+	return p
+}
+
+// And returns predicate matching rows matched by p and all preds
+// nolint: dupl
+func (p UserPredicate) And(preds ...UserPredicate) UserPredicate {
+	return p.join("AND", preds)
+}
+
+// Or returns predicate matching rows matched by p or any of preds
+// nolint: dupl
+func (p UserPredicate) Or(preds ...UserPredicate) UserPredicate {
+	return p.join("OR", preds)
+}
+
+// Not returns predicate matching rows not matched by p
+// nolint: dupl
+func (p UserPredicate) Not() UserPredicate {
+	if p.expr != "" {
+		p.expr = "NOT (" + p.expr + ")"
+	}
+
+	return p
+}
+
+// UserPredicates creates predicates by User fields
+type UserPredicates struct{}
+
+// UserQ creates predicates by User fields
+var UserQ UserPredicates
+
+// CreatedAtEq creates predicate "created_at = ?"
+// nolint: dupl
+func (UserPredicates) CreatedAtEq(createdAt time.Time) UserPredicate {
+	return UserPredicate{expr: "created_at = ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtGt creates predicate "created_at > ?"
+// nolint: dupl
+func (UserPredicates) CreatedAtGt(createdAt time.Time) UserPredicate {
+	return UserPredicate{expr: "created_at > ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtGte creates predicate "created_at >= ?"
+// nolint: dupl
+func (UserPredicates) CreatedAtGte(createdAt time.Time) UserPredicate {
+	return UserPredicate{expr: "created_at >= ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtLt creates predicate "created_at < ?"
+// nolint: dupl
+func (UserPredicates) CreatedAtLt(createdAt time.Time) UserPredicate {
+	return UserPredicate{expr: "created_at < ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtLte creates predicate "created_at <= ?"
+// nolint: dupl
+func (UserPredicates) CreatedAtLte(createdAt time.Time) UserPredicate {
+	return UserPredicate{expr: "created_at <= ?", args: []interface{}{createdAt}}
+}
+
+// CreatedAtNe creates predicate "created_at != ?"
+// nolint: dupl
+func (UserPredicates) CreatedAtNe(createdAt time.Time) UserPredicate {
+	return UserPredicate{expr: "created_at != ?", args: []interface{}{createdAt}}
+}
+
+// DeletedAtEq creates predicate "deleted_at = ?"
+// nolint: dupl
+func (UserPredicates) DeletedAtEq(deletedAt time.Time) UserPredicate {
+	return UserPredicate{expr: "deleted_at = ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtGt creates predicate "deleted_at > ?"
+// nolint: dupl
+func (UserPredicates) DeletedAtGt(deletedAt time.Time) UserPredicate {
+	return UserPredicate{expr: "deleted_at > ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtGte creates predicate "deleted_at >= ?"
+// nolint: dupl
+func (UserPredicates) DeletedAtGte(deletedAt time.Time) UserPredicate {
+	return UserPredicate{expr: "deleted_at >= ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtIsNotNull creates predicate "deleted_at IS NOT NULL"
+// nolint: dupl
+func (UserPredicates) DeletedAtIsNotNull() UserPredicate {
+	return UserPredicate{expr: "deleted_at IS NOT NULL"}
+}
+
+// DeletedAtIsNull creates predicate "deleted_at IS NULL"
+// nolint: dupl
+func (UserPredicates) DeletedAtIsNull() UserPredicate {
+	return UserPredicate{expr: "deleted_at IS NULL"}
+}
+
+// DeletedAtLt creates predicate "deleted_at < ?"
+// nolint: dupl
+func (UserPredicates) DeletedAtLt(deletedAt time.Time) UserPredicate {
+	return UserPredicate{expr: "deleted_at < ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtLte creates predicate "deleted_at <= ?"
+// nolint: dupl
+func (UserPredicates) DeletedAtLte(deletedAt time.Time) UserPredicate {
+	return UserPredicate{expr: "deleted_at <= ?", args: []interface{}{deletedAt}}
+}
+
+// DeletedAtNe creates predicate "deleted_at != ?"
+// nolint: dupl
+func (UserPredicates) DeletedAtNe(deletedAt time.Time) UserPredicate {
+	return UserPredicate{expr: "deleted_at != ?", args: []interface{}{deletedAt}}
+}
+
+// EmailEq creates predicate "email = ?"
+// nolint: dupl
+func (UserPredicates) EmailEq(email string) UserPredicate {
+	return UserPredicate{expr: "email = ?", args: []interface{}{email}}
+}
+
+// EmailGt creates predicate "email > ?"
+// nolint: dupl
+func (UserPredicates) EmailGt(email string) UserPredicate {
+	return UserPredicate{expr: "email > ?", args: []interface{}{email}}
+}
+
+// EmailGte creates predicate "email >= ?"
+// nolint: dupl
+func (UserPredicates) EmailGte(email string) UserPredicate {
+	return UserPredicate{expr: "email >= ?", args: []interface{}{email}}
+}
+
+// EmailIn creates predicate "email IN (?)"
+// nolint: dupl
+func (UserPredicates) EmailIn(email ...string) UserPredicate {
+	if len(email) == 0 {
+		return UserPredicate{err: errors.New("must at least pass one email in EmailIn")}
+	}
+	return UserPredicate{expr: "email IN (?)", args: []interface{}{email}}
+}
+
+// EmailLike creates predicate "email LIKE ?"
+// nolint: dupl
+func (UserPredicates) EmailLike(email string) UserPredicate {
+	return UserPredicate{expr: "email LIKE ?", args: []interface{}{email}}
+}
+
+// EmailLt creates predicate "email < ?"
+// nolint: dupl
+func (UserPredicates) EmailLt(email string) UserPredicate {
+	return UserPredicate{expr: "email < ?", args: []interface{}{email}}
+}
+
+// EmailLte creates predicate "email <= ?"
+// nolint: dupl
+func (UserPredicates) EmailLte(email string) UserPredicate {
+	return UserPredicate{expr: "email <= ?", args: []interface{}{email}}
+}
+
+// EmailNe creates predicate "email != ?"
+// nolint: dupl
+func (UserPredicates) EmailNe(email string) UserPredicate {
+	return UserPredicate{expr: "email != ?", args: []interface{}{email}}
+}
+
+// EmailNotIn creates predicate "email NOT IN (?)"
+// nolint: dupl
+func (UserPredicates) EmailNotIn(email ...string) UserPredicate {
+	if len(email) == 0 {
+		return UserPredicate{err: errors.New("must at least pass one email in EmailNotIn")}
+	}
+	return UserPredicate{expr: "email NOT IN (?)", args: []interface{}{email}}
+}
+
+// EmailNotlike creates predicate "email NOT LIKE ?"
+// nolint: dupl
+func (UserPredicates) EmailNotlike(email string) UserPredicate {
+	return UserPredicate{expr: "email NOT LIKE ?", args: []interface{}{email}}
+}
+
+// IDEq creates predicate "id = ?"
+// nolint: dupl
+func (UserPredicates) IDEq(ID uint) UserPredicate {
+	return UserPredicate{expr: "id = ?", args: []interface{}{ID}}
+}
+
+// IDGt creates predicate "id > ?"
+// nolint: dupl
+func (UserPredicates) IDGt(ID uint) UserPredicate {
+	return UserPredicate{expr: "id > ?", args: []interface{}{ID}}
+}
+
+// IDGte creates predicate "id >= ?"
+// nolint: dupl
+func (UserPredicates) IDGte(ID uint) UserPredicate {
+	return UserPredicate{expr: "id >= ?", args: []interface{}{ID}}
+}
+
+// IDIn creates predicate "id IN (?)"
+// nolint: dupl
+func (UserPredicates) IDIn(ID ...uint) UserPredicate {
+	if len(ID) == 0 {
+		return UserPredicate{err: errors.New("must at least pass one ID in IDIn")}
+	}
+	return UserPredicate{expr: "id IN (?)", args: []interface{}{ID}}
+}
+
+// IDLt creates predicate "id < ?"
+// nolint: dupl
+func (UserPredicates) IDLt(ID uint) UserPredicate {
+	return UserPredicate{expr: "id < ?", args: []interface{}{ID}}
+}
+
+// IDLte creates predicate "id <= ?"
+// nolint: dupl
+func (UserPredicates) IDLte(ID uint) UserPredicate {
+	return UserPredicate{expr: "id <= ?", args: []interface{}{ID}}
+}
+
+// IDNe creates predicate "id != ?"
+// nolint: dupl
+func (UserPredicates) IDNe(ID uint) UserPredicate {
+	return UserPredicate{expr: "id != ?", args: []interface{}{ID}}
+}
+
+// IDNotIn creates predicate "id NOT IN (?)"
+// nolint: dupl
+func (UserPredicates) IDNotIn(ID ...uint) UserPredicate {
+	if len(ID) == 0 {
+		return UserPredicate{err: errors.New("must at least pass one ID in IDNotIn")}
+	}
+	return UserPredicate{expr: "id NOT IN (?)", args: []interface{}{ID}}
+}
+
+// NameEq creates predicate "name = ?"
+// nolint: dupl
+func (UserPredicates) NameEq(name string) UserPredicate {
+	return UserPredicate{expr: "name = ?", args: []interface{}{name}}
+}
+
+// NameGt creates predicate "name > ?"
+// nolint: dupl
+func (UserPredicates) NameGt(name string) UserPredicate {
+	return UserPredicate{expr: "name > ?", args: []interface{}{name}}
+}
+
+// NameGte creates predicate "name >= ?"
+// nolint: dupl
+func (UserPredicates) NameGte(name string) UserPredicate {
+	return UserPredicate{expr: "name >= ?", args: []interface{}{name}}
+}
+
+// NameIn creates predicate "name IN (?)"
+// nolint: dupl
+func (UserPredicates) NameIn(name ...string) UserPredicate {
+	if len(name) == 0 {
+		return UserPredicate{err: errors.New("must at least pass one name in NameIn")}
+	}
+	return UserPredicate{expr: "name IN (?)", args: []interface{}{name}}
+}
+
+// NameLike creates predicate "name LIKE ?"
+// nolint: dupl
+func (UserPredicates) NameLike(name string) UserPredicate {
+	return UserPredicate{expr: "name LIKE ?", args: []interface{}{name}}
+}
+
+// NameLt creates predicate "name < ?"
+// nolint: dupl
+func (UserPredicates) NameLt(name string) UserPredicate {
+	return UserPredicate{expr: "name < ?", args: []interface{}{name}}
+}
+
+// NameLte creates predicate "name <= ?"
+// nolint: dupl
+func (UserPredicates) NameLte(name string) UserPredicate {
+	return UserPredicate{expr: "name <= ?", args: []interface{}{name}}
+}
+
+// NameNe creates predicate "name != ?"
+// nolint: dupl
+func (UserPredicates) NameNe(name string) UserPredicate {
+	return UserPredicate{expr: "name != ?", args: []interface{}{name}}
+}
+
+// NameNotIn creates predicate "name NOT IN (?)"
+// nolint: dupl
+func (UserPredicates) NameNotIn(name ...string) UserPredicate {
+	if len(name) == 0 {
+		return UserPredicate{err: errors.New("must at least pass one name in NameNotIn")}
+	}
+	return UserPredicate{expr: "name NOT IN (?)", args: []interface{}{name}}
+}
+
+// NameNotlike creates predicate "name NOT LIKE ?"
+// nolint: dupl
+func (UserPredicates) NameNotlike(name string) UserPredicate {
+	return UserPredicate{expr: "name NOT LIKE ?", args: []interface{}{name}}
+}
+
+// SurnameEq creates predicate "user_surname = ?"
+// nolint: dupl
+func (UserPredicates) SurnameEq(surname string) UserPredicate {
+	return UserPredicate{expr: "user_surname = ?", args: []interface{}{surname}}
+}
+
+// SurnameGt creates predicate "user_surname > ?"
+// nolint: dupl
+func (UserPredicates) SurnameGt(surname string) UserPredicate {
+	return UserPredicate{expr: "user_surname > ?", args: []interface{}{surname}}
+}
+
+// SurnameGte creates predicate "user_surname >= ?"
+// nolint: dupl
+func (UserPredicates) SurnameGte(surname string) UserPredicate {
+	return UserPredicate{expr: "user_surname >= ?", args: []interface{}{surname}}
+}
+
+// SurnameIn creates predicate "user_surname IN (?)"
+// nolint: dupl
+func (UserPredicates) SurnameIn(surname ...string) UserPredicate {
+	if len(surname) == 0 {
+		return UserPredicate{err: errors.New("must at least pass one surname in SurnameIn")}
+	}
+	return UserPredicate{expr: "user_surname IN (?)", args: []interface{}{surname}}
+}
+
+// SurnameIsNotNull creates predicate "user_surname IS NOT NULL"
+// nolint: dupl
+func (UserPredicates) SurnameIsNotNull() UserPredicate {
+	return UserPredicate{expr: "user_surname IS NOT NULL"}
+}
+
+// SurnameIsNull creates predicate "user_surname IS NULL"
+// nolint: dupl
+func (UserPredicates) SurnameIsNull() UserPredicate {
+	return UserPredicate{expr: "user_surname IS NULL"}
+}
+
+// SurnameLike creates predicate "user_surname LIKE ?"
+// nolint: dupl
+func (UserPredicates) SurnameLike(surname string) UserPredicate {
+	return UserPredicate{expr: "user_surname LIKE ?", args: []interface{}{surname}}
+}
+
+// SurnameLt creates predicate "user_surname < ?"
+// nolint: dupl
+func (UserPredicates) SurnameLt(surname string) UserPredicate {
+	return UserPredicate{expr: "user_surname < ?", args: []interface{}{surname}}
+}
+
+// SurnameLte creates predicate "user_surname <= ?"
+// nolint: dupl
+func (UserPredicates) SurnameLte(surname string) UserPredicate {
+	return UserPredicate{expr: "user_surname <= ?", args: []interface{}{surname}}
+}
+
+// SurnameNe creates predicate "user_surname != ?"
+// nolint: dupl
+func (UserPredicates) SurnameNe(surname string) UserPredicate {
+	return UserPredicate{expr: "user_surname != ?", args: []interface{}{surname}}
+}
+
+// SurnameNotIn creates predicate "user_surname NOT IN (?)"
+// nolint: dupl
+func (UserPredicates) SurnameNotIn(surname ...string) UserPredicate {
+	if len(surname) == 0 {
+		return UserPredicate{err: errors.New("must at least pass one surname in SurnameNotIn")}
+	}
+	return UserPredicate{expr: "user_surname NOT IN (?)", args: []interface{}{surname}}
+}
+
+// SurnameNotlike creates predicate "user_surname NOT LIKE ?"
+// nolint: dupl
+func (UserPredicates) SurnameNotlike(surname string) UserPredicate {
+	return UserPredicate{expr: "user_surname NOT LIKE ?", args: []interface{}{surname}}
+}
+
+// UpdatedAtEq creates predicate "updated_at = ?"
+// nolint: dupl
+func (UserPredicates) UpdatedAtEq(updatedAt time.Time) UserPredicate {
+	return UserPredicate{expr: "updated_at = ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtGt creates predicate "updated_at > ?"
+// nolint: dupl
+func (UserPredicates) UpdatedAtGt(updatedAt time.Time) UserPredicate {
+	return UserPredicate{expr: "updated_at > ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtGte creates predicate "updated_at >= ?"
+// nolint: dupl
+func (UserPredicates) UpdatedAtGte(updatedAt time.Time) UserPredicate {
+	return UserPredicate{expr: "updated_at >= ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtLt creates predicate "updated_at < ?"
+// nolint: dupl
+func (UserPredicates) UpdatedAtLt(updatedAt time.Time) UserPredicate {
+	return UserPredicate{expr: "updated_at < ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtLte creates predicate "updated_at <= ?"
+// nolint: dupl
+func (UserPredicates) UpdatedAtLte(updatedAt time.Time) UserPredicate {
+	return UserPredicate{expr: "updated_at <= ?", args: []interface{}{updatedAt}}
+}
+
+// UpdatedAtNe creates predicate "updated_at != ?"
+// nolint: dupl
+func (UserPredicates) UpdatedAtNe(updatedAt time.Time) UserPredicate {
+	return UserPredicate{expr: "updated_at != ?", args: []interface{}{updatedAt}}
+}
+
+// ===== END of User predicates
+
+// IntSubquery is a subquery selecting single column, it's created by Select
+// methods of querysets and passed to their InQuery and NotInQuery filters
+type IntSubquery struct {
+	db *gorm.DB
+}
+
+func (q IntSubquery) expr() interface{} {
+	return q.db
+}
+
+// StringSubquery is a subquery selecting single column, it's created by Select
+// methods of querysets and passed to their InQuery and NotInQuery filters
+type StringSubquery struct {
+	db *gorm.DB
+}
+
+func (q StringSubquery) expr() interface{} {
+	return q.db
+}
+
+// TmpStringDefSubquery is a subquery selecting single column, it's created by Select
+// methods of querysets and passed to their InQuery and NotInQuery filters
+type TmpStringDefSubquery struct {
+	db *gorm.DB
+}
+
+func (q TmpStringDefSubquery) expr() interface{} {
+	return q.db
+}
+
+// UintSubquery is a subquery selecting single column, it's created by Select
+// methods of querysets and passed to their InQuery and NotInQuery filters
+type UintSubquery struct {
+	db *gorm.DB
+}
+
+func (q UintSubquery) expr() interface{} {
+	return q.db
+}
+
+// ===== END of all query sets
+
+// ===== BEGIN of package helpers
+
+// WithTx runs fn in transaction: it's committed if fn returns nil and
+// rolled back if fn returns error or panics. If db is already a transaction,
+// savepoint is used instead of a new transaction: nested calls are
+// rolled back independently.
+func WithTx(db *gorm.DB, fn func(tx *gorm.DB) error) error {
+	return db.Transaction(fn)
+}
+
+// DBWithContext returns db executing queries with context ctx: it's needed
+// to pass context to struct modifiers (Create, Delete, Update).
+func DBWithContext(ctx context.Context, db *gorm.DB) *gorm.DB {
+	return db.WithContext(ctx)
+}
+
+const pageOrdersKey = "goqueryset:page_orders"
+
+// pageOrder is an order of queryset remembered for keyset pagination
+type pageOrder struct {
+	column   string
+	desc     bool
+	nullable bool
+}
+
+// addPageOrder remembers order by column in db
+func addPageOrder(db *gorm.DB, column string, desc, nullable bool) *gorm.DB {
+	v, _ := db.Get(pageOrdersKey)
+	orders, _ := v.([]pageOrder)
+	orders = append(orders[:len(orders):len(orders)], pageOrder{column: column, desc: desc, nullable: nullable})
+	return db.Set(pageOrdersKey, orders)
+}
+
+// pageQuery returns db filtered by cursor and ordered by primary key after
+// orders of db, fieldPtrs are pointers to fields of model by their columns.
+// Columns are prefixed by table in SQL, table is empty if they aren't qualified.
+func pageQuery(db *gorm.DB, table, pk, cursor string,
+	fieldPtrs map[string]interface{}) (*gorm.DB, []pageOrder, error) {
+
+	v, _ := db.Get(pageOrdersKey)
+	orders, _ := v.([]pageOrder)
+	hasPKOrder := false
+	for _, o := range orders {
+		if _, ok := fieldPtrs[o.column]; !ok {
+			return nil, nil, fmt.Errorf("can't paginate by column %s: it's not a field of model", o.column)
+		}
+		if o.nullable {
+			// NULL isn't greater or less than cursor value: such rows would be lost
+			return nil, nil, fmt.Errorf("can't paginate by column %s: it's nullable", o.column)
+		}
+		hasPKOrder = hasPKOrder || o.column == pk
+	}
+	if !hasPKOrder {
+		orders = append(orders[:len(orders):len(orders)], pageOrder{column: pk})
+		db = db.Order(table + pk + " ASC")
+	}
+
+	if cursor == "" {
+		return db, orders, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid cursor: %s", err)
+	}
+	var values []json.RawMessage
+	if err = json.Unmarshal(data, &values); err != nil {
+		return nil, nil, fmt.Errorf("invalid cursor: %s", err)
+	}
+	if len(values) != len(orders) {
+		return nil, nil, errors.New("invalid cursor: it was made for other orders")
+	}
+
+	// (a > ?) OR (a = ? AND b < ?) OR ...
+	var conds []string
+	var args []interface{}
+	var eqConds []string
+	var eqArgs []interface{}
+	for i, o := range orders {
+		ptr := fieldPtrs[o.column]
+		if err = json.Unmarshal(values[i], ptr); err != nil {
+			return nil, nil, fmt.Errorf("invalid cursor value of %s: %s", o.column, err)
+		}
+		value := reflect.ValueOf(ptr).Elem().Interface()
+
+		op := ">"
+		if o.desc {
+			op = "<"
+		}
+		conds = append(conds, "("+strings.Join(append(eqConds, table+o.column+" "+op+" ?"), " AND ")+")")
+		args = append(append(args, eqArgs...), value)
+
+		eqConds = append(eqConds, table+o.column+" = ?")
+		eqArgs = append(eqArgs, value)
+	}
+
+	return db.Where(strings.Join(conds, " OR "), args...), orders, nil
+}
+
+// encodePageCursor encodes values of ordered fields of the last fetched row
+func encodePageCursor(orders []pageOrder, fieldPtrs map[string]interface{}) (string, error) {
+	var values []interface{}
+	for _, o := range orders {
+		values = append(values, fieldPtrs[o.column])
+	}
+
+	data, err := json.Marshal(values)
+	if err != nil {
+		return "", fmt.Errorf("can't encode cursor: %s", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// ValidationError is returned by Validate methods of models and updaters
+type ValidationError struct {
+	Field  string // name of invalid field
+	Reason string
+}
+
+func (e ValidationError) Error() string {
+	return fmt.Sprintf("invalid field %s: %s", e.Field, e.Reason)
+}
+
+// checkConflictFields checks that row updated by upsert can be found: only
+// MySQL finds conflicting row without conflict fields
+func checkConflictFields(db *gorm.DB, conflictColumns, updateColumns []string) error {
+	if len(conflictColumns) == 0 && len(updateColumns) != 0 && dialectName(db) != "mysql" {
+		return errors.New("conflict fields are required to update existing row by upsert")
+	}
+
+	return nil
+}
+
+// dialectName returns name of database dialect of db
+func dialectName(db *gorm.DB) string {
+	return db.Dialector.Name()
+}
+
+// likePatternEscaper escapes wildcards of LIKE with "!": backslash isn't
+// used because its escaping in string literals differs between databases.
+// "[" is a wildcard in SQL Server.
+var likePatternEscaper = strings.NewReplacer("!", "!!", "%", "!%", "_", "!_", "[", "![")
+
+// whereLike filters column by pattern: escaped value between prefix and
+// suffix. Case-insensitive matching uses ILIKE in PostgreSQL and LOWER elsewhere.
+func whereLike(db *gorm.DB, column, prefix, value, suffix string, caseInsensitive bool) *gorm.DB {
+	pattern := prefix + likePatternEscaper.Replace(value) + suffix
+	switch {
+	case !caseInsensitive:
+		return db.Where(column+" LIKE ? ESCAPE '!'", pattern)
+	case dialectName(db) == "postgres":
+		return db.Where(column+" ILIKE ? ESCAPE '!'", pattern)
+	default:
+		return db.Where("LOWER("+column+") LIKE LOWER(?) ESCAPE '!'", pattern)
+	}
+}
+
+// jsonColumnValue is a map or a slice stored in JSON column: it's marshaled
+// to JSON when passed to database, nil is stored as NULL
+type jsonColumnValue struct {
+	v interface{}
+}
+
+// Value implements driver.Valuer
+func (j jsonColumnValue) Value() (driver.Value, error) {
+	if reflect.ValueOf(j.v).IsNil() {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(j.v)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// whereJSONEq filters JSON column by text value at dot-separated path
+func whereJSONEq(db *gorm.DB, column, path, value string) *gorm.DB {
+	switch dialectName(db) {
+	case "postgres":
+		return db.Where(column+" #>> ? = ?", "{"+strings.Replace(path, ".", ",", -1)+"}", value)
+	case "mysql":
+		return db.Where("JSON_UNQUOTE(JSON_EXTRACT("+column+", ?)) = ?", "$."+path, value)
+	default:
+		return db.Where("CAST(JSON_EXTRACT("+column+", ?) AS TEXT) = ?", "$."+path, value)
+	}
+}
+
+// bulkInsertBatchSize returns max count of rows in one insert query:
+// databases limit count of placeholders in query
+func bulkInsertBatchSize(dialect string, columns int) int {
+	maxParams := 999 // sqlite and unknown dialects
+	switch dialect {
+	case "mysql", "postgres":
+		maxParams = 65535
+	case "mssql", "sqlserver":
+		maxParams = 2100
+	}
+
+	if columns == 0 || columns > maxParams {
+		return 1
+	}
+	return maxParams / columns
+}
+
+// ===== END of package helpers
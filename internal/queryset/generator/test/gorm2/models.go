@@ -0,0 +1,64 @@
+package gorm2
+
+import (
+	"database/sql"
+	"encoding/json"
+
+	"github.com/jirfag/go-queryset/internal/queryset/generator/tmp"
+	"gorm.io/gorm"
+)
+
+//go:generate go run ../../../../../cmd/goqueryset/goqueryset.go -backend gorm2 -in models.go
+
+// User is a usual user
+// gen:qs
+type User struct {
+	gorm.Model
+
+	Posts   []Post
+	Name    string
+	Surname *string `gorm:"column:user_surname"`
+	Email   string
+}
+
+// Blog is a blog
+// gen:qs
+type Blog struct {
+	gorm.Model
+
+	Name    string  `gorm:"column:myname"`
+	Editors []*User `gorm:"many2many:blog_editors"`
+}
+
+// Post is an article
+// gen:qs
+type Post struct {
+	gorm.Model
+
+	BlogID *uint
+	Blog   *Blog // may be no blog
+	UserID uint
+	User   User
+	Title  *string
+	Str    tmp.StringDef
+}
+
+// Account is a struct for checking validation
+// gen:qs validate=true
+type Account struct {
+	gorm.Model
+
+	Login    string  `gorm:"size:8" validate:"required"`
+	Nickname *string `gorm:"not null;size:16"`
+	Age      int     `validate:"min=18,max=150"`
+}
+
+// Document is a struct for checking JSON and nullable fields
+// gen:qs
+type Document struct {
+	gorm.Model
+
+	Data  json.RawMessage
+	Attrs map[string]string `gorm:"serializer:json"`
+	Note  sql.NullString
+}
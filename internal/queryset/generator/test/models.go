@@ -1,6 +1,13 @@
 package test
 
 import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
 	"github.com/jinzhu/gorm"
 	"github.com/jirfag/go-queryset/internal/queryset/generator/tmp"
 )
@@ -12,7 +19,7 @@ import (
 type User struct {
 	gorm.Model
 
-	//Posts []Post
+	Posts   []Post
 	Name    string
 	Surname *string `gorm:"column:user_surname"`
 	Email   string
@@ -23,7 +30,8 @@ type User struct {
 type Blog struct {
 	gorm.Model
 
-	Name string `gorm:"column:myname"`
+	Name    string  `gorm:"column:myname"`
+	Editors []*User `gorm:"many2many:blog_editors"`
 }
 
 // Post is an article
@@ -31,7 +39,9 @@ type Blog struct {
 type Post struct {
 	gorm.Model
 
+	BlogID *uint
 	Blog   *Blog // may be no blog
+	UserID uint
 	User   User
 	Title  *string
 	Str    tmp.StringDef
@@ -59,3 +69,96 @@ type CheckReservedKeywords struct {
 	Type   string
 	Struct int
 }
+
+// Account is a struct for checking validation
+// gen:qs validate=true
+type Account struct {
+	gorm.Model
+
+	Login    string        `gorm:"size:8" validate:"required"`
+	Nickname *string       `gorm:"not null;size:16"`
+	Role     string        `validate:"oneof=admin user"`
+	Age      int           `validate:"min=18,max=150"`
+	Str      tmp.StringDef `gorm:"size:4"`
+}
+
+// AuditLog is a struct for checking read-only querysets
+// gen:qs name=AuditLogs readonly
+type AuditLog struct {
+	ID     uint
+	Action string
+}
+
+// Event is a struct for checking skipping of methods
+// gen:qs skip=Delete,DeleteNumUnscoped,Page,Upsert
+type Event struct {
+	gorm.Model
+
+	Name string
+}
+
+// Product is a struct for checking qs tags of fields
+// gen:qs
+type Product struct {
+	ID       uint    `qs:"filters=eq,in;order"`
+	Name     string  `qs:"filters=eq,like"`
+	Price    int     `qs:"filters=gte,lte;order;group;aggregates"`
+	Note     *string `qs:"filters=isnull"`
+	Internal string  `qs:"-"`
+}
+
+// Comment is a struct for checking bulk insert of renamed timestamps
+// and of columns having default values
+// gen:qs
+type Comment struct {
+	ID        uint
+	Text      string
+	Status    string    `gorm:"default:'new'"`
+	CreatedAt time.Time `gorm:"column:created"`
+	UpdatedAt time.Time `gorm:"column:modified"`
+}
+
+// Tags are stored in PostgreSQL text array
+type Tags []string
+
+// Value implements driver.Valuer
+func (t Tags) Value() (driver.Value, error) {
+	if t == nil {
+		return nil, nil
+	}
+
+	return "{" + strings.Join(t, ",") + "}", nil
+}
+
+// Document is a struct for checking array, map and JSON fields
+// gen:qs
+type Document struct {
+	gorm.Model
+
+	Tags   Tags
+	Data   json.RawMessage
+	Attrs  map[string]string `gorm:"serializer:json"`
+	Labels []string          // neither driver.Valuer nor JSON: it has no methods
+}
+
+// Point is a custom SQL value: it's stored as a string
+type Point struct {
+	X, Y int
+}
+
+// Value implements driver.Valuer
+func (p Point) Value() (driver.Value, error) {
+	return fmt.Sprintf("%d,%d", p.X, p.Y), nil
+}
+
+// Profile is a struct for checking nullable SQL values and custom foreign key
+// gen:qs validate=true
+type Profile struct {
+	gorm.Model
+
+	Bio      sql.NullString `gorm:"size:8"`
+	Rating   sql.NullInt64  `validate:"min=1,max=5"`
+	Location Point
+	OwnerRef *uint
+	Owner    *User `gorm:"foreignkey:OwnerRef"`
+}
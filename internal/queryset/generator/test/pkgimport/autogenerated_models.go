@@ -2,9 +2,17 @@
 package models
 
 import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"reflect"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/jinzhu/gorm"
 	forex "github.com/jirfag/go-queryset/internal/queryset/generator/test/pkgimport/forex/v1"
@@ -39,32 +47,178 @@ func (qs ExampleQuerySet) Select(fields ...ExampleDBSchemaField) ExampleQuerySet
 	return qs.w(qs.db.Select(strings.Join(names, ",")))
 }
 
+// GroupBy groups rows by fields
+func (qs ExampleQuerySet) GroupBy(fields ...ExampleDBSchemaField) ExampleQuerySet {
+	names := []string{}
+	for _, f := range fields {
+		names = append(names, f.String())
+	}
+
+	return qs.w(qs.db.Group(strings.Join(names, ",")))
+}
+
+// Where filters rows by predicate p, predicates are created by ExampleQ
+// nolint: dupl
+func (qs ExampleQuerySet) Where(p ExamplePredicate) ExampleQuerySet {
+	if p.err != nil {
+		// error is added to copy of db: qs can be reused as a base queryset
+		db := qs.db.Model(&Example{})
+		db.AddError(p.err)
+		return qs.w(db)
+	}
+	if p.expr == "" {
+		return qs
+	}
+
+	return qs.w(qs.db.Where(p.expr, p.args...))
+}
+
+// Not filters rows not matching predicate p
+// nolint: dupl
+func (qs ExampleQuerySet) Not(p ExamplePredicate) ExampleQuerySet {
+	return qs.Where(p.Not())
+}
+
 // Create is an autogenerated method
 // nolint: dupl
 func (o *Example) Create(db *gorm.DB) error {
-	return db.Create(o).Error
+	return checkQueryContext(db).Create(o).Error
 }
 
 // Delete is an autogenerated method
 // nolint: dupl
 func (o *Example) Delete(db *gorm.DB) error {
-	return db.Delete(o).Error
+	return checkQueryContext(db).Delete(o).Error
 }
 
 // All is an autogenerated method
 // nolint: dupl
 func (qs ExampleQuerySet) All(ret *[]Example) error {
-	return qs.db.Find(ret).Error
+	return checkQueryContext(qs.db).Find(ret).Error
+}
+
+// AvgCurrency1 returns AVG of Currency1, it's zero value if there are no rows
+// nolint: dupl
+func (qs ExampleQuerySet) AvgCurrency1() (float64, error) {
+	var ret float64
+	var res struct {
+		Value *float64
+	}
+	err := checkQueryContext(qs.db).Select("AVG(currency1) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// AvgPriceID returns AVG of PriceID, it's zero value if there are no rows
+// nolint: dupl
+func (qs ExampleQuerySet) AvgPriceID() (float64, error) {
+	var ret float64
+	var res struct {
+		Value *float64
+	}
+	err := checkQueryContext(qs.db).Select("AVG(price_id) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
 }
 
 // Count is an autogenerated method
 // nolint: dupl
 func (qs ExampleQuerySet) Count() (int, error) {
 	var count int
-	err := qs.db.Count(&count).Error
+	db := checkQueryContext(qs.db)
+	if db.Error != nil {
+		return count, db.Error
+	}
+
+	err := db.Count(&count).Error
 	return count, err
 }
 
+// CountByCurrency1 returns count of rows for every value of Currency1
+// nolint: dupl
+func (qs ExampleQuerySet) CountByCurrency1() (map[forex.Currency1]int, error) {
+	var rows []struct {
+		Value forex.Currency1
+		Count int
+	}
+	err := checkQueryContext(qs.db).Select("currency1 AS value, COUNT(*) AS count").Group("currency1").Scan(&rows).Error
+	ret := make(map[forex.Currency1]int, len(rows))
+	for _, r := range rows {
+		ret[r.Value] = r.Count
+	}
+	return ret, err
+}
+
+// CountByCurrency2 returns count of rows for every value of Currency2
+// nolint: dupl
+func (qs ExampleQuerySet) CountByCurrency2() (map[forex.Currency2]int, error) {
+	var rows []struct {
+		Value forex.Currency2
+		Count int
+	}
+	err := checkQueryContext(qs.db).Select("currency2 AS value, COUNT(*) AS count").Group("currency2").Scan(&rows).Error
+	ret := make(map[forex.Currency2]int, len(rows))
+	for _, r := range rows {
+		ret[r.Value] = r.Count
+	}
+	return ret, err
+}
+
+// CountByCurrency3 returns count of rows for every value of Currency3
+// nolint: dupl
+func (qs ExampleQuerySet) CountByCurrency3() (map[forex.Currency3]int, error) {
+	var rows []struct {
+		Value forex.Currency3
+		Count int
+	}
+	err := checkQueryContext(qs.db).Select("currency3 AS value, COUNT(*) AS count").Group("currency3").Scan(&rows).Error
+	ret := make(map[forex.Currency3]int, len(rows))
+	for _, r := range rows {
+		ret[r.Value] = r.Count
+	}
+	return ret, err
+}
+
+// CountByPriceID returns count of rows for every value of PriceID
+// nolint: dupl
+func (qs ExampleQuerySet) CountByPriceID() (map[int64]int, error) {
+	var rows []struct {
+		Value int64
+		Count int
+	}
+	err := checkQueryContext(qs.db).Select("price_id AS value, COUNT(*) AS count").Group("price_id").Scan(&rows).Error
+	ret := make(map[int64]int, len(rows))
+	for _, r := range rows {
+		ret[r.Value] = r.Count
+	}
+	return ret, err
+}
+
+// CreateMany inserts models by multi-row inserts, conditions of queryset
+// aren't used. Generated primary keys are written back to models if
+// database supports it (all except MS SQL for gorm v1).
+// Unlike gorm Create it doesn't call hooks and doesn't read back default
+// values: zero fields having default values are skipped in insert.
+// nolint: dupl
+func (qs ExampleQuerySet) CreateMany(models []Example) error {
+	rows := make([]map[string]interface{}, 0, len(models))
+	for i := range models {
+		rows = append(rows, qs.fieldPtrs(&models[i]))
+	}
+
+	return bulkInsert(checkQueryContext(qs.db), "", rows)
+}
+
+// Currency1Between filters rows with Currency1 in range [from, to]
+// nolint: dupl
+func (qs ExampleQuerySet) Currency1Between(from forex.Currency1, to forex.Currency1) ExampleQuerySet {
+	return qs.w(qs.db.Where("currency1 BETWEEN ? AND ?", from, to))
+}
+
 // Currency1Eq is an autogenerated method
 // nolint: dupl
 func (qs ExampleQuerySet) Currency1Eq(currency1 forex.Currency1) ExampleQuerySet {
@@ -93,6 +247,18 @@ func (qs ExampleQuerySet) Currency1In(currency1 ...forex.Currency1) ExampleQuery
 	return qs.w(qs.db.Where("currency1 IN (?)", currency1))
 }
 
+// Currency1InQuery filters rows with Currency1 IN values selected by subquery q
+// nolint: dupl
+func (qs ExampleQuerySet) Currency1InQuery(q ForexCurrency1Subquery) ExampleQuerySet {
+	return qs.w(qs.db.Where("currency1 IN (?)", q.expr()))
+}
+
+// Currency1InRange filters rows with Currency1 in range [from, to)
+// nolint: dupl
+func (qs ExampleQuerySet) Currency1InRange(from forex.Currency1, to forex.Currency1) ExampleQuerySet {
+	return qs.w(qs.db.Where("currency1 >= ? AND currency1 < ?", from, to))
+}
+
 // Currency1Lt is an autogenerated method
 // nolint: dupl
 func (qs ExampleQuerySet) Currency1Lt(currency1 forex.Currency1) ExampleQuerySet {
@@ -121,6 +287,18 @@ func (qs ExampleQuerySet) Currency1NotIn(currency1 ...forex.Currency1) ExampleQu
 	return qs.w(qs.db.Where("currency1 NOT IN (?)", currency1))
 }
 
+// Currency1NotInQuery filters rows with Currency1 NOT IN values selected by subquery q
+// nolint: dupl
+func (qs ExampleQuerySet) Currency1NotInQuery(q ForexCurrency1Subquery) ExampleQuerySet {
+	return qs.w(qs.db.Where("currency1 NOT IN (?)", q.expr()))
+}
+
+// Currency2EndsWith filters rows with Currency2 ending with the argument
+// nolint: dupl
+func (qs ExampleQuerySet) Currency2EndsWith(currency2 forex.Currency2) ExampleQuerySet {
+	return qs.w(whereLike(qs.db, "currency2", "%", string(currency2), "", false))
+}
+
 // Currency2Eq is an autogenerated method
 // nolint: dupl
 func (qs ExampleQuerySet) Currency2Eq(currency2 forex.Currency2) ExampleQuerySet {
@@ -139,6 +317,18 @@ func (qs ExampleQuerySet) Currency2Gte(currency2 forex.Currency2) ExampleQuerySe
 	return qs.w(qs.db.Where("currency2 >= ?", currency2))
 }
 
+// Currency2IContains filters rows with Currency2 containing the argument, case is ignored
+// nolint: dupl
+func (qs ExampleQuerySet) Currency2IContains(currency2 forex.Currency2) ExampleQuerySet {
+	return qs.w(whereLike(qs.db, "currency2", "%", string(currency2), "%", true))
+}
+
+// Currency2IEq filters rows with Currency2 equal to the argument, case is ignored
+// nolint: dupl
+func (qs ExampleQuerySet) Currency2IEq(currency2 forex.Currency2) ExampleQuerySet {
+	return qs.w(whereLike(qs.db, "currency2", "", string(currency2), "", true))
+}
+
 // Currency2In is an autogenerated method
 // nolint: dupl
 func (qs ExampleQuerySet) Currency2In(currency2 ...forex.Currency2) ExampleQuerySet {
@@ -149,6 +339,12 @@ func (qs ExampleQuerySet) Currency2In(currency2 ...forex.Currency2) ExampleQuery
 	return qs.w(qs.db.Where("currency2 IN (?)", currency2))
 }
 
+// Currency2InQuery filters rows with Currency2 IN values selected by subquery q
+// nolint: dupl
+func (qs ExampleQuerySet) Currency2InQuery(q ForexCurrency2Subquery) ExampleQuerySet {
+	return qs.w(qs.db.Where("currency2 IN (?)", q.expr()))
+}
+
 // Currency2Like is an autogenerated method
 // nolint: dupl
 func (qs ExampleQuerySet) Currency2Like(currency2 forex.Currency2) ExampleQuerySet {
@@ -183,12 +379,30 @@ func (qs ExampleQuerySet) Currency2NotIn(currency2 ...forex.Currency2) ExampleQu
 	return qs.w(qs.db.Where("currency2 NOT IN (?)", currency2))
 }
 
+// Currency2NotInQuery filters rows with Currency2 NOT IN values selected by subquery q
+// nolint: dupl
+func (qs ExampleQuerySet) Currency2NotInQuery(q ForexCurrency2Subquery) ExampleQuerySet {
+	return qs.w(qs.db.Where("currency2 NOT IN (?)", q.expr()))
+}
+
 // Currency2Notlike is an autogenerated method
 // nolint: dupl
 func (qs ExampleQuerySet) Currency2Notlike(currency2 forex.Currency2) ExampleQuerySet {
 	return qs.w(qs.db.Where("currency2 NOT LIKE ?", currency2))
 }
 
+// Currency2StartsWith filters rows with Currency2 starting with the argument
+// nolint: dupl
+func (qs ExampleQuerySet) Currency2StartsWith(currency2 forex.Currency2) ExampleQuerySet {
+	return qs.w(whereLike(qs.db, "currency2", "", string(currency2), "%", false))
+}
+
+// Currency3EndsWith filters rows with Currency3 ending with the argument
+// nolint: dupl
+func (qs ExampleQuerySet) Currency3EndsWith(currency3 forex.Currency3) ExampleQuerySet {
+	return qs.w(whereLike(qs.db, "currency3", "%", string(currency3), "", false))
+}
+
 // Currency3Eq is an autogenerated method
 // nolint: dupl
 func (qs ExampleQuerySet) Currency3Eq(currency3 forex.Currency3) ExampleQuerySet {
@@ -207,6 +421,18 @@ func (qs ExampleQuerySet) Currency3Gte(currency3 forex.Currency3) ExampleQuerySe
 	return qs.w(qs.db.Where("currency3 >= ?", currency3))
 }
 
+// Currency3IContains filters rows with Currency3 containing the argument, case is ignored
+// nolint: dupl
+func (qs ExampleQuerySet) Currency3IContains(currency3 forex.Currency3) ExampleQuerySet {
+	return qs.w(whereLike(qs.db, "currency3", "%", string(currency3), "%", true))
+}
+
+// Currency3IEq filters rows with Currency3 equal to the argument, case is ignored
+// nolint: dupl
+func (qs ExampleQuerySet) Currency3IEq(currency3 forex.Currency3) ExampleQuerySet {
+	return qs.w(whereLike(qs.db, "currency3", "", string(currency3), "", true))
+}
+
 // Currency3In is an autogenerated method
 // nolint: dupl
 func (qs ExampleQuerySet) Currency3In(currency3 ...forex.Currency3) ExampleQuerySet {
@@ -217,6 +443,12 @@ func (qs ExampleQuerySet) Currency3In(currency3 ...forex.Currency3) ExampleQuery
 	return qs.w(qs.db.Where("currency3 IN (?)", currency3))
 }
 
+// Currency3InQuery filters rows with Currency3 IN values selected by subquery q
+// nolint: dupl
+func (qs ExampleQuerySet) Currency3InQuery(q ForexCurrency3Subquery) ExampleQuerySet {
+	return qs.w(qs.db.Where("currency3 IN (?)", q.expr()))
+}
+
 // Currency3Like is an autogenerated method
 // nolint: dupl
 func (qs ExampleQuerySet) Currency3Like(currency3 forex.Currency3) ExampleQuerySet {
@@ -251,29 +483,41 @@ func (qs ExampleQuerySet) Currency3NotIn(currency3 ...forex.Currency3) ExampleQu
 	return qs.w(qs.db.Where("currency3 NOT IN (?)", currency3))
 }
 
+// Currency3NotInQuery filters rows with Currency3 NOT IN values selected by subquery q
+// nolint: dupl
+func (qs ExampleQuerySet) Currency3NotInQuery(q ForexCurrency3Subquery) ExampleQuerySet {
+	return qs.w(qs.db.Where("currency3 NOT IN (?)", q.expr()))
+}
+
 // Currency3Notlike is an autogenerated method
 // nolint: dupl
 func (qs ExampleQuerySet) Currency3Notlike(currency3 forex.Currency3) ExampleQuerySet {
 	return qs.w(qs.db.Where("currency3 NOT LIKE ?", currency3))
 }
 
+// Currency3StartsWith filters rows with Currency3 starting with the argument
+// nolint: dupl
+func (qs ExampleQuerySet) Currency3StartsWith(currency3 forex.Currency3) ExampleQuerySet {
+	return qs.w(whereLike(qs.db, "currency3", "", string(currency3), "%", false))
+}
+
 // Delete is an autogenerated method
 // nolint: dupl
 func (qs ExampleQuerySet) Delete() error {
-	return qs.db.Delete(Example{}).Error
+	return checkQueryContext(qs.db).Delete(Example{}).Error
 }
 
 // DeleteNum is an autogenerated method
 // nolint: dupl
 func (qs ExampleQuerySet) DeleteNum() (int64, error) {
-	db := qs.db.Delete(Example{})
+	db := checkQueryContext(qs.db).Delete(Example{})
 	return db.RowsAffected, db.Error
 }
 
 // DeleteNumUnscoped is an autogenerated method
 // nolint: dupl
 func (qs ExampleQuerySet) DeleteNumUnscoped() (int64, error) {
-	db := qs.db.Unscoped().Delete(Example{})
+	db := checkQueryContext(qs.db).Unscoped().Delete(Example{})
 	return db.RowsAffected, db.Error
 }
 
@@ -289,12 +533,172 @@ func (qs ExampleQuerySet) GetUpdater() ExampleUpdater {
 	return NewExampleUpdater(qs.db)
 }
 
+// GroupByCurrency1 is an autogenerated method
+// nolint: dupl
+func (qs ExampleQuerySet) GroupByCurrency1() ExampleQuerySet {
+	return qs.w(qs.db.Group("currency1"))
+}
+
+// GroupByCurrency2 is an autogenerated method
+// nolint: dupl
+func (qs ExampleQuerySet) GroupByCurrency2() ExampleQuerySet {
+	return qs.w(qs.db.Group("currency2"))
+}
+
+// GroupByCurrency3 is an autogenerated method
+// nolint: dupl
+func (qs ExampleQuerySet) GroupByCurrency3() ExampleQuerySet {
+	return qs.w(qs.db.Group("currency3"))
+}
+
+// GroupByPriceID is an autogenerated method
+// nolint: dupl
+func (qs ExampleQuerySet) GroupByPriceID() ExampleQuerySet {
+	return qs.w(qs.db.Group("price_id"))
+}
+
+// HavingCountEq is an autogenerated method
+// nolint: dupl
+func (qs ExampleQuerySet) HavingCountEq(count int) ExampleQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) = ?", count))
+}
+
+// HavingCountGt is an autogenerated method
+// nolint: dupl
+func (qs ExampleQuerySet) HavingCountGt(count int) ExampleQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) > ?", count))
+}
+
+// HavingCountGte is an autogenerated method
+// nolint: dupl
+func (qs ExampleQuerySet) HavingCountGte(count int) ExampleQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) >= ?", count))
+}
+
+// HavingCountLt is an autogenerated method
+// nolint: dupl
+func (qs ExampleQuerySet) HavingCountLt(count int) ExampleQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) < ?", count))
+}
+
+// HavingCountLte is an autogenerated method
+// nolint: dupl
+func (qs ExampleQuerySet) HavingCountLte(count int) ExampleQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) <= ?", count))
+}
+
+// HavingCountNe is an autogenerated method
+// nolint: dupl
+func (qs ExampleQuerySet) HavingCountNe(count int) ExampleQuerySet {
+	return qs.w(qs.db.Having("COUNT(*) != ?", count))
+}
+
+// InTx returns copy bound to transaction tx: queries are executed in it.
+// Gorm v1 can't move conditions into another connection, so with gorm v1
+// InTx must be called before any condition is set, otherwise queries fail.
+// Context and preloads are moved into transaction by both gorm versions.
+// nolint: dupl
+func (qs ExampleQuerySet) InTx(tx *gorm.DB) ExampleQuerySet {
+	if !reflect.DeepEqual(qs.db.QueryExpr(), NewExampleQuerySet(qs.db.New()).db.QueryExpr()) {
+		tx = tx.New()
+		tx.AddError(errors.New("InTx must be called before any condition is set: " +
+			"gorm v1 can't move conditions into transaction"))
+	}
+	tx = txWithSettings(qs.db, tx)
+	return NewExampleQuerySet(tx)
+}
+
+// Iterate scans rows one by one and calls fn for every row without
+// loading all rows into memory. It stops on the first error of fn and
+// returns it. Preloads aren't applied.
+// nolint: dupl
+func (qs ExampleQuerySet) Iterate(fn func(*Example) error) error {
+	db := checkQueryContext(qs.db)
+	if db.Error != nil {
+		return db.Error // gorm v1 doesn't check it in Rows
+	}
+
+	rows, err := db.Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var o Example
+		if err = db.ScanRows(rows, &o); err != nil {
+			return err
+		}
+		if err = fn(&o); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
 // Limit is an autogenerated method
 // nolint: dupl
 func (qs ExampleQuerySet) Limit(limit int) ExampleQuerySet {
 	return qs.w(qs.db.Limit(limit))
 }
 
+// MaxCurrency1 returns MAX of Currency1, it's zero value if there are no rows
+// nolint: dupl
+func (qs ExampleQuerySet) MaxCurrency1() (forex.Currency1, error) {
+	var ret forex.Currency1
+	var res struct {
+		Value *forex.Currency1
+	}
+	err := checkQueryContext(qs.db).Select("MAX(currency1) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// MaxPriceID returns MAX of PriceID, it's zero value if there are no rows
+// nolint: dupl
+func (qs ExampleQuerySet) MaxPriceID() (int64, error) {
+	var ret int64
+	var res struct {
+		Value *int64
+	}
+	err := checkQueryContext(qs.db).Select("MAX(price_id) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// MinCurrency1 returns MIN of Currency1, it's zero value if there are no rows
+// nolint: dupl
+func (qs ExampleQuerySet) MinCurrency1() (forex.Currency1, error) {
+	var ret forex.Currency1
+	var res struct {
+		Value *forex.Currency1
+	}
+	err := checkQueryContext(qs.db).Select("MIN(currency1) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// MinPriceID returns MIN of PriceID, it's zero value if there are no rows
+// nolint: dupl
+func (qs ExampleQuerySet) MinPriceID() (int64, error) {
+	var ret int64
+	var res struct {
+		Value *int64
+	}
+	err := checkQueryContext(qs.db).Select("MIN(price_id) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
 // Offset is an autogenerated method
 // nolint: dupl
 func (qs ExampleQuerySet) Offset(offset int) ExampleQuerySet {
@@ -304,55 +708,61 @@ func (qs ExampleQuerySet) Offset(offset int) ExampleQuerySet {
 // One is used to retrieve one result. It returns gorm.ErrRecordNotFound
 // if nothing was fetched
 func (qs ExampleQuerySet) One(ret *Example) error {
-	return qs.db.First(ret).Error
+	return checkQueryContext(qs.db).First(ret).Error
 }
 
 // OrderAscByCurrency1 is an autogenerated method
 // nolint: dupl
 func (qs ExampleQuerySet) OrderAscByCurrency1() ExampleQuerySet {
-	return qs.w(qs.db.Order("currency1 ASC"))
+	return qs.w(addPageOrder(qs.db.Order("currency1 ASC"), "currency1", false, false))
 }
 
 // OrderAscByCurrency2 is an autogenerated method
 // nolint: dupl
 func (qs ExampleQuerySet) OrderAscByCurrency2() ExampleQuerySet {
-	return qs.w(qs.db.Order("currency2 ASC"))
+	return qs.w(addPageOrder(qs.db.Order("currency2 ASC"), "currency2", false, false))
 }
 
 // OrderAscByCurrency3 is an autogenerated method
 // nolint: dupl
 func (qs ExampleQuerySet) OrderAscByCurrency3() ExampleQuerySet {
-	return qs.w(qs.db.Order("currency3 ASC"))
+	return qs.w(addPageOrder(qs.db.Order("currency3 ASC"), "currency3", false, false))
 }
 
 // OrderAscByPriceID is an autogenerated method
 // nolint: dupl
 func (qs ExampleQuerySet) OrderAscByPriceID() ExampleQuerySet {
-	return qs.w(qs.db.Order("price_id ASC"))
+	return qs.w(addPageOrder(qs.db.Order("price_id ASC"), "price_id", false, false))
 }
 
 // OrderDescByCurrency1 is an autogenerated method
 // nolint: dupl
 func (qs ExampleQuerySet) OrderDescByCurrency1() ExampleQuerySet {
-	return qs.w(qs.db.Order("currency1 DESC"))
+	return qs.w(addPageOrder(qs.db.Order("currency1 DESC"), "currency1", true, false))
 }
 
 // OrderDescByCurrency2 is an autogenerated method
 // nolint: dupl
 func (qs ExampleQuerySet) OrderDescByCurrency2() ExampleQuerySet {
-	return qs.w(qs.db.Order("currency2 DESC"))
+	return qs.w(addPageOrder(qs.db.Order("currency2 DESC"), "currency2", true, false))
 }
 
 // OrderDescByCurrency3 is an autogenerated method
 // nolint: dupl
 func (qs ExampleQuerySet) OrderDescByCurrency3() ExampleQuerySet {
-	return qs.w(qs.db.Order("currency3 DESC"))
+	return qs.w(addPageOrder(qs.db.Order("currency3 DESC"), "currency3", true, false))
 }
 
 // OrderDescByPriceID is an autogenerated method
 // nolint: dupl
 func (qs ExampleQuerySet) OrderDescByPriceID() ExampleQuerySet {
-	return qs.w(qs.db.Order("price_id DESC"))
+	return qs.w(addPageOrder(qs.db.Order("price_id DESC"), "price_id", true, false))
+}
+
+// PriceIDBetween filters rows with PriceID in range [from, to]
+// nolint: dupl
+func (qs ExampleQuerySet) PriceIDBetween(from int64, to int64) ExampleQuerySet {
+	return qs.w(qs.db.Where("price_id BETWEEN ? AND ?", from, to))
 }
 
 // PriceIDEq is an autogenerated method
@@ -383,6 +793,18 @@ func (qs ExampleQuerySet) PriceIDIn(priceID ...int64) ExampleQuerySet {
 	return qs.w(qs.db.Where("price_id IN (?)", priceID))
 }
 
+// PriceIDInQuery filters rows with PriceID IN values selected by subquery q
+// nolint: dupl
+func (qs ExampleQuerySet) PriceIDInQuery(q Int64Subquery) ExampleQuerySet {
+	return qs.w(qs.db.Where("price_id IN (?)", q.expr()))
+}
+
+// PriceIDInRange filters rows with PriceID in range [from, to)
+// nolint: dupl
+func (qs ExampleQuerySet) PriceIDInRange(from int64, to int64) ExampleQuerySet {
+	return qs.w(qs.db.Where("price_id >= ? AND price_id < ?", from, to))
+}
+
 // PriceIDLt is an autogenerated method
 // nolint: dupl
 func (qs ExampleQuerySet) PriceIDLt(priceID int64) ExampleQuerySet {
@@ -411,6 +833,102 @@ func (qs ExampleQuerySet) PriceIDNotIn(priceID ...int64) ExampleQuerySet {
 	return qs.w(qs.db.Where("price_id NOT IN (?)", priceID))
 }
 
+// PriceIDNotInQuery filters rows with PriceID NOT IN values selected by subquery q
+// nolint: dupl
+func (qs ExampleQuerySet) PriceIDNotInQuery(q Int64Subquery) ExampleQuerySet {
+	return qs.w(qs.db.Where("price_id NOT IN (?)", q.expr()))
+}
+
+// SelectCurrency1 returns subquery selecting Currency1 of rows of queryset
+// for InQuery and NotInQuery filters
+// nolint: dupl
+func (qs ExampleQuerySet) SelectCurrency1() ForexCurrency1Subquery {
+	return ForexCurrency1Subquery{db: qs.db.Select("currency1")}
+}
+
+// SelectCurrency2 returns subquery selecting Currency2 of rows of queryset
+// for InQuery and NotInQuery filters
+// nolint: dupl
+func (qs ExampleQuerySet) SelectCurrency2() ForexCurrency2Subquery {
+	return ForexCurrency2Subquery{db: qs.db.Select("currency2")}
+}
+
+// SelectCurrency3 returns subquery selecting Currency3 of rows of queryset
+// for InQuery and NotInQuery filters
+// nolint: dupl
+func (qs ExampleQuerySet) SelectCurrency3() ForexCurrency3Subquery {
+	return ForexCurrency3Subquery{db: qs.db.Select("currency3")}
+}
+
+// SelectPriceID returns subquery selecting PriceID of rows of queryset
+// for InQuery and NotInQuery filters
+// nolint: dupl
+func (qs ExampleQuerySet) SelectPriceID() Int64Subquery {
+	return Int64Subquery{db: qs.db.Select("price_id")}
+}
+
+// SumCurrency1 returns SUM of Currency1, it's zero value if there are no rows
+// nolint: dupl
+func (qs ExampleQuerySet) SumCurrency1() (forex.Currency1, error) {
+	var ret forex.Currency1
+	var res struct {
+		Value *forex.Currency1
+	}
+	err := checkQueryContext(qs.db).Select("SUM(currency1) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// SumPriceID returns SUM of PriceID, it's zero value if there are no rows
+// nolint: dupl
+func (qs ExampleQuerySet) SumPriceID() (int64, error) {
+	var ret int64
+	var res struct {
+		Value *int64
+	}
+	err := checkQueryContext(qs.db).Select("SUM(price_id) AS value").Scan(&res).Error
+	if res.Value != nil {
+		ret = *res.Value
+	}
+	return ret, err
+}
+
+// WithContext returns copy executing queries with context ctx.
+// Gorm v1 doesn't support context: its error is checked only before query.
+// nolint: dupl
+func (qs ExampleQuerySet) WithContext(ctx context.Context) ExampleQuerySet {
+	return qs.w(DBWithContext(ctx, qs.db))
+}
+
+// fieldPtrs returns pointers to fields of o by their columns
+// nolint: dupl
+func (qs ExampleQuerySet) fieldPtrs(o *Example) map[string]interface{} {
+	return map[string]interface{}{
+		"price_id":  &o.PriceID,
+		"currency1": &o.Currency1,
+		"currency2": &o.Currency2,
+		"currency3": &o.Currency3,
+	}
+}
+
+// InTx returns copy bound to transaction tx: queries are executed in it.
+// Gorm v1 can't move conditions into another connection, so with gorm v1
+// InTx must be called before any condition is set, otherwise queries fail.
+// Context and preloads are moved into transaction by both gorm versions.
+// nolint: dupl
+func (u ExampleUpdater) InTx(tx *gorm.DB) ExampleUpdater {
+	if !reflect.DeepEqual(u.db.QueryExpr(), u.db.New().Model(&Example{}).QueryExpr()) {
+		tx = tx.New()
+		tx.AddError(errors.New("InTx must be called before any condition is set: " +
+			"gorm v1 can't move conditions into transaction"))
+	}
+	tx = txWithSettings(u.db, tx)
+	u.db = tx.Model(&Example{})
+	return u
+}
+
 // SetCurrency1 is an autogenerated method
 // nolint: dupl
 func (u ExampleUpdater) SetCurrency1(currency1 forex.Currency1) ExampleUpdater {
@@ -442,16 +960,24 @@ func (u ExampleUpdater) SetPriceID(priceID int64) ExampleUpdater {
 // Update is an autogenerated method
 // nolint: dupl
 func (u ExampleUpdater) Update() error {
-	return u.db.Updates(u.fields).Error
+	return checkQueryContext(u.db).Updates(u.fields).Error
 }
 
 // UpdateNum is an autogenerated method
 // nolint: dupl
 func (u ExampleUpdater) UpdateNum() (int64, error) {
-	db := u.db.Updates(u.fields)
+	db := checkQueryContext(u.db).Updates(u.fields)
 	return db.RowsAffected, db.Error
 }
 
+// WithContext returns copy executing queries with context ctx.
+// Gorm v1 doesn't support context: its error is checked only before query.
+// nolint: dupl
+func (u ExampleUpdater) WithContext(ctx context.Context) ExampleUpdater {
+	u.db = DBWithContext(ctx, u.db)
+	return u
+}
+
 // ===== END of query set ExampleQuerySet
 
 // ===== BEGIN of Example modifiers
@@ -493,7 +1019,7 @@ func (o *Example) Update(db *gorm.DB, fields ...ExampleDBSchemaField) error {
 		fs := f.String()
 		u[fs] = dbNameToFieldName[fs]
 	}
-	if err := db.Model(o).Updates(u).Error; err != nil {
+	if err := checkQueryContext(db).Model(o).Updates(u).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return err
 		}
@@ -505,6 +1031,29 @@ func (o *Example) Update(db *gorm.DB, fields ...ExampleDBSchemaField) error {
 	return nil
 }
 
+// Upsert inserts Example or updates updateFields of existing row
+// if insert violates unique constraint on conflictFields. Row isn't
+// changed if there are no updateFields: any conflict is ignored then if
+// there are no conflictFields. MySQL ignores conflictFields: it checks
+// all unique constraints, other databases require them to update row.
+// nolint: dupl
+func (o *Example) Upsert(db *gorm.DB, conflictFields []ExampleDBSchemaField,
+	updateFields ...ExampleDBSchemaField) error {
+
+	conflictColumns := make([]string, 0, len(conflictFields))
+	for _, f := range conflictFields {
+		conflictColumns = append(conflictColumns, f.String())
+	}
+	updateColumns := make([]string, 0, len(updateFields))
+	for _, f := range updateFields {
+		updateColumns = append(updateColumns, f.String())
+	}
+
+	qs := NewExampleQuerySet(db)
+	return upsert(checkQueryContext(qs.db), "", qs.fieldPtrs(o),
+		conflictColumns, updateColumns)
+}
+
 // ExampleUpdater is an Example updates manager
 type ExampleUpdater struct {
 	fields map[string]interface{}
@@ -522,4 +1071,958 @@ func NewExampleUpdater(db *gorm.DB) ExampleUpdater {
 
 // ===== END of Example modifiers
 
-// ===== END of all query sets
+// ===== BEGIN of Example predicates
+
+// ExamplePredicate is a condition on Example fields for Where and Not
+// methods of ExampleQuerySet: predicates are created by ExampleQ and
+// combined by And, Or and Not. Zero predicate matches all rows.
+type ExamplePredicate struct {
+	expr string
+	args []interface{}
+	err  error
+}
+
+// join combines predicates by operation op, empty predicates are skipped
+// nolint: dupl
+func (p ExamplePredicate) join(op string, preds []ExamplePredicate) ExamplePredicate {
+	for _, q := range preds {
+		if p.err == nil {
+			p.err = q.err
+		}
+		if q.expr == "" {
+			continue
+		}
+		if p.expr == "" {
+			p.expr, p.args = q.expr, q.args
+			continue
+		}
+
+		p.expr = "(" + p.expr + ") " + op + " (" + q.expr + ")"
+		p.args = append(append([]interface{}{}, p.args...), q.args...)
+	}
+
+	return p
+}
+
+// And returns predicate matching rows matched by p and all preds
+// nolint: dupl
+func (p ExamplePredicate) And(preds ...ExamplePredicate) ExamplePredicate {
+	return p.join("AND", preds)
+}
+
+// Or returns predicate matching rows matched by p or any of preds
+// nolint: dupl
+func (p ExamplePredicate) Or(preds ...ExamplePredicate) ExamplePredicate {
+	return p.join("OR", preds)
+}
+
+// Not returns predicate matching rows not matched by p
+// nolint: dupl
+func (p ExamplePredicate) Not() ExamplePredicate {
+	if p.expr != "" {
+		p.expr = "NOT (" + p.expr + ")"
+	}
+
+	return p
+}
+
+// ExamplePredicates creates predicates by Example fields
+type ExamplePredicates struct{}
+
+// ExampleQ creates predicates by Example fields
+var ExampleQ ExamplePredicates
+
+// Currency1Eq creates predicate "currency1 = ?"
+// nolint: dupl
+func (ExamplePredicates) Currency1Eq(currency1 forex.Currency1) ExamplePredicate {
+	return ExamplePredicate{expr: "currency1 = ?", args: []interface{}{currency1}}
+}
+
+// Currency1Gt creates predicate "currency1 > ?"
+// nolint: dupl
+func (ExamplePredicates) Currency1Gt(currency1 forex.Currency1) ExamplePredicate {
+	return ExamplePredicate{expr: "currency1 > ?", args: []interface{}{currency1}}
+}
+
+// Currency1Gte creates predicate "currency1 >= ?"
+// nolint: dupl
+func (ExamplePredicates) Currency1Gte(currency1 forex.Currency1) ExamplePredicate {
+	return ExamplePredicate{expr: "currency1 >= ?", args: []interface{}{currency1}}
+}
+
+// Currency1In creates predicate "currency1 IN (?)"
+// nolint: dupl
+func (ExamplePredicates) Currency1In(currency1 ...forex.Currency1) ExamplePredicate {
+	if len(currency1) == 0 {
+		return ExamplePredicate{err: errors.New("must at least pass one currency1 in Currency1In")}
+	}
+	return ExamplePredicate{expr: "currency1 IN (?)", args: []interface{}{currency1}}
+}
+
+// Currency1Lt creates predicate "currency1 < ?"
+// nolint: dupl
+func (ExamplePredicates) Currency1Lt(currency1 forex.Currency1) ExamplePredicate {
+	return ExamplePredicate{expr: "currency1 < ?", args: []interface{}{currency1}}
+}
+
+// Currency1Lte creates predicate "currency1 <= ?"
+// nolint: dupl
+func (ExamplePredicates) Currency1Lte(currency1 forex.Currency1) ExamplePredicate {
+	return ExamplePredicate{expr: "currency1 <= ?", args: []interface{}{currency1}}
+}
+
+// Currency1Ne creates predicate "currency1 != ?"
+// nolint: dupl
+func (ExamplePredicates) Currency1Ne(currency1 forex.Currency1) ExamplePredicate {
+	return ExamplePredicate{expr: "currency1 != ?", args: []interface{}{currency1}}
+}
+
+// Currency1NotIn creates predicate "currency1 NOT IN (?)"
+// nolint: dupl
+func (ExamplePredicates) Currency1NotIn(currency1 ...forex.Currency1) ExamplePredicate {
+	if len(currency1) == 0 {
+		return ExamplePredicate{err: errors.New("must at least pass one currency1 in Currency1NotIn")}
+	}
+	return ExamplePredicate{expr: "currency1 NOT IN (?)", args: []interface{}{currency1}}
+}
+
+// Currency2Eq creates predicate "currency2 = ?"
+// nolint: dupl
+func (ExamplePredicates) Currency2Eq(currency2 forex.Currency2) ExamplePredicate {
+	return ExamplePredicate{expr: "currency2 = ?", args: []interface{}{currency2}}
+}
+
+// Currency2Gt creates predicate "currency2 > ?"
+// nolint: dupl
+func (ExamplePredicates) Currency2Gt(currency2 forex.Currency2) ExamplePredicate {
+	return ExamplePredicate{expr: "currency2 > ?", args: []interface{}{currency2}}
+}
+
+// Currency2Gte creates predicate "currency2 >= ?"
+// nolint: dupl
+func (ExamplePredicates) Currency2Gte(currency2 forex.Currency2) ExamplePredicate {
+	return ExamplePredicate{expr: "currency2 >= ?", args: []interface{}{currency2}}
+}
+
+// Currency2In creates predicate "currency2 IN (?)"
+// nolint: dupl
+func (ExamplePredicates) Currency2In(currency2 ...forex.Currency2) ExamplePredicate {
+	if len(currency2) == 0 {
+		return ExamplePredicate{err: errors.New("must at least pass one currency2 in Currency2In")}
+	}
+	return ExamplePredicate{expr: "currency2 IN (?)", args: []interface{}{currency2}}
+}
+
+// Currency2Like creates predicate "currency2 LIKE ?"
+// nolint: dupl
+func (ExamplePredicates) Currency2Like(currency2 forex.Currency2) ExamplePredicate {
+	return ExamplePredicate{expr: "currency2 LIKE ?", args: []interface{}{currency2}}
+}
+
+// Currency2Lt creates predicate "currency2 < ?"
+// nolint: dupl
+func (ExamplePredicates) Currency2Lt(currency2 forex.Currency2) ExamplePredicate {
+	return ExamplePredicate{expr: "currency2 < ?", args: []interface{}{currency2}}
+}
+
+// Currency2Lte creates predicate "currency2 <= ?"
+// nolint: dupl
+func (ExamplePredicates) Currency2Lte(currency2 forex.Currency2) ExamplePredicate {
+	return ExamplePredicate{expr: "currency2 <= ?", args: []interface{}{currency2}}
+}
+
+// Currency2Ne creates predicate "currency2 != ?"
+// nolint: dupl
+func (ExamplePredicates) Currency2Ne(currency2 forex.Currency2) ExamplePredicate {
+	return ExamplePredicate{expr: "currency2 != ?", args: []interface{}{currency2}}
+}
+
+// Currency2NotIn creates predicate "currency2 NOT IN (?)"
+// nolint: dupl
+func (ExamplePredicates) Currency2NotIn(currency2 ...forex.Currency2) ExamplePredicate {
+	if len(currency2) == 0 {
+		return ExamplePredicate{err: errors.New("must at least pass one currency2 in Currency2NotIn")}
+	}
+	return ExamplePredicate{expr: "currency2 NOT IN (?)", args: []interface{}{currency2}}
+}
+
+// Currency2Notlike creates predicate "currency2 NOT LIKE ?"
+// nolint: dupl
+func (ExamplePredicates) Currency2Notlike(currency2 forex.Currency2) ExamplePredicate {
+	return ExamplePredicate{expr: "currency2 NOT LIKE ?", args: []interface{}{currency2}}
+}
+
+// Currency3Eq creates predicate "currency3 = ?"
+// nolint: dupl
+func (ExamplePredicates) Currency3Eq(currency3 forex.Currency3) ExamplePredicate {
+	return ExamplePredicate{expr: "currency3 = ?", args: []interface{}{currency3}}
+}
+
+// Currency3Gt creates predicate "currency3 > ?"
+// nolint: dupl
+func (ExamplePredicates) Currency3Gt(currency3 forex.Currency3) ExamplePredicate {
+	return ExamplePredicate{expr: "currency3 > ?", args: []interface{}{currency3}}
+}
+
+// Currency3Gte creates predicate "currency3 >= ?"
+// nolint: dupl
+func (ExamplePredicates) Currency3Gte(currency3 forex.Currency3) ExamplePredicate {
+	return ExamplePredicate{expr: "currency3 >= ?", args: []interface{}{currency3}}
+}
+
+// Currency3In creates predicate "currency3 IN (?)"
+// nolint: dupl
+func (ExamplePredicates) Currency3In(currency3 ...forex.Currency3) ExamplePredicate {
+	if len(currency3) == 0 {
+		return ExamplePredicate{err: errors.New("must at least pass one currency3 in Currency3In")}
+	}
+	return ExamplePredicate{expr: "currency3 IN (?)", args: []interface{}{currency3}}
+}
+
+// Currency3Like creates predicate "currency3 LIKE ?"
+// nolint: dupl
+func (ExamplePredicates) Currency3Like(currency3 forex.Currency3) ExamplePredicate {
+	return ExamplePredicate{expr: "currency3 LIKE ?", args: []interface{}{currency3}}
+}
+
+// Currency3Lt creates predicate "currency3 < ?"
+// nolint: dupl
+func (ExamplePredicates) Currency3Lt(currency3 forex.Currency3) ExamplePredicate {
+	return ExamplePredicate{expr: "currency3 < ?", args: []interface{}{currency3}}
+}
+
+// Currency3Lte creates predicate "currency3 <= ?"
+// nolint: dupl
+func (ExamplePredicates) Currency3Lte(currency3 forex.Currency3) ExamplePredicate {
+	return ExamplePredicate{expr: "currency3 <= ?", args: []interface{}{currency3}}
+}
+
+// Currency3Ne creates predicate "currency3 != ?"
+// nolint: dupl
+func (ExamplePredicates) Currency3Ne(currency3 forex.Currency3) ExamplePredicate {
+	return ExamplePredicate{expr: "currency3 != ?", args: []interface{}{currency3}}
+}
+
+// Currency3NotIn creates predicate "currency3 NOT IN (?)"
+// nolint: dupl
+func (ExamplePredicates) Currency3NotIn(currency3 ...forex.Currency3) ExamplePredicate {
+	if len(currency3) == 0 {
+		return ExamplePredicate{err: errors.New("must at least pass one currency3 in Currency3NotIn")}
+	}
+	return ExamplePredicate{expr: "currency3 NOT IN (?)", args: []interface{}{currency3}}
+}
+
+// Currency3Notlike creates predicate "currency3 NOT LIKE ?"
+// nolint: dupl
+func (ExamplePredicates) Currency3Notlike(currency3 forex.Currency3) ExamplePredicate {
+	return ExamplePredicate{expr: "currency3 NOT LIKE ?", args: []interface{}{currency3}}
+}
+
+// PriceIDEq creates predicate "price_id = ?"
+// nolint: dupl
+func (ExamplePredicates) PriceIDEq(priceID int64) ExamplePredicate {
+	return ExamplePredicate{expr: "price_id = ?", args: []interface{}{priceID}}
+}
+
+// PriceIDGt creates predicate "price_id > ?"
+// nolint: dupl
+func (ExamplePredicates) PriceIDGt(priceID int64) ExamplePredicate {
+	return ExamplePredicate{expr: "price_id > ?", args: []interface{}{priceID}}
+}
+
+// PriceIDGte creates predicate "price_id >= ?"
+// nolint: dupl
+func (ExamplePredicates) PriceIDGte(priceID int64) ExamplePredicate {
+	return ExamplePredicate{expr: "price_id >= ?", args: []interface{}{priceID}}
+}
+
+// PriceIDIn creates predicate "price_id IN (?)"
+// nolint: dupl
+func (ExamplePredicates) PriceIDIn(priceID ...int64) ExamplePredicate {
+	if len(priceID) == 0 {
+		return ExamplePredicate{err: errors.New("must at least pass one priceID in PriceIDIn")}
+	}
+	return ExamplePredicate{expr: "price_id IN (?)", args: []interface{}{priceID}}
+}
+
+// PriceIDLt creates predicate "price_id < ?"
+// nolint: dupl
+func (ExamplePredicates) PriceIDLt(priceID int64) ExamplePredicate {
+	return ExamplePredicate{expr: "price_id < ?", args: []interface{}{priceID}}
+}
+
+// PriceIDLte creates predicate "price_id <= ?"
+// nolint: dupl
+func (ExamplePredicates) PriceIDLte(priceID int64) ExamplePredicate {
+	return ExamplePredicate{expr: "price_id <= ?", args: []interface{}{priceID}}
+}
+
+// PriceIDNe creates predicate "price_id != ?"
+// nolint: dupl
+func (ExamplePredicates) PriceIDNe(priceID int64) ExamplePredicate {
+	return ExamplePredicate{expr: "price_id != ?", args: []interface{}{priceID}}
+}
+
+// PriceIDNotIn creates predicate "price_id NOT IN (?)"
+// nolint: dupl
+func (ExamplePredicates) PriceIDNotIn(priceID ...int64) ExamplePredicate {
+	if len(priceID) == 0 {
+		return ExamplePredicate{err: errors.New("must at least pass one priceID in PriceIDNotIn")}
+	}
+	return ExamplePredicate{expr: "price_id NOT IN (?)", args: []interface{}{priceID}}
+}
+
+// ===== END of Example predicates
+
+// ForexCurrency1Subquery is a subquery selecting single column, it's created by Select
+// methods of querysets and passed to their InQuery and NotInQuery filters
+type ForexCurrency1Subquery struct {
+	db *gorm.DB
+}
+
+func (q ForexCurrency1Subquery) expr() interface{} {
+	return q.db.QueryExpr()
+}
+
+// ForexCurrency2Subquery is a subquery selecting single column, it's created by Select
+// methods of querysets and passed to their InQuery and NotInQuery filters
+type ForexCurrency2Subquery struct {
+	db *gorm.DB
+}
+
+func (q ForexCurrency2Subquery) expr() interface{} {
+	return q.db.QueryExpr()
+}
+
+// ForexCurrency3Subquery is a subquery selecting single column, it's created by Select
+// methods of querysets and passed to their InQuery and NotInQuery filters
+type ForexCurrency3Subquery struct {
+	db *gorm.DB
+}
+
+func (q ForexCurrency3Subquery) expr() interface{} {
+	return q.db.QueryExpr()
+}
+
+// Int64Subquery is a subquery selecting single column, it's created by Select
+// methods of querysets and passed to their InQuery and NotInQuery filters
+type Int64Subquery struct {
+	db *gorm.DB
+}
+
+func (q Int64Subquery) expr() interface{} {
+	return q.db.QueryExpr()
+}
+
+// ===== END of all query sets
+
+// ===== BEGIN of package helpers
+
+// WithTx runs fn in transaction: it's committed if fn returns nil and
+// rolled back if fn returns error or panics. If db is already a transaction,
+// savepoint is used instead of a new transaction: nested calls are
+// rolled back independently.
+func WithTx(db *gorm.DB, fn func(tx *gorm.DB) error) error {
+	if _, ok := db.CommonDB().(interface{ Rollback() error }); ok {
+		return withTxSavepoint(db, fn)
+	}
+
+	tx := db.Begin()
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	finished := false
+	defer func() {
+		if !finished {
+			tx.Rollback()
+		}
+	}()
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+
+	finished = true
+	return tx.Commit().Error
+}
+
+func withTxSavepoint(db *gorm.DB, fn func(tx *gorm.DB) error) error {
+	const depthKey = "goqueryset:savepoint_depth"
+	depth, _ := db.Get(depthKey)
+	n, _ := depth.(int)
+	savepoint := fmt.Sprintf("goqueryset_sp%d", n+1)
+
+	if err := db.Exec("SAVEPOINT " + savepoint).Error; err != nil {
+		return err
+	}
+
+	released := false
+	defer func() {
+		if !released {
+			db.Exec("ROLLBACK TO SAVEPOINT " + savepoint)
+		}
+	}()
+
+	if err := fn(db.Set(depthKey, n+1)); err != nil {
+		return err
+	}
+
+	released = true
+	return db.Exec("RELEASE SAVEPOINT " + savepoint).Error
+}
+
+// DBWithContext returns db executing queries with context ctx: it's needed
+// to pass context to struct modifiers (Create, Delete, Update).
+// Gorm v1 doesn't support context, so its error is checked only before query.
+func DBWithContext(ctx context.Context, db *gorm.DB) *gorm.DB {
+	return db.Set(queryContextKey, ctx)
+}
+
+const queryContextKey = "goqueryset:context"
+
+// checkQueryContext returns copy of db with context error if context
+// set by DBWithContext is done: gorm doesn't execute queries then.
+func checkQueryContext(db *gorm.DB) *gorm.DB {
+	v, ok := db.Get(queryContextKey)
+	if !ok {
+		return db
+	}
+
+	ctx, ok := v.(context.Context)
+	if !ok || ctx.Err() == nil {
+		return db
+	}
+
+	db = db.Set(queryContextKey, ctx) // copy db to not change passed one
+	db.AddError(ctx.Err())
+	return db
+}
+
+const preloadsKey = "goqueryset:preloads"
+
+// preloadSpec is arguments of gorm Preload call
+type preloadSpec struct {
+	column     string
+	conditions []interface{}
+}
+
+// preload returns db preloading column and remembers preload: gorm v1
+// doesn't expose preloads, but InTx must move them into transaction
+func preload(db *gorm.DB, column string, conditions ...interface{}) *gorm.DB {
+	v, _ := db.Get(preloadsKey)
+	preloads, _ := v.([]preloadSpec)
+	preloads = append(preloads[:len(preloads):len(preloads)], preloadSpec{column: column, conditions: conditions})
+	return db.Preload(column, conditions...).Set(preloadsKey, preloads)
+}
+
+// txWithSettings returns tx with context and preloads of db: they
+// aren't in query expression of db, so InTx moves them explicitly
+func txWithSettings(db, tx *gorm.DB) *gorm.DB {
+	if ctx, ok := db.Get(queryContextKey); ok {
+		tx = tx.Set(queryContextKey, ctx)
+	}
+
+	v, _ := db.Get(preloadsKey)
+	preloads, _ := v.([]preloadSpec)
+	for _, p := range preloads {
+		tx = preload(tx, p.column, p.conditions...)
+	}
+	return tx
+}
+
+const pageOrdersKey = "goqueryset:page_orders"
+
+// pageOrder is an order of queryset remembered for keyset pagination
+type pageOrder struct {
+	column   string
+	desc     bool
+	nullable bool
+}
+
+// addPageOrder remembers order by column in db
+func addPageOrder(db *gorm.DB, column string, desc, nullable bool) *gorm.DB {
+	v, _ := db.Get(pageOrdersKey)
+	orders, _ := v.([]pageOrder)
+	orders = append(orders[:len(orders):len(orders)], pageOrder{column: column, desc: desc, nullable: nullable})
+	return db.Set(pageOrdersKey, orders)
+}
+
+// pageQuery returns db filtered by cursor and ordered by primary key after
+// orders of db, fieldPtrs are pointers to fields of model by their columns.
+// Columns are prefixed by table in SQL, table is empty if they aren't qualified.
+func pageQuery(db *gorm.DB, table, pk, cursor string,
+	fieldPtrs map[string]interface{}) (*gorm.DB, []pageOrder, error) {
+
+	v, _ := db.Get(pageOrdersKey)
+	orders, _ := v.([]pageOrder)
+	hasPKOrder := false
+	for _, o := range orders {
+		if _, ok := fieldPtrs[o.column]; !ok {
+			return nil, nil, fmt.Errorf("can't paginate by column %s: it's not a field of model", o.column)
+		}
+		if o.nullable {
+			// NULL isn't greater or less than cursor value: such rows would be lost
+			return nil, nil, fmt.Errorf("can't paginate by column %s: it's nullable", o.column)
+		}
+		hasPKOrder = hasPKOrder || o.column == pk
+	}
+	if !hasPKOrder {
+		orders = append(orders[:len(orders):len(orders)], pageOrder{column: pk})
+		db = db.Order(table + pk + " ASC")
+	}
+
+	if cursor == "" {
+		return db, orders, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid cursor: %s", err)
+	}
+	var values []json.RawMessage
+	if err = json.Unmarshal(data, &values); err != nil {
+		return nil, nil, fmt.Errorf("invalid cursor: %s", err)
+	}
+	if len(values) != len(orders) {
+		return nil, nil, errors.New("invalid cursor: it was made for other orders")
+	}
+
+	// (a > ?) OR (a = ? AND b < ?) OR ...
+	var conds []string
+	var args []interface{}
+	var eqConds []string
+	var eqArgs []interface{}
+	for i, o := range orders {
+		ptr := fieldPtrs[o.column]
+		if err = json.Unmarshal(values[i], ptr); err != nil {
+			return nil, nil, fmt.Errorf("invalid cursor value of %s: %s", o.column, err)
+		}
+		value := reflect.ValueOf(ptr).Elem().Interface()
+
+		op := ">"
+		if o.desc {
+			op = "<"
+		}
+		conds = append(conds, "("+strings.Join(append(eqConds, table+o.column+" "+op+" ?"), " AND ")+")")
+		args = append(append(args, eqArgs...), value)
+
+		eqConds = append(eqConds, table+o.column+" = ?")
+		eqArgs = append(eqArgs, value)
+	}
+
+	return db.Where(strings.Join(conds, " OR "), args...), orders, nil
+}
+
+// encodePageCursor encodes values of ordered fields of the last fetched row
+func encodePageCursor(orders []pageOrder, fieldPtrs map[string]interface{}) (string, error) {
+	var values []interface{}
+	for _, o := range orders {
+		values = append(values, fieldPtrs[o.column])
+	}
+
+	data, err := json.Marshal(values)
+	if err != nil {
+		return "", fmt.Errorf("can't encode cursor: %s", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// ValidationError is returned by Validate methods of models and updaters
+type ValidationError struct {
+	Field  string // name of invalid field
+	Reason string
+}
+
+func (e ValidationError) Error() string {
+	return fmt.Sprintf("invalid field %s: %s", e.Field, e.Reason)
+}
+
+// checkConflictFields checks that row updated by upsert can be found: only
+// MySQL finds conflicting row without conflict fields
+func checkConflictFields(db *gorm.DB, conflictColumns, updateColumns []string) error {
+	if len(conflictColumns) == 0 && len(updateColumns) != 0 && dialectName(db) != "mysql" {
+		return errors.New("conflict fields are required to update existing row by upsert")
+	}
+
+	return nil
+}
+
+// dialectName returns name of database dialect of db
+func dialectName(db *gorm.DB) string {
+	return db.Dialect().GetName()
+}
+
+// likePatternEscaper escapes wildcards of LIKE with "!": backslash isn't
+// used because its escaping in string literals differs between databases.
+// "[" is a wildcard in SQL Server.
+var likePatternEscaper = strings.NewReplacer("!", "!!", "%", "!%", "_", "!_", "[", "![")
+
+// whereLike filters column by pattern: escaped value between prefix and
+// suffix. Case-insensitive matching uses ILIKE in PostgreSQL and LOWER elsewhere.
+func whereLike(db *gorm.DB, column, prefix, value, suffix string, caseInsensitive bool) *gorm.DB {
+	pattern := prefix + likePatternEscaper.Replace(value) + suffix
+	switch {
+	case !caseInsensitive:
+		return db.Where(column+" LIKE ? ESCAPE '!'", pattern)
+	case dialectName(db) == "postgres":
+		return db.Where(column+" ILIKE ? ESCAPE '!'", pattern)
+	default:
+		return db.Where("LOWER("+column+") LIKE LOWER(?) ESCAPE '!'", pattern)
+	}
+}
+
+// jsonColumnValue is a map or a slice stored in JSON column: it's marshaled
+// to JSON when passed to database, nil is stored as NULL
+type jsonColumnValue struct {
+	v interface{}
+}
+
+// Value implements driver.Valuer
+func (j jsonColumnValue) Value() (driver.Value, error) {
+	if reflect.ValueOf(j.v).IsNil() {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(j.v)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// whereJSONEq filters JSON column by text value at dot-separated path
+func whereJSONEq(db *gorm.DB, column, path, value string) *gorm.DB {
+	switch dialectName(db) {
+	case "postgres":
+		return db.Where(column+" #>> ? = ?", "{"+strings.Replace(path, ".", ",", -1)+"}", value)
+	case "mysql":
+		return db.Where("JSON_UNQUOTE(JSON_EXTRACT("+column+", ?)) = ?", "$."+path, value)
+	default:
+		return db.Where("CAST(JSON_EXTRACT("+column+", ?) AS TEXT) = ?", "$."+path, value)
+	}
+}
+
+// bulkInsertBatchSize returns max count of rows in one insert query:
+// databases limit count of placeholders in query
+func bulkInsertBatchSize(dialect string, columns int) int {
+	maxParams := 999 // sqlite and unknown dialects
+	switch dialect {
+	case "mysql", "postgres":
+		maxParams = 65535
+	case "mssql", "sqlserver":
+		maxParams = 2100
+	}
+
+	if columns == 0 || columns > maxParams {
+		return 1
+	}
+	return maxParams / columns
+}
+
+// isZeroField checks whether field pointed by ptr has zero value
+func isZeroField(ptr interface{}) bool {
+	v := reflect.ValueOf(ptr).Elem()
+	return reflect.DeepEqual(v.Interface(), reflect.Zero(v.Type()).Interface())
+}
+
+// setGeneratedPK sets field of primary key pointed by ptr to id
+func setGeneratedPK(ptr interface{}, id int64) {
+	v := reflect.ValueOf(ptr).Elem()
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(id)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.SetUint(uint64(id))
+	}
+}
+
+// prepareInsertRows sets timestamps like gorm does on create and returns
+// sorted columns to insert: primary key is skipped if it's generated.
+// Columns of timestamps are taken from parsed model of db.
+func prepareInsertRows(db *gorm.DB, rows []map[string]interface{}, generatedPK string) []string {
+	scope := db.NewScope(db.Value)
+	var timeColumns []string
+	for _, name := range []string{"CreatedAt", "UpdatedAt"} {
+		if f, ok := scope.FieldByName(name); ok {
+			timeColumns = append(timeColumns, f.DBName)
+		}
+	}
+
+	now := gorm.NowFunc()
+	for _, r := range rows {
+		for _, c := range timeColumns {
+			if t, ok := r[c].(*time.Time); ok && t.IsZero() {
+				*t = now
+			}
+		}
+	}
+
+	var columns []string
+	for c := range rows[0] {
+		if c != generatedPK {
+			columns = append(columns, c)
+		}
+	}
+	sort.Strings(columns)
+	return columns
+}
+
+// defaultValueColumns returns columns of db model having default values
+func defaultValueColumns(db *gorm.DB) map[string]bool {
+	ret := map[string]bool{}
+	for _, f := range db.NewScope(db.Value).GetModelStruct().StructFields {
+		if f.HasDefaultValue && !f.IsPrimaryKey {
+			ret[f.DBName] = true
+		}
+	}
+	return ret
+}
+
+// rowInsertColumns returns columns to insert row by: columns having
+// default values are skipped if they are zero like gorm does on create
+func rowInsertColumns(columns []string, row map[string]interface{}, defaults map[string]bool) []string {
+	ret := make([]string, 0, len(columns))
+	for _, c := range columns {
+		if !defaults[c] || !isZeroField(row[c]) {
+			ret = append(ret, c)
+		}
+	}
+	return ret
+}
+
+// buildInsertQuery returns query inserting rows into table of db model
+func buildInsertQuery(db *gorm.DB, columns []string, rows []map[string]interface{}) (string, []interface{}) {
+	dialect := db.Dialect()
+	quotedColumns := make([]string, 0, len(columns))
+	for _, c := range columns {
+		quotedColumns = append(quotedColumns, dialect.Quote(c))
+	}
+
+	var values []string
+	var args []interface{}
+	for _, r := range rows {
+		placeholders := make([]string, 0, len(columns))
+		for _, c := range columns {
+			args = append(args, reflect.ValueOf(r[c]).Elem().Interface())
+			placeholders = append(placeholders, dialect.BindVar(len(args)))
+		}
+		values = append(values, "("+strings.Join(placeholders, ",")+")")
+	}
+
+	query := fmt.Sprintf("INSERT INTO %s (%s) VALUES %s", db.NewScope(db.Value).QuotedTableName(),
+		strings.Join(quotedColumns, ","), strings.Join(values, ","))
+	// gorm's common dialect bind var is replaced by "?" in queries
+	query = strings.Replace(query, "$$$", "?", -1)
+	return query, args
+}
+
+// bulkInsert inserts rows (pointers to fields by columns) into table of db model
+// by batches. Primary key is generated by database if it's not set in all rows.
+func bulkInsert(db *gorm.DB, pk string, rows []map[string]interface{}) error {
+	if db.Error != nil {
+		return db.Error
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+
+	generatedPK := ""
+	if pk != "" {
+		zeroPKs := 0
+		for _, r := range rows {
+			if isZeroField(r[pk]) {
+				zeroPKs++
+			}
+		}
+		if zeroPKs != 0 && zeroPKs != len(rows) {
+			return errors.New("primary key must be set in all rows or in none of them")
+		}
+		if zeroPKs != 0 {
+			generatedPK = pk
+		}
+	}
+
+	columns := prepareInsertRows(db, rows, generatedPK)
+	defaults := defaultValueColumns(db)
+	for start := 0; start < len(rows); {
+		// batch is made of consecutive rows with the same columns:
+		// zero columns having default values are skipped
+		batchColumns := rowInsertColumns(columns, rows[start], defaults)
+		size := bulkInsertBatchSize(db.Dialect().GetName(), len(batchColumns))
+		end := start + 1
+		for end < len(rows) && end-start < size &&
+			reflect.DeepEqual(rowInsertColumns(columns, rows[end], defaults), batchColumns) {
+			end++
+		}
+
+		if err := bulkInsertBatch(db, batchColumns, generatedPK, rows[start:end]); err != nil {
+			return err
+		}
+		start = end
+	}
+
+	return nil
+}
+
+// bulkInsertBatch inserts rows by one query and writes back generated
+// primary keys if database supports it
+func bulkInsertBatch(db *gorm.DB, columns []string, generatedPK string,
+	rows []map[string]interface{}) error {
+
+	dialect := db.Dialect()
+	query, args := buildInsertQuery(db, columns, rows)
+
+	if generatedPK != "" && dialect.GetName() == "postgres" {
+		// rows are returned in order of values
+		res, err := db.CommonDB().Query(query+" RETURNING "+dialect.Quote(generatedPK), args...)
+		if err != nil {
+			return err
+		}
+		defer res.Close()
+
+		for i := 0; res.Next(); i++ {
+			var id int64
+			if err = res.Scan(&id); err != nil {
+				return err
+			}
+			setGeneratedPK(rows[i][generatedPK], id)
+		}
+		return res.Err()
+	}
+
+	res, err := db.CommonDB().Exec(query, args...)
+	if err != nil {
+		return err
+	}
+
+	if generatedPK == "" {
+		return nil
+	}
+
+	var firstID int64
+	switch dialect.GetName() {
+	case "mysql":
+		// ids of rows inserted by one query are consecutive,
+		// the first of them is returned
+		firstID, err = res.LastInsertId()
+	case "sqlite3":
+		// the last id is returned
+		firstID, err = res.LastInsertId()
+		firstID -= int64(len(rows) - 1)
+	default:
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for i, r := range rows {
+		setGeneratedPK(r[generatedPK], firstID+int64(i))
+	}
+	return nil
+}
+
+// upsert inserts row (pointers to fields by columns) into table of db model
+// or updates updateColumns of existing row if insert violates unique
+// constraint on conflictColumns. Generated primary key is written back
+// for MySQL and PostgreSQL.
+func upsert(db *gorm.DB, pk string, row map[string]interface{},
+	conflictColumns, updateColumns []string) error {
+
+	if db.Error != nil {
+		return db.Error
+	}
+	if err := checkConflictFields(db, conflictColumns, updateColumns); err != nil {
+		return err
+	}
+
+	generatedPK := ""
+	if pk != "" && isZeroField(row[pk]) {
+		generatedPK = pk
+	}
+
+	rows := []map[string]interface{}{row}
+	columns := rowInsertColumns(prepareInsertRows(db, rows, generatedPK), row, defaultValueColumns(db))
+	query, args := buildInsertQuery(db, columns, rows)
+
+	dialect := db.Dialect()
+	var sets []string
+	switch dialect.GetName() {
+	case "mysql":
+		// conflict columns are chosen by MySQL itself
+		if generatedPK != "" {
+			// make LAST_INSERT_ID return id of updated row
+			sets = append(sets, fmt.Sprintf("%s = LAST_INSERT_ID(%s)",
+				dialect.Quote(generatedPK), dialect.Quote(generatedPK)))
+		}
+		for _, c := range updateColumns {
+			sets = append(sets, fmt.Sprintf("%s = VALUES(%s)", dialect.Quote(c), dialect.Quote(c)))
+		}
+		if len(sets) == 0 {
+			// do nothing: assign column to itself
+			c := pk
+			if len(conflictColumns) != 0 {
+				c = conflictColumns[0]
+			} else if c == "" {
+				c = columns[0]
+			}
+			sets = append(sets, dialect.Quote(c)+" = "+dialect.Quote(c))
+		}
+		query += " ON DUPLICATE KEY UPDATE " + strings.Join(sets, ", ")
+	case "postgres", "sqlite3":
+		var quotedConflictColumns []string
+		for _, c := range conflictColumns {
+			quotedConflictColumns = append(quotedConflictColumns, dialect.Quote(c))
+		}
+		for _, c := range updateColumns {
+			sets = append(sets, fmt.Sprintf("%s = excluded.%s", dialect.Quote(c), dialect.Quote(c)))
+		}
+
+		query += " ON CONFLICT"
+		if len(quotedConflictColumns) != 0 {
+			query += " (" + strings.Join(quotedConflictColumns, ",") + ")"
+		}
+		if len(sets) == 0 {
+			query += " DO NOTHING"
+		} else {
+			query += " DO UPDATE SET " + strings.Join(sets, ", ")
+		}
+	default:
+		return fmt.Errorf("upsert isn't supported for dialect %s", dialect.GetName())
+	}
+
+	if generatedPK != "" && dialect.GetName() == "postgres" {
+		var id int64
+		err := db.CommonDB().QueryRow(query+" RETURNING "+dialect.Quote(generatedPK), args...).Scan(&id)
+		if err == sql.ErrNoRows {
+			return nil // conflict without update
+		}
+		if err != nil {
+			return err
+		}
+
+		setGeneratedPK(row[generatedPK], id)
+		return nil
+	}
+
+	res, err := db.CommonDB().Exec(query, args...)
+	if err != nil {
+		return err
+	}
+
+	if generatedPK != "" && dialect.GetName() == "mysql" {
+		id, err := res.LastInsertId()
+		if err != nil {
+			return err
+		}
+		if id != 0 {
+			setGeneratedPK(row[generatedPK], id)
+		}
+	}
+
+	return nil
+}
+
+// ===== END of package helpers
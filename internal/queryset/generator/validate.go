@@ -0,0 +1,214 @@
+package generator
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jirfag/go-queryset/internal/queryset/field"
+)
+
+// fieldValidation is a code validating value of field: the value is in
+// variable v of field's type
+type fieldValidation struct {
+	Field  field.Info
+	Checks string
+}
+
+// validationRule is a rule from tags: gorm's "not null" and "size:N" (max
+// length of string) or "required", "min=N", "max=N", "oneof=a b c" from
+// validate tag
+type validationRule struct {
+	name  string
+	param string
+}
+
+func getValidationRules(f field.Info) ([]validationRule, error) {
+	var rules []validationRule
+	if _, ok := f.TagSetting["NOT NULL"]; ok {
+		rules = append(rules, validationRule{name: "notnull"})
+	}
+	if size := f.TagSetting["SIZE"]; size != "" {
+		if _, err := strconv.Atoi(size); err != nil {
+			return nil, fmt.Errorf("invalid size %q: %s", size, err)
+		}
+		rules = append(rules, validationRule{name: "size", param: size})
+	}
+
+	tag := strings.TrimSpace(f.Tag.Get("validate"))
+	if tag == "" {
+		return rules, nil
+	}
+
+	for _, r := range strings.Split(tag, ",") {
+		kv := strings.SplitN(strings.TrimSpace(r), "=", 2)
+		rule := validationRule{name: kv[0]}
+		if len(kv) == 2 {
+			rule.param = kv[1]
+		}
+
+		switch rule.name {
+		case "required":
+		case "min", "max", "oneof":
+			if rule.param == "" {
+				return nil, fmt.Errorf("rule %s requires parameter", rule.name)
+			}
+		default:
+			return nil, fmt.Errorf("unknown rule %q", rule.name)
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+func validationErrorCode(f field.Info, reason string) string {
+	return fmt.Sprintf("return ValidationError{Field: %q, Reason: %q}", f.Name, reason)
+}
+
+// getRuleCheck returns code checking value of non-pointer field f in variable v
+func getRuleCheck(f field.Info, r validationRule, v string) (string, error) {
+	isComparable := !f.IsTime && (f.IsString || f.IsNumeric)
+
+	var cond, reason string
+	switch r.name {
+	case "notnull":
+		return "", nil // non-pointer value can't be NULL
+	case "size":
+		if !f.IsString {
+			return "", nil // e.g. bit size of integer
+		}
+		cond = fmt.Sprintf("utf8.RuneCountInString(string(%s)) > %s", v, r.param)
+		reason = "length must be at most " + r.param
+	case "required":
+		switch {
+		case f.IsTime:
+			cond = v + ".IsZero()"
+		case f.IsString:
+			cond = v + ` == ""`
+		case f.IsNumeric:
+			cond = v + " == 0"
+		default:
+			return "", fmt.Errorf("rule required isn't supported for type %s", f.TypeName)
+		}
+		reason = "is required"
+	case "min", "max":
+		if !isComparable {
+			return "", fmt.Errorf("rule %s isn't supported for type %s", r.name, f.TypeName)
+		}
+		if _, err := strconv.ParseFloat(r.param, 64); err != nil {
+			return "", fmt.Errorf("invalid parameter of rule %s: %s", r.name, err)
+		}
+
+		op, what := "<", "at least"
+		if r.name == "max" {
+			op, what = ">", "at most"
+		}
+		if f.IsString {
+			cond = fmt.Sprintf("utf8.RuneCountInString(string(%s)) %s %s", v, op, r.param)
+			reason = fmt.Sprintf("length must be %s %s", what, r.param)
+		} else {
+			cond = fmt.Sprintf("%s %s %s", v, op, r.param)
+			reason = fmt.Sprintf("must be %s %s", what, r.param)
+		}
+	case "oneof":
+		if !isComparable {
+			return "", fmt.Errorf("rule oneof isn't supported for type %s", f.TypeName)
+		}
+
+		var conds []string
+		for _, value := range strings.Fields(r.param) {
+			if f.IsString {
+				value = strconv.Quote(value)
+			} else if _, err := strconv.ParseFloat(value, 64); err != nil {
+				return "", fmt.Errorf("invalid parameter of rule oneof: %s", err)
+			}
+			conds = append(conds, fmt.Sprintf("%s != %s", v, value))
+		}
+		cond = strings.Join(conds, " && ")
+		reason = "must be one of: " + r.param
+	}
+
+	return fmt.Sprintf("if %s {\n%s\n}", cond, validationErrorCode(f, reason)), nil
+}
+
+// getFieldValidation returns nil if there are no validation rules for field
+func getFieldValidation(f field.Info) (*fieldValidation, error) {
+	rules, err := getValidationRules(f)
+	if err != nil {
+		return nil, fmt.Errorf("invalid validation rules of field %s: %s", f.Name, err)
+	}
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	var checks []string
+	if f.IsNullable() {
+		isNull, notNull, value := "v == nil", "v != nil", "*v"
+		if f.IsNullValue {
+			isNull, notNull, value = "!v.Valid", "v.Valid", "v."+f.NullValueField
+		}
+
+		var pointedChecks []string
+		for _, r := range rules {
+			if r.name == "notnull" || r.name == "required" {
+				reason := "must be not null"
+				if r.name == "required" {
+					reason = "is required"
+				}
+				checks = append(checks, fmt.Sprintf("if %s {\n%s\n}",
+					isNull, validationErrorCode(f, reason)))
+				continue
+			}
+
+			check, err := getRuleCheck(f.GetPointed(), r, value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid validation rules of field %s: %s", f.Name, err)
+			}
+			if check != "" {
+				pointedChecks = append(pointedChecks, check)
+			}
+		}
+		if len(pointedChecks) != 0 {
+			checks = append(checks, fmt.Sprintf("if %s {\n%s\n}", notNull, strings.Join(pointedChecks, "\n")))
+		}
+	} else {
+		for _, r := range rules {
+			check, err := getRuleCheck(f, r, "v")
+			if err != nil {
+				return nil, fmt.Errorf("invalid validation rules of field %s: %s", f.Name, err)
+			}
+			if check != "" {
+				checks = append(checks, check)
+			}
+		}
+	}
+
+	if len(checks) == 0 {
+		return nil, nil
+	}
+
+	return &fieldValidation{
+		Field:  f,
+		Checks: strings.Join(checks, "\n"),
+	}, nil
+}
+
+func getFieldValidations(fields []field.Info) ([]fieldValidation, error) {
+	var ret []fieldValidation
+	for _, f := range fields {
+		if f.IsStruct || (f.IsPointer && f.GetPointed().IsStruct) {
+			continue // associations are validated by their own Validate
+		}
+
+		v, err := getFieldValidation(f)
+		if err != nil {
+			return nil, err
+		}
+		if v != nil {
+			ret = append(ret, *v)
+		}
+	}
+
+	return ret, nil
+}
@@ -0,0 +1,102 @@
+package methods
+
+import "fmt"
+
+// AggrMethod creates aggregate method: Sum, Avg, Min or Max of field
+type AggrMethod struct {
+	onFieldMethod
+	noArgsMethod
+	baseQuerySetMethod
+	constRetMethod
+	constBodyMethod
+}
+
+func newAggrMethod(ctx QsFieldContext, sqlFunc, retTypeName string) AggrMethod {
+	// result is NULL if there are no rows: scan it into pointer
+	body := fmt.Sprintf(`var ret %s
+		var res struct {
+			Value *%s
+		}
+		err := %s.Select(%s).Scan(&res).Error
+		if res.Value != nil {
+			ret = *res.Value
+		}
+		return ret, err`, retTypeName, retTypeName,
+		ctx.backend.queryDBExpr(qsDbName), ctx.columnSQL(sqlFunc+"(%s) AS value"))
+
+	return newAggrMethodWithBody(ctx, sqlFunc, retTypeName, body)
+}
+
+// newTimeAggrMethod creates Min or Max method of time field: the value
+// is selected by order instead of aggregate function, because result of
+// aggregate function loses column type in SQLite and its drivers can't
+// scan it into time.Time
+func newTimeAggrMethod(ctx QsFieldContext, sqlFunc string, desc bool) AggrMethod {
+	sqlOrder := "ASC"
+	if desc {
+		sqlOrder = "DESC"
+	}
+	order := fmt.Sprintf("Order(%s, true)", ctx.columnSQL("%s "+sqlOrder))
+	if ctx.backend.IsGormV2() {
+		order = fmt.Sprintf(`Order(clause.OrderByColumn{
+			Column:  clause.Column{Name: %s, Raw: true},
+			Desc:    %t,
+			Reorder: true,
+		})`, ctx.columnSQL("%s"), desc)
+	}
+
+	// rows are scanned into slice: gorm v1 returns error if there are no rows
+	body := fmt.Sprintf(`var rows []struct {
+			Value %s
+		}
+		err := %s.Select(%s).Where(%s).
+			%s.Limit(1).Scan(&rows).Error
+		if len(rows) == 0 {
+			var ret %s
+			return ret, err
+		}
+		return rows[0].Value, err`, ctx.fieldTypeName(),
+		ctx.backend.queryDBExpr(qsDbName), ctx.columnSQL("%s AS value"), ctx.columnSQL("%s IS NOT NULL"),
+		order, ctx.fieldTypeName())
+
+	return newAggrMethodWithBody(ctx, sqlFunc, ctx.fieldTypeName(), body)
+}
+
+func newAggrMethodWithBody(ctx QsFieldContext, sqlFunc, retTypeName, body string) AggrMethod {
+	r := AggrMethod{
+		onFieldMethod:      ctx.onFieldMethod(),
+		baseQuerySetMethod: newBaseQuerySetMethod(ctx.qsTypeName()),
+		constRetMethod:     newConstRetMethod(fmt.Sprintf("(%s, error)", retTypeName)),
+		constBodyMethod:    newConstBodyMethod("%s", body),
+	}
+	r.setFieldNameFirst(false) // RatingSum -> SumRating
+	r.setDoc(fmt.Sprintf(`// %s returns %s of %s, it's zero value if there are no rows
+	// nolint: dupl`, r.GetMethodName(), sqlFunc, ctx.fieldName()))
+	return r
+}
+
+// NewSumMethod creates Sum<Field> method
+func NewSumMethod(ctx QsFieldContext) AggrMethod {
+	return newAggrMethod(ctx.WithOperationName("Sum"), "SUM", ctx.fieldTypeName())
+}
+
+// NewAvgMethod creates Avg<Field> method
+func NewAvgMethod(ctx QsFieldContext) AggrMethod {
+	return newAggrMethod(ctx.WithOperationName("Avg"), "AVG", "float64")
+}
+
+// NewMinMethod creates Min<Field> method
+func NewMinMethod(ctx QsFieldContext) AggrMethod {
+	if ctx.f.IsTime {
+		return newTimeAggrMethod(ctx.WithOperationName("Min"), "MIN", false)
+	}
+	return newAggrMethod(ctx.WithOperationName("Min"), "MIN", ctx.fieldTypeName())
+}
+
+// NewMaxMethod creates Max<Field> method
+func NewMaxMethod(ctx QsFieldContext) AggrMethod {
+	if ctx.f.IsTime {
+		return newTimeAggrMethod(ctx.WithOperationName("Max"), "MAX", true)
+	}
+	return newAggrMethod(ctx.WithOperationName("Max"), "MAX", ctx.fieldTypeName())
+}
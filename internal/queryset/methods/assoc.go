@@ -0,0 +1,125 @@
+package methods
+
+import (
+	"fmt"
+	"strings"
+)
+
+// AssocSpec describes has many or many to many association
+type AssocSpec struct {
+	FieldName     string // name of association field, e.g. Posts
+	AssocTypeName string // name of associated struct type, e.g. Post
+	JoinTable     string // join table of many to many association, empty for has many
+}
+
+func (s AssocSpec) argName() string {
+	return fieldNameToArgName(s.FieldName)
+}
+
+// AssociationMethod is a method of model changing or counting objects of
+// has many or many to many association by gorm's Association API
+type AssociationMethod struct {
+	onFieldMethod
+	structMethod
+	nArgsMethod
+	constRetMethod
+	constBodyMethod
+}
+
+func newAssociationMethod(ctx QsStructContext, spec AssocSpec, name, ret, body string,
+	args ...oneArgMethod) AssociationMethod {
+
+	r := AssociationMethod{
+		onFieldMethod:   newOnFieldMethod(name, spec.FieldName),
+		structMethod:    newStructMethod("o", "*"+ctx.s.TypeName),
+		nArgsMethod:     newNArgsMethod(append([]oneArgMethod{newOneArgMethod("db", "*gorm.DB")}, args...)...),
+		constRetMethod:  newConstRetMethod(ret),
+		constBodyMethod: newConstBodyMethod("%s", body),
+	}
+	r.setFieldNameFirst(false) // PostsAppend -> AppendPosts
+	return r
+}
+
+// associationExpr returns expression of gorm association of object o
+func associationExpr(ctx QsStructContext, spec AssocSpec) string {
+	return fmt.Sprintf(`%s.Model(o).Association("%s")`, ctx.backend.queryDBExpr("db"), spec.FieldName)
+}
+
+// newChangeAssociationMethod creates method calling gorm's association
+// method gormMethod: it returns *Association in gorm v1 and error in v2
+func newChangeAssociationMethod(ctx QsStructContext, spec AssocSpec, name, gormMethod string,
+	hasArgs bool) AssociationMethod {
+
+	var args []oneArgMethod
+	gormArgs := ""
+	if hasArgs {
+		args = append(args, newOneArgMethod(spec.argName(), "...*"+spec.AssocTypeName))
+		gormArgs = spec.argName()
+	}
+
+	body := fmt.Sprintf("return %s.%s(%s)", associationExpr(ctx, spec), gormMethod, gormArgs)
+	if !ctx.backend.IsGormV2() {
+		body += ".Error"
+	}
+
+	return newAssociationMethod(ctx, spec, name, "error", body, args...)
+}
+
+func (s AssocSpec) relationDoc() string {
+	if s.JoinTable != "" {
+		return "rows of join table " + s.JoinTable
+	}
+
+	return "foreign keys of " + s.AssocTypeName
+}
+
+// NewAppendAssociationMethod creates Append<Field> method: it saves
+// objects and adds them to association
+func NewAppendAssociationMethod(ctx QsStructContext, spec AssocSpec) AssociationMethod {
+	r := newChangeAssociationMethod(ctx, spec, "Append", "Append", true)
+	r.setDoc(fmt.Sprintf(`// %s adds %s to %s of o: %s are saved and %s are set
+	// nolint: dupl`, r.GetMethodName(), spec.argName(), spec.FieldName, spec.argName(), spec.relationDoc()))
+	return r
+}
+
+// NewReplaceAssociationMethod creates Replace<Field> method
+func NewReplaceAssociationMethod(ctx QsStructContext, spec AssocSpec) AssociationMethod {
+	r := newChangeAssociationMethod(ctx, spec, "Replace", "Replace", true)
+	r.setDoc(fmt.Sprintf(`// %s replaces %s of o by %s: other objects are removed
+	// from association but aren't deleted
+	// nolint: dupl`, r.GetMethodName(), spec.FieldName, spec.argName()))
+	return r
+}
+
+// NewRemoveAssociationMethod creates Remove<Field> method, it's Delete
+// method of gorm's association
+func NewRemoveAssociationMethod(ctx QsStructContext, spec AssocSpec) AssociationMethod {
+	r := newChangeAssociationMethod(ctx, spec, "Remove", "Delete", true)
+	r.setDoc(fmt.Sprintf(`// %s removes %s from %s of o: %s are cleared,
+	// objects aren't deleted
+	// nolint: dupl`, r.GetMethodName(), spec.argName(), spec.FieldName, spec.relationDoc()))
+	return r
+}
+
+// NewClearAssociationMethod creates Clear<Field> method
+func NewClearAssociationMethod(ctx QsStructContext, spec AssocSpec) AssociationMethod {
+	r := newChangeAssociationMethod(ctx, spec, "Clear", "Clear", false)
+	r.setDoc(fmt.Sprintf(`// %s removes all objects from %s of o: %s are cleared,
+	// objects aren't deleted
+	// nolint: dupl`, r.GetMethodName(), spec.FieldName, spec.relationDoc()))
+	return r
+}
+
+// NewCountAssociationMethod creates Count<Field> method
+func NewCountAssociationMethod(ctx QsStructContext, spec AssocSpec) AssociationMethod {
+	body := strings.Join([]string{
+		"a := " + associationExpr(ctx, spec),
+		"n := a.Count()",
+		"return n, a.Error",
+	}, "\n")
+	r := newAssociationMethod(ctx, spec, "Count",
+		fmt.Sprintf("(%s, error)", ctx.backend.CountTypeName()), body)
+	r.setDoc(fmt.Sprintf(`// %s returns number of objects in %s of o
+	// nolint: dupl`, r.GetMethodName(), spec.FieldName))
+	return r
+}
@@ -0,0 +1,88 @@
+package methods
+
+import "fmt"
+
+// Backend is a version of gorm API generated code is built for
+type Backend string
+
+const (
+	// BackendGormV1 generates code for github.com/jinzhu/gorm
+	BackendGormV1 Backend = "gorm1"
+	// BackendGormV2 generates code for gorm.io/gorm
+	BackendGormV2 Backend = "gorm2"
+)
+
+// ParseBackend parses backend name, empty name means default backend
+func ParseBackend(name string) (Backend, error) {
+	switch b := Backend(name); b {
+	case "":
+		return BackendGormV1, nil
+	case BackendGormV1, BackendGormV2:
+		return b, nil
+	default:
+		return "", fmt.Errorf("unknown backend %q, must be %q or %q",
+			name, BackendGormV1, BackendGormV2)
+	}
+}
+
+// IsGormV2 returns true if code is generated for gorm.io/gorm
+func (b Backend) IsGormV2() bool {
+	return b == BackendGormV2
+}
+
+// ImportPath returns import path of gorm package
+func (b Backend) ImportPath() string {
+	if b.IsGormV2() {
+		return "gorm.io/gorm"
+	}
+
+	return "github.com/jinzhu/gorm"
+}
+
+// ImportPaths returns import paths of gorm packages used by generated code
+func (b Backend) ImportPaths() []string {
+	if b.IsGormV2() {
+		return []string{b.ImportPath(), "gorm.io/gorm/clause"}
+	}
+
+	return []string{b.ImportPath()}
+}
+
+// CountTypeName returns type of value gorm's Count accepts
+func (b Backend) CountTypeName() string {
+	if b.IsGormV2() {
+		return "int64"
+	}
+
+	return "int"
+}
+
+// emptyModelExpr returns expression to pass model to Delete:
+// gorm v2 needs an addressable value
+func (b Backend) emptyModelExpr(structTypeName string) string {
+	if b.IsGormV2() {
+		return "&" + structTypeName + "{}"
+	}
+
+	return structTypeName + "{}"
+}
+
+// queryDBExpr returns expression of db to execute query by: gorm v1 doesn't
+// support context, so generated helper checks context error before query
+func (b Backend) queryDBExpr(dbExpr string) string {
+	if b.IsGormV2() {
+		return dbExpr
+	}
+
+	return "checkQueryContext(" + dbExpr + ")"
+}
+
+// preloadExpr returns expression of db preloading association by args:
+// gorm v1 doesn't expose preloads, so generated helper remembers them for InTx
+func (b Backend) preloadExpr(dbExpr, args string) string {
+	if b.IsGormV2() {
+		return dbExpr + ".Preload(" + args + ")"
+	}
+
+	return "preload(" + dbExpr + ", " + args + ")"
+}
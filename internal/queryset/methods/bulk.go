@@ -0,0 +1,92 @@
+package methods
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jirfag/go-queryset/internal/queryset/field"
+)
+
+// FieldPtrsMethod creates unexported fieldPtrs method returning pointers
+// to fields by their columns: they are used by generated helpers, e.g.
+// to encode cursor of keyset pagination or to make bulk insert. Maps and
+// slices stored in JSON are wrapped to jsonColumnValue to be marshaled.
+type FieldPtrsMethod struct {
+	namedMethod
+	baseQuerySetMethod
+	oneArgMethod
+	constRetMethod
+	constBodyMethod
+}
+
+// NewFieldPtrsMethod creates FieldPtrsMethod, fields must be columns of table
+func NewFieldPtrsMethod(ctx QsStructContext, fields []field.Info) FieldPtrsMethod {
+	var ptrs []string
+	for _, f := range fields {
+		if f.IsMarshaledToJSON() {
+			// value is copied: fields stored in JSON are only passed to database
+			ptrs = append(ptrs, fmt.Sprintf(`"%s": &jsonColumnValue{o.%s},`, f.DBName, f.Name))
+			continue
+		}
+		ptrs = append(ptrs, fmt.Sprintf(`"%s": &o.%s,`, f.DBName, f.Name))
+	}
+
+	r := FieldPtrsMethod{
+		namedMethod:        newNamedMethod("fieldPtrs"),
+		baseQuerySetMethod: newBaseQuerySetMethod(ctx.qsTypeName()),
+		oneArgMethod:       newOneArgMethod("o", "*"+ctx.s.TypeName),
+		constRetMethod:     newConstRetMethod("map[string]interface{}"),
+		constBodyMethod: newConstBodyMethod("return map[string]interface{}{\n%s\n}",
+			strings.Join(ptrs, "\n")),
+	}
+	r.setDoc(`// fieldPtrs returns pointers to fields of o by their columns
+	// nolint: dupl`)
+	return r
+}
+
+// CreateManyMethod creates CreateMany method making bulk insert
+type CreateManyMethod struct {
+	namedMethod
+	baseQuerySetMethod
+	oneArgMethod
+	errorRetMethod
+	constBodyMethod
+}
+
+// NewCreateManyMethod creates CreateManyMethod, pkDBName is a column of
+// primary key or empty string if there is no primary key
+func NewCreateManyMethod(ctx QsStructContext, pkDBName string) CreateManyMethod {
+	body := `if len(models) == 0 {
+			return nil
+		}
+
+		size := bulkInsertBatchSize(qs.db.Dialector.Name(), len(qs.fieldPtrs(&models[0])))
+		// new db to not use conditions of queryset
+		return qs.db.Session(&gorm.Session{NewDB: true}).CreateInBatches(&models, size).Error`
+	if !ctx.backend.IsGormV2() {
+		body = fmt.Sprintf(`rows := make([]map[string]interface{}, 0, len(models))
+			for i := range models {
+				rows = append(rows, qs.fieldPtrs(&models[i]))
+			}
+
+			return bulkInsert(%s, "%s", rows)`, ctx.backend.queryDBExpr(qsDbName), pkDBName)
+	}
+
+	r := CreateManyMethod{
+		namedMethod:        newNamedMethod("CreateMany"),
+		baseQuerySetMethod: newBaseQuerySetMethod(ctx.qsTypeName()),
+		oneArgMethod:       newOneArgMethod("models", "[]"+ctx.s.TypeName),
+		constBodyMethod:    newConstBodyMethod("%s", body),
+	}
+	doc := `// CreateMany inserts models by multi-row inserts, conditions of queryset
+	// aren't used. Generated primary keys are written back to models if
+	// database supports it (all except MS SQL for gorm v1).`
+	if !ctx.backend.IsGormV2() {
+		doc += `
+	// Unlike gorm Create it doesn't call hooks and doesn't read back default
+	// values: zero fields having default values are skipped in insert.`
+	}
+	r.setDoc(doc + `
+	// nolint: dupl`)
+	return r
+}
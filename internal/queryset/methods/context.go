@@ -1,22 +1,51 @@
 package methods
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/jirfag/go-queryset/internal/parser"
 	"github.com/jirfag/go-queryset/internal/queryset/field"
 )
 
 type QsStructContext struct {
-	s parser.ParsedStruct
+	s        parser.ParsedStruct
+	backend  Backend
+	typeName string
+
+	// qualifyColumns is set if queryset can join other tables: own columns
+	// are qualified by table of model then to not be ambiguous
+	qualifyColumns bool
 }
 
-func NewQsStructContext(s parser.ParsedStruct) QsStructContext {
+// NewQsStructContext creates context of struct s, qsTypeName is
+// a name of its queryset type
+func NewQsStructContext(s parser.ParsedStruct, backend Backend, qsTypeName string) QsStructContext {
 	return QsStructContext{
-		s: s,
+		s:        s,
+		backend:  backend,
+		typeName: qsTypeName,
 	}
 }
 
+// WithQualifiedColumns returns ctx qualifying own columns of model by its table
+func (ctx QsStructContext) WithQualifiedColumns() QsStructContext {
+	ctx.qualifyColumns = true
+	return ctx
+}
+
 func (ctx QsStructContext) qsTypeName() string {
-	return ctx.s.TypeName + "QuerySet"
+	return ctx.typeName
+}
+
+// predicateTypeName is a name of predicate type of struct
+func (ctx QsStructContext) predicateTypeName() string {
+	return ctx.s.TypeName + "Predicate"
+}
+
+// predicatesTypeName is a name of type creating predicates by fields
+func (ctx QsStructContext) predicatesTypeName() string {
+	return ctx.s.TypeName + "Predicates"
 }
 
 func (ctx QsStructContext) FieldCtx(f field.Info) QsFieldContext {
@@ -42,6 +71,32 @@ func (ctx QsFieldContext) fieldDBName() string {
 	return ctx.f.DBName
 }
 
+// isQualified checks whether column of field should be qualified by table
+// of model: columns of joined tables are already qualified by join alias
+func (ctx QsFieldContext) isQualified() bool {
+	return ctx.qualifyColumns && !strings.Contains(ctx.fieldDBName(), ".")
+}
+
+// columnSQL returns Go expression of SQL string sql with %s replaced by
+// column of field: it's a string literal if column isn't qualified, else
+// the column is qualified in runtime by column method of queryset
+func (ctx QsFieldContext) columnSQL(sql string) string {
+	if !ctx.isQualified() {
+		return `"` + strings.Replace(sql, "%s", ctx.fieldDBName(), -1) + `"`
+	}
+
+	var parts []string
+	for i, s := range strings.Split(sql, "%s") {
+		if i != 0 {
+			parts = append(parts, fmt.Sprintf(`qs.column("%s")`, ctx.fieldDBName()))
+		}
+		if s != "" {
+			parts = append(parts, `"`+s+`"`)
+		}
+	}
+	return strings.Join(parts, " + ")
+}
+
 func (ctx QsFieldContext) fieldTypeName() string {
 	return ctx.f.TypeName
 }
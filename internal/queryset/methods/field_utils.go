@@ -1,6 +1,10 @@
 package methods
 
-import "go/token"
+import (
+	"go/token"
+	"strings"
+	"unicode"
+)
 
 // commonInitialisms is a set of common initialisms.
 // Only add entries that are highly unlikely to be non-initialisms.
@@ -58,3 +62,21 @@ func fieldNameToArgName(fieldName string) string {
 	}
 	return argName
 }
+
+// SubqueryTypeName returns name of type of subquery selecting single column
+// of type fieldTypeName, e.g. UintSubquery or TmpStringDefSubquery
+func SubqueryTypeName(fieldTypeName string) string {
+	isNotIdentRune := func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_'
+	}
+
+	name := ""
+	for _, part := range strings.FieldsFunc(fieldTypeName, isNotIdentRune) {
+		name += strings.Title(part)
+	}
+	if strings.HasPrefix(fieldTypeName, "[]") {
+		name += "Slice"
+	}
+
+	return name + "Subquery"
+}
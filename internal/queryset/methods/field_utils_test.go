@@ -21,3 +21,16 @@ func TestFieldNameToArgName(t *testing.T) {
 		assert.Equal(t, c.out, fieldNameToArgName(c.in))
 	}
 }
+
+func TestSubqueryTypeName(t *testing.T) {
+	t.Parallel()
+	cases := []struct{ in, out string }{
+		{"uint", "UintSubquery"},
+		{"tmp.StringDef", "TmpStringDefSubquery"},
+		{"[]byte", "ByteSliceSubquery"},
+	}
+
+	for _, c := range cases {
+		assert.Equal(t, c.out, SubqueryTypeName(c.in))
+	}
+}
@@ -0,0 +1,72 @@
+package methods
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NewGroupByMethod creates GroupBy<Field> method
+func NewGroupByMethod(ctx QsFieldContext) FieldOperationNoArgsMethod {
+	r := newFieldOperationNoArgsMethod(ctx.WithOperationName("GroupBy"), true)
+	r.setGormMethodName("Group")
+	r.setGormMethodArgs(ctx.columnSQL("%s"))
+	return r
+}
+
+// HavingCountMethod filters groups by count of rows in them
+type HavingCountMethod struct {
+	namedMethod
+	chainedQuerySetMethod
+	oneArgMethod
+	qsCallGormMethod
+}
+
+// NewHavingCountMethod creates HavingCount<Op> method, op is a name
+// of filter operation: eq, lt, etc
+func NewHavingCountMethod(qsTypeName, op string) HavingCountMethod {
+	return HavingCountMethod{
+		namedMethod:           newNamedMethod("HavingCount" + strings.Title(op)),
+		chainedQuerySetMethod: newChainedQuerySetMethod(qsTypeName),
+		oneArgMethod:          newOneArgMethod("count", "int"),
+		qsCallGormMethod: newQsCallGormMethod("Having", `"COUNT(*) %s", count`,
+			getWhereCondition(op)),
+	}
+}
+
+// CountByMethod creates CountBy<Field> method: it returns count of rows
+// for every value of field
+type CountByMethod struct {
+	onFieldMethod
+	noArgsMethod
+	baseQuerySetMethod
+	constRetMethod
+	constBodyMethod
+}
+
+// NewCountByMethod creates CountByMethod
+func NewCountByMethod(ctx QsFieldContext) CountByMethod {
+	ctx = ctx.WithOperationName("CountBy")
+	retTypeName := fmt.Sprintf("map[%s]int", ctx.fieldTypeName())
+	body := fmt.Sprintf(`var rows []struct {
+			Value %s
+			Count int
+		}
+		err := %s.Select(%s).Group(%s).Scan(&rows).Error
+		ret := make(%s, len(rows))
+		for _, r := range rows {
+			ret[r.Value] = r.Count
+		}
+		return ret, err`, ctx.fieldTypeName(), ctx.backend.queryDBExpr(qsDbName),
+		ctx.columnSQL("%s AS value, COUNT(*) AS count"), ctx.columnSQL("%s"), retTypeName)
+
+	r := CountByMethod{
+		onFieldMethod:      ctx.onFieldMethod(),
+		baseQuerySetMethod: newBaseQuerySetMethod(ctx.qsTypeName()),
+		constRetMethod:     newConstRetMethod(fmt.Sprintf("(%s, error)", retTypeName)),
+		constBodyMethod:    newConstBodyMethod("%s", body),
+	}
+	r.setFieldNameFirst(false) // NameCountBy -> CountByName
+	r.setDoc(fmt.Sprintf(`// %s returns count of rows for every value of %s
+	// nolint: dupl`, r.GetMethodName(), ctx.fieldName()))
+	return r
+}
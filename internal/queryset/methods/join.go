@@ -0,0 +1,141 @@
+package methods
+
+import (
+	"fmt"
+	"strings"
+)
+
+// JoinSpec describes how to join table of associated struct
+type JoinSpec struct {
+	FieldName     string // name of association field, e.g. Blog
+	AssocTypeName string // name of associated struct type
+	Alias         string // alias of joined table, joined columns are qualified by it
+
+	ModelColumn          string // column of model table in join condition
+	AssocColumn          string // column of associated table in join condition
+	AssocDeletedAtColumn string // soft delete column of associated table, if any
+}
+
+func (s JoinSpec) getCondition() string {
+	cond := fmt.Sprintf("%s.%s = %%s.%s", s.Alias, s.AssocColumn, s.ModelColumn)
+	if s.AssocDeletedAtColumn != "" {
+		cond += fmt.Sprintf(" AND %s.%s IS NULL", s.Alias, s.AssocDeletedAtColumn)
+	}
+	return cond
+}
+
+// JoinMethod joins table of associated struct
+type JoinMethod struct {
+	onFieldMethod
+	noArgsMethod
+	chainedQuerySetMethod
+	constBodyMethod
+}
+
+func newJoinMethod(ctx QsStructContext, spec JoinSpec, joinType, sqlJoin string) JoinMethod {
+	joinSQL := fmt.Sprintf("%s %%s %s ON %s", sqlJoin, spec.Alias, spec.getCondition())
+	joinTmpl := `%s.Joins(fmt.Sprintf("%s",
+		qs.quotedTableName(&%s{}), qs.quotedTableName(&%s{})))`
+
+	body := wrapToGormScope(fmt.Sprintf(joinTmpl, qsDbName, joinSQL, spec.AssocTypeName, ctx.s.TypeName))
+	if ctx.backend.IsGormV2() {
+		body = strings.Join([]string{
+			"db := qs.db",
+			"if len(db.Statement.Selects) == 0 {",
+			"// don't let joined columns overwrite model columns with the same names",
+			fmt.Sprintf(`db = db.Select(qs.quotedTableName(&%s{}) + ".*")`, ctx.s.TypeName),
+			"}",
+			wrapToGormScope(fmt.Sprintf(joinTmpl, "db", joinSQL, spec.AssocTypeName, ctx.s.TypeName)),
+		}, "\n")
+	}
+
+	r := JoinMethod{
+		onFieldMethod:         newOnFieldMethod(joinType, spec.FieldName),
+		chainedQuerySetMethod: newChainedQuerySetMethod(ctx.qsTypeName()),
+		constBodyMethod:       newConstBodyMethod("%s", body),
+	}
+	r.setFieldNameFirst(false) // BlogJoin -> JoinBlog
+	r.setDoc(fmt.Sprintf(`// %s joins table of %s by alias %s:
+	// it's needed for filtering by %s fields
+	// nolint: dupl`, r.GetMethodName(), spec.FieldName, spec.Alias, spec.FieldName))
+	return r
+}
+
+// NewJoinMethod creates Join<Field> method making inner join
+func NewJoinMethod(ctx QsStructContext, spec JoinSpec) JoinMethod {
+	return newJoinMethod(ctx, spec, "Join", "JOIN")
+}
+
+// NewLeftJoinMethod creates LeftJoin<Field> method making left join
+func NewLeftJoinMethod(ctx QsStructContext, spec JoinSpec) JoinMethod {
+	return newJoinMethod(ctx, spec, "LeftJoin", "LEFT JOIN")
+}
+
+// QuotedTableNameMethod creates unexported quotedTableName method:
+// table names are obtained from gorm in runtime
+type QuotedTableNameMethod struct {
+	namedMethod
+	baseQuerySetMethod
+	oneArgMethod
+	constRetMethod
+	constBodyMethod
+}
+
+// NewQuotedTableNameMethod creates QuotedTableNameMethod
+func NewQuotedTableNameMethod(ctx QsStructContext) QuotedTableNameMethod {
+	body := "return qs.db.New().NewScope(model).QuotedTableName()"
+	if ctx.backend.IsGormV2() {
+		body = `stmt := &gorm.Statement{DB: qs.db}
+		if err := stmt.Parse(model); err != nil {
+			qs.db.AddError(err)
+			return ""
+		}
+		return qs.db.Statement.Quote(stmt.Schema.Table)`
+	}
+
+	r := QuotedTableNameMethod{
+		namedMethod:        newNamedMethod("quotedTableName"),
+		baseQuerySetMethod: newBaseQuerySetMethod(ctx.qsTypeName()),
+		oneArgMethod:       newOneArgMethod("model", "interface{}"),
+		constRetMethod:     newConstRetMethod("string"),
+		constBodyMethod:    newConstBodyMethod("%s", body),
+	}
+	r.setDoc(`// quotedTableName returns quoted name of model's table
+	// nolint: dupl`)
+	return r
+}
+
+// columnPrefixExpr returns Go expression of prefix qualifying own columns
+// of model in SQL: it's empty if columns aren't qualified
+func (ctx QsStructContext) columnPrefixExpr() string {
+	if !ctx.qualifyColumns {
+		return `""`
+	}
+
+	return fmt.Sprintf(`qs.quotedTableName(&%s{}) + "."`, ctx.s.TypeName)
+}
+
+// ColumnMethod creates unexported column method qualifying own column
+// of model by its table: it's needed to not make columns ambiguous
+// after joins
+type ColumnMethod struct {
+	namedMethod
+	baseQuerySetMethod
+	oneArgMethod
+	constRetMethod
+	constBodyMethod
+}
+
+// NewColumnMethod creates ColumnMethod
+func NewColumnMethod(ctx QsStructContext) ColumnMethod {
+	r := ColumnMethod{
+		namedMethod:        newNamedMethod("column"),
+		baseQuerySetMethod: newBaseQuerySetMethod(ctx.qsTypeName()),
+		oneArgMethod:       newOneArgMethod("name", "string"),
+		constRetMethod:     newConstRetMethod("string"),
+		constBodyMethod:    newConstBodyMethod("return %s + name", ctx.columnPrefixExpr()),
+	}
+	r.setDoc(`// column returns column name qualified by quoted table of model
+	// nolint: dupl`)
+	return r
+}
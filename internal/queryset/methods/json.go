@@ -0,0 +1,30 @@
+package methods
+
+import "fmt"
+
+// JSONEqMethod filters JSON field by value at path
+type JSONEqMethod struct {
+	onFieldMethod
+	nArgsMethod
+	chainedQuerySetMethod
+	constBodyMethod
+}
+
+// NewJSONEqMethod creates <Field>JSONEq method
+func NewJSONEqMethod(ctx QsFieldContext) JSONEqMethod {
+	ctx = ctx.WithOperationName("JSONEq")
+	r := JSONEqMethod{
+		onFieldMethod: ctx.onFieldMethod(),
+		nArgsMethod: newNArgsMethod(
+			newOneArgMethod("path", "string"),
+			newOneArgMethod("value", "string"),
+		),
+		chainedQuerySetMethod: ctx.chainedQuerySetMethod(),
+		constBodyMethod: newConstBodyMethod(`return qs.w(whereJSONEq(%s, %s, path, value))`,
+			qsDbName, ctx.columnSQL("%s")),
+	}
+	r.setDoc(fmt.Sprintf(`// %s filters rows with text value of %s at path equal to value,
+	// path is a dot-separated list of keys, e.g. "address.city"
+	// nolint: dupl`, r.GetMethodName(), ctx.fieldName()))
+	return r
+}
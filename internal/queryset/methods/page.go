@@ -0,0 +1,130 @@
+package methods
+
+import (
+	"fmt"
+)
+
+// OrderByMethod orders by field and remembers the order:
+// it's used by keyset pagination
+type OrderByMethod struct {
+	FieldOperationNoArgsMethod
+	dbName   string
+	desc     bool
+	nullable bool
+}
+
+// GetBody returns body of method
+func (m OrderByMethod) GetBody() string {
+	return wrapToGormScope(fmt.Sprintf(`addPageOrder(%s, "%s", %t, %t)`,
+		m.callGormMethod.GetBody(), m.dbName, m.desc, m.nullable))
+}
+
+func newOrderByMethod(ctx QsFieldContext, operationName, sqlOrder string, desc bool) OrderByMethod {
+	r := newFieldOperationNoArgsMethod(ctx.WithOperationName(operationName), true)
+	r.setGormMethodName("Order")
+	r.setGormMethodArgs(ctx.columnSQL("%s " + sqlOrder))
+	return OrderByMethod{
+		FieldOperationNoArgsMethod: r,
+		dbName:                     ctx.fieldDBName(),
+		desc:                       desc,
+		nullable:                   ctx.f.IsNullable(),
+	}
+}
+
+// PageMethod creates Page method making keyset pagination
+type PageMethod struct {
+	namedMethod
+	baseQuerySetMethod
+	constRetMethod
+	constBodyMethod
+	nArgsMethod
+}
+
+// NewPageMethod creates PageMethod, pkDBName is a column of primary key:
+// rows are ordered by it after all orders of queryset
+func NewPageMethod(ctx QsStructContext, pkDBName string) PageMethod {
+	body := fmt.Sprintf(`if size <= 0 {
+			return "", errors.New("page size must be positive")
+		}
+
+		var last %s
+		db, orders, err := pageQuery(qs.db, %s, "%s", cursor, qs.fieldPtrs(&last))
+		if err != nil {
+			return "", err
+		}
+
+		if err = %s.Limit(size + 1).Find(ret).Error; err != nil {
+			return "", err
+		}
+		if len(*ret) <= size {
+			return "", nil
+		}
+
+		*ret = (*ret)[:size]
+		return encodePageCursor(orders, qs.fieldPtrs(&(*ret)[size-1]))`,
+		ctx.s.TypeName, ctx.columnPrefixExpr(), pkDBName, ctx.backend.queryDBExpr("db"))
+
+	r := PageMethod{
+		namedMethod:        newNamedMethod("Page"),
+		baseQuerySetMethod: newBaseQuerySetMethod(ctx.qsTypeName()),
+		nArgsMethod: newNArgsMethod(
+			newOneArgMethod("cursor", "string"),
+			newOneArgMethod("size", "int"),
+			newOneArgMethod("ret", "*[]"+ctx.s.TypeName),
+		),
+		constRetMethod:  newConstRetMethod("(string, error)"),
+		constBodyMethod: newConstBodyMethod("%s", body),
+	}
+	r.setDoc(`// Page fetches at most size rows following cursor into ret, it uses
+	// orders set by OrderAscBy/OrderDescBy methods and primary key to break ties.
+	// Ordered fields can't be nullable: rows with NULL can't be compared with
+	// cursor, so error is returned for them. Empty cursor means the first page. It returns cursor of the next page or
+	// empty string if there are no more rows.
+	// nolint: dupl`)
+	return r
+}
+
+// BatchesMethod creates Batches method
+type BatchesMethod struct {
+	namedMethod
+	baseQuerySetMethod
+	nArgsMethod
+	errorRetMethod
+	constBodyMethod
+}
+
+// NewBatchesMethod creates BatchesMethod: it walks queryset by pages
+func NewBatchesMethod(ctx QsStructContext) BatchesMethod {
+	r := BatchesMethod{
+		namedMethod:        newNamedMethod("Batches"),
+		baseQuerySetMethod: newBaseQuerySetMethod(ctx.qsTypeName()),
+		nArgsMethod: newNArgsMethod(
+			newOneArgMethod("size", "int"),
+			newOneArgMethod("fn", fmt.Sprintf("func([]%s) error", ctx.s.TypeName)),
+		),
+		constBodyMethod: newConstBodyMethod(`cursor := ""
+			for {
+				var batch []%s
+				next, err := qs.Page(cursor, size, &batch)
+				if err != nil {
+					return err
+				}
+
+				if len(batch) != 0 {
+					if err = fn(batch); err != nil {
+						return err
+					}
+				}
+
+				if next == "" {
+					return nil
+				}
+				cursor = next
+			}`, ctx.s.TypeName),
+	}
+	r.setDoc(`// Batches calls fn for every batch of at most size rows, batches are
+	// fetched by Page: ordered by primary key after queryset orders.
+	// It stops on the first error of fn and returns it.
+	// nolint: dupl`)
+	return r
+}
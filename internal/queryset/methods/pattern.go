@@ -0,0 +1,63 @@
+package methods
+
+import "fmt"
+
+// PatternFilterMethod filters string field by pattern made of escaped argument:
+// wildcards in argument match only themselves
+type PatternFilterMethod struct {
+	onFieldMethod
+	oneArgMethod
+	chainedQuerySetMethod
+	constBodyMethod
+}
+
+func newPatternFilterMethod(ctx QsFieldContext, operationName, prefix, suffix string,
+	caseInsensitive bool) PatternFilterMethod {
+
+	ctx = ctx.WithOperationName(operationName)
+	argName := fieldNameToArgName(ctx.fieldName())
+	value := argName
+	if ctx.fieldTypeName() != "string" {
+		value = fmt.Sprintf("string(%s)", argName) // named string type
+	}
+
+	return PatternFilterMethod{
+		onFieldMethod:         ctx.onFieldMethod(),
+		oneArgMethod:          newOneArgMethod(argName, ctx.fieldTypeName()),
+		chainedQuerySetMethod: ctx.chainedQuerySetMethod(),
+		constBodyMethod: newConstBodyMethod(`return qs.w(whereLike(%s, %s, "%s", %s, "%s", %t))`,
+			qsDbName, ctx.columnSQL("%s"), prefix, value, suffix, caseInsensitive),
+	}
+}
+
+// NewStartsWithMethod creates <Field>StartsWith method
+func NewStartsWithMethod(ctx QsFieldContext) PatternFilterMethod {
+	r := newPatternFilterMethod(ctx, "StartsWith", "", "%", false)
+	r.setDoc(fmt.Sprintf(`// %s filters rows with %s starting with the argument
+	// nolint: dupl`, r.GetMethodName(), ctx.fieldName()))
+	return r
+}
+
+// NewEndsWithMethod creates <Field>EndsWith method
+func NewEndsWithMethod(ctx QsFieldContext) PatternFilterMethod {
+	r := newPatternFilterMethod(ctx, "EndsWith", "%", "", false)
+	r.setDoc(fmt.Sprintf(`// %s filters rows with %s ending with the argument
+	// nolint: dupl`, r.GetMethodName(), ctx.fieldName()))
+	return r
+}
+
+// NewIContainsMethod creates <Field>IContains method
+func NewIContainsMethod(ctx QsFieldContext) PatternFilterMethod {
+	r := newPatternFilterMethod(ctx, "IContains", "%", "%", true)
+	r.setDoc(fmt.Sprintf(`// %s filters rows with %s containing the argument, case is ignored
+	// nolint: dupl`, r.GetMethodName(), ctx.fieldName()))
+	return r
+}
+
+// NewIEqMethod creates <Field>IEq method
+func NewIEqMethod(ctx QsFieldContext) PatternFilterMethod {
+	r := newPatternFilterMethod(ctx, "IEq", "", "", true)
+	r.setDoc(fmt.Sprintf(`// %s filters rows with %s equal to the argument, case is ignored
+	// nolint: dupl`, r.GetMethodName(), ctx.fieldName()))
+	return r
+}
@@ -0,0 +1,98 @@
+package methods
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PredicateTablePlaceholder is replaced by quoted table of model in
+// predicate expressions by Where method of queryset: own columns are
+// qualified by it if queryset can join other tables
+const PredicateTablePlaceholder = "{table}"
+
+// PredicateMethod creates predicate by condition on field: it's a method
+// of <Struct>Predicates type, predicates are passed to Where and Not
+// methods of queryset
+type PredicateMethod struct {
+	onFieldMethod
+	*receiverMethod
+	nArgsMethod
+	constRetMethod
+	constBodyMethod
+}
+
+// predicateSQL returns SQL expression of predicate: sql with %s replaced by
+// column of field
+func (ctx QsFieldContext) predicateSQL(sql string) string {
+	column := ctx.fieldDBName()
+	if ctx.isQualified() {
+		column = PredicateTablePlaceholder + "." + column
+	}
+	return strings.Replace(sql, "%s", column, -1)
+}
+
+func newPredicateMethod(ctx QsFieldContext, cond, body string, args ...oneArgMethod) PredicateMethod {
+	r := PredicateMethod{
+		onFieldMethod:   ctx.onFieldMethod(),
+		receiverMethod:  newReceiverMethod(ctx.predicatesTypeName()),
+		nArgsMethod:     newNArgsMethod(args...),
+		constRetMethod:  newConstRetMethod(ctx.predicateTypeName()),
+		constBodyMethod: newConstBodyMethod("%s", body),
+	}
+	r.setDoc(fmt.Sprintf(`// %s creates predicate "%s"
+	// nolint: dupl`, r.GetMethodName(), cond))
+	return r
+}
+
+// NewBinaryPredicateMethod creates predicate comparing field with argument,
+// operations are the same as for BinaryFilterMethod
+func NewBinaryPredicateMethod(ctx QsFieldContext) PredicateMethod {
+	argName := fieldNameToArgName(ctx.fieldName())
+	sql := "%s " + getWhereCondition(ctx.operationName)
+	cond := fmt.Sprintf(sql, ctx.fieldDBName())
+	body := fmt.Sprintf(`return %s{expr: "%s", args: []interface{}{%s}}`,
+		ctx.predicateTypeName(), ctx.predicateSQL(sql), argName)
+	return newPredicateMethod(ctx, cond, body, newOneArgMethod(argName, ctx.fieldTypeName()))
+}
+
+func newInPredicateMethod(ctx QsFieldContext, operationName, sql string) PredicateMethod {
+	ctx = ctx.WithOperationName(operationName)
+	argName := fieldNameToArgName(ctx.fieldName())
+	sql = "%s " + sql + " (?)"
+	cond := fmt.Sprintf(sql, ctx.fieldDBName())
+	body := fmt.Sprintf(`if len(%s) == 0 {
+		return %s{err: errors.New("must at least pass one %s in %s")}
+	}
+	return %s{expr: "%s", args: []interface{}{%s}}`,
+		argName, ctx.predicateTypeName(), argName, ctx.onFieldMethod().GetMethodName(),
+		ctx.predicateTypeName(), ctx.predicateSQL(sql), argName)
+	return newPredicateMethod(ctx, cond, body, newOneArgMethod(argName, "..."+ctx.fieldTypeName()))
+}
+
+// NewInPredicateMethod creates IN predicate
+func NewInPredicateMethod(ctx QsFieldContext) PredicateMethod {
+	return newInPredicateMethod(ctx, "In", "IN")
+}
+
+// NewNotInPredicateMethod creates NOT IN predicate
+func NewNotInPredicateMethod(ctx QsFieldContext) PredicateMethod {
+	return newInPredicateMethod(ctx, "NotIn", "NOT IN")
+}
+
+func newUnaryPredicateMethod(ctx QsFieldContext, operationName, sql string) PredicateMethod {
+	ctx = ctx.WithOperationName(operationName)
+	sql = "%s " + sql
+	cond := fmt.Sprintf(sql, ctx.fieldDBName())
+	return newPredicateMethod(ctx, cond, fmt.Sprintf(`return %s{expr: "%s"}`,
+		ctx.predicateTypeName(), ctx.predicateSQL(sql)))
+}
+
+// NewIsNullPredicateMethod creates IS NULL predicate
+func NewIsNullPredicateMethod(ctx QsFieldContext) PredicateMethod {
+	return newUnaryPredicateMethod(ctx, "IsNull", "IS NULL")
+}
+
+// NewIsNotNullPredicateMethod creates IS NOT NULL predicate
+func NewIsNotNullPredicateMethod(ctx QsFieldContext) PredicateMethod {
+	return newUnaryPredicateMethod(ctx, "IsNotNull", "IS NOT NULL")
+}
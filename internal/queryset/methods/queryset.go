@@ -147,8 +147,8 @@ func NewBinaryFilterMethod(ctx QsFieldContext) BinaryFilterMethod {
 		onFieldMethod:         ctx.onFieldMethod(),
 		oneArgMethod:          newOneArgMethod(argName, ctx.fieldTypeName()),
 		chainedQuerySetMethod: ctx.chainedQuerySetMethod(),
-		qsCallGormMethod: newQsCallGormMethod("Where", "\"%s %s\", %s",
-			ctx.fieldDBName(), getWhereCondition(ctx.operationName), argName),
+		qsCallGormMethod: newQsCallGormMethod("Where", "%s, %s",
+			ctx.columnSQL("%s "+getWhereCondition(ctx.operationName)), argName),
 	}
 }
 
@@ -179,8 +179,8 @@ func newInFilterMethodImpl(ctx QsFieldContext, operationName, sql string) InFilt
 		onFieldMethod:         ctx.onFieldMethod(),
 		nArgsMethod:           args,
 		chainedQuerySetMethod: ctx.chainedQuerySetMethod(),
-		qsCallGormMethod: newQsCallGormMethod("Where", "\"%s %s (?)\", %s",
-			ctx.fieldDBName(), sql, argName),
+		qsCallGormMethod: newQsCallGormMethod("Where", "%s, %s",
+			ctx.columnSQL("%s "+sql+" (?)"), argName),
 	}
 }
 
@@ -194,6 +194,42 @@ func NewNotInFilterMethod(ctx QsFieldContext) InFilterMethod {
 	return newInFilterMethodImpl(ctx, "NotIn", "NOT IN")
 }
 
+// InQueryFilterMethod filters with IN condition by subquery selecting
+// single column of the same type as field
+type InQueryFilterMethod struct {
+	chainedQuerySetMethod
+	onFieldMethod
+	oneArgMethod
+	qsCallGormMethod
+	subqueryMethod
+}
+
+func newInQueryFilterMethodImpl(ctx QsFieldContext, operationName, sql string) InQueryFilterMethod {
+	ctx = ctx.WithOperationName(operationName)
+	subqueryTypeName := SubqueryTypeName(ctx.fieldTypeName())
+	r := InQueryFilterMethod{
+		onFieldMethod:         ctx.onFieldMethod(),
+		oneArgMethod:          newOneArgMethod("q", subqueryTypeName),
+		chainedQuerySetMethod: ctx.chainedQuerySetMethod(),
+		qsCallGormMethod: newQsCallGormMethod("Where", "%s, q.expr()",
+			ctx.columnSQL("%s "+sql+" (?)")),
+		subqueryMethod: newSubqueryMethod(subqueryTypeName),
+	}
+	r.setDoc(fmt.Sprintf(`// %s filters rows with %s %s values selected by subquery q
+	// nolint: dupl`, r.GetMethodName(), ctx.fieldName(), sql))
+	return r
+}
+
+// NewInQueryFilterMethod create new IN filter method by subquery
+func NewInQueryFilterMethod(ctx QsFieldContext) InQueryFilterMethod {
+	return newInQueryFilterMethodImpl(ctx, "InQuery", "IN")
+}
+
+// NewNotInQueryFilterMethod create new NOT IN filter method by subquery
+func NewNotInQueryFilterMethod(ctx QsFieldContext) InQueryFilterMethod {
+	return newInQueryFilterMethodImpl(ctx, "NotInQuery", "NOT IN")
+}
+
 func getWhereCondition(name string) string {
 	nameToOp := map[string]string{
 		"eq":      "=",
@@ -204,6 +240,10 @@ func getWhereCondition(name string) string {
 		"gte":     ">=",
 		"like":    "LIKE",
 		"notlike": "NOT LIKE",
+
+		// PostgreSQL arrays
+		"contains": "@>",
+		"overlaps": "&&",
 	}
 	op := nameToOp[name]
 	if op == "" {
@@ -224,8 +264,8 @@ type UnaryFilterMethod struct {
 func newUnaryFilterMethod(ctx QsFieldContext, op string) UnaryFilterMethod {
 	r := UnaryFilterMethod{
 		onFieldMethod: ctx.onFieldMethod(),
-		qsCallGormMethod: newQsCallGormMethod("Where", `"%s %s"`,
-			ctx.fieldDBName(), op),
+		qsCallGormMethod: newQsCallGormMethod("Where", "%s",
+			ctx.columnSQL("%s "+op)),
 		chainedQuerySetMethod: ctx.chainedQuerySetMethod(),
 	}
 	return r
@@ -241,12 +281,12 @@ type SelectMethod struct {
 	gormErroredMethod
 }
 
-func newSelectMethod(name, gormName, argTypeName, qsTypeName string) SelectMethod {
+func newSelectMethod(name, gormName, argTypeName, qsTypeName string, backend Backend) SelectMethod {
 	return SelectMethod{
 		namedMethod:        newNamedMethod(name),
 		baseQuerySetMethod: newBaseQuerySetMethod(qsTypeName),
 		oneArgMethod:       newOneArgMethod("ret", argTypeName),
-		gormErroredMethod:  newGormErroredMethod(gormName, "ret", qsDbName),
+		gormErroredMethod:  newGormErroredMethod(gormName, "ret", backend.queryDBExpr(qsDbName)),
 	}
 }
 
@@ -280,12 +320,13 @@ type DeleteMethod struct {
 }
 
 // NewDeleteMethod creates Delete method
-func NewDeleteMethod(qsTypeName, structTypeName string) DeleteMethod {
+func NewDeleteMethod(qsTypeName, structTypeName string, backend Backend) DeleteMethod {
 	return DeleteMethod{
 
 		namedMethod:        newNamedMethod("Delete"),
 		baseQuerySetMethod: newBaseQuerySetMethod(qsTypeName),
-		gormErroredMethod:  newGormErroredMethod("Delete", structTypeName+"{}", qsDbName),
+		gormErroredMethod: newGormErroredMethod("Delete", backend.emptyModelExpr(structTypeName),
+			backend.queryDBExpr(qsDbName)),
 	}
 }
 
@@ -300,14 +341,14 @@ type DeleteNumMethod struct {
 }
 
 // NewDeleteNumMethod delete row count
-func NewDeleteNumMethod(qsTypeName, structTypeName string) DeleteNumMethod {
+func NewDeleteNumMethod(qsTypeName, structTypeName string, backend Backend) DeleteNumMethod {
 	return DeleteNumMethod{
 		namedMethod:        newNamedMethod("DeleteNum"),
 		baseQuerySetMethod: newBaseQuerySetMethod(qsTypeName),
 		constRetMethod:     newConstRetMethod("(int64, error)"),
 		constBodyMethod: newConstBodyMethod(
 			strings.Join([]string{
-				"db := qs.db.Delete(" + structTypeName + "{}" + ")",
+				"db := " + backend.queryDBExpr(qsDbName) + ".Delete(" + backend.emptyModelExpr(structTypeName) + ")",
 				"return db.RowsAffected, db.Error",
 			}, "\n"),
 		),
@@ -325,14 +366,14 @@ type DeleteNumUnscopedMethod struct {
 }
 
 // NewDeleteNumUnscopedMethod delete row count for hard deletes
-func NewDeleteNumUnscopedMethod(qsTypeName, structTypeName string) DeleteNumUnscopedMethod {
+func NewDeleteNumUnscopedMethod(qsTypeName, structTypeName string, backend Backend) DeleteNumUnscopedMethod {
 	return DeleteNumUnscopedMethod{
 		namedMethod:        newNamedMethod("DeleteNumUnscoped"),
 		baseQuerySetMethod: newBaseQuerySetMethod(qsTypeName),
 		constRetMethod:     newConstRetMethod("(int64, error)"),
 		constBodyMethod: newConstBodyMethod(
 			strings.Join([]string{
-				"db := qs.db.Unscoped().Delete(" + structTypeName + "{}" + ")",
+				"db := " + backend.queryDBExpr(qsDbName) + ".Unscoped().Delete(" + backend.emptyModelExpr(structTypeName) + ")",
 				"return db.RowsAffected, db.Error",
 			}, "\n"),
 		),
@@ -349,39 +390,89 @@ type CountMethod struct {
 }
 
 // NewCountMethod returns new CountMethod
-func NewCountMethod(qsTypeName string) CountMethod {
+func NewCountMethod(qsTypeName string, backend Backend) CountMethod {
+	countTypeName := backend.CountTypeName()
+	body := fmt.Sprintf(`var count %s
+		err := %s.Count(&count).Error
+		return count, err`, countTypeName, qsDbName)
+	if !backend.IsGormV2() {
+		// gorm v1 executes count query even if db has an error
+		body = fmt.Sprintf(`var count %s
+			db := %s
+			if db.Error != nil {
+				return count, db.Error
+			}
+
+			err := db.Count(&count).Error
+			return count, err`, countTypeName, backend.queryDBExpr(qsDbName))
+	}
+
 	return CountMethod{
 		baseQuerySetMethod: newBaseQuerySetMethod(qsTypeName),
 		namedMethod:        newNamedMethod("Count"),
-		constRetMethod:     newConstRetMethod("(int, error)"),
-		constBodyMethod: newConstBodyMethod(`var count int
-			err := %s.Count(&count).Error
-			return count, err`, qsDbName),
+		constRetMethod:     newConstRetMethod(fmt.Sprintf("(%s, error)", countTypeName)),
+		constBodyMethod:    newConstBodyMethod("%s", body),
 	}
 }
 
 // Concrete methods
 
+// PreloadMethod preloads association
+type PreloadMethod struct {
+	onFieldMethod
+	noArgsMethod
+	chainedQuerySetMethod
+	constBodyMethod
+}
+
 // NewPreloadMethod creates new Preload method
-func NewPreloadMethod(ctx QsFieldContext) FieldOperationNoArgsMethod {
-	r := newFieldOperationNoArgsMethod(ctx.WithOperationName("Preload"), false)
+func NewPreloadMethod(ctx QsFieldContext) PreloadMethod {
+	ctx = ctx.WithOperationName("Preload")
+	r := PreloadMethod{
+		onFieldMethod:         ctx.onFieldMethod(),
+		chainedQuerySetMethod: ctx.chainedQuerySetMethod(),
+		constBodyMethod: newConstBodyMethod("%s",
+			wrapToGormScope(ctx.backend.preloadExpr(qsDbName, fmt.Sprintf("%q", ctx.fieldName())))),
+	}
+	r.setFieldNameFirst(false) // UserPreload -> PreloadUser
 	return r
 }
 
-// NewOrderAscByMethod creates new OrderBy method ascending
-func NewOrderAscByMethod(ctx QsFieldContext) FieldOperationNoArgsMethod {
-	r := newFieldOperationNoArgsMethod(ctx.WithOperationName("OrderAscBy"), true)
-	r.setGormMethodName("Order")
-	r.setGormMethodArgs(fmt.Sprintf(`"%s ASC"`, ctx.fieldDBName()))
+// PreloadWhereMethod preloads association with conditions set by
+// queryset of associated struct
+type PreloadWhereMethod struct {
+	namedMethod
+	oneArgMethod
+	chainedQuerySetMethod
+	constBodyMethod
+}
+
+// NewPreloadWhereMethod creates Preload<Field>Where method, assocQsTypeName
+// is a name of queryset type of associated struct
+func NewPreloadWhereMethod(ctx QsFieldContext, assocQsTypeName string) PreloadWhereMethod {
+	r := PreloadWhereMethod{
+		namedMethod:           newNamedMethod("Preload" + ctx.fieldName() + "Where"),
+		oneArgMethod:          newOneArgMethod("f", fmt.Sprintf("func(%s) %s", assocQsTypeName, assocQsTypeName)),
+		chainedQuerySetMethod: ctx.chainedQuerySetMethod(),
+		constBodyMethod: newConstBodyMethod("%s", wrapToGormScope(ctx.backend.preloadExpr(qsDbName,
+			fmt.Sprintf(`"%s", func(db *gorm.DB) *gorm.DB {
+				return f(New%s(db)).db
+			}`, ctx.fieldName(), assocQsTypeName)))),
+	}
+	r.setDoc(fmt.Sprintf(`// %s preloads %s filtered, ordered or limited by f:
+	// limit is applied to all preloaded objects, not to objects of every row
+	// nolint: dupl`, r.GetMethodName(), ctx.fieldName()))
 	return r
 }
 
+// NewOrderAscByMethod creates new OrderBy method ascending
+func NewOrderAscByMethod(ctx QsFieldContext) OrderByMethod {
+	return newOrderByMethod(ctx, "OrderAscBy", "ASC", false)
+}
+
 // NewOrderDescByMethod creates new OrderBy method descending
-func NewOrderDescByMethod(ctx QsFieldContext) FieldOperationNoArgsMethod {
-	r := newFieldOperationNoArgsMethod(ctx.WithOperationName("OrderDescBy"), true)
-	r.setGormMethodName("Order")
-	r.setGormMethodArgs(fmt.Sprintf(`"%s DESC"`, ctx.fieldDBName()))
-	return r
+func NewOrderDescByMethod(ctx QsFieldContext) OrderByMethod {
+	return newOrderByMethod(ctx, "OrderDescBy", "DESC", true)
 }
 
 // NewLimitMethod creates Limit method
@@ -395,19 +486,66 @@ func NewOffsetMethod(qsTypeName string) StructOperationOneArgMethod {
 }
 
 // NewAllMethod creates All method
-func NewAllMethod(structName, qsTypeName string) SelectMethod {
-	return newSelectMethod("All", "Find", fmt.Sprintf("*[]%s", structName), qsTypeName)
+func NewAllMethod(structName, qsTypeName string, backend Backend) SelectMethod {
+	return newSelectMethod("All", "Find", fmt.Sprintf("*[]%s", structName), qsTypeName, backend)
 }
 
 // NewOneMethod creates One method
-func NewOneMethod(structName, qsTypeName string) SelectMethod {
-	r := newSelectMethod("One", "First", fmt.Sprintf("*%s", structName), qsTypeName)
+func NewOneMethod(structName, qsTypeName string, backend Backend) SelectMethod {
+	r := newSelectMethod("One", "First", fmt.Sprintf("*%s", structName), qsTypeName, backend)
 	const doc = `// One is used to retrieve one result. It returns gorm.ErrRecordNotFound
 	// if nothing was fetched`
 	r.setDoc(doc)
 	return r
 }
 
+// IterateMethod creates Iterate method: it scans rows one by one
+type IterateMethod struct {
+	namedMethod
+	baseQuerySetMethod
+	oneArgMethod
+	errorRetMethod
+	constBodyMethod
+}
+
+// NewIterateMethod creates IterateMethod
+func NewIterateMethod(ctx QsStructContext) IterateMethod {
+	body := fmt.Sprintf(`db := %s
+		if db.Error != nil {
+			return db.Error // gorm v1 doesn't check it in Rows
+		}
+
+		rows, err := db.Rows()
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var o %s
+			if err = db.ScanRows(rows, &o); err != nil {
+				return err
+			}
+			if err = fn(&o); err != nil {
+				return err
+			}
+		}
+
+		return rows.Err()`, ctx.backend.queryDBExpr(qsDbName), ctx.s.TypeName)
+
+	r := IterateMethod{
+		namedMethod:        newNamedMethod("Iterate"),
+		baseQuerySetMethod: newBaseQuerySetMethod(ctx.qsTypeName()),
+		oneArgMethod:       newOneArgMethod("fn", fmt.Sprintf("func(*%s) error", ctx.s.TypeName)),
+		constBodyMethod:    newConstBodyMethod("%s", body),
+	}
+	r.setDoc(`// Iterate scans rows one by one and calls fn for every row without
+	// loading all rows into memory. It stops on the first error of fn and
+	// returns it. Preloads aren't applied.
+	// nolint: dupl`)
+	return r
+}
+
 // NewIsNullMethod create IsNull method
 func NewIsNullMethod(ctx QsFieldContext) UnaryFilterMethod {
 	return newUnaryFilterMethod(ctx.WithOperationName("IsNull"), "IS NULL")
@@ -0,0 +1,64 @@
+package methods
+
+import "fmt"
+
+// RangeFilterMethod filters by range of field values: Between or InRange
+type RangeFilterMethod struct {
+	chainedQuerySetMethod
+	onFieldMethod
+	nArgsMethod
+	qsCallGormMethod
+}
+
+func newRangeFilterMethod(ctx QsFieldContext, condExpr string) RangeFilterMethod {
+	return RangeFilterMethod{
+		onFieldMethod: ctx.onFieldMethod(),
+		nArgsMethod: newNArgsMethod(
+			newOneArgMethod("from", ctx.fieldTypeName()),
+			newOneArgMethod("to", ctx.fieldTypeName()),
+		),
+		chainedQuerySetMethod: ctx.chainedQuerySetMethod(),
+		qsCallGormMethod:      newQsCallGormMethod("Where", `%s, from, to`, condExpr),
+	}
+}
+
+// NewBetweenMethod creates <Field>Between method: range includes both ends
+func NewBetweenMethod(ctx QsFieldContext) RangeFilterMethod {
+	ctx = ctx.WithOperationName("Between")
+	r := newRangeFilterMethod(ctx, ctx.columnSQL("%s BETWEEN ? AND ?"))
+	r.setDoc(fmt.Sprintf(`// %s filters rows with %s in range [from, to]
+	// nolint: dupl`, r.GetMethodName(), ctx.fieldName()))
+	return r
+}
+
+// NewInRangeMethod creates <Field>InRange method: range is half-open
+func NewInRangeMethod(ctx QsFieldContext) RangeFilterMethod {
+	ctx = ctx.WithOperationName("InRange")
+	r := newRangeFilterMethod(ctx, ctx.columnSQL("%s >= ? AND %s < ?"))
+	r.setDoc(fmt.Sprintf(`// %s filters rows with %s in range [from, to)
+	// nolint: dupl`, r.GetMethodName(), ctx.fieldName()))
+	return r
+}
+
+// WithinMethod filters time field by duration till now
+type WithinMethod struct {
+	chainedQuerySetMethod
+	onFieldMethod
+	oneArgMethod
+	qsCallGormMethod
+}
+
+// NewWithinMethod creates <Field>Within method
+func NewWithinMethod(ctx QsFieldContext) WithinMethod {
+	ctx = ctx.WithOperationName("Within")
+	r := WithinMethod{
+		onFieldMethod:         ctx.onFieldMethod(),
+		oneArgMethod:          newOneArgMethod("d", "time.Duration"),
+		chainedQuerySetMethod: ctx.chainedQuerySetMethod(),
+		qsCallGormMethod: newQsCallGormMethod("Where", `%s, time.Now().Add(-d)`,
+			ctx.columnSQL("%s "+getWhereCondition("gte"))),
+	}
+	r.setDoc(fmt.Sprintf(`// %s filters rows with %s not earlier than d ago
+	// nolint: dupl`, r.GetMethodName(), ctx.fieldName()))
+	return r
+}
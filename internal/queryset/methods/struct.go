@@ -9,12 +9,32 @@ type StructModifierMethod struct {
 }
 
 // NewStructModifierMethod create StructModifierMethod method
-func NewStructModifierMethod(name, structTypeName string) StructModifierMethod {
+func NewStructModifierMethod(name, structTypeName string, backend Backend) StructModifierMethod {
 	r := StructModifierMethod{
 		namedMethod:       newNamedMethod(name),
 		dbArgMethod:       newDbArgMethod(),
 		structMethod:      newStructMethod("o", "*"+structTypeName),
-		gormErroredMethod: newGormErroredMethod(name, "o", "db"),
+		gormErroredMethod: newGormErroredMethod(name, "o", backend.queryDBExpr("db")),
 	}
 	return r
 }
+
+// ValidatedMethod calls validation before body of wrapped method
+type ValidatedMethod struct {
+	Method
+	validation string
+}
+
+// GetBody returns body of method
+func (m ValidatedMethod) GetBody() string {
+	return m.validation + "\n\n" + m.Method.GetBody()
+}
+
+// NewValidatedMethod creates ValidatedMethod, validation is a code
+// returning error if validation failed
+func NewValidatedMethod(m Method, validation string) ValidatedMethod {
+	return ValidatedMethod{
+		Method:     m,
+		validation: validation,
+	}
+}
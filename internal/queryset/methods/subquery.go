@@ -0,0 +1,53 @@
+package methods
+
+import "fmt"
+
+// SubqueryMethod is a method using subquery type: types of all used
+// subqueries are generated
+type SubqueryMethod interface {
+	GetSubqueryTypeName() string
+}
+
+type subqueryMethod struct {
+	subqueryTypeName string
+}
+
+// GetSubqueryTypeName returns name of used subquery type
+func (m subqueryMethod) GetSubqueryTypeName() string {
+	return m.subqueryTypeName
+}
+
+func newSubqueryMethod(subqueryTypeName string) subqueryMethod {
+	return subqueryMethod{
+		subqueryTypeName: subqueryTypeName,
+	}
+}
+
+// SelectSubqueryMethod creates subquery selecting field: Select<Field>
+type SelectSubqueryMethod struct {
+	onFieldMethod
+	baseQuerySetMethod
+	noArgsMethod
+	constRetMethod
+	constBodyMethod
+	subqueryMethod
+}
+
+// NewSelectSubqueryMethod creates Select<Field> method
+func NewSelectSubqueryMethod(ctx QsFieldContext) SelectSubqueryMethod {
+	ctx = ctx.WithOperationName("Select")
+	subqueryTypeName := SubqueryTypeName(ctx.fieldTypeName())
+	r := SelectSubqueryMethod{
+		onFieldMethod:      ctx.onFieldMethod(),
+		baseQuerySetMethod: newBaseQuerySetMethod(ctx.qsTypeName()),
+		constRetMethod:     newConstRetMethod(subqueryTypeName),
+		constBodyMethod: newConstBodyMethod(`return %s{db: %s.Select(%s)}`,
+			subqueryTypeName, qsDbName, ctx.columnSQL("%s")),
+		subqueryMethod: newSubqueryMethod(subqueryTypeName),
+	}
+	r.setFieldNameFirst(false)
+	r.setDoc(fmt.Sprintf(`// %s returns subquery selecting %s of rows of queryset
+	// for InQuery and NotInQuery filters
+	// nolint: dupl`, r.GetMethodName(), ctx.fieldName()))
+	return r
+}
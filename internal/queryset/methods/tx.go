@@ -0,0 +1,88 @@
+package methods
+
+import (
+	"fmt"
+	"strings"
+)
+
+const inTxDoc = `// InTx returns copy bound to transaction tx: queries are executed in it.
+	// Gorm v1 can't move conditions into another connection, so with gorm v1
+	// InTx must be called before any condition is set, otherwise queries fail.
+	// Context and preloads are moved into transaction by both gorm versions.
+	// nolint: dupl`
+
+// InTxMethod creates InTx method
+type InTxMethod struct {
+	namedMethod
+	structMethod
+	oneArgMethod
+	constRetMethod
+	constBodyMethod
+}
+
+// getInTxBody returns code binding gorm db in dbVar to transaction tx,
+// emptyDB is an expression for db without conditions
+func getInTxBody(backend Backend, dbVar, emptyDB string) string {
+	if backend.IsGormV2() {
+		return fmt.Sprintf(`ctx := tx.Statement.Context
+		if %[1]s.Statement.Context != context.Background() {
+			ctx = %[1]s.Statement.Context // set by WithContext
+		}
+		db := %[1]s.Session(&gorm.Session{Context: ctx})
+		db.Statement.ConnPool = tx.Statement.ConnPool
+		if tx.Error != nil {
+			db.AddError(tx.Error)
+		}`, dbVar)
+	}
+
+	return strings.Join([]string{
+		fmt.Sprintf("if !reflect.DeepEqual(%s.QueryExpr(), %s.QueryExpr()) {", dbVar, emptyDB),
+		"tx = tx.New()",
+		`tx.AddError(errors.New("InTx must be called before any condition is set: " +`,
+		`"gorm v1 can't move conditions into transaction"))`,
+		"}",
+		fmt.Sprintf("tx = txWithSettings(%s, tx)", dbVar),
+	}, "\n")
+}
+
+// NewQuerySetInTxMethod creates InTx method of queryset
+func NewQuerySetInTxMethod(ctx QsStructContext) InTxMethod {
+	qsTypeName := ctx.qsTypeName()
+	body := getInTxBody(ctx.backend, qsDbName, fmt.Sprintf("New%s(qs.db.New()).db", qsTypeName))
+	if ctx.backend.IsGormV2() {
+		body += "\nreturn qs.w(db)"
+	} else {
+		body += fmt.Sprintf("\nreturn New%s(tx)", qsTypeName)
+	}
+
+	r := InTxMethod{
+		namedMethod:     newNamedMethod("InTx"),
+		structMethod:    newStructMethod(qsReceiverName, qsTypeName),
+		oneArgMethod:    newOneArgMethod("tx", "*gorm.DB"),
+		constRetMethod:  newConstRetMethod(qsTypeName),
+		constBodyMethod: newConstBodyMethod("%s", body),
+	}
+	r.setDoc(inTxDoc)
+	return r
+}
+
+// NewUpdaterInTxMethod creates InTx method of updater
+func NewUpdaterInTxMethod(ctx QsStructContext, updaterTypeName string) InTxMethod {
+	body := getInTxBody(ctx.backend, "u.db", fmt.Sprintf("u.db.New().Model(&%s{})", ctx.s.TypeName))
+	if ctx.backend.IsGormV2() {
+		body += "\nu.db = db"
+	} else {
+		body += fmt.Sprintf("\nu.db = tx.Model(&%s{})", ctx.s.TypeName)
+	}
+	body += "\nreturn u"
+
+	r := InTxMethod{
+		namedMethod:     newNamedMethod("InTx"),
+		structMethod:    newStructMethod("u", updaterTypeName),
+		oneArgMethod:    newOneArgMethod("tx", "*gorm.DB"),
+		constRetMethod:  newConstRetMethod(updaterTypeName),
+		constBodyMethod: newConstBodyMethod("%s", body),
+	}
+	r.setDoc(inTxDoc)
+	return r
+}
@@ -1,7 +1,10 @@
 package methods
 
 import (
+	"fmt"
 	"strings"
+
+	"github.com/jirfag/go-queryset/internal/queryset/field"
 )
 
 // baseUpdaterMethod
@@ -32,13 +35,45 @@ type UpdaterSetMethod struct {
 func NewUpdaterSetMethod(fieldName, fieldTypeName,
 	updaterTypeName, dbSchemaTypeName string) UpdaterSetMethod {
 
+	return newUpdaterSetMethodImpl(fieldName, fieldName, fieldTypeName, updaterTypeName, dbSchemaTypeName, "%s")
+}
+
+// NewUpdaterSetJSONMethod create new SetField method for map or slice
+// field stored in JSON column: value is marshaled to JSON
+func NewUpdaterSetJSONMethod(fieldName, fieldTypeName,
+	updaterTypeName, dbSchemaTypeName string) UpdaterSetMethod {
+
+	return newUpdaterSetMethodImpl(fieldName, fieldName, fieldTypeName, updaterTypeName, dbSchemaTypeName,
+		"jsonColumnValue{%s}")
+}
+
+// NewUpdaterSetAssocIDMethod creates new Set<Assoc>ID method for belongs
+// to association with foreign key fk not named <Assoc>ID
+func NewUpdaterSetAssocIDMethod(assocName string, fk field.Info,
+	updaterTypeName, dbSchemaTypeName string) UpdaterSetMethod {
+
+	typeName := fk.TypeName
+	if fk.IsNullValue {
+		typeName = fk.GetPointed().TypeName
+	}
+	r := newUpdaterSetMethodImpl(assocName+"ID", fk.Name, typeName, updaterTypeName, dbSchemaTypeName, "%s")
+	r.setDoc(fmt.Sprintf(`// %s sets foreign key %s of %s
+	// nolint: dupl`, r.GetMethodName(), fk.Name, assocName))
+	return r
+}
+
+// newUpdaterSetMethodImpl creates method Set<fieldName> setting column of
+// field columnFieldName: they differ for foreign keys
+func newUpdaterSetMethodImpl(fieldName, columnFieldName, fieldTypeName,
+	updaterTypeName, dbSchemaTypeName, valueFmt string) UpdaterSetMethod {
+
 	argName := fieldNameToArgName(fieldName)
 	cbm := newConstBodyMethod(
 		`u.fields[string(%s.%s)] = %s
 		return u`,
 		dbSchemaTypeName,
-		fieldName,
-		argName)
+		columnFieldName,
+		fmt.Sprintf(valueFmt, argName))
 
 	r := UpdaterSetMethod{
 		onFieldMethod:     newOnFieldMethod("Set", fieldName),
@@ -52,6 +87,105 @@ func NewUpdaterSetMethod(fieldName, fieldTypeName,
 	return r
 }
 
+// UpdaterSetNullMethod generates Set<Field>Null method for nullable value
+type UpdaterSetNullMethod struct {
+	namedMethod
+	noArgsMethod
+	baseUpdaterMethod
+	constRetMethod
+	constBodyMethod
+}
+
+// NewUpdaterSetNullMethod create new SetFieldNull method
+func NewUpdaterSetNullMethod(fieldName, updaterTypeName, dbSchemaTypeName string) UpdaterSetNullMethod {
+	r := UpdaterSetNullMethod{
+		namedMethod:       newNamedMethod("Set" + fieldName + "Null"),
+		baseUpdaterMethod: newBaseUpdaterMethod(updaterTypeName),
+		constRetMethod:    newConstRetMethod(updaterTypeName),
+		constBodyMethod: newConstBodyMethod(
+			`u.fields[string(%s.%s)] = nil
+			return u`,
+			dbSchemaTypeName,
+			fieldName),
+	}
+	r.setDoc(fmt.Sprintf(`// %s sets %s to NULL
+	// nolint: dupl`, r.GetMethodName(), fieldName))
+	return r
+}
+
+// UpdaterSetAssocMethod generates Set<Assoc> method for belongs to
+// association: it sets foreign key to key of associated object
+type UpdaterSetAssocMethod struct {
+	onFieldMethod
+	oneArgMethod
+	baseUpdaterMethod
+	constRetMethod
+	constBodyMethod
+}
+
+// NewUpdaterSetAssocMethod creates new Set<Assoc> method: fk is a foreign
+// key field of model, key is a referenced field of associated struct.
+// Object is passed by pointer for both pointer and value association
+// fields. Nil object clears foreign key: it's set to NULL if fk is nullable.
+func NewUpdaterSetAssocMethod(assoc, fk, key field.Info,
+	updaterTypeName, dbSchemaTypeName string) UpdaterSetAssocMethod {
+
+	argName := fieldNameToArgName(assoc.Name)
+	column := fmt.Sprintf("u.fields[string(%s.%s)]", dbSchemaTypeName, fk.Name)
+
+	valueTypeName := fk.TypeName
+	if fk.IsNullable() {
+		valueTypeName = fk.GetPointed().TypeName
+	}
+	value := argName + "." + key.Name
+	if valueTypeName != key.TypeName {
+		value = fmt.Sprintf("%s(%s)", valueTypeName, value)
+	}
+
+	var body string
+	switch {
+	case fk.IsPointer:
+		body = fmt.Sprintf(`var id *%s
+		if %s != nil {
+			v := %s
+			id = &v
+		}
+		%s = id
+		return u`, valueTypeName, argName, value, column)
+	case fk.IsNullValue:
+		// updater sets value of nullable value or NULL
+		body = fmt.Sprintf(`if %s == nil {
+			%s = nil
+			return u
+		}
+		%s = %s
+		return u`, argName, column, column, value)
+	default:
+		body = fmt.Sprintf(`var id %s
+		if %s != nil {
+			id = %s
+		}
+		%s = id
+		return u`, fk.TypeName, argName, value, column)
+	}
+
+	assocTypeName := assoc.TypeName
+	if assoc.IsPointer {
+		assocTypeName = assoc.GetPointed().TypeName
+	}
+	r := UpdaterSetAssocMethod{
+		onFieldMethod:     newOnFieldMethod("Set", assoc.Name),
+		oneArgMethod:      newOneArgMethod(argName, "*"+assocTypeName),
+		baseUpdaterMethod: newBaseUpdaterMethod(updaterTypeName),
+		constRetMethod:    newConstRetMethod(updaterTypeName),
+		constBodyMethod:   newConstBodyMethod("%s", body),
+	}
+	r.setFieldNameFirst(false)
+	r.setDoc(fmt.Sprintf(`// %s sets foreign key %s to %s of %s, nil clears it
+	// nolint: dupl`, r.GetMethodName(), fk.Name, key.Name, argName))
+	return r
+}
+
 // UpdaterUpdateMethod creates Update method
 type UpdaterUpdateMethod struct {
 	namedMethod
@@ -62,11 +196,12 @@ type UpdaterUpdateMethod struct {
 }
 
 // NewUpdaterUpdateMethod create new Update method
-func NewUpdaterUpdateMethod(updaterTypeName string) UpdaterUpdateMethod {
+func NewUpdaterUpdateMethod(updaterTypeName string, backend Backend) UpdaterUpdateMethod {
 	return UpdaterUpdateMethod{
 		namedMethod:       newNamedMethod("Update"),
 		baseUpdaterMethod: newBaseUpdaterMethod(updaterTypeName),
-		constBodyMethod:   newConstBodyMethod("return u.db.Updates(u.fields).Error"),
+		constBodyMethod: newConstBodyMethod("return %s.Updates(u.fields).Error",
+			backend.queryDBExpr("u.db")),
 	}
 }
 
@@ -80,14 +215,14 @@ type UpdaterUpdateNumMethod struct {
 }
 
 // NewUpdaterUpdateNumMethod creates new UpdateNum method
-func NewUpdaterUpdateNumMethod(updaterTypeName string) UpdaterUpdateNumMethod {
+func NewUpdaterUpdateNumMethod(updaterTypeName string, backend Backend) UpdaterUpdateNumMethod {
 	return UpdaterUpdateNumMethod{
 		namedMethod:       newNamedMethod("UpdateNum"),
 		baseUpdaterMethod: newBaseUpdaterMethod(updaterTypeName),
 		constRetMethod:    newConstRetMethod("(int64, error)"),
 		constBodyMethod: newConstBodyMethod(
 			strings.Join([]string{
-				"db := u.db.Updates(u.fields)",
+				"db := " + backend.queryDBExpr("u.db") + ".Updates(u.fields)",
 				"return db.RowsAffected, db.Error",
 			}, "\n"),
 		),
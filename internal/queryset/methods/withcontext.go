@@ -0,0 +1,41 @@
+package methods
+
+const withContextDoc = `// WithContext returns copy executing queries with context ctx.
+	// Gorm v1 doesn't support context: its error is checked only before query.
+	// nolint: dupl`
+
+// WithContextMethod creates WithContext method
+type WithContextMethod struct {
+	namedMethod
+	structMethod
+	oneArgMethod
+	constRetMethod
+	constBodyMethod
+}
+
+// NewQuerySetWithContextMethod creates WithContext method of queryset
+func NewQuerySetWithContextMethod(qsTypeName string) WithContextMethod {
+	r := WithContextMethod{
+		namedMethod:     newNamedMethod("WithContext"),
+		structMethod:    newStructMethod(qsReceiverName, qsTypeName),
+		oneArgMethod:    newOneArgMethod("ctx", "context.Context"),
+		constRetMethod:  newConstRetMethod(qsTypeName),
+		constBodyMethod: newConstBodyMethod("return qs.w(DBWithContext(ctx, %s))", qsDbName),
+	}
+	r.setDoc(withContextDoc)
+	return r
+}
+
+// NewUpdaterWithContextMethod creates WithContext method of updater
+func NewUpdaterWithContextMethod(updaterTypeName string) WithContextMethod {
+	r := WithContextMethod{
+		namedMethod:    newNamedMethod("WithContext"),
+		structMethod:   newStructMethod("u", updaterTypeName),
+		oneArgMethod:   newOneArgMethod("ctx", "context.Context"),
+		constRetMethod: newConstRetMethod(updaterTypeName),
+		constBodyMethod: newConstBodyMethod(`u.db = DBWithContext(ctx, u.db)
+			return u`),
+	}
+	r.setDoc(withContextDoc)
+	return r
+}